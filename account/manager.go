@@ -20,6 +20,7 @@ package account
 
 import (
 	"errors"
+	"sort"
 
 	"path/filepath"
 
@@ -28,8 +29,10 @@ import (
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/cipher"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
@@ -185,6 +188,49 @@ func (m *Manager) Accounts() []*core.Address {
 	return addrs
 }
 
+// AccountInfo is the metadata of an account.
+type AccountInfo struct {
+	Address *core.Address
+
+	// Unlocked is true if the key is unlocked in keystore.
+	Unlocked bool
+
+	// PathHash is the hash of the key file path, empty if the key is not in keydir.
+	PathHash byteutils.Hash
+}
+
+// AccountsInfo returns the metadata of at most limit accounts after the cursor address,
+// the accounts are sorted by address, limit 0 returns all accounts after cursor.
+// The returned cursor is the address of the last account if there are more accounts.
+func (m *Manager) AccountsInfo(cursor string, limit int) ([]*AccountInfo, string) {
+	m.refreshAccounts()
+	accounts := make([]*account, 0, len(m.accounts))
+	for _, a := range m.accounts {
+		if a.addr.String() > cursor {
+			accounts = append(accounts, a)
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].addr.String() < accounts[j].addr.String()
+	})
+
+	next := ""
+	if limit > 0 && len(accounts) > limit {
+		accounts = accounts[:limit]
+		next = accounts[limit-1].addr.String()
+	}
+
+	infos := make([]*AccountInfo, len(accounts))
+	for index, a := range accounts {
+		_, err := m.ks.GetUnlocked(a.addr.String())
+		infos[index] = &AccountInfo{Address: a.addr, Unlocked: err == nil}
+		if len(a.path) > 0 {
+			infos[index].PathHash = hash.Sha3256([]byte(a.path))
+		}
+	}
+	return infos, next
+}
+
 // Update update addr locked passphrase
 func (m *Manager) Update(addr *core.Address, oldPassphrase, newPassphrase []byte) error {
 	key, err := m.ks.GetKey(addr.String(), oldPassphrase)
@@ -302,28 +348,49 @@ func (m *Manager) SignTransactionWithPassphrase(addr *core.Address, tx *core.Tra
 	if !tx.From().Equals(addr) {
 		return ErrTxSignFrom
 	}
+	signature, err := m.signatureWithPassphrase(addr, passphrase)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"func": "SignTransactionWithPassphrase",
+			"err":  ErrTxAddressLocked,
+			"tx":   tx,
+		}).Error("transaction address get failed")
+		return err
+	}
+	return tx.Sign(signature)
+}
+
+// SignMultisigPayloadWithPassphrase sign the hash of multisig payload with the signer passphrase
+func (m *Manager) SignMultisigPayloadWithPassphrase(addr *core.Address, payload *core.MultisigPayload, hash byteutils.Hash, passphrase []byte) error {
+	signature, err := m.signatureWithPassphrase(addr, passphrase)
+	if err != nil {
+		return err
+	}
+	sign, err := signature.Sign(hash)
+	if err != nil {
+		return err
+	}
+	return payload.AddSignature(addr, signature.Algorithm(), sign)
+}
+
+func (m *Manager) signatureWithPassphrase(addr *core.Address, passphrase []byte) (keystore.Signature, error) {
 	res, err := m.ks.ContainsAlias(addr.String())
 	if err != nil || res == false {
 		err = m.loadFile(addr, passphrase)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	key, err := m.ks.GetKey(addr.String(), passphrase)
 	if err != nil {
-		logging.VLog().WithFields(logrus.Fields{
-			"func": "SignTransactionWithPassphrase",
-			"err":  ErrTxAddressLocked,
-			"tx":   tx,
-		}).Error("transaction address get failed")
-		return err
+		return nil, err
 	}
 
 	signature, err := crypto.NewSignature(m.signatureAlg)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	signature.InitSign(key.(keystore.PrivateKey))
-	return tx.Sign(signature)
+	return signature, nil
 }
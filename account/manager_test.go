@@ -217,3 +217,41 @@ func TestManager_SignTransactionWithPassphrase(t *testing.T) {
 		})
 	}
 }
+
+func TestManager_AccountsInfo(t *testing.T) {
+	manager := NewManager(nil)
+	passphrase := []byte("passphrase")
+	addr1, err := manager.NewAccount(passphrase)
+	assert.Nil(t, err)
+	addr2, err := manager.NewAccount(passphrase)
+	assert.Nil(t, err)
+	defer manager.Delete(addr1, passphrase)
+	defer manager.Delete(addr2, passphrase)
+	assert.Nil(t, manager.Unlock(addr1, passphrase, keystore.DefaultUnlockDuration))
+
+	all, next := manager.AccountsInfo("", 0)
+	assert.Equal(t, "", next)
+	assert.Equal(t, len(manager.Accounts()), len(all))
+
+	var infos []*AccountInfo
+	for cursor := ""; ; {
+		page, next := manager.AccountsInfo(cursor, 1)
+		infos = append(infos, page...)
+		if len(next) == 0 {
+			break
+		}
+		assert.Equal(t, 1, len(page))
+		cursor = next
+	}
+	assert.Equal(t, all, infos)
+
+	for _, info := range infos {
+		if info.Address.Equals(addr1) {
+			assert.True(t, info.Unlocked)
+			assert.NotEmpty(t, info.PathHash)
+		}
+		if info.Address.Equals(addr2) {
+			assert.False(t, info.Unlocked)
+		}
+	}
+}
@@ -77,6 +77,54 @@ Admin.prototype.getDelegateVoters = function (delegatee, callback) {
     return this.request("post", "/v1/admin/delegateVoters", params, callback);
 };
 
+Admin.prototype.getTransactionDependency = function (height, callback) {
+    var params = { "height": height };
+    return this.request("post", "/v1/admin/txDependency", params, callback);
+};
+
+Admin.prototype.getForks = function (depth, callback) {
+    var params = { "depth": depth };
+    return this.request("post", "/v1/admin/forks", params, callback);
+};
+
+Admin.prototype.getContractStats = function (address, limit, callback) {
+    var params = { "address": address, "limit": limit };
+    return this.request("post", "/v1/admin/contractStats", params, callback);
+};
+
+Admin.prototype.getVoteSnapshot = function (height, callback) {
+    var params = { "height": height };
+    return this.request("post", "/v1/admin/voteSnapshot", params, callback);
+};
+
+Admin.prototype.debugCall = function (from, to, value, nonce, gasPrice, gasLimit, contract, callback) {
+    var params = {
+        "from": from,
+        "to": to,
+        "value": utils.toString(value),
+        "nonce": nonce,
+        "gasPrice": utils.toString(gasPrice),
+        "gasLimit": utils.toString(gasLimit),
+        "contract": contract
+    };
+    return this.request("post", "/v1/admin/debugCall", params, callback);
+};
+
+Admin.prototype.debugTransaction = function (hash, callback) {
+    var params = { "hash": hash };
+    return this.request("post", "/v1/admin/debugTransaction", params, callback);
+};
+
+Admin.prototype.iterateAccounts = function (height, after, limit, rate, callback) {
+    var params = { "height": height, "after": after, "limit": limit, "rate": rate };
+    return this.request("post", "/v1/admin/iterateAccounts", params, callback);
+};
+
+Admin.prototype.reloadPeerAccessControl = function (allowList, denyList, callback) {
+    var params = { "allowList": allowList, "denyList": denyList };
+    return this.request("post", "/v1/admin/peerAccessControl", params, callback);
+};
+
 Admin.prototype.startMining = function (passphrase, callback) {
     var params = { "passphrase": passphrase };
     return this.request("post", "/v1/admin/startMining", params, callback);
@@ -86,6 +134,61 @@ Admin.prototype.stopMining = function (callback) {
     return this.request("get", "/v1/admin/stopMining", null, callback);
 };
 
+Admin.prototype.registerWebhook = function (url, topics, contracts, secret, callback) {
+    var params = { "url": url, "topics": topics, "contracts": contracts, "secret": secret };
+    return this.request("post", "/v1/admin/webhook/register", params, callback);
+};
+
+Admin.prototype.unregisterWebhook = function (id, callback) {
+    var params = { "id": id };
+    return this.request("post", "/v1/admin/webhook/unregister", params, callback);
+};
+
+Admin.prototype.startPprof = function (listen, callback) {
+    var params = { "listen": listen };
+    return this.request("post", "/v1/admin/pprof/start", params, callback);
+};
+
+Admin.prototype.stopPprof = function (callback) {
+    return this.request("get", "/v1/admin/pprof/stop", null, callback);
+};
+
+Admin.prototype.setLogLevel = function (module, level, callback) {
+    var params = { "module": module, "level": level };
+    return this.request("post", "/v1/admin/logLevel", params, callback);
+};
+
+Admin.prototype.getConfig = function (callback) {
+    return this.request("get", "/v1/admin/getConfig", null, callback);
+};
+
+Admin.prototype.createMultisigTransaction = function (from, to, nonce, gasPrice, gasLimit, multisig, callback) {
+    var params = {
+        "from": from,
+        "to": to,
+        "nonce": nonce,
+        "gasPrice": utils.toString(gasPrice),
+        "gasLimit": utils.toString(gasLimit),
+        "multisig": multisig
+    };
+    return this.request("post", "/v1/admin/multisig/create", params, callback);
+};
+
+Admin.prototype.signMultisigTransaction = function (hash, signer, passphrase, callback) {
+    var params = { "hash": hash, "signer": signer, "passphrase": passphrase };
+    return this.request("post", "/v1/admin/multisig/sign", params, callback);
+};
+
+Admin.prototype.getMultisigTransaction = function (hash, callback) {
+    var params = { "hash": hash };
+    return this.request("post", "/v1/admin/multisig/get", params, callback);
+};
+
+Admin.prototype.sendMultisigTransaction = function (hash, passphrase, callback) {
+    var params = { "hash": hash, "passphrase": passphrase };
+    return this.request("post", "/v1/admin/multisig/send", params, callback);
+};
+
 Admin.prototype.request = function (method, api, params, callback) {
     if (utils.isFunction(callback)) {
         this._request.asyncRequest(method, api, params, callback);
@@ -15,12 +15,24 @@ API.prototype.getNebState = function (callback) {
     return this.request("get", "/v1/user/nebstate", null, callback);
 };
 
+API.prototype.getSyncStatus = function (callback) {
+    return this.request("get", "/v1/user/syncStatus", null, callback);
+};
+
 API.prototype.nodeInfo = function (callback) {
     return this.request("get", "/v1/user/nodeinfo", null, callback);
 };
 
-API.prototype.accounts = function (callback) {
-    return this.request("get", "/v1/user/accounts", null, callback);
+API.prototype.accounts = function (cursor, limit, callback) {
+    if (utils.isFunction(cursor)) {
+        callback = cursor;
+        cursor = undefined;
+    }
+    var api = "/v1/user/accounts";
+    if (cursor !== undefined || limit !== undefined) {
+        api += "?cursor=" + encodeURIComponent(cursor || "") + "&limit=" + (limit || 0);
+    }
+    return this.request("get", api, null, callback);
 };
 
 API.prototype.blockDump = function (count, callback) {
@@ -33,6 +45,11 @@ API.prototype.getAccountState = function (address, height, callback) {
     return this.request("post", "/v1/user/accountstate", params, callback);
 };
 
+API.prototype.getAccountStateProof = function (address, height, callback) {
+    var params = { "address": address, "height": height };
+    return this.request("post", "/v1/user/accountStateProof", params, callback);
+};
+
 API.prototype.sendTransaction = function (from, to, value, nonce, gasPrice, gasLimit, contract, candidate, delegate, callback) {
     var params = {
         "from": from,
@@ -66,16 +83,50 @@ API.prototype.sendRawTransaction = function (data, callback) {
     return this.request("post", "/v1/user/rawtransaction", params, callback);
 };
 
-API.prototype.getBlockByHash = function (hash, fullTransaction, callback) {
-    var params = { "hash": hash, "fullTransaction": fullTransaction };
+API.prototype.sendRawTransactions = function (data, callback) {
+    var params = { "data": data };
+    return this.request("post", "/v1/user/rawtransactions", params, callback);
+};
+
+API.prototype.getBlockByHash = function (hash, fullTransaction, fullDpos, headerOnly, callback) {
+    if (utils.isFunction(fullDpos)) {
+        callback = fullDpos;
+        fullDpos = false;
+    }
+    if (utils.isFunction(headerOnly)) {
+        callback = headerOnly;
+        headerOnly = false;
+    }
+    var params = { "hash": hash, "fullTransaction": fullTransaction, "fullDpos": fullDpos, "headerOnly": headerOnly };
     return this.request("post", "/v1/user/getBlockByHash", params, callback);
 };
 
-API.prototype.getBlockByHeight = function (height, fullTransaction, callback) {
-    var params = { "height": height, "fullTransaction": fullTransaction };
+API.prototype.getBlockByHeight = function (height, fullTransaction, fullDpos, headerOnly, callback) {
+    if (utils.isFunction(fullDpos)) {
+        callback = fullDpos;
+        fullDpos = false;
+    }
+    if (utils.isFunction(headerOnly)) {
+        callback = headerOnly;
+        headerOnly = false;
+    }
+    var params = { "height": height, "fullTransaction": fullTransaction, "fullDpos": fullDpos, "headerOnly": headerOnly };
     return this.request("post", "/v1/user/getBlockByHeight", params, callback);
 };
 
+API.prototype.getBlockByTimestamp = function (timestamp, fullTransaction, fullDpos, headerOnly, callback) {
+    if (utils.isFunction(fullDpos)) {
+        callback = fullDpos;
+        fullDpos = false;
+    }
+    if (utils.isFunction(headerOnly)) {
+        callback = headerOnly;
+        headerOnly = false;
+    }
+    var params = { "timestamp": timestamp, "fullTransaction": fullTransaction, "fullDpos": fullDpos, "headerOnly": headerOnly };
+    return this.request("post", "/v1/user/getBlockByTimestamp", params, callback);
+};
+
 API.prototype.getTransactionReceipt = function (hash, callback) {
     var params = { "hash": hash };
     return this.request("post", "/v1/user/getTransactionReceipt", params, callback);
@@ -86,6 +137,11 @@ API.prototype.subscribe = function (topic, callback) {
     return this.request("post", "/v1/user/subscribe", params, callback);
 };
 
+API.prototype.subscribeTransaction = function (hash, confirmations, callback) {
+    var params = { "hash": hash, "confirmations": confirmations };
+    return this.request("post", "/v1/user/subscribeTransaction", params, callback);
+};
+
 API.prototype.gasPrice = function (callback) {
     return this.request("get", "/v1/user/getGasPrice", null, callback);
 };
@@ -110,11 +166,71 @@ API.prototype.getGasUsed = function (hash, callback) {
     return this.request("post", "/v1/user/getGasUsed", params, callback);
 };
 
+API.prototype.getContractState = function (address, key, height, callback) {
+    var params = { "address": address, "key": key, "height": height };
+    return this.request("post", "/v1/user/getContractState", params, callback);
+};
+
+API.prototype.getContractMetadata = function (address, callback) {
+    var params = { "address": address };
+    return this.request("post", "/v1/user/getContractMetadata", params, callback);
+};
+
+API.prototype.getTokenBalance = function (contract, address, callback) {
+    var params = { "contract": contract, "address": address };
+    return this.request("post", "/v1/user/getTokenBalance", params, callback);
+};
+
+API.prototype.getTokenMetadata = function (contract, callback) {
+    var params = { "contract": contract };
+    return this.request("post", "/v1/user/getTokenMetadata", params, callback);
+};
+
+API.prototype.getContractAddress = function (from, nonce, callback) {
+    var params = { "from": from, "nonce": nonce };
+    return this.request("post", "/v1/user/getContractAddress", params, callback);
+};
+
+API.prototype.getAccountHistory = function (address, offset, limit, callback) {
+    var params = { "address": address, "offset": offset, "limit": limit };
+    return this.request("post", "/v1/user/accountHistory", params, callback);
+};
+
+API.prototype.getChainStats = function (window, callback) {
+    var params = { "window": window };
+    return this.request("post", "/v1/user/chainStats", params, callback);
+};
+
+API.prototype.getMinerStats = function (start, end, callback) {
+    var params = { "start": start, "end": end };
+    return this.request("post", "/v1/user/minerStats", params, callback);
+};
+
 API.prototype.getEventsByHash = function (hash, callback) {
     var params = { "hash": hash };
     return this.request("post", "/v1/user/getEventsByHash", params, callback);
 };
 
+API.prototype.getTotalSupply = function (height, callback) {
+    var params = { "height": height };
+    return this.request("post", "/v1/user/totalSupply", params, callback);
+};
+
+API.prototype.getEventsByCursor = function (consumer, limit, callback) {
+    var params = { "consumer": consumer, "limit": limit };
+    return this.request("post", "/v1/user/getEventsByCursor", params, callback);
+};
+
+API.prototype.filterEvents = function (fromHeight, toHeight, topics, contracts, indexed, limit, callback) {
+    var params = { "from_height": fromHeight, "to_height": toHeight, "topics": topics, "contracts": contracts, "indexed": indexed, "limit": limit };
+    return this.request("post", "/v1/user/filterEvents", params, callback);
+};
+
+API.prototype.commitEventCursor = function (consumer, height, index, callback) {
+    var params = { "consumer": consumer, "height": height, "index": index };
+    return this.request("post", "/v1/user/commitEventCursor", params, callback);
+};
+
 API.prototype.request = function (method, api, params, callback) {
     if (utils.isFunction(callback)) {
         this._request.asyncRequest(method, api, params, callback);
@@ -20,12 +20,16 @@ package main
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"strconv"
 
 	"bytes"
 	"encoding/json"
 
 	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/urfave/cli"
 )
 
@@ -55,6 +59,40 @@ The genesis command for genesis dump or other commands.`,
 
 Dump the genesis config info.`,
 			},
+			{
+				Name:      "generate",
+				Usage:     "generate a genesis for private chain",
+				ArgsUsage: "<genesisPath> <dynastyAddress>...",
+				Action:    generateGenesis,
+				Flags: []cli.Flag{
+					cli.UintFlag{
+						Name:  "chainid",
+						Usage: "chain id",
+						Value: 100,
+					},
+					cli.StringFlag{
+						Name:  "balance",
+						Usage: "balance allocated to each dynasty member",
+						Value: "10000000000000000000000",
+					},
+				},
+				Description: `
+    neb genesis generate --chainid 1001 genesis.json <address>...
+
+Generate a genesis JSON with the default params, the addresses are the initial
+dynasty members and allocated the balance each. Edit the file to change the
+token distribution or the params.`,
+			},
+			{
+				Name:      "validate",
+				Usage:     "validate a genesis file",
+				ArgsUsage: "<genesisPath>",
+				Action:    validateGenesis,
+				Description: `
+    neb genesis validate genesis.json
+
+Validate the genesis in JSON or protobuf text is able to create a genesis block.`,
+			},
 		},
 	}
 
@@ -67,6 +105,38 @@ Dump the genesis config info.`,
 		Description: `
 Use "./neb dump 10" to dump 10 blocks before tail block.`,
 	}
+
+	snapshotCommand = cli.Command{
+		Name:     "snapshot",
+		Usage:    "Manage state snapshots",
+		Category: "BLOCKCHAIN COMMANDS",
+		Description: `
+Export the state at the latest irreversible block to a file, and bootstrap
+a new node from it without replaying the blocks from genesis.`,
+		Subcommands: []cli.Command{
+			{
+				Name:      "export",
+				Usage:     "Export the snapshot of the latest irreversible block",
+				ArgsUsage: "<snapshotPath>",
+				Action:    MergeFlags(exportSnapshot),
+				Description: `
+    neb snapshot export snapshot.dat
+
+Export the state at the latest irreversible block to the file.`,
+			},
+			{
+				Name:      "restore",
+				Usage:     "Restore the snapshot into an empty data directory",
+				ArgsUsage: "<snapshotPath>",
+				Action:    MergeFlags(restoreSnapshot),
+				Description: `
+    neb snapshot restore snapshot.dat
+
+Restore the snapshot into the empty data directory, the node starts from
+the block of snapshot. Make sure the block hash printed is trusted.`,
+			},
+		},
+	}
 )
 
 func initGenesis(ctx *cli.Context) error {
@@ -109,6 +179,36 @@ func dumpGenesis(ctx *cli.Context) error {
 	return nil
 }
 
+func generateGenesis(ctx *cli.Context) error {
+	if len(ctx.Args()) < 2 {
+		FatalF("generate genesis failed: genesis path and dynasty addresses are required")
+	}
+	genesis := core.NewGenesisConf(uint32(ctx.Uint("chainid")), ctx.Args()[1:], ctx.String("balance"))
+	if err := core.ValidateGenesisConf(genesis); err != nil {
+		FatalF("generate genesis failed: %v", err)
+	}
+	content, err := core.MarshalGenesisConf(genesis)
+	if err != nil {
+		FatalF("generate genesis failed: %v", err)
+	}
+	if err := ioutil.WriteFile(ctx.Args().First(), []byte(content), 0644); err != nil {
+		FatalF("generate genesis failed: %v", err)
+	}
+	return nil
+}
+
+func validateGenesis(ctx *cli.Context) error {
+	genesis, err := core.LoadGenesisConf(ctx.Args().First())
+	if err != nil {
+		FatalF("load genesis conf failed: %v", err)
+	}
+	if err := core.ValidateGenesisConf(genesis); err != nil {
+		FatalF("invalid genesis: %v", err)
+	}
+	fmt.Println("genesis is valid")
+	return nil
+}
+
 func dumpblock(ctx *cli.Context) error {
 	neb, err := makeNeb(ctx)
 	if err != nil {
@@ -124,3 +224,59 @@ func dumpblock(ctx *cli.Context) error {
 	fmt.Printf("blockchain dump: %s\n", neb.BlockChain().Dump(count))
 	return nil
 }
+
+func exportSnapshot(ctx *cli.Context) error {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+
+	neb.Setup()
+
+	f, err := os.Create(ctx.Args().First())
+	if err != nil {
+		FatalF("export snapshot failed: %v", err)
+	}
+	defer f.Close()
+
+	block, err := neb.BlockChain().ExportSnapshot(f)
+	if err != nil {
+		FatalF("export snapshot failed: %v", err)
+	}
+	fmt.Printf("snapshot exported at height %d, block hash %s\n", block.Height(), block.Hash())
+	return nil
+}
+
+func restoreSnapshot(ctx *cli.Context) error {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(ctx.Args().First())
+	if err != nil {
+		FatalF("restore snapshot failed: %v", err)
+	}
+	defer f.Close()
+
+	var stor interface {
+		storage.Storage
+		Close() error
+	}
+	if conf := neb.Config().Chain; conf.SplitStorage {
+		stor, err = storage.NewSplitDiskStorage(conf.Datadir)
+	} else {
+		stor, err = storage.NewDiskStorage(conf.Datadir)
+	}
+	if err != nil {
+		FatalF("restore snapshot failed: %v", err)
+	}
+	defer stor.Close()
+
+	header, err := core.RestoreSnapshot(f, stor, neb.Genesis().Meta.ChainId)
+	if err != nil {
+		FatalF("restore snapshot failed: %v", err)
+	}
+	fmt.Printf("snapshot restored at height %d, block hash %s\n", header.Height, byteutils.Hash(header.BlockHash))
+	return nil
+}
@@ -99,6 +99,12 @@ var (
 		Usage: "chain miner's passphrase.",
 	}
 
+	// ChainDevFlag chain dev mode
+	ChainDevFlag = cli.BoolFlag{
+		Name:  "dev",
+		Usage: "run alone on a local dev network minting a block every second, the chain data is kept in <datadir>.dev",
+	}
+
 	// ChainGasPriceFlag chain transaction pool min gasPrice
 	ChainGasPriceFlag = cli.StringFlag{
 		Name:  "chain.gasprice",
@@ -122,6 +128,7 @@ var (
 		ChainPassphraseFlag,
 		ChainGasPriceFlag,
 		ChainGasLimitFlag,
+		ChainDevFlag,
 	}
 
 	// RPCListenFlag rpc listen
@@ -275,6 +282,9 @@ func chainConfig(ctx *cli.Context, cfg *nebletpb.ChainConfig) {
 	if ctx.GlobalIsSet(ChainCipherFlag.Name) {
 		cfg.SignatureCiphers = ctx.GlobalStringSlice(ChainCipherFlag.Name)
 	}
+	if ctx.GlobalIsSet(ChainDevFlag.Name) {
+		cfg.Dev = ctx.GlobalBool(ChainDevFlag.Name)
+	}
 }
 
 func rpcConfig(ctx *cli.Context, cfg *nebletpb.RPCConfig) {
@@ -76,7 +76,9 @@ func main() {
 		licenseCommand,
 		configCommand,
 		blockDumpCommand,
+		snapshotCommand,
 		serializeCommand,
+		signerCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
@@ -19,10 +19,18 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
 
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/urfave/cli"
 )
 
@@ -48,6 +56,33 @@ If the private key of a network node is exist, the nodeID will not change.
 
 Make sure that the seed node should have a private key.`,
 			},
+			{
+				Name:      "dnsseed-keygen",
+				Usage:     "Generate a key signing the dns seed records",
+				Action:    generateDNSSeedKey,
+				ArgsUsage: "<path>",
+				Description: `
+
+Generate a secp256k1 key signing the dns seed records, the private key is saved
+in hex to the path, and the public key should be set as network.dns_seed_pubkey.`,
+			},
+			{
+				Name:      "dnsseed-sign",
+				Usage:     "Sign the seed peers as TXT records of a dns seed",
+				Action:    signDNSSeedRecords,
+				ArgsUsage: "<keyPath> <domain> <ipfsAddr>...",
+				Flags: []cli.Flag{
+					cli.DurationFlag{
+						Name:  "expire",
+						Usage: "validity duration of the records",
+						Value: 30 * 24 * time.Hour,
+					},
+				},
+				Description: `
+
+Sign the seed peers with the key generated by dnsseed-keygen, each line printed
+is the value of a TXT record of the domain.`,
+			},
 		},
 	}
 )
@@ -71,3 +106,63 @@ func generatePrivateKey(ctx *cli.Context) error {
 
 	return nil
 }
+
+func generateDNSSeedKey(ctx *cli.Context) error {
+	path := ctx.Args().First()
+	if len(path) == 0 {
+		return errors.New("missing the key path")
+	}
+
+	priv, err := crypto.NewPrivateKey(keystore.SECP256K1, nil)
+	if err != nil {
+		return err
+	}
+	data, err := priv.Encoded()
+	if err != nil {
+		return err
+	}
+	pub, err := priv.PublicKey().Encoded()
+	if err != nil {
+		return err
+	}
+	if err := account.WriteFile(path, []byte(byteutils.Hex(data))); err != nil {
+		return err
+	}
+
+	fmt.Printf("dns seed public key: %s\n", byteutils.Hex(pub))
+	return nil
+}
+
+func signDNSSeedRecords(ctx *cli.Context) error {
+	if ctx.NArg() < 3 {
+		return errors.New("usage: dnsseed-sign <keyPath> <domain> <ipfsAddr>...")
+	}
+
+	content, err := ioutil.ReadFile(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	data, err := byteutils.FromHex(strings.TrimSpace(string(content)))
+	if err != nil {
+		return err
+	}
+	priv, err := crypto.NewPrivateKey(keystore.SECP256K1, data)
+	if err != nil {
+		return err
+	}
+
+	domain := ctx.Args().Get(1)
+	expires := time.Now().Add(ctx.Duration("expire")).Unix()
+	for _, v := range ctx.Args()[2:] {
+		addr, err := ma.NewMultiaddr(v)
+		if err != nil {
+			return err
+		}
+		record, err := p2p.SignDNSSeedRecord(priv, domain, addr, expires)
+		if err != nil {
+			return err
+		}
+		fmt.Println(record)
+	}
+	return nil
+}
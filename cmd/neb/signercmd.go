@@ -0,0 +1,114 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/consensus/dpos"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/urfave/cli"
+)
+
+var (
+	signerCommand = cli.Command{
+		Action:    MergeFlags(runSigner),
+		Name:      "signer",
+		Usage:     "Run a remote block signer holding the miner key",
+		ArgsUsage: "<listenAddress>",
+		Category:  "ACCOUNT COMMANDS",
+		Description: `
+    neb signer unix:///tmp/neb.signer.ipc
+    neb signer 0.0.0.0:8690
+
+Unlock the miner in config and sign the blocks for the neblets configured with the
+same remote_signer. The signer never signs two different blocks in a slot.
+The signer on tcp requires remote_signer_cert, remote_signer_key and remote_signer_ca
+in config, only the neblets with a cert issued by the ca can get blocks signed.`,
+	}
+)
+
+func runSigner(ctx *cli.Context) error {
+	neb, err := makeNeb(ctx)
+	if err != nil {
+		return err
+	}
+	logging.Init(neb.Config().App.LogFile, neb.Config().App.LogLevel, neb.Config().App.LogAge)
+
+	target := ctx.Args().First()
+	if len(target) == 0 {
+		FatalF("No listen address specified")
+	}
+	miner, err := core.AddressParse(neb.Config().Chain.Miner)
+	if err != nil {
+		FatalF("Invalid miner address %s: %v", neb.Config().Chain.Miner, err)
+	}
+
+	passphrase := getPassPhrase(fmt.Sprintf("Unlock the miner %s", miner.String()), false)
+	if err := neb.AccountManager().Unlock(miner, []byte(passphrase), keystore.YearUnlockDuration); err != nil {
+		FatalF("Failed to unlock the miner: %v", err)
+	}
+	key, err := keystore.DefaultKS.GetUnlocked(miner.String())
+	if err != nil {
+		return err
+	}
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	if err != nil {
+		return err
+	}
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	var (
+		listener  net.Listener
+		tlsConfig *tls.Config
+	)
+	if strings.HasPrefix(target, dpos.UnixSignerPrefix) {
+		path := strings.TrimPrefix(target, dpos.UnixSignerPrefix)
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if listener, err = net.Listen("unix", path); err != nil {
+			return err
+		}
+		// only the owner can access the signer.
+		if err := os.Chmod(path, 0600); err != nil {
+			return err
+		}
+	} else {
+		chain := neb.Config().Chain
+		if tlsConfig, err = dpos.NewSignerTLSConfig(chain.RemoteSignerCert, chain.RemoteSignerKey, chain.RemoteSignerCa); err != nil {
+			FatalF("Failed to load the mutual tls config of the signer on tcp: %v", err)
+		}
+		if listener, err = net.Listen("tcp", target); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Signing blocks of %s on %s\n", miner.String(), target)
+	blockInterval := core.GenesisParams(neb.Genesis()).BlockInterval
+	server := dpos.NewSignerServer(neb.Config().Chain.ChainId, blockInterval, miner, signature)
+	return server.Serve(listener, tlsConfig)
+}
@@ -85,12 +85,31 @@ func (q *PriorityDeque) PopMin() interface{} {
 	return nil
 }
 
+// Delete the item in priority deque, return false if not found
+func (q *PriorityDeque) Delete(ele interface{}) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for pos, v := range q.heap {
+		if v == ele {
+			q.deleteAt(pos)
+			return true
+		}
+	}
+	return false
+}
+
 func (q *PriorityDeque) deleteAt(pos int) {
 	heap := q.heap
 	size := len(heap)
 	heap[pos] = heap[size-1]
 	q.heap = heap[0 : size-1]
-	q.trickleDown(pos)
+	if pos < len(q.heap) {
+		// the last item moved into the middle may be out of order with its ancestors,
+		// and the ancestor swapped into pos may be out of order with its descendants.
+		q.bubbleUp(pos)
+		q.trickleDown(pos)
+	}
 }
 
 func level(pos int) LvTy {
@@ -207,24 +226,17 @@ func (q *PriorityDeque) trickleDownMin(pos int) {
 	heap := q.heap
 	children := q.children([]int{pos})
 	if len(children) > 0 {
-		grandchild := q.children(children)
-		if len(grandchild) > 0 {
-			children = append(children, grandchild...)
-			opts := q.sort(children)
-			opt := opts[0]
-			if q.less(heap[opt], heap[pos]) {
-				q.swap(opt, pos)
-				if q.less(heap[parent(opt)], heap[pos]) {
+		opts := q.sort(append(children, q.children(children)...))
+		opt := opts[0]
+		if q.less(heap[opt], heap[pos]) {
+			q.swap(opt, pos)
+			// opt is a grandchild, keep the order with its max level parent.
+			if parent(opt) != pos {
+				if q.less(heap[parent(opt)], heap[opt]) {
 					q.swap(opt, parent(opt))
 				}
 				q.trickleDownMin(opt)
 			}
-		} else {
-			opts := q.sort(children)
-			opt := opts[0]
-			if q.less(heap[opt], heap[pos]) {
-				q.swap(opt, pos)
-			}
 		}
 	}
 }
@@ -233,24 +245,17 @@ func (q *PriorityDeque) trickleDownMax(pos int) {
 	heap := q.heap
 	children := q.children([]int{pos})
 	if len(children) > 0 {
-		grandchild := q.children(children)
-		if len(grandchild) > 0 {
-			children = append(children, grandchild...)
-			opts := q.sort(children)
-			opt := opts[len(opts)-1]
-			if q.less(heap[pos], heap[opt]) {
-				q.swap(opt, pos)
+		opts := q.sort(append(children, q.children(children)...))
+		opt := opts[len(opts)-1]
+		if q.less(heap[pos], heap[opt]) {
+			q.swap(opt, pos)
+			// opt is a grandchild, keep the order with its min level parent.
+			if parent(opt) != pos {
 				if q.less(heap[opt], heap[parent(opt)]) {
 					q.swap(opt, parent(opt))
 				}
 				q.trickleDownMax(opt)
 			}
-		} else {
-			opts := q.sort(children)
-			opt := opts[len(opts)-1]
-			if q.less(heap[pos], heap[opt]) {
-				q.swap(opt, pos)
-			}
 		}
 	}
 }
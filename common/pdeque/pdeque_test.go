@@ -66,3 +66,19 @@ func TestPdeq_2(t *testing.T) {
 	assert.Equal(t, q.PopMin(), 4)
 	assert.Equal(t, q.PopMin(), 5)
 }
+
+func TestPdeq_Delete(t *testing.T) {
+	q := NewPriorityDeque(func(a interface{}, b interface{}) bool { return a.(int) < b.(int) })
+	for _, v := range []int{10, 51, 30, 46, 31, 21, 71, 41, 11, 13, 16, 8} {
+		q.Insert(v)
+	}
+	assert.True(t, q.Delete(21))
+	assert.True(t, q.Delete(71))
+	assert.True(t, q.Delete(8))
+	assert.False(t, q.Delete(21))
+	assert.Equal(t, 9, q.Len())
+
+	for _, v := range []int{10, 11, 13, 16, 30, 31, 41, 46, 51} {
+		assert.Equal(t, v, q.PopMin())
+	}
+}
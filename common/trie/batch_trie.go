@@ -59,7 +59,9 @@ func (bt *BatchTrie) Clone() (*BatchTrie, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &BatchTrie{trie: tr, changelog: bt.changelog, batching: bt.batching}, nil
+	// limit the capacity, the changelogs appended by clones must not overwrite each other.
+	changelog := bt.changelog[:len(bt.changelog):len(bt.changelog)]
+	return &BatchTrie{trie: tr, changelog: changelog, batching: bt.batching}, nil
 }
 
 // Get the value to the key in BatchTrie
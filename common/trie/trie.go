@@ -455,6 +455,51 @@ func (t *Trie) Clone() (*Trie, error) {
 	return &Trie{t.rootHash, t.storage}, nil
 }
 
+// Walk visits the nodes reachable from root in depth-first order, visit is called with the
+// hash of each node and the value if it's a leaf node. The children of the node are skipped
+// if visit returns false.
+func (t *Trie) Walk(visit func(hash []byte, value []byte) bool) error {
+	if t.rootHash == nil {
+		return nil
+	}
+	return t.walk(t.rootHash, visit)
+}
+
+func (t *Trie) walk(hash []byte, visit func(hash []byte, value []byte) bool) error {
+	n, err := t.fetchNode(hash)
+	if err != nil {
+		return err
+	}
+	flag, err := n.Type()
+	if err != nil {
+		return err
+	}
+	switch flag {
+	case branch:
+		if !visit(hash, nil) {
+			return nil
+		}
+		for _, child := range n.Val {
+			if len(child) == 0 {
+				continue
+			}
+			if err := t.walk(child, visit); err != nil {
+				return err
+			}
+		}
+	case ext:
+		if !visit(hash, nil) {
+			return nil
+		}
+		return t.walk(n.Val[2], visit)
+	case leaf:
+		visit(hash, n.Val[2])
+	default:
+		return errors.New("unknown node type")
+	}
+	return nil
+}
+
 // prefixLen returns the length of the common prefix between a and b.
 func prefixLen(a, b []byte) int {
 	var i, length = 0, len(a)
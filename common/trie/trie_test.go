@@ -241,6 +241,56 @@ func TestTrie_Operation(t *testing.T) {
 	}
 }
 
+func TestTrie_Walk(t *testing.T) {
+	storage, _ := storage.NewMemoryStorage()
+	tr, _ := NewTrie(nil, storage)
+	if err := tr.Walk(func(hash []byte, value []byte) bool { return true }); err != nil {
+		t.Errorf("Trie.Walk() on empty trie error = %v", err)
+	}
+
+	values := make(map[string]bool)
+	for i := int64(0); i < 100; i++ {
+		value := byteutils.FromInt64(i)
+		tr.Put(hash.Sha3256(value), value)
+		values[byteutils.Hex(value)] = true
+	}
+
+	visited := make(map[string]bool)
+	leaves := 0
+	err := tr.Walk(func(h []byte, value []byte) bool {
+		if _, err := storage.Get(h); err != nil {
+			t.Errorf("Trie.Walk() visit %x not in storage", h)
+		}
+		visited[byteutils.Hex(h)] = true
+		if value != nil {
+			leaves++
+			if !values[byteutils.Hex(value)] {
+				t.Errorf("Trie.Walk() visit unknown value %x", value)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		t.Errorf("Trie.Walk() error = %v", err)
+	}
+	if leaves != len(values) {
+		t.Errorf("Trie.Walk() visit %d leaves, want %d", leaves, len(values))
+	}
+	if !visited[byteutils.Hex(tr.RootHash())] {
+		t.Errorf("Trie.Walk() root not visited")
+	}
+
+	// the children are skipped.
+	count := 0
+	tr.Walk(func(h []byte, value []byte) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Errorf("Trie.Walk() visit %d nodes, want 1", count)
+	}
+}
+
 func TestTrie_Stress(t *testing.T) {
 	COUNT := int64(10000)
 	storage, _ := storage.NewMemoryStorage()
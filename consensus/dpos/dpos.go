@@ -19,7 +19,9 @@
 package dpos
 
 import (
+	"crypto/tls"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
@@ -27,10 +29,12 @@ import (
 	"github.com/nebulasio/go-nebulas/account"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/consensus"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/net/p2p"
 
+	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
@@ -45,6 +49,7 @@ var (
 	ErrCannotMintWhenDiable   = errors.New("cannot mint block now, waiting for enable it again")
 	ErrWaitingBlockInLastSlot = errors.New("cannot mint block now, waiting for last block")
 	ErrBlockMintedInNextSlot  = errors.New("cannot mint block now, there is a block minted in current slot")
+	ErrInvalidBlockCoinbase   = errors.New("invalid block coinbase")
 )
 
 // Neblet interface breaks cycle import dependency and hides unused services.
@@ -59,6 +64,9 @@ type Neblet interface {
 type Dpos struct {
 	quitCh chan bool
 
+	// double mint evidences found by the block pool.
+	doubleMintCh chan *core.Event
+
 	chain *core.BlockChain
 	nm    p2p.Manager
 	am    *account.Manager
@@ -66,10 +74,21 @@ type Dpos struct {
 	coinbase *core.Address
 	miner    *core.Address
 
+	// validators the node mints blocks for, the miner only unless on the dev network
+	// where the node holds the keys of all the validators.
+	validators []*core.Address
+
+	// signs the minted blocks, the account manager or a remote signer.
+	signer BlockSigner
+	remote *remoteSigner
+
 	blockInterval   int64
 	dynastyInterval int64
 	txsPerBlock     int
 
+	// block gas limit voted by the miner.
+	targetGasLimit uint64
+
 	enable  bool
 	pending bool
 }
@@ -77,14 +96,15 @@ type Dpos struct {
 // NewDpos create Dpos instance.
 func NewDpos(neblet Neblet) (*Dpos, error) {
 	p := &Dpos{
-		quitCh: make(chan bool, 5),
+		quitCh:       make(chan bool, 5),
+		doubleMintCh: make(chan *core.Event, 16),
 
 		chain: neblet.BlockChain(),
 		nm:    neblet.NetManager(),
 		am:    neblet.AccountManager(),
 
-		blockInterval:   core.BlockInterval,
-		dynastyInterval: core.DynastyInterval,
+		blockInterval:   neblet.BlockChain().Params().BlockInterval,
+		dynastyInterval: neblet.BlockChain().Params().DynastyInterval,
 		txsPerBlock:     10000,
 
 		enable:  false,
@@ -92,14 +112,6 @@ func NewDpos(neblet Neblet) (*Dpos, error) {
 	}
 
 	config := neblet.Config().Chain
-	coinbase, err := core.AddressParse(config.Coinbase)
-	if err != nil {
-		logging.CLog().WithFields(logrus.Fields{
-			"address": config.Coinbase,
-			"err":     err,
-		}).Error("Failed to parse coinbase address.")
-		return nil, err
-	}
 	miner, err := core.AddressParse(config.Miner)
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
@@ -108,14 +120,68 @@ func NewDpos(neblet Neblet) (*Dpos, error) {
 		}).Error("Failed to parse miner address.")
 		return nil, err
 	}
+	// the rewards are paid to the miner if the coinbase is not specified.
+	coinbase := miner
+	if len(config.Coinbase) > 0 {
+		if coinbase, err = core.AddressParse(config.Coinbase); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": config.Coinbase,
+				"err":     err,
+			}).Error("Failed to parse coinbase address.")
+			return nil, err
+		}
+	}
+	if coinbase.Equals(miner) {
+		logging.CLog().WithFields(logrus.Fields{
+			"miner": miner.String(),
+		}).Warn("The block rewards are paid to the miner, use a coinbase apart from the signing key to keep the funds cold.")
+	}
 	p.coinbase = coinbase
 	p.miner = miner
+	p.validators = []*core.Address{miner}
+	if config.Dev {
+		dynasty, err := p.chain.GenesisBlock().Dynasty()
+		if err != nil {
+			return nil, err
+		}
+		p.validators = nil
+		for _, v := range dynasty {
+			validator, err := core.AddressParseFromBytes(v)
+			if err != nil {
+				return nil, err
+			}
+			p.validators = append(p.validators, validator)
+		}
+	}
+	p.signer = p.am
+	if len(config.RemoteSigner) > 0 {
+		var tlsConfig *tls.Config
+		if !strings.HasPrefix(config.RemoteSigner, UnixSignerPrefix) {
+			if tlsConfig, err = NewSignerTLSConfig(config.RemoteSignerCert, config.RemoteSignerKey, config.RemoteSignerCa); err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"signer": config.RemoteSigner,
+					"err":    err,
+				}).Error("Failed to load the tls config of remote signer.")
+				return nil, err
+			}
+		}
+		if p.remote, err = newRemoteSigner(config.RemoteSigner, tlsConfig); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"signer": config.RemoteSigner,
+				"err":    err,
+			}).Error("Failed to connect remote signer.")
+			return nil, err
+		}
+		p.signer = p.remote
+	}
+	p.targetGasLimit = config.TargetBlockGasLimit
 	return p, nil
 }
 
 // Start start pow service.
 func (p *Dpos) Start() {
 	logging.CLog().Info("Starting Dpos Mining...")
+	p.chain.EventEmitter().Register(core.TopicDoubleMint, p.doubleMintCh)
 	go p.blockLoop()
 }
 
@@ -123,13 +189,21 @@ func (p *Dpos) Start() {
 func (p *Dpos) Stop() {
 	logging.CLog().Info("Stopping Dpos Mining...")
 	p.DisableMining()
+	p.chain.EventEmitter().Deregister(core.TopicDoubleMint, p.doubleMintCh)
 	p.quitCh <- true
+	if p.remote != nil {
+		p.remote.Close()
+	}
 }
 
-// EnableMining start the consensus
+// EnableMining start the consensus, the passphrase is ignored if the blocks are signed remotely.
 func (p *Dpos) EnableMining(passphrase string) error {
-	if err := p.am.Unlock(p.miner, []byte(passphrase), keystore.YearUnlockDuration); err != nil {
-		return err
+	if p.remote == nil {
+		for _, v := range p.validators {
+			if err := p.am.Unlock(v, []byte(passphrase), keystore.YearUnlockDuration); err != nil {
+				return err
+			}
+		}
 	}
 	p.enable = true
 	logging.CLog().Info("Enabled Dpos Mining...")
@@ -138,8 +212,12 @@ func (p *Dpos) EnableMining(passphrase string) error {
 
 // DisableMining stop the consensus
 func (p *Dpos) DisableMining() error {
-	if err := p.am.Lock(p.miner); err != nil {
-		return err
+	if p.remote == nil {
+		for _, v := range p.validators {
+			if err := p.am.Lock(v); err != nil {
+				return err
+			}
+		}
 	}
 	p.enable = false
 	logging.CLog().Info("Disable Dpos Mining...")
@@ -247,9 +325,13 @@ func (p *Dpos) FastVerifyBlock(block *core.Block) error {
 	if elapsedSecond%p.blockInterval != 0 {
 		return ErrInvalidBlockInterval
 	}
+	// check coinbase, the rewards are paid to it instead of the miner signed the block.
+	if _, err := core.AddressParseFromBytes(block.CoinbaseHash()); err != nil {
+		return ErrInvalidBlockCoinbase
+	}
 	// check proposer
-	currentHour := block.Timestamp() / core.DynastyInterval
-	tailHour := tail.Timestamp() / core.DynastyInterval
+	currentHour := block.Timestamp() / p.dynastyInterval
+	tailHour := tail.Timestamp() / p.dynastyInterval
 	var dynastyRoot byteutils.Hash
 	if currentHour == tailHour {
 		dynastyRoot = tail.DposContext().DynastyRoot
@@ -262,7 +344,7 @@ func (p *Dpos) FastVerifyBlock(block *core.Block) error {
 	if err != nil {
 		return err
 	}
-	proposer, err := core.FindProposer(block.Timestamp(), dynasty)
+	proposer, err := core.FindProposer(p.chain.Params(), block.Timestamp(), dynasty)
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"proposer": proposer,
@@ -290,7 +372,7 @@ func (p *Dpos) VerifyBlock(block *core.Block, parent *core.Block) error {
 	if err != nil {
 		return err
 	}
-	proposer, err := core.FindProposer(block.Timestamp(), dynasty)
+	proposer, err := core.FindProposer(p.chain.Params(), block.Timestamp(), dynasty)
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"proposer": proposer,
@@ -322,6 +404,7 @@ func (p *Dpos) newBlock(tail *core.Block, context *core.DynastyContext, deadline
 		}).Error("Failed to create new block")
 		return nil, err
 	}
+	block.SetGasLimit(tail.NextGasLimit(p.targetGasLimit))
 	if err := block.LoadDynastyContext(context); err != nil {
 		logging.CLog().WithFields(logrus.Fields{
 			"block": block,
@@ -330,7 +413,8 @@ func (p *Dpos) newBlock(tail *core.Block, context *core.DynastyContext, deadline
 		return nil, err
 	}
 	block.CollectTransactions(deadline)
-	block.SetMiner(p.miner)
+	miner := p.validator(context.Proposer)
+	block.SetMiner(miner)
 	if err = block.Seal(); err != nil {
 		logging.CLog().WithFields(logrus.Fields{
 			"block": block,
@@ -338,9 +422,9 @@ func (p *Dpos) newBlock(tail *core.Block, context *core.DynastyContext, deadline
 		}).Error("Failed to seal new block")
 		return nil, err
 	}
-	if err = p.am.SignBlock(p.miner, block); err != nil {
+	if err = p.signer.SignBlock(miner, block); err != nil {
 		logging.CLog().WithFields(logrus.Fields{
-			"miner": p.miner.String(),
+			"miner": miner.String(),
 			"block": block,
 			"err":   err,
 		}).Error("Failed to sign new block")
@@ -350,41 +434,24 @@ func (p *Dpos) newBlock(tail *core.Block, context *core.DynastyContext, deadline
 	return block, nil
 }
 
-func lastSlot(now int64) int64 {
-	return int64((now-1)/core.BlockInterval) * core.BlockInterval
-}
-
-func nextSlot(now int64) int64 {
-	return int64((now+core.BlockInterval-1)/core.BlockInterval) * core.BlockInterval
-}
-
-func deadline(now int64) int64 {
-	nextSlot := nextSlot(now)
-	remain := nextSlot - now
-	if core.MaxMintDuration > remain {
-		return nextSlot
-	}
-	return now + core.MaxMintDuration
-}
-
 func (p *Dpos) checkDeadline(tail *core.Block, now int64) (int64, error) {
-	lastSlot := lastSlot(now)
-	nextSlot := nextSlot(now)
+	lastSlot := consensus.LastSlot(now, p.blockInterval)
+	nextSlot := consensus.NextSlot(now, p.blockInterval)
 
 	if tail.Timestamp() == nextSlot {
 		return 0, ErrBlockMintedInNextSlot
 	}
 	if tail.Timestamp() == lastSlot {
-		return deadline(now), nil
+		return consensus.Deadline(now, p.blockInterval), nil
 	}
 	if nextSlot-now <= core.MinMintDuration {
-		return deadline(now), nil
+		return consensus.Deadline(now, p.blockInterval), nil
 	}
 	return 0, ErrWaitingBlockInLastSlot
 }
 
 func (p *Dpos) checkProposer(tail *core.Block, now int64) (*core.DynastyContext, error) {
-	slot := nextSlot(now)
+	slot := consensus.NextSlot(now, p.blockInterval)
 	elapsed := slot - tail.Timestamp()
 	context, err := tail.NextDynastyContext(p.chain, elapsed)
 	if err != nil {
@@ -395,7 +462,7 @@ func (p *Dpos) checkProposer(tail *core.Block, now int64) (*core.DynastyContext,
 		}).Debug("Failed to generate next dynasty context.")
 		return nil, core.ErrGenerateNextDynastyContext
 	}
-	if context.Proposer == nil || !context.Proposer.Equals(p.miner.Bytes()) {
+	if context.Proposer == nil || p.validator(context.Proposer) == nil {
 		proposer := "nil"
 		if context.Proposer != nil {
 			proposer = string(context.Proposer.Hex())
@@ -424,6 +491,16 @@ func (p *Dpos) broadcast(tail *core.Block, block *core.Block) error {
 	return nil
 }
 
+// validator returns the validator of the node for the proposer, nil if the node doesn't mint for it.
+func (p *Dpos) validator(proposer byteutils.Hash) *core.Address {
+	for _, v := range p.validators {
+		if proposer.Equals(v.Bytes()) {
+			return v
+		}
+	}
+	return nil
+}
+
 func (p *Dpos) mintBlock(now int64) error {
 	// check mining enable
 	if !p.enable {
@@ -466,7 +543,7 @@ func (p *Dpos) mintBlock(now int64) error {
 		"deadline": deadline,
 	}).Info("All tx are packed.")
 
-	slot := nextSlot(now)
+	slot := consensus.NextSlot(now, p.blockInterval)
 	current := time.Now().Unix()
 	if slot > current {
 		timer := time.NewTimer(time.Duration(slot-current) * time.Second).C
@@ -493,9 +570,41 @@ func (p *Dpos) blockLoop() {
 		select {
 		case now := <-timeChan:
 			p.mintBlock(now.Unix())
+		case e := <-p.doubleMintCh:
+			p.reportDoubleMint(e.Data)
 		case <-p.quitCh:
 			logging.CLog().Info("Stopped Dpos Mining.")
 			return
 		}
 	}
 }
+
+// reportDoubleMint sends the slash tx with the evidences from the miner if mining is enabled,
+// the remote signer only signs blocks so the report is left to the other miners.
+func (p *Dpos) reportDoubleMint(payload string) error {
+	if !p.enable || p.remote != nil {
+		return nil
+	}
+	pool := p.chain.TransactionPool()
+	tx := core.NewTransaction(p.chain.ChainID(), p.miner, p.miner, util.NewUint128(), pool.NextNonce(p.miner), core.TxPayloadSlashType, []byte(payload), nil, nil)
+	gasLimit := util.NewUint128().Add(tx.GasCountOfTxBase().Int, core.SlashBaseGasCount.Int)
+	tx = core.NewTransaction(p.chain.ChainID(), p.miner, p.miner, util.NewUint128(), tx.Nonce(), core.TxPayloadSlashType, []byte(payload), nil, util.NewUint128FromBigInt(gasLimit))
+	if err := p.am.SignTransaction(p.miner, tx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tx":  tx,
+			"err": err,
+		}).Error("Failed to sign slash tx.")
+		return err
+	}
+	if err := pool.PushAndBroadcast(tx); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tx":  tx,
+			"err": err,
+		}).Error("Failed to send slash tx.")
+		return err
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"tx": tx,
+	}).Info("Reported double mint.")
+	return nil
+}
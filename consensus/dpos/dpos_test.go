@@ -191,6 +191,8 @@ func (n MockNetManager) SendMessageToPeer(messageName string, data []byte, prior
 
 func (n MockNetManager) ClosePeer(peerID string, reason error) {}
 
+func (n MockNetManager) ReportPeer(peerID string, misbehavior p2p.Misbehavior) {}
+
 func (n MockNetManager) BroadcastNetworkID([]byte) {}
 
 func (n MockNetManager) BuildRawMessageData([]byte, string) []byte { return nil }
@@ -203,7 +205,14 @@ func TestDpos_New(t *testing.T) {
 	neb.config.Chain.Coinbase += "0"
 	_, err = NewDpos(neb)
 	assert.NotNil(t, err)
+	neb.config.Chain.Coinbase = ""
+	dpos, err := NewDpos(neb)
+	assert.Nil(t, err)
+	assert.True(t, dpos.coinbase.Equals(dpos.miner))
 	neb.config.Chain.Coinbase = coinbase
+	dpos, err = NewDpos(neb)
+	assert.Nil(t, err)
+	assert.Equal(t, coinbase, dpos.coinbase.String())
 	neb.config.Chain.Miner += "0"
 	_, err = NewDpos(neb)
 	assert.NotNil(t, err)
@@ -215,7 +224,7 @@ func TestDpos_VerifySign(t *testing.T) {
 	dpos.chain.SetConsensusHandler(dpos)
 	tail := dpos.chain.TailBlock()
 
-	elapsedSecond := int64(core.DynastySize*core.BlockInterval + core.DynastyInterval)
+	elapsedSecond := int64(core.DefaultDynastySize)*core.DefaultBlockInterval + core.DefaultDynastyInterval
 	context, err := tail.NextDynastyContext(dpos.chain, elapsedSecond)
 	assert.Nil(t, err)
 	coinbase, err := core.AddressParse("1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c")
@@ -261,7 +270,7 @@ func TestForkChoice(t *testing.T) {
 
 	addr0 := GetUnlockAddress(t, am, "2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8")
 	block0, _ := dpos.chain.NewBlock(addr0)
-	block0.SetTimestamp(core.BlockInterval)
+	block0.SetTimestamp(core.DefaultBlockInterval)
 	block0.SetMiner(addr0)
 	block0.Seal()
 	am.SignBlock(addr0, block0)
@@ -271,14 +280,14 @@ func TestForkChoice(t *testing.T) {
 	addr1 := GetUnlockAddress(t, am, "333cb3ed8c417971845382ede3cf67a0a96270c05fe2f700")
 	block11, err := dpos.chain.NewBlock(addr1)
 	assert.Nil(t, err)
-	block11.SetTimestamp(core.BlockInterval * 2)
+	block11.SetTimestamp(core.DefaultBlockInterval * 2)
 	block11.SetMiner(addr1)
 	block11.Seal()
 	am.SignBlock(addr1, block11)
 	assert.Nil(t, dpos.chain.BlockPool().Push(block11))
 
 	block12, _ := dpos.chain.NewBlock(addr1)
-	block12.SetTimestamp(core.BlockInterval * 2)
+	block12.SetTimestamp(core.DefaultBlockInterval * 2)
 	block12.SetMiner(addr1)
 	block12.Seal()
 	am.SignBlock(addr1, block12)
@@ -289,7 +298,7 @@ func TestForkChoice(t *testing.T) {
 
 	addr2 := GetUnlockAddress(t, am, "48f981ed38910f1232c1bab124f650c482a57271632db9e3")
 	block111, _ := dpos.chain.NewBlockFromParent(addr2, block11)
-	block111.SetTimestamp(core.BlockInterval * 3)
+	block111.SetTimestamp(core.DefaultBlockInterval * 3)
 	block111.SetMiner(addr2)
 	block111.Seal()
 	am.SignBlock(addr2, block111)
@@ -297,7 +306,7 @@ func TestForkChoice(t *testing.T) {
 
 	addr3 := GetUnlockAddress(t, am, "59fc526072b09af8a8ca9732dae17132c4e9127e43cf2232")
 	block1111, _ := dpos.chain.NewBlockFromParent(addr3, block111)
-	block1111.SetTimestamp(core.BlockInterval * 4)
+	block1111.SetTimestamp(core.DefaultBlockInterval * 4)
 	block1111.SetMiner(addr3)
 	block1111.Seal()
 	am.SignBlock(addr3, block1111)
@@ -329,7 +338,7 @@ func TestFastVerifyBlock(t *testing.T) {
 	manager := account.NewManager(nil)
 	assert.Nil(t, dpos.EnableMining("passphrase"))
 
-	elapsedSecond := int64(core.DynastyInterval)
+	elapsedSecond := int64(core.DefaultDynastyInterval)
 	context, err := tail.NextDynastyContext(dpos.chain, elapsedSecond)
 	assert.Nil(t, err)
 	block, err := core.NewBlock(dpos.chain.ChainID(), coinbase, tail)
@@ -341,7 +350,7 @@ func TestFastVerifyBlock(t *testing.T) {
 	assert.Nil(t, manager.SignBlock(coinbase, block))
 	assert.Nil(t, dpos.FastVerifyBlock(block))
 
-	elapsedSecond = int64(core.DynastyInterval)
+	elapsedSecond = int64(core.DefaultDynastyInterval)
 	context, err = tail.NextDynastyContext(dpos.chain, elapsedSecond)
 	block, err = core.NewBlock(dpos.chain.ChainID(), coinbase, tail)
 	assert.Nil(t, err)
@@ -351,7 +360,7 @@ func TestFastVerifyBlock(t *testing.T) {
 	assert.Nil(t, manager.SignBlock(coinbase, block))
 	assert.Nil(t, dpos.FastVerifyBlock(block))
 
-	elapsedSecond = int64(core.DynastySize*core.BlockInterval + core.DynastyInterval)
+	elapsedSecond = int64(core.DefaultDynastySize)*core.DefaultBlockInterval + core.DefaultDynastyInterval
 	context, err = tail.NextDynastyContext(dpos.chain, elapsedSecond)
 	block, err = core.NewBlock(dpos.chain.ChainID(), coinbase, tail)
 	assert.Nil(t, err)
@@ -374,9 +383,39 @@ func TestDpos_MintBlock(t *testing.T) {
 	assert.Equal(t, dpos.mintBlock(0), ErrCannotMintWhenPending)
 
 	dpos.ResumeMining()
-	assert.Equal(t, dpos.mintBlock(core.BlockInterval), ErrInvalidBlockProposer)
+	assert.Equal(t, dpos.mintBlock(core.DefaultBlockInterval), ErrInvalidBlockProposer)
 
 	received = []byte{}
-	assert.Equal(t, dpos.mintBlock(core.DynastyInterval), nil)
+	assert.Equal(t, dpos.mintBlock(core.DefaultDynastyInterval), nil)
 	assert.NotEqual(t, received, []byte{})
 }
+
+func TestDpos_DevValidators(t *testing.T) {
+	neb := mockNeb(t)
+	neb.config.Chain.Dev = true
+	dpos, err := NewDpos(neb)
+	assert.Nil(t, err)
+	dynasty, err := dpos.chain.GenesisBlock().Dynasty()
+	assert.Nil(t, err)
+	assert.Equal(t, len(dynasty), len(dpos.validators))
+	assert.Nil(t, dpos.EnableMining("passphrase"))
+
+	// the node mints for every validator on the dev network.
+	tail := dpos.chain.TailBlock()
+	var now int64
+	for i := int64(1); i <= int64(core.DefaultDynastySize); i++ {
+		now = tail.Timestamp() + core.DefaultDynastyInterval + i*core.DefaultBlockInterval
+		context, err := tail.NextDynastyContext(dpos.chain, now-tail.Timestamp())
+		assert.Nil(t, err)
+		if context.Proposer != nil && !context.Proposer.Equals(dpos.miner.Bytes()) {
+			break
+		}
+	}
+	context, err := dpos.checkProposer(tail, now)
+	assert.Nil(t, err)
+	assert.False(t, context.Proposer.Equals(dpos.miner.Bytes()))
+	block, err := dpos.newBlock(tail, context, now)
+	assert.Nil(t, err)
+	assert.Nil(t, dpos.VerifyBlock(block, tail))
+	assert.Nil(t, dpos.DisableMining())
+}
@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: signer.proto
+
+/*
+Package dpospb is a generated protocol buffer package.
+
+It is generated from these files:
+	signer.proto
+
+It has these top-level messages:
+	SignBlockRequest
+	SignBlockResponse
+*/
+package dpospb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type SignBlockRequest struct {
+	// Miner of the block.
+	Miner string `protobuf:"bytes,1,opt,name=miner,proto3" json:"miner,omitempty"`
+	// Encoded block header.
+	Header []byte `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	// Hashes of the txs in block.
+	TxHashes [][]byte `protobuf:"bytes,3,rep,name=tx_hashes,json=txHashes" json:"tx_hashes,omitempty"`
+}
+
+func (m *SignBlockRequest) Reset()                    { *m = SignBlockRequest{} }
+func (m *SignBlockRequest) String() string            { return proto.CompactTextString(m) }
+func (*SignBlockRequest) ProtoMessage()               {}
+func (*SignBlockRequest) Descriptor() ([]byte, []int) { return fileDescriptorSigner, []int{0} }
+
+func (m *SignBlockRequest) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *SignBlockRequest) GetHeader() []byte {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SignBlockRequest) GetTxHashes() [][]byte {
+	if m != nil {
+		return m.TxHashes
+	}
+	return nil
+}
+
+type SignBlockResponse struct {
+	// Signature algorithm.
+	Alg uint32 `protobuf:"varint,1,opt,name=alg,proto3" json:"alg,omitempty"`
+	// Signature of the block hash.
+	Sign []byte `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (m *SignBlockResponse) Reset()                    { *m = SignBlockResponse{} }
+func (m *SignBlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignBlockResponse) ProtoMessage()               {}
+func (*SignBlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorSigner, []int{1} }
+
+func (m *SignBlockResponse) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *SignBlockResponse) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SignBlockRequest)(nil), "dpospb.SignBlockRequest")
+	proto.RegisterType((*SignBlockResponse)(nil), "dpospb.SignBlockResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for BlockSigner service
+
+type BlockSignerClient interface {
+	// Sign the block hash recomputed from the header.
+	SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignBlockResponse, error)
+}
+
+type blockSignerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBlockSignerClient(cc *grpc.ClientConn) BlockSignerClient {
+	return &blockSignerClient{cc}
+}
+
+func (c *blockSignerClient) SignBlock(ctx context.Context, in *SignBlockRequest, opts ...grpc.CallOption) (*SignBlockResponse, error) {
+	out := new(SignBlockResponse)
+	err := grpc.Invoke(ctx, "/dpospb.BlockSigner/SignBlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for BlockSigner service
+
+type BlockSignerServer interface {
+	// Sign the block hash recomputed from the header.
+	SignBlock(context.Context, *SignBlockRequest) (*SignBlockResponse, error)
+}
+
+func RegisterBlockSignerServer(s *grpc.Server, srv BlockSignerServer) {
+	s.RegisterService(&_BlockSigner_serviceDesc, srv)
+}
+
+func _BlockSigner_SignBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockSignerServer).SignBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dpospb.BlockSigner/SignBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockSignerServer).SignBlock(ctx, req.(*SignBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BlockSigner_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dpospb.BlockSigner",
+	HandlerType: (*BlockSignerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SignBlock",
+			Handler:    _BlockSigner_SignBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "signer.proto",
+}
+
+func init() { proto.RegisterFile("signer.proto", fileDescriptorSigner) }
+
+var fileDescriptorSigner = []byte{
+	// 198 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0xce, 0x4c, 0xcf,
+	0x4b, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4b, 0x29, 0xc8, 0x2f, 0x2e, 0x48,
+	0x52, 0x8a, 0xe5, 0x12, 0x08, 0xce, 0x4c, 0xcf, 0x73, 0xca, 0xc9, 0x4f, 0xce, 0x0e, 0x4a, 0x2d,
+	0x2c, 0x4d, 0x2d, 0x2e, 0x11, 0x12, 0xe1, 0x62, 0xcd, 0xcd, 0xcc, 0x4b, 0x2d, 0x92, 0x60, 0x54,
+	0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x70, 0x84, 0xc4, 0xb8, 0xd8, 0x32, 0x52, 0x13, 0x53, 0x52, 0x8b,
+	0x24, 0x98, 0x14, 0x18, 0x35, 0x78, 0x82, 0xa0, 0x3c, 0x21, 0x69, 0x2e, 0xce, 0x92, 0x8a, 0xf8,
+	0x8c, 0xc4, 0xe2, 0x8c, 0xd4, 0x62, 0x09, 0x66, 0x05, 0x66, 0x0d, 0x9e, 0x20, 0x8e, 0x92, 0x0a,
+	0x0f, 0x30, 0x5f, 0xc9, 0x92, 0x4b, 0x10, 0xc9, 0xf8, 0xe2, 0x82, 0xfc, 0xbc, 0xe2, 0x54, 0x21,
+	0x01, 0x2e, 0xe6, 0xc4, 0x9c, 0x74, 0xb0, 0xe9, 0xbc, 0x41, 0x20, 0xa6, 0x90, 0x10, 0x17, 0x0b,
+	0xc8, 0x75, 0x50, 0x93, 0xc1, 0x6c, 0xa3, 0x40, 0x2e, 0x6e, 0xb0, 0xb6, 0x60, 0xb0, 0xb3, 0x85,
+	0x9c, 0xb8, 0x38, 0xe1, 0x26, 0x09, 0x49, 0xe8, 0x41, 0x9c, 0xaf, 0x87, 0xee, 0x76, 0x29, 0x49,
+	0x2c, 0x32, 0x10, 0x6b, 0x95, 0x18, 0x92, 0xd8, 0xc0, 0x7e, 0x37, 0x06, 0x0c, 0x00, 0xef, 0x5d,
+	0x61, 0x5f, 0x0b, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/consensus/dpos/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// UnixSignerPrefix is the scheme of the remote signer listening on a unix socket.
+const UnixSignerPrefix = "unix://"
+
+// RemoteSignTimeout is the timeout of a block signing request to the remote signer.
+const RemoteSignTimeout = time.Second
+
+// Errors in block signer
+var (
+	ErrInvalidRemoteSignature = errors.New("the block signed by remote signer doesn't belong to the miner")
+	ErrSignerMinerMismatch    = errors.New("the block miner is not served by the signer")
+	ErrSignerChainMismatch    = errors.New("the block chain id is not served by the signer")
+	ErrSignerConflictingSlot  = errors.New("refuse to sign a block conflicting with the signed ones")
+	ErrSignerFutureSlot       = errors.New("refuse to sign a block in a future slot")
+	ErrSignerTLSRequired      = errors.New("the remote signer on tcp requires the cert, key and ca of mutual tls")
+	ErrInvalidSignerCA        = errors.New("no certificate found in the remote signer ca")
+)
+
+// BlockSigner signs the blocks minted by the miner.
+type BlockSigner interface {
+	SignBlock(miner *core.Address, block *core.Block) error
+}
+
+// remoteSigner delegates the block signing to a remote process holding the miner key.
+type remoteSigner struct {
+	conn   *grpc.ClientConn
+	client dpospb.BlockSignerClient
+}
+
+// NewSignerTLSConfig returns the mutual tls config between the signer and the neblets on tcp,
+// both sides present the certificate and verify the peer's against the ca.
+func NewSignerTLSConfig(certFile, keyFile, caFile string) (*tls.Config, error) {
+	if len(certFile) == 0 || len(keyFile) == 0 || len(caFile) == 0 {
+		return nil, ErrSignerTLSRequired
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, ErrInvalidSignerCA
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// newRemoteSigner dials the signer at target, either "host:port" or "unix:///path/to/socket".
+// The signer on tcp is dialed with the mutual tls config, which is required.
+func newRemoteSigner(target string, tlsConfig *tls.Config) (*remoteSigner, error) {
+	var opts []grpc.DialOption
+	if strings.HasPrefix(target, UnixSignerPrefix) {
+		path := strings.TrimPrefix(target, UnixSignerPrefix)
+		opts = append(opts, grpc.WithInsecure(), grpc.WithDialer(func(_ string, timeout time.Duration) (net.Conn, error) {
+			return net.DialTimeout("unix", path, timeout)
+		}))
+	} else if tlsConfig != nil {
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		return nil, ErrSignerTLSRequired
+	}
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteSigner{conn: conn, client: dpospb.NewBlockSignerClient(conn)}, nil
+}
+
+// SignBlock sends the header to the remote signer, the returned signature is verified
+// against the miner before the block is broadcasted.
+func (s *remoteSigner) SignBlock(miner *core.Address, block *core.Block) error {
+	header, txHashes, err := block.EncodeHeader()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), RemoteSignTimeout)
+	defer cancel()
+	resp, err := s.client.SignBlock(ctx, &dpospb.SignBlockRequest{
+		Miner:    miner.String(),
+		Header:   header,
+		TxHashes: txHashes,
+	})
+	if err != nil {
+		return err
+	}
+
+	block.SetSignature(keystore.Algorithm(resp.Alg), resp.Sign)
+	signer, err := core.RecoverMiner(block)
+	if err != nil {
+		return err
+	}
+	if !signer.Equals(miner) {
+		return ErrInvalidRemoteSignature
+	}
+	return nil
+}
+
+// Close the connection to the remote signer.
+func (s *remoteSigner) Close() error {
+	return s.conn.Close()
+}
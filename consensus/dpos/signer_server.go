@@ -0,0 +1,130 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"crypto/tls"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/consensus/dpos/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// SignerServer signs the blocks of a miner for the remote neblets.
+// The approval policy never signs two different blocks in a slot or a block
+// in a slot before the signed one, so a compromised neblet can't make the miner slashed.
+// The blocks in slots later than the next one are refused too, or a single signed block
+// far in the future would stop the miner from signing until then.
+type SignerServer struct {
+	mu sync.Mutex
+
+	chainID       uint32
+	blockInterval int64
+	miner         *core.Address
+	signature     keystore.Signature
+
+	// now returns the current unix time, replaced in tests.
+	now func() int64
+
+	// the slot and hash of the last signed block.
+	lastSlot int64
+	lastHash byteutils.Hash
+}
+
+// NewSignerServer create a signer for the miner, the signature is initialized with the miner key.
+// The blocks in slots before the startup are refused, they might have been signed before a restart.
+func NewSignerServer(chainID uint32, blockInterval int64, miner *core.Address, signature keystore.Signature) *SignerServer {
+	return &SignerServer{
+		chainID:       chainID,
+		blockInterval: blockInterval,
+		miner:         miner,
+		signature:     signature,
+		now:           func() int64 { return time.Now().Unix() },
+		lastSlot:      time.Now().Unix(),
+	}
+}
+
+// SignBlock signs the block hash recomputed from the header if approved by the policy.
+func (s *SignerServer) SignBlock(ctx context.Context, req *dpospb.SignBlockRequest) (*dpospb.SignBlockResponse, error) {
+	if req.Miner != s.miner.String() {
+		return nil, ErrSignerMinerMismatch
+	}
+	block, err := core.DecodeHeader(req.Header, req.TxHashes)
+	if err != nil {
+		return nil, err
+	}
+	if block.ChainID() != s.chainID {
+		return nil, ErrSignerChainMismatch
+	}
+
+	if block.Timestamp() > s.now()+s.blockInterval {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+		}).Warn("Refused to sign a block in a future slot.")
+		return nil, ErrSignerFutureSlot
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if block.Timestamp() < s.lastSlot || (block.Timestamp() == s.lastSlot && !block.Hash().Equals(s.lastHash)) {
+		logging.VLog().WithFields(logrus.Fields{
+			"block":    block,
+			"lastSlot": s.lastSlot,
+			"lastHash": s.lastHash.String(),
+		}).Warn("Refused to sign a conflicting block.")
+		return nil, ErrSignerConflictingSlot
+	}
+	// the last slot is advanced only once the block is approved and signed.
+	sign, err := s.signature.Sign(block.Hash())
+	if err != nil {
+		return nil, err
+	}
+	s.lastSlot = block.Timestamp()
+	s.lastHash = block.Hash()
+
+	logging.VLog().WithFields(logrus.Fields{
+		"block": block,
+	}).Info("Signed block.")
+	return &dpospb.SignBlockResponse{Alg: uint32(s.signature.Algorithm()), Sign: sign}, nil
+}
+
+// Serve the signing requests on the listener, it blocks until the listener is closed.
+// The listener on tcp requires the mutual tls config, only the unix socket is served without it.
+func (s *SignerServer) Serve(listener net.Listener, tlsConfig *tls.Config) error {
+	var opts []grpc.ServerOption
+	if listener.Addr().Network() != "unix" {
+		if tlsConfig == nil {
+			return ErrSignerTLSRequired
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	server := grpc.NewServer(opts...)
+	dpospb.RegisterBlockSignerServer(server, s)
+	return server.Serve(listener)
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package dpos
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus/dpos/pb"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	dpos, err := NewDpos(mockNeb(t))
+	assert.Nil(t, err)
+	dpos.chain.SetConsensusHandler(dpos)
+	tail := dpos.chain.TailBlock()
+
+	elapsedSecond := int64(core.DefaultDynastySize)*core.DefaultBlockInterval + core.DefaultDynastyInterval
+	context0, err := tail.NextDynastyContext(dpos.chain, elapsedSecond)
+	assert.Nil(t, err)
+	miner, err := core.AddressParseFromBytes(context0.Proposer)
+	assert.Nil(t, err)
+	newBlock := func(context *core.DynastyContext, coinbase string) *core.Block {
+		addr, err := core.AddressParse(coinbase)
+		assert.Nil(t, err)
+		block, err := core.NewBlock(dpos.chain.ChainID(), addr, tail)
+		assert.Nil(t, err)
+		assert.Nil(t, block.LoadDynastyContext(context))
+		block.SetMiner(miner)
+		assert.Nil(t, block.Seal())
+		return block
+	}
+
+	manager := account.NewManager(nil)
+	assert.Nil(t, manager.Unlock(miner, []byte("passphrase"), keystore.DefaultUnlockDuration))
+	key, err := keystore.DefaultKS.GetUnlocked(miner.String())
+	assert.Nil(t, err)
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+	server := NewSignerServer(dpos.chain.ChainID(), core.DefaultBlockInterval, miner, signature)
+	// the blocks in test are minted right after genesis.
+	server.lastSlot = 0
+
+	dir, err := ioutil.TempDir("", "signer")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "signer.ipc")
+	listener, err := net.Listen("unix", path)
+	assert.Nil(t, err)
+	defer listener.Close()
+	go server.Serve(listener, nil)
+
+	signer, err := newRemoteSigner(UnixSignerPrefix+path, nil)
+	assert.Nil(t, err)
+	defer signer.Close()
+
+	block := newBlock(context0, "1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c")
+	assert.Nil(t, signer.SignBlock(miner, block))
+	assert.Nil(t, dpos.VerifyBlock(block, tail))
+	// the same block can be signed again.
+	assert.Nil(t, signer.SignBlock(miner, block))
+
+	request := func(miner *core.Address, block *core.Block) error {
+		header, txHashes, err := block.EncodeHeader()
+		assert.Nil(t, err)
+		_, err = server.SignBlock(context.Background(), &dpospb.SignBlockRequest{
+			Miner:    miner.String(),
+			Header:   header,
+			TxHashes: txHashes,
+		})
+		return err
+	}
+	other := newBlock(context0, "2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8")
+	assert.Equal(t, ErrSignerConflictingSlot, request(miner, other))
+	coinbase, err := core.AddressParse("1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c")
+	assert.Nil(t, err)
+	assert.Equal(t, ErrSignerMinerMismatch, request(coinbase, other))
+
+	context1, err := tail.NextDynastyContext(dpos.chain, elapsedSecond+core.DefaultBlockInterval)
+	assert.Nil(t, err)
+	later := newBlock(context1, "2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8")
+	// the block after the next slot is refused, and the refused one doesn't advance the last slot.
+	now := server.now
+	server.now = func() int64 { return block.Timestamp() - 1 }
+	assert.Equal(t, ErrSignerFutureSlot, request(miner, later))
+	assert.Nil(t, request(miner, block))
+	server.now = now
+
+	assert.Nil(t, request(miner, later))
+	// the earlier slot is refused once a later one is signed.
+	assert.Equal(t, ErrSignerConflictingSlot, request(miner, block))
+}
+
+func TestRemoteSignerTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer listener.Close()
+
+	// the signer on tcp is refused without mutual tls on both sides.
+	_, err = newRemoteSigner(listener.Addr().String(), nil)
+	assert.Equal(t, ErrSignerTLSRequired, err)
+	assert.Equal(t, ErrSignerTLSRequired, NewSignerServer(0, core.DefaultBlockInterval, nil, nil).Serve(listener, nil))
+	_, err = NewSignerTLSConfig("", "", "")
+	assert.Equal(t, ErrSignerTLSRequired, err)
+
+	dir, err := ioutil.TempDir("", "signer")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	certFile, keyFile := writeSignerCert(t, dir)
+	tlsConfig, err := NewSignerTLSConfig(certFile, keyFile, certFile)
+	assert.Nil(t, err)
+	_, err = NewSignerTLSConfig(certFile, keyFile, keyFile)
+	assert.Equal(t, ErrInvalidSignerCA, err)
+
+	signer, err := newRemoteSigner(listener.Addr().String(), tlsConfig)
+	assert.Nil(t, err)
+	signer.Close()
+}
+
+// writeSignerCert writes a self-signed cert of 127.0.0.1 for both the signer and the neblets,
+// it is the ca as well.
+func writeSignerCert(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "signer"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+
+	certFile, keyFile := filepath.Join(dir, "signer.crt"), filepath.Join(dir, "signer.key")
+	assert.Nil(t, ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.Nil(t, ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return certFile, keyFile
+}
@@ -0,0 +1,358 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package poa
+
+import (
+	"errors"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Errors in PoA Consensus
+var (
+	ErrMissingSignersForPoa   = errors.New("missing poa signers in genesis")
+	ErrInvalidBlockInterval   = errors.New("invalid block interval")
+	ErrInvalidBlockSigner     = errors.New("invalid block signer")
+	ErrCannotMintWhenPending  = errors.New("cannot mint block now, waiting for cancel pending again")
+	ErrCannotMintWhenDiable   = errors.New("cannot mint block now, waiting for enable it again")
+	ErrWaitingBlockInLastSlot = errors.New("cannot mint block now, waiting for last block")
+	ErrBlockMintedInNextSlot  = errors.New("cannot mint block now, there is a block minted in current slot")
+	ErrNotMyTurn              = errors.New("cannot mint block now, the slot belongs to another signer")
+	ErrBlockInFutureSlot      = errors.New("block is minted in a future slot")
+)
+
+// Neblet interface breaks cycle import dependency and hides unused services.
+type Neblet interface {
+	Config() *nebletpb.Config
+	Genesis() *corepb.Genesis
+	BlockChain() *core.BlockChain
+	AccountManager() *account.Manager
+}
+
+// Poa Proof-of-Authority, the signers in genesis mint the slots in turn.
+// The chain state still keeps the dpos dynasty, it is not used to choose the proposers.
+type Poa struct {
+	quitCh chan bool
+
+	chain *core.BlockChain
+	am    *account.Manager
+
+	signers  []*core.Address
+	coinbase *core.Address
+	miner    *core.Address
+
+	// block gas limit voted by the miner.
+	targetGasLimit uint64
+
+	enable  bool
+	pending bool
+}
+
+// NewPoa create Poa instance.
+func NewPoa(neblet Neblet) (*Poa, error) {
+	p := &Poa{
+		quitCh: make(chan bool, 5),
+
+		chain: neblet.BlockChain(),
+		am:    neblet.AccountManager(),
+
+		enable:  false,
+		pending: true,
+	}
+
+	genesis := neblet.Genesis()
+	if genesis.Consensus == nil || genesis.Consensus.Poa == nil || len(genesis.Consensus.Poa.Signers) == 0 {
+		return nil, ErrMissingSignersForPoa
+	}
+	for _, v := range genesis.Consensus.Poa.Signers {
+		signer, err := core.AddressParse(v)
+		if err != nil {
+			return nil, err
+		}
+		p.signers = append(p.signers, signer)
+	}
+
+	config := neblet.Config().Chain
+	miner, err := core.AddressParse(config.Miner)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"address": config.Miner,
+			"err":     err,
+		}).Error("Failed to parse miner address.")
+		return nil, err
+	}
+	// the rewards are paid to the miner if the coinbase is not specified.
+	coinbase := miner
+	if len(config.Coinbase) > 0 {
+		if coinbase, err = core.AddressParse(config.Coinbase); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address": config.Coinbase,
+				"err":     err,
+			}).Error("Failed to parse coinbase address.")
+			return nil, err
+		}
+	}
+	p.coinbase = coinbase
+	p.miner = miner
+	p.targetGasLimit = config.TargetBlockGasLimit
+	return p, nil
+}
+
+// Start start poa service.
+func (p *Poa) Start() {
+	logging.CLog().Info("Starting PoA Mining...")
+	go p.blockLoop()
+}
+
+// Stop stop poa service.
+func (p *Poa) Stop() {
+	logging.CLog().Info("Stopping PoA Mining...")
+	p.DisableMining()
+	p.quitCh <- true
+}
+
+// EnableMining start the consensus
+func (p *Poa) EnableMining(passphrase string) error {
+	if err := p.am.Unlock(p.miner, []byte(passphrase), keystore.YearUnlockDuration); err != nil {
+		return err
+	}
+	p.enable = true
+	logging.CLog().Info("Enabled PoA Mining...")
+	return nil
+}
+
+// DisableMining stop the consensus
+func (p *Poa) DisableMining() error {
+	if err := p.am.Lock(p.miner); err != nil {
+		return err
+	}
+	p.enable = false
+	logging.CLog().Info("Disable PoA Mining...")
+	return nil
+}
+
+// Enable returns is mining
+func (p *Poa) Enable() bool {
+	return p.enable
+}
+
+// Pending return if consensus can do mining now
+func (p *Poa) Pending() bool {
+	return p.pending
+}
+
+// SuspendMining pend poa mining
+func (p *Poa) SuspendMining() {
+	logging.CLog().Info("Suspended PoA Mining.")
+	p.pending = true
+}
+
+// ResumeMining continue poa mining
+func (p *Poa) ResumeMining() {
+	logging.CLog().Info("Resumed PoA Mining.")
+	p.pending = false
+}
+
+// signer returns the signer of the slot, the signers mint the slots round-robin.
+func (p *Poa) signer(slot int64) *core.Address {
+	return p.signers[(slot/p.chain.Params().BlockInterval)%int64(len(p.signers))]
+}
+
+// ForkChoice select the highest tail, the greater hash wins at the same height as dpos does.
+func (p *Poa) ForkChoice() error {
+	bc := p.chain
+	tailBlock := bc.TailBlock()
+
+	newTailBlock := tailBlock
+	for _, v := range bc.DetachedTailBlocks() {
+		if v.Height() > newTailBlock.Height() ||
+			(v.Height() == newTailBlock.Height() && byteutils.Less(newTailBlock.Hash(), v.Hash())) {
+			newTailBlock = v
+		}
+	}
+
+	if newTailBlock.Hash().Equals(tailBlock.Hash()) {
+		return nil
+	}
+	if err := bc.SetTailBlock(newTailBlock); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"new tail": newTailBlock,
+			"old tail": tailBlock,
+			"err":      err,
+		}).Error("Failed to set new tail block.")
+		return err
+	}
+
+	logging.VLog().WithFields(logrus.Fields{
+		"new tail": newTailBlock,
+		"old tail": tailBlock,
+	}).Info("change to new tail.")
+	return nil
+}
+
+// FastVerifyBlock verify the block is signed by the signer of its slot.
+func (p *Poa) FastVerifyBlock(block *core.Block) error {
+	interval := p.chain.Params().BlockInterval
+	if block.Timestamp()%interval != 0 {
+		return ErrInvalidBlockInterval
+	}
+	if block.Timestamp() > consensus.NextSlot(time.Now().Unix(), interval) {
+		return ErrBlockInFutureSlot
+	}
+	signer := p.signer(block.Timestamp())
+	addr, err := core.RecoverMiner(block)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":   err,
+			"block": block,
+		}).Debug("Failed to recover block's signer.")
+		return err
+	}
+	if !signer.Equals(addr) {
+		logging.VLog().WithFields(logrus.Fields{
+			"address": addr.String(),
+			"signer":  signer.String(),
+			"block":   block,
+		}).Debug("Failed to verify block's sign.")
+		return ErrInvalidBlockSigner
+	}
+	block.SetMiner(signer)
+	return nil
+}
+
+// VerifyBlock verify the block with its parent found
+func (p *Poa) VerifyBlock(block *core.Block, parent *core.Block) error {
+	if block.Timestamp() <= parent.Timestamp() {
+		return ErrInvalidBlockInterval
+	}
+	return p.FastVerifyBlock(block)
+}
+
+func (p *Poa) checkDeadline(tail *core.Block, now int64) (int64, error) {
+	interval := p.chain.Params().BlockInterval
+	lastSlot := consensus.LastSlot(now, interval)
+	nextSlot := consensus.NextSlot(now, interval)
+
+	if tail.Timestamp() >= nextSlot {
+		return 0, ErrBlockMintedInNextSlot
+	}
+	if tail.Timestamp() == lastSlot {
+		return consensus.Deadline(now, interval), nil
+	}
+	if nextSlot-now <= core.MinMintDuration {
+		return consensus.Deadline(now, interval), nil
+	}
+	return 0, ErrWaitingBlockInLastSlot
+}
+
+func (p *Poa) newBlock(tail *core.Block, slot int64, deadline int64) (*core.Block, error) {
+	block, err := core.NewBlock(p.chain.ChainID(), p.coinbase, tail)
+	if err != nil {
+		return nil, err
+	}
+	block.SetGasLimit(tail.NextGasLimit(p.targetGasLimit))
+	// the dynasty context is kept by the chain state though the proposer is not used.
+	context, err := tail.NextDynastyContext(p.chain, slot-tail.Timestamp())
+	if err != nil {
+		return nil, err
+	}
+	if err := block.LoadDynastyContext(context); err != nil {
+		return nil, err
+	}
+	block.CollectTransactions(deadline)
+	block.SetMiner(p.miner)
+	if err := block.Seal(); err != nil {
+		return nil, err
+	}
+	if err := p.am.SignBlock(p.miner, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (p *Poa) mintBlock(now int64) error {
+	if !p.enable {
+		return ErrCannotMintWhenDiable
+	}
+	if p.pending {
+		return ErrCannotMintWhenPending
+	}
+
+	tail := p.chain.TailBlock()
+	deadline, err := p.checkDeadline(tail, now)
+	if err != nil {
+		return err
+	}
+	slot := consensus.NextSlot(now, p.chain.Params().BlockInterval)
+	if !p.signer(slot).Equals(p.miner) {
+		return ErrNotMyTurn
+	}
+
+	block, err := p.newBlock(tail, slot, deadline)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail": tail,
+			"slot": slot,
+			"err":  err,
+		}).Error("Failed to mint new block")
+		return err
+	}
+
+	current := time.Now().Unix()
+	if slot > current {
+		<-time.NewTimer(time.Duration(slot-current) * time.Second).C
+	}
+	if err := p.chain.BlockPool().PushAndBroadcast(block); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"tail":  tail,
+			"block": block,
+			"err":   err,
+		}).Error("Failed to broadcast new block")
+		return err
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"tail":  tail,
+		"block": block,
+	}).Info("Minted new block")
+	return nil
+}
+
+func (p *Poa) blockLoop() {
+	logging.CLog().Info("Started PoA Mining.")
+	timeChan := time.NewTicker(time.Second).C
+	for {
+		select {
+		case now := <-timeChan:
+			p.mintBlock(now.Unix())
+		case <-p.quitCh:
+			logging.CLog().Info("Stopped PoA Mining.")
+			return
+		}
+	}
+}
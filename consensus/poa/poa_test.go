@@ -0,0 +1,149 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package poa
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+var signers = []string{
+	"1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+	"2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8",
+	"333cb3ed8c417971845382ede3cf67a0a96270c05fe2f700",
+}
+
+type Neb struct {
+	config  *nebletpb.Config
+	chain   *core.BlockChain
+	am      *account.Manager
+	genesis *corepb.Genesis
+	storage storage.Storage
+	emitter *core.EventEmitter
+}
+
+func mockNeb(t *testing.T) *Neb {
+	storage, _ := storage.NewMemoryStorage()
+	neb := &Neb{
+		genesis: &corepb.Genesis{
+			Meta: &corepb.GenesisMeta{ChainId: 100},
+			Consensus: &corepb.GenesisConsensus{
+				Dpos: &corepb.GenesisConsensusDpos{Dynasty: signers},
+				Poa:  &corepb.GenesisConsensusPoa{Signers: signers},
+			},
+			Params: &corepb.GenesisParams{DynastySize: core.MinDynastySize, DynastyInterval: 30},
+		},
+		storage: storage,
+		emitter: core.NewEventEmitter(1024),
+		config: &nebletpb.Config{
+			Chain: &nebletpb.ChainConfig{
+				ChainId:   100,
+				Keydir:    "../../keydir",
+				Miner:     signers[0],
+				Consensus: "poa",
+			},
+		},
+	}
+	neb.am = account.NewManager(neb)
+	chain, err := core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	neb.chain = chain
+	return neb
+}
+
+func (n *Neb) Config() *nebletpb.Config {
+	return n.config
+}
+
+func (n *Neb) BlockChain() *core.BlockChain {
+	return n.chain
+}
+
+func (n *Neb) AccountManager() *account.Manager {
+	return n.am
+}
+
+func (n *Neb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *Neb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *Neb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func TestPoa_New(t *testing.T) {
+	neb := mockNeb(t)
+	poa, err := NewPoa(neb)
+	assert.Nil(t, err)
+	assert.Equal(t, len(signers), len(poa.signers))
+	assert.Equal(t, signers[0], poa.coinbase.String())
+
+	neb.genesis.Consensus.Poa = nil
+	_, err = NewPoa(neb)
+	assert.Equal(t, ErrMissingSignersForPoa, err)
+}
+
+func TestPoa_VerifyBlock(t *testing.T) {
+	neb := mockNeb(t)
+	poa, err := NewPoa(neb)
+	assert.Nil(t, err)
+	tail := poa.chain.TailBlock()
+
+	// the signers mint the slots in turn.
+	for i, v := range signers {
+		assert.Equal(t, v, poa.signer(int64(i)*core.DefaultBlockInterval).String())
+	}
+	assert.Equal(t, signers[0], poa.signer(int64(len(signers))*core.DefaultBlockInterval).String())
+
+	slot := tail.Timestamp() + int64(len(signers))*core.DefaultBlockInterval
+	assert.Nil(t, poa.EnableMining("passphrase"))
+	block, err := poa.newBlock(tail, slot, slot)
+	assert.Nil(t, err)
+	assert.Nil(t, poa.VerifyBlock(block, tail))
+
+	// the slot of another signer.
+	block, err = poa.newBlock(tail, slot+core.DefaultBlockInterval, slot)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInvalidBlockSigner, poa.FastVerifyBlock(block))
+
+	other, err := core.AddressParse(signers[1])
+	assert.Nil(t, err)
+	assert.Nil(t, poa.am.Unlock(other, []byte("passphrase"), keystore.DefaultUnlockDuration))
+	assert.Nil(t, poa.am.SignBlock(other, block))
+	assert.Nil(t, poa.VerifyBlock(block, tail))
+
+	// the slot is not reached yet.
+	block, err = core.NewBlock(poa.chain.ChainID(), poa.coinbase, tail)
+	assert.Nil(t, err)
+	block.SetTimestamp(consensus.NextSlot(time.Now().Unix(), core.DefaultBlockInterval) + core.DefaultBlockInterval)
+	assert.Equal(t, ErrBlockInFutureSlot, poa.FastVerifyBlock(block))
+}
@@ -0,0 +1,41 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import "github.com/nebulasio/go-nebulas/core"
+
+// LastSlot returns the last slot at or before now.
+func LastSlot(now, interval int64) int64 {
+	return int64((now-1)/interval) * interval
+}
+
+// NextSlot returns the slot to mint at now.
+func NextSlot(now, interval int64) int64 {
+	return int64((now+interval-1)/interval) * interval
+}
+
+// Deadline returns the time the block minted at now must be sealed before.
+func Deadline(now, interval int64) int64 {
+	nextSlot := NextSlot(now, interval)
+	remain := nextSlot - now
+	if core.MaxMintDuration > remain {
+		return nextSlot
+	}
+	return now + core.MaxMintDuration
+}
@@ -27,6 +27,12 @@ const (
 	CanMiningEvent  = "event.canmining"
 )
 
+// Consensus engines selectable in config.
+const (
+	DposEngine = "dpos"
+	PoaEngine  = "poa"
+)
+
 // Consensus interface of consensus algorithm.
 type Consensus interface {
 	Start()
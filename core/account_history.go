@@ -0,0 +1,320 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Reasons of balance changes.
+const (
+	BalanceChangeTransfer = "transfer"
+	BalanceChangeCoinbase = "coinbase"
+	BalanceChangeGas      = "gas"
+)
+
+// Limits of the balance changes returned by GetAccountHistory.
+const (
+	DefaultAccountHistoryLimit = 100
+	MaxAccountHistoryLimit     = 1000
+)
+
+// BalanceChange is a change of the account balance on canonical chain, the tx hash is
+// empty for the block reward. Transfers made by contracts, multisig wallets and timelocks are
+// not recorded.
+type BalanceChange struct {
+	Address *Address
+	Height  uint64
+	TxHash  byteutils.Hash
+	Reason  string
+	Delta   *big.Int
+}
+
+// ToProto converts domain BalanceChange to proto BalanceChange
+func (c *BalanceChange) ToProto() (proto.Message, error) {
+	return &corepb.BalanceChange{
+		Address: c.Address.address,
+		Height:  c.Height,
+		TxHash:  c.TxHash,
+		Reason:  c.Reason,
+		Delta:   c.Delta.String(),
+	}, nil
+}
+
+// FromProto converts proto BalanceChange to domain BalanceChange
+func (c *BalanceChange) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.BalanceChange); ok {
+		delta, ok := new(big.Int).SetString(msg.Delta, 10)
+		if !ok {
+			return ErrInvalidBalanceChange
+		}
+		c.Address = &Address{msg.Address}
+		c.Height = msg.Height
+		c.TxHash = msg.TxHash
+		c.Reason = msg.Reason
+		c.Delta = delta
+		return nil
+	}
+	return ErrInvalidBalanceChange
+}
+
+// balanceChanges returns the balance changes made by the block reward, the gas and
+// the value transfers of the executed transactions, in execution order.
+func (block *Block) balanceChanges() ([]*BalanceChange, error) {
+	var changes []*BalanceChange
+	record := func(addr *Address, tx *Transaction, reason string, delta *big.Int) {
+		if delta.Sign() == 0 {
+			return
+		}
+		change := &BalanceChange{Address: addr, Height: block.height, Reason: reason, Delta: delta}
+		if tx != nil {
+			change.TxHash = tx.hash
+		}
+		changes = append(changes, change)
+	}
+
+	record(block.Coinbase(), nil, BalanceChangeCoinbase, block.chainParams().BlockRewardAt(block.height).Int)
+	for _, tx := range block.transactions {
+		receipt, ok := block.receipts[tx.hash.Hex()]
+		if !ok {
+			continue
+		}
+
+		if receipt.status == ReceiptStatusSuccess && tx.Type() == TxPayloadBatchType {
+			payload, err := LoadBatchPayload(tx.data.Payload)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range payload.Transfers {
+				to, err := AddressParse(v.To)
+				if err != nil {
+					return nil, err
+				}
+				value, ok := new(big.Int).SetString(v.Value, 10)
+				if !ok {
+					return nil, ErrInvalidBatchValue
+				}
+				record(tx.from, tx, BalanceChangeTransfer, new(big.Int).Neg(value))
+				record(to, tx, BalanceChangeTransfer, value)
+			}
+		}
+
+		gasCost := new(big.Int).Mul(tx.gasPrice.Int, receipt.gasUsed.Int)
+		record(tx.from, tx, BalanceChangeGas, new(big.Int).Neg(gasCost))
+		record(block.Coinbase(), tx, BalanceChangeCoinbase, gasCost)
+
+		if receipt.status == ReceiptStatusSuccess {
+			record(tx.from, tx, BalanceChangeTransfer, new(big.Int).Neg(tx.value.Int))
+			record(tx.to, tx, BalanceChangeTransfer, new(big.Int).Set(tx.value.Int))
+		}
+	}
+	return changes, nil
+}
+
+// GetAccountHistory returns at most limit balance changes of the account on canonical chain,
+// newest first, skipping the newest offset ones, and the total count of the changes.
+func (bc *BlockChain) GetAccountHistory(addr *Address, offset, limit uint64) ([]*BalanceChange, uint64, error) {
+	if !bc.accountHistory {
+		return nil, 0, ErrAccountHistoryDisabled
+	}
+	if limit == 0 {
+		limit = DefaultAccountHistoryLimit
+	}
+	if limit > MaxAccountHistoryLimit {
+		limit = MaxAccountHistoryLimit
+	}
+
+	total, err := bc.accountHistoryCount(addr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var changes []*BalanceChange
+	for i := offset; i < total && uint64(len(changes)) < limit; i++ {
+		value, err := bc.storage.Get(accountHistoryKey(addr, total-1-i))
+		if err != nil {
+			return nil, 0, err
+		}
+		change, err := unmarshalBalanceChange(value)
+		if err != nil {
+			return nil, 0, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, total, nil
+}
+
+func (bc *BlockChain) storeBalanceChanges(block *Block) error {
+	changes, err := block.balanceChanges()
+	if err != nil {
+		return err
+	}
+	pbChanges := new(corepb.BalanceChanges)
+	for _, v := range changes {
+		msg, err := v.ToProto()
+		if err != nil {
+			return err
+		}
+		pbChanges.Changes = append(pbChanges.Changes, msg.(*corepb.BalanceChange))
+	}
+	value, err := proto.Marshal(pbChanges)
+	if err != nil {
+		return err
+	}
+	return bc.storage.Put(append([]byte(BalanceChangesPrefix), block.Hash()...), value)
+}
+
+// loadBalanceChanges returns nil if the changes of the block are not stored,
+// which happens when the block is accepted before the history is enabled.
+func (bc *BlockChain) loadBalanceChanges(hash byteutils.Hash) ([]*BalanceChange, error) {
+	value, err := bc.storage.Get(append([]byte(BalanceChangesPrefix), hash...))
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	pbChanges := new(corepb.BalanceChanges)
+	if err := proto.Unmarshal(value, pbChanges); err != nil {
+		return nil, err
+	}
+	changes := make([]*BalanceChange, len(pbChanges.Changes))
+	for i, v := range pbChanges.Changes {
+		changes[i] = new(BalanceChange)
+		if err := changes[i].FromProto(v); err != nil {
+			return nil, err
+		}
+	}
+	return changes, nil
+}
+
+// updateAccountHistory removes the changes of the reverted blocks, from the old tail
+// down, and appends the changes of the blocks in (from, to] on the new canonical chain.
+func (bc *BlockChain) updateAccountHistory(from *Block, to *Block, reverted []*Block) error {
+	for _, block := range reverted {
+		changes, err := bc.loadBalanceChanges(block.Hash())
+		if err != nil {
+			return err
+		}
+		for i := len(changes) - 1; i >= 0; i-- {
+			if err := bc.popAccountHistory(changes[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	var blocks []*Block
+	for !to.Hash().Equals(from.Hash()) {
+		blocks = append(blocks, to)
+		if to = bc.GetBlock(to.header.parentHash); to == nil {
+			return ErrMissingParentBlock
+		}
+	}
+	for i := len(blocks) - 1; i >= 0; i-- {
+		changes, err := bc.loadBalanceChanges(blocks[i].Hash())
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			if err := bc.pushAccountHistory(change); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (bc *BlockChain) pushAccountHistory(change *BalanceChange) error {
+	count, err := bc.accountHistoryCount(change.Address)
+	if err != nil {
+		return err
+	}
+	msg, err := change.ToProto()
+	if err != nil {
+		return err
+	}
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err := bc.storage.Put(accountHistoryKey(change.Address, count), value); err != nil {
+		return err
+	}
+	return bc.storage.Put(accountHistoryCountKey(change.Address), byteutils.FromUint64(count+1))
+}
+
+// popAccountHistory removes the last change of the account if it is the given one.
+func (bc *BlockChain) popAccountHistory(change *BalanceChange) error {
+	count, err := bc.accountHistoryCount(change.Address)
+	if err != nil || count == 0 {
+		return err
+	}
+	value, err := bc.storage.Get(accountHistoryKey(change.Address, count-1))
+	if err != nil {
+		return err
+	}
+	last, err := unmarshalBalanceChange(value)
+	if err != nil {
+		return err
+	}
+	if last.Height != change.Height {
+		return nil
+	}
+	if err := bc.storage.Del(accountHistoryKey(change.Address, count-1)); err != nil {
+		return err
+	}
+	return bc.storage.Put(accountHistoryCountKey(change.Address), byteutils.FromUint64(count-1))
+}
+
+func (bc *BlockChain) accountHistoryCount(addr *Address) (uint64, error) {
+	value, err := bc.storage.Get(accountHistoryCountKey(addr))
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Uint64(value), nil
+}
+
+func unmarshalBalanceChange(value []byte) (*BalanceChange, error) {
+	msg := new(corepb.BalanceChange)
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return nil, err
+	}
+	change := new(BalanceChange)
+	if err := change.FromProto(msg); err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// the addresses have the same length, the count key never collides with the change keys.
+func accountHistoryCountKey(addr *Address) []byte {
+	return append([]byte(AccountHistoryPrefix), addr.address...)
+}
+
+func accountHistoryKey(addr *Address, index uint64) []byte {
+	return append(accountHistoryCountKey(addr), byteutils.FromUint64(index)...)
+}
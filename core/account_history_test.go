@@ -0,0 +1,85 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockChain_AccountHistory(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	_, _, err = bc.GetAccountHistory(mockAddress(), 0, 0)
+	assert.Equal(t, ErrAccountHistoryDisabled, err)
+	bc.accountHistory = true
+
+	coinbase := mockAddress()
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.miner = coinbase
+
+	from, to := mockAddress(), mockAddress()
+	balance := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(10000000).Int))
+	block.accState.GetOrCreateUserAccount(from.address).AddBalance(balance)
+	tx1 := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, nil, TransactionGasPrice, TransactionMaxGas)
+	tx2 := NewTransaction(bc.ChainID(), from, to, balance, 2, TxPayloadBinaryType, nil, TransactionGasPrice, TransactionMaxGas)
+	block.transactions = Transactions{tx1, tx2}
+	assert.Nil(t, block.execute())
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, bc.storeBalanceChanges(block))
+	assert.Nil(t, bc.updateAccountHistory(bc.genesisBlock, block, nil))
+
+	gasCost := new(big.Int).Mul(TransactionGasPrice.Int, tx1.GasCountOfTxBase().Int)
+	changes, total, err := bc.GetAccountHistory(from, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), total)
+	assert.Equal(t, 3, len(changes))
+	// the failed transfer of tx2 only costs gas.
+	assert.Equal(t, tx2.Hash(), changes[0].TxHash)
+	assert.Equal(t, BalanceChangeGas, changes[0].Reason)
+	assert.Equal(t, new(big.Int).Neg(gasCost).String(), changes[0].Delta.String())
+	assert.Equal(t, tx1.Hash(), changes[1].TxHash)
+	assert.Equal(t, BalanceChangeTransfer, changes[1].Reason)
+	assert.Equal(t, "-1", changes[1].Delta.String())
+	assert.Equal(t, block.Height(), changes[1].Height)
+
+	changes, total, err = bc.GetAccountHistory(to, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), total)
+	assert.Equal(t, "1", changes[0].Delta.String())
+
+	changes, _, err = bc.GetAccountHistory(from, 2, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(changes))
+	assert.Equal(t, tx1.Hash(), changes[0].TxHash)
+	assert.Equal(t, BalanceChangeGas, changes[0].Reason)
+
+	// the changes are removed when the block is reverted.
+	assert.Nil(t, bc.updateAccountHistory(bc.genesisBlock, bc.genesisBlock, []*Block{block}))
+	_, total, err = bc.GetAccountHistory(from, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), total)
+	_, total, err = bc.GetAccountHistory(coinbase, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), total)
+}
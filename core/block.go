@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/crypto"
@@ -32,6 +33,7 @@ import (
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/crypto/sha3"
@@ -45,7 +47,10 @@ var (
 	// BlockHashLength define a const of the length of Hash of Block in byte.
 	BlockHashLength = 32
 
-	// BlockReward given to coinbase
+	// RecentBlockHashDepth is how far back contracts can look up block hashes.
+	RecentBlockHashDepth uint64 = 128
+
+	// BlockReward given to coinbase by the default reward schedule
 	// rule: 3% per year, 3,000,000. 1 block per 5 seconds
 	// value: 10^8 * 3% / (365*24*3600/5) * 10^18 ≈ 16 * 3% * 10*18 = 48 * 10^16
 	BlockReward = util.NewUint128FromBigInt(util.NewUint128().Mul(util.NewUint128FromInt(48).Int,
@@ -67,6 +72,7 @@ type BlockHeader struct {
 	nonce     uint64
 	timestamp int64
 	chainID   uint32
+	gasLimit  uint64
 
 	// sign
 	alg  uint8
@@ -88,6 +94,7 @@ func (b *BlockHeader) ToProto() (proto.Message, error) {
 		ChainId:     b.chainID,
 		Alg:         uint32(b.alg),
 		Sign:        b.sign,
+		GasLimit:    b.gasLimit,
 	}, nil
 }
 
@@ -106,6 +113,7 @@ func (b *BlockHeader) FromProto(msg proto.Message) error {
 		b.chainID = msg.ChainId
 		b.alg = uint8(msg.Alg)
 		b.sign = msg.Sign
+		b.gasLimit = msg.GasLimit
 		return nil
 	}
 	return errors.New("Protobuf message cannot be converted into BlockHeader")
@@ -128,6 +136,18 @@ type Block struct {
 
 	storage      storage.Storage
 	eventEmitter *EventEmitter
+	params       *ChainParams
+
+	// gas used by the executed transactions.
+	gasUsed uint64
+
+	// execution errors of the failed transactions and the receipts, not part of consensus.
+	executionErrors map[byteutils.HexHash]string
+	receipts        map[byteutils.HexHash]*Receipt
+	gasRefunds      map[byteutils.HexHash]*util.Uint128
+
+	// validators substituted in the dynasty change of the block, not part of consensus.
+	dynastyKickouts []string
 }
 
 // ToProto converts domain Block into proto Block
@@ -214,6 +234,7 @@ func NewBlock(chainID uint32, coinbase *Address, parent *Block) (*Block, error)
 			nonce:       0,
 			timestamp:   time.Now().Unix(),
 			chainID:     chainID,
+			gasLimit:    parent.NextGasLimit(parent.header.gasLimit),
 		},
 		transactions: make(Transactions, 0),
 		parenetBlock: parent,
@@ -226,6 +247,7 @@ func NewBlock(chainID uint32, coinbase *Address, parent *Block) (*Block, error)
 		sealed:       false,
 		storage:      parent.storage,
 		eventEmitter: parent.eventEmitter,
+		params:       parent.params,
 	}
 
 	block.begin()
@@ -246,6 +268,12 @@ func (block *Block) Sign(signature keystore.Signature) error {
 	return nil
 }
 
+// SetSignature sets the signature of block hash signed apart, e.g. by a remote signer.
+func (block *Block) SetSignature(alg keystore.Algorithm, sign byteutils.Hash) {
+	block.header.alg = uint8(alg)
+	block.header.sign = sign
+}
+
 // ChainID returns block's chainID
 func (block *Block) ChainID() uint32 {
 	return block.header.chainID
@@ -333,14 +361,18 @@ func (block *Block) DposContext() *corepb.DposContext {
 
 // DposContextHash hash dpos context
 func (block *Block) DposContextHash() byteutils.Hash {
+	return hashDposContext(block.header.dposContext)
+}
+
+func hashDposContext(dposContext *corepb.DposContext) byteutils.Hash {
 	hasher := sha3.New256()
 
-	hasher.Write(block.header.dposContext.DynastyRoot)
-	hasher.Write(block.header.dposContext.NextDynastyRoot)
-	hasher.Write(block.header.dposContext.DelegateRoot)
-	hasher.Write(block.header.dposContext.VoteRoot)
-	hasher.Write(block.header.dposContext.CandidateRoot)
-	hasher.Write(block.header.dposContext.MintCntRoot)
+	hasher.Write(dposContext.DynastyRoot)
+	hasher.Write(dposContext.NextDynastyRoot)
+	hasher.Write(dposContext.DelegateRoot)
+	hasher.Write(dposContext.VoteRoot)
+	hasher.Write(dposContext.CandidateRoot)
+	hasher.Write(dposContext.MintCntRoot)
 
 	return hasher.Sum(nil)
 }
@@ -376,6 +408,57 @@ func (block *Block) VerifyAddress(str string) bool {
 	return err == nil
 }
 
+// AncestorHash returns the hash of the ancestor block at the given height.
+// Only the RecentBlockHashDepth blocks below this block can be looked up, so
+// contracts see the same answer whether the block is being mined or verified.
+func (block *Block) AncestorHash(height uint64) (byteutils.Hash, error) {
+	if height >= block.height || block.height-height > RecentBlockHashDepth {
+		return nil, ErrInvalidAncestorHeight
+	}
+
+	hash := block.ParentHash()
+	parent := block.parenetBlock
+	for h := block.height - 1; h > height; h-- {
+		// blocks verified in the same batch are not stored yet, follow the links first.
+		if parent != nil {
+			hash = parent.ParentHash()
+			parent = parent.parenetBlock
+			continue
+		}
+		value, err := block.storage.Get(hash)
+		if err != nil {
+			return nil, err
+		}
+		pbBlock := new(corepb.Block)
+		if err := proto.Unmarshal(value, pbBlock); err != nil {
+			return nil, err
+		}
+		hash = pbBlock.Header.ParentHash
+	}
+	return hash, nil
+}
+
+// RecoverAddress returns the address which signed the hash with the given algorithm.
+func (block *Block) RecoverAddress(alg uint8, hash, sign byteutils.Hash) (string, error) {
+	signature, err := crypto.NewSignature(keystore.Algorithm(alg))
+	if err != nil {
+		return "", err
+	}
+	pub, err := signature.RecoverPublic(hash, sign)
+	if err != nil {
+		return "", err
+	}
+	pubdata, err := pub.Encoded()
+	if err != nil {
+		return "", err
+	}
+	addr, err := NewAddressFromPublicKey(pubdata)
+	if err != nil {
+		return "", err
+	}
+	return addr.String(), nil
+}
+
 // LinkParentBlock link parent block, return true if hash is the same; false otherwise.
 func (block *Block) LinkParentBlock(chain *BlockChain, parentBlock *Block) error {
 	// startAt := time.Now().Unix()
@@ -412,6 +495,7 @@ func (block *Block) LinkParentBlock(chain *BlockChain, parentBlock *Block) error
 	block.storage = parentBlock.storage
 	block.height = parentBlock.height + 1
 	block.eventEmitter = parentBlock.eventEmitter
+	block.params = chain.params
 
 	/* 	logging.VLog().WithFields(logrus.Fields{
 		"parent":    parentBlock,
@@ -463,6 +547,25 @@ func (block *Block) ReturnTransactions() {
 	}
 }
 
+// ResubmitTransactions return the transactions back to the pool with priority.
+func (block *Block) ResubmitTransactions() {
+	for _, tx := range block.transactions {
+		if err := block.txPool.PushWithPriority(tx); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": block,
+				"tx":    tx,
+				"err":   err,
+			}).Debug("Failed to resubmit a tx in reverted block.")
+			continue
+		}
+		event := &Event{
+			Topic: TopicReorgRecoveredTransaction,
+			Data:  tx.String(),
+		}
+		block.eventEmitter.Trigger(event)
+	}
+}
+
 // CollectTransactions and add them to block.
 func (block *Block) CollectTransactions(deadline int64) {
 	if block.sealed {
@@ -634,7 +737,7 @@ func (block *Block) Seal() error {
 }
 
 func (block *Block) String() string {
-	return fmt.Sprintf(`{"height": %d, "hash": "%s", "parent_hash": "%s", "state": "%s", "txs": "%s", "events": "%s", "nonce": %d, "timestamp": %d, "coinbase": "%s", "miner": %s, "dynasty": %s, tx": %d}`,
+	return fmt.Sprintf(`{"height": %d, "hash": "%s", "parent_hash": "%s", "state": "%s", "txs": "%s", "events": "%s", "nonce": %d, "timestamp": %d, "coinbase": "%s", "miner": %s, "dynasty": %s, "gas_limit": %d, tx": %d}`,
 		block.height,
 		block.header.hash,
 		block.header.parentHash,
@@ -646,6 +749,7 @@ func (block *Block) String() string {
 		block.header.coinbase,
 		block.miner,
 		byteutils.Hex(block.header.dposContext.DynastyRoot),
+		block.header.gasLimit,
 		len(block.transactions),
 	)
 }
@@ -658,6 +762,9 @@ func (block *Block) VerifyExecution(parent *Block, consensus Consensus) error {
 	if err := consensus.VerifyBlock(block, parent); err != nil {
 		return err
 	}
+	if err := block.verifyGasLimit(parent); err != nil {
+		return err
+	}
 	// verifyAt := time.Now().Unix()
 
 	block.begin()
@@ -712,6 +819,20 @@ func (block *Block) triggerEvent() {
 			topic = TopicDelegate
 		case TxPayloadCandidateType:
 			topic = TopicCandidate
+		case TxPayloadMultisigType:
+			topic = TopicMultisig
+		case TxPayloadBatchType:
+			topic = TopicBatch
+		case TxPayloadTimelockType:
+			topic = TopicTimelock
+		case TxPayloadSlashType:
+			topic = TopicSlash
+		case TxPayloadUpgradeType:
+			topic = TopicUpgradeSmartContract
+		case TxPayloadVerifyType:
+			topic = TopicVerifySmartContract
+		case TxPayloadPauseType:
+			topic = TopicContractPause
 		}
 		event := &Event{
 			Topic: topic,
@@ -720,11 +841,22 @@ func (block *Block) triggerEvent() {
 		block.eventEmitter.Trigger(event)
 
 		events, err := block.FetchEvents(v.hash)
-		if err != nil {
+		if err == nil {
 			for _, e := range events {
 				block.eventEmitter.Trigger(e)
 			}
 		}
+		if block.isTxExecutionSucceed(v.hash) {
+			block.triggerGovernanceEvent(v)
+		}
+	}
+
+	block.triggerDynastyChangeEvent()
+	for _, v := range block.dynastyKickouts {
+		block.eventEmitter.Trigger(&Event{
+			Topic: TopicDynastyKickout,
+			Data:  v,
+		})
 	}
 
 	e := &Event{
@@ -755,9 +887,9 @@ func (block *Block) VerifyIntegrity(chainID uint32, consensus Consensus) error {
 		return ErrInvalidBlockHash
 	}
 
-	// verify transactions integrity.
+	// verify transactions integrity, the signatures are verified concurrently.
 	for _, tx := range block.transactions {
-		if err := tx.VerifyIntegrity(block.header.chainID); err != nil {
+		if err := tx.verifyHash(block.header.chainID); err != nil {
 			logging.VLog().WithFields(logrus.Fields{
 				"tx":  tx,
 				"err": err,
@@ -765,6 +897,13 @@ func (block *Block) VerifyIntegrity(chainID uint32, consensus Consensus) error {
 			return err
 		}
 	}
+	if tx, err := signVerifier.VerifyAll(block.transactions); err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tx":  tx,
+			"err": err,
+		}).Debug("Failed to verify tx's signature.")
+		return err
+	}
 
 	// verify the block is acceptable by consensus.
 	if err := consensus.FastVerifyBlock(block); err != nil {
@@ -808,23 +947,8 @@ func (block *Block) verifyState() error {
 func (block *Block) execute() error {
 	block.rewardCoinbase()
 
-	for _, tx := range block.transactions {
-		start := time.Now().Unix()
-		metricsTxExecute.Mark(1)
-
-		giveback, err := block.executeTransaction(tx)
-		if giveback {
-			err := block.txPool.Push(tx)
-			if err != nil {
-				return err
-			}
-		}
-		if err != nil {
-			return err
-		}
-
-		end := time.Now().Unix()
-		metricsTxExecutedTimer.Update(time.Duration(end - start))
+	if err := block.executeTransactions(); err != nil {
+		return err
 	}
 
 	return block.recordMintCnt()
@@ -840,12 +964,52 @@ func (block *Block) GetNonce(address byteutils.Hash) uint64 {
 	return block.accState.GetOrCreateUserAccount(address).Nonce()
 }
 
+// ProveAccount returns the merkle proof of the account against the state root of this block.
+func (block *Block) ProveAccount(address byteutils.Hash) (trie.MerkleProof, error) {
+	stateTrie, err := trie.NewTrie(block.StateRoot(), block.storage)
+	if err != nil {
+		return nil, err
+	}
+	return stateTrie.Prove(address)
+}
+
+// GetContract returns the contract account for the given address on this block.
+func (block *Block) GetContract(address byteutils.Hash) (state.Account, error) {
+	contract, err := block.accState.GetContractAccount(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(contract.BirthPlace()) == 0 {
+		return nil, ErrContractNotFound
+	}
+	return contract, nil
+}
+
+// GetContractStorage returns the value of the given storage key in contract on this block.
+func (block *Block) GetContractStorage(address byteutils.Hash, key string) ([]byte, error) {
+	contract, err := block.GetContract(address)
+	if err != nil {
+		return nil, err
+	}
+	return contract.Get(nvm.HashStorageKey(key))
+}
+
 // RecordEvent record event's topic and data with txHash
 func (block *Block) RecordEvent(txHash byteutils.Hash, topic, data string) error {
 	event := &Event{Topic: topic, Data: data}
 	return block.recordEvent(txHash, event)
 }
 
+// RecordIndexedEvent record event's topic, data and indexed params with txHash,
+// the params are dropped before the indexed event height.
+func (block *Block) RecordIndexedEvent(txHash byteutils.Hash, topic, data string, indexed []string) error {
+	event := &Event{Topic: topic, Data: data}
+	if params := block.chainParams(); params.IndexedEventHeight > 0 && block.height >= params.IndexedEventHeight {
+		event.Indexed = indexed
+	}
+	return block.recordEvent(txHash, event)
+}
+
 func (block *Block) recordEvent(txHash byteutils.Hash, event *Event) error {
 	iter, err := block.eventsTrie.Iterator(txHash)
 	if err != nil && err != storage.ErrKeyNotFound {
@@ -883,6 +1047,19 @@ func (block *Block) recordEvent(txHash byteutils.Hash, event *Event) error {
 	return nil
 }
 
+func (block *Block) recordExecutionError(txHash byteutils.Hash, err error, result string) {
+	reason := NewExecutionError(err, result).Error()
+	if block.executionErrors == nil {
+		block.executionErrors = make(map[byteutils.HexHash]string)
+	}
+	block.executionErrors[txHash.Hex()] = reason
+}
+
+// ExecutionErrors returns the execution errors of the failed transactions in block.
+func (block *Block) ExecutionErrors() map[byteutils.HexHash]string {
+	return block.executionErrors
+}
+
 // FetchEvents fetch events by txHash.
 func (block *Block) FetchEvents(txHash byteutils.Hash) ([]*Event, error) {
 	events := []*Event{}
@@ -911,9 +1088,22 @@ func (block *Block) FetchEvents(txHash byteutils.Hash) ([]*Event, error) {
 	return events, nil
 }
 
+func (block *Block) isTxExecutionSucceed(txHash byteutils.Hash) bool {
+	events, err := block.FetchEvents(txHash)
+	if err != nil {
+		return false
+	}
+	for _, v := range events {
+		if v.Topic == TopicExecuteTxSuccess {
+			return true
+		}
+	}
+	return false
+}
+
 func (block *Block) recordMintCnt() error {
 	// startAt := time.Now().Unix()
-	key := append(byteutils.FromInt64(block.Timestamp()/DynastyInterval), block.miner.Bytes()...)
+	key := append(byteutils.FromInt64(block.Timestamp()/block.chainParams().DynastyInterval), block.miner.Bytes()...)
 	bytes, err := block.dposContext.mintCntTrie.Get(key)
 	if err != nil && err != storage.ErrKeyNotFound {
 		return err
@@ -930,7 +1120,7 @@ func (block *Block) recordMintCnt() error {
 	// endAt := time.Now().Unix()
 
 	/* 	logging.VLog().WithFields(logrus.Fields{
-		"dynasty": block.Timestamp() / DynastyInterval,
+		"dynasty": block.Timestamp() / block.chainParams().DynastyInterval,
 		"miner":   block.miner.String(),
 		"count":   cnt,
 		"time":    endAt - startAt,
@@ -943,7 +1133,8 @@ func (block *Block) rewardCoinbase() {
 	stateOld := block.accState.RootHash().String()
 	coinbaseAcc := block.accState.GetOrCreateUserAccount(coinbaseAddr)
 	balanceOld := coinbaseAcc.Balance().String()
-	coinbaseAcc.AddBalance(BlockReward)
+	reward := block.chainParams().BlockRewardAt(block.height)
+	coinbaseAcc.AddBalance(reward)
 	balanceNew := coinbaseAcc.Balance().String()
 	stateNew := block.accState.RootHash().String()
 
@@ -953,7 +1144,7 @@ func (block *Block) rewardCoinbase() {
 		"balance.after":  balanceNew,
 		"state.before":   stateOld,
 		"state.after":    stateNew,
-		"reward":         BlockReward.String(),
+		"reward":         reward.String(),
 	}).Info("Rewarded the coinbase.")
 }
 
@@ -975,6 +1166,85 @@ func (block *Block) GetTransaction(hash byteutils.Hash) (*Transaction, error) {
 	return tx, nil
 }
 
+// TransactionsDependency returns the indexes of the former transactions each transaction in block depends on.
+// Two transactions conflict when they read or write the same account. The gas reward of coinbase
+// is ignored since it's accumulative, and all dpos transactions conflict with each other.
+func (block *Block) TransactionsDependency() [][]int {
+	dependency := make([][]int, len(block.transactions))
+	touched := make(map[string][]int)
+	for i, tx := range block.transactions {
+		keys := []string{}
+		for _, addr := range transactionAccounts(tx) {
+			keys = append(keys, addr.String())
+		}
+		if tx.Type() == TxPayloadCandidateType || tx.Type() == TxPayloadDelegateType {
+			keys = append(keys, "dpos")
+		}
+
+		depends := make(map[int]bool)
+		for _, key := range keys {
+			for _, idx := range touched[key] {
+				depends[idx] = true
+			}
+			touched[key] = append(touched[key], i)
+		}
+
+		dependency[i] = []int{}
+		for idx := range depends {
+			dependency[i] = append(dependency[i], idx)
+		}
+		sort.Ints(dependency[i])
+	}
+	return dependency
+}
+
+// transactionAccounts returns the accounts the transaction reads or writes, except the coinbase.
+func transactionAccounts(tx *Transaction) []*Address {
+	accounts := []*Address{tx.from}
+	if !tx.from.Equals(tx.to) {
+		accounts = append(accounts, tx.to)
+	}
+	if tx.Type() == TxPayloadMultisigType {
+		// the multisig tx also transfers from the wallet.
+		if payload, err := LoadMultisigPayload(tx.data.Payload); err == nil {
+			if wallet, err := payload.Wallet(); err == nil && !wallet.Equals(tx.from) && !wallet.Equals(tx.to) {
+				accounts = append(accounts, wallet)
+			}
+		}
+	}
+	if tx.Type() == TxPayloadBatchType {
+		// the batch tx also transfers to the recipients.
+		if payload, err := LoadBatchPayload(tx.data.Payload); err == nil {
+			seen := map[string]bool{tx.from.String(): true, tx.to.String(): true}
+			for _, v := range payload.Transfers {
+				if to, err := AddressParse(v.To); err == nil && !seen[to.String()] {
+					seen[to.String()] = true
+					accounts = append(accounts, to)
+				}
+			}
+		}
+	}
+	if tx.Type() == TxPayloadTimelockType {
+		// the timelock tx also transfers to or from the lock account.
+		if payload, err := LoadTimelockPayload(tx.data.Payload); err == nil {
+			lock, err := TimelockAddress(tx.hash)
+			if payload.Action == TimelockReleaseAction {
+				lock, err = AddressParse(payload.Lock)
+			}
+			if err == nil {
+				accounts = append(accounts, lock)
+			}
+		}
+	}
+	if tx.Type() == TxPayloadSlashType {
+		// the slash tx records the miner in the slash account.
+		if slash, err := SlashAddress(); err == nil {
+			accounts = append(accounts, slash)
+		}
+	}
+	return accounts
+}
+
 func (block *Block) acceptTransaction(tx *Transaction) error {
 	// record tx
 	pbTx, err := tx.ToProto()
@@ -1000,6 +1270,10 @@ func (block *Block) checkTransaction(tx *Transaction) (giveback bool, err error)
 		return false, ErrDuplicatedTransaction
 	}
 
+	if tx.Expired(block.height, block.header.timestamp) {
+		return false, ErrTransactionExpired
+	}
+
 	// check nonce
 	fromAcc := block.accState.GetOrCreateUserAccount(tx.from.address)
 
@@ -1016,7 +1290,18 @@ func (block *Block) executeTransaction(tx *Transaction) (giveback bool, err erro
 		return giveback, err
 	}
 
-	if _, err := tx.VerifyExecution(block); err != nil {
+	// the tx is valid, but it has to wait for the next block.
+	if !block.checkGasLimit(tx) {
+		return true, ErrBlockGasLimitExceeded
+	}
+
+	start := time.Now()
+	gas, err := tx.VerifyExecution(block)
+	if err != nil {
+		return false, err
+	}
+	block.gasUsed += gas.Uint64()
+	if err := block.recordReceipt(tx, gas, time.Since(start)); err != nil {
 		return false, err
 	}
 
@@ -1029,25 +1314,80 @@ func (block *Block) executeTransaction(tx *Transaction) (giveback bool, err erro
 
 // HashBlock return the hash of block.
 func HashBlock(block *Block) byteutils.Hash {
+	txHashes := make([]byteutils.Hash, len(block.transactions))
+	for i, tx := range block.transactions {
+		txHashes[i] = tx.Hash()
+	}
+	return hashBlockHeader(block.header, txHashes)
+}
+
+// hashBlockHeader return the block hash from the header and the hashes of the txs in block.
+func hashBlockHeader(header *BlockHeader, txHashes []byteutils.Hash) byteutils.Hash {
 	hasher := sha3.New256()
 
-	hasher.Write(block.ParentHash())
-	hasher.Write(block.StateRoot())
-	hasher.Write(block.TxsRoot())
-	hasher.Write(block.EventsRoot())
-	hasher.Write(block.DposContextHash())
-	hasher.Write(byteutils.FromUint64(block.header.nonce))
-	hasher.Write(block.header.coinbase.address)
-	hasher.Write(byteutils.FromInt64(block.header.timestamp))
-	hasher.Write(byteutils.FromUint32(block.header.chainID))
+	hasher.Write(header.parentHash)
+	hasher.Write(header.stateRoot)
+	hasher.Write(header.txsRoot)
+	hasher.Write(header.eventsRoot)
+	hasher.Write(hashDposContext(header.dposContext))
+	hasher.Write(byteutils.FromUint64(header.nonce))
+	hasher.Write(header.coinbase.address)
+	hasher.Write(byteutils.FromInt64(header.timestamp))
+	hasher.Write(byteutils.FromUint32(header.chainID))
+	// the blocks without gas limit keep their hashes.
+	if header.gasLimit > 0 {
+		hasher.Write(byteutils.FromUint64(header.gasLimit))
+	}
 
-	for _, tx := range block.transactions {
-		hasher.Write(tx.Hash())
+	for _, hash := range txHashes {
+		hasher.Write(hash)
 	}
 
 	return hasher.Sum(nil)
 }
 
+// EncodeHeader returns the encoded header and the hashes of the txs in block,
+// which are enough to recompute the block hash.
+func (block *Block) EncodeHeader() ([]byte, [][]byte, error) {
+	pbHeader, err := block.header.ToProto()
+	if err != nil {
+		return nil, nil, err
+	}
+	header, err := proto.Marshal(pbHeader)
+	if err != nil {
+		return nil, nil, err
+	}
+	txHashes := make([][]byte, len(block.transactions))
+	for i, tx := range block.transactions {
+		txHashes[i] = tx.Hash()
+	}
+	return header, txHashes, nil
+}
+
+// DecodeHeader returns a block of the encoded header only,
+// the hash in header is verified with the hashes of the txs in block.
+func DecodeHeader(data []byte, txHashes [][]byte) (*Block, error) {
+	pbHeader := new(corepb.BlockHeader)
+	if err := proto.Unmarshal(data, pbHeader); err != nil {
+		return nil, err
+	}
+	header := new(BlockHeader)
+	if err := header.FromProto(pbHeader); err != nil {
+		return nil, err
+	}
+	if header.dposContext == nil {
+		return nil, ErrInvalidBlockHash
+	}
+	hashes := make([]byteutils.Hash, len(txHashes))
+	for i, v := range txHashes {
+		hashes[i] = v
+	}
+	if !hashBlockHeader(header, hashes).Equals(header.hash) {
+		return nil, ErrInvalidBlockHash
+	}
+	return &Block{header: header}, nil
+}
+
 // HashPbBlock return the hash of pb block.
 func HashPbBlock(pbBlock *corepb.Block) byteutils.Hash {
 	block := new(Block)
@@ -1137,6 +1477,14 @@ func (block *Block) Clone() (*Block, error) {
 		return nil, err
 	}
 
+	var gasRefunds map[byteutils.HexHash]*util.Uint128
+	if len(block.gasRefunds) > 0 {
+		gasRefunds = make(map[byteutils.HexHash]*util.Uint128, len(block.gasRefunds))
+		for k, v := range block.gasRefunds {
+			gasRefunds[k] = v
+		}
+	}
+
 	return &Block{
 		header:       block.header,
 		sealed:       block.sealed,
@@ -1146,7 +1494,12 @@ func (block *Block) Clone() (*Block, error) {
 		miner:        block.miner,
 		storage:      block.storage,
 		eventEmitter: block.eventEmitter,
+		params:       block.params,
 		transactions: make(Transactions, 0),
+		gasUsed:      block.gasUsed,
+
+		gasRefunds:      gasRefunds,
+		dynastyKickouts: append([]string(nil), block.dynastyKickouts...),
 
 		accState:    accState,
 		txsTrie:     txsTrie,
@@ -1161,7 +1514,52 @@ func (block *Block) Merge(source *Block) {
 	block.txsTrie = source.txsTrie
 	block.eventsTrie = source.eventsTrie
 	block.dposContext = source.dposContext
+	block.gasUsed = source.gasUsed
 	block.transactions = append(block.transactions, source.transactions...)
+	for _, tx := range source.transactions {
+		block.mergeTransactionResults(source, tx)
+	}
+	block.dynastyKickouts = source.dynastyKickouts
+}
+
+// mergeTransactionResults merges the results of tx kept out of the tries from source block.
+// A new per-tx field of Block must be merged here, so that the parallel execution and
+// the clones of block keep it.
+func (block *Block) mergeTransactionResults(source *Block, tx *Transaction) {
+	key := tx.hash.Hex()
+	if reason, ok := source.executionErrors[key]; ok {
+		if block.executionErrors == nil {
+			block.executionErrors = make(map[byteutils.HexHash]string)
+		}
+		block.executionErrors[key] = reason
+	}
+	if receipt, ok := source.receipts[key]; ok {
+		if block.receipts == nil {
+			block.receipts = make(map[byteutils.HexHash]*Receipt)
+		}
+		block.receipts[key] = receipt
+	}
+	if refund, ok := source.gasRefunds[key]; ok {
+		block.recordGasRefund(tx.hash, refund)
+	}
+}
+
+// ActivationHeights returns the heights of the contract execution changes set by the genesis.
+func (block *Block) ActivationHeights() nvm.ActivationHeights {
+	return nvm.ActivationHeights{
+		HostBindingsCheck: block.chainParams().HostBindingsCheckHeight,
+		SeededRandom:      block.chainParams().SeededRandomHeight,
+		ContractTransfer:  block.chainParams().ContractTransferHeight,
+		BlockContext:      block.chainParams().BlockContextHeight,
+	}
+}
+
+// chainParams returns the params of the chain the block is linked to.
+func (block *Block) chainParams() *ChainParams {
+	if block == nil || block.params == nil {
+		return defaultChainParams
+	}
+	return block.params
 }
 
 // Dispose dispose block.
@@ -0,0 +1,291 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/tracing"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// MinParallelTransactions is the least count of txs in block to execute in parallel,
+// cloning the state for fewer txs costs more than executing them serially.
+const MinParallelTransactions = 32
+
+// ParallelExecutionWorkers is the count of tx groups executed concurrently, 1 disables it.
+var ParallelExecutionWorkers = runtime.NumCPU()
+
+// txGroup is the txs touching the accounts no other group touches.
+type txGroup struct {
+	txs      Transactions
+	accounts []*Address
+
+	block *Block
+	err   error
+}
+
+// executeTransactions executes the txs in block, the independent txs are executed
+// concurrently and all txs are executed serially if any of them fails.
+func (block *Block) executeTransactions() error {
+	if groups := block.parallelGroups(); len(groups) > 1 {
+		if block.executeGroups(groups) {
+			return block.mergeGroups(groups)
+		}
+		metricsParallelExecutionFallback.Inc(1)
+	}
+
+	for _, tx := range block.transactions {
+		giveback, err := block.runTransaction(tx)
+		if giveback {
+			err := block.txPool.Push(tx)
+			if err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (block *Block) runTransaction(tx *Transaction) (bool, error) {
+	start := time.Now().Unix()
+	metricsTxExecute.Mark(1)
+
+	span := tracing.StartBoundSpan(tx.hash, "block.executeTransaction")
+	span.SetAttribute("block.height", strconv.FormatUint(block.height, 10))
+	giveback, err := block.executeTransaction(tx)
+	span.SetError(err)
+	span.End()
+	if err != nil {
+		return giveback, err
+	}
+
+	end := time.Now().Unix()
+	metricsTxExecutedTimer.Update(time.Duration(end - start))
+	return false, nil
+}
+
+// parallelGroups splits the txs into the groups touching disjoint accounts, the txs keep
+// their order in each group. It returns nil if the txs must be executed serially.
+func (block *Block) parallelGroups() []*txGroup {
+	if ParallelExecutionWorkers < 2 || len(block.transactions) < MinParallelTransactions {
+		return nil
+	}
+
+	coinbase := block.header.coinbase
+	for _, tx := range block.transactions {
+		switch tx.Type() {
+		case TxPayloadBinaryType, TxPayloadMultisigType, TxPayloadBatchType:
+		default:
+			// the contracts are able to touch any account, and the dpos txs share the dpos context.
+			return nil
+		}
+		for _, addr := range transactionAccounts(tx) {
+			// the gas is accumulated to coinbase, which must not be spent by the txs.
+			if addr.Equals(coinbase) {
+				return nil
+			}
+		}
+	}
+
+	// the gas left is checked by each tx in order, so the txs must fit in the block
+	// in any order to execute them in parallel.
+	if gasLimit := block.header.gasLimit; gasLimit > 0 {
+		gas := new(big.Int).SetUint64(block.gasUsed)
+		for _, tx := range block.transactions {
+			gas.Add(gas, tx.gasLimit.Int)
+		}
+		if gas.Cmp(new(big.Int).SetUint64(gasLimit)) > 0 {
+			return nil
+		}
+	}
+
+	// union the txs with their dependencies.
+	parent := make([]int, len(block.transactions))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i, depends := range block.TransactionsDependency() {
+		for _, j := range depends {
+			parent[find(j)] = find(i)
+		}
+	}
+
+	groups := []*txGroup{}
+	index := make(map[int]*txGroup)
+	for i, tx := range block.transactions {
+		root := find(i)
+		group, ok := index[root]
+		if !ok {
+			group = &txGroup{}
+			index[root] = group
+			groups = append(groups, group)
+		}
+		group.txs = append(group.txs, tx)
+		group.accounts = append(group.accounts, transactionAccounts(tx)...)
+	}
+	return groups
+}
+
+// executeGroups executes each group on a clone of block concurrently, returns false
+// if any tx fails.
+func (block *Block) executeGroups(groups []*txGroup) bool {
+	for _, group := range groups {
+		clone, err := block.Clone()
+		if err != nil {
+			return false
+		}
+		group.block = clone
+	}
+
+	workers := ParallelExecutionWorkers
+	if workers > len(groups) {
+		workers = len(groups)
+	}
+	jobs := make(chan *txGroup, len(groups))
+	for _, group := range groups {
+		jobs <- group
+	}
+	close(jobs)
+
+	wg := new(sync.WaitGroup)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for group := range jobs {
+				for _, tx := range group.txs {
+					if _, group.err = group.block.runTransaction(tx); group.err != nil {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, group := range groups {
+		if group.err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": block,
+				"err":   group.err,
+			}).Debug("Failed to execute txs in parallel, execute them serially.")
+			return false
+		}
+	}
+	return true
+}
+
+// mergeGroups merges the states changed by each group into block.
+func (block *Block) mergeGroups(groups []*txGroup) error {
+	// compare with the state before execution, then update the changed accounts.
+	type change struct {
+		addr  byteutils.Hash
+		bytes []byte
+	}
+	changes := []*change{}
+	reward := new(big.Int)
+	coinbase := block.accState.GetOrCreateUserAccount(block.CoinbaseHash())
+	for _, group := range groups {
+		for _, addr := range group.accounts {
+			acc, err := group.block.accState.GetContractAccount(addr.address)
+			if err != nil {
+				// the account is not created in the group.
+				continue
+			}
+			bytes, err := acc.ToBytes()
+			if err != nil {
+				return err
+			}
+			if origin, err := block.accState.GetContractAccount(addr.address); err == nil {
+				originBytes, err := origin.ToBytes()
+				if err != nil {
+					return err
+				}
+				if byteutils.Equal(bytes, originBytes) {
+					continue
+				}
+			}
+			changes = append(changes, &change{addr: addr.address, bytes: bytes})
+		}
+
+		gas := group.block.accState.GetOrCreateUserAccount(block.CoinbaseHash()).Balance()
+		reward.Add(reward, new(big.Int).Sub(gas.Int, coinbase.Balance().Int))
+	}
+
+	gasUsed := block.gasUsed
+	for _, group := range groups {
+		gasUsed += group.block.gasUsed - block.gasUsed
+	}
+	block.gasUsed = gasUsed
+
+	for _, v := range changes {
+		acc := block.accState.GetOrCreateUserAccount(v.addr)
+		if err := acc.FromBytes(v.bytes, block.storage); err != nil {
+			return err
+		}
+	}
+	coinbase.AddBalance(util.NewUint128FromBigInt(reward))
+
+	// record the txs and events in order.
+	sources := make(map[*Transaction]*Block)
+	for _, group := range groups {
+		for _, tx := range group.txs {
+			sources[tx] = group.block
+		}
+	}
+	for _, tx := range block.transactions {
+		source := sources[tx]
+		value, err := source.txsTrie.Get(tx.hash)
+		if err != nil {
+			return err
+		}
+		if _, err := block.txsTrie.Put(tx.hash, value); err != nil {
+			return err
+		}
+		events, err := source.FetchEvents(tx.hash)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			if err := block.recordEvent(tx.hash, event); err != nil {
+				return err
+			}
+		}
+		block.mergeTransactionResults(source, tx)
+	}
+	return nil
+}
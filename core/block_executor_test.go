@@ -0,0 +1,238 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlock_ParallelExecution(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	coinbase := mockAddress()
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.miner = coinbase
+
+	balance := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(10000000).Int))
+	value := util.NewUint128FromInt(1000)
+	gasLimit := util.NewUint128FromInt(200000)
+	accounts := []*Address{}
+	for i := 0; i < MinParallelTransactions; i++ {
+		addr := mockAddress()
+		block.accState.GetOrCreateUserAccount(addr.address).AddBalance(balance)
+		accounts = append(accounts, addr)
+	}
+
+	// independent transfers, a chain of dependent transfers and a failed transfer.
+	txs := Transactions{}
+	for i := 0; i < len(accounts)/2; i++ {
+		txs = append(txs, NewTransaction(bc.ChainID(), accounts[i], mockAddress(), value, 1, TxPayloadBinaryType, nil, TransactionGasPrice, gasLimit))
+	}
+	for i := len(accounts) / 2; i < len(accounts)-1; i++ {
+		txs = append(txs, NewTransaction(bc.ChainID(), accounts[i], accounts[i+1], value, 1, TxPayloadBinaryType, nil, TransactionGasPrice, gasLimit))
+	}
+	txs = append(txs, NewTransaction(bc.ChainID(), accounts[0], mockAddress(), balance, 2, TxPayloadBinaryType, nil, TransactionGasPrice, gasLimit))
+
+	serial, err := block.Clone()
+	assert.Nil(t, err)
+	block.transactions, serial.transactions = txs, txs
+
+	groups := block.parallelGroups()
+	assert.Equal(t, len(accounts)/2+1, len(groups))
+
+	defer func(workers int) { ParallelExecutionWorkers = workers }(ParallelExecutionWorkers)
+	ParallelExecutionWorkers = 1
+	assert.Nil(t, serial.execute())
+	ParallelExecutionWorkers = 4
+	assert.Nil(t, block.execute())
+
+	assert.Equal(t, serial.accState.RootHash(), block.accState.RootHash())
+	assert.Equal(t, serial.txsTrie.RootHash(), block.txsTrie.RootHash())
+	assert.Equal(t, serial.eventsTrie.RootHash(), block.eventsTrie.RootHash())
+	assert.Equal(t, serial.ExecutionErrors(), block.ExecutionErrors())
+	assert.Equal(t, 1, len(block.ExecutionErrors()))
+	assert.Equal(t, serial.GasUsed(), block.GasUsed())
+
+	// the dpos txs are executed serially.
+	block.transactions = append(txs, NewTransaction(bc.ChainID(), accounts[1], accounts[1], util.NewUint128(), 2, TxPayloadCandidateType, nil, TransactionGasPrice, TransactionMaxGas))
+	assert.Nil(t, block.parallelGroups())
+}
+
+func TestBlock_ParallelExecutionGasRefund(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	coinbase := mockAddress()
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.miner = coinbase
+	params := *bc.Params()
+	params.StorageRefundHeight = block.height
+	block.params = &params
+
+	balance := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(10000000).Int))
+	owner, sender := mockAddress(), mockAddress()
+	block.accState.GetOrCreateUserAccount(owner.address).AddBalance(balance)
+	block.accState.GetOrCreateUserAccount(sender.address).AddBalance(balance)
+
+	source := `var C = function () {};
+C.prototype = {
+	init: function () { LocalContractStorage.set("key", "a value refunded once deleted"); },
+	del: function () { LocalContractStorage.del("key"); }
+};
+module.exports = C;`
+	deploy, err := NewDeployPayload(source, "js", "").ToBytes()
+	assert.Nil(t, err)
+	deployTx := NewTransaction(bc.ChainID(), owner, owner, util.NewUint128(), 1, TxPayloadDeployType, deploy, TransactionGasPrice, TransactionMaxGas)
+	deployTx.hash, err = HashTransaction(deployTx)
+	assert.Nil(t, err)
+	_, err = block.runTransaction(deployTx)
+	assert.Nil(t, err)
+	contract, err := deployTx.GenerateContractAddress()
+	assert.Nil(t, err)
+
+	call, err := NewCallPayload("del", "").ToBytes()
+	assert.Nil(t, err)
+	callTx := NewTransaction(bc.ChainID(), owner, contract, util.NewUint128(), 2, TxPayloadCallType, call, TransactionGasPrice, TransactionMaxGas)
+	transferTx := NewTransaction(bc.ChainID(), sender, mockAddress(), util.NewUint128FromInt(1000), 1, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+	txs := Transactions{callTx, transferTx}
+	for _, tx := range txs {
+		tx.hash, err = HashTransaction(tx)
+		assert.Nil(t, err)
+	}
+
+	serial, err := block.Clone()
+	assert.Nil(t, err)
+	block.transactions, serial.transactions = txs, txs
+
+	// the contract call is executed serially in a block, run it through the parallel path directly.
+	for _, tx := range txs {
+		_, err := serial.runTransaction(tx)
+		assert.Nil(t, err)
+	}
+	groups := []*txGroup{
+		{txs: Transactions{callTx}, accounts: transactionAccounts(callTx)},
+		{txs: Transactions{transferTx}, accounts: transactionAccounts(transferTx)},
+	}
+	assert.True(t, block.executeGroups(groups))
+	assert.Nil(t, block.mergeGroups(groups))
+
+	assert.Equal(t, serial.accState.RootHash(), block.accState.RootHash())
+	assert.Equal(t, serial.txsTrie.RootHash(), block.txsTrie.RootHash())
+	assert.Equal(t, serial.eventsTrie.RootHash(), block.eventsTrie.RootHash())
+	assert.Equal(t, serial.GasUsed(), block.GasUsed())
+	assert.Equal(t, serial.gasRefunds, block.gasRefunds)
+
+	refund, ok := block.gasRefunds[callTx.hash.Hex()]
+	assert.True(t, ok)
+	assert.True(t, refund.Sign() > 0)
+	assert.Equal(t, refund.String(), block.receipts[callTx.hash.Hex()].GasRefund().String())
+}
+
+func TestBlock_CloneAndMerge(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	block, err := bc.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	block.dynastyKickouts = []string{mockAddress().String()}
+	block.recordGasRefund([]byte("tx1"), util.NewUint128FromInt(100))
+
+	clone, err := block.Clone()
+	assert.Nil(t, err)
+	assert.Equal(t, block.dynastyKickouts, clone.dynastyKickouts)
+	assert.Equal(t, block.gasRefunds, clone.gasRefunds)
+
+	// the clone records the refunds apart from the block until merged.
+	clone.recordGasRefund([]byte("tx2"), util.NewUint128FromInt(200))
+	assert.Equal(t, 1, len(block.gasRefunds))
+	block.Merge(clone)
+	assert.Equal(t, 2, len(block.gasRefunds))
+	assert.Equal(t, "200", block.gasRefunds[byteutils.Hash("tx2").Hex()].String())
+	assert.Equal(t, clone.dynastyKickouts, block.dynastyKickouts)
+}
+
+func TestBlock_ParallelExecutionMatchesSerial(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	coinbase := mockAddress()
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.miner = coinbase
+
+	balance := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(10000000).Int))
+	gasLimit := util.NewUint128FromInt(200000)
+	accounts := []*Address{}
+	for i := 0; i < MinParallelTransactions; i++ {
+		addr := mockAddress()
+		block.accState.GetOrCreateUserAccount(addr.address).AddBalance(balance)
+		accounts = append(accounts, addr)
+	}
+
+	txs := Transactions{}
+	for i := 0; i < len(accounts)-1; i++ {
+		txs = append(txs, NewTransaction(bc.ChainID(), accounts[i], mockAddress(), util.NewUint128FromInt(1000), 1, TxPayloadBinaryType, nil, TransactionGasPrice, gasLimit))
+	}
+	txs = append(txs, NewTransaction(bc.ChainID(), accounts[len(accounts)-1], mockAddress(), balance, 1, TxPayloadBinaryType, nil, TransactionGasPrice, gasLimit))
+	for _, tx := range txs {
+		tx.hash, err = HashTransaction(tx)
+		assert.Nil(t, err)
+	}
+
+	serial, err := block.Clone()
+	assert.Nil(t, err)
+	block.transactions, serial.transactions = txs, txs
+	assert.True(t, len(block.parallelGroups()) > 1)
+
+	defer func(workers int) { ParallelExecutionWorkers = workers }(ParallelExecutionWorkers)
+	ParallelExecutionWorkers = 1
+	assert.Nil(t, serial.execute())
+	ParallelExecutionWorkers = 4
+	assert.Nil(t, block.execute())
+
+	assert.Equal(t, serial.accState.RootHash(), block.accState.RootHash())
+	assert.Equal(t, serial.txsTrie.RootHash(), block.txsTrie.RootHash())
+	assert.Equal(t, serial.eventsTrie.RootHash(), block.eventsTrie.RootHash())
+	assert.Equal(t, serial.dposContext.RootHash(), block.dposContext.RootHash())
+	assert.Equal(t, serial.transactions, block.transactions)
+	assert.Equal(t, serial.GasUsed(), block.GasUsed())
+	assert.Equal(t, serial.executionErrors, block.executionErrors)
+	assert.Equal(t, 1, len(block.executionErrors))
+	assert.Equal(t, serial.gasRefunds, block.gasRefunds)
+	assert.Equal(t, serial.dynastyKickouts, block.dynastyKickouts)
+
+	// the receipts match except the execution time on this node.
+	assert.Equal(t, len(serial.receipts), len(block.receipts))
+	for _, tx := range txs {
+		expected, err := serial.receipts[tx.hash.Hex()].ToProto()
+		assert.Nil(t, err)
+		actual, err := block.receipts[tx.hash.Hex()].ToProto()
+		assert.Nil(t, err)
+		assert.Equal(t, expected, actual)
+
+		expectedEvents, err := serial.FetchEvents(tx.hash)
+		assert.Nil(t, err)
+		actualEvents, err := block.FetchEvents(tx.hash)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedEvents, actualEvents)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Bounds of the block gas limit.
+const (
+	MinBlockGasLimit     = uint64(1000000)
+	MaxBlockGasLimit     = uint64(1000000000000)
+	DefaultBlockGasLimit = uint64(100000000000)
+
+	// BlockGasLimitBoundDivisor limits the change of gas limit between blocks to less
+	// than 1/BlockGasLimitBoundDivisor of the parent's.
+	BlockGasLimitBoundDivisor = uint64(1024)
+)
+
+// GasLimit returns the max gas the txs in block are able to use, 0 means unlimited.
+func (block *Block) GasLimit() uint64 {
+	return block.header.gasLimit
+}
+
+// SetGasLimit set the gas limit of block.
+func (block *Block) SetGasLimit(gasLimit uint64) {
+	if block.sealed {
+		logging.VLog().WithFields(logrus.Fields{
+			"block": block,
+		}).Fatal("Sealed block can't be changed.")
+	}
+	block.header.gasLimit = gasLimit
+}
+
+// GasUsed returns the gas used by the executed txs in block.
+func (block *Block) GasUsed() uint64 {
+	return block.gasUsed
+}
+
+// CalcBlockGasLimit returns the gas limit of the child block of parent, which moves
+// from the parent's towards the target by less than 1/BlockGasLimitBoundDivisor of the parent's.
+func CalcBlockGasLimit(parent, target uint64) uint64 {
+	// the chains created without the block gas limit are not limited.
+	if parent == 0 || target == 0 {
+		return parent
+	}
+
+	delta := parent/BlockGasLimitBoundDivisor - 1
+	gasLimit := target
+	if target > parent+delta {
+		gasLimit = parent + delta
+	}
+	if target < parent-delta {
+		gasLimit = parent - delta
+	}
+
+	if gasLimit < MinBlockGasLimit {
+		gasLimit = MinBlockGasLimit
+	}
+	if gasLimit > MaxBlockGasLimit {
+		gasLimit = MaxBlockGasLimit
+	}
+	return gasLimit
+}
+
+// NextGasLimit returns the gas limit of the child block moving towards the target.
+// The child of an unlimited block stays unlimited until the block gas limit height,
+// from which it starts at the block gas limit in genesis params.
+func (block *Block) NextGasLimit(target uint64) uint64 {
+	if block.header.gasLimit > 0 {
+		return CalcBlockGasLimit(block.header.gasLimit, target)
+	}
+	params := block.chainParams()
+	if params.BlockGasLimitHeight > 0 && block.height+1 >= params.BlockGasLimitHeight {
+		return params.BlockGasLimit
+	}
+	return 0
+}
+
+// verifyGasLimit checks the gas limit of block is in the bounds and changed by
+// less than 1/BlockGasLimitBoundDivisor of the parent's.
+func (block *Block) verifyGasLimit(parent *Block) error {
+	gasLimit, parentGasLimit := block.header.gasLimit, parent.header.gasLimit
+	if parentGasLimit == 0 {
+		if gasLimit != parent.NextGasLimit(0) {
+			return ErrInvalidBlockGasLimit
+		}
+		return nil
+	}
+
+	if gasLimit < MinBlockGasLimit || gasLimit > MaxBlockGasLimit {
+		return ErrInvalidBlockGasLimit
+	}
+	diff := gasLimit - parentGasLimit
+	if gasLimit < parentGasLimit {
+		diff = parentGasLimit - gasLimit
+	}
+	if diff >= parentGasLimit/BlockGasLimitBoundDivisor {
+		return ErrInvalidBlockGasLimit
+	}
+	return nil
+}
+
+// checkGasLimit returns whether the tx fits in the gas left in block, the gas
+// limit of tx is checked rather than the gas used to pack and verify blocks alike.
+func (block *Block) checkGasLimit(tx *Transaction) bool {
+	if block.header.gasLimit == 0 {
+		return true
+	}
+	left := block.header.gasLimit - block.gasUsed
+	return tx.gasLimit.IsUint64() && tx.gasLimit.Uint64() <= left
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalcBlockGasLimit(t *testing.T) {
+	parent := DefaultBlockGasLimit
+	delta := parent/BlockGasLimitBoundDivisor - 1
+	tests := []struct {
+		name   string
+		parent uint64
+		target uint64
+		want   uint64
+	}{
+		{"unlimited", 0, parent, 0},
+		{"keep", parent, 0, parent},
+		{"reach", parent, parent + 100, parent + 100},
+		{"raise", parent, 2 * parent, parent + delta},
+		{"lower", parent, parent / 2, parent - delta},
+		{"min", MinBlockGasLimit, 0, MinBlockGasLimit},
+		{"bound min", MinBlockGasLimit, 1, MinBlockGasLimit},
+		{"bound max", MaxBlockGasLimit, 2 * MaxBlockGasLimit, MaxBlockGasLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CalcBlockGasLimit(tt.parent, tt.target))
+		})
+	}
+}
+
+func TestBlock_VerifyGasLimit(t *testing.T) {
+	parent := &Block{header: &BlockHeader{gasLimit: DefaultBlockGasLimit}}
+	block := &Block{header: &BlockHeader{}}
+
+	block.header.gasLimit = CalcBlockGasLimit(DefaultBlockGasLimit, MaxBlockGasLimit)
+	assert.Nil(t, block.verifyGasLimit(parent))
+	block.header.gasLimit = CalcBlockGasLimit(DefaultBlockGasLimit, MinBlockGasLimit)
+	assert.Nil(t, block.verifyGasLimit(parent))
+	block.header.gasLimit = DefaultBlockGasLimit + DefaultBlockGasLimit/BlockGasLimitBoundDivisor
+	assert.Equal(t, ErrInvalidBlockGasLimit, block.verifyGasLimit(parent))
+	block.header.gasLimit = 0
+	assert.Equal(t, ErrInvalidBlockGasLimit, block.verifyGasLimit(parent))
+
+	// the chains without gas limit stay unlimited.
+	parent.header.gasLimit = 0
+	assert.Nil(t, block.verifyGasLimit(parent))
+	block.header.gasLimit = DefaultBlockGasLimit
+	assert.Equal(t, ErrInvalidBlockGasLimit, block.verifyGasLimit(parent))
+}
+
+func TestBlock_CheckGasLimit(t *testing.T) {
+	block := &Block{header: &BlockHeader{gasLimit: MinBlockGasLimit}}
+	tx := NewTransaction(1, mockAddress(), mockAddress(), util.NewUint128(), 1, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(int64(MinBlockGasLimit)))
+	assert.True(t, block.checkGasLimit(tx))
+	block.gasUsed = 1
+	assert.False(t, block.checkGasLimit(tx))
+
+	block.header.gasLimit = 0
+	assert.True(t, block.checkGasLimit(tx))
+}
+
+func TestBlockChain_SyncUnlimitedBlocks(t *testing.T) {
+	// an existing chain created without block_gas_limit in genesis conf.
+	neb := testNeb()
+	neb.genesis.Params = &corepb.GenesisParams{BlockGasLimitHeight: 4}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	assert.Equal(t, uint64(0), bc.genesisBlock.GasLimit())
+
+	coinbase := mockAddress()
+	for i := int64(2); i <= 5; i++ {
+		block, err := bc.NewBlock(coinbase)
+		assert.Nil(t, err)
+		block.header.timestamp = DefaultBlockInterval * i
+		block.SetMiner(coinbase)
+		if block.Height() < 4 {
+			// the blocks synced from the network are unlimited before the activation.
+			assert.Equal(t, uint64(0), block.GasLimit())
+		} else {
+			assert.NotEqual(t, uint64(0), block.GasLimit())
+			unlimited := &Block{header: &BlockHeader{}, height: block.Height()}
+			assert.Equal(t, ErrInvalidBlockGasLimit, unlimited.verifyGasLimit(bc.TailBlock()))
+		}
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+	}
+	assert.Equal(t, DefaultBlockGasLimit, bc.GetBlockOnCanonicalChainByHeight(4).GasLimit())
+}
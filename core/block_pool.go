@@ -21,6 +21,7 @@ package core
 import (
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -41,6 +42,10 @@ const (
 // BlockPool a pool of all received blocks from network.
 // Blocks will be sent to Consensus when it passes signature verification.
 type BlockPool struct {
+	// bestHeight is the max height of the verified blocks received, it's accessed
+	// atomically and kept first for 64-bit alignment.
+	bestHeight uint64
+
 	size                          int
 	receiveBlockMessageCh         chan net.Message
 	receiveDownloadBlockMessageCh chan net.Message
@@ -141,6 +146,7 @@ func (pool *BlockPool) handleBlock(msg net.Message) {
 			"msg":     msg,
 			"err":     err,
 		}).Debug("Failed to unmarshal data.")
+		pool.reportPeer(msg.MessageFrom(), p2p.MisbehaviorMalformedMessage)
 		return
 	}
 	if err := block.FromProto(pbblock); err != nil {
@@ -149,6 +155,7 @@ func (pool *BlockPool) handleBlock(msg net.Message) {
 			"msg":     msg,
 			"err":     err,
 		}).Debug("Failed to recover a block from proto data.")
+		pool.reportPeer(msg.MessageFrom(), p2p.MisbehaviorMalformedMessage)
 		return
 	}
 
@@ -350,7 +357,7 @@ func (pool *BlockPool) download(sender string, block *Block) error {
 		"block":  block,
 		"tail":   pool.bc.TailBlock(),
 		"gap":    strconv.Itoa(int(block.Timestamp()-pool.bc.TailBlock().Timestamp())) + "s",
-		"limit":  strconv.Itoa(int(DynastyInterval)) + "s",
+		"limit":  strconv.Itoa(int(pool.bc.params.DynastyInterval)) + "s",
 	}).Info("Send download request.")
 
 	return nil
@@ -381,8 +388,22 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 			"block": block,
 			"err":   err,
 		}).Debug("Failed to check block integrity.")
+		pool.reportPeer(sender, p2p.MisbehaviorInvalidBlock)
+		return err
+	}
+
+	// verify block not contradicting checkpoints
+	if err := pool.bc.VerifyCheckpoints(block); err != nil {
+		metricsInvalidBlock.Inc(1)
+		logging.VLog().WithFields(logrus.Fields{
+			"block":  block,
+			"sender": sender,
+			"err":    err,
+		}).Warn("Found block contradicting the checkpoint.")
+		pool.reportPeer(sender, p2p.MisbehaviorInvalidBlock)
 		return err
 	}
+	pool.updateBestHeight(block.Height())
 	// checkIntegrityAt := time.Now().Unix()
 
 	bc := pool.bc
@@ -398,6 +419,7 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 			"preBlock": preBlock.(*Block),
 			"sender":   sender,
 		}).Warn("Found someone minted multiple blocks at same time.")
+		pool.reportDoubleMint(preBlock.(*Block), lb.block)
 		return ErrDoubleBlockMinted
 	}
 	pool.slot.Add(lb.block.Timestamp(), lb.block)
@@ -457,7 +479,7 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 					"tail":    bc.tailBlock,
 					"block":   block,
 					"offline": strconv.Itoa(int(lb.block.Timestamp()-bc.TailBlock().Timestamp())) + "s",
-					"limit":   strconv.Itoa(int(DynastyInterval)) + "s",
+					"limit":   strconv.Itoa(int(bc.params.DynastyInterval)) + "s",
 				}).Warn("Offline too long, pend mining and restart sync from others.")
 			}
 			return ErrInvalidBlockCannotFindParentInLocalAndTrySync
@@ -514,6 +536,21 @@ func (pool *BlockPool) push(sender string, block *Block) error {
 	return nil
 }
 
+// BestHeight return the max height of the verified blocks received, it's the best
+// known height of the peers while syncing.
+func (pool *BlockPool) BestHeight() uint64 {
+	return atomic.LoadUint64(&pool.bestHeight)
+}
+
+func (pool *BlockPool) updateBestHeight(height uint64) {
+	for {
+		best := atomic.LoadUint64(&pool.bestHeight)
+		if height <= best || atomic.CompareAndSwapUint64(&pool.bestHeight, best, height) {
+			return
+		}
+	}
+}
+
 func (pool *BlockPool) setBlockChain(bc *BlockChain) {
 	pool.bc = bc
 }
@@ -589,3 +626,30 @@ func (lb *linkedBlock) Dispose() {
 	lb.parentBlock = nil
 	lb.childBlocks = nil
 }
+
+// reportPeer reports the misbehavior of the sender, the blocks minted locally have no sender.
+func (pool *BlockPool) reportPeer(sender string, misbehavior p2p.Misbehavior) {
+	if pool.nm == nil || len(sender) == 0 {
+		return
+	}
+	pool.nm.ReportPeer(sender, misbehavior)
+}
+
+// reportDoubleMint emits the slash payload if the blocks are signed by the same miner.
+func (pool *BlockPool) reportDoubleMint(first, second *Block) {
+	if first.miner == nil || second.miner == nil || !first.miner.Equals(second.miner) {
+		return
+	}
+	payload, err := NewSlashPayload(first, second)
+	if err != nil {
+		return
+	}
+	data, err := payload.ToBytes()
+	if err != nil {
+		return
+	}
+	pool.bc.eventEmitter.Trigger(&Event{
+		Topic: TopicDoubleMint,
+		Data:  string(data),
+	})
+}
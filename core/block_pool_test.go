@@ -91,6 +91,8 @@ func (n MockNetManager) SendMessageToPeer(messageName string, data []byte, prior
 
 func (n MockNetManager) ClosePeer(peerID string, reason error) {}
 
+func (n MockNetManager) ReportPeer(peerID string, misbehavior p2p.Misbehavior) {}
+
 func (n MockNetManager) BroadcastNetworkID([]byte) {}
 
 func (n MockNetManager) BuildRawMessageData([]byte, string) []byte { return nil }
@@ -129,31 +131,31 @@ func TestBlockPool(t *testing.T) {
 	addr := &Address{validators[1]}
 	block0, err := NewBlock(bc.ChainID(), addr, bc.tailBlock)
 	assert.Nil(t, err)
-	block0.header.timestamp = bc.tailBlock.header.timestamp + BlockInterval
+	block0.header.timestamp = bc.tailBlock.header.timestamp + DefaultBlockInterval
 	block0.SetMiner(addr)
 	block0.Seal()
 
 	addr = &Address{validators[2]}
 	block1, _ := NewBlock(bc.ChainID(), addr, block0)
-	block1.header.timestamp = block0.header.timestamp + BlockInterval
+	block1.header.timestamp = block0.header.timestamp + DefaultBlockInterval
 	block1.SetMiner(addr)
 	block1.Seal()
 
 	addr = &Address{validators[3]}
 	block2, _ := NewBlock(bc.ChainID(), addr, block1)
-	block2.header.timestamp = block1.header.timestamp + BlockInterval
+	block2.header.timestamp = block1.header.timestamp + DefaultBlockInterval
 	block2.SetMiner(addr)
 	block2.Seal()
 
 	addr = &Address{validators[4]}
 	block3, _ := NewBlock(bc.ChainID(), addr, block2)
-	block3.header.timestamp = block2.header.timestamp + BlockInterval
+	block3.header.timestamp = block2.header.timestamp + DefaultBlockInterval
 	block3.SetMiner(addr)
 	block3.Seal()
 
 	addr = &Address{validators[5]}
 	block4, _ := NewBlock(bc.ChainID(), addr, block3)
-	block4.header.timestamp = block3.header.timestamp + BlockInterval
+	block4.header.timestamp = block3.header.timestamp + DefaultBlockInterval
 	block4.SetMiner(addr)
 	block4.Seal()
 
@@ -182,7 +184,7 @@ func TestBlockPool(t *testing.T) {
 
 	addr = &Address{validators[0]}
 	block5, _ := NewBlock(bc.ChainID(), addr, block4)
-	block5.header.timestamp = block4.header.timestamp + BlockInterval
+	block5.header.timestamp = block4.header.timestamp + DefaultBlockInterval
 	block5.SetMiner(addr)
 	block5.Seal()
 	block5.header.hash[0]++
@@ -190,7 +192,7 @@ func TestBlockPool(t *testing.T) {
 
 	addr = &Address{validators[1]}
 	block41, _ := NewBlock(bc.ChainID(), addr, block3)
-	block41.header.timestamp = block3.header.timestamp + BlockInterval
+	block41.header.timestamp = block3.header.timestamp + DefaultBlockInterval
 	block41.SetMiner(addr)
 	block41.Seal()
 	assert.Equal(t, pool.Push(block41), ErrDoubleBlockMinted)
@@ -0,0 +1,121 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// rewardEpoch gives the reward to the coinbase of the blocks from the start height until
+// the next epoch, the reward halves every halving interval blocks if it's specified.
+type rewardEpoch struct {
+	start   uint64
+	reward  *big.Int
+	halving uint64
+}
+
+// DefaultRewardSchedule gives BlockReward to every block.
+func DefaultRewardSchedule() []*corepb.GenesisRewardEpoch {
+	return []*corepb.GenesisRewardEpoch{{StartHeight: 0, Reward: BlockReward.String()}}
+}
+
+func validateRewardSchedule(schedule []*corepb.GenesisRewardEpoch) error {
+	if len(schedule) == 0 {
+		return ErrInvalidGenesisRewardSchedule
+	}
+	for i, v := range schedule {
+		if i > 0 && v.StartHeight <= schedule[i-1].StartHeight {
+			return ErrInvalidGenesisRewardSchedule
+		}
+		if _, ok := parseGenesisAmount(v.Reward); !ok {
+			return ErrInvalidGenesisRewardSchedule
+		}
+	}
+	return nil
+}
+
+func (epoch *rewardEpoch) rewardAt(height uint64) *big.Int {
+	reward := new(big.Int).Set(epoch.reward)
+	if epoch.halving > 0 {
+		halvings := (height - epoch.start) / epoch.halving
+		if halvings >= uint64(reward.BitLen()) {
+			return new(big.Int)
+		}
+		reward.Rsh(reward, uint(halvings))
+	}
+	return reward
+}
+
+// sum returns the total reward of the blocks in [start, end] of the epoch.
+func (epoch *rewardEpoch) sum(start, end uint64) *big.Int {
+	if epoch.halving == 0 {
+		return new(big.Int).Mul(epoch.reward, new(big.Int).SetUint64(end-start+1))
+	}
+	total := new(big.Int)
+	for height := start; height <= end; {
+		reward := epoch.rewardAt(height)
+		if reward.Sign() == 0 {
+			break
+		}
+		periodEnd := epoch.start + ((height-epoch.start)/epoch.halving+1)*epoch.halving - 1
+		if periodEnd > end || periodEnd < height {
+			periodEnd = end
+		}
+		total.Add(total, reward.Mul(reward, new(big.Int).SetUint64(periodEnd-height+1)))
+		if periodEnd == end {
+			break
+		}
+		height = periodEnd + 1
+	}
+	return total
+}
+
+// BlockRewardAt returns the reward given to the coinbase of the block at the height.
+func (params *ChainParams) BlockRewardAt(height uint64) *util.Uint128 {
+	schedule := params.rewardSchedule
+	for i := len(schedule) - 1; i >= 0; i-- {
+		if height >= schedule[i].start {
+			return util.NewUint128FromBigInt(schedule[i].rewardAt(height))
+		}
+	}
+	return util.NewUint128()
+}
+
+// TotalBlockReward returns the total reward given to the blocks in [from, to].
+func (params *ChainParams) TotalBlockReward(from, to uint64) *big.Int {
+	schedule := params.rewardSchedule
+	total := new(big.Int)
+	for i, epoch := range schedule {
+		start, end := from, to
+		if start < epoch.start {
+			start = epoch.start
+		}
+		if i+1 < len(schedule) && end >= schedule[i+1].start {
+			end = schedule[i+1].start - 1
+		}
+		if start > end {
+			continue
+		}
+		total.Add(total, epoch.sum(start, end))
+	}
+	return total
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockRewardSchedule(t *testing.T) {
+	params := DefaultChainParams()
+	assert.Equal(t, BlockReward.String(), params.BlockRewardAt(2).String())
+
+	schedule := []*corepb.GenesisRewardEpoch{
+		{StartHeight: 2, Reward: "1000", HalvingInterval: 10},
+		{StartHeight: 50, Reward: "30"},
+	}
+	assert.Nil(t, validateRewardSchedule(schedule))
+	params = NewChainParams(&corepb.GenesisParams{RewardSchedule: schedule})
+
+	assert.Equal(t, "0", params.BlockRewardAt(1).String())
+	assert.Equal(t, "1000", params.BlockRewardAt(2).String())
+	assert.Equal(t, "1000", params.BlockRewardAt(11).String())
+	assert.Equal(t, "500", params.BlockRewardAt(12).String())
+	assert.Equal(t, "62", params.BlockRewardAt(49).String())
+	assert.Equal(t, "30", params.BlockRewardAt(50).String())
+
+	for _, r := range [][2]uint64{{1, 1}, {2, 100}, {7, 33}, {12, 12}, {40, 60}} {
+		expected := new(big.Int)
+		for h := r[0]; h <= r[1]; h++ {
+			expected.Add(expected, params.BlockRewardAt(h).Int)
+		}
+		assert.Equal(t, expected.String(), params.TotalBlockReward(r[0], r[1]).String())
+	}
+
+	assert.Equal(t, ErrInvalidGenesisRewardSchedule, validateRewardSchedule(nil))
+	assert.Equal(t, ErrInvalidGenesisRewardSchedule, validateRewardSchedule([]*corepb.GenesisRewardEpoch{
+		{StartHeight: 2, Reward: "1000"},
+		{StartHeight: 2, Reward: "30"},
+	}))
+	assert.Equal(t, ErrInvalidGenesisRewardSchedule, validateRewardSchedule([]*corepb.GenesisRewardEpoch{
+		{StartHeight: 2, Reward: "-1"},
+	}))
+}
@@ -26,8 +26,10 @@ import (
 	"github.com/nebulasio/go-nebulas/util/logging"
 
 	pb "github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
@@ -185,7 +187,7 @@ func TestBlock_LinkParentBlock(t *testing.T) {
 			},
 			nonce:     3546456,
 			coinbase:  &Address{[]byte("hello")},
-			timestamp: BlockInterval,
+			timestamp: DefaultBlockInterval,
 			chainID:   100,
 		},
 		transactions: []*Transaction{},
@@ -208,7 +210,7 @@ func TestBlock_LinkParentBlock(t *testing.T) {
 			},
 			nonce:     3546456,
 			coinbase:  &Address{[]byte("hello")},
-			timestamp: BlockInterval * 2,
+			timestamp: DefaultBlockInterval * 2,
 			chainID:   100,
 		},
 		transactions: []*Transaction{},
@@ -243,14 +245,14 @@ func TestBlock_CollectTransactions(t *testing.T) {
 	coinbase, _ := NewAddressFromPublicKey(pubdata2)
 
 	block0, _ := NewBlock(bc.ChainID(), from, tail)
-	block0.header.timestamp = BlockInterval
+	block0.header.timestamp = DefaultBlockInterval
 	block0.SetMiner(from)
 	block0.Seal()
 	//bc.BlockPool().push(block0)
 	bc.SetTailBlock(block0)
 
 	block, _ := NewBlock(bc.ChainID(), coinbase, block0)
-	block.header.timestamp = BlockInterval * 2
+	block.header.timestamp = DefaultBlockInterval * 2
 
 	tx1 := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 1, TxPayloadBinaryType, []byte("nas"), TransactionGasPrice, util.NewUint128FromInt(200000))
 	tx1.Sign(signature)
@@ -327,14 +329,14 @@ func TestBlock_DposCandidates(t *testing.T) {
 	coinbase, _ := NewAddressFromPublicKey(pubdata2)
 
 	block0, _ := NewBlock(bc.ChainID(), from, tail)
-	block0.header.timestamp = BlockInterval
+	block0.header.timestamp = DefaultBlockInterval
 	block0.SetMiner(from)
 	block0.Seal()
 	assert.Nil(t, bc.storeBlockToStorage(block0))
 	assert.Nil(t, bc.SetTailBlock(block0))
 
 	block, _ := NewBlock(bc.ChainID(), coinbase, block0)
-	block.header.timestamp = BlockInterval * 2
+	block.header.timestamp = DefaultBlockInterval * 2
 	bytes, _ := NewCandidatePayload(LoginAction).ToBytes()
 	tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 1, TxPayloadCandidateType, bytes, TransactionGasPrice, util.NewUint128FromInt(200000))
 	tx.Sign(signature)
@@ -365,7 +367,7 @@ func TestBlock_DposCandidates(t *testing.T) {
 	assert.Nil(t, bc.SetTailBlock(block))
 
 	block, _ = NewBlock(bc.ChainID(), coinbase, block)
-	block.header.timestamp = BlockInterval * 3
+	block.header.timestamp = DefaultBlockInterval * 3
 	payload = NewDelegatePayload(UnDelegateAction, from.String())
 	bytes, _ = payload.ToBytes()
 	tx = NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 3, TxPayloadDelegateType, bytes, TransactionGasPrice, util.NewUint128FromInt(200000))
@@ -392,7 +394,7 @@ func TestBlock_DposCandidates(t *testing.T) {
 	assert.Nil(t, bc.SetTailBlock(block))
 
 	block, _ = NewBlock(bc.ChainID(), coinbase, block)
-	block.header.timestamp = BlockInterval * 4
+	block.header.timestamp = DefaultBlockInterval * 4
 	payload = NewDelegatePayload(DelegateAction, from.String())
 	bytes, _ = payload.ToBytes()
 	tx = NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(1), 4, TxPayloadDelegateType, bytes, TransactionGasPrice, util.NewUint128FromInt(200000))
@@ -510,6 +512,25 @@ func TestRecordEvent(t *testing.T) {
 	assert.Equal(t, events[0].Data, "world")
 }
 
+func TestRecordIndexedEvent(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+
+	// the indexed params are dropped before the indexed event height.
+	txHash := []byte("hello")
+	assert.Nil(t, bc.tailBlock.RecordIndexedEvent(txHash, TopicSendTransaction, "world", []string{"to"}))
+	params := *bc.Params()
+	params.IndexedEventHeight = bc.tailBlock.Height()
+	bc.tailBlock.params = &params
+	assert.Nil(t, bc.tailBlock.RecordIndexedEvent(txHash, TopicSendTransaction, "world", []string{"to"}))
+
+	events, err := bc.tailBlock.FetchEvents(txHash)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(events))
+	assert.Nil(t, events[0].Indexed)
+	assert.Equal(t, []string{"to"}, events[1].Indexed)
+}
+
 func TestBlockVerifyIntegrity(t *testing.T) {
 	var cons MockConsensus
 	bc, err := NewBlockChain(testNeb())
@@ -630,3 +651,89 @@ func TestBlockVerifyState(t *testing.T) {
 	block.header.stateRoot[0]++
 	assert.NotNil(t, block.VerifyExecution(bc.tailBlock, bc.ConsensusHandler()))
 }
+
+func TestBlock_TransactionsDependency(t *testing.T) {
+	a, b, c, d := mockAddress(), mockAddress(), mockAddress(), mockAddress()
+	block := &Block{
+		transactions: Transactions{
+			NewTransaction(1, a, b, util.NewUint128(), 1, TxPayloadBinaryType, nil, TransactionGasPrice, TransactionMaxGas),
+			NewTransaction(1, c, d, util.NewUint128(), 1, TxPayloadBinaryType, nil, TransactionGasPrice, TransactionMaxGas),
+			NewTransaction(1, b, c, util.NewUint128(), 1, TxPayloadBinaryType, nil, TransactionGasPrice, TransactionMaxGas),
+			NewTransaction(1, a, a, util.NewUint128(), 2, TxPayloadBinaryType, nil, TransactionGasPrice, TransactionMaxGas),
+			NewTransaction(1, d, d, util.NewUint128(), 2, TxPayloadCandidateType, nil, TransactionGasPrice, TransactionMaxGas),
+			NewTransaction(1, b, b, util.NewUint128(), 2, TxPayloadDelegateType, nil, TransactionGasPrice, TransactionMaxGas),
+		},
+	}
+	assert.Equal(t, [][]int{{}, {}, {0, 1}, {0}, {1}, {0, 2, 4}}, block.TransactionsDependency())
+}
+
+func TestBlock_ProveAccount(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	block := bc.tailBlock
+
+	addr, err := AddressParse("1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c")
+	assert.Nil(t, err)
+	proof, err := block.ProveAccount(addr.Bytes())
+	assert.Nil(t, err)
+
+	stateTrie, err := trie.NewTrie(nil, block.storage)
+	assert.Nil(t, err)
+	assert.Nil(t, stateTrie.Verify(block.StateRoot(), addr.Bytes(), proof))
+
+	_, err = block.ProveAccount(mockAddress().Bytes())
+	assert.NotNil(t, err)
+}
+
+func TestBlock_AncestorHash(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	genesis := bc.tailBlock
+	coinbase := mockAddress()
+
+	block1, err := NewBlock(bc.ChainID(), coinbase, genesis)
+	assert.Nil(t, err)
+	assert.Nil(t, block1.Seal())
+	block2, err := NewBlock(bc.ChainID(), coinbase, block1)
+	assert.Nil(t, err)
+	assert.Nil(t, block2.Seal())
+
+	hash, err := block2.AncestorHash(block1.Height())
+	assert.Nil(t, err)
+	assert.Equal(t, block1.Hash(), hash)
+	hash, err = block2.AncestorHash(genesis.Height())
+	assert.Nil(t, err)
+	assert.Equal(t, genesis.Hash(), hash)
+
+	_, err = block2.AncestorHash(block2.Height())
+	assert.Equal(t, ErrInvalidAncestorHeight, err)
+
+	// unlinked ancestors are read from storage.
+	assert.Nil(t, bc.storeBlockToStorage(block1))
+	block2.parenetBlock = nil
+	hash, err = block2.AncestorHash(genesis.Height())
+	assert.Nil(t, err)
+	assert.Equal(t, genesis.Hash(), hash)
+}
+
+func TestBlock_RecoverAddress(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	block := bc.tailBlock
+	ks := keystore.DefaultKS
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signer := mockAddress()
+	key, _ := ks.GetUnlocked(signer.String())
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	msg := hash.Sha3256([]byte("nebulas"))
+	sign, err := signature.Sign(msg)
+	assert.Nil(t, err)
+
+	addr, err := block.RecoverAddress(uint8(keystore.SECP256K1), msg, sign)
+	assert.Nil(t, err)
+	assert.Equal(t, signer.String(), addr)
+
+	_, err = block.RecoverAddress(0, msg, sign)
+	assert.NotNil(t, err)
+}
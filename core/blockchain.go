@@ -19,13 +19,17 @@
 package core
 
 import (
+	"context"
+	"encoding/json"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -36,9 +40,15 @@ import (
 // storage: key -> value
 // scheme -> scheme version
 // genesis hash -> genesis block
+// genesis_params -> genesis params
 // blockchain_tail -> tail block hash
 // block hash -> block
 // height -> block hash
+// execution_result_ + tx hash -> tx execution error
+// block_stats_ + block hash -> accumulated block stats
+// timestamp_ + block slot -> height
+// event_cursor_ + consumer -> height + event index
+// event_bloom_ + block hash -> bloom of event topics and contracts
 
 // BlockChain the BlockChain core type.
 type BlockChain struct {
@@ -49,6 +59,9 @@ type BlockChain struct {
 	genesisBlock *Block
 	tailBlock    *Block
 
+	tailSnapshot *Block
+	snapshotLock sync.RWMutex
+
 	bkPool *BlockPool
 	txPool *TransactionPool
 
@@ -59,12 +72,26 @@ type BlockChain struct {
 	detachedTailBlocks *lru.Cache
 
 	latestIrreversibleBlock *Block
+	libConfirmations        int
 
 	storage storage.Storage
 	neb     Neblet
 
 	eventEmitter *EventEmitter
 
+	executionResultLog bool
+	accountHistory     bool
+
+	reorgResubmit bool
+	miner         string
+
+	mode   string
+	pruner *statePruner
+
+	params *ChainParams
+
+	checkpoints map[uint64]byteutils.Hash
+
 	quitCh chan int
 }
 
@@ -83,6 +110,34 @@ const (
 
 	// LIB (latest irreversible block) in storage
 	LIB = "blockchain_lib"
+
+	// GenesisParamsKey is the key of the genesis params in storage
+	GenesisParamsKey = "genesis_params"
+
+	// ExecutionResultPrefix is the key prefix of tx execution results in storage, keyed by
+	// the hash of block and tx, so that the results of the reverted blocks are never read.
+	ExecutionResultPrefix = "execution_result_"
+
+	// BlockStatsPrefix is the key prefix of accumulated block stats in storage
+	BlockStatsPrefix = "block_stats_"
+
+	// TimestampIndexPrefix is the key prefix of the block slot to height index in storage
+	TimestampIndexPrefix = "timestamp_"
+
+	// EventCursorPrefix is the key prefix of the event cursors of consumers in storage
+	EventCursorPrefix = "event_cursor_"
+
+	// EventBloomPrefix is the key prefix of the event bloom filters of blocks in storage
+	EventBloomPrefix = "event_bloom_"
+
+	// ReceiptPrefix is the key prefix of tx receipts in storage
+	ReceiptPrefix = "receipt_"
+
+	// BalanceChangesPrefix is the key prefix of the balance changes of blocks in storage
+	BalanceChangesPrefix = "balance_changes_"
+
+	// AccountHistoryPrefix is the key prefix of the balance change history of accounts in storage
+	AccountHistoryPrefix = "account_history_"
 )
 
 // NewBlockChain create new #BlockChain instance.
@@ -91,7 +146,11 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 	if err != nil {
 		return nil, err
 	}
-	txPool, err := NewTransactionPool(40960)
+	txPoolSize := int(neb.Config().Chain.TxPoolSize)
+	if txPoolSize == 0 {
+		txPoolSize = DefaultTxPoolSize
+	}
+	txPool, err := NewTransactionPool(txPoolSize)
 	if err != nil {
 		return nil, err
 	}
@@ -106,6 +165,28 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 		neb:          neb,
 		eventEmitter: neb.EventEmitter(),
 		quitCh:       make(chan int, 1),
+
+		executionResultLog: neb.Config().Chain.ExecutionResultLog,
+		accountHistory:     neb.Config().Chain.AccountHistory,
+		reorgResubmit:      neb.Config().Chain.ReorgResubmit,
+		miner:              neb.Config().Chain.Miner,
+	}
+
+	bc.checkpoints, err = loadCheckpoints(neb.Config().Chain.Checkpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	bc.mode, err = nodeMode(neb.Config().Chain)
+	if err != nil {
+		return nil, err
+	}
+	switch bc.mode {
+	case FullNodeMode:
+		bc.pruner = newStatePruner(bc.storage, neb.Config().Chain.StatePruneBlocks)
+	}
+	if bc.pruner != nil {
+		bc.storage = bc.pruner.storage
 	}
 
 	bc.cachedBlocks, _ = lru.NewWithEvict(4096, func(key interface{}, value interface{}) {
@@ -131,10 +212,35 @@ func NewBlockChain(neb Neblet) (*BlockChain, error) {
 		return nil, err
 	}
 
+	params := GenesisParams(neb.Genesis())
+	if err := validateGenesisParams(params); err != nil {
+		return nil, err
+	}
+	bc.params = NewChainParams(params)
+
+	bc.libConfirmations, err = libConfirmations(bc.params, neb.Config().Chain.LibConfirmations)
+	if err != nil {
+		return nil, err
+	}
+	if v := neb.Config().Chain.TypescriptVersion; len(v) > 0 && v != nvm.TypeScriptCompilerVersion {
+		logging.CLog().WithFields(logrus.Fields{
+			"pinned":  v,
+			"bundled": nvm.TypeScriptCompilerVersion,
+		}).Error("Found unmatched TypeScript compiler version.")
+		return nil, ErrTypeScriptVersionMismatch
+	}
+	// the memory and instruction limits of the node apply to the local calls and estimates only.
+	nvm.SetExecutionLimits(nvm.ExecutionLimits{
+		Timeout:         time.Duration(neb.Config().Chain.NvmExecutionTimeout) * time.Millisecond,
+		TotalMemorySize: neb.Config().Chain.NvmMemoryLimit,
+		Instructions:    neb.Config().Chain.NvmInstructionLimit,
+	})
+
 	logging.CLog().WithFields(logrus.Fields{
 		"meta.chainid":           neb.Genesis().Meta.ChainId,
 		"consensus.dpos.dynasty": neb.Genesis().Consensus.Dpos.Dynasty,
 		"token.distribution":     neb.Genesis().TokenDistribution,
+		"params":                 params,
 	}).Info("Genesis Configuration.")
 
 	bc.genesisBlock, err = bc.loadGenesisFromStorage()
@@ -187,6 +293,10 @@ func (bc *BlockChain) loop() {
 			return
 		case <-timerChan:
 			bc.updateLatestIrreversibleBlock(bc.tailBlock)
+			metricsBlockForksGauge.Update(int64(len(bc.Forks(DefaultForkDepth))))
+			if bc.pruner != nil {
+				bc.pruner.prune(bc)
+			}
 		}
 	}
 }
@@ -210,6 +320,10 @@ func (bc *BlockChain) CheckChainConfig(neb Neblet) error {
 			return ErrGenesisConfNotMatch
 		}
 
+		if !proto.Equal(GenesisParams(neb.Genesis()), GenesisParams(genesis)) {
+			return ErrGenesisConfNotMatch
+		}
+
 		// check dpos equal
 		for _, confDposAddr := range neb.Genesis().Consensus.Dpos.Dynasty {
 			contains := false
@@ -249,6 +363,11 @@ func (bc *BlockChain) ChainID() uint32 {
 	return bc.chainID
 }
 
+// Params return the chain params set by the genesis.
+func (bc *BlockChain) Params() *ChainParams {
+	return bc.params
+}
+
 // Storage return the storage.
 func (bc *BlockChain) Storage() storage.Storage {
 	return bc.storage
@@ -274,22 +393,80 @@ func (bc *BlockChain) EventEmitter() *EventEmitter {
 	return bc.eventEmitter
 }
 
-func (bc *BlockChain) revertBlocks(from *Block, to *Block) error {
+// reorgEvent is the data of the chain reorganization event.
+type reorgEvent struct {
+	OldTail        string   `json:"old_tail"`
+	OldHeight      uint64   `json:"old_height"`
+	NewTail        string   `json:"new_tail"`
+	NewHeight      uint64   `json:"new_height"`
+	Ancestor       string   `json:"common_ancestor"`
+	AncestorHeight uint64   `json:"common_ancestor_height"`
+	RevertedTxs    []string `json:"reverted_txs"`
+}
+
+// triggerReorgEvent triggers the reorg event, the txs in reverted blocks but not
+// in the new canonical blocks are reported as reverted.
+func (bc *BlockChain) triggerReorgEvent(oldTail, newTail, ancestor *Block, reverted []*Block) {
+	included := make(map[byteutils.HexHash]bool)
+	for block := newTail; block != nil && !block.Hash().Equals(ancestor.Hash()); block = bc.GetBlock(block.ParentHash()) {
+		for _, tx := range block.transactions {
+			included[tx.Hash().Hex()] = true
+		}
+	}
+
+	reorg := &reorgEvent{
+		OldTail:        oldTail.Hash().String(),
+		OldHeight:      oldTail.Height(),
+		NewTail:        newTail.Hash().String(),
+		NewHeight:      newTail.Height(),
+		Ancestor:       ancestor.Hash().String(),
+		AncestorHeight: ancestor.Height(),
+		RevertedTxs:    []string{},
+	}
+	for _, block := range reverted {
+		for _, tx := range block.transactions {
+			if !included[tx.Hash().Hex()] {
+				reorg.RevertedTxs = append(reorg.RevertedTxs, tx.Hash().String())
+			}
+		}
+	}
+
+	data, err := json.Marshal(reorg)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to marshal the reorg event.")
+		return
+	}
+	bc.eventEmitter.Trigger(&Event{
+		Topic: TopicReorg,
+		Data:  string(data),
+	})
+}
+
+// revertBlocks reverts the blocks in (from, to] and returns them.
+func (bc *BlockChain) revertBlocks(from *Block, to *Block) ([]*Block, error) {
+	var blocks []*Block
 	reverted := to
 	var revertTimes int64
 	for revertTimes = 0; !reverted.Hash().Equals(from.Hash()); {
 		if reverted.Hash().Equals(bc.latestIrreversibleBlock.Hash()) {
-			return ErrCannotRevertLIB
+			return nil, ErrCannotRevertLIB
+		}
+		if bc.reorgResubmit && reverted.miner != nil && reverted.miner.String() == bc.miner {
+			reverted.ResubmitTransactions()
+		} else {
+			reverted.ReturnTransactions()
 		}
-		reverted.ReturnTransactions()
 		logging.VLog().WithFields(logrus.Fields{
 			"block": reverted,
 		}).Warn("A block is reverted.")
 		revertTimes++
+		blocks = append(blocks, reverted)
 
 		reverted = bc.GetBlock(reverted.header.parentHash)
 		if reverted == nil {
-			return ErrMissingParentBlock
+			return nil, ErrMissingParentBlock
 		}
 	}
 	// record count of reverted blocks
@@ -297,7 +474,7 @@ func (bc *BlockChain) revertBlocks(from *Block, to *Block) error {
 		metricsBlockRevertTimesGauge.Update(revertTimes)
 		metricsBlockRevertMeter.Mark(1)
 	}
-	return nil
+	return blocks, nil
 }
 
 func (bc *BlockChain) buildIndexByBlockHeight(from *Block, to *Block) error {
@@ -306,6 +483,13 @@ func (bc *BlockChain) buildIndexByBlockHeight(from *Block, to *Block) error {
 		if err != nil {
 			return err
 		}
+		err = bc.storage.Put(bc.timestampIndexKey(to.Timestamp()), byteutils.FromUint64(to.height))
+		if err != nil {
+			return err
+		}
+		if _, err := bc.EventBloom(to); err != nil {
+			return err
+		}
 		to = bc.GetBlock(to.header.parentHash)
 		if to == nil {
 			return ErrMissingParentBlock
@@ -329,7 +513,8 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 	}
 	// foundAt := time.Now().Unix()
 
-	if err := bc.revertBlocks(ancestor, oldTail); err != nil {
+	reverted, err := bc.revertBlocks(ancestor, oldTail)
+	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"from":  ancestor,
 			"to":    oldTail,
@@ -350,11 +535,26 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 	}
 	// builtAt := time.Now().Unix()
 
+	if bc.accountHistory {
+		if err := bc.updateAccountHistory(ancestor, newTail, reverted); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"from":  ancestor,
+				"to":    newTail,
+				"range": "(from, to]",
+				"err":   err,
+			}).Debug("Failed to update account history.")
+			return err
+		}
+	}
+
 	// record new tail
 	if err := bc.storeTailToStorage(newTail); err != nil {
 		return err
 	}
 	bc.tailBlock = newTail
+	if _, err := bc.updateTailSnapshot(newTail); err != nil {
+		return err
+	}
 	// storedAt := time.Now().Unix()
 
 	metricsBlockHeightGauge.Update(int64(newTail.Height()))
@@ -371,6 +571,10 @@ func (bc *BlockChain) SetTailBlock(newTail *Block) error {
 		"tail.new": newTail,
 	}).Info("Succeed to set tail block.")
 
+	if len(reverted) > 0 {
+		bc.triggerReorgEvent(oldTail, newTail, ancestor, reverted)
+	}
+
 	return nil
 }
 
@@ -382,25 +586,25 @@ func (bc *BlockChain) updateLatestIrreversibleBlock(tail *Block) {
 	miners := make(map[string]bool)
 	dynasty := int64(0)
 	for !cur.Hash().Equals(lib.Hash()) {
-		curDynasty := cur.header.timestamp / DynastyInterval
+		curDynasty := cur.header.timestamp / bc.params.DynastyInterval
 		if curDynasty != dynasty {
 			miners = make(map[string]bool)
 			dynasty = curDynasty
 		}
-		if int(cur.height)-int(lib.height) < ConsensusSize-len(miners) {
+		if int(cur.height)-int(lib.height) < bc.libConfirmations-len(miners) {
 			logging.VLog().WithFields(logrus.Fields{
 				"tail": tail,
 				"lib":  lib,
 				"cur":  cur,
 				// "time":             time.Now().Unix() - startAt,
 				"err":              "supported miners is not enough",
-				"miners.limit":     ConsensusSize,
+				"miners.limit":     bc.libConfirmations,
 				"miners.supported": len(miners),
 			}).Debug("Failed to update latest irreversible block.")
 			return
 		}
 		miners[cur.miner.String()] = true
-		if len(miners) >= ConsensusSize {
+		if len(miners) >= bc.libConfirmations {
 			if err := bc.storeLIBToStorage(cur); err != nil {
 				logging.VLog().WithFields(logrus.Fields{
 					"tail": tail,
@@ -413,10 +617,11 @@ func (bc *BlockChain) updateLatestIrreversibleBlock(tail *Block) {
 				"lib.old": bc.latestIrreversibleBlock,
 				"tail":    tail,
 				// "time":             time.Now().Unix() - startAt,
-				"miners.limit":     ConsensusSize,
+				"miners.limit":     bc.libConfirmations,
 				"miners.supported": len(miners),
 			}).Info("Succeed to update latest irreversible block.")
 			bc.latestIrreversibleBlock = cur
+			bc.triggerFinalizedEvent(cur)
 			return
 		}
 
@@ -437,16 +642,68 @@ func (bc *BlockChain) updateLatestIrreversibleBlock(tail *Block) {
 		"tail": tail,
 		// "time":             time.Now().Unix() - startAt,
 		"err":              "supported miners is not enough",
-		"miners.limit":     ConsensusSize,
+		"miners.limit":     bc.libConfirmations,
 		"miners.supported": len(miners),
 	}).Warn("Failed to update latest irreversible block.")
 }
 
+// libConfirmations returns the distinct miners confirming the lib, the consensus
+// size if not configured.
+func libConfirmations(params *ChainParams, confirmations uint32) (int, error) {
+	if confirmations == 0 {
+		return params.ConsensusSize, nil
+	}
+	if int(confirmations) < params.ConsensusSize || int(confirmations) > params.DynastySize {
+		return 0, ErrInvalidLIBConfirmations
+	}
+	return int(confirmations), nil
+}
+
+type finalizedEvent struct {
+	Hash      string `json:"hash"`
+	Height    uint64 `json:"height"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// triggerFinalizedEvent triggers the event of advancing the lib to the block.
+func (bc *BlockChain) triggerFinalizedEvent(block *Block) {
+	data, err := json.Marshal(&finalizedEvent{
+		Hash:      block.Hash().String(),
+		Height:    block.Height(),
+		Timestamp: block.Timestamp(),
+	})
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to marshal the finalized event.")
+		return
+	}
+	bc.eventEmitter.Trigger(&Event{
+		Topic: TopicFinalizedBlock,
+		Data:  string(data),
+	})
+}
+
 // LatestIrreversibleBlock return the latest irreversible block
 func (bc *BlockChain) LatestIrreversibleBlock() *Block {
 	return bc.latestIrreversibleBlock
 }
 
+// Confirmations returns the count of blocks on canonical chain from the block of given hash
+// and height to the tail, and whether the block is irreversible. It returns 0 if the block
+// is not on canonical chain.
+func (bc *BlockChain) Confirmations(hash byteutils.Hash, height uint64) (uint64, bool) {
+	tail, lib := bc.TailBlock(), bc.LatestIrreversibleBlock()
+	if height > tail.Height() {
+		return 0, false
+	}
+	canonical, err := bc.storage.Get(byteutils.FromUint64(height))
+	if err != nil || !byteutils.Hash(canonical).Equals(hash) {
+		return 0, false
+	}
+	return tail.Height() - height + 1, height <= lib.Height()
+}
+
 // GetBlockOnCanonicalChainByHeight return block in given height
 func (bc *BlockChain) GetBlockOnCanonicalChainByHeight(height uint64) *Block {
 	blockHash, err := bc.storage.Get(byteutils.FromUint64(height))
@@ -456,6 +713,69 @@ func (bc *BlockChain) GetBlockOnCanonicalChainByHeight(height uint64) *Block {
 	return bc.GetBlock(blockHash)
 }
 
+// GetBlockOnCanonicalChainByTimestamp return the block on canonical chain nearest to the given timestamp
+func (bc *BlockChain) GetBlockOnCanonicalChainByTimestamp(timestamp int64) *Block {
+	tail := bc.TailBlock()
+	if timestamp >= tail.Timestamp() {
+		return tail
+	}
+	if timestamp <= bc.genesisBlock.Timestamp() {
+		return bc.genesisBlock
+	}
+
+	floor := bc.findBlockBeforeTimestamp(timestamp)
+	if floor == nil {
+		return nil
+	}
+	next := bc.GetBlockOnCanonicalChainByHeight(floor.Height() + 1)
+	if next != nil && next.Timestamp()-timestamp < timestamp-floor.Timestamp() {
+		return next
+	}
+	return floor
+}
+
+// findBlockBeforeTimestamp return the last block on canonical chain not after the timestamp.
+func (bc *BlockChain) findBlockBeforeTimestamp(timestamp int64) *Block {
+	// lookup the timestamp index, skipping the slots without blocks in a dynasty at most.
+	interval := bc.params.BlockInterval
+	for i := int64(0); i < bc.params.DynastyInterval/interval; i++ {
+		slotTimestamp := timestamp - i*interval
+		value, err := bc.storage.Get(bc.timestampIndexKey(slotTimestamp))
+		if err != nil {
+			continue
+		}
+		block := bc.GetBlockOnCanonicalChainByHeight(byteutils.Uint64(value))
+		// index of reverted blocks may be left in storage.
+		if block == nil || block.Timestamp()/interval != slotTimestamp/interval {
+			continue
+		}
+		if block.Timestamp() > timestamp {
+			return bc.GetBlockOnCanonicalChainByHeight(block.Height() - 1)
+		}
+		return block
+	}
+
+	// blocks are not indexed or too sparse, binary search on the heights.
+	low, high := bc.genesisBlock.Height(), bc.TailBlock().Height()
+	for low < high {
+		mid := low + (high-low+1)/2
+		block := bc.GetBlockOnCanonicalChainByHeight(mid)
+		if block == nil {
+			return nil
+		}
+		if block.Timestamp() <= timestamp {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	return bc.GetBlockOnCanonicalChainByHeight(low)
+}
+
+func (bc *BlockChain) timestampIndexKey(timestamp int64) []byte {
+	return append([]byte(TimestampIndexPrefix), byteutils.FromInt64(timestamp/bc.params.BlockInterval)...)
+}
+
 // GetBlockOnCanonicalChainByHash check if a block is on canonical chain
 func (bc *BlockChain) GetBlockOnCanonicalChainByHash(blockHash byteutils.Hash) *Block {
 	blockByHash := bc.GetBlock(blockHash)
@@ -605,6 +925,43 @@ func (bc *BlockChain) putVerifiedNewBlocks(parent *Block, allBlocks, tailBlocks
 			return err
 		}
 
+		if _, err := bc.blockStats(v); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": v,
+				"err":   err,
+			}).Error("Failed to store the stats of the block.")
+			return err
+		}
+
+		if err := bc.storeReceiptsToStorage(v); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"block": v,
+				"err":   err,
+			}).Error("Failed to store the receipts of the block.")
+			return err
+		}
+		recordContractStats(v)
+
+		if bc.accountHistory {
+			if err := bc.storeBalanceChanges(v); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"block": v,
+					"err":   err,
+				}).Error("Failed to store the balance changes of the block.")
+				return err
+			}
+		}
+
+		if bc.executionResultLog {
+			if err := bc.storeExecutionResultsToStorage(v); err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"block": v,
+					"err":   err,
+				}).Error("Failed to store the execution results of the block.")
+				return err
+			}
+		}
+
 		logging.VLog().WithFields(logrus.Fields{
 			"block": v,
 		}).Info("Accepted the new block on chain")
@@ -641,7 +998,7 @@ func (bc *BlockChain) GetBlock(hash byteutils.Hash) *Block {
 	// TODO: get block from local storage.
 	v, _ := bc.cachedBlocks.Get(hash.Hex())
 	if v == nil {
-		block, err := LoadBlockFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
+		block, err := bc.loadBlockFromStorage(hash)
 		if err != nil {
 			return nil
 		}
@@ -662,6 +1019,53 @@ func (bc *BlockChain) GetTransaction(hash byteutils.Hash) *Transaction {
 	return tx
 }
 
+// GetTransactionHeight return the height of the block on canonical chain which contains the transaction.
+func (bc *BlockChain) GetTransactionHeight(hash byteutils.Hash) (uint64, error) {
+	tail := bc.TailBlock()
+	if _, err := tail.GetTransaction(hash); err != nil {
+		return 0, err
+	}
+
+	// txs trie is accumulated along the chain, search the first block containing the tx.
+	low, high := uint64(1), tail.Height()
+	for low < high {
+		mid := low + (high-low)/2
+		block := bc.GetBlockOnCanonicalChainByHeight(mid)
+		if block == nil {
+			return 0, ErrNotBlockInCanonicalChain
+		}
+		if _, err := block.GetTransaction(hash); err == nil {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	return low, nil
+}
+
+// GetExecutionError return the execution error of the failed transaction of given hash
+// in the block on canonical chain. It's only available when execution result log is enabled.
+func (bc *BlockChain) GetExecutionError(hash byteutils.Hash) (string, error) {
+	height, err := bc.GetTransactionHeight(hash)
+	if err != nil {
+		return "", err
+	}
+	block := bc.GetBlockOnCanonicalChainByHeight(height)
+	if block == nil {
+		return "", ErrNotBlockInCanonicalChain
+	}
+	value, err := bc.storage.Get(executionResultKey(block.Hash(), hash))
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+func executionResultKey(blockHash, txHash byteutils.Hash) []byte {
+	key := append([]byte(ExecutionResultPrefix), blockHash...)
+	return append(key, txHash...)
+}
+
 // GasPrice returns the lowest transaction gas price.
 func (bc *BlockChain) GasPrice() *util.Uint128 {
 	gasPrice := TransactionMaxGasPrice
@@ -693,15 +1097,23 @@ func (bc *BlockChain) GasPrice() *util.Uint128 {
 }
 
 // EstimateGas returns the transaction gas cost
-func (bc *BlockChain) EstimateGas(tx *Transaction) (*util.Uint128, error) {
-	gas, _, err := tx.LocalExecution(bc.tailBlock)
-	return gas, err
+func (bc *BlockChain) EstimateGas(ctx context.Context, tx *Transaction) (*util.Uint128, error) {
+	snapshot, err := bc.TailSnapshot()
+	if err != nil {
+		return util.NewUint128(), err
+	}
+	gas, result, err := tx.LocalExecution(ctx, snapshot)
+	return gas, NewExecutionError(err, result)
 }
 
 // Call returns the transaction call result
-func (bc *BlockChain) Call(tx *Transaction) (string, error) {
-	_, result, err := tx.LocalExecution(bc.tailBlock)
-	return result, err
+func (bc *BlockChain) Call(ctx context.Context, tx *Transaction) (string, error) {
+	snapshot, err := bc.TailSnapshot()
+	if err != nil {
+		return "", err
+	}
+	_, result, err := tx.LocalExecution(ctx, snapshot)
+	return result, NewExecutionError(err, result)
 }
 
 // Dump dump full chain.
@@ -733,6 +1145,22 @@ func (bc *BlockChain) storeBlockToStorage(block *Block) error {
 	if err != nil {
 		return err
 	}
+	if bc.pruner != nil {
+		bc.pruner.storage.keepRoots(block)
+	}
+	return nil
+}
+
+func (bc *BlockChain) storeExecutionResultsToStorage(block *Block) error {
+	for k, v := range block.ExecutionErrors() {
+		hash, err := k.Hash()
+		if err != nil {
+			return err
+		}
+		if err := bc.storage.Put(executionResultKey(block.Hash(), hash), []byte(v)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -763,11 +1191,21 @@ func (bc *BlockChain) loadTailFromStorage() (*Block, error) {
 		return genesis, nil
 	}
 
-	return LoadBlockFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
+	return bc.loadBlockFromStorage(hash)
+}
+
+// loadBlockFromStorage returns the block in storage linked to the chain params.
+func (bc *BlockChain) loadBlockFromStorage(hash byteutils.Hash) (*Block, error) {
+	block, err := LoadBlockFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
+	if err != nil {
+		return nil, err
+	}
+	block.params = bc.params
+	return block, nil
 }
 
 func (bc *BlockChain) loadGenesisFromStorage() (*Block, error) {
-	genesis, err := LoadBlockFromStorage(GenesisHash, bc.storage, bc.txPool, bc.eventEmitter)
+	genesis, err := bc.loadBlockFromStorage(GenesisHash)
 	if err != nil {
 		genesis, err = NewGenesisBlock(bc.genesis, bc)
 		if err != nil {
@@ -776,6 +1214,13 @@ func (bc *BlockChain) loadGenesisFromStorage() (*Block, error) {
 		if err := bc.storeBlockToStorage(genesis); err != nil {
 			return nil, err
 		}
+		params, err := proto.Marshal(GenesisParams(bc.genesis))
+		if err != nil {
+			return nil, err
+		}
+		if err := bc.storage.Put([]byte(GenesisParamsKey), params); err != nil {
+			return nil, err
+		}
 		heightKey := byteutils.FromUint64(genesis.height)
 		if err := bc.storage.Put(heightKey, genesis.Hash()); err != nil {
 			return nil, err
@@ -794,5 +1239,5 @@ func (bc *BlockChain) loadLIBFromStorage() (*Block, error) {
 		return bc.genesisBlock, nil
 	}
 
-	return LoadBlockFromStorage(hash, bc.storage, bc.txPool, bc.eventEmitter)
+	return bc.loadBlockFromStorage(hash)
 }
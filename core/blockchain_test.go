@@ -19,12 +19,17 @@
 package core
 
 import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util"
 
 	"github.com/gogo/protobuf/proto"
@@ -58,7 +63,7 @@ func TestBlockChain_FindCommonAncestorWithTail(t *testing.T) {
 
 	//add from reward
 	block0, _ := bc.NewBlock(from)
-	block0.header.timestamp = BlockInterval
+	block0.header.timestamp = DefaultBlockInterval
 	block0.SetMiner(from)
 	block0.Seal()
 	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block0)))
@@ -78,9 +83,9 @@ func TestBlockChain_FindCommonAncestorWithTail(t *testing.T) {
 					       \_ 222 tail
 	*/
 	block11, _ := bc.NewBlock(coinbase11)
-	block11.header.timestamp = BlockInterval * 2
+	block11.header.timestamp = DefaultBlockInterval * 2
 	block12, _ := bc.NewBlock(coinbase12)
-	block12.header.timestamp = BlockInterval * 3
+	block12.header.timestamp = DefaultBlockInterval * 3
 	block11.SetMiner(coinbase11)
 	block11.Seal()
 	block12.SetMiner(coinbase12)
@@ -92,16 +97,16 @@ func TestBlockChain_FindCommonAncestorWithTail(t *testing.T) {
 	bc.SetTailBlock(block11)
 	assert.Equal(t, bc.latestIrreversibleBlock, bc.genesisBlock)
 	block111, _ := bc.NewBlock(coinbase111)
-	block111.header.timestamp = BlockInterval * 4
+	block111.header.timestamp = DefaultBlockInterval * 4
 	block111.SetMiner(coinbase111)
 	block111.Seal()
 	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block111)))
 	bc.SetTailBlock(block12)
 	assert.Equal(t, bc.latestIrreversibleBlock, bc.genesisBlock)
 	block221, _ := bc.NewBlock(coinbase221)
-	block221.header.timestamp = BlockInterval * 5
+	block221.header.timestamp = DefaultBlockInterval * 5
 	block222, _ := bc.NewBlock(coinbase222)
-	block222.header.timestamp = BlockInterval * 6
+	block222.header.timestamp = DefaultBlockInterval * 6
 	block221.SetMiner(coinbase221)
 	block221.Seal()
 	block222.SetMiner(coinbase222)
@@ -111,7 +116,7 @@ func TestBlockChain_FindCommonAncestorWithTail(t *testing.T) {
 	bc.SetTailBlock(block111)
 	assert.Equal(t, bc.latestIrreversibleBlock, bc.genesisBlock)
 	block1111, _ := bc.NewBlock(coinbase1111)
-	block1111.header.timestamp = BlockInterval * 7
+	block1111.header.timestamp = DefaultBlockInterval * 7
 	block1111.SetMiner(coinbase1111)
 	block1111.Seal()
 	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block1111)))
@@ -153,7 +158,7 @@ func TestBlockChain_FindCommonAncestorWithTail(t *testing.T) {
 	assert.Equal(t, bc.latestIrreversibleBlock, bc.genesisBlock)
 
 	block11111, _ := bc.NewBlock(coinbase11111)
-	block11111.header.timestamp = BlockInterval * 8
+	block11111.header.timestamp = DefaultBlockInterval * 8
 	block11111.SetMiner(coinbase11111)
 	block11111.Seal()
 	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block11111)))
@@ -173,14 +178,14 @@ func TestBlockChain_FetchDescendantInCanonicalChain(t *testing.T) {
 		         \_ block - block1
 	*/
 	block, _ := bc.NewBlock(coinbase)
-	block.header.timestamp = BlockInterval
+	block.header.timestamp = DefaultBlockInterval
 	block.SetMiner(coinbase)
 	block.Seal()
 	bc.BlockPool().Push(block)
 	bc.SetTailBlock(block)
 
 	block1, _ := bc.NewBlock(coinbase)
-	block1.header.timestamp = BlockInterval * 2
+	block1.header.timestamp = DefaultBlockInterval * 2
 	block1.SetMiner(coinbase)
 	block1.Seal()
 	bc.BlockPool().Push(block1)
@@ -189,7 +194,7 @@ func TestBlockChain_FetchDescendantInCanonicalChain(t *testing.T) {
 	var blocks []*Block
 	for i := 0; i < 6; i++ {
 		block, _ := bc.NewBlock(coinbase)
-		block.header.timestamp = BlockInterval * int64(i+3)
+		block.header.timestamp = DefaultBlockInterval * int64(i+3)
 		blocks = append(blocks, block)
 		block.SetMiner(coinbase)
 		block.Seal()
@@ -224,10 +229,92 @@ func TestBlockChain_EstimateGas(t *testing.T) {
 	bc, _ := NewBlockChain(testNeb())
 	tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(0), 1, TxPayloadBinaryType, payload, TransactionGasPrice, util.NewUint128FromInt(200000))
 
-	_, err = bc.EstimateGas(tx)
+	_, err = bc.EstimateGas(context.Background(), tx)
 	assert.Nil(t, err)
 }
 
+func TestNewExecutionError(t *testing.T) {
+	assert.Nil(t, NewExecutionError(nil, "result"))
+	assert.Equal(t, ErrInsufficientBalance, NewExecutionError(ErrInsufficientBalance, ""))
+
+	err := NewExecutionError(nvm.ErrExecutionFailed, "Error: bad args (contract.js:3)")
+	assert.Equal(t, nvm.ErrExecutionFailed, err.(*ExecutionError).Err)
+	assert.Equal(t, "execution failed: Error: bad args (contract.js:3)", err.Error())
+}
+
+func TestBlockChain_DryRun(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	from, _ := AddressParse("1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c")
+	to, _ := AddressParse("2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8")
+	balance := bc.tailBlock.GetBalance(from.address)
+
+	payload, err := NewBinaryPayload(nil).ToBytes()
+	assert.Nil(t, err)
+	tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(100), 1, TxPayloadBinaryType, payload, TransactionGasPrice, util.NewUint128FromInt(200000))
+
+	diff, err := bc.DryRun(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, "", diff.Err)
+	assert.Equal(t, 0, len(diff.Storage))
+
+	gasCost := new(big.Int).Mul(diff.GasUsed, TransactionGasPrice.Int)
+	deltas := make(map[string]*big.Int)
+	for _, v := range diff.Balances {
+		deltas[v.Address.String()] = v.Delta
+	}
+	assert.Equal(t, new(big.Int).Neg(new(big.Int).Add(gasCost, big.NewInt(100))), deltas[from.String()])
+	assert.Equal(t, big.NewInt(100), deltas[to.String()])
+	assert.Equal(t, gasCost, deltas[bc.tailBlock.Coinbase().String()])
+
+	assert.Equal(t, TopicExecuteTxSuccess, diff.Events[len(diff.Events)-1].Topic)
+
+	// nothing is committed.
+	assert.Equal(t, balance, bc.tailBlock.GetBalance(from.address))
+
+	// the value exceeding the balance fails the execution.
+	tx = NewTransaction(bc.ChainID(), from, to, balance, 1, TxPayloadBinaryType, payload, TransactionGasPrice, util.NewUint128FromInt(200000))
+	diff, err = bc.DryRun(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInsufficientBalance.Error(), diff.Err)
+	assert.Equal(t, TopicExecuteTxFailed, diff.Events[len(diff.Events)-1].Topic)
+}
+
+func TestBlockChain_TailSnapshot(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	from, _ := AddressParse("1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c")
+	to, _ := AddressParse("2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8")
+	balance := bc.tailBlock.GetBalance(from.address)
+
+	snapshot, err := bc.TailSnapshot()
+	assert.Nil(t, err)
+	assert.Equal(t, bc.tailBlock.Hash(), snapshot.Hash())
+	assert.NotEqual(t, bc.tailBlock, snapshot)
+
+	// the snapshot is kept until the tail changes.
+	again, err := bc.TailSnapshot()
+	assert.Nil(t, err)
+	assert.True(t, snapshot == again)
+
+	payload, err := NewBinaryPayload(nil).ToBytes()
+	assert.Nil(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tx := NewTransaction(bc.ChainID(), from, to, util.NewUint128FromInt(100), 1, TxPayloadBinaryType, payload, TransactionGasPrice, util.NewUint128FromInt(200000))
+			_, err := bc.EstimateGas(context.Background(), tx)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// the local executions touch neither the tail block nor the snapshot.
+	assert.Equal(t, balance, bc.tailBlock.GetBalance(from.address))
+	assert.Equal(t, balance, snapshot.GetBalance(from.address))
+}
+
 func TestTailBlock(t *testing.T) {
 	bc, err := NewBlockChain(testNeb())
 	assert.Nil(t, err)
@@ -271,3 +358,406 @@ func TestGetPrice(t *testing.T) {
 	bc.storeBlockToStorage(block)
 	assert.Equal(t, bc.GasPrice(), lowerGasPrice)
 }
+
+func TestBlockChain_Stats(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+
+	stats, err := bc.Stats(DefaultChainStatsWindow)
+	assert.Nil(t, err)
+	assert.Equal(t, bc.TailBlock().Height(), stats.Height)
+	assert.Equal(t, uint64(0), stats.TotalTxs)
+	assert.Equal(t, uint64(0), stats.TotalContracts)
+
+	blockStats, err := bc.loadBlockStats(bc.TailBlock().Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), blockStats.DynastyBlocks)
+}
+
+func TestBlockChain_Supply(t *testing.T) {
+	neb := testNeb()
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	genesis := util.NewUint128()
+	for _, v := range neb.Genesis().TokenDistribution {
+		genesis.Add(genesis.Int, util.NewUint128FromString(v.Value).Int)
+	}
+
+	supply, err := bc.Supply(bc.GenesisBlock())
+	assert.Nil(t, err)
+	assert.Equal(t, genesis.String(), supply.Genesis.String())
+	assert.Equal(t, "0", supply.Minted.String())
+	assert.Equal(t, "0", supply.Burned.String())
+	assert.Equal(t, genesis.String(), supply.Total.String())
+	assert.Equal(t, genesis.String(), supply.Circulating.String())
+}
+
+func TestBlockChain_GetBlockOnCanonicalChainByTimestamp(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	/*
+		genesis -- 1 -- 2 -- 3
+	*/
+	var blocks []*Block
+	coinbases := []string{"012345678901234567890001", "012345678901234567890002", "012345678901234567890003"}
+	for i, slot := range []int64{1, 3, 20} {
+		coinbase := &Address{[]byte(coinbases[i])}
+		block, err := bc.NewBlock(coinbase)
+		assert.Nil(t, err)
+		block.header.timestamp = DefaultBlockInterval * slot
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+
+	tests := []struct {
+		timestamp int64
+		expected  *Block
+	}{
+		{0, bc.genesisBlock},
+		{DefaultBlockInterval, blocks[0]},
+		{DefaultBlockInterval*2 - 1, blocks[0]},
+		{DefaultBlockInterval*2 + 1, blocks[1]},
+		// found in the timestamp index.
+		{DefaultBlockInterval * 10, blocks[1]},
+		// too sparse for the timestamp index, found by binary search.
+		{DefaultBlockInterval * 17, blocks[2]},
+		{DefaultBlockInterval * 30, blocks[2]},
+	}
+	for _, tt := range tests {
+		block := bc.GetBlockOnCanonicalChainByTimestamp(tt.timestamp)
+		assert.NotNil(t, block, tt.timestamp)
+		assert.Equal(t, tt.expected.Hash(), block.Hash(), tt.timestamp)
+	}
+}
+
+func TestBlockChain_Confirmations(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	/*
+		genesis -- 1 -- 2 -- 3
+		        \_ fork
+	*/
+	fork, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	fork.header.timestamp = DefaultBlockInterval * 2
+	fork.SetMiner(coinbase)
+	fork.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(fork)))
+
+	var blocks []*Block
+	for i := 0; i < 3; i++ {
+		block, err := bc.NewBlock(coinbase)
+		assert.Nil(t, err)
+		block.header.timestamp = DefaultBlockInterval * int64(i+1)
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+		blocks = append(blocks, block)
+	}
+	bc.latestIrreversibleBlock = blocks[0]
+
+	confirmations, irreversible := bc.Confirmations(blocks[0].Hash(), blocks[0].Height())
+	assert.Equal(t, uint64(3), confirmations)
+	assert.True(t, irreversible)
+
+	confirmations, irreversible = bc.Confirmations(blocks[2].Hash(), blocks[2].Height())
+	assert.Equal(t, uint64(1), confirmations)
+	assert.False(t, irreversible)
+
+	confirmations, irreversible = bc.Confirmations(fork.Hash(), fork.Height())
+	assert.Equal(t, uint64(0), confirmations)
+	assert.False(t, irreversible)
+}
+
+func TestBlockChain_EventCursor(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+
+	cursor, err := bc.LoadEventCursor("consumer")
+	assert.Nil(t, err)
+	assert.Nil(t, cursor)
+
+	_, err = bc.LoadEventCursor("")
+	assert.Equal(t, ErrInvalidEventConsumer, err)
+	assert.Equal(t, ErrInvalidEventCursor, bc.CommitEventCursor("consumer", &EventCursor{Height: bc.TailBlock().Height() + 1}))
+
+	// no events in genesis, the cursor moves to the tail.
+	events, next, err := bc.EventsAfterCursor(nil, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(events))
+	assert.Equal(t, &EventCursor{Height: bc.TailBlock().Height(), Index: 0}, next)
+
+	assert.Nil(t, bc.CommitEventCursor("consumer", next))
+	cursor, err = bc.LoadEventCursor("consumer")
+	assert.Nil(t, err)
+	assert.Equal(t, next, cursor)
+}
+
+func TestBloom(t *testing.T) {
+	bloom := new(Bloom)
+	assert.False(t, bloom.Test([]byte("chain.transferEvent")))
+
+	bloom.Add([]byte("chain.transferEvent"))
+	assert.True(t, bloom.Test([]byte("chain.transferEvent")))
+	assert.False(t, bloom.Test([]byte("chain.contractEvent")))
+	assert.True(t, bloom.testAny(nil))
+	assert.True(t, bloom.testAny([][]byte{[]byte("chain.contractEvent"), []byte("chain.transferEvent")}))
+}
+
+func TestEventFilter_Indexed(t *testing.T) {
+	bloom := new(Bloom)
+	bloom.Add(indexedBloomItem(0, "from"))
+	bloom.Add(indexedBloomItem(1, "to"))
+
+	event := &Event{Topic: "chain.contract.transfer", Indexed: []string{"from", "to"}}
+	tests := []struct {
+		indexed []string
+		matched bool
+	}{
+		{nil, true},
+		{[]string{"from"}, true},
+		{[]string{"", "to"}, true},
+		{[]string{"from", "to"}, true},
+		{[]string{"to"}, false},
+		{[]string{"from", "to", "value"}, false},
+	}
+	for _, tt := range tests {
+		filter := &EventFilter{Indexed: tt.indexed}
+		assert.Equal(t, tt.matched, filter.matchIndexed(event), tt.indexed)
+		assert.Equal(t, tt.matched, filter.testIndexed(bloom), tt.indexed)
+	}
+}
+
+func TestBlockChain_FilterEvents(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+
+	bloom, err := bc.EventBloom(bc.genesisBlock)
+	assert.Nil(t, err)
+	assert.Equal(t, new(Bloom), bloom)
+
+	events, next, err := bc.FilterEvents(&EventFilter{Topics: []string{"chain.transferEvent"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(events))
+	assert.Equal(t, bc.TailBlock().Height()+1, next)
+}
+
+func TestBlockChain_ReorgEvent(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	/*
+		genesis -- 11
+				\_ 12
+	*/
+	coinbase11 := &Address{[]byte("012345678901234567890011")}
+	coinbase12 := &Address{[]byte("012345678901234567890012")}
+	block11, _ := bc.NewBlock(coinbase11)
+	block11.header.timestamp = DefaultBlockInterval
+	block11.SetMiner(coinbase11)
+	block11.Seal()
+	block12, _ := bc.NewBlock(coinbase12)
+	block12.header.timestamp = DefaultBlockInterval * 2
+	block12.SetMiner(coinbase12)
+	block12.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block11)))
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block12)))
+
+	reorgs := func() []*reorgEvent {
+		var ret []*reorgEvent
+		for {
+			select {
+			case e := <-bc.eventEmitter.eventCh:
+				if e.Topic == TopicReorg {
+					reorg := new(reorgEvent)
+					assert.Nil(t, json.Unmarshal([]byte(e.Data), reorg))
+					ret = append(ret, reorg)
+				}
+			default:
+				return ret
+			}
+		}
+	}
+
+	assert.Nil(t, bc.SetTailBlock(block11))
+	assert.Equal(t, 0, len(reorgs()))
+
+	assert.Nil(t, bc.SetTailBlock(block12))
+	events := reorgs()
+	assert.Equal(t, 1, len(events))
+	assert.Equal(t, block11.Hash().String(), events[0].OldTail)
+	assert.Equal(t, block12.Hash().String(), events[0].NewTail)
+	assert.Equal(t, bc.GenesisBlock().Hash().String(), events[0].Ancestor)
+	assert.Equal(t, uint64(1), events[0].AncestorHeight)
+	assert.Equal(t, 0, len(events[0].RevertedTxs))
+}
+
+func TestBlockChain_ExecutionErrorReverted(t *testing.T) {
+	neb := testNeb()
+	neb.config.Chain.ExecutionResultLog = true
+	bc, _ := NewBlockChain(neb)
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	block0, _ := bc.NewBlock(from)
+	block0.header.timestamp = DefaultBlockInterval
+	block0.SetMiner(from)
+	assert.Nil(t, block0.Seal())
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block0)))
+	assert.Nil(t, bc.SetTailBlock(block0))
+
+	/*
+		genesis -- 0 -- 1 (the failed tx)
+				     \_ 2
+	*/
+	value := util.NewUint128FromString("1000000000000000000000000000")
+	tx := NewTransaction(bc.ChainID(), from, mockAddress(), value, 1, TxPayloadBinaryType, nil, TransactionGasPrice, util.NewUint128FromInt(200000))
+	assert.Nil(t, tx.Sign(signature))
+	assert.Nil(t, bc.txPool.Push(tx))
+	block1, _ := bc.NewBlock(from)
+	block1.header.timestamp = DefaultBlockInterval * 2
+	block1.CollectTransactions(time.Now().Unix() + 2)
+	assert.Equal(t, 1, len(block1.transactions))
+	block1.SetMiner(from)
+	assert.Nil(t, block1.Seal())
+	block2, _ := bc.NewBlock(from)
+	block2.header.timestamp = DefaultBlockInterval * 3
+	block2.SetMiner(from)
+	assert.Nil(t, block2.Seal())
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block1)))
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block2)))
+
+	assert.Nil(t, bc.SetTailBlock(block1))
+	reason, err := bc.GetExecutionError(tx.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInsufficientBalance.Error(), reason)
+
+	// the result of the reverted block is not read.
+	assert.Nil(t, bc.SetTailBlock(block2))
+	_, err = bc.GetExecutionError(tx.Hash())
+	assert.NotNil(t, err)
+}
+
+func TestBlockChain_FinalizedEvent(t *testing.T) {
+	params := DefaultChainParams()
+	confirmations, err := libConfirmations(params, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, params.ConsensusSize, confirmations)
+	confirmations, err = libConfirmations(params, uint32(params.DynastySize))
+	assert.Nil(t, err)
+	assert.Equal(t, params.DynastySize, confirmations)
+	_, err = libConfirmations(params, uint32(params.ConsensusSize-1))
+	assert.Equal(t, ErrInvalidLIBConfirmations, err)
+	_, err = libConfirmations(params, uint32(params.DynastySize+1))
+	assert.Equal(t, ErrInvalidLIBConfirmations, err)
+
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	assert.Equal(t, params.ConsensusSize, bc.libConfirmations)
+
+	coinbase11 := &Address{[]byte("012345678901234567890011")}
+	block11, _ := bc.NewBlock(coinbase11)
+	block11.header.timestamp = DefaultBlockInterval
+	block11.SetMiner(coinbase11)
+	block11.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block11)))
+	assert.Nil(t, bc.SetTailBlock(block11))
+
+	bc.updateLatestIrreversibleBlock(block11)
+	assert.Equal(t, bc.genesisBlock.Hash(), bc.latestIrreversibleBlock.Hash())
+
+	bc.libConfirmations = 1
+	bc.updateLatestIrreversibleBlock(block11)
+	assert.Equal(t, block11.Hash(), bc.latestIrreversibleBlock.Hash())
+
+	var finalized []*finalizedEvent
+	for done := false; !done; {
+		select {
+		case e := <-bc.eventEmitter.eventCh:
+			if e.Topic == TopicFinalizedBlock {
+				event := new(finalizedEvent)
+				assert.Nil(t, json.Unmarshal([]byte(e.Data), event))
+				finalized = append(finalized, event)
+			}
+		default:
+			done = true
+		}
+	}
+	assert.Equal(t, 1, len(finalized))
+	assert.Equal(t, block11.Hash().String(), finalized[0].Hash)
+	assert.Equal(t, block11.Height(), finalized[0].Height)
+}
+
+func TestBlockChain_MinerStats(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+	coinbase := &Address{[]byte("012345678901234567890000")}
+
+	_, err := bc.MinerStats(0, 0)
+	assert.Equal(t, ErrInvalidMinerStatsRange, err)
+
+	// the slot 3 is missed.
+	for _, slot := range []int64{1, 2, 4} {
+		block, err := bc.NewBlock(coinbase)
+		assert.Nil(t, err)
+		block.header.timestamp = DefaultBlockInterval * slot
+		block.SetMiner(coinbase)
+		block.Seal()
+		assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, bc.SetTailBlock(block))
+	}
+
+	members, err := bc.TailBlock().Dynasty()
+	assert.Nil(t, err)
+	stats, err := bc.MinerStats(0, 0)
+	assert.Nil(t, err)
+	minted, missed := uint64(0), uint64(0)
+	for _, v := range stats {
+		minted += v.Minted
+		missed += v.Missed
+		if v.Address.Equals(members[3]) {
+			assert.Equal(t, uint64(1), v.Missed)
+			assert.Equal(t, float64(0), v.Uptime())
+		}
+	}
+	assert.Equal(t, uint64(3), minted)
+	assert.Equal(t, uint64(1), missed)
+
+	_, err = bc.MinerStats(3, 2)
+	assert.Equal(t, ErrInvalidMinerStatsRange, err)
+}
+
+func TestBlockChain_TypeScriptVersion(t *testing.T) {
+	neb := testNeb()
+	neb.config.Chain.TypescriptVersion = nvm.TypeScriptCompilerVersion
+	_, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	neb = testNeb()
+	neb.config.Chain.TypescriptVersion = "1.0.0"
+	_, err = NewBlockChain(neb)
+	assert.Equal(t, ErrTypeScriptVersionMismatch, err)
+}
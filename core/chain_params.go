@@ -0,0 +1,105 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/core/pb"
+)
+
+// ChainParams are the consensus parameters of the chain built from the genesis params,
+// they are read only once the chain is created.
+type ChainParams struct {
+	BlockInterval   int64
+	DynastyInterval int64
+	DynastySize     int
+	SafeSize        int
+	ConsensusSize   int
+
+	// heights from which the consensus changes take effect, 0 means never.
+	StorageRefundHeight     uint64
+	IndexedEventHeight      uint64
+	HostBindingsCheckHeight uint64
+	SeededRandomHeight      uint64
+	BlockGasLimitHeight     uint64
+	MissedSlotsHeight       uint64
+	ContractTransferHeight  uint64
+	BlockContextHeight      uint64
+
+	// heights from which the tx payload types added after the launch are accepted,
+	// a type with 0 height is never accepted.
+	PayloadHeights map[string]uint64
+
+	// gas limit of the first limited block of a chain unlimited in genesis.
+	BlockGasLimit uint64
+
+	// reward schedule sorted by start height.
+	rewardSchedule []*rewardEpoch
+}
+
+// defaultChainParams are used by the blocks not linked to a chain.
+var defaultChainParams = NewChainParams(GenesisParams(nil))
+
+func safeSize(dynastySize int) int {
+	return dynastySize/3 + 1
+}
+
+func consensusSize(dynastySize int) int {
+	return dynastySize*2/3 + 1
+}
+
+// NewChainParams returns the chain params of the validated genesis params.
+func NewChainParams(params *corepb.GenesisParams) *ChainParams {
+	dynastySize := int(params.DynastySize)
+	schedule := make([]*rewardEpoch, len(params.RewardSchedule))
+	for i, v := range params.RewardSchedule {
+		reward, _ := parseGenesisAmount(v.Reward)
+		schedule[i] = &rewardEpoch{start: v.StartHeight, reward: reward.Int, halving: v.HalvingInterval}
+	}
+	return &ChainParams{
+		BlockInterval:           params.BlockInterval,
+		DynastyInterval:         params.DynastyInterval,
+		DynastySize:             dynastySize,
+		SafeSize:                safeSize(dynastySize),
+		ConsensusSize:           consensusSize(dynastySize),
+		StorageRefundHeight:     params.StorageRefundHeight,
+		IndexedEventHeight:      params.IndexedEventHeight,
+		HostBindingsCheckHeight: params.HostBindingsCheckHeight,
+		SeededRandomHeight:      params.SeededRandomHeight,
+		BlockGasLimitHeight:     params.BlockGasLimitHeight,
+		MissedSlotsHeight:       params.MissedSlotsHeight,
+		ContractTransferHeight:  params.ContractTransferHeight,
+		BlockContextHeight:      params.BlockContextHeight,
+		PayloadHeights: map[string]uint64{
+			TxPayloadMultisigType: params.MultisigPayloadHeight,
+			TxPayloadBatchType:    params.BatchPayloadHeight,
+			TxPayloadTimelockType: params.TimelockPayloadHeight,
+			TxPayloadSlashType:    params.SlashPayloadHeight,
+			TxPayloadUpgradeType:  params.UpgradePayloadHeight,
+			TxPayloadVerifyType:   params.VerifyPayloadHeight,
+			TxPayloadPauseType:    params.PausePayloadHeight,
+		},
+		BlockGasLimit:  params.BlockGasLimit,
+		rewardSchedule: schedule,
+	}
+}
+
+// DefaultChainParams returns the chain params of the default genesis params.
+func DefaultChainParams() *ChainParams {
+	return defaultChainParams
+}
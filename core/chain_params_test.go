@@ -0,0 +1,52 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewChainParams(t *testing.T) {
+	params := DefaultChainParams()
+	assert.Equal(t, DefaultBlockInterval, params.BlockInterval)
+	assert.Equal(t, DefaultDynastyInterval, params.DynastyInterval)
+	assert.Equal(t, DefaultDynastySize, params.DynastySize)
+	assert.Equal(t, 3, params.SafeSize)
+	assert.Equal(t, 5, params.ConsensusSize)
+
+	conf := MockGenesisConf()
+	conf.Params = &corepb.GenesisParams{BlockInterval: 1, DynastyInterval: 30, DynastySize: MinDynastySize, IndexedEventHeight: 10, SeededRandomHeight: 20}
+	custom := NewChainParams(GenesisParams(conf))
+	assert.Equal(t, int64(1), custom.BlockInterval)
+	assert.Equal(t, int64(30), custom.DynastyInterval)
+	assert.Equal(t, MinDynastySize, custom.DynastySize)
+	assert.Equal(t, 2, custom.SafeSize)
+	assert.Equal(t, 3, custom.ConsensusSize)
+	assert.Equal(t, uint64(10), custom.IndexedEventHeight)
+	assert.Equal(t, uint64(20), custom.SeededRandomHeight)
+	assert.Equal(t, uint64(0), params.SeededRandomHeight)
+	assert.Equal(t, BlockReward.String(), custom.BlockRewardAt(2).String())
+
+	// the params of a chain never leak into the others.
+	assert.Equal(t, DefaultBlockInterval, DefaultChainParams().BlockInterval)
+	assert.Equal(t, uint64(0), DefaultChainParams().IndexedEventHeight)
+}
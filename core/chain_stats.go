@@ -0,0 +1,255 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sort"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// DefaultChainStatsWindow is the default count of blocks to calculate TPS and block interval.
+const DefaultChainStatsWindow = 120
+
+// MaxMinerStatsRange is the max count of blocks in a miner stats query.
+const MaxMinerStatsRange = 10000
+
+// ChainStats is the statistics of the canonical chain.
+type ChainStats struct {
+	Height               uint64
+	TotalTxs             uint64
+	TotalContracts       uint64
+	TPS                  float64
+	AverageBlockInterval float64
+	DynastyMissRate      float64
+}
+
+// Stats returns the statistics of the canonical chain, TPS and average block interval
+// are calculated over the latest window blocks.
+func (bc *BlockChain) Stats(window uint64) (*ChainStats, error) {
+	tail := bc.TailBlock()
+	tailStats, err := bc.blockStats(tail)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &ChainStats{
+		Height:         tail.Height(),
+		TotalTxs:       tailStats.TotalTxs,
+		TotalContracts: tailStats.TotalContracts,
+	}
+
+	if window >= tail.Height() {
+		window = tail.Height() - 1
+	}
+	if window > 0 {
+		start := bc.GetBlockOnCanonicalChainByHeight(tail.Height() - window)
+		if start == nil {
+			return nil, ErrNotBlockInCanonicalChain
+		}
+		startStats, err := bc.blockStats(start)
+		if err != nil {
+			return nil, err
+		}
+		if elapsed := tail.Timestamp() - start.Timestamp(); elapsed > 0 {
+			stats.TPS = float64(tailStats.TotalTxs-startStats.TotalTxs) / float64(elapsed)
+			stats.AverageBlockInterval = float64(elapsed) / float64(window)
+		}
+	}
+
+	// slots passed in current dynasty, include the slot of tail.
+	slots := tail.Timestamp()%bc.params.DynastyInterval/bc.params.BlockInterval + 1
+	if missed := slots - int64(tailStats.DynastyBlocks); missed > 0 {
+		stats.DynastyMissRate = float64(missed) / float64(slots)
+	}
+	return stats, nil
+}
+
+// blockStats returns the accumulated stats until the block, the stats of
+// ancestors are calculated and stored if not found in storage.
+func (bc *BlockChain) blockStats(block *Block) (*corepb.BlockStats, error) {
+	var (
+		stats   *corepb.BlockStats
+		prev    *Block
+		pending []*Block
+	)
+
+	// find the nearest ancestor with stats.
+	for cur := block; ; {
+		s, err := bc.loadBlockStats(cur.Hash())
+		if err == nil {
+			stats, prev = s, cur
+			break
+		}
+		if err != storage.ErrKeyNotFound {
+			return nil, err
+		}
+		pending = append(pending, cur)
+		if CheckGenesisBlock(cur) {
+			stats = &corepb.BlockStats{}
+			break
+		}
+		if cur = bc.GetBlock(cur.ParentHash()); cur == nil {
+			return nil, ErrMissingParentBlock
+		}
+	}
+
+	for i := len(pending) - 1; i >= 0; i-- {
+		cur := pending[i]
+		next := &corepb.BlockStats{
+			TotalTxs:       stats.TotalTxs + uint64(len(cur.transactions)),
+			TotalContracts: stats.TotalContracts,
+			DynastyBlocks:  1,
+		}
+		if prev != nil && prev.Timestamp()/bc.params.DynastyInterval == cur.Timestamp()/bc.params.DynastyInterval {
+			next.DynastyBlocks = stats.DynastyBlocks + 1
+		}
+		for _, tx := range cur.transactions {
+			if tx.Type() == TxPayloadDeployType && cur.isTxExecutionSucceed(tx.Hash()) {
+				next.TotalContracts++
+			}
+		}
+		if err := bc.storeBlockStats(cur.Hash(), next); err != nil {
+			return nil, err
+		}
+		stats, prev = next, cur
+	}
+	return stats, nil
+}
+
+func (bc *BlockChain) loadBlockStats(hash byteutils.Hash) (*corepb.BlockStats, error) {
+	value, err := bc.storage.Get(append([]byte(BlockStatsPrefix), hash...))
+	if err != nil {
+		return nil, err
+	}
+	stats := new(corepb.BlockStats)
+	if err := proto.Unmarshal(value, stats); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (bc *BlockChain) storeBlockStats(hash byteutils.Hash, stats *corepb.BlockStats) error {
+	value, err := proto.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	return bc.storage.Put(append([]byte(BlockStatsPrefix), hash...), value)
+}
+
+// MinerStats is the minting statistics of a validator in a height range.
+type MinerStats struct {
+	Address byteutils.Hash
+	Minted  uint64
+	Missed  uint64
+}
+
+// Expected returns the count of the slots assigned to the validator.
+func (s *MinerStats) Expected() uint64 {
+	return s.Minted + s.Missed
+}
+
+// Uptime returns the percentage of the assigned slots minted by the validator.
+func (s *MinerStats) Uptime() float64 {
+	if s.Expected() == 0 {
+		return 0
+	}
+	return float64(s.Minted) * 100 / float64(s.Expected())
+}
+
+// MinerStats returns the statistics of the validators minted or missed slots between the heights
+// on canonical chain, the slots in the dynasties without any block are not counted.
+func (bc *BlockChain) MinerStats(start, end uint64) ([]*MinerStats, error) {
+	tail := bc.TailBlock()
+	if end == 0 || end > tail.Height() {
+		end = tail.Height()
+	}
+	if start == 0 && end > DefaultChainStatsWindow {
+		start = end - DefaultChainStatsWindow + 1
+	}
+	// the genesis block is not minted.
+	if start < 2 {
+		start = 2
+	}
+	if start > end || end-start+1 > MaxMinerStatsRange {
+		return nil, ErrInvalidMinerStatsRange
+	}
+
+	stats := make(map[byteutils.HexHash]*MinerStats)
+	record := func(slot int64, dynasty *trie.BatchTrie, minted bool) error {
+		proposer, err := FindProposer(bc.params, slot, dynasty)
+		if err != nil || proposer == nil {
+			return err
+		}
+		s, ok := stats[proposer.Hex()]
+		if !ok {
+			s = &MinerStats{Address: proposer}
+			stats[proposer.Hex()] = s
+		}
+		if minted {
+			s.Minted++
+		} else {
+			s.Missed++
+		}
+		return nil
+	}
+
+	parent := bc.GetBlockOnCanonicalChainByHeight(start - 1)
+	if parent == nil {
+		return nil, ErrNotBlockInCanonicalChain
+	}
+	blockInterval, dynastyInterval := bc.params.BlockInterval, bc.params.DynastyInterval
+	for height := start; height <= end; height++ {
+		block := bc.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			return nil, ErrNotBlockInCanonicalChain
+		}
+		// the slots skipped after the parent in the dynasties of parent and block.
+		parentDynasty, blockDynasty := parent.Timestamp()/dynastyInterval, block.Timestamp()/dynastyInterval
+		for slot := parent.Timestamp() + blockInterval; slot < block.Timestamp() && !CheckGenesisBlock(parent); slot += blockInterval {
+			dynasty := block.dposContext.dynastyTrie
+			if slot/dynastyInterval == parentDynasty && parentDynasty != blockDynasty {
+				dynasty = parent.dposContext.dynastyTrie
+			} else if slot/dynastyInterval != blockDynasty {
+				slot = blockDynasty*dynastyInterval - blockInterval
+				continue
+			}
+			if err := record(slot, dynasty, false); err != nil {
+				return nil, err
+			}
+		}
+		if err := record(block.Timestamp(), block.dposContext.dynastyTrie, true); err != nil {
+			return nil, err
+		}
+		parent = block
+	}
+
+	result := make([]*MinerStats, 0, len(stats))
+	for _, v := range stats {
+		result = append(result, v)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return byteutils.Less(result[i].Address, result[j].Address)
+	})
+	return result, nil
+}
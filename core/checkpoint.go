@@ -0,0 +1,67 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// loadCheckpoints returns the trusted block hashes by height in config.
+func loadCheckpoints(conf []*nebletpb.CheckpointConfig) (map[uint64]byteutils.Hash, error) {
+	checkpoints := make(map[uint64]byteutils.Hash)
+	for _, v := range conf {
+		hash, err := byteutils.FromHex(v.Hash)
+		if err != nil || len(hash) == 0 || v.Height == 0 {
+			return nil, ErrInvalidCheckpoint
+		}
+		if _, ok := checkpoints[v.Height]; ok {
+			return nil, ErrInvalidCheckpoint
+		}
+		checkpoints[v.Height] = hash
+	}
+	return checkpoints, nil
+}
+
+// VerifyCheckpoints returns ErrCheckpointMismatch if the block contradicts a checkpoint,
+// either the block at the height of a checkpoint is not the trusted one, or the block
+// forks from the canonical chain below a checkpoint the canonical chain has passed.
+func (bc *BlockChain) VerifyCheckpoints(block *Block) error {
+	if len(bc.checkpoints) == 0 {
+		return nil
+	}
+	if hash, ok := bc.checkpoints[block.Height()]; ok && !hash.Equals(block.Hash()) {
+		return ErrCheckpointMismatch
+	}
+
+	passed := uint64(0)
+	tail := bc.TailBlock()
+	for height := range bc.checkpoints {
+		if height <= tail.Height() && height > passed {
+			passed = height
+		}
+	}
+	if block.Height() <= passed {
+		canonical := bc.GetBlockOnCanonicalChainByHeight(block.Height())
+		if canonical != nil && !canonical.Hash().Equals(block.Hash()) {
+			return ErrCheckpointMismatch
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadCheckpoints(t *testing.T) {
+	hash := "4a1e0a4b4b2f0d6c1b3d0a1f3e6b4c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b"
+	tests := []struct {
+		name string
+		conf []*nebletpb.CheckpointConfig
+		err  error
+	}{
+		{"empty", nil, nil},
+		{"valid", []*nebletpb.CheckpointConfig{{Height: 10, Hash: hash}, {Height: 20, Hash: hash}}, nil},
+		{"zero height", []*nebletpb.CheckpointConfig{{Height: 0, Hash: hash}}, ErrInvalidCheckpoint},
+		{"invalid hash", []*nebletpb.CheckpointConfig{{Height: 10, Hash: "xyz"}}, ErrInvalidCheckpoint},
+		{"duplicated height", []*nebletpb.CheckpointConfig{{Height: 10, Hash: hash}, {Height: 10, Hash: hash}}, ErrInvalidCheckpoint},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkpoints, err := loadCheckpoints(tt.conf)
+			assert.Equal(t, tt.err, err)
+			if err == nil {
+				assert.Equal(t, len(tt.conf), len(checkpoints))
+			}
+		})
+	}
+}
+
+func TestBlockChain_VerifyCheckpoints(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	/*
+		genesis -- 11 -- 111 tail
+				\_ 12
+	*/
+	coinbase11 := &Address{[]byte("012345678901234567890011")}
+	coinbase12 := &Address{[]byte("012345678901234567890012")}
+	coinbase111 := &Address{[]byte("012345678901234567890111")}
+	block11, _ := bc.NewBlock(coinbase11)
+	block11.header.timestamp = DefaultBlockInterval
+	block11.SetMiner(coinbase11)
+	block11.Seal()
+	block12, _ := bc.NewBlock(coinbase12)
+	block12.header.timestamp = DefaultBlockInterval * 2
+	block12.SetMiner(coinbase12)
+	block12.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block11)))
+
+	// the block at the height of checkpoint must be the trusted one.
+	bc.checkpoints = map[uint64]byteutils.Hash{block11.Height(): block11.Hash()}
+	assert.Nil(t, bc.VerifyCheckpoints(block11))
+	assert.Equal(t, ErrCheckpointMismatch, bc.VerifyCheckpoints(block12))
+	assert.Equal(t, ErrCheckpointMismatch, bc.BlockPool().Push(BlockFromNetwork(block12)))
+
+	// the branches forking below the passed checkpoint are rejected.
+	assert.Nil(t, bc.SetTailBlock(block11))
+	block111, _ := bc.NewBlock(coinbase111)
+	block111.header.timestamp = DefaultBlockInterval * 3
+	block111.SetMiner(coinbase111)
+	block111.Seal()
+	bc.checkpoints = map[uint64]byteutils.Hash{block111.Height(): block111.Hash()}
+	assert.Nil(t, bc.VerifyCheckpoints(block12))
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block111)))
+	assert.Nil(t, bc.SetTailBlock(block111))
+	assert.Equal(t, ErrCheckpointMismatch, bc.VerifyCheckpoints(block12))
+	assert.Nil(t, bc.VerifyCheckpoints(block111))
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// MaxTrackedContracts is the max count of contracts with execution statistics kept in memory,
+// the contract with the least gas used is dropped to track a new one.
+const MaxTrackedContracts = 10000
+
+// DefaultContractStatsLimit is the default count of contracts returned by ContractExecutionStats.
+const DefaultContractStatsLimit = 20
+
+// ContractStats is the execution statistics of a contract since the node started. The executions
+// are counted once per block accepted by the node, including the blocks on forks.
+type ContractStats struct {
+	Address  byteutils.Hash
+	Calls    uint64
+	Failures uint64
+	GasUsed  uint64
+	Duration time.Duration // total execution time.
+}
+
+// AverageDuration returns the average execution time of the calls.
+func (s *ContractStats) AverageDuration() time.Duration {
+	if s.Calls == 0 {
+		return 0
+	}
+	return s.Duration / time.Duration(s.Calls)
+}
+
+// FailureRate returns the rate of the failed calls.
+func (s *ContractStats) FailureRate() float64 {
+	if s.Calls == 0 {
+		return 0
+	}
+	return float64(s.Failures) / float64(s.Calls)
+}
+
+type contractStatsTracker struct {
+	mu    sync.Mutex
+	stats map[byteutils.HexHash]*ContractStats
+}
+
+var contractStats = &contractStatsTracker{stats: make(map[byteutils.HexHash]*ContractStats)}
+
+func (t *contractStatsTracker) record(addr byteutils.Hash, gas uint64, failed bool, duration time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s, ok := t.stats[addr.Hex()]
+	if !ok {
+		if len(t.stats) >= MaxTrackedContracts {
+			t.evict()
+		}
+		s = &ContractStats{Address: addr}
+		t.stats[addr.Hex()] = s
+	}
+	s.Calls++
+	s.GasUsed += gas
+	s.Duration += duration
+	if failed {
+		s.Failures++
+	}
+}
+
+// evict drops the contract with the least gas used.
+func (t *contractStatsTracker) evict() {
+	var least *ContractStats
+	for _, s := range t.stats {
+		if least == nil || s.GasUsed < least.GasUsed {
+			least = s
+		}
+	}
+	if least != nil {
+		delete(t.stats, least.Address.Hex())
+	}
+}
+
+func (t *contractStatsTracker) get(addr byteutils.Hash) *ContractStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if s, ok := t.stats[addr.Hex()]; ok {
+		copied := *s
+		return &copied
+	}
+	return nil
+}
+
+func (t *contractStatsTracker) top(limit int) []*ContractStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats := make([]*ContractStats, 0, len(t.stats))
+	for _, s := range t.stats {
+		copied := *s
+		stats = append(stats, &copied)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].GasUsed != stats[j].GasUsed {
+			return stats[i].GasUsed > stats[j].GasUsed
+		}
+		return stats[i].Address.Hex() < stats[j].Address.Hex()
+	})
+	if len(stats) > limit {
+		stats = stats[:limit]
+	}
+	return stats
+}
+
+// recordContractStats records the executions of the txs calling or deploying a contract
+// from the receipts of the block, once when the block is put on chain.
+func recordContractStats(block *Block) {
+	for _, tx := range block.transactions {
+		receipt, ok := block.receipts[tx.hash.Hex()]
+		if !ok {
+			continue
+		}
+
+		var addr byteutils.Hash
+		switch tx.Type() {
+		case TxPayloadCallType:
+			addr = tx.to.Bytes()
+		case TxPayloadDeployType:
+			contract, err := tx.GenerateContractAddress()
+			if err != nil {
+				continue
+			}
+			addr = contract.Bytes()
+		default:
+			continue
+		}
+
+		failed := receipt.status == ReceiptStatusFailed
+		if failed {
+			metricsContractExeFailed.Mark(1)
+		}
+		gas := receipt.gasUsed.Uint64()
+		metricsContractGasUsed.Inc(int64(gas))
+		metricsContractExecutedTimer.Update(receipt.duration)
+		contractStats.record(addr, gas, failed, receipt.duration)
+	}
+}
+
+// ContractExecutionStats returns the execution statistics of the contracts using the most gas,
+// or of the given contract only if address is not nil.
+func (bc *BlockChain) ContractExecutionStats(address *Address, limit int) []*ContractStats {
+	if address != nil {
+		if s := contractStats.get(address.Bytes()); s != nil {
+			return []*ContractStats{s}
+		}
+		return []*ContractStats{}
+	}
+	if limit <= 0 {
+		limit = DefaultContractStatsLimit
+	}
+	return contractStats.top(limit)
+}
@@ -0,0 +1,89 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContractStatsTracker(t *testing.T) {
+	tracker := &contractStatsTracker{stats: make(map[byteutils.HexHash]*ContractStats)}
+	contract1 := byteutils.Hash("contract1")
+	contract2 := byteutils.Hash("contract2")
+
+	tracker.record(contract1, 100, false, time.Millisecond)
+	tracker.record(contract1, 300, true, 3*time.Millisecond)
+	tracker.record(contract2, 1000, false, time.Millisecond)
+
+	s := tracker.get(contract1)
+	assert.Equal(t, uint64(2), s.Calls)
+	assert.Equal(t, uint64(1), s.Failures)
+	assert.Equal(t, uint64(400), s.GasUsed)
+	assert.Equal(t, 2*time.Millisecond, s.AverageDuration())
+	assert.Equal(t, 0.5, s.FailureRate())
+	assert.Nil(t, tracker.get(byteutils.Hash("contract3")))
+
+	// sorted by gas used.
+	top := tracker.top(10)
+	assert.Equal(t, 2, len(top))
+	assert.Equal(t, contract2, top[0].Address)
+	assert.Equal(t, contract1, top[1].Address)
+	assert.Equal(t, 1, len(tracker.top(1)))
+
+	// the contract with the least gas used is dropped when full.
+	tracker.evict()
+	assert.Nil(t, tracker.get(contract1))
+	assert.NotNil(t, tracker.get(contract2))
+}
+
+func TestRecordContractStats(t *testing.T) {
+	contractStats = &contractStatsTracker{stats: make(map[byteutils.HexHash]*ContractStats)}
+
+	from := mockAddress()
+	contract := mockAddress()
+	call, _ := NewCallPayload("get", "").ToBytes()
+	tx1 := NewTransaction(100, from, contract, util.NewUint128(), 1, TxPayloadCallType, call, TransactionGasPrice, TransactionMaxGas)
+	tx2 := NewTransaction(100, from, contract, util.NewUint128(), 2, TxPayloadCallType, call, TransactionGasPrice, TransactionMaxGas)
+	tx3 := NewTransaction(100, from, mockAddress(), util.NewUint128(), 3, TxPayloadBinaryType, nil, TransactionGasPrice, TransactionMaxGas)
+
+	block := &Block{transactions: Transactions{tx1, tx2, tx3}}
+	for _, tx := range block.transactions {
+		tx.hash, _ = HashTransaction(tx)
+	}
+	block.executionErrors = map[byteutils.HexHash]string{tx2.hash.Hex(): "failed"}
+	for _, tx := range block.transactions {
+		assert.Nil(t, block.recordReceipt(tx, util.NewUint128FromInt(20000), time.Millisecond))
+	}
+
+	// the executions only count when the block is put on chain.
+	assert.Nil(t, contractStats.get(contract.Bytes()))
+
+	recordContractStats(block)
+	s := contractStats.get(contract.Bytes())
+	assert.Equal(t, uint64(2), s.Calls)
+	assert.Equal(t, uint64(1), s.Failures)
+	assert.Equal(t, uint64(40000), s.GasUsed)
+	assert.Equal(t, 2*time.Millisecond, s.Duration)
+	assert.Equal(t, 1, len(contractStats.top(10)))
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// ExecutionTrace is the execution of a transaction traced in nvm.
+type ExecutionTrace struct {
+	GasUsed *util.Uint128
+	Result  string
+	Err     string
+	Steps   []*nvm.TraceStep
+	Stack   string
+}
+
+// traceExecution executes the tx locally on the block with tracing enabled.
+func traceExecution(ctx context.Context, tx *Transaction, block *Block) *ExecutionTrace {
+	tracer := nvm.NewTracer()
+	gas, result, err := tx.localExecution(ctx, block, tracer)
+
+	trace := &ExecutionTrace{
+		GasUsed: gas,
+		Result:  result,
+		Steps:   tracer.Steps,
+		Stack:   tracer.Stack,
+	}
+	if err != nil {
+		trace.Err = err.Error()
+	}
+	return trace
+}
+
+// DebugCall executes the tx on the tail block with tracing enabled.
+func (bc *BlockChain) DebugCall(ctx context.Context, tx *Transaction) *ExecutionTrace {
+	snapshot, err := bc.TailSnapshot()
+	if err != nil {
+		return &ExecutionTrace{GasUsed: util.NewUint128(), Err: err.Error()}
+	}
+	return traceExecution(ctx, tx, snapshot)
+}
+
+// DebugTransaction re-executes the tx on the canonical chain with tracing enabled,
+// on the state of its parent block with the preceding txs in its block replayed.
+func (bc *BlockChain) DebugTransaction(ctx context.Context, hash byteutils.Hash) (*ExecutionTrace, error) {
+	height, err := bc.GetTransactionHeight(hash)
+	if err != nil {
+		return nil, err
+	}
+	block := bc.GetBlockOnCanonicalChainByHeight(height)
+	if block == nil {
+		return nil, ErrNotBlockInCanonicalChain
+	}
+	parent := bc.GetBlock(block.ParentHash())
+	if parent == nil {
+		return nil, ErrMissingParentBlock
+	}
+	if err := bc.CheckStateAvailable(parent); err != nil {
+		return nil, err
+	}
+
+	replay, err := parent.Clone()
+	if err != nil {
+		return nil, err
+	}
+	replay.header = block.header
+	replay.height = block.height
+	replay.miner = block.miner
+	replay.rewardCoinbase()
+
+	for _, tx := range block.transactions {
+		if tx.Hash().Equals(hash) {
+			// the txs of cached blocks are shared, execute a decoded copy.
+			target := bc.GetTransaction(hash)
+			if target == nil {
+				return nil, ErrTransactionNotInBlock
+			}
+			return traceExecution(ctx, target, replay), nil
+		}
+		if _, err := tx.VerifyExecution(replay); err != nil {
+			return nil, err
+		}
+	}
+	return nil, ErrTransactionNotInBlock
+}
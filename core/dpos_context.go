@@ -19,6 +19,7 @@
 package core
 
 import (
+	"fmt"
 	"hash/fnv"
 	"sort"
 	// "strconv"
@@ -36,16 +37,28 @@ import (
 
 // Consensus Related Constants
 const (
-	BlockInterval        = int64(5)
 	AcceptedNetWorkDelay = int64(2)
 	MaxMintDuration      = int64(2)
 	MinMintDuration      = int64(1)
-	DynastyInterval      = int64(60) // TODO(roy): 3600
-	DynastySize          = 6         // TODO(roy): 21
-	SafeSize             = DynastySize/3 + 1
-	ConsensusSize        = DynastySize*2/3 + 1
+
+	DefaultDynastySize     = 6 // TODO(roy): 21
+	DefaultBlockInterval   = int64(5)
+	DefaultDynastyInterval = int64(60) // TODO(roy): 3600
+
+	// bounds of the genesis params, keep a dynasty able to tolerate a faulty validator.
+	MinDynastySize   = 3
+	MaxDynastySize   = 21
+	MinBlockInterval = int64(1)
+	MaxBlockInterval = int64(60)
+
+	// MaxConsecutiveMissedSlots is the count of consecutive slots missed by a validator before
+	// it's substituted by the top standby candidate in the next dynasty change.
+	MaxConsecutiveMissedSlots = int64(3)
 )
 
+// key prefix of the consecutive missed slots of the validators in mint count trie.
+var missedSlotsKeyPrefix = []byte("missed_slots")
+
 // DposContext carry context in dpos consensus
 type DposContext struct {
 	dynastyTrie     *trie.BatchTrie // key: delegatee, val: delegatee
@@ -53,7 +66,7 @@ type DposContext struct {
 	delegateTrie    *trie.BatchTrie // key: delegatee + delegator, val: delegator
 	voteTrie        *trie.BatchTrie // key: delegator, val: delegatee
 	candidateTrie   *trie.BatchTrie // key: delegatee, val: delegatee
-	mintCntTrie     *trie.BatchTrie // key: dynastyId + delegatee, val: count
+	mintCntTrie     *trie.BatchTrie // key: dynastyId + delegatee, val: count; key: missed_slots + delegatee, val: count
 
 	storage storage.Storage
 }
@@ -219,6 +232,14 @@ type DynastyContext struct {
 	MintCntTrie     *trie.BatchTrie
 	Accounts        state.AccountState
 	Storage         storage.Storage
+	Params          *ChainParams
+
+	// whether the missed slots are counted and the offline validators substituted,
+	// from the missed slots height in genesis params.
+	countMissed bool
+
+	// data of the dynasty.kickout events in the dynasty change.
+	Kickouts []string
 }
 
 func (dc *DynastyContext) tallyVotes() (map[string]*util.Uint128, error) {
@@ -493,7 +514,7 @@ func (dc *DynastyContext) kickoutDynasty(dynastyID int64) error {
 		}
 		if err != storage.ErrKeyNotFound {
 			cnt := byteutils.Int64(bytes)
-			if cnt >= DynastyInterval/BlockInterval/DynastySize/2 {
+			if cnt >= dc.Params.DynastyInterval/dc.Params.BlockInterval/int64(dc.Params.DynastySize)/2 {
 				exist, err = iter.Next()
 				if err != nil {
 					return err
@@ -541,6 +562,176 @@ func (dc *DynastyContext) kickoutDynasty(dynastyID int64) error {
 	return nil
 }
 
+// kickoutSlashed kicks the miners recorded in the slash account out of candidates,
+// the bootstrap validators are not protected.
+func (dc *DynastyContext) kickoutSlashed() error {
+	slash, err := SlashAddress()
+	if err != nil {
+		return err
+	}
+	slashAcc, err := dc.Accounts.GetContractAccount(slash.address)
+	if err != nil {
+		// nobody is slashed.
+		return nil
+	}
+	iter, err := slashAcc.Iterator(nil)
+	if err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	if err != nil {
+		return nil
+	}
+	exist, err := iter.Next()
+	if err != nil {
+		return err
+	}
+	for exist {
+		if err := dc.kickoutCandidate(iter.Value()); err != nil {
+			return err
+		}
+		exist, err = iter.Next()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func missedSlotsKey(validator byteutils.Hash) []byte {
+	return append(append([]byte{}, missedSlotsKeyPrefix...), validator...)
+}
+
+// missedSlots returns the count of consecutive slots missed by the validator.
+func (dc *DynastyContext) missedSlots(validator byteutils.Hash) (int64, error) {
+	bytes, err := dc.MintCntTrie.Get(missedSlotsKey(validator))
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return byteutils.Int64(bytes), nil
+}
+
+// recordMissedSlots counts the slots in [from, to) of current dynasty missed by the proposers.
+func (dc *DynastyContext) recordMissedSlots(from, to int64) error {
+	for slot := from; slot < to; slot += dc.Params.BlockInterval {
+		proposer, err := FindProposer(dc.Params, slot, dc.DynastyTrie)
+		if err != nil {
+			return err
+		}
+		if proposer == nil {
+			continue
+		}
+		missed, err := dc.missedSlots(proposer)
+		if err != nil {
+			return err
+		}
+		if _, err := dc.MintCntTrie.Put(missedSlotsKey(proposer), byteutils.FromInt64(missed+1)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resetMissedSlots clears the consecutive missed slots of the validator.
+func (dc *DynastyContext) resetMissedSlots(validator byteutils.Hash) error {
+	if _, err := dc.MintCntTrie.Del(missedSlotsKey(validator)); err != nil && err != storage.ErrKeyNotFound {
+		return err
+	}
+	return nil
+}
+
+// offlineValidators returns the validators in current and next dynasty missed too many consecutive
+// slots, they are substituted only if there are enough standby candidates.
+func (dc *DynastyContext) offlineValidators(candidates Candidates) (map[byteutils.HexHash]int64, error) {
+	if !dc.countMissed {
+		return nil, nil
+	}
+	offline := make(map[byteutils.HexHash]int64)
+	for _, dynasty := range []*trie.BatchTrie{dc.DynastyTrie, dc.NextDynastyTrie} {
+		members, err := TraverseDynasty(dynasty)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range members {
+			missed, err := dc.missedSlots(v)
+			if err != nil {
+				return nil, err
+			}
+			if missed >= MaxConsecutiveMissedSlots {
+				offline[v.Hex()] = missed
+			}
+		}
+	}
+	if len(excludeCandidates(candidates, offline)) < dc.Params.DynastySize {
+		return nil, nil
+	}
+	return offline, nil
+}
+
+func excludeCandidates(candidates Candidates, excluded map[byteutils.HexHash]int64) Candidates {
+	if len(excluded) == 0 {
+		return candidates
+	}
+	var result Candidates
+	for _, v := range candidates {
+		if _, ok := excluded[v.Address.address.Hex()]; !ok {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// substituteValidators replaces the offline validators in the dynasty with the top standby
+// candidates, the missed slots of the offline validators are reset.
+func (dc *DynastyContext) substituteValidators(dynasty *trie.BatchTrie, offline map[byteutils.HexHash]int64, standby Candidates) error {
+	if len(offline) == 0 {
+		return nil
+	}
+	members, err := TraverseDynasty(dynasty)
+	if err != nil {
+		return err
+	}
+	for _, v := range members {
+		missed, ok := offline[v.Hex()]
+		if !ok {
+			continue
+		}
+		var substitute byteutils.Hash
+		for len(standby) > 0 && substitute == nil {
+			candidate := standby[0].Address.Bytes()
+			standby = standby[1:]
+			if _, err := dynasty.Get(candidate); err == storage.ErrKeyNotFound {
+				substitute = candidate
+			} else if err != nil {
+				return err
+			}
+		}
+		if substitute == nil {
+			break
+		}
+		if _, err := dynasty.Del(v); err != nil {
+			return err
+		}
+		if _, err := dynasty.Put(substitute, substitute); err != nil {
+			return err
+		}
+		dc.Kickouts = append(dc.Kickouts, fmt.Sprintf(`{"validator":"%s", "substitute":"%s", "missed_slots":%d}`,
+			v.String(), substitute.String(), missed))
+	}
+	for k := range offline {
+		validator, err := byteutils.FromHex(string(k))
+		if err != nil {
+			return err
+		}
+		if err := dc.resetMissedSlots(validator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nextDynastyID int64, baseGenesis bool) error {
 	/* 	logging.VLog().WithFields(logrus.Fields{
 		"base":            baseDynastyID,
@@ -562,6 +753,9 @@ func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nex
 				return err
 			}
 		}
+		if err := dc.kickoutSlashed(); err != nil {
+			return err
+		}
 		// kickAt := time.Now().Unix()
 
 		votes, err := dc.tallyVotes()
@@ -574,15 +768,20 @@ func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nex
 		if err != nil {
 			return err
 		}
-		if len(candidates) < SafeSize {
+		if len(candidates) < dc.Params.SafeSize {
 			return ErrTooFewCandidates
 		}
+		offline, err := dc.offlineValidators(candidates)
+		if err != nil {
+			return err
+		}
+		candidates = excludeCandidates(candidates, offline)
 		// chooseAt := time.Now().Unix()
 
 		// Top 20 are selected directly
 		newDynasty := []string{}
 		nextDynastyTrie, err := trie.NewBatchTrie(nil, dc.Storage)
-		directSelected := DynastySize - 1
+		directSelected := dc.Params.DynastySize - 1
 		for i := 0; i < directSelected && i < len(candidates); i++ {
 			delegatee := candidates[i].Address.Bytes()
 			_, err := nextDynastyTrie.Put(delegatee, delegatee)
@@ -599,7 +798,7 @@ func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nex
 			hasher.Write(byteutils.FromInt64(nextDynastyID))
 			hasher.Write(dc.Accounts.RootHash())
 			result := int(hasher.Sum32()) % (len(candidates) - directSelected)
-			offset := result + DynastySize - 1
+			offset := result + dc.Params.DynastySize - 1
 			delegatee := candidates[offset].Address.Bytes()
 			_, err = nextDynastyTrie.Put(delegatee, delegatee)
 			if err != nil {
@@ -609,6 +808,9 @@ func (dc *DynastyContext) electNextDynastyOnBaseDynasty(baseDynastyID int64, nex
 		}
 		// lastAt := time.Now().Unix()
 
+		if err := dc.substituteValidators(dc.NextDynastyTrie, offline, candidates); err != nil {
+			return err
+		}
 		dc.DynastyTrie = dc.NextDynastyTrie
 		dc.NextDynastyTrie = nextDynastyTrie
 
@@ -667,6 +869,7 @@ func (block *Block) LoadDynastyContext(context *DynastyContext) error {
 		mintCntTrie:     mintCntTrie,
 		storage:         block.storage,
 	}
+	block.dynastyKickouts = context.Kickouts
 	return nil
 }
 
@@ -692,7 +895,8 @@ func GenesisDynastyContext(chain *BlockChain, conf *corepb.Genesis) (*DynastyCon
 	if err != nil {
 		return nil, err
 	}
-	if len(conf.Consensus.Dpos.Dynasty) < SafeSize {
+	params := chain.params
+	if len(conf.Consensus.Dpos.Dynasty) < params.SafeSize {
 		return nil, ErrInitialDynastyNotEnough
 	}
 	for i := 0; i < len(conf.Consensus.Dpos.Dynasty); i++ {
@@ -702,7 +906,7 @@ func GenesisDynastyContext(chain *BlockChain, conf *corepb.Genesis) (*DynastyCon
 			return nil, err
 		}
 		v := member.Bytes()
-		if i < DynastySize {
+		if i < params.DynastySize {
 			if _, err = dynastyTrie.Put(v, v); err != nil {
 				return nil, err
 			}
@@ -735,17 +939,18 @@ func GenesisDynastyContext(chain *BlockChain, conf *corepb.Genesis) (*DynastyCon
 		ProtectTrie:     protectTrie,
 		MintCntTrie:     mintTrie,
 		VoteTrie:        voteTrie,
+		Params:          params,
 	}, nil
 }
 
 // FindProposer for now in given dynasty
-func FindProposer(now int64, dynasty *trie.BatchTrie) (proposer byteutils.Hash, err error) {
-	offset := now % DynastyInterval
-	if offset%BlockInterval != 0 {
+func FindProposer(params *ChainParams, now int64, dynasty *trie.BatchTrie) (proposer byteutils.Hash, err error) {
+	offset := now % params.DynastyInterval
+	if offset%params.BlockInterval != 0 {
 		return nil, ErrNotBlockForgTime
 	}
-	offset /= BlockInterval
-	offset %= DynastySize
+	offset /= params.BlockInterval
+	offset %= int64(params.DynastySize)
 	delegatees, err := TraverseDynasty(dynasty)
 	if err != nil {
 		return nil, err
@@ -764,7 +969,8 @@ func FindProposer(now int64, dynasty *trie.BatchTrie) (proposer byteutils.Hash,
 
 // NextDynastyContext when some seconds elapsed
 func (block *Block) NextDynastyContext(chain *BlockChain, elapsedSecond int64) (*DynastyContext, error) {
-	if elapsedSecond%BlockInterval != 0 {
+	params := chain.params
+	if elapsedSecond%params.BlockInterval != 0 {
 		return nil, ErrNotBlockForgTime
 	}
 
@@ -808,10 +1014,25 @@ func (block *Block) NextDynastyContext(chain *BlockChain, elapsedSecond int64) (
 		MintCntTrie:     mintCntTrie,
 		Accounts:        block.accState,
 		Storage:         block.storage,
+		Params:          params,
+		countMissed:     params.MissedSlotsHeight > 0 && block.height+1 >= params.MissedSlotsHeight,
+	}
+
+	baseDynastyID := block.header.timestamp / params.DynastyInterval
+	newDynastyID := context.TimeStamp / params.DynastyInterval
+
+	// the slots skipped in base dynasty, the slots before the first block are not missed.
+	countMissed := context.countMissed && !CheckGenesisBlock(block)
+	end := context.TimeStamp
+	if baseDynastyID < newDynastyID {
+		end = (baseDynastyID + 1) * params.DynastyInterval
+	}
+	if countMissed {
+		if err := context.recordMissedSlots(block.header.timestamp+params.BlockInterval, end); err != nil {
+			return nil, err
+		}
 	}
 
-	baseDynastyID := block.header.timestamp / DynastyInterval
-	newDynastyID := context.TimeStamp / DynastyInterval
 	if baseDynastyID < newDynastyID {
 		if baseDynastyID+1 < newDynastyID {
 			// do not kickout genesis dynasty
@@ -825,15 +1046,33 @@ func (block *Block) NextDynastyContext(chain *BlockChain, elapsedSecond int64) (
 		if err != nil {
 			return nil, err
 		}
+		// the slots skipped in new dynasty.
+		if countMissed {
+			if err := context.recordMissedSlots(newDynastyID*params.DynastyInterval, context.TimeStamp); err != nil {
+				return nil, err
+			}
+		}
 	}
 
-	context.Proposer, err = FindProposer(context.TimeStamp, context.DynastyTrie)
+	context.Proposer, err = FindProposer(params, context.TimeStamp, context.DynastyTrie)
 	if err != nil {
 		return nil, err
 	}
+	// the proposer mints the block in the slot.
+	if context.countMissed && context.Proposer != nil {
+		if err := context.resetMissedSlots(context.Proposer); err != nil {
+			return nil, err
+		}
+	}
 	return context, nil
 }
 
+// Dynasty return the validators of the block's dynasty, the i-th validator mints
+// the i-th slot of the dynasty.
+func (block *Block) Dynasty() ([]byteutils.Hash, error) {
+	return TraverseDynasty(block.dposContext.dynastyTrie)
+}
+
 // TraverseDynasty return all members in the dynasty
 func TraverseDynasty(dynasty *trie.BatchTrie) ([]byteutils.Hash, error) {
 	members := []byteutils.Hash{}
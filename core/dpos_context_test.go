@@ -19,11 +19,13 @@
 package core
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
@@ -33,7 +35,7 @@ import (
 func checkDynasty(t *testing.T, dynasty *trie.BatchTrie) {
 	delegatees, err := TraverseDynasty(dynasty)
 	assert.Nil(t, err)
-	for i := 0; i < DynastySize-1; i++ {
+	for i := 0; i < DefaultDynastySize-1; i++ {
 		assert.Equal(t, string(delegatees[i].Hex()), MockDynasty[i])
 	}
 }
@@ -45,7 +47,7 @@ func TestBlock_NextDynastyContext(t *testing.T) {
 	chain.SetConsensusHandler(c)
 	block, _ := LoadBlockFromStorage(GenesisHash, chain.storage, chain.txPool, neb.emitter)
 
-	context, err := block.NextDynastyContext(chain, BlockInterval)
+	context, err := block.NextDynastyContext(chain, DefaultBlockInterval)
 	assert.Nil(t, err)
 	validators, _ := TraverseDynasty(block.dposContext.dynastyTrie)
 	assert.Equal(t, context.Proposer, validators[1])
@@ -53,7 +55,7 @@ func TestBlock_NextDynastyContext(t *testing.T) {
 	checkDynasty(t, context.DynastyTrie)
 	checkDynasty(t, context.NextDynastyTrie)
 
-	context, err = block.NextDynastyContext(chain, BlockInterval+DynastyInterval)
+	context, err = block.NextDynastyContext(chain, DefaultBlockInterval+DefaultDynastyInterval)
 	assert.Nil(t, err)
 	validators, _ = TraverseDynasty(block.dposContext.dynastyTrie)
 	assert.Equal(t, context.Proposer, validators[1])
@@ -61,18 +63,18 @@ func TestBlock_NextDynastyContext(t *testing.T) {
 	checkDynasty(t, context.DynastyTrie)
 	checkDynasty(t, context.NextDynastyTrie)
 
-	context, err = block.NextDynastyContext(chain, DynastyInterval/2)
+	context, err = block.NextDynastyContext(chain, DefaultDynastyInterval/2)
 	assert.Nil(t, err)
 	validators, _ = TraverseDynasty(block.dposContext.dynastyTrie)
-	assert.Equal(t, context.Proposer, validators[int(DynastyInterval/2/BlockInterval)%DynastySize])
+	assert.Equal(t, context.Proposer, validators[int(DefaultDynastyInterval/2/DefaultBlockInterval)%DefaultDynastySize])
 	// check dynasty
 	checkDynasty(t, context.DynastyTrie)
 	checkDynasty(t, context.NextDynastyTrie)
 
-	context, err = block.NextDynastyContext(chain, DynastyInterval*2+DynastyInterval/3)
+	context, err = block.NextDynastyContext(chain, DefaultDynastyInterval*2+DefaultDynastyInterval/3)
 	assert.Nil(t, err)
 	validators, _ = TraverseDynasty(block.dposContext.dynastyTrie)
-	index := int((DynastyInterval*2+DynastyInterval/3)%DynastyInterval) / int(BlockInterval) % DynastySize
+	index := int((DefaultDynastyInterval*2+DefaultDynastyInterval/3)%DefaultDynastyInterval) / int(DefaultBlockInterval) % DefaultDynastySize
 	assert.Equal(t, context.Proposer, validators[index])
 	// check dynasty
 	checkDynasty(t, context.DynastyTrie)
@@ -109,7 +111,7 @@ func TestBlock_ElectNewDynasty(t *testing.T) {
 	_, err = block.executeTransaction(tx)
 	assert.Nil(t, err)
 	block.commit()
-	context, err := block.NextDynastyContext(chain, DynastyInterval)
+	context, err := block.NextDynastyContext(chain, DefaultDynastyInterval)
 	assert.Nil(t, err)
 	_, err = context.NextDynastyTrie.Get(kickout.Bytes())
 	assert.Equal(t, storage.ErrKeyNotFound, err)
@@ -126,7 +128,7 @@ func TestBlock_Kickout(t *testing.T) {
 	coinbase := &Address{validators[2]}
 
 	block, _ := NewBlock(0, coinbase, chain.tailBlock)
-	block.header.timestamp = DynastyInterval
+	block.header.timestamp = DefaultDynastyInterval
 	context, err := chain.tailBlock.NextDynastyContext(chain, block.Timestamp()-chain.tailBlock.Timestamp())
 	assert.Nil(t, err)
 	block.LoadDynastyContext(context)
@@ -141,7 +143,7 @@ func TestBlock_Kickout(t *testing.T) {
 	checkDynasty(t, chain.tailBlock.dposContext.nextDynastyTrie)
 
 	block, _ = NewBlock(0, coinbase, block)
-	block.header.timestamp = DynastyInterval * 2
+	block.header.timestamp = DefaultDynastyInterval * 2
 	context, err = chain.tailBlock.NextDynastyContext(chain, block.Timestamp()-chain.tailBlock.Timestamp())
 	assert.Nil(t, err)
 	block.LoadDynastyContext(context)
@@ -222,6 +224,104 @@ func TestKickoutDynastyActuallyKickoutCandidates(t *testing.T) {
 	assert.Equal(t, len(candidates), len(neb.Genesis().Consensus.Dpos.Dynasty)-1)
 }
 
+func TestSubstituteOfflineValidators(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Params = &corepb.GenesisParams{MissedSlotsHeight: 1}
+	chain, err := NewBlockChain(neb)
+	dc, err := chain.TailBlock().NextDynastyContext(chain, 0)
+	assert.Nil(t, err)
+	assert.True(t, dc.countMissed)
+	members, err := TraverseDynasty(dc.DynastyTrie)
+	assert.Nil(t, err)
+	offlineMember := members[0]
+
+	// the first member misses its slots in consecutive dynasties.
+	for i := int64(0); i < MaxConsecutiveMissedSlots; i++ {
+		assert.Nil(t, dc.recordMissedSlots(i*DefaultDynastyInterval, i*DefaultDynastyInterval+DefaultBlockInterval))
+	}
+	missed, err := dc.missedSlots(offlineMember)
+	assert.Nil(t, err)
+	assert.Equal(t, MaxConsecutiveMissedSlots, missed)
+
+	var candidates Candidates
+	for _, v := range members {
+		addr, err := AddressParseFromBytes(v)
+		assert.Nil(t, err)
+		candidates = append(candidates, &Candidate{addr, util.NewUint128()})
+	}
+	// no standby candidate to substitute.
+	offline, err := dc.offlineValidators(candidates)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(offline))
+
+	standby := mockAddress()
+	candidates = append(candidates, &Candidate{standby, util.NewUint128()})
+	offline, err = dc.offlineValidators(candidates)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(offline))
+	assert.Nil(t, dc.substituteValidators(dc.NextDynastyTrie, offline, excludeCandidates(candidates, offline)))
+
+	_, err = dc.NextDynastyTrie.Get(offlineMember)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	_, err = dc.NextDynastyTrie.Get(standby.Bytes())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(dc.Kickouts))
+	missed, err = dc.missedSlots(offlineMember)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), missed)
+
+	// the validators are never substituted before the missed slots height.
+	for i := int64(0); i < MaxConsecutiveMissedSlots; i++ {
+		assert.Nil(t, dc.recordMissedSlots(i*DefaultDynastyInterval, i*DefaultDynastyInterval+DefaultBlockInterval))
+	}
+	dc.countMissed = false
+	offline, err = dc.offlineValidators(candidates)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(offline))
+}
+
+func TestBlock_ReplayMissedSlotsBeforeActivation(t *testing.T) {
+	newChain := func(missedSlotsHeight uint64) *BlockChain {
+		neb := testNeb()
+		neb.genesis.Params = &corepb.GenesisParams{MissedSlotsHeight: missedSlotsHeight}
+		chain, err := NewBlockChain(neb)
+		assert.Nil(t, err)
+		var c MockConsensus
+		chain.SetConsensusHandler(c)
+		return chain
+	}
+	mint := func(chain *BlockChain, elapsedSecond int64) *Block {
+		tail := chain.TailBlock()
+		context, err := tail.NextDynastyContext(chain, elapsedSecond)
+		assert.Nil(t, err)
+		proposer := &Address{context.Proposer}
+		block, err := NewBlock(chain.ChainID(), proposer, tail)
+		assert.Nil(t, err)
+		assert.Nil(t, block.LoadDynastyContext(context))
+		block.SetMiner(proposer)
+		assert.Nil(t, block.Seal())
+		assert.Nil(t, chain.BlockPool().Push(BlockFromNetwork(block)))
+		assert.Nil(t, chain.SetTailBlock(block))
+		return block
+	}
+
+	// the blocks of an existing chain, the proposers of 2 slots are missed before block3.
+	history := newChain(0)
+	block2 := mint(history, DefaultBlockInterval)
+	block3 := mint(history, DefaultBlockInterval*3)
+
+	// the blocks before the activation replay with the same dpos context.
+	replay := newChain(4)
+	assert.Nil(t, replay.BlockPool().Push(BlockFromNetwork(block2)))
+	assert.Nil(t, replay.BlockPool().Push(BlockFromNetwork(block3)))
+	assert.Equal(t, block3.Hash(), replay.GetBlock(block3.Hash()).Hash())
+
+	// the missed slots are counted in the dpos context from the activation.
+	activated := newChain(3)
+	assert.Nil(t, activated.BlockPool().Push(BlockFromNetwork(block2)))
+	assert.NotNil(t, activated.BlockPool().Push(BlockFromNetwork(block3)))
+}
+
 func TestCheckActiveBootstrapValidators(t *testing.T) {
 	neb := testNeb()
 	chain, err := NewBlockChain(neb)
@@ -254,7 +354,7 @@ func TestElectNextDynastyOnBaseDynastyWhenTooFewCandidates(t *testing.T) {
 	dc, err := chain.TailBlock().NextDynastyContext(chain, 0)
 	members, err := TraverseDynasty(dc.CandidateTrie)
 	assert.Nil(t, err)
-	for i := 0; i < len(members)-SafeSize+1; i++ {
+	for i := 0; i < len(members)-chain.Params().SafeSize+1; i++ {
 		assert.Nil(t, dc.kickoutCandidate(members[i]))
 	}
 	assert.Equal(t, dc.electNextDynastyOnBaseDynasty(0, 1, false), ErrTooFewCandidates)
@@ -270,9 +370,83 @@ func TestTraverseDynasty(t *testing.T) {
 	assert.Equal(t, members, []byteutils.Hash{})
 }
 
+func TestBlock_Dynasty(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	dynasty, err := bc.GenesisBlock().Dynasty()
+	assert.Nil(t, err)
+	assert.Equal(t, len(MockDynasty), len(dynasty))
+	for _, v := range dynasty {
+		assert.Contains(t, MockDynasty, v.String())
+	}
+}
+
 func TestInitialDynastyNotEnough(t *testing.T) {
 	neb := testNeb()
 	neb.genesis.Consensus.Dpos.Dynasty = []string{}
 	_, err := NewBlockChain(neb)
 	assert.Equal(t, err, ErrInitialDynastyNotEnough)
 }
+
+func TestGovernanceEvents(t *testing.T) {
+	neb := testNeb()
+	chain, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	block, err := chain.NewBlock(mockAddress())
+	assert.Nil(t, err)
+	block.header.timestamp = DefaultDynastyInterval
+	for len(chain.eventEmitter.eventCh) > 0 {
+		<-chain.eventEmitter.eventCh
+	}
+	delegatee := mockAddress()
+	payload, err := NewDelegatePayload(DelegateAction, delegatee.String()).ToBytes()
+	assert.Nil(t, err)
+	tx := mockTransaction(chain.ChainID(), 1, TxPayloadDelegateType, payload)
+	block.triggerGovernanceEvent(tx)
+	block.triggerDynastyChangeEvent()
+
+	e := <-chain.eventEmitter.eventCh
+	assert.Equal(t, TopicVoteChange, e.Topic)
+	vote := new(VoteChangeEvent)
+	assert.Nil(t, json.Unmarshal([]byte(e.Data), vote))
+	assert.Equal(t, tx.from.String(), vote.Delegator)
+	assert.Equal(t, delegatee.String(), vote.Delegatee)
+	assert.Equal(t, DelegateAction, vote.Action)
+
+	e = <-chain.eventEmitter.eventCh
+	assert.Equal(t, TopicDynastyChange, e.Topic)
+	dynasty := new(DynastyChangeEvent)
+	assert.Nil(t, json.Unmarshal([]byte(e.Data), dynasty))
+	assert.Equal(t, int64(1), dynasty.Dynasty)
+	members, err := block.Dynasty()
+	assert.Nil(t, err)
+	assert.Equal(t, len(members), len(dynasty.Members))
+
+	// no dynasty change inside a dynasty.
+	block.header.timestamp = DefaultBlockInterval
+	block.triggerDynastyChangeEvent()
+	select {
+	case e = <-chain.eventEmitter.eventCh:
+		t.Errorf("unexpected event %s", e.Topic)
+	default:
+	}
+}
+
+func TestBlockChain_VoteSnapshot(t *testing.T) {
+	neb := testNeb()
+	chain, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	snapshot, err := chain.VoteSnapshot(0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), snapshot.Height)
+	assert.Equal(t, len(neb.genesis.Consensus.Dpos.Dynasty), len(snapshot.Candidates))
+	assert.Equal(t, 0, len(snapshot.Delegations))
+	for i := 1; i < len(snapshot.Candidates); i++ {
+		assert.False(t, snapshot.Candidates[i].Votes.Cmp(snapshot.Candidates[i-1].Votes.Int) > 0)
+	}
+
+	_, err = chain.VoteSnapshot(100)
+	assert.Equal(t, ErrNotBlockInCanonicalChain, err)
+}
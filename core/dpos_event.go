@@ -0,0 +1,127 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// DynastyChangeEvent is the data of TopicDynastyChange.
+type DynastyChangeEvent struct {
+	Dynasty int64    `json:"dynasty"`
+	Height  uint64   `json:"height"`
+	Members []string `json:"members"`
+}
+
+// CandidateChangeEvent is the data of TopicCandidateChange.
+type CandidateChangeEvent struct {
+	Candidate string `json:"candidate"`
+	Action    string `json:"action"`
+	Tx        string `json:"tx"`
+	Height    uint64 `json:"height"`
+}
+
+// VoteChangeEvent is the data of TopicVoteChange.
+type VoteChangeEvent struct {
+	Delegator string `json:"delegator"`
+	Delegatee string `json:"delegatee"`
+	Action    string `json:"action"`
+	Tx        string `json:"tx"`
+	Height    uint64 `json:"height"`
+}
+
+// triggerGovernanceEvent emits the candidate and vote changes of a succeeded tx,
+// they are not recorded in the events trie so the events root of blocks keeps unchanged.
+func (block *Block) triggerGovernanceEvent(tx *Transaction) {
+	var (
+		topic string
+		data  interface{}
+	)
+	switch tx.Type() {
+	case TxPayloadCandidateType:
+		payload, err := LoadCandidatePayload(tx.data.Payload)
+		if err != nil {
+			return
+		}
+		topic = TopicCandidateChange
+		data = &CandidateChangeEvent{
+			Candidate: tx.from.String(),
+			Action:    payload.Action,
+			Tx:        tx.hash.String(),
+			Height:    block.height,
+		}
+	case TxPayloadDelegateType:
+		payload, err := LoadDelegatePayload(tx.data.Payload)
+		if err != nil {
+			return
+		}
+		topic = TopicVoteChange
+		data = &VoteChangeEvent{
+			Delegator: tx.from.String(),
+			Delegatee: payload.Delegatee,
+			Action:    payload.Action,
+			Tx:        tx.hash.String(),
+			Height:    block.height,
+		}
+	default:
+		return
+	}
+	block.triggerJSONEvent(topic, data)
+}
+
+// triggerDynastyChangeEvent emits the new dynasty if the block is the first one in it.
+func (block *Block) triggerDynastyChangeEvent() {
+	parent := block.parenetBlock
+	if parent == nil {
+		return
+	}
+	interval := block.chainParams().DynastyInterval
+	dynastyID := block.Timestamp() / interval
+	if dynastyID == parent.Timestamp()/interval {
+		return
+	}
+	dynasty, err := block.Dynasty()
+	if err != nil {
+		return
+	}
+	event := &DynastyChangeEvent{Dynasty: dynastyID, Height: block.height}
+	for _, v := range dynasty {
+		member, err := AddressParseFromBytes(v)
+		if err != nil {
+			return
+		}
+		event.Members = append(event.Members, member.String())
+	}
+	block.triggerJSONEvent(TopicDynastyChange, event)
+}
+
+func (block *Block) triggerJSONEvent(topic string, data interface{}) {
+	bytes, err := json.Marshal(data)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"topic": topic,
+			"err":   err,
+		}).Debug("Failed to marshal event.")
+		return
+	}
+	block.eventEmitter.Trigger(&Event{Topic: topic, Data: string(bytes)})
+}
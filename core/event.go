@@ -45,6 +45,33 @@ const (
 	// TopicCandidate the topic of candidate.
 	TopicCandidate = "chain.candidate"
 
+	// TopicMultisig the topic of transfer from a multisig wallet.
+	TopicMultisig = "chain.multisig"
+
+	// TopicBatch the topic of batch transfer.
+	TopicBatch = "chain.batch"
+
+	// TopicBatchTransfer the topic of transfer to each recipient in batch.
+	TopicBatchTransfer = "chain.batchTransfer"
+
+	// TopicTimelock the topic of locking or releasing value in a timelock.
+	TopicTimelock = "chain.timelock"
+
+	// TopicUpgradeSmartContract the topic of upgrading the code of a smart contract.
+	TopicUpgradeSmartContract = "chain.upgradeSmartContract"
+
+	// TopicVerifySmartContract the topic of verifying the source of a smart contract.
+	TopicVerifySmartContract = "chain.verifySmartContract"
+
+	// TopicContractPause the topic of a validator voting to pause or unpause a smart contract.
+	TopicContractPause = "chain.contractPause"
+
+	// TopicSlash the topic of slashing a miner minted multiple blocks in a slot.
+	TopicSlash = "chain.slash"
+
+	// TopicDoubleMint the topic of found a miner minted multiple blocks in a slot, the data is the slash payload.
+	TopicDoubleMint = "chain.doubleMint"
+
 	// TopicLinkBlock the topic of link a block.
 	TopicLinkBlock = "chain.linkBlock"
 
@@ -53,12 +80,40 @@ const (
 
 	// TopicExecuteTxSuccess the topic of execute a transaction success.
 	TopicExecuteTxSuccess = "chain.executeTxSuccess"
+
+	// TopicReorgRecoveredTransaction the topic of resubmit a transaction in reverted block.
+	TopicReorgRecoveredTransaction = "chain.reorgRecoveredTransaction"
+
+	// TopicReplaceTransaction the topic of replace a pending transaction with higher gas price.
+	TopicReplaceTransaction = "chain.replaceTransaction"
+
+	// TopicEvictTransaction the topic of evict a transaction from transaction_pool when full or expired.
+	TopicEvictTransaction = "chain.evictTransaction"
+
+	// TopicDynastyKickout the topic of substitute an offline validator with a standby candidate in dynasty change.
+	TopicDynastyKickout = "dynasty.kickout"
+
+	// TopicDynastyChange the topic of rotate to a new dynasty, the data is the dynasty id and its members.
+	TopicDynastyChange = "dynasty.change"
+
+	// TopicCandidateChange the topic of a candidate login or logout.
+	TopicCandidateChange = "dynasty.candidate"
+
+	// TopicVoteChange the topic of a delegator vote or unvote a candidate.
+	TopicVoteChange = "dynasty.vote"
+
+	// TopicReorg the topic of switch the canonical chain to another fork.
+	TopicReorg = "chain.reorg"
+
+	// TopicFinalizedBlock the topic of advance the latest irreversible block, the data is the new lib.
+	TopicFinalizedBlock = "block.finalized"
 )
 
 // Event event structure.
 type Event struct {
-	Topic string
-	Data  string
+	Topic   string
+	Data    string
+	Indexed []string `json:",omitempty"`
 }
 
 // EventEmitter provide event functionality for Nebulas.
@@ -0,0 +1,243 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"strconv"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+)
+
+// Event bloom filter settings.
+const (
+	// BloomByteLength is the length of event bloom filter, 2048 bits.
+	BloomByteLength = 256
+
+	// bloomHashes is the count of bits set for each item.
+	bloomHashes = 3
+
+	// MaxEventFilterBlocks is the max count of blocks scanned in a FilterEvents call.
+	MaxEventFilterBlocks = 10000
+)
+
+// indexedBloomItem is the bloom item of the indexed param at the position.
+func indexedBloomItem(position int, param string) []byte {
+	return []byte(strconv.Itoa(position) + ":" + param)
+}
+
+// Bloom is the bloom filter of the event topics, contract addresses and indexed params in a block.
+type Bloom [BloomByteLength]byte
+
+// Add adds the item to the bloom.
+func (b *Bloom) Add(item []byte) {
+	for _, bit := range bloomBits(item) {
+		b[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Test return false if the item is definitely not in the bloom.
+func (b *Bloom) Test(item []byte) bool {
+	for _, bit := range bloomBits(item) {
+		if b[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *Bloom) testAny(items [][]byte) bool {
+	if len(items) == 0 {
+		return true
+	}
+	for _, v := range items {
+		if b.Test(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func bloomBits(item []byte) []uint {
+	h := hash.Sha3256(item)
+	bits := make([]uint, bloomHashes)
+	for i := range bits {
+		bits[i] = (uint(h[2*i])<<8 | uint(h[2*i+1])) % (BloomByteLength * 8)
+	}
+	return bits
+}
+
+// EventFilter selects the events on canonical chain in [FromHeight, ToHeight], an event
+// matches if its topic is any of Topics and its contract is any of Contracts, empty
+// Topics or Contracts matches all. Indexed are the params expected at their positions
+// in the indexed params of event, an empty one matches any param.
+type EventFilter struct {
+	FromHeight uint64
+	ToHeight   uint64
+	Topics     []string
+	Contracts  []*Address
+	Indexed    []string
+	Limit      int
+}
+
+// matchIndexed return true if the indexed params of event match the filter.
+func (filter *EventFilter) matchIndexed(e *Event) bool {
+	for i, v := range filter.Indexed {
+		if len(v) == 0 {
+			continue
+		}
+		if i >= len(e.Indexed) || e.Indexed[i] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// testIndexed return false if any expected indexed param is definitely not in the bloom.
+func (filter *EventFilter) testIndexed(bloom *Bloom) bool {
+	for i, v := range filter.Indexed {
+		if len(v) > 0 && !bloom.Test(indexedBloomItem(i, v)) {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterEvents return the matching events, the blocks are skipped by their bloom filters.
+// The events of a block are never split, so at most one block of events exceeding the limit
+// are returned. The returned height is the next block to scan, the scan ends at tail.
+func (bc *BlockChain) FilterEvents(filter *EventFilter) ([]*CursorEvent, uint64, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = DefaultEventCursorLimit
+	}
+	if limit > MaxEventCursorLimit {
+		limit = MaxEventCursorLimit
+	}
+
+	from, to := filter.FromHeight, filter.ToHeight
+	if from == 0 {
+		from = bc.genesisBlock.Height()
+	}
+	if tail := bc.TailBlock().Height(); to == 0 || to > tail {
+		to = tail
+	}
+	if to >= from && to-from >= MaxEventFilterBlocks {
+		to = from + MaxEventFilterBlocks - 1
+	}
+
+	topics := make(map[string]bool)
+	var items, contractItems [][]byte
+	for _, v := range filter.Topics {
+		topics[v] = true
+		items = append(items, []byte(v))
+	}
+	contracts := make(map[string]bool)
+	for _, v := range filter.Contracts {
+		contracts[v.String()] = true
+		contractItems = append(contractItems, v.Bytes())
+	}
+
+	var events []*CursorEvent
+	height := from
+	for ; height <= to && len(events) < limit; height++ {
+		block := bc.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			return nil, 0, ErrNotBlockInCanonicalChain
+		}
+		bloom, err := bc.EventBloom(block)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !bloom.testAny(items) || !bloom.testAny(contractItems) || !filter.testIndexed(bloom) {
+			continue
+		}
+
+		index := uint32(0)
+		for _, tx := range block.transactions {
+			txEvents, err := block.FetchEvents(tx.hash)
+			if err != nil {
+				return nil, 0, err
+			}
+			matched := len(contracts) == 0
+			if contract := tx.eventContract(); !matched && contract != nil {
+				matched = contracts[contract.String()]
+			}
+			for _, e := range txEvents {
+				if matched && (len(topics) == 0 || topics[e.Topic]) && filter.matchIndexed(e) {
+					events = append(events, &CursorEvent{Event: e, Height: height, Index: index, TxHash: tx.hash})
+				}
+				index++
+			}
+		}
+	}
+	return events, height, nil
+}
+
+// EventBloom return the bloom filter of the events in block, it's calculated and
+// stored if not found in storage.
+func (bc *BlockChain) EventBloom(block *Block) (*Bloom, error) {
+	key := append([]byte(EventBloomPrefix), block.Hash()...)
+	value, err := bc.storage.Get(key)
+	if err == nil && len(value) == BloomByteLength {
+		bloom := new(Bloom)
+		copy(bloom[:], value)
+		return bloom, nil
+	}
+	if err != nil && err != storage.ErrKeyNotFound {
+		return nil, err
+	}
+
+	bloom := new(Bloom)
+	for _, tx := range block.transactions {
+		events, err := block.FetchEvents(tx.hash)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) == 0 {
+			continue
+		}
+		if contract := tx.eventContract(); contract != nil {
+			bloom.Add(contract.Bytes())
+		}
+		for _, e := range events {
+			bloom.Add([]byte(e.Topic))
+			for i, v := range e.Indexed {
+				bloom.Add(indexedBloomItem(i, v))
+			}
+		}
+	}
+	if err := bc.storage.Put(key, bloom[:]); err != nil {
+		return nil, err
+	}
+	return bloom, nil
+}
+
+// eventContract return the contract emitting the events of the tx, nil if it's not a contract tx.
+func (tx *Transaction) eventContract() *Address {
+	switch tx.Type() {
+	case TxPayloadCallType, TxPayloadUpgradeType, TxPayloadVerifyType, TxPayloadPauseType:
+		return tx.to
+	case TxPayloadDeployType:
+		if addr, err := tx.GenerateContractAddress(); err == nil {
+			return addr
+		}
+	}
+	return nil
+}
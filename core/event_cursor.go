@@ -0,0 +1,147 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Limits of the events fetched after cursor.
+const (
+	DefaultEventCursorLimit  = 100
+	MaxEventCursorLimit      = 1000
+	MaxEventCursorScanBlocks = 1024
+)
+
+// EventCursor is the position of the last acknowledged event, index is the
+// position of the event in all events of the transactions of the block.
+type EventCursor struct {
+	Height uint64
+	Index  uint32
+}
+
+// CursorEvent is the event with its position on canonical chain.
+type CursorEvent struct {
+	*Event
+	Height uint64
+	Index  uint32
+	TxHash byteutils.Hash
+}
+
+// LoadEventCursor return the committed cursor of the consumer, nil if not committed.
+func (bc *BlockChain) LoadEventCursor(consumer string) (*EventCursor, error) {
+	if len(consumer) == 0 {
+		return nil, ErrInvalidEventConsumer
+	}
+	value, err := bc.storage.Get(eventCursorKey(consumer))
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(value) != 12 {
+		return nil, ErrInvalidEventCursor
+	}
+	return &EventCursor{
+		Height: byteutils.Uint64(value[:8]),
+		Index:  byteutils.Uint32(value[8:]),
+	}, nil
+}
+
+// CommitEventCursor store the cursor of the consumer.
+func (bc *BlockChain) CommitEventCursor(consumer string, cursor *EventCursor) error {
+	if len(consumer) == 0 {
+		return ErrInvalidEventConsumer
+	}
+	if cursor.Height > bc.TailBlock().Height() {
+		return ErrInvalidEventCursor
+	}
+	value := append(byteutils.FromUint64(cursor.Height), byteutils.FromUint32(cursor.Index)...)
+	return bc.storage.Put(eventCursorKey(consumer), value)
+}
+
+// DeleteEventCursor remove the cursor of the consumer.
+func (bc *BlockChain) DeleteEventCursor(consumer string) error {
+	if len(consumer) == 0 {
+		return ErrInvalidEventConsumer
+	}
+	return bc.storage.Del(eventCursorKey(consumer))
+}
+
+// EventsAfterCursor return at most limit events on canonical chain strictly after the cursor,
+// all events are returned from genesis if cursor is nil. The returned cursor is the position
+// of the last returned event, or of the last scanned block if it has no events, which can be
+// committed to fetch the following events.
+func (bc *BlockChain) EventsAfterCursor(cursor *EventCursor, limit int) ([]*CursorEvent, *EventCursor, error) {
+	if limit <= 0 {
+		limit = DefaultEventCursorLimit
+	}
+	if limit > MaxEventCursorLimit {
+		limit = MaxEventCursorLimit
+	}
+
+	height := bc.genesisBlock.Height()
+	if cursor != nil {
+		height = cursor.Height
+	}
+
+	var events []*CursorEvent
+	next := cursor
+	tail := bc.TailBlock().Height()
+	for scanned := 0; height <= tail && scanned < MaxEventCursorScanBlocks; height, scanned = height+1, scanned+1 {
+		block := bc.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			return nil, nil, ErrNotBlockInCanonicalChain
+		}
+		inCursorBlock := cursor != nil && height == cursor.Height
+
+		index := uint32(0)
+		for _, tx := range block.transactions {
+			txEvents, err := block.FetchEvents(tx.hash)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, e := range txEvents {
+				// skip the acknowledged events in the block of cursor.
+				if inCursorBlock && index <= cursor.Index {
+					index++
+					continue
+				}
+				if len(events) >= limit {
+					return events, next, nil
+				}
+				events = append(events, &CursorEvent{Event: e, Height: height, Index: index, TxHash: tx.hash})
+				next = &EventCursor{Height: height, Index: index}
+				index++
+			}
+		}
+
+		// move the cursor over the blocks without events.
+		if index == 0 && !inCursorBlock {
+			next = &EventCursor{Height: height, Index: 0}
+		}
+	}
+	return events, next, nil
+}
+
+func eventCursorKey(consumer string) []byte {
+	return append([]byte(EventCursorPrefix), []byte(consumer)...)
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sort"
+)
+
+// DefaultForkDepth is the default max depth below the tail of the common ancestor of forks listed.
+const DefaultForkDepth = 128
+
+// Fork is a side branch competing with the canonical chain.
+type Fork struct {
+	Tip      *Block
+	Ancestor *Block // the common ancestor with the canonical chain.
+	Length   uint64 // count of blocks in the branch since the ancestor.
+}
+
+// Forks returns the side branches seen recently whose common ancestor with the canonical
+// chain is at most depth blocks below the tail, the longest branches come first. The
+// branches persisting or mined by the same miner repeatedly indicate a network partition
+// or a misbehaving miner.
+func (bc *BlockChain) Forks(depth uint64) []*Fork {
+	if depth == 0 {
+		depth = DefaultForkDepth
+	}
+	tail := bc.TailBlock()
+
+	forks := make([]*Fork, 0)
+	for _, tip := range bc.DetachedTailBlocks() {
+		// the ancestor of a tip deeper than depth is deeper too.
+		if tip.Hash().Equals(tail.Hash()) || tip.Height()+depth < tail.Height() {
+			continue
+		}
+		ancestor, err := bc.FindCommonAncestorWithTail(tip)
+		if err != nil || ancestor.Hash().Equals(tip.Hash()) {
+			continue
+		}
+		if ancestor.Height()+depth < tail.Height() {
+			continue
+		}
+		forks = append(forks, &Fork{
+			Tip:      tip,
+			Ancestor: ancestor,
+			Length:   tip.Height() - ancestor.Height(),
+		})
+	}
+
+	sort.Slice(forks, func(i, j int) bool {
+		if forks[i].Length != forks[j].Length {
+			return forks[i].Length > forks[j].Length
+		}
+		return forks[i].Tip.Height() > forks[j].Tip.Height()
+	})
+	return forks
+}
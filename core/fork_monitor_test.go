@@ -0,0 +1,67 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockChain_Forks(t *testing.T) {
+	bc, _ := NewBlockChain(testNeb())
+	var c MockConsensus
+	bc.SetConsensusHandler(c)
+
+	/*
+		genesis -- 11 -- 111 tail
+				\_ 12
+	*/
+	coinbase11 := &Address{[]byte("012345678901234567890011")}
+	coinbase12 := &Address{[]byte("012345678901234567890012")}
+	coinbase111 := &Address{[]byte("012345678901234567890111")}
+	block11, _ := bc.NewBlock(coinbase11)
+	block11.header.timestamp = DefaultBlockInterval
+	block11.SetMiner(coinbase11)
+	block11.Seal()
+	block12, _ := bc.NewBlock(coinbase12)
+	block12.header.timestamp = DefaultBlockInterval * 2
+	block12.SetMiner(coinbase12)
+	block12.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block11)))
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block12)))
+	assert.Nil(t, bc.SetTailBlock(block11))
+
+	block111, _ := bc.NewBlock(coinbase111)
+	block111.header.timestamp = DefaultBlockInterval * 3
+	block111.SetMiner(coinbase111)
+	block111.Seal()
+	assert.Nil(t, bc.BlockPool().Push(BlockFromNetwork(block111)))
+	assert.Nil(t, bc.SetTailBlock(block111))
+
+	forks := bc.Forks(DefaultForkDepth)
+	assert.Equal(t, 1, len(forks))
+	assert.Equal(t, block12.Hash(), forks[0].Tip.Hash())
+	assert.Equal(t, bc.GenesisBlock().Hash(), forks[0].Ancestor.Hash())
+	assert.Equal(t, uint64(1), forks[0].Length)
+	assert.Equal(t, coinbase12.String(), forks[0].Tip.Miner().String())
+
+	// the forks deeper than depth are not listed.
+	assert.Equal(t, 0, len(bc.Forks(1)))
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// MaxGasRefundQuotient caps the gas refund of a transaction at 1/5 of the gas it used.
+const MaxGasRefundQuotient = 5
+
+// gasRefund returns the refund of the gas used by the tx in the block at the height.
+func (params *ChainParams) gasRefund(height uint64, gasUsed *util.Uint128, refund uint64) *util.Uint128 {
+	if params.StorageRefundHeight == 0 || height < params.StorageRefundHeight || refund == 0 {
+		return util.NewUint128()
+	}
+	if max := gasUsed.Uint64() / MaxGasRefundQuotient; refund > max {
+		refund = max
+	}
+	return util.NewUint128FromBigInt(new(big.Int).SetUint64(refund))
+}
+
+func (block *Block) recordGasRefund(txHash byteutils.Hash, refund *util.Uint128) {
+	if refund.Sign() == 0 {
+		return
+	}
+	if block.gasRefunds == nil {
+		block.gasRefunds = make(map[byteutils.HexHash]*util.Uint128)
+	}
+	block.gasRefunds[txHash.Hex()] = refund
+}
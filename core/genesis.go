@@ -19,9 +19,12 @@
 package core
 
 import (
+	"bytes"
 	"io/ioutil"
+	"path/filepath"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/jsonpb"
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/core/state"
@@ -38,21 +41,195 @@ var (
 	GenesisCoinbase  = &Address{make([]byte, AddressLength)}
 )
 
-// LoadGenesisConf load genesis conf for file
+// LoadGenesisConf load genesis conf for file, the file with .json extension is
+// parsed as JSON, otherwise as protobuf text.
 func LoadGenesisConf(filePath string) (*corepb.Genesis, error) {
 	b, err := ioutil.ReadFile(filePath)
 	if err != nil {
 		return nil, err
 	}
-	content := string(b)
 
 	genesis := new(corepb.Genesis)
-	if err := proto.UnmarshalText(content, genesis); err != nil {
+	if filepath.Ext(filePath) == ".json" {
+		if err := jsonpb.Unmarshal(bytes.NewReader(b), genesis); err != nil {
+			return nil, err
+		}
+		return genesis, nil
+	}
+	if err := proto.UnmarshalText(string(b), genesis); err != nil {
 		return nil, err
 	}
 	return genesis, nil
 }
 
+// MarshalGenesisConf returns the genesis conf in indented JSON.
+func MarshalGenesisConf(conf *corepb.Genesis) (string, error) {
+	marshaler := &jsonpb.Marshaler{OrigName: true, Indent: "    "}
+	return marshaler.MarshalToString(conf)
+}
+
+// NewGenesisConf returns a genesis conf with the default params, the dynasty members
+// are allocated the balance each.
+func NewGenesisConf(chainID uint32, dynasty []string, balance string) *corepb.Genesis {
+	conf := &corepb.Genesis{
+		Meta: &corepb.GenesisMeta{ChainId: chainID},
+		Consensus: &corepb.GenesisConsensus{
+			Dpos: &corepb.GenesisConsensusDpos{Dynasty: dynasty},
+		},
+		Params: GenesisParams(nil),
+	}
+	for _, v := range dynasty {
+		conf.TokenDistribution = append(conf.TokenDistribution, &corepb.GenesisTokenDistribution{
+			Address: v,
+			Value:   balance,
+		})
+	}
+	return conf
+}
+
+// GenesisParams returns the params of genesis conf, the defaults are filled if not specified.
+func GenesisParams(conf *corepb.Genesis) *corepb.GenesisParams {
+	params := &corepb.GenesisParams{
+		BlockInterval:   DefaultBlockInterval,
+		DynastyInterval: DefaultDynastyInterval,
+		DynastySize:     DefaultDynastySize,
+		RewardSchedule:  DefaultRewardSchedule(),
+		GasPrice:        TransactionGasPrice.String(),
+		GasLimit:        TransactionMaxGas.String(),
+		BlockGasLimit:   DefaultBlockGasLimit,
+	}
+	if conf == nil || conf.Params == nil {
+		return params
+	}
+	if conf.Params.BlockInterval > 0 {
+		params.BlockInterval = conf.Params.BlockInterval
+	}
+	if conf.Params.DynastyInterval > 0 {
+		params.DynastyInterval = conf.Params.DynastyInterval
+	}
+	if conf.Params.DynastySize > 0 {
+		params.DynastySize = conf.Params.DynastySize
+	}
+	if len(conf.Params.RewardSchedule) > 0 {
+		params.RewardSchedule = conf.Params.RewardSchedule
+	}
+	if len(conf.Params.GasPrice) > 0 {
+		params.GasPrice = conf.Params.GasPrice
+	}
+	if len(conf.Params.GasLimit) > 0 {
+		params.GasLimit = conf.Params.GasLimit
+	}
+	if conf.Params.BlockGasLimit > 0 {
+		params.BlockGasLimit = conf.Params.BlockGasLimit
+	}
+	params.StorageRefundHeight = conf.Params.StorageRefundHeight
+	params.IndexedEventHeight = conf.Params.IndexedEventHeight
+	params.HostBindingsCheckHeight = conf.Params.HostBindingsCheckHeight
+	params.SeededRandomHeight = conf.Params.SeededRandomHeight
+	params.BlockGasLimitHeight = conf.Params.BlockGasLimitHeight
+	params.MissedSlotsHeight = conf.Params.MissedSlotsHeight
+	params.ContractTransferHeight = conf.Params.ContractTransferHeight
+	params.BlockContextHeight = conf.Params.BlockContextHeight
+	params.MultisigPayloadHeight = conf.Params.MultisigPayloadHeight
+	params.BatchPayloadHeight = conf.Params.BatchPayloadHeight
+	params.TimelockPayloadHeight = conf.Params.TimelockPayloadHeight
+	params.SlashPayloadHeight = conf.Params.SlashPayloadHeight
+	params.UpgradePayloadHeight = conf.Params.UpgradePayloadHeight
+	params.VerifyPayloadHeight = conf.Params.VerifyPayloadHeight
+	params.PausePayloadHeight = conf.Params.PausePayloadHeight
+	return params
+}
+
+// genesisGasLimit returns the gas limit of the genesis block, the chains created without
+// block_gas_limit in genesis conf are unlimited until the block gas limit height.
+func genesisGasLimit(conf *corepb.Genesis) uint64 {
+	if conf == nil || conf.Params == nil {
+		return 0
+	}
+	return conf.Params.BlockGasLimit
+}
+
+func validateGenesisParams(params *corepb.GenesisParams) error {
+	if params.DynastySize < MinDynastySize || params.DynastySize > MaxDynastySize {
+		return ErrInvalidGenesisDynastySize
+	}
+	if params.BlockInterval < MinBlockInterval || params.BlockInterval > MaxBlockInterval ||
+		params.DynastyInterval%(params.BlockInterval*int64(params.DynastySize)) != 0 {
+		return ErrInvalidGenesisInterval
+	}
+	if err := validateRewardSchedule(params.RewardSchedule); err != nil {
+		return err
+	}
+	gasPrice, ok := parseGenesisAmount(params.GasPrice)
+	if !ok || gasPrice.Sign() == 0 {
+		return ErrInvalidGenesisGas
+	}
+	gasLimit, ok := parseGenesisAmount(params.GasLimit)
+	if !ok || gasLimit.Sign() == 0 || gasLimit.Cmp(TransactionMaxGas.Int) > 0 {
+		return ErrInvalidGenesisGas
+	}
+	// the txs accepted by the pool must fit in a block.
+	if params.BlockGasLimit < MinBlockGasLimit || params.BlockGasLimit > MaxBlockGasLimit || gasLimit.Uint64() > params.BlockGasLimit {
+		return ErrInvalidGenesisGas
+	}
+	return nil
+}
+
+func parseGenesisAmount(s string) (*util.Uint128, bool) {
+	v, ok := util.NewUint128().FromString(s)
+	if !ok || v.Validate() != nil {
+		return nil, false
+	}
+	return v, true
+}
+
+// ValidateGenesisConf checks the genesis conf is able to create a genesis block.
+func ValidateGenesisConf(conf *corepb.Genesis) error {
+	if conf.Meta == nil || conf.Meta.ChainId == 0 {
+		return ErrInvalidChainID
+	}
+	dynastySize := int(GenesisParams(conf).DynastySize)
+	if conf.Consensus == nil || conf.Consensus.Dpos == nil || len(conf.Consensus.Dpos.Dynasty) < safeSize(dynastySize) {
+		return ErrInitialDynastyNotEnough
+	}
+	members := make(map[string]bool)
+	for _, v := range conf.Consensus.Dpos.Dynasty {
+		if _, err := AddressParse(v); err != nil {
+			return err
+		}
+		if members[v] {
+			return ErrDuplicatedGenesisAddress
+		}
+		members[v] = true
+	}
+	if conf.Consensus.Poa != nil {
+		if len(conf.Consensus.Poa.Signers) == 0 {
+			return ErrInvalidGenesisSigners
+		}
+		signers := make(map[string]bool)
+		for _, v := range conf.Consensus.Poa.Signers {
+			if _, err := AddressParse(v); err != nil || signers[v] {
+				return ErrInvalidGenesisSigners
+			}
+			signers[v] = true
+		}
+	}
+	accounts := make(map[string]bool)
+	for _, v := range conf.TokenDistribution {
+		if _, err := AddressParse(v.Address); err != nil {
+			return err
+		}
+		if accounts[v.Address] {
+			return ErrDuplicatedGenesisAddress
+		}
+		accounts[v.Address] = true
+		if _, ok := parseGenesisAmount(v.Value); !ok {
+			return ErrInvalidGenesisBalance
+		}
+	}
+	return validateGenesisParams(GenesisParams(conf))
+}
+
 // NewGenesisBlock create genesis @Block from file.
 func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 	accState, err := state.NewAccountState(nil, chain.storage)
@@ -79,6 +256,7 @@ func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 			coinbase:    GenesisCoinbase,
 			timestamp:   GenesisTimestamp,
 			nonce:       0,
+			gasLimit:    genesisGasLimit(conf),
 		},
 		accState:    accState,
 		txsTrie:     txsTrie,
@@ -86,6 +264,7 @@ func NewGenesisBlock(conf *corepb.Genesis, chain *BlockChain) (*Block, error) {
 		dposContext: dposContext,
 		txPool:      chain.txPool,
 		storage:     chain.storage,
+		params:      chain.params,
 		height:      1,
 		sealed:      false,
 	}
@@ -162,11 +341,20 @@ func DumpGenesis(stor storage.Storage) (*corepb.Genesis, error) {
 			Value:   balance.String(),
 		})
 	}
+	// the chains created before the params are introduced use the defaults.
+	var params *corepb.GenesisParams
+	if value, err := stor.Get([]byte(GenesisParamsKey)); err == nil {
+		params = new(corepb.GenesisParams)
+		if err := proto.Unmarshal(value, params); err != nil {
+			return nil, err
+		}
+	}
 	return &corepb.Genesis{
 		Meta: &corepb.GenesisMeta{ChainId: genesis.ChainID()},
 		Consensus: &corepb.GenesisConsensus{
 			Dpos: &corepb.GenesisConsensusDpos{Dynasty: bootstrap},
 		},
 		TokenDistribution: distribution,
+		Params:            params,
 	}, nil
 }
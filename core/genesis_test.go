@@ -19,6 +19,9 @@
 package core
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -151,3 +154,89 @@ func TestNewGenesisBlock(t *testing.T) {
 	assert.Equal(t, dumpConf.Consensus.Dpos.Dynasty, conf.Consensus.Dpos.Dynasty)
 	assert.Equal(t, dumpConf.TokenDistribution, conf.TokenDistribution)
 }
+
+func TestLoadGenesisConf_JSON(t *testing.T) {
+	dir, err := ioutil.TempDir("", "genesis")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	conf := NewGenesisConf(1001, MockDynasty, "10000000000000000000000")
+	conf.Params = &corepb.GenesisParams{BlockInterval: 10, DynastyInterval: 420}
+	content, err := MarshalGenesisConf(conf)
+	assert.Nil(t, err)
+
+	path := filepath.Join(dir, "genesis.json")
+	assert.Nil(t, ioutil.WriteFile(path, []byte(content), 0644))
+	loaded, err := LoadGenesisConf(path)
+	assert.Nil(t, err)
+	assert.Equal(t, conf.Meta.ChainId, loaded.Meta.ChainId)
+	assert.Equal(t, conf.Consensus.Dpos.Dynasty, loaded.Consensus.Dpos.Dynasty)
+	assert.Equal(t, conf.TokenDistribution, loaded.TokenDistribution)
+	assert.Nil(t, ValidateGenesisConf(loaded))
+
+	params := GenesisParams(loaded)
+	assert.Equal(t, int64(10), params.BlockInterval)
+	assert.Equal(t, int64(420), params.DynastyInterval)
+	assert.Equal(t, uint32(DefaultDynastySize), params.DynastySize)
+	assert.Equal(t, TransactionGasPrice.String(), params.GasPrice)
+	assert.Equal(t, TransactionMaxGas.String(), params.GasLimit)
+	assert.Equal(t, DefaultBlockGasLimit, params.BlockGasLimit)
+}
+
+func TestValidateGenesisConf(t *testing.T) {
+	assert.Nil(t, ValidateGenesisConf(MockGenesisConf()))
+
+	conf := MockGenesisConf()
+	conf.Meta.ChainId = 0
+	assert.Equal(t, ErrInvalidChainID, ValidateGenesisConf(conf))
+
+	conf = MockGenesisConf()
+	conf.Consensus.Dpos.Dynasty = MockDynasty[:safeSize(DefaultDynastySize)-1]
+	assert.Equal(t, ErrInitialDynastyNotEnough, ValidateGenesisConf(conf))
+
+	conf = MockGenesisConf()
+	conf.TokenDistribution[1].Address = conf.TokenDistribution[0].Address
+	assert.Equal(t, ErrDuplicatedGenesisAddress, ValidateGenesisConf(conf))
+
+	conf = MockGenesisConf()
+	conf.TokenDistribution[0].Value = "-1"
+	assert.Equal(t, ErrInvalidGenesisBalance, ValidateGenesisConf(conf))
+
+	conf = MockGenesisConf()
+	conf.Params = &corepb.GenesisParams{BlockInterval: 5, DynastyInterval: 100}
+	assert.Equal(t, ErrInvalidGenesisInterval, ValidateGenesisConf(conf))
+
+	conf = MockGenesisConf()
+	conf.Params = &corepb.GenesisParams{BlockInterval: MaxBlockInterval + 1, DynastyInterval: (MaxBlockInterval + 1) * DefaultDynastySize}
+	assert.Equal(t, ErrInvalidGenesisInterval, ValidateGenesisConf(conf))
+
+	conf = MockGenesisConf()
+	conf.Params = &corepb.GenesisParams{DynastySize: MinDynastySize - 1}
+	assert.Equal(t, ErrInvalidGenesisDynastySize, ValidateGenesisConf(conf))
+
+	conf = MockGenesisConf()
+	conf.Params = &corepb.GenesisParams{DynastySize: MaxDynastySize + 1}
+	assert.Equal(t, ErrInvalidGenesisDynastySize, ValidateGenesisConf(conf))
+
+	// a private network with 3 validators and 1-second blocks.
+	conf = MockGenesisConf()
+	conf.Consensus.Dpos.Dynasty = MockDynasty[:MinDynastySize]
+	conf.Params = &corepb.GenesisParams{BlockInterval: 1, DynastyInterval: 30, DynastySize: MinDynastySize}
+	assert.Nil(t, ValidateGenesisConf(conf))
+
+	conf = MockGenesisConf()
+	conf.Params = &corepb.GenesisParams{GasLimit: "0"}
+	assert.Equal(t, ErrInvalidGenesisGas, ValidateGenesisConf(conf))
+
+	conf = MockGenesisConf()
+	conf.Params = &corepb.GenesisParams{BlockGasLimit: MinBlockGasLimit}
+	assert.Equal(t, ErrInvalidGenesisGas, ValidateGenesisConf(conf))
+
+	conf = MockGenesisConf()
+	conf.Consensus.Poa = &corepb.GenesisConsensusPoa{Signers: MockDynasty[:2]}
+	assert.Nil(t, ValidateGenesisConf(conf))
+	conf.Consensus.Poa.Signers = []string{}
+	assert.Equal(t, ErrInvalidGenesisSigners, ValidateGenesisConf(conf))
+	conf.Consensus.Poa.Signers = []string{MockDynasty[0], MockDynasty[0]}
+	assert.Equal(t, ErrInvalidGenesisSigners, ValidateGenesisConf(conf))
+}
@@ -25,12 +25,17 @@ import (
 // Metrics for core
 var (
 	// block metrics
-	metricsBlockHeightGauge      = metrics.NewGauge("neb.block.height")
-	metricsBlocktailHashGauge    = metrics.NewGauge("neb.block.tailhash")
-	metricsBlockRevertTimesGauge = metrics.NewGauge("neb.block.revertcount")
-	metricsBlockRevertMeter      = metrics.NewMeter("neb.block.revert")
-	metricsBlockOnchainTimer     = metrics.NewTimer("neb.block.onchain")
-	metricsTxOnchainTimer        = metrics.NewTimer("neb.transaction.onchain")
+	metricsBlockHeightGauge          = metrics.NewGauge("neb.block.height")
+	metricsBlocktailHashGauge        = metrics.NewGauge("neb.block.tailhash")
+	metricsBlockRevertTimesGauge     = metrics.NewGauge("neb.block.revertcount")
+	metricsBlockRevertMeter          = metrics.NewMeter("neb.block.revert")
+	metricsBlockForksGauge           = metrics.NewGauge("neb.block.forks")
+	metricsBlockOnchainTimer         = metrics.NewTimer("neb.block.onchain")
+	metricsTxOnchainTimer            = metrics.NewTimer("neb.transaction.onchain")
+	metricsParallelExecutionFallback = metrics.NewCounter("neb.block.parallel.fallback")
+
+	// state metrics
+	metricsStatePrunedNodes = metrics.NewCounter("neb.state.pruned")
 
 	// block_pool metrics
 	metricsCachedNewBlock      = metrics.NewGauge("neb.block.new.cached")
@@ -41,15 +46,27 @@ var (
 	metricsTxExecutedTimer     = metrics.NewTimer("neb.tx.executed")
 
 	// txpool metrics
-	metricsCachedTx            = metrics.NewGauge("neb.txpool.cached")
-	metricsInvalidTx           = metrics.NewCounter("neb.txpool.invalid")
-	metricsDuplicateTx         = metrics.NewCounter("neb.txpool.duplicate")
-	metricsTxPoolBelowGasPrice = metrics.NewCounter("neb.txpool.below_gas_price")
-	metricsTxPoolOutOfGasLimit = metrics.NewCounter("neb.txpool.out_of_gas_limit")
+	metricsCachedTx                 = metrics.NewGauge("neb.txpool.cached")
+	metricsInvalidTx                = metrics.NewCounter("neb.txpool.invalid")
+	metricsDuplicateTx              = metrics.NewCounter("neb.txpool.duplicate")
+	metricsTxPoolBelowGasPrice      = metrics.NewCounter("neb.txpool.below_gas_price")
+	metricsTxPoolOutOfGasLimit      = metrics.NewCounter("neb.txpool.out_of_gas_limit")
+	metricsTxPoolReplaced           = metrics.NewCounter("neb.txpool.replaced")
+	metricsTxPoolReplaceUnderpriced = metrics.NewCounter("neb.txpool.replace_underpriced")
+	metricsTxPoolFutureFull         = metrics.NewCounter("neb.txpool.future_full")
+	metricsTxPoolAccountFull        = metrics.NewCounter("neb.txpool.account_full")
+	metricsTxPoolEvicted            = metrics.NewCounter("neb.txpool.evicted")
+	metricsTxPoolRebroadcast        = metrics.NewCounter("neb.txpool.rebroadcast")
 
 	// transaction metrics
-	metricsTxSubmit     = metrics.NewMeter("neb.transaction.submit")
-	metricsTxExecute    = metrics.NewMeter("neb.transaction.execute")
-	metricsTxExeSuccess = metrics.NewMeter("neb.transaction.execute.success")
-	metricsTxExeFailed  = metrics.NewMeter("neb.transaction.execute.failed")
+	metricsTxSubmit       = metrics.NewMeter("neb.transaction.submit")
+	metricsTxExecute      = metrics.NewMeter("neb.transaction.execute")
+	metricsTxExeSuccess   = metrics.NewMeter("neb.transaction.execute.success")
+	metricsTxExeFailed    = metrics.NewMeter("neb.transaction.execute.failed")
+	metricsTxSignCacheHit = metrics.NewCounter("neb.transaction.sign.cachehit")
+
+	// contract metrics
+	metricsContractGasUsed       = metrics.NewCounter("neb.contract.gas")
+	metricsContractExecutedTimer = metrics.NewTimer("neb.contract.executed")
+	metricsContractExeFailed     = metrics.NewMeter("neb.contract.execute.failed")
 )
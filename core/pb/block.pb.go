@@ -13,10 +13,16 @@ It has these top-level messages:
 	Transaction
 	DposContext
 	BlockHeader
+	Receipt
+	BalanceChange
+	BalanceChanges
+	BlockStats
 	Block
 	NetBlocks
 	NetBlock
 	DownloadBlock
+	SnapshotHeader
+	SnapshotEntry
 */
 package corepb
 
@@ -108,18 +114,20 @@ func (m *Data) GetPayload() []byte {
 }
 
 type Transaction struct {
-	Hash      []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	From      []byte `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To        []byte `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	Value     []byte `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Nonce     uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Timestamp int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Data      *Data  `protobuf:"bytes,7,opt,name=data" json:"data,omitempty"`
-	ChainId   uint32 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	GasPrice  []byte `protobuf:"bytes,9,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	GasLimit  []byte `protobuf:"bytes,10,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	Alg       uint32 `protobuf:"varint,11,opt,name=alg,proto3" json:"alg,omitempty"`
-	Sign      []byte `protobuf:"bytes,12,opt,name=sign,proto3" json:"sign,omitempty"`
+	Hash             []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	From             []byte `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To               []byte `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value            []byte `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Nonce            uint64 `protobuf:"varint,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Timestamp        int64  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Data             *Data  `protobuf:"bytes,7,opt,name=data" json:"data,omitempty"`
+	ChainId          uint32 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	GasPrice         []byte `protobuf:"bytes,9,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasLimit         []byte `protobuf:"bytes,10,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	Alg              uint32 `protobuf:"varint,11,opt,name=alg,proto3" json:"alg,omitempty"`
+	Sign             []byte `protobuf:"bytes,12,opt,name=sign,proto3" json:"sign,omitempty"`
+	ValidUntilHeight uint64 `protobuf:"varint,13,opt,name=valid_until_height,json=validUntilHeight,proto3" json:"valid_until_height,omitempty"`
+	ValidUntil       int64  `protobuf:"varint,14,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
 }
 
 func (m *Transaction) Reset()                    { *m = Transaction{} }
@@ -211,6 +219,20 @@ func (m *Transaction) GetSign() []byte {
 	return nil
 }
 
+func (m *Transaction) GetValidUntilHeight() uint64 {
+	if m != nil {
+		return m.ValidUntilHeight
+	}
+	return 0
+}
+
+func (m *Transaction) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
 type DposContext struct {
 	DynastyRoot     []byte `protobuf:"bytes,1,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
 	NextDynastyRoot []byte `protobuf:"bytes,2,opt,name=next_dynasty_root,json=nextDynastyRoot,proto3" json:"next_dynasty_root,omitempty"`
@@ -280,6 +302,7 @@ type BlockHeader struct {
 	TxsRoot     []byte       `protobuf:"bytes,10,opt,name=txs_root,json=txsRoot,proto3" json:"txs_root,omitempty"`
 	EventsRoot  []byte       `protobuf:"bytes,11,opt,name=events_root,json=eventsRoot,proto3" json:"events_root,omitempty"`
 	DposContext *DposContext `protobuf:"bytes,12,opt,name=dpos_context,json=dposContext" json:"dpos_context,omitempty"`
+	GasLimit    uint64       `protobuf:"varint,13,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
 func (m *BlockHeader) Reset()                    { *m = BlockHeader{} }
@@ -371,6 +394,189 @@ func (m *BlockHeader) GetDposContext() *DposContext {
 	return nil
 }
 
+func (m *BlockHeader) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+type Receipt struct {
+	TxHash            []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BlockHash         []byte `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height            uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Status            uint32 `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	GasUsed           []byte `protobuf:"bytes,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	CumulativeGasUsed []byte `protobuf:"bytes,6,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	Error             string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	ContractAddress   []byte `protobuf:"bytes,8,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	GasRefund         []byte `protobuf:"bytes,9,opt,name=gas_refund,json=gasRefund,proto3" json:"gas_refund,omitempty"`
+}
+
+func (m *Receipt) Reset()                    { *m = Receipt{} }
+func (m *Receipt) String() string            { return proto.CompactTextString(m) }
+func (*Receipt) ProtoMessage()               {}
+func (*Receipt) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{5} }
+
+func (m *Receipt) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *Receipt) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *Receipt) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Receipt) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Receipt) GetGasUsed() []byte {
+	if m != nil {
+		return m.GasUsed
+	}
+	return nil
+}
+
+func (m *Receipt) GetCumulativeGasUsed() []byte {
+	if m != nil {
+		return m.CumulativeGasUsed
+	}
+	return nil
+}
+
+func (m *Receipt) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *Receipt) GetContractAddress() []byte {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return nil
+}
+
+func (m *Receipt) GetGasRefund() []byte {
+	if m != nil {
+		return m.GasRefund
+	}
+	return nil
+}
+
+type BalanceChange struct {
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Height  uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	TxHash  []byte `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Reason  string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Delta   string `protobuf:"bytes,5,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (m *BalanceChange) Reset()                    { *m = BalanceChange{} }
+func (m *BalanceChange) String() string            { return proto.CompactTextString(m) }
+func (*BalanceChange) ProtoMessage()               {}
+func (*BalanceChange) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{6} }
+
+func (m *BalanceChange) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *BalanceChange) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BalanceChange) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *BalanceChange) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BalanceChange) GetDelta() string {
+	if m != nil {
+		return m.Delta
+	}
+	return ""
+}
+
+type BalanceChanges struct {
+	Changes []*BalanceChange `protobuf:"bytes,1,rep,name=changes" json:"changes,omitempty"`
+}
+
+func (m *BalanceChanges) Reset()                    { *m = BalanceChanges{} }
+func (m *BalanceChanges) String() string            { return proto.CompactTextString(m) }
+func (*BalanceChanges) ProtoMessage()               {}
+func (*BalanceChanges) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{7} }
+
+func (m *BalanceChanges) GetChanges() []*BalanceChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type BlockStats struct {
+	TotalTxs       uint64 `protobuf:"varint,1,opt,name=total_txs,json=totalTxs,proto3" json:"total_txs,omitempty"`
+	TotalContracts uint64 `protobuf:"varint,2,opt,name=total_contracts,json=totalContracts,proto3" json:"total_contracts,omitempty"`
+	DynastyBlocks  uint64 `protobuf:"varint,3,opt,name=dynasty_blocks,json=dynastyBlocks,proto3" json:"dynasty_blocks,omitempty"`
+}
+
+func (m *BlockStats) Reset()                    { *m = BlockStats{} }
+func (m *BlockStats) String() string            { return proto.CompactTextString(m) }
+func (*BlockStats) ProtoMessage()               {}
+func (*BlockStats) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
+
+func (m *BlockStats) GetTotalTxs() uint64 {
+	if m != nil {
+		return m.TotalTxs
+	}
+	return 0
+}
+
+func (m *BlockStats) GetTotalContracts() uint64 {
+	if m != nil {
+		return m.TotalContracts
+	}
+	return 0
+}
+
+func (m *BlockStats) GetDynastyBlocks() uint64 {
+	if m != nil {
+		return m.DynastyBlocks
+	}
+	return 0
+}
+
 type Block struct {
 	Header       *BlockHeader   `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
 	Transactions []*Transaction `protobuf:"bytes,2,rep,name=transactions" json:"transactions,omitempty"`
@@ -381,7 +587,7 @@ type Block struct {
 func (m *Block) Reset()                    { *m = Block{} }
 func (m *Block) String() string            { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()               {}
-func (*Block) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{5} }
+func (*Block) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{9} }
 
 func (m *Block) GetHeader() *BlockHeader {
 	if m != nil {
@@ -420,7 +626,7 @@ type NetBlocks struct {
 func (m *NetBlocks) Reset()                    { *m = NetBlocks{} }
 func (m *NetBlocks) String() string            { return proto.CompactTextString(m) }
 func (*NetBlocks) ProtoMessage()               {}
-func (*NetBlocks) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{6} }
+func (*NetBlocks) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{10} }
 
 func (m *NetBlocks) GetFrom() string {
 	if m != nil {
@@ -452,7 +658,7 @@ type NetBlock struct {
 func (m *NetBlock) Reset()                    { *m = NetBlock{} }
 func (m *NetBlock) String() string            { return proto.CompactTextString(m) }
 func (*NetBlock) ProtoMessage()               {}
-func (*NetBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{7} }
+func (*NetBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{11} }
 
 func (m *NetBlock) GetFrom() string {
 	if m != nil {
@@ -483,7 +689,7 @@ type DownloadBlock struct {
 func (m *DownloadBlock) Reset()                    { *m = DownloadBlock{} }
 func (m *DownloadBlock) String() string            { return proto.CompactTextString(m) }
 func (*DownloadBlock) ProtoMessage()               {}
-func (*DownloadBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{8} }
+func (*DownloadBlock) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{12} }
 
 func (m *DownloadBlock) GetHash() []byte {
 	if m != nil {
@@ -499,66 +705,167 @@ func (m *DownloadBlock) GetSign() []byte {
 	return nil
 }
 
+type SnapshotHeader struct {
+	Version   uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	ChainId   uint32 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	BlockHash []byte `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height    uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Entries   uint64 `protobuf:"varint,5,opt,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (m *SnapshotHeader) Reset()                    { *m = SnapshotHeader{} }
+func (m *SnapshotHeader) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotHeader) ProtoMessage()               {}
+func (*SnapshotHeader) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{13} }
+
+func (m *SnapshotHeader) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SnapshotHeader) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *SnapshotHeader) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *SnapshotHeader) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SnapshotHeader) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+type SnapshotEntry struct {
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *SnapshotEntry) Reset()                    { *m = SnapshotEntry{} }
+func (m *SnapshotEntry) String() string            { return proto.CompactTextString(m) }
+func (*SnapshotEntry) ProtoMessage()               {}
+func (*SnapshotEntry) Descriptor() ([]byte, []int) { return fileDescriptorBlock, []int{14} }
+
+func (m *SnapshotEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *SnapshotEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Account)(nil), "corepb.Account")
 	proto.RegisterType((*Data)(nil), "corepb.Data")
 	proto.RegisterType((*Transaction)(nil), "corepb.Transaction")
 	proto.RegisterType((*DposContext)(nil), "corepb.DposContext")
 	proto.RegisterType((*BlockHeader)(nil), "corepb.BlockHeader")
+	proto.RegisterType((*Receipt)(nil), "corepb.Receipt")
+	proto.RegisterType((*BalanceChange)(nil), "corepb.BalanceChange")
+	proto.RegisterType((*BalanceChanges)(nil), "corepb.BalanceChanges")
+	proto.RegisterType((*BlockStats)(nil), "corepb.BlockStats")
 	proto.RegisterType((*Block)(nil), "corepb.Block")
 	proto.RegisterType((*NetBlocks)(nil), "corepb.NetBlocks")
 	proto.RegisterType((*NetBlock)(nil), "corepb.NetBlock")
 	proto.RegisterType((*DownloadBlock)(nil), "corepb.DownloadBlock")
+	proto.RegisterType((*SnapshotHeader)(nil), "corepb.SnapshotHeader")
+	proto.RegisterType((*SnapshotEntry)(nil), "corepb.SnapshotEntry")
 }
 
 func init() { proto.RegisterFile("block.proto", fileDescriptorBlock) }
 
 var fileDescriptorBlock = []byte{
-	// 730 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
-	0x14, 0x96, 0x93, 0x38, 0x3f, 0xc7, 0x4e, 0xef, 0xbd, 0x73, 0x2b, 0xe4, 0xf2, 0xa3, 0x06, 0x57,
-	0x95, 0x22, 0x90, 0xba, 0x28, 0x88, 0xae, 0xa1, 0x59, 0x14, 0x09, 0xa1, 0xca, 0x62, 0x83, 0x84,
-	0x64, 0x4d, 0xec, 0x21, 0xb1, 0x70, 0x66, 0x2c, 0xcf, 0x69, 0x49, 0x1e, 0x83, 0x35, 0xaf, 0xc0,
-	0x73, 0x21, 0xf1, 0x16, 0x68, 0xce, 0x8c, 0x9d, 0x84, 0x76, 0xc3, 0x6e, 0xbe, 0xef, 0x3b, 0x33,
-	0x3e, 0x3f, 0x9f, 0x0f, 0x04, 0xf3, 0x52, 0x65, 0x5f, 0xce, 0xaa, 0x5a, 0xa1, 0x62, 0xfd, 0x4c,
-	0xd5, 0xa2, 0x9a, 0xc7, 0xdf, 0x3c, 0x18, 0xbc, 0xce, 0x32, 0x75, 0x23, 0x91, 0x45, 0x30, 0xe0,
-	0x79, 0x5e, 0x0b, 0xad, 0x23, 0x6f, 0xe2, 0x4d, 0xc3, 0xa4, 0x81, 0x46, 0x99, 0xf3, 0x92, 0xcb,
-	0x4c, 0x44, 0x1d, 0xab, 0x38, 0xc8, 0x0e, 0xc1, 0x97, 0xca, 0xf0, 0xdd, 0x89, 0x37, 0xed, 0x25,
-	0x16, 0xb0, 0x47, 0x30, 0xba, 0xe5, 0xb5, 0x4e, 0x97, 0x5c, 0x2f, 0xa3, 0x1e, 0xdd, 0x18, 0x1a,
-	0xe2, 0x8a, 0xeb, 0x25, 0x3b, 0x86, 0x60, 0x5e, 0xd4, 0xb8, 0x4c, 0xab, 0x92, 0x67, 0x22, 0xf2,
-	0x49, 0x06, 0xa2, 0xae, 0x0d, 0x13, 0xbf, 0x84, 0xde, 0x8c, 0x23, 0x67, 0x0c, 0x7a, 0xb8, 0xa9,
-	0x04, 0x25, 0x33, 0x4a, 0xe8, 0x6c, 0x32, 0xa9, 0xf8, 0xa6, 0x54, 0x3c, 0x6f, 0x32, 0x71, 0x30,
-	0xfe, 0xd1, 0x81, 0xe0, 0x43, 0xcd, 0xa5, 0xe6, 0x19, 0x16, 0x4a, 0x9a, 0xdb, 0xf4, 0x79, 0x5b,
-	0x0a, 0x9d, 0x0d, 0xf7, 0xb9, 0x56, 0x2b, 0x77, 0x95, 0xce, 0xec, 0x00, 0x3a, 0xa8, 0x28, 0xfd,
-	0x30, 0xe9, 0xa0, 0x32, 0x15, 0xdd, 0xf2, 0xf2, 0x46, 0xb8, 0xbc, 0x2d, 0xd8, 0xd6, 0xe9, 0xef,
-	0xd6, 0xf9, 0x18, 0x46, 0x58, 0xac, 0x84, 0x46, 0xbe, 0xaa, 0xa2, 0xfe, 0xc4, 0x9b, 0x76, 0x93,
-	0x2d, 0xc1, 0x26, 0xd0, 0xcb, 0x39, 0xf2, 0x68, 0x30, 0xf1, 0xa6, 0xc1, 0x79, 0x78, 0x66, 0x5b,
-	0x7e, 0x66, 0x6a, 0x4b, 0x48, 0x61, 0x47, 0x30, 0xcc, 0x96, 0xbc, 0x90, 0x69, 0x91, 0x47, 0xc3,
-	0x89, 0x37, 0x1d, 0x27, 0x03, 0xc2, 0x6f, 0x73, 0xd3, 0xc2, 0x05, 0xd7, 0x69, 0x55, 0x17, 0x99,
-	0x88, 0x46, 0xb6, 0x85, 0x0b, 0xae, 0xaf, 0x0d, 0x6e, 0xc4, 0xb2, 0x58, 0x15, 0x18, 0x41, 0x2b,
-	0xbe, 0x33, 0x98, 0xfd, 0x0b, 0x5d, 0x5e, 0x2e, 0xa2, 0x80, 0xde, 0x33, 0x47, 0x53, 0xb6, 0x2e,
-	0x16, 0x32, 0x0a, 0x6d, 0xd9, 0xe6, 0x1c, 0xff, 0xf2, 0x20, 0x98, 0x55, 0x4a, 0x5f, 0x2a, 0x89,
-	0x62, 0x8d, 0xec, 0x29, 0x84, 0xf9, 0x46, 0x72, 0x8d, 0x9b, 0xb4, 0x56, 0x0a, 0x5d, 0xdb, 0x02,
-	0xc7, 0x25, 0x4a, 0x21, 0x7b, 0x06, 0xff, 0x49, 0xb1, 0xc6, 0x74, 0x2f, 0xce, 0xb6, 0xf2, 0x1f,
-	0x23, 0xcc, 0x76, 0x62, 0x4f, 0x60, 0x9c, 0x8b, 0x52, 0x2c, 0x38, 0x0a, 0x1b, 0x67, 0x1b, 0x1c,
-	0x36, 0x24, 0x05, 0x9d, 0xc2, 0x41, 0xc6, 0x65, 0x5e, 0xe4, 0x6d, 0x94, 0xed, 0xf9, 0xb8, 0x65,
-	0x29, 0xcc, 0xb8, 0x49, 0x35, 0x11, 0xbe, 0x73, 0x93, 0x72, 0x62, 0x0c, 0xe3, 0x55, 0x21, 0x31,
-	0xcd, 0x24, 0xda, 0x80, 0xbe, 0x4d, 0xdc, 0x90, 0x97, 0x12, 0x4d, 0x4c, 0xfc, 0xb3, 0x03, 0xc1,
-	0x1b, 0x63, 0xfe, 0x2b, 0xc1, 0x73, 0x51, 0xdf, 0x6b, 0x8d, 0x63, 0x08, 0x2a, 0x5e, 0x0b, 0x89,
-	0xd6, 0xb4, 0xb6, 0x2c, 0xb0, 0x14, 0xd9, 0xf6, 0x7e, 0xa7, 0x3f, 0x84, 0x61, 0xa6, 0x0a, 0x39,
-	0xe7, 0xba, 0x31, 0x4c, 0x8b, 0xf7, 0xdd, 0xe1, 0xff, 0xe9, 0x8e, 0xdd, 0xd9, 0xf7, 0xf7, 0x67,
-	0xef, 0x26, 0x38, 0xb8, 0x3b, 0xc1, 0xe1, 0x76, 0x82, 0xec, 0x09, 0x80, 0xc6, 0xb6, 0x73, 0xd6,
-	0x22, 0x23, 0x62, 0xa8, 0x31, 0x47, 0x30, 0xc4, 0xb5, 0xb6, 0xa2, 0xb5, 0xc8, 0x00, 0xd7, 0x9a,
-	0xa4, 0x63, 0x08, 0xc4, 0xad, 0x90, 0xe8, 0xd4, 0xc0, 0xd6, 0x6a, 0x29, 0x0a, 0x78, 0x05, 0x61,
-	0x5e, 0x29, 0x9d, 0x66, 0xd6, 0x1c, 0x64, 0x9c, 0xe0, 0xfc, 0xff, 0xd6, 0xc1, 0x5b, 0xdf, 0x24,
-	0x41, 0xbe, 0x05, 0xf1, 0x77, 0x0f, 0x7c, 0x6a, 0x34, 0x7b, 0x0e, 0xfd, 0x25, 0x35, 0x9b, 0x9a,
-	0xbc, 0x73, 0x77, 0x67, 0x0e, 0x89, 0x0b, 0x61, 0x17, 0x10, 0xe2, 0xf6, 0xcf, 0xd5, 0x51, 0x67,
-	0xd2, 0xdd, 0xbd, 0xb2, 0xf3, 0x57, 0x27, 0x7b, 0x81, 0xec, 0x81, 0xf9, 0x4a, 0xb1, 0x58, 0xa2,
-	0x1b, 0x8a, 0x43, 0x66, 0x56, 0xab, 0x42, 0x8a, 0xba, 0xf9, 0x87, 0x09, 0xc4, 0x9f, 0x60, 0xf4,
-	0x5e, 0x20, 0x25, 0xa0, 0xdb, 0x55, 0xe0, 0x96, 0x0b, 0xad, 0x82, 0x43, 0xf0, 0xe7, 0x1c, 0x33,
-	0x3b, 0xfd, 0x5e, 0x62, 0x01, 0x3b, 0x85, 0x3e, 0x6d, 0x4e, 0x1d, 0x75, 0x29, 0xaf, 0xf1, 0x5e,
-	0x29, 0x89, 0x13, 0xe3, 0x8f, 0x30, 0x6c, 0x5e, 0xff, 0x8b, 0xc7, 0x4f, 0xc0, 0xa7, 0xfb, 0x54,
-	0xc0, 0x9d, 0xb7, 0xad, 0x16, 0x5f, 0xc0, 0x78, 0xa6, 0xbe, 0x4a, 0xb3, 0xe6, 0xda, 0xf7, 0xef,
-	0xdb, 0x6d, 0x64, 0x91, 0xce, 0xd6, 0x22, 0xf3, 0x3e, 0x2d, 0xfb, 0x17, 0xbf, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0x21, 0x22, 0xf3, 0xfb, 0xfb, 0x05, 0x00, 0x00,
+	// 1093 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x8f, 0xdb, 0x36,
+	0x13, 0x86, 0xfc, 0x25, 0x7b, 0x64, 0x7b, 0x37, 0xcc, 0xbe, 0x79, 0x95, 0xb6, 0xc1, 0xba, 0x0a,
+	0x82, 0xba, 0x1f, 0xd8, 0x02, 0xdb, 0xa2, 0x39, 0x6f, 0x76, 0x8b, 0x6e, 0x81, 0xa2, 0x08, 0x94,
+	0xe4, 0x50, 0xa0, 0x80, 0x40, 0x4b, 0x8c, 0x2d, 0x44, 0x26, 0x05, 0x71, 0xec, 0xda, 0xe7, 0x5e,
+	0x7a, 0xed, 0xa5, 0x97, 0xf6, 0x0f, 0xf6, 0x37, 0xf4, 0x52, 0x70, 0x48, 0xd9, 0x52, 0xb3, 0x29,
+	0xd0, 0x1b, 0xe7, 0x99, 0x21, 0x35, 0xcf, 0xcc, 0xc3, 0xa1, 0x20, 0x58, 0x14, 0x2a, 0x7d, 0x73,
+	0x51, 0x56, 0x0a, 0x15, 0x1b, 0xa4, 0xaa, 0x12, 0xe5, 0x22, 0xfa, 0xd5, 0x03, 0xff, 0x2a, 0x4d,
+	0xd5, 0x46, 0x22, 0x0b, 0xc1, 0xe7, 0x59, 0x56, 0x09, 0xad, 0x43, 0x6f, 0xe6, 0xcd, 0xc7, 0x71,
+	0x6d, 0x1a, 0xcf, 0x82, 0x17, 0x5c, 0xa6, 0x22, 0xec, 0x58, 0x8f, 0x33, 0xd9, 0x19, 0xf4, 0xa5,
+	0x32, 0x78, 0x77, 0xe6, 0xcd, 0x7b, 0xb1, 0x35, 0xd8, 0xfb, 0x30, 0xda, 0xf2, 0x4a, 0x27, 0x2b,
+	0xae, 0x57, 0x61, 0x8f, 0x76, 0x0c, 0x0d, 0x70, 0xcb, 0xf5, 0x8a, 0x9d, 0x43, 0xb0, 0xc8, 0x2b,
+	0x5c, 0x25, 0x65, 0xc1, 0x53, 0x11, 0xf6, 0xc9, 0x0d, 0x04, 0x3d, 0x37, 0x48, 0xf4, 0x25, 0xf4,
+	0x6e, 0x38, 0x72, 0xc6, 0xa0, 0x87, 0xfb, 0x52, 0x50, 0x32, 0xa3, 0x98, 0xd6, 0x26, 0x93, 0x92,
+	0xef, 0x0b, 0xc5, 0xb3, 0x3a, 0x13, 0x67, 0x46, 0x7f, 0x75, 0x20, 0x78, 0x59, 0x71, 0xa9, 0x79,
+	0x8a, 0xb9, 0x92, 0x66, 0x37, 0x7d, 0xde, 0x52, 0xa1, 0xb5, 0xc1, 0x5e, 0x57, 0x6a, 0xed, 0xb6,
+	0xd2, 0x9a, 0x4d, 0xa1, 0x83, 0x8a, 0xd2, 0x1f, 0xc7, 0x1d, 0x54, 0x86, 0xd1, 0x96, 0x17, 0x1b,
+	0xe1, 0xf2, 0xb6, 0xc6, 0x91, 0x67, 0xbf, 0xc9, 0xf3, 0x03, 0x18, 0x61, 0xbe, 0x16, 0x1a, 0xf9,
+	0xba, 0x0c, 0x07, 0x33, 0x6f, 0xde, 0x8d, 0x8f, 0x00, 0x9b, 0x41, 0x2f, 0xe3, 0xc8, 0x43, 0x7f,
+	0xe6, 0xcd, 0x83, 0xcb, 0xf1, 0x85, 0x2d, 0xf9, 0x85, 0xe1, 0x16, 0x93, 0x87, 0x3d, 0x84, 0x61,
+	0xba, 0xe2, 0xb9, 0x4c, 0xf2, 0x2c, 0x1c, 0xce, 0xbc, 0xf9, 0x24, 0xf6, 0xc9, 0xfe, 0x36, 0x33,
+	0x25, 0x5c, 0x72, 0x9d, 0x94, 0x55, 0x9e, 0x8a, 0x70, 0x64, 0x4b, 0xb8, 0xe4, 0xfa, 0xb9, 0xb1,
+	0x6b, 0x67, 0x91, 0xaf, 0x73, 0x0c, 0xe1, 0xe0, 0xfc, 0xce, 0xd8, 0xec, 0x14, 0xba, 0xbc, 0x58,
+	0x86, 0x01, 0x9d, 0x67, 0x96, 0x86, 0xb6, 0xce, 0x97, 0x32, 0x1c, 0x5b, 0xda, 0x66, 0xcd, 0x3e,
+	0x03, 0xb6, 0xe5, 0x45, 0x9e, 0x25, 0x1b, 0x89, 0x79, 0x91, 0xac, 0x44, 0xbe, 0x5c, 0x61, 0x38,
+	0x21, 0x76, 0xa7, 0xe4, 0x79, 0x65, 0x1c, 0xb7, 0x84, 0x9b, 0x9e, 0x35, 0xa2, 0xc3, 0x29, 0x51,
+	0x85, 0x63, 0x58, 0xf4, 0xa7, 0x07, 0xc1, 0x4d, 0xa9, 0xf4, 0xb5, 0x92, 0x28, 0x76, 0xc8, 0x3e,
+	0x84, 0x71, 0xb6, 0x97, 0x5c, 0xe3, 0x3e, 0xa9, 0x94, 0x42, 0xd7, 0x85, 0xc0, 0x61, 0xb1, 0x52,
+	0xc8, 0x3e, 0x81, 0x7b, 0x52, 0xec, 0x30, 0x69, 0xc5, 0xd9, 0xce, 0x9c, 0x18, 0xc7, 0x4d, 0x23,
+	0xf6, 0x31, 0x4c, 0x32, 0x51, 0x88, 0x25, 0x47, 0x61, 0xe3, 0x6c, 0xbf, 0xc6, 0x35, 0x48, 0x41,
+	0x4f, 0x60, 0x9a, 0x72, 0x99, 0xe5, 0xd9, 0x21, 0xca, 0xb6, 0x70, 0x72, 0x40, 0x29, 0xcc, 0x88,
+	0x53, 0xd5, 0x11, 0x7d, 0x27, 0x4e, 0xe5, 0x9c, 0x11, 0x4c, 0xd6, 0xb9, 0xc4, 0x24, 0x95, 0x68,
+	0x03, 0x06, 0x36, 0x71, 0x03, 0x5e, 0x4b, 0x34, 0x31, 0xd1, 0xcf, 0x5d, 0x08, 0x9e, 0x99, 0xbb,
+	0x74, 0x2b, 0x78, 0x26, 0xaa, 0x3b, 0x95, 0x76, 0x0e, 0x41, 0xc9, 0x2b, 0x21, 0xd1, 0xde, 0x01,
+	0x4b, 0x0b, 0x2c, 0x44, 0xb7, 0xe0, 0xee, 0x8b, 0xf3, 0x1e, 0x0c, 0x53, 0x95, 0xcb, 0x05, 0xd7,
+	0xb5, 0xfe, 0x0e, 0x76, 0x5b, 0x6c, 0xfd, 0x7f, 0x8a, 0xad, 0x29, 0xa5, 0x41, 0x5b, 0x4a, 0x4e,
+	0x10, 0xfe, 0xdb, 0x82, 0x18, 0x36, 0x04, 0xf1, 0x08, 0x40, 0xe3, 0xa1, 0x72, 0x56, 0x71, 0x23,
+	0x42, 0xa8, 0x30, 0x0f, 0x61, 0x88, 0x3b, 0x6d, 0x9d, 0x56, 0x71, 0x3e, 0xee, 0x34, 0xb9, 0xce,
+	0x21, 0x10, 0x5b, 0x21, 0xd1, 0x79, 0x03, 0xcb, 0xd5, 0x42, 0x14, 0xf0, 0x15, 0x8c, 0xb3, 0x52,
+	0xe9, 0x24, 0xb5, 0xe2, 0x20, 0x1d, 0x06, 0x97, 0xf7, 0x0f, 0x17, 0xe2, 0xa8, 0x9b, 0x38, 0xc8,
+	0x8e, 0x46, 0x5b, 0xe6, 0x56, 0x9a, 0x07, 0x99, 0x47, 0x7f, 0x74, 0xc0, 0x8f, 0x45, 0x2a, 0xf2,
+	0x12, 0xd9, 0xff, 0xc1, 0xc7, 0x5d, 0xd2, 0x68, 0xc2, 0x00, 0x77, 0x54, 0xe5, 0x47, 0x00, 0x34,
+	0xf5, 0x9a, 0x5d, 0x18, 0x11, 0x42, 0xee, 0x07, 0x30, 0x70, 0xc2, 0xb7, 0x5d, 0x70, 0x96, 0xc1,
+	0x0d, 0xf3, 0x8d, 0xa6, 0x26, 0x4c, 0x62, 0x67, 0x99, 0x22, 0x98, 0x84, 0x36, 0x5a, 0x64, 0x4e,
+	0x39, 0xfe, 0x92, 0xeb, 0x57, 0x5a, 0x64, 0xec, 0x02, 0xee, 0xa7, 0x9b, 0xf5, 0xa6, 0xe0, 0x98,
+	0x6f, 0x45, 0x72, 0x88, 0xb2, 0xf2, 0xb9, 0x77, 0x74, 0x7d, 0xe3, 0xe2, 0xcf, 0xa0, 0x2f, 0xaa,
+	0x4a, 0x55, 0xd4, 0x96, 0x51, 0x6c, 0x0d, 0xf6, 0x31, 0x9c, 0x9a, 0x22, 0x55, 0x3c, 0xc5, 0xa4,
+	0x9e, 0xc5, 0xb6, 0x49, 0x27, 0x35, 0x7e, 0x65, 0x61, 0x43, 0xcd, 0x7c, 0xa5, 0x12, 0xaf, 0x37,
+	0x32, 0xab, 0xfb, 0xb5, 0xe4, 0x3a, 0x26, 0x20, 0xfa, 0xc5, 0x83, 0xc9, 0x33, 0x3b, 0xa4, 0xaf,
+	0x57, 0x5c, 0x2e, 0xc5, 0xbf, 0x8c, 0xf7, 0x63, 0x19, 0x3a, 0xad, 0x32, 0x34, 0xca, 0xda, 0x6d,
+	0x95, 0xf5, 0x01, 0x0c, 0x2a, 0xc1, 0xb5, 0x92, 0x54, 0x9f, 0x51, 0xec, 0x2c, 0x43, 0x2a, 0x13,
+	0x05, 0x72, 0x2a, 0xce, 0x28, 0xb6, 0x46, 0x74, 0x05, 0xd3, 0x56, 0x26, 0x9a, 0x7d, 0x0e, 0x7e,
+	0x6a, 0x97, 0xa1, 0x37, 0xeb, 0xce, 0x83, 0xcb, 0xff, 0xd5, 0x5a, 0x68, 0x05, 0xc6, 0x75, 0x54,
+	0xb4, 0x07, 0xa0, 0x1b, 0xf7, 0x02, 0x39, 0x6a, 0xa3, 0x0b, 0x54, 0xc8, 0x8b, 0x04, 0x77, 0x96,
+	0x4b, 0x2f, 0x1e, 0x12, 0xf0, 0x72, 0xa7, 0xd9, 0x47, 0x70, 0x62, 0x9d, 0x75, 0xc1, 0xb4, 0x63,
+	0x35, 0x25, 0xf8, 0xba, 0x46, 0xcd, 0xb8, 0xa8, 0x47, 0x0f, 0x29, 0x42, 0x3b, 0x11, 0x4c, 0x1c,
+	0x4a, 0x1f, 0xd4, 0xd1, 0xef, 0x1e, 0xf4, 0x69, 0xc9, 0x3e, 0x35, 0x65, 0x32, 0x37, 0x3e, 0xf4,
+	0xda, 0x02, 0x6e, 0x0c, 0x83, 0xd8, 0x85, 0xb0, 0xa7, 0x30, 0xc6, 0xe3, 0x6b, 0x64, 0x72, 0xe8,
+	0x36, 0xb7, 0x34, 0x5e, 0xaa, 0xb8, 0x15, 0xf8, 0x4e, 0x4d, 0x9e, 0x41, 0x7f, 0x9d, 0x4b, 0x51,
+	0xd5, 0xef, 0x12, 0x19, 0xd1, 0x8f, 0x30, 0xfa, 0x5e, 0xa0, 0x4d, 0xf5, 0xf0, 0xbc, 0xb9, 0x07,
+	0xd3, 0xac, 0xcd, 0xb6, 0x05, 0xc7, 0x74, 0xe5, 0x8a, 0x60, 0x0d, 0xf6, 0x04, 0x06, 0x07, 0xce,
+	0x26, 0xaf, 0x49, 0x8b, 0x4a, 0xec, 0x9c, 0xd1, 0x0f, 0x30, 0xac, 0x4f, 0xff, 0x0f, 0x87, 0x3f,
+	0x86, 0x3e, 0xed, 0x27, 0x02, 0x6f, 0x9d, 0x6d, 0x7d, 0xd1, 0x53, 0x98, 0xdc, 0xa8, 0x9f, 0xa4,
+	0x79, 0xba, 0x0f, 0xe7, 0xdf, 0xf5, 0x5e, 0xd3, 0x9c, 0xea, 0x1c, 0xe7, 0x54, 0xf4, 0x9b, 0x07,
+	0xd3, 0x17, 0x92, 0x97, 0x7a, 0xa5, 0xd0, 0x0d, 0xe0, 0x10, 0xfc, 0xad, 0xa8, 0x74, 0xae, 0x24,
+	0xed, 0x9e, 0xc4, 0xb5, 0xd9, 0x9a, 0x8a, 0x9d, 0xf6, 0x54, 0x6c, 0x8f, 0x86, 0xee, 0xbb, 0x47,
+	0x43, 0xaf, 0xd5, 0x86, 0x10, 0x7c, 0x21, 0xb1, 0xca, 0x85, 0x76, 0xbf, 0x02, 0xb5, 0x69, 0x18,
+	0xd5, 0x79, 0x7d, 0x2d, 0xb1, 0xda, 0x9b, 0xb9, 0xfb, 0x46, 0xec, 0x1d, 0x21, 0xb3, 0x3c, 0xfe,
+	0x5b, 0x74, 0x1a, 0xff, 0x16, 0x8b, 0x01, 0xfd, 0x92, 0x7d, 0xf1, 0xf7, 0x00, 0x46, 0x67, 0xc3,
+	0x43, 0xa1, 0x09, 0x00, 0x00,
 }
@@ -9,9 +9,12 @@ It is generated from these files:
 
 It has these top-level messages:
 	Genesis
+	GenesisParams
+	GenesisRewardEpoch
 	GenesisMeta
 	GenesisConsensus
 	GenesisConsensusDpos
+	GenesisConsensusPoa
 	GenesisTokenDistribution
 */
 package corepb
@@ -39,6 +42,8 @@ type Genesis struct {
 	// genesis token distribution address
 	// map<string, string> token_distribution = 3;
 	TokenDistribution []*GenesisTokenDistribution `protobuf:"bytes,3,rep,name=token_distribution,json=tokenDistribution" json:"token_distribution,omitempty"`
+	// genesis chain parameters, the defaults are used if not specified.
+	Params *GenesisParams `protobuf:"bytes,4,opt,name=params" json:"params,omitempty"`
 }
 
 func (m *Genesis) Reset()                    { *m = Genesis{} }
@@ -67,6 +72,254 @@ func (m *Genesis) GetTokenDistribution() []*GenesisTokenDistribution {
 	return nil
 }
 
+func (m *Genesis) GetParams() *GenesisParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type GenesisParams struct {
+	// seconds between two blocks, default is 5.
+	BlockInterval int64 `protobuf:"varint,1,opt,name=block_interval,json=blockInterval,proto3" json:"block_interval,omitempty"`
+	// seconds of a dynasty, should be a multiple of block_interval * dynasty size, default is 60.
+	DynastyInterval int64 `protobuf:"varint,2,opt,name=dynasty_interval,json=dynastyInterval,proto3" json:"dynasty_interval,omitempty"`
+	// min gas price accepted by the transaction pool, default is 1000000.
+	GasPrice string `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// max gas limit of a transaction accepted by the transaction pool, default is 50000000000.
+	GasLimit string `protobuf:"bytes,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// gas limit of the genesis block, adjusted by the miners later. The chain is unlimited if not
+	// specified, and starts from 100000000000 at block_gas_limit_height.
+	BlockGasLimit uint64 `protobuf:"varint,5,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
+	// count of validators in a dynasty, between 3 and 21, default is 6.
+	DynastySize uint32 `protobuf:"varint,6,opt,name=dynasty_size,json=dynastySize,proto3" json:"dynasty_size,omitempty"`
+	// block reward of the height ranges sorted by start height, default gives 0.48 NAS to every block.
+	RewardSchedule []*GenesisRewardEpoch `protobuf:"bytes,7,rep,name=reward_schedule,json=rewardSchedule" json:"reward_schedule,omitempty"`
+	// height from which deleting the contract storage refunds gas, 0 means no refund.
+	StorageRefundHeight uint64 `protobuf:"varint,8,opt,name=storage_refund_height,json=storageRefundHeight,proto3" json:"storage_refund_height,omitempty"`
+	// height from which the indexed params of contract events are recorded, 0 means never.
+	IndexedEventHeight uint64 `protobuf:"varint,9,opt,name=indexed_event_height,json=indexedEventHeight,proto3" json:"indexed_event_height,omitempty"`
+	// height from which the contracts accessing the host bindings of nvm are rejected, 0 means never.
+	HostBindingsCheckHeight uint64 `protobuf:"varint,10,opt,name=host_bindings_check_height,json=hostBindingsCheckHeight,proto3" json:"host_bindings_check_height,omitempty"`
+	// height from which Math.random of the contracts is seeded by the parent block and transaction hash,
+	// 0 means never.
+	SeededRandomHeight uint64 `protobuf:"varint,11,opt,name=seeded_random_height,json=seededRandomHeight,proto3" json:"seeded_random_height,omitempty"`
+	// height from which the blocks of a chain unlimited in genesis are limited by block_gas_limit,
+	// 0 means never.
+	BlockGasLimitHeight uint64 `protobuf:"varint,12,opt,name=block_gas_limit_height,json=blockGasLimitHeight,proto3" json:"block_gas_limit_height,omitempty"`
+	// height from which the consecutive missed slots of the validators are counted in the dpos
+	// context and the offline validators are substituted at the dynasty change, 0 means never.
+	MissedSlotsHeight uint64 `protobuf:"varint,13,opt,name=missed_slots_height,json=missedSlotsHeight,proto3" json:"missed_slots_height,omitempty"`
+	// height from which the contract transfers are credited to the decoded address, the values
+	// are checked and every transfer is charged, 0 means never.
+	ContractTransferHeight uint64 `protobuf:"varint,14,opt,name=contract_transfer_height,json=contractTransferHeight,proto3" json:"contract_transfer_height,omitempty"`
+	// height from which the block seen by the contracts has the timestamp and parent hash,
+	// 0 means never.
+	BlockContextHeight uint64 `protobuf:"varint,15,opt,name=block_context_height,json=blockContextHeight,proto3" json:"block_context_height,omitempty"`
+	// heights from which the tx payload types added after the launch are accepted, 0 means never.
+	MultisigPayloadHeight uint64 `protobuf:"varint,16,opt,name=multisig_payload_height,json=multisigPayloadHeight,proto3" json:"multisig_payload_height,omitempty"`
+	BatchPayloadHeight    uint64 `protobuf:"varint,17,opt,name=batch_payload_height,json=batchPayloadHeight,proto3" json:"batch_payload_height,omitempty"`
+	TimelockPayloadHeight uint64 `protobuf:"varint,18,opt,name=timelock_payload_height,json=timelockPayloadHeight,proto3" json:"timelock_payload_height,omitempty"`
+	SlashPayloadHeight    uint64 `protobuf:"varint,19,opt,name=slash_payload_height,json=slashPayloadHeight,proto3" json:"slash_payload_height,omitempty"`
+	UpgradePayloadHeight  uint64 `protobuf:"varint,20,opt,name=upgrade_payload_height,json=upgradePayloadHeight,proto3" json:"upgrade_payload_height,omitempty"`
+	VerifyPayloadHeight   uint64 `protobuf:"varint,21,opt,name=verify_payload_height,json=verifyPayloadHeight,proto3" json:"verify_payload_height,omitempty"`
+	PausePayloadHeight    uint64 `protobuf:"varint,22,opt,name=pause_payload_height,json=pausePayloadHeight,proto3" json:"pause_payload_height,omitempty"`
+}
+
+func (m *GenesisParams) Reset()                    { *m = GenesisParams{} }
+func (m *GenesisParams) String() string            { return proto.CompactTextString(m) }
+func (*GenesisParams) ProtoMessage()               {}
+func (*GenesisParams) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{1} }
+
+func (m *GenesisParams) GetBlockInterval() int64 {
+	if m != nil {
+		return m.BlockInterval
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetDynastyInterval() int64 {
+	if m != nil {
+		return m.DynastyInterval
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *GenesisParams) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+func (m *GenesisParams) GetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetDynastySize() uint32 {
+	if m != nil {
+		return m.DynastySize
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetRewardSchedule() []*GenesisRewardEpoch {
+	if m != nil {
+		return m.RewardSchedule
+	}
+	return nil
+}
+
+func (m *GenesisParams) GetStorageRefundHeight() uint64 {
+	if m != nil {
+		return m.StorageRefundHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetIndexedEventHeight() uint64 {
+	if m != nil {
+		return m.IndexedEventHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetHostBindingsCheckHeight() uint64 {
+	if m != nil {
+		return m.HostBindingsCheckHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetSeededRandomHeight() uint64 {
+	if m != nil {
+		return m.SeededRandomHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetBlockGasLimitHeight() uint64 {
+	if m != nil {
+		return m.BlockGasLimitHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetMissedSlotsHeight() uint64 {
+	if m != nil {
+		return m.MissedSlotsHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetContractTransferHeight() uint64 {
+	if m != nil {
+		return m.ContractTransferHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetBlockContextHeight() uint64 {
+	if m != nil {
+		return m.BlockContextHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetMultisigPayloadHeight() uint64 {
+	if m != nil {
+		return m.MultisigPayloadHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetBatchPayloadHeight() uint64 {
+	if m != nil {
+		return m.BatchPayloadHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetTimelockPayloadHeight() uint64 {
+	if m != nil {
+		return m.TimelockPayloadHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetSlashPayloadHeight() uint64 {
+	if m != nil {
+		return m.SlashPayloadHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetUpgradePayloadHeight() uint64 {
+	if m != nil {
+		return m.UpgradePayloadHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetVerifyPayloadHeight() uint64 {
+	if m != nil {
+		return m.VerifyPayloadHeight
+	}
+	return 0
+}
+
+func (m *GenesisParams) GetPausePayloadHeight() uint64 {
+	if m != nil {
+		return m.PausePayloadHeight
+	}
+	return 0
+}
+
+type GenesisRewardEpoch struct {
+	// first height of the epoch, the epoch lasts until the next one starts.
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// reward given to the coinbase of each block at the start of the epoch.
+	Reward string `protobuf:"bytes,2,opt,name=reward,proto3" json:"reward,omitempty"`
+	// blocks between two halvings of the reward in the epoch, 0 means no halving.
+	HalvingInterval uint64 `protobuf:"varint,3,opt,name=halving_interval,json=halvingInterval,proto3" json:"halving_interval,omitempty"`
+}
+
+func (m *GenesisRewardEpoch) Reset()                    { *m = GenesisRewardEpoch{} }
+func (m *GenesisRewardEpoch) String() string            { return proto.CompactTextString(m) }
+func (*GenesisRewardEpoch) ProtoMessage()               {}
+func (*GenesisRewardEpoch) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{2} }
+
+func (m *GenesisRewardEpoch) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *GenesisRewardEpoch) GetReward() string {
+	if m != nil {
+		return m.Reward
+	}
+	return ""
+}
+
+func (m *GenesisRewardEpoch) GetHalvingInterval() uint64 {
+	if m != nil {
+		return m.HalvingInterval
+	}
+	return 0
+}
+
 type GenesisMeta struct {
 	// ChainID.
 	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -75,7 +328,7 @@ type GenesisMeta struct {
 func (m *GenesisMeta) Reset()                    { *m = GenesisMeta{} }
 func (m *GenesisMeta) String() string            { return proto.CompactTextString(m) }
 func (*GenesisMeta) ProtoMessage()               {}
-func (*GenesisMeta) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{1} }
+func (*GenesisMeta) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{3} }
 
 func (m *GenesisMeta) GetChainId() uint32 {
 	if m != nil {
@@ -87,12 +340,14 @@ func (m *GenesisMeta) GetChainId() uint32 {
 type GenesisConsensus struct {
 	// ChainID.
 	Dpos *GenesisConsensusDpos `protobuf:"bytes,1,opt,name=dpos" json:"dpos,omitempty"`
+	// PoA signers, required by the poa consensus engine.
+	Poa *GenesisConsensusPoa `protobuf:"bytes,2,opt,name=poa" json:"poa,omitempty"`
 }
 
 func (m *GenesisConsensus) Reset()                    { *m = GenesisConsensus{} }
 func (m *GenesisConsensus) String() string            { return proto.CompactTextString(m) }
 func (*GenesisConsensus) ProtoMessage()               {}
-func (*GenesisConsensus) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{2} }
+func (*GenesisConsensus) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
 
 func (m *GenesisConsensus) GetDpos() *GenesisConsensusDpos {
 	if m != nil {
@@ -101,6 +356,13 @@ func (m *GenesisConsensus) GetDpos() *GenesisConsensusDpos {
 	return nil
 }
 
+func (m *GenesisConsensus) GetPoa() *GenesisConsensusPoa {
+	if m != nil {
+		return m.Poa
+	}
+	return nil
+}
+
 type GenesisConsensusDpos struct {
 	// dpos genesis dynasty address
 	Dynasty []string `protobuf:"bytes,1,rep,name=dynasty" json:"dynasty,omitempty"`
@@ -109,7 +371,7 @@ type GenesisConsensusDpos struct {
 func (m *GenesisConsensusDpos) Reset()                    { *m = GenesisConsensusDpos{} }
 func (m *GenesisConsensusDpos) String() string            { return proto.CompactTextString(m) }
 func (*GenesisConsensusDpos) ProtoMessage()               {}
-func (*GenesisConsensusDpos) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{3} }
+func (*GenesisConsensusDpos) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{5} }
 
 func (m *GenesisConsensusDpos) GetDynasty() []string {
 	if m != nil {
@@ -118,6 +380,23 @@ func (m *GenesisConsensusDpos) GetDynasty() []string {
 	return nil
 }
 
+type GenesisConsensusPoa struct {
+	// signers minting the slots in turn, the dpos dynasty is still kept by the chain state.
+	Signers []string `protobuf:"bytes,1,rep,name=signers" json:"signers,omitempty"`
+}
+
+func (m *GenesisConsensusPoa) Reset()                    { *m = GenesisConsensusPoa{} }
+func (m *GenesisConsensusPoa) String() string            { return proto.CompactTextString(m) }
+func (*GenesisConsensusPoa) ProtoMessage()               {}
+func (*GenesisConsensusPoa) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{6} }
+
+func (m *GenesisConsensusPoa) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
 type GenesisTokenDistribution struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Value   string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -126,7 +405,7 @@ type GenesisTokenDistribution struct {
 func (m *GenesisTokenDistribution) Reset()                    { *m = GenesisTokenDistribution{} }
 func (m *GenesisTokenDistribution) String() string            { return proto.CompactTextString(m) }
 func (*GenesisTokenDistribution) ProtoMessage()               {}
-func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{4} }
+func (*GenesisTokenDistribution) Descriptor() ([]byte, []int) { return fileDescriptorGenesis, []int{7} }
 
 func (m *GenesisTokenDistribution) GetAddress() string {
 	if m != nil {
@@ -144,31 +423,67 @@ func (m *GenesisTokenDistribution) GetValue() string {
 
 func init() {
 	proto.RegisterType((*Genesis)(nil), "corepb.Genesis")
+	proto.RegisterType((*GenesisParams)(nil), "corepb.GenesisParams")
+	proto.RegisterType((*GenesisRewardEpoch)(nil), "corepb.GenesisRewardEpoch")
 	proto.RegisterType((*GenesisMeta)(nil), "corepb.GenesisMeta")
 	proto.RegisterType((*GenesisConsensus)(nil), "corepb.GenesisConsensus")
 	proto.RegisterType((*GenesisConsensusDpos)(nil), "corepb.GenesisConsensusDpos")
+	proto.RegisterType((*GenesisConsensusPoa)(nil), "corepb.GenesisConsensusPoa")
 	proto.RegisterType((*GenesisTokenDistribution)(nil), "corepb.GenesisTokenDistribution")
 }
 
 func init() { proto.RegisterFile("genesis.proto", fileDescriptorGenesis) }
 
 var fileDescriptorGenesis = []byte{
-	// 264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x51, 0x4b, 0xc3, 0x30,
-	0x14, 0x85, 0xa9, 0x9d, 0xab, 0xbd, 0x65, 0xa0, 0x71, 0x0f, 0x11, 0x7c, 0x28, 0x79, 0xb1, 0x4f,
-	0x65, 0x4c, 0xf0, 0x0f, 0x58, 0x10, 0x05, 0x11, 0x82, 0xef, 0x23, 0x6d, 0x2e, 0x1a, 0xd4, 0xa4,
-	0xf4, 0xa6, 0xc2, 0x7e, 0x9b, 0x7f, 0x4e, 0x96, 0xae, 0x38, 0x8a, 0x7b, 0x3c, 0x39, 0x5f, 0x2e,
-	0xe7, 0xdc, 0x0b, 0x8b, 0x37, 0xb4, 0x48, 0x86, 0xca, 0xb6, 0x73, 0xde, 0xb1, 0x79, 0xe3, 0x3a,
-	0x6c, 0x6b, 0xf1, 0x13, 0x41, 0xf2, 0x30, 0x38, 0xec, 0x06, 0x66, 0x5f, 0xe8, 0x15, 0x8f, 0xf2,
-	0xa8, 0xc8, 0xd6, 0x97, 0xe5, 0x80, 0x94, 0x7b, 0xfb, 0x19, 0xbd, 0x92, 0x01, 0x60, 0x77, 0x90,
-	0x36, 0xce, 0x12, 0x5a, 0xea, 0x89, 0x9f, 0x04, 0x9a, 0x4f, 0xe8, 0xfb, 0xd1, 0x97, 0x7f, 0x28,
-	0x7b, 0x01, 0xe6, 0xdd, 0x07, 0xda, 0x8d, 0x36, 0xe4, 0x3b, 0x53, 0xf7, 0xde, 0x38, 0xcb, 0xe3,
-	0x3c, 0x2e, 0xb2, 0x75, 0x3e, 0x19, 0xf0, 0xba, 0x03, 0xab, 0x03, 0x4e, 0x5e, 0xf8, 0xe9, 0x93,
-	0x28, 0x20, 0x3b, 0x48, 0xc7, 0xae, 0xe0, 0xac, 0x79, 0x57, 0xc6, 0x6e, 0x8c, 0x0e, 0x25, 0x16,
-	0x32, 0x09, 0xfa, 0x51, 0x8b, 0x0a, 0xce, 0xa7, 0xc9, 0xd8, 0x0a, 0x66, 0xba, 0x75, 0xb4, 0xef,
-	0x7b, 0x7d, 0xac, 0x41, 0xd5, 0x3a, 0x92, 0x81, 0x14, 0x2b, 0x58, 0xfe, 0xe7, 0x32, 0x0e, 0x89,
-	0xde, 0x5a, 0x45, 0x7e, 0xcb, 0xa3, 0x3c, 0x2e, 0x52, 0x39, 0x4a, 0xf1, 0x04, 0xfc, 0x58, 0xa1,
-	0xdd, 0x2f, 0xa5, 0x75, 0x87, 0x34, 0x44, 0x48, 0xe5, 0x28, 0xd9, 0x12, 0x4e, 0xbf, 0xd5, 0x67,
-	0x8f, 0x61, 0xb9, 0xa9, 0x1c, 0x44, 0x3d, 0x0f, 0xa7, 0xbb, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff,
-	0xbe, 0xf4, 0x0e, 0x3f, 0xcb, 0x01, 0x00, 0x00,
+	// 792 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x95, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0xc7, 0xe1, 0xda, 0x75, 0xe2, 0xe3, 0x3a, 0x4e, 0xe8, 0x8f, 0x68, 0xed, 0x2e, 0x3c, 0x03,
+	0xdb, 0xbc, 0x8b, 0x66, 0x41, 0x3a, 0x04, 0x03, 0x76, 0xb7, 0xb4, 0xe8, 0x3a, 0x6c, 0x58, 0xc0,
+	0xf4, 0x5e, 0xa0, 0xc5, 0x13, 0x99, 0x88, 0x4c, 0x0a, 0x24, 0xed, 0x35, 0x79, 0xbb, 0xbd, 0xce,
+	0x9e, 0xa2, 0x10, 0x3f, 0xec, 0x58, 0x4e, 0x2e, 0xc9, 0xdf, 0xff, 0xa7, 0x73, 0x48, 0x41, 0x47,
+	0xd0, 0xcb, 0x51, 0xa2, 0x11, 0xe6, 0xac, 0xd4, 0xca, 0x2a, 0xd2, 0xce, 0x94, 0xc6, 0x72, 0x3e,
+	0xfd, 0xbf, 0x01, 0x07, 0x1f, 0x3d, 0x21, 0x3f, 0x42, 0x6b, 0x89, 0x96, 0x25, 0x8d, 0x49, 0x63,
+	0xd6, 0xbd, 0x18, 0x9c, 0xf9, 0xc8, 0x59, 0xc0, 0x7f, 0xa3, 0x65, 0xd4, 0x05, 0xc8, 0x25, 0x74,
+	0x32, 0x25, 0x0d, 0x4a, 0xb3, 0x32, 0xc9, 0x0b, 0x97, 0x4e, 0x6a, 0xe9, 0xab, 0xc8, 0xe9, 0x36,
+	0x4a, 0xfe, 0x01, 0x62, 0xd5, 0x1d, 0xca, 0x94, 0x0b, 0x63, 0xb5, 0x98, 0xaf, 0xac, 0x50, 0x32,
+	0x69, 0x4e, 0x9a, 0xb3, 0xee, 0xc5, 0xa4, 0xf6, 0x80, 0xcf, 0x55, 0xf0, 0xfd, 0xa3, 0x1c, 0x3d,
+	0xb1, 0xf5, 0x2d, 0xf2, 0x16, 0xda, 0x25, 0xd3, 0x6c, 0x69, 0x92, 0x96, 0xeb, 0x62, 0x54, 0x7b,
+	0xc8, 0xb5, 0x83, 0x34, 0x84, 0xa6, 0xff, 0x1d, 0x42, 0x6f, 0x87, 0x90, 0xef, 0xe1, 0x68, 0x5e,
+	0xa8, 0xec, 0x2e, 0x15, 0xd2, 0xa2, 0x5e, 0xb3, 0xc2, 0x1d, 0xbe, 0x49, 0x7b, 0x6e, 0xf7, 0x53,
+	0xd8, 0x24, 0x3f, 0xc1, 0x31, 0xbf, 0x97, 0xcc, 0xd8, 0xfb, 0x6d, 0xf0, 0x85, 0x0b, 0xf6, 0xc3,
+	0xfe, 0x26, 0xfa, 0x06, 0x3a, 0x39, 0x33, 0x69, 0xa9, 0x45, 0x86, 0x49, 0x73, 0xd2, 0x98, 0x75,
+	0xe8, 0x61, 0xce, 0xcc, 0x75, 0xb5, 0x8e, 0xb0, 0x10, 0x4b, 0x61, 0x93, 0xd6, 0x06, 0xfe, 0x55,
+	0xad, 0xc9, 0x0f, 0xd0, 0xf7, 0xbd, 0x6c, 0x23, 0x2f, 0x27, 0x8d, 0x59, 0x2b, 0x34, 0xf3, 0x31,
+	0xe6, 0xbe, 0x83, 0x57, 0xb1, 0x19, 0x23, 0x1e, 0x30, 0x69, 0x4f, 0x1a, 0xb3, 0x1e, 0xed, 0x86,
+	0xbd, 0x1b, 0xf1, 0x80, 0xe4, 0x0a, 0xfa, 0x1a, 0xff, 0x65, 0x9a, 0xa7, 0x26, 0x5b, 0x20, 0x5f,
+	0x15, 0x98, 0x1c, 0xb8, 0x5b, 0x7e, 0x5d, 0xbb, 0x20, 0xea, 0x52, 0x1f, 0x4a, 0x95, 0x2d, 0xe8,
+	0x91, 0x57, 0x6e, 0x82, 0x41, 0x2e, 0x60, 0x64, 0xac, 0xd2, 0x2c, 0xc7, 0x54, 0xe3, 0xed, 0x4a,
+	0xf2, 0x74, 0x81, 0x22, 0x5f, 0xd8, 0xe4, 0xd0, 0x75, 0x35, 0x08, 0x90, 0x3a, 0xf6, 0x87, 0x43,
+	0xe4, 0x1c, 0x86, 0x42, 0x72, 0xfc, 0x82, 0x3c, 0xc5, 0x35, 0x4a, 0x1b, 0x95, 0x8e, 0x53, 0x48,
+	0x60, 0x1f, 0x2a, 0x14, 0x8c, 0xdf, 0xe0, 0xf5, 0x42, 0x19, 0x9b, 0xce, 0x85, 0xe4, 0x42, 0xe6,
+	0x26, 0xcd, 0x16, 0x98, 0xdd, 0x45, 0x0f, 0x9c, 0x77, 0x5a, 0x25, 0x7e, 0x0f, 0x81, 0xab, 0x8a,
+	0x6f, 0xcb, 0x19, 0x44, 0x8e, 0x3c, 0xd5, 0x4c, 0x72, 0xb5, 0x8c, 0x5a, 0xd7, 0x97, 0xf3, 0x8c,
+	0x3a, 0x14, 0x8c, 0x77, 0x30, 0xae, 0x5d, 0x72, 0x74, 0x5e, 0xf9, 0x53, 0xed, 0xdc, 0x75, 0x90,
+	0xce, 0x60, 0xb0, 0x14, 0xc6, 0x20, 0x4f, 0x4d, 0xa1, 0xac, 0x89, 0x46, 0xcf, 0x19, 0x27, 0x1e,
+	0xdd, 0x54, 0x24, 0xe4, 0x7f, 0x85, 0x24, 0x53, 0xd2, 0x6a, 0x96, 0xd9, 0xd4, 0x6a, 0x26, 0xcd,
+	0x2d, 0xea, 0x28, 0x1d, 0x39, 0x69, 0x1c, 0xf9, 0xe7, 0x80, 0xb7, 0x07, 0xf2, 0xed, 0x55, 0x1c,
+	0xbf, 0x6c, 0x9a, 0xeb, 0xfb, 0x03, 0x39, 0x76, 0xe5, 0x51, 0x30, 0x2e, 0xe1, 0x74, 0xb9, 0x2a,
+	0xac, 0x30, 0x22, 0x4f, 0x4b, 0x76, 0x5f, 0x28, 0xb6, 0x79, 0x4f, 0xc7, 0x4e, 0x1a, 0x45, 0x7c,
+	0xed, 0xe9, 0xa3, 0x4a, 0xcc, 0x66, 0x8b, 0xba, 0x74, 0x12, 0x2a, 0x55, 0x6c, 0xd7, 0xb8, 0x84,
+	0x53, 0x2b, 0x96, 0xe8, 0xda, 0xab, 0x49, 0xc4, 0x57, 0x8a, 0x78, 0xaf, 0x92, 0x29, 0x98, 0xd9,
+	0xab, 0x34, 0x08, 0x2f, 0xa9, 0x62, 0xbb, 0xc6, 0x2f, 0x30, 0x5e, 0x95, 0xb9, 0x66, 0x1c, 0xeb,
+	0xce, 0xd0, 0x39, 0xc3, 0x40, 0x77, 0xad, 0x0b, 0x18, 0xad, 0x51, 0x8b, 0xdb, 0xfb, 0xba, 0x34,
+	0xf2, 0x6f, 0xd6, 0xc3, 0xbd, 0xde, 0x4a, 0xb6, 0x32, 0x7b, 0x75, 0xc6, 0xbe, 0x37, 0xc7, 0x76,
+	0x8c, 0xe9, 0x03, 0x90, 0xfd, 0x6f, 0xa7, 0xfa, 0x26, 0x8d, 0x65, 0x7a, 0xf3, 0xbe, 0x1a, 0xce,
+	0xef, 0xba, 0xbd, 0x50, 0x6a, 0x0c, 0x6d, 0xff, 0x81, 0xb9, 0xc9, 0xd1, 0xa1, 0x61, 0x55, 0xcd,
+	0x96, 0x05, 0x2b, 0xd6, 0x42, 0xe6, 0xdb, 0xd9, 0xd2, 0x74, 0x7a, 0x3f, 0xec, 0xc7, 0xd9, 0x32,
+	0x9d, 0x41, 0xf7, 0xd1, 0x30, 0x26, 0xdf, 0xc0, 0x61, 0xb6, 0x60, 0x42, 0xa6, 0x82, 0xbb, 0x82,
+	0x3d, 0x7a, 0xe0, 0xd6, 0x9f, 0xf8, 0xd4, 0xc0, 0x71, 0x7d, 0x10, 0x93, 0x73, 0x68, 0xf1, 0x52,
+	0x99, 0x30, 0xde, 0xbf, 0x7d, 0x6e, 0x60, 0xbf, 0x2f, 0x95, 0xa1, 0x2e, 0x49, 0xde, 0x42, 0xb3,
+	0x54, 0x2c, 0x4c, 0xf8, 0x37, 0xcf, 0x09, 0xd7, 0x8a, 0xd1, 0x2a, 0x37, 0x3d, 0x87, 0xe1, 0x53,
+	0x0f, 0x23, 0x09, 0x1c, 0x84, 0xe1, 0x94, 0x34, 0x26, 0xcd, 0x59, 0x87, 0xc6, 0xe5, 0xf4, 0x67,
+	0x18, 0x3c, 0xf1, 0xb4, 0x4a, 0x30, 0x22, 0x97, 0xa8, 0x4d, 0x14, 0xc2, 0x72, 0xfa, 0x27, 0x24,
+	0xcf, 0xfd, 0x1f, 0x2a, 0x8b, 0x71, 0xae, 0xd1, 0xf8, 0x23, 0x76, 0x68, 0x5c, 0x92, 0x21, 0xbc,
+	0x5c, 0xb3, 0x62, 0x85, 0xe1, 0xe6, 0xfd, 0x62, 0xde, 0x76, 0x7f, 0xc2, 0x77, 0x5f, 0x07, 0x00,
+	0xaa, 0xb0, 0x45, 0x32, 0x1a, 0x07, 0x00, 0x00,
 }
@@ -0,0 +1,232 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Status of the executed transaction in receipt.
+const (
+	ReceiptStatusFailed  = 0
+	ReceiptStatusSuccess = 1
+)
+
+// Receipt is the result of the transaction execution, stored when the block is on chain.
+type Receipt struct {
+	txHash            byteutils.Hash
+	blockHash         byteutils.Hash
+	height            uint64
+	status            uint32
+	gasUsed           *util.Uint128
+	cumulativeGasUsed *util.Uint128
+	err               string
+	contractAddress   *Address
+	gasRefund         *util.Uint128
+	duration          time.Duration // execution time on this node, not stored.
+}
+
+// TxHash returns the hash of the transaction.
+func (r *Receipt) TxHash() byteutils.Hash {
+	return r.txHash
+}
+
+// BlockHash returns the hash of the block including the transaction.
+func (r *Receipt) BlockHash() byteutils.Hash {
+	return r.blockHash
+}
+
+// Height returns the height of the block including the transaction.
+func (r *Receipt) Height() uint64 {
+	return r.height
+}
+
+// Status returns the execution status.
+func (r *Receipt) Status() uint32 {
+	return r.status
+}
+
+// GasUsed returns the gas used by the transaction.
+func (r *Receipt) GasUsed() *util.Uint128 {
+	return r.gasUsed
+}
+
+// CumulativeGasUsed returns the gas used by the transaction and the former ones in block.
+func (r *Receipt) CumulativeGasUsed() *util.Uint128 {
+	return r.cumulativeGasUsed
+}
+
+// Error returns the execution error, empty if succeed.
+func (r *Receipt) Error() string {
+	return r.err
+}
+
+// ContractAddress returns the contract created by the transaction, nil if none.
+func (r *Receipt) ContractAddress() *Address {
+	return r.contractAddress
+}
+
+// GasRefund returns the gas refunded for the storage deleted by the transaction,
+// it's already deducted from the gas used.
+func (r *Receipt) GasRefund() *util.Uint128 {
+	if r.gasRefund == nil {
+		return util.NewUint128()
+	}
+	return r.gasRefund
+}
+
+// ToProto converts domain Receipt to proto Receipt
+func (r *Receipt) ToProto() (proto.Message, error) {
+	gasUsed, err := r.gasUsed.ToFixedSizeByteSlice()
+	if err != nil {
+		return nil, err
+	}
+	cumulativeGasUsed, err := r.cumulativeGasUsed.ToFixedSizeByteSlice()
+	if err != nil {
+		return nil, err
+	}
+	msg := &corepb.Receipt{
+		TxHash:            r.txHash,
+		BlockHash:         r.blockHash,
+		Height:            r.height,
+		Status:            r.status,
+		GasUsed:           gasUsed,
+		CumulativeGasUsed: cumulativeGasUsed,
+		Error:             r.err,
+	}
+	if r.contractAddress != nil {
+		msg.ContractAddress = r.contractAddress.address
+	}
+	if r.gasRefund != nil {
+		if msg.GasRefund, err = r.gasRefund.ToFixedSizeByteSlice(); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+// FromProto converts proto Receipt into domain Receipt
+func (r *Receipt) FromProto(msg proto.Message) error {
+	if msg, ok := msg.(*corepb.Receipt); ok {
+		gasUsed, err := util.NewUint128FromFixedSizeByteSlice(msg.GasUsed)
+		if err != nil {
+			return err
+		}
+		cumulativeGasUsed, err := util.NewUint128FromFixedSizeByteSlice(msg.CumulativeGasUsed)
+		if err != nil {
+			return err
+		}
+		r.txHash = msg.TxHash
+		r.blockHash = msg.BlockHash
+		r.height = msg.Height
+		r.status = msg.Status
+		r.gasUsed = gasUsed
+		r.cumulativeGasUsed = cumulativeGasUsed
+		r.err = msg.Error
+		r.contractAddress = nil
+		if len(msg.ContractAddress) > 0 {
+			r.contractAddress = &Address{msg.ContractAddress}
+		}
+		r.gasRefund = nil
+		if len(msg.GasRefund) > 0 {
+			if r.gasRefund, err = util.NewUint128FromFixedSizeByteSlice(msg.GasRefund); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return errors.New("Protobuf message cannot be converted into Receipt")
+}
+
+// recordReceipt records the receipt of the executed tx, the cumulative gas and
+// the block are filled when the block is stored.
+func (block *Block) recordReceipt(tx *Transaction, gas *util.Uint128, duration time.Duration) error {
+	receipt := &Receipt{
+		txHash:    tx.hash,
+		status:    ReceiptStatusSuccess,
+		gasUsed:   gas,
+		gasRefund: block.gasRefunds[tx.hash.Hex()],
+		duration:  duration,
+	}
+	if reason, ok := block.executionErrors[tx.hash.Hex()]; ok {
+		receipt.status = ReceiptStatusFailed
+		receipt.err = reason
+	} else if tx.Type() == TxPayloadDeployType {
+		addr, err := tx.GenerateContractAddress()
+		if err != nil {
+			return err
+		}
+		receipt.contractAddress = addr
+	}
+	if block.receipts == nil {
+		block.receipts = make(map[byteutils.HexHash]*Receipt)
+	}
+	block.receipts[tx.hash.Hex()] = receipt
+	return nil
+}
+
+// GetReceipt returns the receipt of the transaction on chain.
+func (bc *BlockChain) GetReceipt(hash byteutils.Hash) (*Receipt, error) {
+	value, err := bc.storage.Get(append([]byte(ReceiptPrefix), hash...))
+	if err != nil {
+		return nil, err
+	}
+	pbReceipt := new(corepb.Receipt)
+	if err := proto.Unmarshal(value, pbReceipt); err != nil {
+		return nil, err
+	}
+	receipt := new(Receipt)
+	if err := receipt.FromProto(pbReceipt); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (bc *BlockChain) storeReceiptsToStorage(block *Block) error {
+	cumulativeGasUsed := util.NewUint128()
+	for _, tx := range block.transactions {
+		receipt, ok := block.receipts[tx.hash.Hex()]
+		if !ok {
+			continue
+		}
+		cumulativeGasUsed = util.NewUint128FromBigInt(util.NewUint128().Add(cumulativeGasUsed.Int, receipt.gasUsed.Int))
+		receipt.blockHash = block.Hash()
+		receipt.height = block.height
+		receipt.cumulativeGasUsed = cumulativeGasUsed
+
+		pbReceipt, err := receipt.ToProto()
+		if err != nil {
+			return err
+		}
+		value, err := proto.Marshal(pbReceipt)
+		if err != nil {
+			return err
+		}
+		if err := bc.storage.Put(append([]byte(ReceiptPrefix), tx.hash...), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockChain_GetReceipt(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	coinbase := mockAddress()
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.miner = coinbase
+
+	from := mockAddress()
+	balance := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(10000000).Int))
+	block.accState.GetOrCreateUserAccount(from.address).AddBalance(balance)
+	tx1 := NewTransaction(bc.ChainID(), from, mockAddress(), util.NewUint128FromInt(1), 1, TxPayloadBinaryType, nil, TransactionGasPrice, TransactionMaxGas)
+	tx2 := NewTransaction(bc.ChainID(), from, mockAddress(), balance, 2, TxPayloadBinaryType, nil, TransactionGasPrice, TransactionMaxGas)
+	block.transactions = Transactions{tx1, tx2}
+	assert.Nil(t, block.execute())
+	assert.Nil(t, block.Seal())
+	assert.Nil(t, bc.storeReceiptsToStorage(block))
+
+	receipt1, err := bc.GetReceipt(tx1.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(ReceiptStatusSuccess), receipt1.Status())
+	assert.Equal(t, block.Hash(), receipt1.BlockHash())
+	assert.Equal(t, block.Height(), receipt1.Height())
+	assert.Equal(t, tx1.GasCountOfTxBase().String(), receipt1.GasUsed().String())
+	assert.Equal(t, receipt1.GasUsed().String(), receipt1.CumulativeGasUsed().String())
+	assert.Empty(t, receipt1.Error())
+	assert.Nil(t, receipt1.ContractAddress())
+
+	receipt2, err := bc.GetReceipt(tx2.Hash())
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(ReceiptStatusFailed), receipt2.Status())
+	assert.Equal(t, ErrInsufficientBalance.Error(), receipt2.Error())
+	assert.Equal(t, util.NewUint128().Add(receipt1.GasUsed().Int, receipt2.GasUsed().Int).String(), receipt2.CumulativeGasUsed().String())
+
+	_, err = bc.GetReceipt(mockAddress().Bytes())
+	assert.NotNil(t, err)
+}
+
+func TestGasRefund(t *testing.T) {
+	params := DefaultChainParams()
+	gasUsed := util.NewUint128FromInt(50000)
+
+	// no refund unless enabled by genesis.
+	assert.Equal(t, "0", params.gasRefund(100, gasUsed, 1000).String())
+
+	params = NewChainParams(&corepb.GenesisParams{StorageRefundHeight: 10})
+	assert.Equal(t, "0", params.gasRefund(9, gasUsed, 1000).String())
+	assert.Equal(t, "1000", params.gasRefund(10, gasUsed, 1000).String())
+
+	// capped at 1/MaxGasRefundQuotient of the gas used.
+	assert.Equal(t, "10000", params.gasRefund(10, gasUsed, 20000).String())
+}
+
+func TestReceipt_GasRefund(t *testing.T) {
+	receipt := &Receipt{
+		txHash:            mockAddress().Bytes(),
+		status:            ReceiptStatusSuccess,
+		gasUsed:           util.NewUint128FromInt(20000),
+		cumulativeGasUsed: util.NewUint128FromInt(20000),
+		gasRefund:         util.NewUint128FromInt(100),
+	}
+	pb, err := receipt.ToProto()
+	assert.Nil(t, err)
+
+	decoded := new(Receipt)
+	assert.Nil(t, decoded.FromProto(pb))
+	assert.Equal(t, "100", decoded.GasRefund().String())
+
+	// the receipts without refund report 0.
+	receipt.gasRefund = nil
+	pb, err = receipt.ToProto()
+	assert.Nil(t, err)
+	assert.Nil(t, decoded.FromProto(pb))
+	assert.Equal(t, "0", decoded.GasRefund().String())
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"runtime"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// DefaultSignCacheSize is the count of verified tx signatures kept in cache.
+const DefaultSignCacheSize = 40960
+
+// signVerifier is shared by block validation and tx pool admission, so the
+// signature of a tx received by the pool is not verified again in the block.
+var signVerifier = NewSignVerifier(runtime.NumCPU(), DefaultSignCacheSize)
+
+// SignVerifier verifies tx signatures concurrently by a bounded count of workers
+// and caches the verified txs.
+type SignVerifier struct {
+	workers chan struct{}
+	cache   *lru.Cache
+}
+
+// NewSignVerifier returns a new SignVerifier.
+func NewSignVerifier(workers, cacheSize int) *SignVerifier {
+	if workers < 1 {
+		workers = 1
+	}
+	cache, _ := lru.New(cacheSize)
+	return &SignVerifier{
+		workers: make(chan struct{}, workers),
+		cache:   cache,
+	}
+}
+
+// Verify verifies the signature of tx.
+func (v *SignVerifier) Verify(tx *Transaction) error {
+	key := tx.hash.String()
+	if sign, ok := v.cache.Get(key); ok && byteutils.Equal(sign.(byteutils.Hash), tx.sign) {
+		metricsTxSignCacheHit.Inc(1)
+		return nil
+	}
+
+	v.workers <- struct{}{}
+	err := tx.verifySign()
+	<-v.workers
+	if err != nil {
+		return err
+	}
+	v.cache.Add(key, tx.sign)
+	return nil
+}
+
+// VerifyAll verifies the signatures of txs concurrently, returns the first
+// invalid tx in order and its error.
+func (v *SignVerifier) VerifyAll(txs Transactions) (*Transaction, error) {
+	workers := cap(v.workers)
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+
+	errs := make([]error, len(txs))
+	jobs := make(chan int, len(txs))
+	for i := range txs {
+		jobs <- i
+	}
+	close(jobs)
+
+	wg := new(sync.WaitGroup)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				errs[j] = v.Verify(txs[j])
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return txs[i], err
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockSignedTransaction(t *testing.T) *Transaction {
+	from := mockAddress()
+	key, err := keystore.DefaultKS.GetUnlocked(from.String())
+	assert.Nil(t, err)
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	assert.Nil(t, err)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	tx := NewTransaction(1, from, mockAddress(), util.NewUint128(), 1, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, TransactionMaxGas)
+	assert.Nil(t, tx.Sign(signature))
+	return tx
+}
+
+func TestSignVerifier_VerifyAll(t *testing.T) {
+	verifier := NewSignVerifier(4, 16)
+
+	txs := Transactions{}
+	for i := 0; i < 10; i++ {
+		txs = append(txs, mockSignedTransaction(t))
+	}
+	tx, err := verifier.VerifyAll(txs)
+	assert.Nil(t, tx)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, verifier.cache.Len())
+
+	// a cached tx with a forged signature is verified again.
+	forged := mockSignedTransaction(t)
+	txs[3].sign = forged.sign
+	tx, err = verifier.VerifyAll(txs)
+	assert.Equal(t, txs[3], tx)
+	assert.NotNil(t, err)
+
+	tx, err = verifier.VerifyAll(Transactions{})
+	assert.Nil(t, tx)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,206 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// SnapshotVersion is the version of the snapshot format.
+const SnapshotVersion = 1
+
+// maxSnapshotMessageSize is the max size of a message in snapshot.
+const maxSnapshotMessageSize = 64 * 1024 * 1024
+
+// snapshot format: the header followed by the entries, each message is prefixed
+// by its length in uvarint. The entries are the block and the trie nodes of its
+// state, transactions and events.
+
+// ExportSnapshot writes the snapshot of the latest irreversible block to w, the
+// state of an irreversible block never changes, so the snapshot is consistent.
+func (bc *BlockChain) ExportSnapshot(w io.Writer) (*Block, error) {
+	block := bc.LatestIrreversibleBlock()
+
+	marked := make(map[byteutils.HexHash]bool)
+	for i, root := range blockStateRoots(block) {
+		// the first root is the account state.
+		if err := markTrie(bc.storage, root, i == 0, marked); err != nil {
+			return nil, err
+		}
+	}
+	for _, root := range blockHistoryRoots(block) {
+		if err := markTrie(bc.storage, root, false, marked); err != nil {
+			return nil, err
+		}
+	}
+
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	value, err := proto.Marshal(pbBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	bw := bufio.NewWriter(w)
+	header := &corepb.SnapshotHeader{
+		Version:   SnapshotVersion,
+		ChainId:   bc.chainID,
+		BlockHash: block.Hash(),
+		Height:    block.Height(),
+		Entries:   uint64(len(marked)) + 1,
+	}
+	if err := writeSnapshotMessage(bw, header); err != nil {
+		return nil, err
+	}
+	if err := writeSnapshotMessage(bw, &corepb.SnapshotEntry{Key: block.Hash(), Value: value}); err != nil {
+		return nil, err
+	}
+	for node := range marked {
+		key, err := node.Hash()
+		if err != nil {
+			return nil, err
+		}
+		value, err := bc.storage.Get(key)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeSnapshotMessage(bw, &corepb.SnapshotEntry{Key: key, Value: value}); err != nil {
+			return nil, err
+		}
+	}
+	if err := bw.Flush(); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// RestoreSnapshot restores the snapshot read from r into the empty storage, the block
+// of snapshot is set as the tail and the latest irreversible block. The trie nodes are
+// verified by their hash, and the block is verified to be the one in the header.
+func RestoreSnapshot(r io.Reader, s storage.Storage, chainID uint32) (*corepb.SnapshotHeader, error) {
+	if _, err := s.Get([]byte(Tail)); err != storage.ErrKeyNotFound {
+		if err == nil {
+			return nil, ErrSnapshotStorageNotEmpty
+		}
+		return nil, err
+	}
+
+	br := bufio.NewReader(r)
+	header := new(corepb.SnapshotHeader)
+	if err := readSnapshotMessage(br, header); err != nil {
+		return nil, err
+	}
+	if header.Version != SnapshotVersion {
+		return nil, ErrInvalidSnapshot
+	}
+	if header.ChainId != chainID {
+		return nil, ErrInvalidChainID
+	}
+
+	var value []byte
+	for i := uint64(0); i < header.Entries; i++ {
+		entry := new(corepb.SnapshotEntry)
+		if err := readSnapshotMessage(br, entry); err != nil {
+			return nil, err
+		}
+		if bytes.Equal(entry.Key, header.BlockHash) {
+			value = entry.Value
+			continue
+		}
+		if !bytes.Equal(entry.Key, hash.Sha3256(entry.Value)) {
+			return nil, ErrInvalidSnapshot
+		}
+		if err := s.Put(entry.Key, entry.Value); err != nil {
+			return nil, err
+		}
+	}
+	if value == nil {
+		return nil, ErrInvalidSnapshot
+	}
+
+	pbBlock := new(corepb.Block)
+	if err := proto.Unmarshal(value, pbBlock); err != nil {
+		return nil, err
+	}
+	block := new(Block)
+	if err := block.FromProto(pbBlock); err != nil {
+		return nil, err
+	}
+	if !HashBlock(block).Equals(header.BlockHash) || block.Height() != header.Height {
+		return nil, ErrInvalidSnapshot
+	}
+	if err := s.Put(header.BlockHash, value); err != nil {
+		return nil, err
+	}
+	// check the tries of block are complete.
+	if _, err := LoadBlockFromStorage(header.BlockHash, s, nil, nil); err != nil {
+		return nil, err
+	}
+
+	if err := s.Put(byteutils.FromUint64(header.Height), header.BlockHash); err != nil {
+		return nil, err
+	}
+	if err := s.Put([]byte(LIB), header.BlockHash); err != nil {
+		return nil, err
+	}
+	if err := s.Put([]byte(Tail), header.BlockHash); err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func writeSnapshotMessage(w io.Writer, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, uint64(len(data)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func readSnapshotMessage(r *bufio.Reader, msg proto.Message) error {
+	size, err := binary.ReadUvarint(r)
+	if err != nil {
+		return err
+	}
+	if size > maxSnapshotMessageSize {
+		return ErrInvalidSnapshot
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return err
+	}
+	return proto.Unmarshal(data, msg)
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockChain_Snapshot(t *testing.T) {
+	neb := testNeb()
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+
+	buf := new(bytes.Buffer)
+	block, err := bc.ExportSnapshot(buf)
+	assert.Nil(t, err)
+	assert.Equal(t, bc.LatestIrreversibleBlock().Hash(), block.Hash())
+	data := buf.Bytes()
+
+	// the storage of an existing chain is rejected.
+	_, err = RestoreSnapshot(bytes.NewReader(data), bc.storage, bc.ChainID())
+	assert.Equal(t, ErrSnapshotStorageNotEmpty, err)
+
+	stor, _ := storage.NewMemoryStorage()
+	_, err = RestoreSnapshot(bytes.NewReader(data), stor, bc.ChainID()+1)
+	assert.Equal(t, ErrInvalidChainID, err)
+
+	stor, _ = storage.NewMemoryStorage()
+	header, err := RestoreSnapshot(bytes.NewReader(data), stor, bc.ChainID())
+	assert.Nil(t, err)
+	assert.Equal(t, block.Height(), header.Height)
+
+	restored, err := LoadBlockFromStorage(header.BlockHash, stor, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, block.StateRoot(), restored.StateRoot())
+	for _, v := range neb.Genesis().TokenDistribution {
+		addr, err := AddressParse(v.Address)
+		assert.Nil(t, err)
+		assert.Equal(t, block.GetBalance(addr.Bytes()), restored.GetBalance(addr.Bytes()))
+	}
+	tail, err := stor.Get([]byte(Tail))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(block.Hash()), tail)
+
+	// corrupted trie nodes are rejected.
+	corrupted := append([]byte{}, data...)
+	corrupted[len(corrupted)-1]++
+	stor, _ = storage.NewMemoryStorage()
+	_, err = RestoreSnapshot(bytes.NewReader(corrupted), stor, bc.ChainID())
+	assert.Equal(t, ErrInvalidSnapshot, err)
+}
@@ -19,6 +19,7 @@
 package state
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 
@@ -337,6 +338,43 @@ func (as *accountState) Accounts() ([]Account, error) {
 	return accounts, nil
 }
 
+// IterateAccounts calls fn with the committed accounts in the order of address, starting
+// after the given address, until fn returns false.
+func (as *accountState) IterateAccounts(after byteutils.Hash, fn func(Account) (bool, error)) error {
+	iter, err := as.stateTrie.Iterator(nil)
+	if err == storage.ErrKeyNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	exist, err := iter.Next()
+	for ; exist && err == nil; exist, err = iter.Next() {
+		acc := new(account)
+		if err := acc.FromBytes(iter.Value(), as.storage); err != nil {
+			return err
+		}
+		// the trie is traversed in the order of keys.
+		if after != nil && bytes.Compare(acc.Address(), after) <= 0 {
+			continue
+		}
+		next, err := fn(acc)
+		if err != nil || !next {
+			return err
+		}
+	}
+	return err
+}
+
+// DirtyAccounts returns the accounts loaded or changed in the batch task.
+func (as *accountState) DirtyAccounts() []Account {
+	accounts := make([]Account, 0, len(as.dirtyAccount))
+	for _, acc := range as.dirtyAccount {
+		accounts = append(accounts, acc)
+	}
+	return accounts
+}
+
 // BeginBatch begin a batch task
 func (as *accountState) BeginBatch() {
 	as.stateTrie.BeginBatch()
@@ -70,3 +70,25 @@ func TestAccountState(t *testing.T) {
 	as.RollBack()
 	assert.Equal(t, as.RootHash(), asClone.RootHash())
 }
+
+func TestAccountState_IterateAccounts(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	as, _ := NewAccountState(nil, stor)
+	for _, v := range []string{"accAddr3", "accAddr1", "accAddr2"} {
+		as.GetOrCreateUserAccount([]byte(v)).AddBalance(util.NewUint128FromInt(1))
+	}
+	as.RootHash()
+
+	collect := func(after []byte, limit int) []string {
+		var addrs []string
+		err := as.IterateAccounts(after, func(acc Account) (bool, error) {
+			addrs = append(addrs, string(acc.Address()))
+			return len(addrs) < limit, nil
+		})
+		assert.Nil(t, err)
+		return addrs
+	}
+	assert.Equal(t, []string{"accAddr1", "accAddr2", "accAddr3"}, collect(nil, 10))
+	assert.Equal(t, []string{"accAddr2", "accAddr3"}, collect([]byte("accAddr1"), 10))
+	assert.Equal(t, []string{"accAddr1"}, collect(nil, 1))
+}
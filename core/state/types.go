@@ -59,6 +59,8 @@ type Account interface {
 type AccountState interface {
 	RootHash() byteutils.Hash
 	Accounts() ([]Account, error)
+	IterateAccounts(after byteutils.Hash, fn func(Account) (bool, error)) error
+	DirtyAccounts() []Account
 
 	BeginBatch()
 	Commit()
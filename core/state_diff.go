@@ -0,0 +1,130 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+)
+
+// BalanceDelta is the balance change of an account made by a transaction.
+type BalanceDelta struct {
+	Address *Address
+	Delta   *big.Int
+}
+
+// StorageWrite is a write to the storage of a contract, the value is empty if deleted.
+type StorageWrite struct {
+	Contract *Address
+	Key      string
+	Value    string
+	Deleted  bool
+}
+
+// StateDiff is the state changes made by a transaction.
+type StateDiff struct {
+	GasUsed  *big.Int
+	Err      string
+	Balances []*BalanceDelta
+	Storage  []*StorageWrite
+	Events   []*Event
+}
+
+// DryRun executes the tx on the tail block, see Transaction.DryRun.
+func (bc *BlockChain) DryRun(ctx context.Context, tx *Transaction) (*StateDiff, error) {
+	snapshot, err := bc.TailSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	return tx.DryRun(ctx, snapshot)
+}
+
+// DryRun executes the tx on a copy of the block state as if it were packed in the next block,
+// and returns the state changes without committing them. Unlike LocalExecution, the gas is
+// charged and the value is transferred as in the block execution.
+func (tx *Transaction) DryRun(ctx context.Context, block *Block) (*StateDiff, error) {
+	// the execution results of the unsigned tx are recorded by its hash.
+	if len(tx.hash) == 0 {
+		hash, err := HashTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+		tx.hash = hash
+	}
+
+	origin, err := block.accState.Clone()
+	if err != nil {
+		return nil, err
+	}
+	dryRun, err := block.Clone()
+	if err != nil {
+		return nil, err
+	}
+	// the accounts touched in execution are tracked in batch.
+	dryRun.accState.BeginBatch()
+	defer dryRun.accState.RollBack()
+
+	tracer := nvm.NewTracer()
+	gas, err := tx.verifyExecution(dryRun, ctx, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &StateDiff{GasUsed: gas.Int}
+	if reason, ok := dryRun.executionErrors[tx.hash.Hex()]; ok {
+		diff.Err = reason
+	}
+
+	for _, acc := range dryRun.accState.DirtyAccounts() {
+		before := origin.GetOrCreateUserAccount(acc.Address()).Balance()
+		delta := new(big.Int).Sub(acc.Balance().Int, before.Int)
+		if delta.Sign() != 0 {
+			diff.Balances = append(diff.Balances, &BalanceDelta{Address: &Address{acc.Address()}, Delta: delta})
+		}
+	}
+	sort.Slice(diff.Balances, func(i, j int) bool {
+		return diff.Balances[i].Address.String() < diff.Balances[j].Address.String()
+	})
+
+	// the storage writes are rolled back if the execution failed.
+	if len(diff.Err) == 0 {
+		contract := tx.to
+		if tx.Type() == TxPayloadDeployType {
+			if contract, err = tx.GenerateContractAddress(); err != nil {
+				return nil, err
+			}
+		}
+		for _, step := range tracer.Steps {
+			switch step.Op {
+			case nvm.TraceOpStoragePut:
+				diff.Storage = append(diff.Storage, &StorageWrite{Contract: contract, Key: step.Args[0], Value: step.Args[1]})
+			case nvm.TraceOpStorageDel:
+				diff.Storage = append(diff.Storage, &StorageWrite{Contract: contract, Key: step.Args[0], Deleted: true})
+			}
+		}
+	}
+
+	if diff.Events, err = dryRun.FetchEvents(tx.hash); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// IterateAccounts calls fn with the accounts in the state of the irreversible block at height
+// in the order of address, starting after the given address, until fn returns false. The
+// latest irreversible block is used if height is 0. The state of an irreversible block never
+// changes, so the iteration can be resumed from the last address.
+func (bc *BlockChain) IterateAccounts(height uint64, after byteutils.Hash, fn func(state.Account) (bool, error)) error {
+	lib := bc.LatestIrreversibleBlock()
+	if height == 0 {
+		height = lib.Height()
+	}
+	if height > lib.Height() {
+		return ErrBlockNotIrreversible
+	}
+	block := bc.GetBlockOnCanonicalChainByHeight(height)
+	if block == nil {
+		return ErrNotBlockInCanonicalChain
+	}
+	return block.accState.IterateAccounts(after, fn)
+}
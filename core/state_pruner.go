@@ -0,0 +1,337 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// MinStatePruneBlocks is the min count of the latest blocks whose state is kept in pruning mode.
+	MinStatePruneBlocks = 128
+
+	// StatePruneInterval is the count of blocks between two rounds of state pruning.
+	StatePruneInterval = 128
+)
+
+// Node modes.
+const (
+	// ArchiveNodeMode keeps the state of all blocks.
+	ArchiveNodeMode = "archive"
+
+	// FullNodeMode keeps the state of the latest blocks and prunes the older.
+	FullNodeMode = "full"
+)
+
+func nodeMode(conf *nebletpb.ChainConfig) (string, error) {
+	switch conf.NodeMode {
+	case "":
+		if conf.StatePruneBlocks > 0 {
+			return FullNodeMode, nil
+		}
+		return ArchiveNodeMode, nil
+	case ArchiveNodeMode, FullNodeMode:
+		return conf.NodeMode, nil
+	}
+	return "", ErrInvalidNodeMode
+}
+
+// NodeMode returns the mode of node.
+func (bc *BlockChain) NodeMode() string {
+	return bc.mode
+}
+
+// CheckStateAvailable returns ErrStateUnavailable if the state of block is not
+// served in the mode of node.
+func (bc *BlockChain) CheckStateAvailable(block *Block) error {
+	switch bc.mode {
+	case FullNodeMode:
+		if CheckGenesisBlock(block) || block.Hash().Equals(bc.LatestIrreversibleBlock().Hash()) {
+			return nil
+		}
+		if tail := bc.TailBlock(); block.Height()+bc.pruner.keep <= tail.Height() {
+			return ErrStateUnavailable
+		}
+	}
+	return nil
+}
+
+// journalStorage records the trie nodes written into storage, a trie node is stored
+// with the sha3 hash of its value as key. The nodes are swept one round after they
+// are written, so that the blocks being executed are not affected.
+type journalStorage struct {
+	storage.Storage
+
+	mu       sync.Mutex
+	current  map[byteutils.HexHash]bool
+	previous map[byteutils.HexHash]bool
+	roots    map[byteutils.HexHash]bool // the state roots of blocks, kept to load the pruned blocks.
+
+	// the txs and events roots of blocks stored in the current and previous round,
+	// the history of blocks is never pruned.
+	histories     []byteutils.Hash
+	prevHistories []byteutils.Hash
+}
+
+func newJournalStorage(s storage.Storage) *journalStorage {
+	return &journalStorage{
+		Storage:  s,
+		current:  make(map[byteutils.HexHash]bool),
+		previous: make(map[byteutils.HexHash]bool),
+		roots:    make(map[byteutils.HexHash]bool),
+	}
+}
+
+// Put put the key-value entry to Storage and record it if it's a trie node
+func (s *journalStorage) Put(key []byte, value []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.Storage.Put(key, value); err != nil {
+		return err
+	}
+	if bytes.Equal(key, hash.Sha3256(value)) {
+		s.current[byteutils.Hash(key).Hex()] = true
+	}
+	return nil
+}
+
+// keepRoots records the trie roots of the stored block, they are never swept.
+func (s *journalStorage) keepRoots(block *Block) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, root := range blockStateRoots(block) {
+		s.roots[root.Hex()] = true
+	}
+	s.histories = append(s.histories, blockHistoryRoots(block)...)
+}
+
+// rotate returns the nodes written in the previous round to be swept, and
+// the history roots of blocks stored since then.
+func (s *journalStorage) rotate() (map[byteutils.HexHash]bool, []byteutils.Hash) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	candidates := s.previous
+	s.previous, s.current = s.current, make(map[byteutils.HexHash]bool)
+
+	histories := append(s.prevHistories, s.histories...)
+	s.prevHistories, s.histories = s.histories, nil
+	return candidates, histories
+}
+
+// sweep deletes the candidates unreachable from the kept blocks and histories, the
+// ones reachable from the kept blocks are swept again in later rounds since they may
+// be orphaned by the following blocks.
+func (s *journalStorage) sweep(candidates, marked, histories map[byteutils.HexHash]bool) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	swept := 0
+	for node := range candidates {
+		// the node is written again since rotated.
+		if s.current[node] || s.previous[node] {
+			continue
+		}
+		if marked[node] {
+			s.current[node] = true
+			continue
+		}
+		if s.roots[node] || histories[node] {
+			continue
+		}
+		key, err := node.Hash()
+		if err != nil {
+			return swept, err
+		}
+		if err := s.Storage.Del(key); err != nil {
+			return swept, err
+		}
+		swept++
+	}
+
+	// the roots not waiting to be swept are useless.
+	for root := range s.roots {
+		if !s.current[root] && !s.previous[root] {
+			delete(s.roots, root)
+		}
+	}
+	return swept, nil
+}
+
+// blockStateRoots returns the roots of the state tries of block, the account state first.
+func blockStateRoots(block *Block) []byteutils.Hash {
+	dpos := block.DposContext()
+	return nonEmptyRoots(
+		block.StateRoot(),
+		dpos.DynastyRoot,
+		dpos.NextDynastyRoot,
+		dpos.DelegateRoot,
+		dpos.CandidateRoot,
+		dpos.VoteRoot,
+		dpos.MintCntRoot,
+	)
+}
+
+// blockHistoryRoots returns the roots of the transactions and events tries of block.
+func blockHistoryRoots(block *Block) []byteutils.Hash {
+	return nonEmptyRoots(block.TxsRoot(), block.EventsRoot())
+}
+
+func nonEmptyRoots(roots ...byteutils.Hash) []byteutils.Hash {
+	ret := make([]byteutils.Hash, 0, len(roots))
+	for _, root := range roots {
+		if len(root) > 0 {
+			ret = append(ret, root)
+		}
+	}
+	return ret
+}
+
+// markTrie marks the nodes reachable from root, the variables tries of accounts are
+// marked if it's the account state trie.
+func markTrie(s storage.Storage, root byteutils.Hash, accounts bool, marked map[byteutils.HexHash]bool) error {
+	t, err := trie.NewTrie(root, s)
+	if err != nil {
+		return err
+	}
+	var walkErr error
+	err = t.Walk(func(node []byte, value []byte) bool {
+		key := byteutils.Hash(node).Hex()
+		if marked[key] || walkErr != nil {
+			return false
+		}
+		marked[key] = true
+		if accounts && value != nil {
+			acc := new(corepb.Account)
+			if walkErr = proto.Unmarshal(value, acc); walkErr != nil {
+				return false
+			}
+			if len(acc.VarsHash) > 0 {
+				walkErr = markTrie(s, acc.VarsHash, false, marked)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	return walkErr
+}
+
+// statePruner keeps the state of the latest blocks, the latest irreversible block, the
+// genesis and the detached tails, and deletes the orphaned trie nodes of the others.
+// The transactions and events of all blocks are kept. The nodes written before the
+// node starts are not recorded, they are kept on disk.
+type statePruner struct {
+	storage *journalStorage
+	keep    uint64
+	pruned  uint64 // the tail height of last round.
+}
+
+func newStatePruner(s storage.Storage, keep uint32) *statePruner {
+	if keep < MinStatePruneBlocks {
+		keep = MinStatePruneBlocks
+	}
+	return &statePruner{storage: newJournalStorage(s), keep: uint64(keep)}
+}
+
+// keptBlocks returns the blocks whose state is kept.
+func (p *statePruner) keptBlocks(bc *BlockChain) []*Block {
+	blocks := []*Block{bc.GenesisBlock(), bc.LatestIrreversibleBlock()}
+	cur := bc.TailBlock()
+	for i := uint64(0); i < p.keep && cur != nil; i++ {
+		blocks = append(blocks, cur)
+		if CheckGenesisBlock(cur) {
+			break
+		}
+		cur = bc.GetBlock(cur.ParentHash())
+	}
+	return append(blocks, bc.DetachedTailBlocks()...)
+}
+
+// prune runs a round of pruning if the tail grows StatePruneInterval blocks since last round.
+func (p *statePruner) prune(bc *BlockChain) {
+	tail := bc.TailBlock()
+	if tail.Height() < p.pruned+StatePruneInterval {
+		return
+	}
+	p.pruned = tail.Height()
+
+	candidates, roots := p.storage.rotate()
+	if len(candidates) == 0 {
+		return
+	}
+
+	histories := make(map[byteutils.HexHash]bool)
+	for _, root := range roots {
+		if err := markTrie(p.storage, root, false, histories); err != nil {
+			p.abort(candidates, err)
+			return
+		}
+	}
+
+	marked := make(map[byteutils.HexHash]bool)
+	for _, block := range p.keptBlocks(bc) {
+		for i, root := range blockStateRoots(block) {
+			// the first root is the account state.
+			if err := markTrie(p.storage, root, i == 0, marked); err != nil {
+				p.abort(candidates, err)
+				return
+			}
+		}
+	}
+
+	swept, err := p.storage.sweep(candidates, marked, histories)
+	metricsStatePrunedNodes.Inc(int64(swept))
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"tail":  tail,
+			"swept": swept,
+			"err":   err,
+		}).Error("Failed to sweep the orphaned state.")
+		return
+	}
+	logging.VLog().WithFields(logrus.Fields{
+		"tail":       tail,
+		"candidates": len(candidates),
+		"marked":     len(marked),
+		"swept":      swept,
+	}).Info("Pruned the orphaned state.")
+}
+
+// abort puts the candidates back to retry in next round.
+func (p *statePruner) abort(candidates map[byteutils.HexHash]bool, err error) {
+	p.storage.sweep(candidates, candidates, nil)
+	logging.VLog().WithFields(logrus.Fields{
+		"err": err,
+	}).Error("Failed to mark the state to keep.")
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJournalStorage_Sweep(t *testing.T) {
+	stor, _ := storage.NewMemoryStorage()
+	s := newJournalStorage(stor)
+
+	// only the trie nodes are recorded.
+	assert.Nil(t, s.Put([]byte("key"), []byte("value")))
+	tr, err := trie.NewTrie(nil, s)
+	assert.Nil(t, err)
+	_, err = tr.Put(hash.Sha3256([]byte("k1")), []byte("v1"))
+	assert.Nil(t, err)
+	old := byteutils.Hash(tr.RootHash())
+	_, err = tr.Put(hash.Sha3256([]byte("k2")), []byte("v2"))
+	assert.Nil(t, err)
+	root := byteutils.Hash(tr.RootHash())
+	assert.NotContains(t, s.current, byteutils.Hash([]byte("key")).Hex())
+	assert.Contains(t, s.current, old.Hex())
+
+	// nodes are swept one round after written.
+	candidates, _ := s.rotate()
+	assert.Equal(t, 0, len(candidates))
+	candidates, _ = s.rotate()
+	assert.NotEqual(t, 0, len(candidates))
+
+	marked := make(map[byteutils.HexHash]bool)
+	assert.Nil(t, markTrie(s, root, false, marked))
+	swept, err := s.sweep(candidates, marked, nil)
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, swept)
+
+	// the orphaned root is deleted, the marked nodes are swept again in later rounds.
+	_, err = stor.Get(old)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Contains(t, s.current, root.Hex())
+	tr, err = trie.NewTrie(root, s)
+	assert.Nil(t, err)
+	v, err := tr.Get(hash.Sha3256([]byte("k1")))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("v1"), v)
+	_, err = stor.Get([]byte("key"))
+	assert.Nil(t, err)
+}
+
+func TestNodeMode(t *testing.T) {
+	tests := []struct {
+		mode string
+		keep uint32
+		want string
+		err  error
+	}{
+		{"", 0, ArchiveNodeMode, nil},
+		{"", 256, FullNodeMode, nil},
+		{ArchiveNodeMode, 256, ArchiveNodeMode, nil},
+		{FullNodeMode, 0, FullNodeMode, nil},
+		{"light", 0, "", ErrInvalidNodeMode},
+		{"unknown", 0, "", ErrInvalidNodeMode},
+	}
+	for _, tt := range tests {
+		mode, err := nodeMode(&nebletpb.ChainConfig{NodeMode: tt.mode, StatePruneBlocks: tt.keep})
+		assert.Equal(t, tt.err, err)
+		assert.Equal(t, tt.want, mode)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// BurnAddress is the address without private key, the balance sent to it can never be spent.
+var BurnAddress = GenesisCoinbase
+
+// Supply is the token supply at the height of a block.
+type Supply struct {
+	Height uint64
+
+	// Genesis is the token distributed in the genesis block.
+	Genesis *util.Uint128
+
+	// Minted is the total reward given to the coinbases of blocks.
+	Minted *util.Uint128
+
+	// Burned is the balance of the burn address.
+	Burned *util.Uint128
+
+	// Total is the sum of genesis distribution and minted reward.
+	Total *util.Uint128
+
+	// Circulating is the total supply excluding the burned.
+	Circulating *util.Uint128
+}
+
+// Supply returns the token supply at the height of the block, every block except
+// genesis mints the reward in schedule, the gas is paid to the coinbase and not burned.
+func (bc *BlockChain) Supply(block *Block) (*Supply, error) {
+	accounts, err := bc.genesisBlock.accState.Accounts()
+	if err != nil {
+		return nil, err
+	}
+	genesis := new(big.Int)
+	for _, v := range accounts {
+		genesis.Add(genesis, v.Balance().Int)
+	}
+
+	minted := bc.params.TotalBlockReward(bc.genesisBlock.Height()+1, block.Height())
+	total := new(big.Int).Add(genesis, minted)
+	burned := block.GetBalance(BurnAddress.Bytes())
+
+	return &Supply{
+		Height:      block.Height(),
+		Genesis:     util.NewUint128FromBigInt(genesis),
+		Minted:      util.NewUint128FromBigInt(minted),
+		Burned:      burned,
+		Total:       util.NewUint128FromBigInt(total),
+		Circulating: util.NewUint128FromBigInt(new(big.Int).Sub(total, burned.Int)),
+	}, nil
+}
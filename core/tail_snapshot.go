@@ -0,0 +1,76 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"context"
+	"runtime"
+)
+
+var (
+	// localExecutionSlots bounds the concurrent read-only executions, so the heavy read
+	// traffic leaves cpu for the block execution.
+	localExecutionSlots = make(chan struct{}, runtime.NumCPU())
+)
+
+// TailSnapshot returns an immutable copy of the tail block. The snapshot is never read
+// directly, the read-only executions run on clones of it, so they neither race with
+// nor wait for the block processing on the tail block.
+func (bc *BlockChain) TailSnapshot() (*Block, error) {
+	tail := bc.tailBlock
+
+	bc.snapshotLock.RLock()
+	snapshot := bc.tailSnapshot
+	bc.snapshotLock.RUnlock()
+	if snapshot != nil && snapshot.Hash().Equals(tail.Hash()) {
+		return snapshot, nil
+	}
+	return bc.updateTailSnapshot(tail)
+}
+
+// updateTailSnapshot takes the snapshot of the new tail block.
+func (bc *BlockChain) updateTailSnapshot(tail *Block) (*Block, error) {
+	snapshot, err := tail.Clone()
+	if err != nil {
+		return nil, err
+	}
+
+	bc.snapshotLock.Lock()
+	defer bc.snapshotLock.Unlock()
+	bc.tailSnapshot = snapshot
+	return snapshot, nil
+}
+
+// acquireLocalExecution waits for a slot of the read-only executions, it fails if ctx is done first.
+func acquireLocalExecution(ctx context.Context) error {
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	select {
+	case localExecutionSlots <- struct{}{}:
+		return nil
+	case <-done:
+		return ctx.Err()
+	}
+}
+
+func releaseLocalExecution() {
+	<-localExecutionSlots
+}
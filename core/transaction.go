@@ -19,6 +19,7 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -31,6 +32,7 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
@@ -59,6 +61,20 @@ var (
 	DelegateBaseGasCount = util.NewUint128FromInt(20000)
 	// CandidateBaseGasCount is base gas count of candidate transaction
 	CandidateBaseGasCount = util.NewUint128FromInt(20000)
+	// MultisigBaseGasCount is base gas count of multisig transaction
+	MultisigBaseGasCount = util.NewUint128FromInt(20000)
+	// BatchTransferGasCount is gas count of each recipient in batch transaction
+	BatchTransferGasCount = util.NewUint128FromInt(20000)
+	// TimelockBaseGasCount is base gas count of timelock transaction
+	TimelockBaseGasCount = util.NewUint128FromInt(20000)
+	// SlashBaseGasCount is base gas count of slash transaction
+	SlashBaseGasCount = util.NewUint128FromInt(20000)
+	// UpgradeBaseGasCount is base gas count of upgrade transaction
+	UpgradeBaseGasCount = util.NewUint128FromInt(20000)
+	// VerifyBaseGasCount is base gas count of verify transaction
+	VerifyBaseGasCount = util.NewUint128FromInt(20000)
+	// PauseBaseGasCount is base gas count of pause transaction
+	PauseBaseGasCount = util.NewUint128FromInt(20000)
 	// ZeroGasCount is zero gas count
 	ZeroGasCount = util.NewUint128()
 )
@@ -76,6 +92,10 @@ type Transaction struct {
 	gasPrice  *util.Uint128
 	gasLimit  *util.Uint128
 
+	// the tx can't be included in the blocks after the height or timestamp, 0 means no expiry.
+	validUntilHeight uint64
+	validUntil       int64
+
 	// Signature
 	alg  uint8          // algorithm
 	sign byteutils.Hash // Signature values
@@ -111,6 +131,28 @@ func (tx *Transaction) Nonce() uint64 {
 	return tx.nonce
 }
 
+// ValidUntilHeight return the last block height the tx can be included in, 0 means no expiry.
+func (tx *Transaction) ValidUntilHeight() uint64 {
+	return tx.validUntilHeight
+}
+
+// ValidUntil return the last block timestamp the tx can be included in, 0 means no expiry.
+func (tx *Transaction) ValidUntil() int64 {
+	return tx.validUntil
+}
+
+// SetValidUntil set the expiry of the tx, it must be called before the tx is hashed and signed.
+func (tx *Transaction) SetValidUntil(height uint64, timestamp int64) {
+	tx.validUntilHeight = height
+	tx.validUntil = timestamp
+}
+
+// Expired return if the tx can't be included in the block of height and timestamp.
+func (tx *Transaction) Expired(height uint64, timestamp int64) bool {
+	return (tx.validUntilHeight > 0 && height > tx.validUntilHeight) ||
+		(tx.validUntil > 0 && timestamp > tx.validUntil)
+}
+
 // Type return tx type
 func (tx *Transaction) Type() string {
 	return tx.data.Type
@@ -148,6 +190,9 @@ func (tx *Transaction) ToProto() (proto.Message, error) {
 		GasLimit:  gasLimit,
 		Alg:       uint32(tx.alg),
 		Sign:      tx.sign,
+
+		ValidUntilHeight: tx.validUntilHeight,
+		ValidUntil:       tx.validUntil,
 	}, nil
 }
 
@@ -178,6 +223,8 @@ func (tx *Transaction) FromProto(msg proto.Message) error {
 		tx.gasLimit = gasLimit
 		tx.alg = uint8(msg.Alg)
 		tx.sign = msg.Sign
+		tx.validUntilHeight = msg.ValidUntilHeight
+		tx.validUntil = msg.ValidUntil
 		return nil
 	}
 	return errors.New("Protobug Message cannot be converted into Transaction")
@@ -278,6 +325,9 @@ func (tx *Transaction) LoadPayload(block *Block) (TxPayload, error) {
 		payload TxPayload
 		err     error
 	)
+	if height, ok := block.chainParams().PayloadHeights[tx.data.Type]; ok && (height == 0 || block.Height() < height) {
+		return nil, ErrInvalidTxPayloadType
+	}
 	switch tx.data.Type {
 	case TxPayloadBinaryType:
 		if block.Height() >= 280921 && block.Height() <= 297680 || block.Height() >= 300087 && block.Height() <= 302302 {
@@ -293,14 +343,65 @@ func (tx *Transaction) LoadPayload(block *Block) (TxPayload, error) {
 		payload, err = LoadCandidatePayload(tx.data.Payload)
 	case TxPayloadDelegateType:
 		payload, err = LoadDelegatePayload(tx.data.Payload)
+	case TxPayloadMultisigType:
+		payload, err = LoadMultisigPayload(tx.data.Payload)
+	case TxPayloadBatchType:
+		payload, err = LoadBatchPayload(tx.data.Payload)
+	case TxPayloadTimelockType:
+		payload, err = LoadTimelockPayload(tx.data.Payload)
+	case TxPayloadSlashType:
+		payload, err = LoadSlashPayload(tx.data.Payload)
+	case TxPayloadUpgradeType:
+		payload, err = LoadUpgradePayload(tx.data.Payload)
+	case TxPayloadVerifyType:
+		payload, err = LoadVerifyPayload(tx.data.Payload)
+	case TxPayloadPauseType:
+		payload, err = LoadPausePayload(tx.data.Payload)
 	default:
 		err = ErrInvalidTxPayloadType
 	}
 	return payload, err
 }
 
-// LocalExecution returns tx local execution
-func (tx *Transaction) LocalExecution(block *Block) (*util.Uint128, string, error) {
+// ExecutionError is the error of a failed payload execution with its result, which is the
+// exception thrown by the contract and the location it is thrown.
+type ExecutionError struct {
+	Err    error
+	Result string
+}
+
+// NewExecutionError returns the err with the result of the failed execution, err as is if
+// there is no result.
+func NewExecutionError(err error, result string) error {
+	if err == nil || len(result) == 0 {
+		return err
+	}
+	return &ExecutionError{Err: err, Result: result}
+}
+
+func (e *ExecutionError) Error() string {
+	return e.Err.Error() + ": " + e.Result
+}
+
+// LocalExecution returns tx local execution, the execution is aborted when ctx is done.
+func (tx *Transaction) LocalExecution(ctx context.Context, block *Block) (*util.Uint128, string, error) {
+	return tx.localExecution(ctx, block, nil)
+}
+
+// localExecution executes the tx locally, the execution in nvm is recorded by the tracer if not nil.
+func (tx *Transaction) localExecution(ctx context.Context, block *Block, tracer *nvm.Tracer) (*util.Uint128, string, error) {
+	if err := acquireLocalExecution(ctx); err != nil {
+		return util.NewUint128(), "", err
+	}
+	defer releaseLocalExecution()
+
+	// the execution runs on a copy of the block state, the block may be shared with
+	// the block processing and the other local executions.
+	block, err := block.Clone()
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
+
 	// update gas to max for estimate
 	tx.gasLimit = TransactionMaxGas
 
@@ -318,14 +419,18 @@ func (tx *Transaction) LocalExecution(block *Block) (*util.Uint128, string, erro
 	gasUsed := tx.GasCountOfTxBase()
 	gasUsed.Add(gasUsed.Int, payload.BaseGasCount().Int)
 
-	ctx := NewPayloadContext(block, tx)
-	err = ctx.BeginBatch()
+	payloadCtx := NewPayloadContext(block, tx)
+	payloadCtx.SetCancelContext(ctx)
+	payloadCtx.SetTracer(tracer)
+	payloadCtx.SetPooledEngine(true)
+	payloadCtx.SetLocalExecution(true)
+	err = payloadCtx.BeginBatch()
 	if err != nil {
 		return gasUsed, "", err
 	}
-	defer ctx.RollBack()
+	defer payloadCtx.RollBack()
 
-	gasExecution, result, err := payload.Execute(ctx)
+	gasExecution, result, err := payload.Execute(payloadCtx)
 
 	gas := util.NewUint128FromBigInt(util.NewUint128().Add(gasUsed.Int, gasExecution.Int))
 	return gas, result, err
@@ -333,6 +438,12 @@ func (tx *Transaction) LocalExecution(block *Block) (*util.Uint128, string, erro
 
 // VerifyExecution transaction and return result.
 func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
+	return tx.verifyExecution(block, nil, nil)
+}
+
+// verifyExecution executes the tx in block, the execution of payload is aborted when
+// cancelCtx is done and recorded by the tracer if not nil.
+func (tx *Transaction) verifyExecution(block *Block, cancelCtx context.Context, tracer *nvm.Tracer) (*util.Uint128, error) {
 	// check balance.
 	fromAcc := block.accState.GetOrCreateUserAccount(tx.from.address)
 	toAcc := block.accState.GetOrCreateUserAccount(tx.to.address)
@@ -366,10 +477,13 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 
 		tx.gasConsumption(fromAcc, coinbaseAcc, gasUsed)
 		tx.triggerEvent(TopicExecuteTxFailed, block, err)
+		block.recordExecutionError(tx.hash, err, "")
 		return gasUsed, nil
 	}
 
 	ctx := NewPayloadContext(block, tx)
+	ctx.SetCancelContext(cancelCtx)
+	ctx.SetTracer(tracer)
 
 	err = ctx.BeginBatch()
 	if err != nil {
@@ -387,11 +501,12 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 
 		tx.gasConsumption(fromAcc, coinbaseAcc, tx.gasLimit)
 		tx.triggerEvent(TopicExecuteTxFailed, block, err)
+		block.recordExecutionError(tx.hash, ErrOutOfGasLimit, "")
 		return tx.gasLimit, nil
 	}
 
 	// execute smart contract and sub the calcute gas.
-	gasExecution, _, err := payload.Execute(ctx)
+	gasExecution, result, err := payload.Execute(ctx)
 	if err != nil {
 		ctx.RollBack()
 	} else {
@@ -405,6 +520,13 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 	// gas = tx.GasCountOfTxBase() +  gasExecution
 	gas := util.NewUint128FromBigInt(util.NewUint128().Add(gasUsed.Int, gasExecution.Int))
 
+	// the storage deleted by the succeeded execution refunds part of the gas.
+	if err == nil {
+		refund := block.chainParams().gasRefund(block.height, gas, ctx.gasRefund)
+		gas.Sub(gas.Int, refund.Int)
+		block.recordGasRefund(tx.hash, refund)
+	}
+
 	/* 	logging.VLog().WithFields(logrus.Fields{
 		"tx":           tx,
 		"gasUsed":      gasUsed.String(),
@@ -427,6 +549,7 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 
 		metricsTxExeFailed.Mark(1)
 		tx.triggerEvent(TopicExecuteTxFailed, block, err)
+		block.recordExecutionError(tx.hash, err, result)
 	} else {
 		if fromAcc.Balance().Cmp(tx.value.Int) < 0 {
 			logging.VLog().WithFields(logrus.Fields{
@@ -437,6 +560,7 @@ func (tx *Transaction) VerifyExecution(block *Block) (*util.Uint128, error) {
 
 			metricsTxExeFailed.Mark(1)
 			tx.triggerEvent(TopicExecuteTxFailed, block, ErrInsufficientBalance)
+			block.recordExecutionError(tx.hash, ErrInsufficientBalance, "")
 		} else {
 			// accept the transaction
 			fromAcc.SubBalance(tx.value)
@@ -497,6 +621,15 @@ func (tx *Transaction) Sign(signature keystore.Signature) error {
 
 // VerifyIntegrity return transaction verify result, including Hash and Signature.
 func (tx *Transaction) VerifyIntegrity(chainID uint32) error {
+	if err := tx.verifyHash(chainID); err != nil {
+		return err
+	}
+
+	// check Signature.
+	return signVerifier.Verify(tx)
+}
+
+func (tx *Transaction) verifyHash(chainID uint32) error {
 	// check ChainID.
 	if tx.chainID != chainID {
 		return ErrInvalidChainID
@@ -510,12 +643,6 @@ func (tx *Transaction) VerifyIntegrity(chainID uint32) error {
 	if wantedHash.Equals(tx.hash) == false {
 		return ErrInvalidTransactionHash
 	}
-
-	// check Signature.
-	if err := tx.verifySign(); err != nil {
-		return err
-	}
-
 	return nil
 }
 
@@ -548,7 +675,12 @@ func (tx *Transaction) verifySign() error {
 
 // GenerateContractAddress according to tx.from and tx.nonce.
 func (tx *Transaction) GenerateContractAddress() (*Address, error) {
-	return NewContractAddressFromHash(hash.Sha3256(tx.from.Bytes(), byteutils.FromUint64(tx.nonce)))
+	return NewContractAddress(tx.from, tx.nonce)
+}
+
+// NewContractAddress returns the address of the contract deployed by the tx of from and nonce.
+func NewContractAddress(from *Address, nonce uint64) (*Address, error) {
+	return NewContractAddressFromHash(hash.Sha3256(from.Bytes(), byteutils.FromUint64(nonce)))
 }
 
 // HashTransaction hash the transaction.
@@ -569,7 +701,7 @@ func HashTransaction(tx *Transaction) (byteutils.Hash, error) {
 	if err != nil {
 		return nil, err
 	}
-	return hash.Sha3256(
+	fields := [][]byte{
 		tx.from.address,
 		tx.to.address,
 		value,
@@ -579,5 +711,10 @@ func HashTransaction(tx *Transaction) (byteutils.Hash, error) {
 		byteutils.FromUint32(tx.chainID),
 		gasPrice,
 		gasLimit,
-	), nil
+	}
+	// the txs without expiry keep their hashes.
+	if tx.validUntilHeight > 0 || tx.validUntil > 0 {
+		fields = append(fields, byteutils.FromUint64(tx.validUntilHeight), byteutils.FromInt64(tx.validUntil))
+	}
+	return hash.Sha3256(fields...), nil
 }
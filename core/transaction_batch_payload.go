@@ -0,0 +1,119 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// BatchMaxRecipients is the max count of recipients in a batch transfer.
+const BatchMaxRecipients = 100
+
+// BatchTransfer is the transfer to a recipient in batch.
+type BatchTransfer struct {
+	To    string
+	Value string
+}
+
+// BatchPayload transfers value from the tx sender to multiple recipients atomically
+type BatchPayload struct {
+	Transfers []*BatchTransfer
+}
+
+// LoadBatchPayload from bytes
+func LoadBatchPayload(bytes []byte) (*BatchPayload, error) {
+	payload := &BatchPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewBatchPayload with transfers
+func NewBatchPayload(transfers []*BatchTransfer) *BatchPayload {
+	return &BatchPayload{
+		Transfers: transfers,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *BatchPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count, proportional to the count of recipients
+func (payload *BatchPayload) BaseGasCount() *util.Uint128 {
+	count := util.NewUint128FromInt(int64(len(payload.Transfers)))
+	return util.NewUint128FromBigInt(util.NewUint128().Mul(BatchTransferGasCount.Int, count.Int))
+}
+
+// Execute the batch payload in tx, transfer value to each recipient
+func (payload *BatchPayload) Execute(ctx *PayloadContext) (*util.Uint128, string, error) {
+	if len(payload.Transfers) == 0 || len(payload.Transfers) > BatchMaxRecipients {
+		return ZeroGasCount, "", ErrInvalidBatchRecipients
+	}
+
+	tx := ctx.tx
+	recipients := make([]*Address, len(payload.Transfers))
+	values := make([]*util.Uint128, len(payload.Transfers))
+	total := new(big.Int)
+	for i, v := range payload.Transfers {
+		to, err := AddressParse(v.To)
+		if err != nil {
+			return ZeroGasCount, "", err
+		}
+		value, ok := new(big.Int).SetString(v.Value, 10)
+		if !ok || value.Sign() < 0 {
+			return ZeroGasCount, "", ErrInvalidBatchValue
+		}
+		recipients[i], values[i] = to, util.NewUint128FromBigInt(value)
+		total.Add(total, value)
+	}
+
+	// the gas and the tx value are reserved.
+	fromAcc := ctx.accState.GetOrCreateUserAccount(tx.from.address)
+	total.Add(total, tx.MinBalanceRequired().Int)
+	total.Add(total, tx.value.Int)
+	if fromAcc.Balance().Cmp(total) < 0 {
+		return ZeroGasCount, "", ErrInsufficientBalance
+	}
+
+	for i, to := range recipients {
+		if err := fromAcc.SubBalance(values[i]); err != nil {
+			return ZeroGasCount, "", err
+		}
+		ctx.accState.GetOrCreateUserAccount(to.address).AddBalance(values[i])
+	}
+
+	// record the event of each recipient after all transfers succeed.
+	for i, to := range recipients {
+		event := &Event{
+			Topic: TopicBatchTransfer,
+			Data:  fmt.Sprintf(`{"index":%d, "to":"%s", "value":"%s"}`, i, to.String(), values[i].String()),
+		}
+		if err := ctx.block.recordEvent(tx.hash, event); err != nil {
+			return ZeroGasCount, "", err
+		}
+	}
+	return ZeroGasCount, "", nil
+}
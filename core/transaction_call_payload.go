@@ -65,13 +65,20 @@ func (payload *CallPayload) Execute(context *PayloadContext) (*util.Uint128, str
 		return util.NewUint128(), "", err
 	}
 
-	engine := nvm.NewV8Engine(ctx)
+	engine, err := context.newEngine(ctx, deployPayload.SourceType)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
 	defer engine.Dispose()
+	engine.SetCancelContext(context.cancelCtx)
+	engine.SetTracer(context.tracer)
+	engine.SetLocalExecution(context.localExecution)
 
 	//add gas limit and memory use limit
 	engine.SetExecutionLimits(context.tx.PayloadGasLimit(payload).Uint64(), nvm.DefaultLimitsOfTotalMemorySize)
 
 	result, err := engine.Call(deployPayload.Source, deployPayload.SourceType, payload.Function, payload.Args)
+	context.gasRefund = engine.GasRefund()
 	return util.NewUint128FromInt(int64(engine.ExecutionInstructions())), result, err
 }
 
@@ -81,15 +88,17 @@ func generateCallContext(ctx *PayloadContext) (*nvm.Context, *DeployPayload, err
 	if err != nil {
 		return nil, nil, err
 	}
-	birthTx, err := ctx.block.GetTransaction(contract.BirthPlace())
-	if err != nil {
+	if paused, err := IsContractPaused(contract); err != nil || paused {
+		if err == nil {
+			err = ErrContractPaused
+		}
 		return nil, nil, err
 	}
-	owner := ctx.accState.GetOrCreateUserAccount(birthTx.from.Bytes())
-	deploy, err := LoadDeployPayload(birthTx.data.Payload)
+	birthTx, deploy, err := ctx.block.ContractCode(contract)
 	if err != nil {
 		return nil, nil, err
 	}
+	owner := ctx.accState.GetOrCreateUserAccount(birthTx.from.Bytes())
 
 	nvmctx := nvm.NewContext(ctx.block, convertNvmTx(ctx.tx), owner, contract, ctx.accState)
 	return nvmctx, deploy, nil
@@ -30,6 +30,9 @@ type DeployPayload struct {
 	SourceType string
 	Source     string
 	Args       string
+
+	// Upgradable allows the deployer to replace the code later, contracts are immutable by default.
+	Upgradable bool `json:",omitempty"`
 }
 
 // LoadDeployPayload from bytes
@@ -67,13 +70,20 @@ func (payload *DeployPayload) Execute(ctx *PayloadContext) (*util.Uint128, strin
 		return util.NewUint128(), "", err
 	}
 
-	engine := nvm.NewV8Engine(nvmctx)
+	engine, err := ctx.newEngine(nvmctx, payload.SourceType)
+	if err != nil {
+		return util.NewUint128(), "", err
+	}
 	defer engine.Dispose()
+	engine.SetCancelContext(ctx.cancelCtx)
+	engine.SetTracer(ctx.tracer)
+	engine.SetLocalExecution(ctx.localExecution)
 
 	engine.SetExecutionLimits(ctx.tx.PayloadGasLimit(payload).Uint64(), nvm.DefaultLimitsOfTotalMemorySize)
 
 	// Deploy and Init.
 	result, err := engine.DeployAndInit(payload.Source, payload.SourceType, payload.Args)
+	ctx.gasRefund = engine.GasRefund()
 	return util.NewUint128FromInt(int64(engine.ExecutionInstructions())), result, err
 }
 
@@ -82,6 +92,10 @@ func generateDeployContext(ctx *PayloadContext) (*nvm.Context, error) {
 	if err != nil {
 		return nil, err
 	}
+	// the contract deployed at the address must not be overwritten.
+	if acc, err := ctx.accState.GetContractAccount(addr.Bytes()); err == nil && len(acc.BirthPlace()) > 0 {
+		return nil, ErrContractAddressCollision
+	}
 	owner := ctx.accState.GetOrCreateUserAccount(ctx.tx.from.Bytes())
 	contract, err := ctx.accState.CreateContractAccount(addr.Bytes(), ctx.tx.Hash())
 	if err != nil {
@@ -0,0 +1,238 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// MultisigMaxSigners is the max count of signers of a multisig wallet.
+const MultisigMaxSigners = 16
+
+// MultisigSignature is the signature of a signer on the multisig payload hash.
+type MultisigSignature struct {
+	Signer string
+	Alg    uint8
+	Sign   []byte
+}
+
+// MultisigPayload transfers value from the M-of-N multisig wallet to the tx receiver,
+// the wallet address is derived from the threshold and the signers.
+type MultisigPayload struct {
+	Threshold  uint32
+	Signers    []string
+	Value      string
+	Signatures []*MultisigSignature
+}
+
+// LoadMultisigPayload from bytes
+func LoadMultisigPayload(bytes []byte) (*MultisigPayload, error) {
+	payload := &MultisigPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewMultisigPayload with threshold, signers & value, without signatures
+func NewMultisigPayload(threshold uint32, signers []string, value string) *MultisigPayload {
+	return &MultisigPayload{
+		Threshold: threshold,
+		Signers:   signers,
+		Value:     value,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *MultisigPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *MultisigPayload) BaseGasCount() *util.Uint128 {
+	return MultisigBaseGasCount
+}
+
+// signers parse and check the signers, the threshold must be in [1, N].
+func (payload *MultisigPayload) signers() ([]*Address, error) {
+	if len(payload.Signers) > MultisigMaxSigners {
+		return nil, ErrTooManyMultisigSigners
+	}
+	if payload.Threshold == 0 || int(payload.Threshold) > len(payload.Signers) {
+		return nil, ErrInvalidMultisigThreshold
+	}
+	addrs := make([]*Address, 0, len(payload.Signers))
+	seen := make(map[byteutils.HexHash]bool)
+	for _, v := range payload.Signers {
+		addr, err := AddressParse(v)
+		if err != nil {
+			return nil, err
+		}
+		if seen[addr.address.Hex()] {
+			return nil, ErrDuplicatedMultisigSigner
+		}
+		seen[addr.address.Hex()] = true
+		addrs = append(addrs, addr)
+	}
+	return addrs, nil
+}
+
+// Wallet returns the multisig wallet address, it doesn't depend on the order of signers.
+func (payload *MultisigPayload) Wallet() (*Address, error) {
+	addrs, err := payload.signers()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i].address, addrs[j].address) < 0
+	})
+	data := [][]byte{byteutils.FromUint32(payload.Threshold)}
+	for _, addr := range addrs {
+		data = append(data, addr.address)
+	}
+	return NewContractAddressFromHash(hash.Sha3256(data...))
+}
+
+// Hash returns the hash signed by the signers, the signatures are excluded.
+func (payload *MultisigPayload) Hash(chainID uint32, from, to *Address, nonce uint64) (byteutils.Hash, error) {
+	data, err := json.Marshal(NewMultisigPayload(payload.Threshold, payload.Signers, payload.Value))
+	if err != nil {
+		return nil, err
+	}
+	return hash.Sha3256(
+		byteutils.FromUint32(chainID),
+		from.address,
+		to.address,
+		byteutils.FromUint64(nonce),
+		data,
+	), nil
+}
+
+// AddSignature add the signature of signer, the previous one of the signer is replaced.
+func (payload *MultisigPayload) AddSignature(signer *Address, alg keystore.Algorithm, sign []byte) error {
+	if !payload.isSigner(signer) {
+		return ErrInvalidMultisigSigner
+	}
+	sig := &MultisigSignature{Signer: signer.String(), Alg: uint8(alg), Sign: sign}
+	for i, v := range payload.Signatures {
+		if v.Signer == sig.Signer {
+			payload.Signatures[i] = sig
+			return nil
+		}
+	}
+	payload.Signatures = append(payload.Signatures, sig)
+	return nil
+}
+
+func (payload *MultisigPayload) isSigner(addr *Address) bool {
+	for _, v := range payload.Signers {
+		if signer, err := AddressParse(v); err == nil && signer.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Verify check the signatures of distinct signers on hash reach the threshold.
+func (payload *MultisigPayload) Verify(hash byteutils.Hash) error {
+	if _, err := payload.signers(); err != nil {
+		return err
+	}
+	signed := make(map[byteutils.HexHash]bool)
+	for _, v := range payload.Signatures {
+		signer, err := AddressParse(v.Signer)
+		if err != nil {
+			return err
+		}
+		if !payload.isSigner(signer) || signed[signer.address.Hex()] {
+			return ErrInvalidMultisigSigner
+		}
+		if err := verifyMultisigSignature(signer, hash, v); err != nil {
+			return err
+		}
+		signed[signer.address.Hex()] = true
+	}
+	if len(signed) < int(payload.Threshold) {
+		return ErrMultisigSignaturesNotEnough
+	}
+	return nil
+}
+
+func verifyMultisigSignature(signer *Address, hash byteutils.Hash, sig *MultisigSignature) error {
+	signature, err := crypto.NewSignature(keystore.Algorithm(sig.Alg))
+	if err != nil {
+		return err
+	}
+	pub, err := signature.RecoverPublic(hash, sig.Sign)
+	if err != nil {
+		return err
+	}
+	pubdata, err := pub.Encoded()
+	if err != nil {
+		return err
+	}
+	addr, err := NewAddressFromPublicKey(pubdata)
+	if err != nil {
+		return err
+	}
+	if !signer.Equals(addr) {
+		return ErrInvalidMultisigSignature
+	}
+	return nil
+}
+
+// Execute the multisig payload in tx, transfer value from the wallet to the receiver
+func (payload *MultisigPayload) Execute(ctx *PayloadContext) (*util.Uint128, string, error) {
+	tx := ctx.tx
+	hash, err := payload.Hash(tx.chainID, tx.from, tx.to, tx.nonce)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if err := payload.Verify(hash); err != nil {
+		return ZeroGasCount, "", err
+	}
+	wallet, err := payload.Wallet()
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	value, ok := new(big.Int).SetString(payload.Value, 10)
+	if !ok || value.Sign() < 0 {
+		return ZeroGasCount, "", ErrInvalidMultisigValue
+	}
+	amount := util.NewUint128FromBigInt(value)
+
+	walletAcc := ctx.accState.GetOrCreateUserAccount(wallet.address)
+	if walletAcc.Balance().Cmp(amount.Int) < 0 {
+		return ZeroGasCount, "", ErrInsufficientBalance
+	}
+	if err := walletAcc.SubBalance(amount); err != nil {
+		return ZeroGasCount, "", err
+	}
+	ctx.accState.GetOrCreateUserAccount(tx.to.address).AddBalance(amount)
+	return ZeroGasCount, "", nil
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Actions of the pause payload.
+const (
+	PauseActionPause   = "pause"
+	PauseActionUnpause = "unpause"
+)
+
+// contractPausedKey is the key of the pause record in the variables of a contract, the value
+// is the hash of the vote tx paused the contract.
+var contractPausedKey = []byte("paused")
+
+// PausePayload is the vote of a validator in the current dynasty to pause or unpause the contract
+// at the tx receiver. The action takes effect once the votes of the current validators reach the
+// consensus size, the calls to a paused contract fail with ErrContractPaused.
+type PausePayload struct {
+	Action string
+}
+
+// LoadPausePayload from bytes
+func LoadPausePayload(bytes []byte) (*PausePayload, error) {
+	payload := &PausePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewPausePayload with the action
+func NewPausePayload(action string) *PausePayload {
+	return &PausePayload{Action: action}
+}
+
+// ToBytes serialize payload
+func (payload *PausePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *PausePayload) BaseGasCount() *util.Uint128 {
+	return PauseBaseGasCount
+}
+
+// Execute the pause payload in tx, record the vote and apply the action on consensus
+func (payload *PausePayload) Execute(ctx *PayloadContext) (*util.Uint128, string, error) {
+	tx := ctx.tx
+	if payload.Action != PauseActionPause && payload.Action != PauseActionUnpause {
+		return ZeroGasCount, "", ErrInvalidPauseAction
+	}
+
+	contract, err := ctx.accState.GetContractAccount(tx.to.address)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if len(contract.BirthPlace()) == 0 {
+		return ZeroGasCount, "", ErrContractNotFound
+	}
+	paused, err := IsContractPaused(contract)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if paused == (payload.Action == PauseActionPause) {
+		return ZeroGasCount, "", ErrContractPauseUnchanged
+	}
+
+	members, err := TraverseDynasty(ctx.dposContext.dynastyTrie)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	validators := make(map[byteutils.HexHash]bool)
+	for _, v := range members {
+		validators[v.Hex()] = true
+	}
+	if !validators[tx.from.address.Hex()] {
+		return ZeroGasCount, "", ErrNotDynastyValidator
+	}
+
+	prefix := pauseVotePrefix(payload.Action)
+	if err := contract.Put(append(prefix, tx.from.address...), tx.from.address); err != nil {
+		return ZeroGasCount, "", err
+	}
+	voters, err := pauseVoters(contract, prefix)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	votes := 0
+	for _, v := range voters {
+		if validators[v.Hex()] {
+			votes++
+		}
+	}
+
+	threshold := consensusSize(len(members))
+	if votes >= threshold {
+		// the votes are cleared once the action is taken, the next action needs new votes.
+		for _, v := range voters {
+			if err := contract.Del(append(pauseVotePrefix(payload.Action), v...)); err != nil {
+				return ZeroGasCount, "", err
+			}
+		}
+		if payload.Action == PauseActionPause {
+			err = contract.Put(contractPausedKey, tx.hash)
+		} else {
+			err = contract.Del(contractPausedKey)
+		}
+		if err != nil {
+			return ZeroGasCount, "", err
+		}
+		paused = !paused
+	}
+
+	event := &Event{
+		Topic: TopicContractPause,
+		Data: fmt.Sprintf(`{"contract":"%s", "validator":"%s", "action":"%s", "votes":%d, "threshold":%d, "paused":%t}`,
+			tx.to.String(), tx.from.String(), payload.Action, votes, threshold, paused),
+	}
+	if err := ctx.block.recordEvent(tx.hash, event); err != nil {
+		return ZeroGasCount, "", err
+	}
+	return ZeroGasCount, "", nil
+}
+
+// pauseVotePrefix is the key prefix of the votes for the action in the variables of a contract.
+func pauseVotePrefix(action string) []byte {
+	return []byte("pause_vote_" + action + "_")
+}
+
+// pauseVoters returns the validators voted for the action with the prefix.
+func pauseVoters(contract state.Account, prefix []byte) ([]byteutils.Hash, error) {
+	voters := []byteutils.Hash{}
+	iter, err := contract.Iterator(prefix)
+	if err == storage.ErrKeyNotFound {
+		return voters, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	exist, err := iter.Next()
+	for exist {
+		voters = append(voters, iter.Value())
+		exist, err = iter.Next()
+	}
+	return voters, err
+}
+
+// IsContractPaused returns if the contract is paused by the dynasty vote.
+func IsContractPaused(contract state.Account) (bool, error) {
+	_, err := contract.Get(contractPausedKey)
+	if err == storage.ErrKeyNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
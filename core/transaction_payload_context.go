@@ -18,7 +18,12 @@
 
 package core
 
-import "github.com/nebulasio/go-nebulas/core/state"
+import (
+	"context"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+)
 
 // PayloadContext transaction payload context
 type PayloadContext struct {
@@ -27,6 +32,15 @@ type PayloadContext struct {
 
 	accState    state.AccountState
 	dposContext *DposContext
+
+	cancelCtx context.Context
+	tracer    *nvm.Tracer
+
+	pooledEngine   bool
+	localExecution bool
+
+	// gas refunded for the storage deleted in nvm.
+	gasRefund uint64
 }
 
 // NewPayloadContext returns new payloadcontxt
@@ -40,6 +54,36 @@ func (ctx *PayloadContext) Block() *Block {
 	return ctx.block
 }
 
+// SetCancelContext set the context to cancel the execution of payload.
+func (ctx *PayloadContext) SetCancelContext(cancelCtx context.Context) {
+	ctx.cancelCtx = cancelCtx
+}
+
+// SetTracer set the tracer to record the execution of payload in nvm.
+func (ctx *PayloadContext) SetTracer(tracer *nvm.Tracer) {
+	ctx.tracer = tracer
+}
+
+// SetPooledEngine set whether the payload runs on a warm engine from the nvm pool,
+// only the local executions not affecting consensus may do it.
+func (ctx *PayloadContext) SetPooledEngine(pooled bool) {
+	ctx.pooledEngine = pooled
+}
+
+// SetLocalExecution set whether the payload is executed locally and never affects consensus,
+// the nvm limits configured by the node apply to the local executions only.
+func (ctx *PayloadContext) SetLocalExecution(local bool) {
+	ctx.localExecution = local
+}
+
+// newEngine returns the engine executing the contract of the source type.
+func (ctx *PayloadContext) newEngine(nvmctx *nvm.Context, sourceType string) (nvm.Engine, error) {
+	if ctx.pooledEngine {
+		return nvm.NewPooledEngine(nvmctx, sourceType)
+	}
+	return nvm.NewEngine(nvmctx, sourceType)
+}
+
 // Transaction returns ctx transaction
 func (ctx *PayloadContext) Transaction() *Transaction {
 	return ctx.tx
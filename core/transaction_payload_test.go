@@ -19,11 +19,15 @@
 package core
 
 import (
+	"math/big"
 	"testing"
 
+	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/crypto"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -447,3 +451,518 @@ func TestPayload_Execute(t *testing.T) {
 
 	block.accState.Commit()
 }
+
+func TestMultisigPayload(t *testing.T) {
+	var (
+		signers    []string
+		signatures []keystore.Signature
+	)
+	for i := 0; i < 3; i++ {
+		priv := secp256k1.GeneratePrivateKey()
+		pubdata, _ := priv.PublicKey().Encoded()
+		addr, _ := NewAddressFromPublicKey(pubdata)
+		signature, _ := crypto.NewSignature(keystore.SECP256K1)
+		signature.InitSign(priv)
+		signers = append(signers, addr.String())
+		signatures = append(signatures, signature)
+	}
+	sign := func(payload *MultisigPayload, i int, hash []byte) error {
+		signer, _ := AddressParse(signers[i])
+		sign, err := signatures[i].Sign(hash)
+		assert.Nil(t, err)
+		return payload.AddSignature(signer, signatures[i].Algorithm(), sign)
+	}
+
+	// the wallet doesn't depend on the order of signers.
+	payload := NewMultisigPayload(2, signers, "100")
+	wallet, err := payload.Wallet()
+	assert.Nil(t, err)
+	reordered, err := NewMultisigPayload(2, []string{signers[2], signers[0], signers[1]}, "100").Wallet()
+	assert.Nil(t, err)
+	assert.Equal(t, wallet, reordered)
+	other, err := NewMultisigPayload(3, signers, "100").Wallet()
+	assert.Nil(t, err)
+	assert.NotEqual(t, wallet, other)
+
+	_, err = NewMultisigPayload(0, signers, "100").Wallet()
+	assert.Equal(t, ErrInvalidMultisigThreshold, err)
+	_, err = NewMultisigPayload(4, signers, "100").Wallet()
+	assert.Equal(t, ErrInvalidMultisigThreshold, err)
+	_, err = NewMultisigPayload(1, []string{signers[0], signers[0]}, "100").Wallet()
+	assert.Equal(t, ErrDuplicatedMultisigSigner, err)
+
+	from, _ := AddressParse(signers[0])
+	to := &Address{[]byte("to")}
+	hash, err := payload.Hash(100, from, to, 1)
+	assert.Nil(t, err)
+
+	// the signatures are collected until the threshold.
+	assert.Nil(t, sign(payload, 0, hash))
+	assert.Equal(t, ErrMultisigSignaturesNotEnough, payload.Verify(hash))
+	assert.Nil(t, sign(payload, 0, hash))
+	assert.Equal(t, 1, len(payload.Signatures))
+	assert.Nil(t, sign(payload, 1, hash))
+	assert.Nil(t, payload.Verify(hash))
+
+	// the signatures are excluded from hash.
+	signed, err := payload.Hash(100, from, to, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, signed)
+
+	// the signatures on a different tx are invalid.
+	another, err := payload.Hash(100, from, to, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInvalidMultisigSignature, payload.Verify(another))
+
+	// only the signers are able to sign.
+	stranger := NewMultisigPayload(2, signers[1:], "100")
+	assert.Equal(t, ErrInvalidMultisigSigner, sign(stranger, 0, hash))
+
+	bytes, err := payload.ToBytes()
+	assert.Nil(t, err)
+	loaded, err := LoadMultisigPayload(bytes)
+	assert.Nil(t, err)
+	assert.Equal(t, payload, loaded)
+}
+
+func TestBatchPayload(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Params = &corepb.GenesisParams{BatchPayloadHeight: 1}
+	bc, _ := NewBlockChain(neb)
+	block := bc.tailBlock
+	block.accState.BeginBatch()
+	defer block.accState.RollBack()
+
+	to1, to2 := mockAddress(), mockAddress()
+	payload := NewBatchPayload([]*BatchTransfer{
+		{To: to1.String(), Value: "100"},
+		{To: to2.String(), Value: "200"},
+		{To: to1.String(), Value: "300"},
+	})
+	assert.Equal(t, util.NewUint128FromInt(60000), payload.BaseGasCount())
+
+	bytes, err := payload.ToBytes()
+	assert.Nil(t, err)
+	tx := mockTransaction(bc.chainID, 1, TxPayloadBatchType, bytes)
+	key, _ := keystore.DefaultKS.GetUnlocked(tx.from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+	assert.Nil(t, tx.Sign(signature))
+
+	loaded, err := tx.LoadPayload(block)
+	assert.Nil(t, err)
+	assert.Equal(t, payload, loaded)
+
+	execute := func(payload *BatchPayload) error {
+		ctx := NewPayloadContext(block, tx)
+		assert.Nil(t, ctx.BeginBatch())
+		_, _, err := payload.Execute(ctx)
+		if err == nil {
+			ctx.Commit()
+		}
+		return err
+	}
+
+	assert.Equal(t, ErrInvalidBatchRecipients, execute(NewBatchPayload(nil)))
+	assert.Equal(t, ErrInvalidBatchValue, execute(NewBatchPayload([]*BatchTransfer{{To: to1.String(), Value: "-1"}})))
+
+	// the gas is reserved besides the values.
+	assert.Equal(t, ErrInsufficientBalance, execute(payload))
+	balance := util.NewUint128FromBigInt(util.NewUint128().Add(tx.MinBalanceRequired().Int, big.NewInt(600)))
+	block.accState.GetOrCreateUserAccount(tx.from.address).AddBalance(balance)
+	assert.Nil(t, execute(payload))
+
+	assert.Equal(t, tx.MinBalanceRequired(), block.accState.GetOrCreateUserAccount(tx.from.address).Balance())
+	assert.Equal(t, util.NewUint128FromInt(400), block.accState.GetOrCreateUserAccount(to1.address).Balance())
+	assert.Equal(t, util.NewUint128FromInt(200), block.accState.GetOrCreateUserAccount(to2.address).Balance())
+	events, err := block.FetchEvents(tx.hash)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(events))
+}
+
+func TestDeployPayload_AddressCollision(t *testing.T) {
+	bc, err := NewBlockChain(testNeb())
+	assert.Nil(t, err)
+	block := bc.tailBlock
+	block.begin()
+	defer block.rollback()
+
+	tx := mockDeployTransaction(bc.chainID, 1)
+	addr, err := NewContractAddress(tx.from, tx.nonce)
+	assert.Nil(t, err)
+	generated, err := tx.GenerateContractAddress()
+	assert.Nil(t, err)
+	assert.Equal(t, addr, generated)
+
+	_, err = block.accState.CreateContractAccount(addr.Bytes(), mockNormalTransaction(bc.chainID, 1).Hash())
+	assert.Nil(t, err)
+
+	payload, err := tx.LoadPayload(block)
+	assert.Nil(t, err)
+	ctx := NewPayloadContext(block, tx)
+	assert.Nil(t, ctx.BeginBatch())
+	_, _, err = payload.Execute(ctx)
+	assert.Equal(t, ErrContractAddressCollision, err)
+}
+
+func TestTimelockPayload(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Params = &corepb.GenesisParams{TimelockPayloadHeight: 1}
+	bc, _ := NewBlockChain(neb)
+	block := bc.tailBlock
+	block.accState.BeginBatch()
+	defer block.accState.RollBack()
+	height := block.height
+	defer func() { block.height = height }()
+
+	signedTx := func(payload *TimelockPayload, nonce uint64) *Transaction {
+		bytes, err := payload.ToBytes()
+		assert.Nil(t, err)
+		tx := mockTransaction(bc.chainID, nonce, TxPayloadTimelockType, bytes)
+		key, _ := keystore.DefaultKS.GetUnlocked(tx.from.String())
+		signature, _ := crypto.NewSignature(keystore.SECP256K1)
+		signature.InitSign(key.(keystore.PrivateKey))
+		assert.Nil(t, tx.Sign(signature))
+
+		loaded, err := tx.LoadPayload(block)
+		assert.Nil(t, err)
+		assert.Equal(t, payload, loaded)
+		return tx
+	}
+	execute := func(tx *Transaction) (string, error) {
+		payload, err := tx.LoadPayload(block)
+		assert.Nil(t, err)
+		ctx := NewPayloadContext(block, tx)
+		assert.Nil(t, ctx.BeginBatch())
+		_, result, err := payload.Execute(ctx)
+		if err == nil {
+			ctx.Commit()
+		}
+		return result, err
+	}
+
+	_, err := execute(signedTx(NewTimelockPayload("100", 0, 0), 1))
+	assert.Equal(t, ErrInvalidTimelockCondition, err)
+	_, err = execute(signedTx(NewTimelockPayload("0", height+1, 0), 1))
+	assert.Equal(t, ErrInvalidTimelockValue, err)
+
+	lockTx := signedTx(NewTimelockPayload("100", height+1, 0), 1)
+	_, err = execute(lockTx)
+	assert.Equal(t, ErrInsufficientBalance, err)
+	balance := util.NewUint128FromBigInt(util.NewUint128().Add(lockTx.MinBalanceRequired().Int, big.NewInt(100)))
+	block.accState.GetOrCreateUserAccount(lockTx.from.address).AddBalance(balance)
+	result, err := execute(lockTx)
+	assert.Nil(t, err)
+
+	lock, err := TimelockAddress(lockTx.hash)
+	assert.Nil(t, err)
+	assert.Equal(t, lock.String(), result)
+	assert.Equal(t, lockTx.MinBalanceRequired(), block.accState.GetOrCreateUserAccount(lockTx.from.address).Balance())
+	assert.Equal(t, util.NewUint128FromInt(100), block.accState.GetOrCreateUserAccount(lock.address).Balance())
+
+	releaseTx := signedTx(NewTimelockReleasePayload(lock.String()), 1)
+	_, err = execute(releaseTx)
+	assert.Equal(t, ErrTimelockNotUnlocked, err)
+
+	block.height = height + 1
+	_, err = execute(releaseTx)
+	assert.Nil(t, err)
+	assert.Equal(t, util.NewUint128FromInt(100), block.accState.GetOrCreateUserAccount(lockTx.to.address).Balance())
+	assert.Equal(t, "0", block.accState.GetOrCreateUserAccount(lock.address).Balance().String())
+
+	_, err = execute(releaseTx)
+	assert.Equal(t, ErrTimelockReleased, err)
+	_, err = execute(signedTx(NewTimelockReleasePayload(mockAddress().String()), 1))
+	assert.Equal(t, ErrTimelockNotFound, err)
+}
+
+func TestSlashPayload(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Params = &corepb.GenesisParams{SlashPayloadHeight: 1}
+	bc, _ := NewBlockChain(neb)
+	block := bc.tailBlock
+	block.accState.BeginBatch()
+	defer block.accState.RollBack()
+
+	miner := mockAddress()
+	key, _ := keystore.DefaultKS.GetUnlocked(miner.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+	mintedBlock := func(timestamp int64, nonce uint64) *Block {
+		minted, err := bc.NewBlock(miner)
+		assert.Nil(t, err)
+		minted.SetTimestamp(timestamp)
+		minted.SetNonce(nonce)
+		minted.SetMiner(miner)
+		assert.Nil(t, minted.Seal())
+		assert.Nil(t, minted.Sign(signature))
+		return minted
+	}
+	execute := func(first, second *Block) error {
+		payload, err := NewSlashPayload(first, second)
+		assert.Nil(t, err)
+		bytes, err := payload.ToBytes()
+		assert.Nil(t, err)
+		tx := mockTransaction(bc.chainID, 1, TxPayloadSlashType, bytes)
+		loaded, err := tx.LoadPayload(block)
+		assert.Nil(t, err)
+		ctx := NewPayloadContext(block, tx)
+		assert.Nil(t, ctx.BeginBatch())
+		_, _, err = loaded.Execute(ctx)
+		if err == nil {
+			ctx.Commit()
+		}
+		return err
+	}
+
+	first := mintedBlock(block.Timestamp(), 1)
+	second := mintedBlock(block.Timestamp(), 2)
+	assert.Equal(t, ErrInvalidSlashEvidence, execute(first, first))
+	assert.Equal(t, ErrInvalidSlashEvidence, execute(first, mintedBlock(block.Timestamp()+DefaultBlockInterval, 2)))
+	assert.Equal(t, ErrInvalidSlashEvidence, execute(mintedBlock(block.Timestamp()+DefaultBlockInterval, 1), mintedBlock(block.Timestamp()+DefaultBlockInterval, 2)))
+
+	// the evidence must recompute the signed hash.
+	tampered := mintedBlock(block.Timestamp(), 3)
+	tampered.header.timestamp = first.Timestamp() + DefaultBlockInterval
+	assert.Equal(t, ErrInvalidSlashEvidence, execute(first, tampered))
+
+	assert.Nil(t, execute(first, second))
+	assert.Equal(t, ErrMinerAlreadySlashed, execute(second, first))
+
+	// the slashed miner is kicked out of candidates in the dynasty transition.
+	dc, err := GenesisDynastyContext(bc, neb.Genesis())
+	assert.Nil(t, err)
+	dc.Accounts = block.accState
+	_, err = dc.CandidateTrie.Put(miner.Bytes(), miner.Bytes())
+	assert.Nil(t, err)
+	assert.Nil(t, dc.kickoutSlashed())
+	_, err = dc.CandidateTrie.Get(miner.Bytes())
+	assert.NotNil(t, err)
+}
+
+func mockContract(t *testing.T, block *Block, payload *DeployPayload) (*Transaction, *Address) {
+	bytes, err := payload.ToBytes()
+	assert.Nil(t, err)
+	deployTx := mockTransaction(block.header.chainID, 1, TxPayloadDeployType, bytes)
+	deployTx.hash, err = HashTransaction(deployTx)
+	assert.Nil(t, err)
+	assert.Nil(t, block.acceptTransaction(deployTx))
+	contract, err := deployTx.GenerateContractAddress()
+	assert.Nil(t, err)
+	_, err = block.accState.CreateContractAccount(contract.Bytes(), deployTx.hash)
+	assert.Nil(t, err)
+	return deployTx, contract
+}
+
+func executePayload(t *testing.T, block *Block, from, contract *Address, payloadType string, payload []byte) (*Transaction, error) {
+	tx := NewTransaction(block.header.chainID, from, contract, util.NewUint128(), 1, payloadType, payload, TransactionGasPrice, TransactionMaxGas)
+	var err error
+	tx.hash, err = HashTransaction(tx)
+	assert.Nil(t, err)
+	assert.Nil(t, block.acceptTransaction(tx))
+	p, err := tx.LoadPayload(block)
+	assert.Nil(t, err)
+	ctx := NewPayloadContext(block, tx)
+	assert.Nil(t, ctx.BeginBatch())
+	_, _, err = p.Execute(ctx)
+	if err == nil {
+		ctx.Commit()
+	}
+	return tx, err
+}
+
+func TestUpgradePayload(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Params = &corepb.GenesisParams{UpgradePayloadHeight: 1}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	block := bc.tailBlock
+	block.begin()
+	defer block.rollback()
+
+	deploy := func(upgradable bool) *Address {
+		payload := NewDeployPayload("function C(){}; module.exports = C;", "js", "")
+		payload.Upgradable = upgradable
+		_, contract := mockContract(t, block, payload)
+		return contract
+	}
+	upgrade := func(from, contract *Address, source string) (*Transaction, error) {
+		bytes, err := NewUpgradePayload(source, "js").ToBytes()
+		assert.Nil(t, err)
+		return executePayload(t, block, from, contract, TxPayloadUpgradeType, bytes)
+	}
+
+	immutable := deploy(false)
+	acc, err := block.accState.GetContractAccount(immutable.Bytes())
+	assert.Nil(t, err)
+	birthTx, err := block.GetTransaction(acc.BirthPlace())
+	assert.Nil(t, err)
+	_, err = upgrade(birthTx.from, immutable, "function D(){}; module.exports = D;")
+	assert.Equal(t, ErrContractNotUpgradable, err)
+
+	upgradable := deploy(true)
+	acc, err = block.accState.GetContractAccount(upgradable.Bytes())
+	assert.Nil(t, err)
+	birthTx, err = block.GetTransaction(acc.BirthPlace())
+	assert.Nil(t, err)
+	_, err = upgrade(mockAddress(), upgradable, "function D(){}; module.exports = D;")
+	assert.Equal(t, ErrUpgradeNotAuthorized, err)
+	_, err = upgrade(birthTx.from, upgradable, "")
+	assert.Equal(t, ErrInvalidUpgradeSource, err)
+
+	upgradeTx, err := upgrade(birthTx.from, upgradable, "function D(){}; module.exports = D;")
+	assert.Nil(t, err)
+	hash, err := ContractUpgradeTx(acc)
+	assert.Nil(t, err)
+	assert.Equal(t, upgradeTx.hash, hash)
+	_, code, err := block.ContractCode(acc)
+	assert.Nil(t, err)
+	assert.Equal(t, "function D(){}; module.exports = D;", code.Source)
+
+	events, err := block.FetchEvents(upgradeTx.hash)
+	assert.Nil(t, err)
+	assert.Equal(t, TopicUpgradeSmartContract, events[0].Topic)
+}
+
+func TestVerifyPayload(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Params = &corepb.GenesisParams{UpgradePayloadHeight: 1, VerifyPayloadHeight: 1}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	block := bc.tailBlock
+	block.begin()
+	defer block.rollback()
+
+	source := "function C(){}; module.exports = C;"
+	payload := NewDeployPayload(source, "js", "")
+	payload.Upgradable = true
+	deployTx, contract := mockContract(t, block, payload)
+
+	verify := func(source, compiler string) (*Transaction, error) {
+		bytes, err := NewVerifyPayload(source, "js", compiler, "").ToBytes()
+		assert.Nil(t, err)
+		return executePayload(t, block, deployTx.from, contract, TxPayloadVerifyType, bytes)
+	}
+	verifyTx := func() byteutils.Hash {
+		acc, err := block.accState.GetContractAccount(contract.Bytes())
+		assert.Nil(t, err)
+		hash, err := ContractVerifyTx(acc)
+		assert.Nil(t, err)
+		return hash
+	}
+
+	_, err = verify("", "")
+	assert.Equal(t, ErrInvalidVerifySource, err)
+	_, err = verify(source, "solc")
+	assert.Equal(t, ErrUnsupportedVerifyCompiler, err)
+	_, err = verify(source, VerifyCompilerTypeScript)
+	assert.Equal(t, ErrUnsupportedVerifyCompiler, err)
+	_, err = verify("function D(){}; module.exports = D;", "")
+	assert.Equal(t, ErrContractSourceMismatch, err)
+	assert.Nil(t, verifyTx())
+
+	tx, err := verify(source, "")
+	assert.Nil(t, err)
+	assert.Equal(t, tx.hash, verifyTx())
+	events, err := block.FetchEvents(tx.hash)
+	assert.Nil(t, err)
+	assert.Equal(t, TopicVerifySmartContract, events[0].Topic)
+
+	// the verification is void once the contract is upgraded.
+	bytes, err := NewUpgradePayload("function D(){}; module.exports = D;", "js").ToBytes()
+	assert.Nil(t, err)
+	_, err = executePayload(t, block, deployTx.from, contract, TxPayloadUpgradeType, bytes)
+	assert.Nil(t, err)
+	assert.Nil(t, verifyTx())
+}
+
+func TestPausePayload(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Params = &corepb.GenesisParams{PausePayloadHeight: 1}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	block := bc.tailBlock
+	block.begin()
+	defer block.rollback()
+
+	deployTx, contract := mockContract(t, block, NewDeployPayload("function C(){}; C.prototype = {f: function(){}}; module.exports = C;", "js", ""))
+
+	vote := func(from *Address, action string) error {
+		bytes, err := NewPausePayload(action).ToBytes()
+		assert.Nil(t, err)
+		_, err = executePayload(t, block, from, contract, TxPayloadPauseType, bytes)
+		return err
+	}
+	paused := func() bool {
+		acc, err := block.accState.GetContractAccount(contract.Bytes())
+		assert.Nil(t, err)
+		paused, err := IsContractPaused(acc)
+		assert.Nil(t, err)
+		return paused
+	}
+
+	members, err := block.Dynasty()
+	assert.Nil(t, err)
+	validators := make([]*Address, len(members))
+	for i, v := range members {
+		validators[i], err = AddressParseFromBytes(v)
+		assert.Nil(t, err)
+	}
+	threshold := consensusSize(len(validators))
+
+	assert.Equal(t, ErrInvalidPauseAction, vote(validators[0], "stop"))
+	assert.Equal(t, ErrContractPauseUnchanged, vote(validators[0], PauseActionUnpause))
+	assert.Equal(t, ErrNotDynastyValidator, vote(mockAddress(), PauseActionPause))
+
+	// the repeated votes of a validator count once.
+	assert.Nil(t, vote(validators[0], PauseActionPause))
+	assert.Nil(t, vote(validators[0], PauseActionPause))
+	for i := 1; i < threshold-1; i++ {
+		assert.Nil(t, vote(validators[i], PauseActionPause))
+	}
+	assert.False(t, paused())
+	assert.Nil(t, vote(validators[threshold-1], PauseActionPause))
+	assert.True(t, paused())
+
+	call, err := NewCallPayload("f", "").ToBytes()
+	assert.Nil(t, err)
+	_, err = executePayload(t, block, deployTx.from, contract, TxPayloadCallType, call)
+	assert.Equal(t, ErrContractPaused, err)
+
+	for i := 0; i < threshold; i++ {
+		assert.Nil(t, vote(validators[i], PauseActionUnpause))
+	}
+	assert.False(t, paused())
+}
+
+func TestLoadPayload_ActivationHeight(t *testing.T) {
+	neb := testNeb()
+	neb.genesis.Params = &corepb.GenesisParams{BatchPayloadHeight: 3}
+	bc, err := NewBlockChain(neb)
+	assert.Nil(t, err)
+	block := bc.tailBlock
+	height := block.height
+	defer func() { block.height = height }()
+
+	bytes, err := NewBatchPayload([]*BatchTransfer{{To: mockAddress().String(), Value: "100"}}).ToBytes()
+	assert.Nil(t, err)
+	batchTx := mockTransaction(bc.chainID, 1, TxPayloadBatchType, bytes)
+	bytes, err = NewPausePayload(PauseActionPause).ToBytes()
+	assert.Nil(t, err)
+	pauseTx := mockTransaction(bc.chainID, 1, TxPayloadPauseType, bytes)
+
+	block.height = 2
+	_, err = batchTx.LoadPayload(block)
+	assert.Equal(t, ErrInvalidTxPayloadType, err)
+	block.height = 3
+	_, err = batchTx.LoadPayload(block)
+	assert.Nil(t, err)
+
+	// the payload type without activation height is never accepted.
+	_, err = pauseTx.LoadPayload(block)
+	assert.Equal(t, ErrInvalidTxPayloadType, err)
+
+	// the payload types of the launch are accepted since genesis.
+	_, err = mockDeployTransaction(bc.chainID, 1).LoadPayload(block)
+	assert.Nil(t, err)
+}
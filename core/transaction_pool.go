@@ -19,6 +19,9 @@
 package core
 
 import (
+	"fmt"
+	"math/big"
+	"sort"
 	"sync"
 	"time"
 
@@ -27,6 +30,7 @@ import (
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/tracing"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
@@ -38,20 +42,62 @@ type TransactionPool struct {
 	receivedMessageCh chan net.Message
 	quitCh            chan int
 
-	size  int
-	cache *pdeque.PriorityDeque
-	all   map[byteutils.HexHash]*Transaction
-	bc    *BlockChain
+	size    int
+	cache   *pdeque.PriorityDeque
+	all     map[byteutils.HexHash]*Transaction
+	prior   map[byteutils.HexHash]bool
+	local   map[byteutils.HexHash]bool // txs submitted to this node, retained and re-broadcast.
+	pending map[nonceKey]*Transaction
+	future  map[byteutils.HexHash]map[uint64]*Transaction // txs waiting for the nonce gap to fill, by sender.
+	counts  map[byteutils.HexHash]int                     // count of txs by sender.
+	arrival map[byteutils.HexHash]time.Time               // the time txs are pushed into pool.
+	bc      *BlockChain
 
 	nm p2p.Manager
 	mu sync.RWMutex
 
-	gasPrice *util.Uint128 // the lowest gasPrice.
-	gasLimit *util.Uint128 // the maximum gasLimit.
+	gasPrice  *util.Uint128 // the lowest gasPrice.
+	gasLimit  *util.Uint128 // the maximum gasLimit.
+	priceBump uint32        // the min gasPrice bump in percent to replace a tx.
+
+	futureLimit  int           // the max count of future txs of each account.
+	accountLimit int           // the max count of txs of each account, 0 means unlimited.
+	maxAge       time.Duration // the max duration txs stay in pool, 0 means no expiry.
 
 	eventEmitter *EventEmitter
 }
 
+// const
+const (
+	// DefaultPriceBump is the default min gasPrice bump in percent to replace a pending tx.
+	DefaultPriceBump = 10
+
+	// DefaultFutureLimit is the default max count of future txs of each account.
+	DefaultFutureLimit = 64
+
+	// DefaultTxPoolSize is the default max count of txs in pool.
+	DefaultTxPoolSize = 40960
+
+	// LocalRebroadcastInterval is the interval to re-announce the local pending txs.
+	LocalRebroadcastInterval = time.Minute
+)
+
+// reasons of evicting txs from pool.
+const (
+	EvictReasonFull    = "full"
+	EvictReasonExpired = "expired"
+)
+
+// nonceKey is the key of the pending tx of an account with the nonce.
+type nonceKey struct {
+	from  byteutils.HexHash
+	nonce uint64
+}
+
+func newNonceKey(tx *Transaction) nonceKey {
+	return nonceKey{from: tx.from.address.Hex(), nonce: tx.nonce}
+}
+
 func less(a interface{}, b interface{}) bool {
 	txa := a.(*Transaction)
 	txb := b.(*Transaction)
@@ -72,14 +118,37 @@ func NewTransactionPool(size int) (*TransactionPool, error) {
 		receivedMessageCh: make(chan net.Message, size),
 		quitCh:            make(chan int, 1),
 		size:              size,
-		cache:             pdeque.NewPriorityDeque(less),
 		all:               make(map[byteutils.HexHash]*Transaction),
+		prior:             make(map[byteutils.HexHash]bool),
+		local:             make(map[byteutils.HexHash]bool),
+		pending:           make(map[nonceKey]*Transaction),
+		future:            make(map[byteutils.HexHash]map[uint64]*Transaction),
+		counts:            make(map[byteutils.HexHash]int),
+		arrival:           make(map[byteutils.HexHash]time.Time),
 		gasPrice:          TransactionGasPrice,
 		gasLimit:          TransactionMaxGas,
+		priceBump:         DefaultPriceBump,
+		futureLimit:       DefaultFutureLimit,
 	}
+	txPool.cache = pdeque.NewPriorityDeque(txPool.priorLess)
 	return txPool, nil
 }
 
+// priorLess pops the prioritized txs before the others, except the txs from same account.
+func (pool *TransactionPool) priorLess(a interface{}, b interface{}) bool {
+	if len(pool.prior) > 0 {
+		txa := a.(*Transaction)
+		txb := b.(*Transaction)
+		if !txa.from.Equals(txb.from) {
+			pa, pb := pool.prior[txa.hash.Hex()], pool.prior[txb.hash.Hex()]
+			if pa != pb {
+				return pa
+			}
+		}
+	}
+	return less(a, b)
+}
+
 // SetGasConfig config the lowest gasPrice and the maximum gasLimit.
 func (pool *TransactionPool) SetGasConfig(gasPrice, gasLimit *util.Uint128) {
 	if gasPrice == nil || gasPrice.Cmp(util.NewUint128().Int) <= 0 {
@@ -94,6 +163,32 @@ func (pool *TransactionPool) SetGasConfig(gasPrice, gasLimit *util.Uint128) {
 	}
 }
 
+// SetPriceBump config the min gasPrice bump in percent to replace a pending tx.
+func (pool *TransactionPool) SetPriceBump(percent uint32) {
+	if percent == 0 {
+		percent = DefaultPriceBump
+	}
+	pool.priceBump = percent
+}
+
+// SetFutureLimit config the max count of future txs of each account.
+func (pool *TransactionPool) SetFutureLimit(limit uint32) {
+	if limit == 0 {
+		limit = DefaultFutureLimit
+	}
+	pool.futureLimit = int(limit)
+}
+
+// SetAccountLimit config the max count of txs of each account, 0 means unlimited.
+func (pool *TransactionPool) SetAccountLimit(limit uint32) {
+	pool.accountLimit = int(limit)
+}
+
+// SetMaxAge config the max duration txs stay in pool, 0 means no expiry.
+func (pool *TransactionPool) SetMaxAge(age time.Duration) {
+	pool.maxAge = age
+}
+
 // RegisterInNetwork register message subscriber in network.
 func (pool *TransactionPool) RegisterInNetwork(nm p2p.Manager) {
 	nm.Register(net.NewSubscriber(pool, pool.receivedMessageCh, MessageTypeNewTx))
@@ -132,10 +227,15 @@ func (pool *TransactionPool) loop() {
 	}).Info("Started TransactionPool.")
 
 	timerChan := time.NewTicker(time.Second).C
+	rebroadcastChan := time.NewTicker(LocalRebroadcastInterval).C
 	for {
 		select {
 		case <-timerChan:
 			metricsCachedTx.Update(int64(len(pool.receivedMessageCh)))
+			pool.promoteFutures()
+			pool.expire(time.Now())
+		case <-rebroadcastChan:
+			pool.rebroadcastLocals()
 		case <-pool.quitCh:
 			logging.CLog().WithFields(logrus.Fields{
 				"size": pool.size,
@@ -195,6 +295,41 @@ func (pool *TransactionPool) Push(tx *Transaction) error {
 	return pool.push(tx)
 }
 
+// PushWithPriority push tx into pool, it's popped before the txs without priority
+func (pool *TransactionPool) PushWithPriority(tx *Transaction) error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if _, ok := pool.all[tx.hash.Hex()]; ok {
+		metricsDuplicateTx.Inc(1)
+		return ErrDuplicatedTransaction
+	}
+	pool.prior[tx.hash.Hex()] = true
+	if err := pool.push(tx); err != nil {
+		delete(pool.prior, tx.hash.Hex())
+		return err
+	}
+	return nil
+}
+
+// PushLocal push the tx submitted to this node into pool, it's exempted from eviction
+// and re-announced periodically while pending.
+func (pool *TransactionPool) PushLocal(tx *Transaction) error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if _, ok := pool.all[tx.hash.Hex()]; ok {
+		metricsDuplicateTx.Inc(1)
+		return ErrDuplicatedTransaction
+	}
+	pool.local[tx.hash.Hex()] = true
+	if err := pool.push(tx); err != nil {
+		delete(pool.local, tx.hash.Hex())
+		return err
+	}
+	return nil
+}
+
 // PushAndRelay push tx into pool and relay it
 func (pool *TransactionPool) PushAndRelay(tx *Transaction) error {
 	if err := pool.Push(tx); err != nil {
@@ -205,21 +340,155 @@ func (pool *TransactionPool) PushAndRelay(tx *Transaction) error {
 	return nil
 }
 
-// PushAndBroadcast push tx into pool and broadcast it
+// PushAndBroadcast push the local tx into pool and broadcast it
 func (pool *TransactionPool) PushAndBroadcast(tx *Transaction) error {
-	if err := pool.Push(tx); err != nil {
+	span := tracing.StartBoundSpan(tx.hash, "txpool.push")
+	if err := pool.PushLocal(tx); err != nil {
+		span.SetError(err)
+		span.End()
 		logging.VLog().WithFields(logrus.Fields{
 			"tx":  tx,
 			"err": err,
 		}).Debug("Failed to push a new tx into tx pool")
 		return err
 	}
+	span.End()
 
+	span = tracing.StartBoundSpan(tx.hash, "net.broadcast")
 	pool.nm.Broadcast(MessageTypeNewTx, tx, net.MessagePriorityNormal)
+	span.End()
 	return nil
 }
 
+// PushBatch push the txs into pool atomically, no tx is pushed if any of them is invalid.
+// The nonces of the txs from the same sender must be sequential. It returns the error of
+// each tx, and ErrTransactionBatchRejected if the batch is not pushed.
+func (pool *TransactionPool) PushBatch(txs []*Transaction) ([]error, error) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return pool.pushBatch(txs, false)
+}
+
+// PushBatchAndBroadcast push the local txs into pool atomically and broadcast them
+func (pool *TransactionPool) PushBatchAndBroadcast(txs []*Transaction) ([]error, error) {
+	pool.mu.Lock()
+	errs, err := pool.pushBatch(txs, true)
+	pool.mu.Unlock()
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"count": len(txs),
+			"err":   err,
+		}).Debug("Failed to push a batch of txs into tx pool")
+		return errs, err
+	}
+
+	for _, tx := range txs {
+		pool.nm.Broadcast(MessageTypeNewTx, tx, net.MessagePriorityNormal)
+	}
+	return errs, nil
+}
+
+func (pool *TransactionPool) pushBatch(txs []*Transaction, local bool) ([]error, error) {
+
+	if len(txs) > pool.size {
+		return nil, ErrTransactionBatchTooLarge
+	}
+
+	errs := make([]error, len(txs))
+	batch := make(map[byteutils.HexHash]bool)
+	senders := make(map[byteutils.HexHash][]int)
+	for i, tx := range txs {
+		if batch[tx.hash.Hex()] {
+			metricsDuplicateTx.Inc(1)
+			errs[i] = ErrDuplicatedTransaction
+			continue
+		}
+		batch[tx.hash.Hex()] = true
+		if errs[i] = pool.verify(tx); errs[i] != nil {
+			continue
+		}
+		from := tx.from.address.Hex()
+		senders[from] = append(senders[from], i)
+	}
+
+	tail := pool.bc.TailBlock()
+	for from, indexes := range senders {
+		if pool.accountLimit > 0 && pool.counts[from]+len(indexes) > pool.accountLimit {
+			for _, index := range indexes {
+				errs[index] = ErrAccountTransactionsFull
+			}
+			continue
+		}
+		sort.Slice(indexes, func(i, j int) bool {
+			return txs[indexes[i]].Nonce() < txs[indexes[j]].Nonce()
+		})
+		nonce := tail.GetNonce(txs[indexes[0]].from.address)
+		for i, index := range indexes {
+			tx := txs[index]
+			if i == 0 && tx.Nonce() <= nonce {
+				errs[index] = ErrSmallTransactionNonce
+			} else if i > 0 && tx.Nonce() != nonce+1 {
+				errs[index] = ErrNonSequentialTransactionNonce
+			}
+			nonce = tx.Nonce()
+		}
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return errs, ErrTransactionBatchRejected
+		}
+	}
+	for _, tx := range txs {
+		if local {
+			pool.local[tx.hash.Hex()] = true
+		}
+		pool.insert(tx)
+	}
+	return errs, nil
+}
+
 func (pool *TransactionPool) push(tx *Transaction) error {
+	if err := pool.verify(tx); err != nil {
+		return err
+	}
+	if pool.sameNonce(tx) == nil {
+		if pool.accountLimit > 0 && pool.counts[tx.from.address.Hex()] >= pool.accountLimit {
+			metricsTxPoolAccountFull.Inc(1)
+			return ErrAccountTransactionsFull
+		}
+		if pool.isFuture(tx) {
+			if err := pool.makeFutureRoom(tx); err != nil {
+				return err
+			}
+		}
+	}
+	pool.insert(tx)
+	return nil
+}
+
+// makeFutureRoom drops the future tx with the highest nonce for tx if the future txs of
+// the account are full, the tx closer to the nonce gap is preferred.
+func (pool *TransactionPool) makeFutureRoom(tx *Transaction) error {
+	txs := pool.future[tx.from.address.Hex()]
+	if len(txs) < pool.futureLimit {
+		return nil
+	}
+	var highest *Transaction
+	for _, v := range txs {
+		if highest == nil || v.nonce > highest.nonce {
+			highest = v
+		}
+	}
+	if tx.nonce > highest.nonce {
+		metricsTxPoolFutureFull.Inc(1)
+		return ErrFutureTransactionsFull
+	}
+	pool.remove(highest)
+	return nil
+}
+
+func (pool *TransactionPool) verify(tx *Transaction) error {
 	// verify non-dup tx
 	if _, ok := pool.all[tx.hash.Hex()]; ok {
 		metricsDuplicateTx.Inc(1)
@@ -236,19 +505,83 @@ func (pool *TransactionPool) push(tx *Transaction) error {
 		return ErrOutOfGasLimit
 	}
 
+	// the tx can't be included in the next block.
+	if tx.Expired(pool.bc.TailBlock().Height()+1, time.Now().Unix()) {
+		return ErrTransactionExpired
+	}
+
 	// verify hash & sign of tx
 	if err := tx.VerifyIntegrity(pool.bc.chainID); err != nil {
 		metricsInvalidTx.Inc(1)
 		return err
 	}
 
-	// cache the verified tx
-	pool.cache.Insert(tx)
+	// the tx with same from and nonce can only be replaced by a higher gasPrice
+	if old := pool.sameNonce(tx); old != nil && !pool.replaceable(old, tx) {
+		metricsTxPoolReplaceUnderpriced.Inc(1)
+		return ErrReplaceUnderpriced
+	}
+	return nil
+}
+
+// replaceable return if the gasPrice of tx is at least priceBump percent higher than old.
+func (pool *TransactionPool) replaceable(old, tx *Transaction) bool {
+	threshold := new(big.Int).Mul(old.gasPrice.Int, big.NewInt(int64(100+pool.priceBump)))
+	threshold.Div(threshold, big.NewInt(100))
+	return tx.gasPrice.Cmp(threshold) >= 0
+}
+
+// sameNonce return the pending or future tx with the same from and nonce of tx.
+func (pool *TransactionPool) sameNonce(tx *Transaction) *Transaction {
+	if old, ok := pool.pending[newNonceKey(tx)]; ok {
+		return old
+	}
+	return pool.future[tx.from.address.Hex()][tx.nonce]
+}
+
+// NextNonce return the nonce of the next tx sent from the account.
+func (pool *TransactionPool) NextNonce(from *Address) uint64 {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return pool.nextNonce(from)
+}
+
+// nextNonce return the nonce following the account nonce and the sequential pending txs.
+func (pool *TransactionPool) nextNonce(from *Address) uint64 {
+	nonce := pool.bc.TailBlock().GetNonce(from.address) + 1
+	for {
+		if _, ok := pool.pending[nonceKey{from: from.address.Hex(), nonce: nonce}]; !ok {
+			return nonce
+		}
+		nonce++
+	}
+}
+
+// isFuture return if there is a nonce gap before tx.
+func (pool *TransactionPool) isFuture(tx *Transaction) bool {
+	return tx.nonce > pool.nextNonce(tx.from)
+}
+
+func (pool *TransactionPool) insert(tx *Transaction) {
+	// replace the tx with same from and nonce
+	old := pool.sameNonce(tx)
+	if old != nil {
+		pool.cache.Delete(old)
+		pool.remove(old)
+	}
+
 	pool.all[tx.hash.Hex()] = tx
-	// delete tx with lowest priority if cache is full
-	if pool.cache.Len() > pool.size {
-		tx := pool.cache.PopMax().(*Transaction)
-		delete(pool.all, tx.hash.Hex())
+	pool.arrival[tx.hash.Hex()] = time.Now()
+	from := tx.from.address.Hex()
+	pool.counts[from]++
+	if pool.isFuture(tx) {
+		if pool.future[from] == nil {
+			pool.future[from] = make(map[uint64]*Transaction)
+		}
+		pool.future[from][tx.nonce] = tx
+	} else {
+		pool.enqueue(tx)
+		pool.promote(from, tx.nonce+1)
 	}
 
 	// trigger pending transaction
@@ -258,7 +591,152 @@ func (pool *TransactionPool) push(tx *Transaction) error {
 	}
 	pool.eventEmitter.Trigger(event)
 
-	return nil
+	// evict txs if pool is full
+	for len(pool.all) > pool.size {
+		pool.evict(pool.evictee(), EvictReasonFull)
+	}
+
+	if old != nil {
+		metricsTxPoolReplaced.Inc(1)
+		pool.eventEmitter.Trigger(&Event{
+			Topic: TopicReplaceTransaction,
+			Data:  fmt.Sprintf(`{"hash":"%s", "replacement":%s}`, old.hash.String(), tx.String()),
+		})
+	}
+}
+
+// enqueue the tx into cache to be popped.
+func (pool *TransactionPool) enqueue(tx *Transaction) {
+	pool.cache.Insert(tx)
+	pool.pending[newNonceKey(tx)] = tx
+}
+
+// evictee return the tx to be evicted when pool is full, the remote one with lowest gasPrice,
+// and the oldest one if same gasPrice. The local txs are evicted only if no remote one left.
+func (pool *TransactionPool) evictee() *Transaction {
+	var evictee *Transaction
+	for hash, tx := range pool.all {
+		if evictee == nil {
+			evictee = tx
+			continue
+		}
+		if local, evicteeLocal := pool.local[hash], pool.local[evictee.hash.Hex()]; local != evicteeLocal {
+			if !local {
+				evictee = tx
+			}
+			continue
+		}
+		if cmp := tx.gasPrice.Cmp(evictee.gasPrice.Int); cmp < 0 || (cmp == 0 && pool.arrival[hash].Before(pool.arrival[evictee.hash.Hex()])) {
+			evictee = tx
+		}
+	}
+	return evictee
+}
+
+// evict the tx from pool and trigger the evict event.
+func (pool *TransactionPool) evict(tx *Transaction, reason string) {
+	pool.cache.Delete(tx)
+	pool.remove(tx)
+
+	metricsTxPoolEvicted.Inc(1)
+	pool.eventEmitter.Trigger(&Event{
+		Topic: TopicEvictTransaction,
+		Data:  fmt.Sprintf(`{"reason":"%s", "transaction":%s}`, reason, tx.String()),
+	})
+}
+
+// expire evicts the txs which can't be included in the next block any more, and the
+// remote txs staying in pool longer than maxAge.
+func (pool *TransactionPool) expire(now time.Time) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	height := pool.bc.TailBlock().Height() + 1
+	for hash, t := range pool.arrival {
+		tx := pool.all[hash]
+		if tx.Expired(height, now.Unix()) || (pool.maxAge > 0 && !pool.local[hash] && now.Sub(t) > pool.maxAge) {
+			pool.evict(tx, EvictReasonExpired)
+		}
+	}
+}
+
+// rebroadcastLocals re-announce the local pending txs to the network.
+func (pool *TransactionPool) rebroadcastLocals() {
+	pool.mu.RLock()
+	var txs []*Transaction
+	for hash := range pool.local {
+		tx := pool.all[hash]
+		if pool.pending[newNonceKey(tx)] == tx {
+			txs = append(txs, tx)
+		}
+	}
+	pool.mu.RUnlock()
+
+	for _, tx := range txs {
+		pool.nm.Broadcast(MessageTypeNewTx, tx, net.MessagePriorityNormal)
+	}
+	metricsTxPoolRebroadcast.Inc(int64(len(txs)))
+}
+
+// promote the sequential future txs of the account from nonce.
+func (pool *TransactionPool) promote(from byteutils.HexHash, nonce uint64) {
+	txs := pool.future[from]
+	for {
+		tx, ok := txs[nonce]
+		if !ok {
+			break
+		}
+		delete(txs, nonce)
+		pool.enqueue(tx)
+		nonce++
+	}
+	if len(txs) == 0 {
+		delete(pool.future, from)
+	}
+}
+
+// promoteFutures promote the future txs whose nonce gap is filled by the txs on chain,
+// and drop the ones whose nonce is used.
+func (pool *TransactionPool) promoteFutures() {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	tail := pool.bc.TailBlock()
+	for from, txs := range pool.future {
+		var addr *Address
+		for nonce, tx := range txs {
+			addr = tx.from
+			if nonce <= tail.GetNonce(addr.address) {
+				pool.remove(tx)
+			}
+		}
+		pool.promote(from, pool.nextNonce(addr))
+	}
+}
+
+// remove the tx popped from cache, or the future tx.
+func (pool *TransactionPool) remove(tx *Transaction) {
+	if _, ok := pool.all[tx.hash.Hex()]; !ok {
+		return
+	}
+	delete(pool.all, tx.hash.Hex())
+	delete(pool.prior, tx.hash.Hex())
+	delete(pool.local, tx.hash.Hex())
+	delete(pool.arrival, tx.hash.Hex())
+	if from := tx.from.address.Hex(); pool.counts[from] > 1 {
+		pool.counts[from]--
+	} else {
+		delete(pool.counts, from)
+	}
+	if key := newNonceKey(tx); pool.pending[key] == tx {
+		delete(pool.pending, key)
+	}
+	if txs := pool.future[tx.from.address.Hex()]; txs[tx.nonce] == tx {
+		delete(txs, tx.nonce)
+		if len(txs) == 0 {
+			delete(pool.future, tx.from.address.Hex())
+		}
+	}
 }
 
 // Pop a transaction from pool
@@ -271,12 +749,31 @@ func (pool *TransactionPool) Pop() *Transaction {
 func (pool *TransactionPool) pop() *Transaction {
 	if pool.cache.Len() > 0 {
 		tx := pool.cache.PopMin().(*Transaction)
-		delete(pool.all, tx.hash.Hex())
+		pool.remove(tx)
 		return tx
 	}
 	return nil
 }
 
+// GetTransaction return the tx in pool by hash, nil if not found.
+func (pool *TransactionPool) GetTransaction(hash byteutils.Hash) *Transaction {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+	return pool.all[hash.Hex()]
+}
+
+// Accepting return if the pool is able to handle the received transactions.
+func (pool *TransactionPool) Accepting() bool {
+	return len(pool.receivedMessageCh) < cap(pool.receivedMessageCh)
+}
+
+// Len return the count of txs ready to be popped in pool, the future txs are excluded
+func (pool *TransactionPool) Len() int {
+	pool.mu.RLock()
+	defer pool.mu.RUnlock()
+	return pool.cache.Len()
+}
+
 // Empty return if the pool is empty
 func (pool *TransactionPool) Empty() bool {
 	pool.mu.Lock()
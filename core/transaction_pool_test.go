@@ -81,38 +81,79 @@ func TestTransactionPool(t *testing.T) {
 	// put tx with different chainID, should fail
 	assert.Nil(t, txs[4].Sign(signature1))
 	assert.NotNil(t, txPool.Push(txs[4]))
-	// put one new, replace txs[1]
+	// txs[0] is queued in future for the nonce gap
 	assert.Equal(t, len(txPool.all), 3)
-	assert.Equal(t, txPool.cache.Len(), 3)
+	assert.Equal(t, txPool.cache.Len(), 2)
+	// put one with same from and nonce and higher gas price, replace txs[2]
 	assert.Nil(t, txs[6].Sign(signature1))
 	assert.Nil(t, txPool.Push(txs[6]))
-	assert.Equal(t, txPool.cache.Len(), 3)
-	assert.Equal(t, len(txPool.all), 3)
-	// get from: other, nonce: 1, data: "da"
-	tx1 := txPool.Pop()
-	assert.Equal(t, txs[2].from.address, tx1.from.address)
-	assert.Equal(t, txs[2].nonce, tx1.nonce)
-	assert.Equal(t, txs[2].data, tx1.data)
-	// put one new
-	assert.Equal(t, len(txPool.all), 2)
 	assert.Equal(t, txPool.cache.Len(), 2)
+	assert.Equal(t, len(txPool.all), 3)
+	assert.Nil(t, txPool.GetTransaction(txs[2].hash))
+	// put the replaced one again, should fail
+	assert.Equal(t, ErrReplaceUnderpriced, txPool.Push(txs[2]))
+	// put one with same from and nonce as txs[1] and lower gas price, should fail
 	assert.Nil(t, txs[5].Sign(signature2))
-	assert.Nil(t, txPool.Push(txs[5]))
+	assert.Equal(t, ErrReplaceUnderpriced, txPool.Push(txs[5]))
 	assert.Equal(t, len(txPool.all), 3)
-	assert.Equal(t, txPool.cache.Len(), 3)
-	// get 2 txs, txs[5], txs[0]
-	tx21 := txPool.Pop()
-	tx22 := txPool.Pop()
-	assert.Equal(t, txs[5].from.address, tx21.from.address)
-	assert.Equal(t, txs[5].Nonce(), tx21.Nonce())
-	assert.Equal(t, txs[5].data, tx21.data)
-	assert.Equal(t, txs[6].from.address, tx22.from.address)
-	assert.Equal(t, txs[6].Nonce(), tx22.Nonce())
-	assert.Equal(t, txs[6].data, tx22.data)
-	assert.Equal(t, txPool.Empty(), false)
-	txPool.Pop()
+	assert.Equal(t, txPool.cache.Len(), 2)
+	// get 2 txs, txs[1], txs[6]
+	popped := map[string]bool{
+		txPool.Pop().Hash().String(): true,
+		txPool.Pop().Hash().String(): true,
+	}
+	assert.True(t, popped[txs[1].Hash().String()])
+	assert.True(t, popped[txs[6].Hash().String()])
 	assert.Equal(t, txPool.Empty(), true)
 	assert.Nil(t, txPool.Pop())
+	assert.Equal(t, txs[0], txPool.GetTransaction(txs[0].hash))
+}
+
+func TestTransactionPool_Future(t *testing.T) {
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	txPool, _ := NewTransactionPool(10)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+	txPool.SetFutureLimit(2)
+
+	newTx := func(nonce uint64) *Transaction {
+		tx := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		return tx
+	}
+
+	// txs with nonce gap are queued.
+	assert.Nil(t, txPool.Push(newTx(3)))
+	assert.Nil(t, txPool.Push(newTx(4)))
+	assert.Equal(t, ErrFutureTransactionsFull, txPool.Push(newTx(5)))
+	assert.Equal(t, 0, txPool.Len())
+	assert.Equal(t, 2, len(txPool.all))
+
+	// the tx closer to the gap drops the queued one with highest nonce.
+	tx4 := txPool.future[from.address.Hex()][4]
+	assert.Nil(t, txPool.Push(newTx(2)))
+	assert.Nil(t, txPool.GetTransaction(tx4.hash))
+	assert.Equal(t, 0, txPool.Len())
+
+	// the queued txs are promoted once the gap is filled.
+	assert.Nil(t, txPool.Push(newTx(1)))
+	assert.Equal(t, 3, txPool.Len())
+	assert.Equal(t, 0, len(txPool.future))
+	assert.Nil(t, txPool.Push(tx4))
+	assert.Equal(t, 4, txPool.Len())
+	for nonce := uint64(1); nonce <= 4; nonce++ {
+		assert.Equal(t, nonce, txPool.Pop().Nonce())
+	}
 }
 
 func TestGasConfig(t *testing.T) {
@@ -157,3 +198,246 @@ func TestPushTxs(t *testing.T) {
 	assert.Equal(t, txPool.push(txs[0]), ErrBelowGasPrice)
 	assert.Equal(t, txPool.push(txs[1]), ErrOutOfGasLimit)
 }
+
+func TestPushWithPriority(t *testing.T) {
+	ks := keystore.DefaultKS
+	priv1 := secp256k1.GeneratePrivateKey()
+	pubdata1, _ := priv1.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata1)
+	ks.SetKey(from.String(), priv1, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key1, _ := ks.GetUnlocked(from.String())
+	signature1, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature1.InitSign(key1.(keystore.PrivateKey))
+
+	priv2 := secp256k1.GeneratePrivateKey()
+	pubdata2, _ := priv2.PublicKey().Encoded()
+	other, _ := NewAddressFromPublicKey(pubdata2)
+	ks.SetKey(other.String(), priv2, []byte("passphrase"))
+	ks.Unlock(other.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key2, _ := ks.GetUnlocked(other.String())
+	signature2, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature2.InitSign(key2.(keystore.PrivateKey))
+
+	heighPrice := util.NewUint128FromBigInt(util.NewUint128().Mul(TransactionGasPrice.Int, util.NewUint128FromInt(2).Int))
+	txPool, _ := NewTransactionPool(3)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+
+	txs := []*Transaction{
+		NewTransaction(bc.ChainID(), other, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000)),
+		NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), 1, TxPayloadBinaryType, []byte("data"), heighPrice, util.NewUint128FromInt(200000)),
+	}
+	assert.Nil(t, txs[0].Sign(signature2))
+	assert.Nil(t, txs[1].Sign(signature1))
+
+	assert.Nil(t, txPool.Push(txs[0]))
+	assert.Nil(t, txPool.PushWithPriority(txs[1]))
+	// put dup tx, should fail
+	assert.Equal(t, ErrDuplicatedTransaction, txPool.PushWithPriority(txs[0]))
+	assert.Equal(t, 1, len(txPool.prior))
+
+	// the prioritized tx is popped first.
+	assert.Equal(t, txs[1].Hash(), txPool.Pop().Hash())
+	assert.Equal(t, 0, len(txPool.prior))
+	assert.Equal(t, txs[0].Hash(), txPool.Pop().Hash())
+}
+
+func TestPushBatch(t *testing.T) {
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	txPool, _ := NewTransactionPool(3)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+
+	newTx := func(nonce uint64) *Transaction {
+		tx := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		return tx
+	}
+
+	// batch larger than pool is rejected.
+	_, err := txPool.PushBatch([]*Transaction{newTx(1), newTx(2), newTx(3), newTx(4)})
+	assert.Equal(t, ErrTransactionBatchTooLarge, err)
+
+	// gap in nonces, nothing is pushed.
+	errs, err := txPool.PushBatch([]*Transaction{newTx(1), newTx(3)})
+	assert.Equal(t, ErrTransactionBatchRejected, err)
+	assert.Nil(t, errs[0])
+	assert.Equal(t, ErrNonSequentialTransactionNonce, errs[1])
+	assert.Equal(t, 0, len(txPool.all))
+
+	// nonce already used on chain.
+	errs, err = txPool.PushBatch([]*Transaction{newTx(0), newTx(1)})
+	assert.Equal(t, ErrTransactionBatchRejected, err)
+	assert.Equal(t, ErrSmallTransactionNonce, errs[0])
+	assert.Equal(t, 0, len(txPool.all))
+
+	// duplicated tx in batch.
+	tx := newTx(1)
+	errs, err = txPool.PushBatch([]*Transaction{tx, tx})
+	assert.Equal(t, ErrTransactionBatchRejected, err)
+	assert.Equal(t, ErrDuplicatedTransaction, errs[1])
+
+	// unordered but sequential nonces are accepted.
+	errs, err = txPool.PushBatch([]*Transaction{newTx(2), newTx(1), newTx(3)})
+	assert.Nil(t, err)
+	assert.Equal(t, []error{nil, nil, nil}, errs)
+	assert.Equal(t, 3, len(txPool.all))
+	assert.Equal(t, uint64(1), txPool.Pop().Nonce())
+}
+
+func TestTransactionPool_Evict(t *testing.T) {
+	ks := keystore.DefaultKS
+	newSigner := func() (*Address, keystore.Signature) {
+		priv := secp256k1.GeneratePrivateKey()
+		pubdata, _ := priv.PublicKey().Encoded()
+		addr, _ := NewAddressFromPublicKey(pubdata)
+		ks.SetKey(addr.String(), priv, []byte("passphrase"))
+		ks.Unlock(addr.String(), []byte("passphrase"), time.Second*60*60*24*365)
+		key, _ := ks.GetUnlocked(addr.String())
+		signature, _ := crypto.NewSignature(keystore.SECP256K1)
+		signature.InitSign(key.(keystore.PrivateKey))
+		return addr, signature
+	}
+
+	txPool, _ := NewTransactionPool(2)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+	txPool.SetAccountLimit(1)
+	txPool.SetMaxAge(time.Minute)
+
+	newTx := func(from *Address, signature keystore.Signature, nonce uint64, price int64) *Transaction {
+		tx := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("data"), util.NewUint128FromInt(price), util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		return tx
+	}
+	price := TransactionGasPrice.Int64()
+
+	// the tx with lowest gasPrice is evicted when full.
+	from1, signature1 := newSigner()
+	from2, signature2 := newSigner()
+	from3, signature3 := newSigner()
+	low, high := newTx(from1, signature1, 1, price), newTx(from2, signature2, 1, price*3)
+	assert.Nil(t, txPool.Push(low))
+	assert.Nil(t, txPool.Push(high))
+	assert.Nil(t, txPool.Push(newTx(from3, signature3, 1, price*2)))
+	assert.Equal(t, 2, len(txPool.all))
+	assert.Equal(t, 2, txPool.Len())
+	assert.Nil(t, txPool.GetTransaction(low.hash))
+
+	// the count of txs of each account is limited.
+	assert.Equal(t, ErrAccountTransactionsFull, txPool.Push(newTx(from3, signature3, 2, price*2)))
+
+	// the txs staying longer than max age are expired.
+	txPool.expire(time.Now())
+	assert.Equal(t, 2, txPool.Len())
+	txPool.expire(time.Now().Add(2 * time.Minute))
+	assert.Equal(t, 0, txPool.Len())
+	assert.Equal(t, 0, len(txPool.all))
+	assert.Equal(t, 0, len(txPool.counts))
+}
+
+func TestTransactionPool_Local(t *testing.T) {
+	ks := keystore.DefaultKS
+	newSigner := func() (*Address, keystore.Signature) {
+		priv := secp256k1.GeneratePrivateKey()
+		pubdata, _ := priv.PublicKey().Encoded()
+		addr, _ := NewAddressFromPublicKey(pubdata)
+		ks.SetKey(addr.String(), priv, []byte("passphrase"))
+		ks.Unlock(addr.String(), []byte("passphrase"), time.Second*60*60*24*365)
+		key, _ := ks.GetUnlocked(addr.String())
+		signature, _ := crypto.NewSignature(keystore.SECP256K1)
+		signature.InitSign(key.(keystore.PrivateKey))
+		return addr, signature
+	}
+
+	txPool, _ := NewTransactionPool(2)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+	txPool.SetMaxAge(time.Minute)
+
+	newTx := func(from *Address, signature keystore.Signature, nonce uint64, price int64) *Transaction {
+		tx := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("data"), util.NewUint128FromInt(price), util.NewUint128FromInt(200000))
+		assert.Nil(t, tx.Sign(signature))
+		return tx
+	}
+	price := TransactionGasPrice.Int64()
+
+	// the local tx is not evicted even if its gasPrice is the lowest.
+	from1, signature1 := newSigner()
+	from2, signature2 := newSigner()
+	from3, signature3 := newSigner()
+	local, mid := newTx(from1, signature1, 1, price), newTx(from2, signature2, 1, price*2)
+	assert.Nil(t, txPool.PushLocal(local))
+	assert.Equal(t, ErrDuplicatedTransaction, txPool.PushLocal(local))
+	assert.Nil(t, txPool.Push(mid))
+	assert.Nil(t, txPool.Push(newTx(from3, signature3, 1, price*3)))
+	assert.Equal(t, 2, len(txPool.all))
+	assert.NotNil(t, txPool.GetTransaction(local.hash))
+	assert.Nil(t, txPool.GetTransaction(mid.hash))
+
+	// the local tx doesn't expire.
+	txPool.expire(time.Now().Add(2 * time.Minute))
+	assert.Equal(t, 1, txPool.Len())
+	assert.NotNil(t, txPool.GetTransaction(local.hash))
+
+	// the tag is cleared when the tx is popped.
+	assert.Equal(t, local, txPool.Pop())
+	assert.Equal(t, 0, len(txPool.local))
+}
+
+func TestTransactionPool_ValidUntil(t *testing.T) {
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	txPool, _ := NewTransactionPool(16)
+	bc, _ := NewBlockChain(testNeb())
+	txPool.setBlockChain(bc)
+	txPool.setEventEmitter(bc.eventEmitter)
+
+	newTx := func(nonce uint64, height uint64, timestamp int64) *Transaction {
+		tx := NewTransaction(bc.ChainID(), from, &Address{[]byte("to")}, util.NewUint128(), nonce, TxPayloadBinaryType, []byte("data"), TransactionGasPrice, util.NewUint128FromInt(200000))
+		tx.SetValidUntil(height, timestamp)
+		assert.Nil(t, tx.Sign(signature))
+		return tx
+	}
+
+	// the tx can't be included in the next block.
+	tail := bc.TailBlock()
+	assert.Equal(t, ErrTransactionExpired, txPool.Push(newTx(1, tail.Height(), 0)))
+	assert.Equal(t, ErrTransactionExpired, txPool.Push(newTx(1, 0, time.Now().Unix()-1)))
+
+	// the expiry is covered by the hash.
+	tx := newTx(1, tail.Height()+1, 0)
+	tx.SetValidUntil(tail.Height()+2, 0)
+	assert.Equal(t, ErrInvalidTransactionHash, txPool.Push(tx))
+
+	assert.Nil(t, txPool.Push(newTx(1, tail.Height()+1, 0)))
+	assert.Nil(t, txPool.Push(newTx(2, 0, time.Now().Unix()+30)))
+	assert.Equal(t, 2, len(txPool.all))
+
+	// the expired txs are evicted.
+	txPool.expire(time.Now().Add(time.Minute))
+	assert.Equal(t, 1, len(txPool.all))
+	assert.True(t, txPool.Pop().Expired(tail.Height()+2, 0))
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// SlashEvidence is a block header signed by the miner with the hashes of the txs in block,
+// which are enough to recompute the signed block hash.
+type SlashEvidence struct {
+	Header   []byte
+	TxHashes [][]byte
+}
+
+// SlashPayload carries the evidences of two different blocks signed by a miner for the same slot,
+// the miner is recorded in the slash account and kicked out of the candidates in the next
+// dynasty transition.
+type SlashPayload struct {
+	Evidences []*SlashEvidence
+}
+
+// LoadSlashPayload from bytes
+func LoadSlashPayload(bytes []byte) (*SlashPayload, error) {
+	payload := &SlashPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewSlashPayload with the blocks minted in the same slot.
+func NewSlashPayload(first, second *Block) (*SlashPayload, error) {
+	payload := &SlashPayload{}
+	for _, block := range []*Block{first, second} {
+		evidence, err := newSlashEvidence(block)
+		if err != nil {
+			return nil, err
+		}
+		payload.Evidences = append(payload.Evidences, evidence)
+	}
+	return payload, nil
+}
+
+func newSlashEvidence(block *Block) (*SlashEvidence, error) {
+	header, txHashes, err := block.EncodeHeader()
+	if err != nil {
+		return nil, err
+	}
+	return &SlashEvidence{Header: header, TxHashes: txHashes}, nil
+}
+
+// ToBytes serialize payload
+func (payload *SlashPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *SlashPayload) BaseGasCount() *util.Uint128 {
+	return SlashBaseGasCount
+}
+
+// SlashAddress returns the account recording the slashed miners.
+func SlashAddress() (*Address, error) {
+	return NewContractAddressFromHash(hash.Sha3256([]byte(TxPayloadSlashType)))
+}
+
+// Execute the slash payload in tx
+func (payload *SlashPayload) Execute(ctx *PayloadContext) (*util.Uint128, string, error) {
+	if len(payload.Evidences) != 2 {
+		return ZeroGasCount, "", ErrInvalidSlashEvidence
+	}
+	first, miner, err := payload.Evidences[0].verify(ctx.block.ChainID())
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	second, other, err := payload.Evidences[1].verify(ctx.block.ChainID())
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if first.timestamp != second.timestamp || first.timestamp > ctx.block.Timestamp() ||
+		first.hash.Equals(second.hash) || !miner.Equals(other) {
+		return ZeroGasCount, "", ErrInvalidSlashEvidence
+	}
+
+	slash, err := SlashAddress()
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	slashAcc := ctx.accState.GetOrCreateUserAccount(slash.address)
+	_, err = slashAcc.Get(miner.address)
+	if err == nil {
+		return ZeroGasCount, "", ErrMinerAlreadySlashed
+	}
+	if err != storage.ErrKeyNotFound {
+		return ZeroGasCount, "", err
+	}
+	if err := slashAcc.Put(miner.address, miner.address); err != nil {
+		return ZeroGasCount, "", err
+	}
+
+	event := &Event{
+		Topic: TopicSlash,
+		Data:  fmt.Sprintf(`{"miner":"%s", "slot":%d}`, miner.String(), first.timestamp),
+	}
+	if err := ctx.block.recordEvent(ctx.tx.hash, event); err != nil {
+		return ZeroGasCount, "", err
+	}
+	return ZeroGasCount, "", nil
+}
+
+// verify returns the header and the miner signed it.
+func (evidence *SlashEvidence) verify(chainID uint32) (*BlockHeader, *Address, error) {
+	block, err := DecodeHeader(evidence.Header, evidence.TxHashes)
+	if err != nil || block.ChainID() != chainID {
+		return nil, nil, ErrInvalidSlashEvidence
+	}
+	miner, err := RecoverMiner(block)
+	if err != nil {
+		return nil, nil, ErrInvalidSlashEvidence
+	}
+	return block.header, miner, nil
+}
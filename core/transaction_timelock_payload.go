@@ -0,0 +1,209 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// Timelock Action
+const (
+	TimelockLockAction    = "lock"
+	TimelockReleaseAction = "release"
+)
+
+// keys of the timelock record in the variables of the lock account.
+var (
+	timelockBeneficiaryKey  = []byte("beneficiary")
+	timelockUnlockHeightKey = []byte("unlock_height")
+	timelockUnlockTimeKey   = []byte("unlock_time")
+)
+
+// TimelockPayload locks value from the tx sender in a lock account until the unlock height
+// and timestamp are reached, then the value is released to the tx receiver by a release
+// action sent by anyone. The lock account address is derived from the lock tx hash.
+type TimelockPayload struct {
+	Action string
+
+	// lock action.
+	Value        string
+	UnlockHeight uint64
+	UnlockTime   int64
+
+	// release action, the lock account address.
+	Lock string
+}
+
+// LoadTimelockPayload from bytes
+func LoadTimelockPayload(bytes []byte) (*TimelockPayload, error) {
+	payload := &TimelockPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewTimelockPayload locks value until the unlock height and time, 0 means no condition.
+func NewTimelockPayload(value string, unlockHeight uint64, unlockTime int64) *TimelockPayload {
+	return &TimelockPayload{
+		Action:       TimelockLockAction,
+		Value:        value,
+		UnlockHeight: unlockHeight,
+		UnlockTime:   unlockTime,
+	}
+}
+
+// NewTimelockReleasePayload releases the value in the lock account.
+func NewTimelockReleasePayload(lock string) *TimelockPayload {
+	return &TimelockPayload{
+		Action: TimelockReleaseAction,
+		Lock:   lock,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *TimelockPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *TimelockPayload) BaseGasCount() *util.Uint128 {
+	return TimelockBaseGasCount
+}
+
+// TimelockAddress returns the lock account address created by the lock tx.
+func TimelockAddress(txHash byteutils.Hash) (*Address, error) {
+	return NewContractAddressFromHash(hash.Sha3256([]byte(TxPayloadTimelockType), txHash))
+}
+
+// Execute the timelock payload in tx
+func (payload *TimelockPayload) Execute(ctx *PayloadContext) (*util.Uint128, string, error) {
+	switch payload.Action {
+	case TimelockLockAction:
+		return payload.lock(ctx)
+	case TimelockReleaseAction:
+		return payload.release(ctx)
+	}
+	return ZeroGasCount, "", ErrInvalidTimelockAction
+}
+
+func (payload *TimelockPayload) lock(ctx *PayloadContext) (*util.Uint128, string, error) {
+	tx := ctx.tx
+	if payload.UnlockHeight == 0 && payload.UnlockTime <= 0 {
+		return ZeroGasCount, "", ErrInvalidTimelockCondition
+	}
+	value, ok := new(big.Int).SetString(payload.Value, 10)
+	if !ok || value.Sign() <= 0 {
+		return ZeroGasCount, "", ErrInvalidTimelockValue
+	}
+	amount := util.NewUint128FromBigInt(value)
+
+	// the gas and the tx value are reserved.
+	fromAcc := ctx.accState.GetOrCreateUserAccount(tx.from.address)
+	total := new(big.Int).Add(value, tx.MinBalanceRequired().Int)
+	total.Add(total, tx.value.Int)
+	if fromAcc.Balance().Cmp(total) < 0 {
+		return ZeroGasCount, "", ErrInsufficientBalance
+	}
+
+	lock, err := TimelockAddress(tx.hash)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	lockAcc := ctx.accState.GetOrCreateUserAccount(lock.address)
+	if err := lockAcc.Put(timelockBeneficiaryKey, tx.to.address); err != nil {
+		return ZeroGasCount, "", err
+	}
+	if err := lockAcc.Put(timelockUnlockHeightKey, byteutils.FromUint64(payload.UnlockHeight)); err != nil {
+		return ZeroGasCount, "", err
+	}
+	if err := lockAcc.Put(timelockUnlockTimeKey, byteutils.FromInt64(payload.UnlockTime)); err != nil {
+		return ZeroGasCount, "", err
+	}
+	if err := fromAcc.SubBalance(amount); err != nil {
+		return ZeroGasCount, "", err
+	}
+	lockAcc.AddBalance(amount)
+
+	event := &Event{
+		Topic: TopicTimelock,
+		Data: fmt.Sprintf(`{"action":"%s", "lock":"%s", "beneficiary":"%s", "value":"%s", "unlock_height":%d, "unlock_time":%d}`,
+			TimelockLockAction, lock.String(), tx.to.String(), amount.String(), payload.UnlockHeight, payload.UnlockTime),
+	}
+	if err := ctx.block.recordEvent(tx.hash, event); err != nil {
+		return ZeroGasCount, "", err
+	}
+	return ZeroGasCount, lock.String(), nil
+}
+
+func (payload *TimelockPayload) release(ctx *PayloadContext) (*util.Uint128, string, error) {
+	tx := ctx.tx
+	lock, err := AddressParse(payload.Lock)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	lockAcc, err := ctx.accState.GetContractAccount(lock.address)
+	if err != nil {
+		return ZeroGasCount, "", ErrTimelockNotFound
+	}
+	beneficiary, err := lockAcc.Get(timelockBeneficiaryKey)
+	if err == storage.ErrKeyNotFound {
+		return ZeroGasCount, "", ErrTimelockNotFound
+	}
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	unlockHeight, err := lockAcc.Get(timelockUnlockHeightKey)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	unlockTime, err := lockAcc.Get(timelockUnlockTimeKey)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if ctx.block.Height() < byteutils.Uint64(unlockHeight) || ctx.block.Timestamp() < byteutils.Int64(unlockTime) {
+		return ZeroGasCount, "", ErrTimelockNotUnlocked
+	}
+
+	amount := lockAcc.Balance()
+	if amount.Cmp(util.NewUint128().Int) == 0 {
+		return ZeroGasCount, "", ErrTimelockReleased
+	}
+	if err := lockAcc.SubBalance(amount); err != nil {
+		return ZeroGasCount, "", err
+	}
+	ctx.accState.GetOrCreateUserAccount(beneficiary).AddBalance(amount)
+
+	event := &Event{
+		Topic: TopicTimelock,
+		Data: fmt.Sprintf(`{"action":"%s", "lock":"%s", "beneficiary":"%s", "value":"%s"}`,
+			TimelockReleaseAction, lock.String(), byteutils.Hash(beneficiary).String(), amount.String()),
+	}
+	if err := ctx.block.recordEvent(tx.hash, event); err != nil {
+		return ZeroGasCount, "", err
+	}
+	return ZeroGasCount, "", nil
+}
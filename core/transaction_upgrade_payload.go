@@ -0,0 +1,149 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// contractCodeKey is the key of the latest upgrade tx hash in the variables of a contract.
+// Contract storage keys are always hashed, so the short raw key never collides with them.
+var contractCodeKey = []byte("code")
+
+// UpgradePayload replaces the code of the contract at the tx receiver, the storage of the
+// contract is preserved. Only the deployer of a contract deployed as upgradable can upgrade it.
+type UpgradePayload struct {
+	SourceType string
+	Source     string
+}
+
+// LoadUpgradePayload from bytes
+func LoadUpgradePayload(bytes []byte) (*UpgradePayload, error) {
+	payload := &UpgradePayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewUpgradePayload with the new source
+func NewUpgradePayload(source, sourceType string) *UpgradePayload {
+	return &UpgradePayload{
+		Source:     source,
+		SourceType: sourceType,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *UpgradePayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *UpgradePayload) BaseGasCount() *util.Uint128 {
+	return UpgradeBaseGasCount
+}
+
+// Execute the upgrade payload in tx, replace the code of the contract
+func (payload *UpgradePayload) Execute(ctx *PayloadContext) (*util.Uint128, string, error) {
+	tx := ctx.tx
+	if len(payload.Source) == 0 {
+		return ZeroGasCount, "", ErrInvalidUpgradeSource
+	}
+	if !nvm.IsSupportedSourceType(payload.SourceType) {
+		return ZeroGasCount, "", nvm.ErrUnsupportedSourceType
+	}
+
+	contract, err := ctx.accState.GetContractAccount(tx.to.address)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if len(contract.BirthPlace()) == 0 {
+		return ZeroGasCount, "", ErrContractNotFound
+	}
+	birthTx, deploy, err := ctx.block.ContractCode(contract)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if !birthTx.from.Equals(tx.from) {
+		return ZeroGasCount, "", ErrUpgradeNotAuthorized
+	}
+	if !deploy.Upgradable {
+		return ZeroGasCount, "", ErrContractNotUpgradable
+	}
+
+	if err := contract.Put(contractCodeKey, tx.hash); err != nil {
+		return ZeroGasCount, "", err
+	}
+
+	event := &Event{
+		Topic: TopicUpgradeSmartContract,
+		Data: fmt.Sprintf(`{"contract":"%s", "owner":"%s", "deploy_tx":"%s", "upgrade_tx":"%s"}`,
+			tx.to.String(), tx.from.String(), birthTx.hash.String(), tx.hash.String()),
+	}
+	if err := ctx.block.recordEvent(tx.hash, event); err != nil {
+		return ZeroGasCount, "", err
+	}
+	return ZeroGasCount, "", nil
+}
+
+// ContractUpgradeTx returns the hash of the latest upgrade tx of the contract, nil if never upgraded.
+func ContractUpgradeTx(contract state.Account) (byteutils.Hash, error) {
+	hash, err := contract.Get(contractCodeKey)
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	return hash, err
+}
+
+// ContractCode returns the deploy tx of the contract and its deploy payload carrying the
+// current code of the contract, which is the source of the latest upgrade if any.
+func (block *Block) ContractCode(contract state.Account) (*Transaction, *DeployPayload, error) {
+	birthTx, err := block.GetTransaction(contract.BirthPlace())
+	if err != nil {
+		return nil, nil, err
+	}
+	deploy, err := LoadDeployPayload(birthTx.data.Payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upgradeHash, err := ContractUpgradeTx(contract)
+	if err != nil || upgradeHash == nil {
+		return birthTx, deploy, err
+	}
+	upgradeTx, err := block.GetTransaction(upgradeHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	upgrade, err := LoadUpgradePayload(upgradeTx.data.Payload)
+	if err != nil {
+		return nil, nil, err
+	}
+	deploy.Source = upgrade.Source
+	deploy.SourceType = upgrade.SourceType
+	return birthTx, deploy, nil
+}
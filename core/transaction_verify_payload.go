@@ -0,0 +1,178 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// VerifyCompilerTypeScript compiles the TypeScript source with the bundled compiler.
+const VerifyCompilerTypeScript = "typescript"
+
+// contractVerifiedKey is the key of the source verification in the variables of a contract,
+// the value is the verify tx hash followed by the hash of the tx carrying the verified code.
+var contractVerifiedKey = []byte("verified")
+
+// VerifyPayload submits the original source and compiler settings of the contract at the tx
+// receiver. The source is compiled and its hash is checked against the current code of the
+// contract, the contract is marked verified on match. An empty compiler takes the source as is.
+type VerifyPayload struct {
+	SourceType      string
+	Source          string
+	Compiler        string
+	CompilerVersion string
+}
+
+// LoadVerifyPayload from bytes
+func LoadVerifyPayload(bytes []byte) (*VerifyPayload, error) {
+	payload := &VerifyPayload{}
+	if err := json.Unmarshal(bytes, payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// NewVerifyPayload with the original source and compiler settings
+func NewVerifyPayload(source, sourceType, compiler, compilerVersion string) *VerifyPayload {
+	return &VerifyPayload{
+		Source:          source,
+		SourceType:      sourceType,
+		Compiler:        compiler,
+		CompilerVersion: compilerVersion,
+	}
+}
+
+// ToBytes serialize payload
+func (payload *VerifyPayload) ToBytes() ([]byte, error) {
+	return json.Marshal(payload)
+}
+
+// BaseGasCount returns base gas count
+func (payload *VerifyPayload) BaseGasCount() *util.Uint128 {
+	return VerifyBaseGasCount
+}
+
+// Execute the verify payload in tx, mark the contract verified if the source compiles to its code
+func (payload *VerifyPayload) Execute(ctx *PayloadContext) (*util.Uint128, string, error) {
+	tx := ctx.tx
+	if len(payload.Source) == 0 {
+		return ZeroGasCount, "", ErrInvalidVerifySource
+	}
+
+	contract, err := ctx.accState.GetContractAccount(tx.to.address)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	if len(contract.BirthPlace()) == 0 {
+		return ZeroGasCount, "", ErrContractNotFound
+	}
+	_, deploy, err := ctx.block.ContractCode(contract)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+
+	code, sourceType, err := payload.compile(ctx, contract)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	codeHash := hash.Sha3256([]byte(code))
+	if sourceType != deploy.SourceType || !bytes.Equal(codeHash, hash.Sha3256([]byte(deploy.Source))) {
+		return ZeroGasCount, "", ErrContractSourceMismatch
+	}
+
+	codeTx, err := contractCodeTx(contract)
+	if err != nil {
+		return ZeroGasCount, "", err
+	}
+	value := append(append([]byte{}, tx.hash...), codeTx...)
+	if err := contract.Put(contractVerifiedKey, value); err != nil {
+		return ZeroGasCount, "", err
+	}
+
+	event := &Event{
+		Topic: TopicVerifySmartContract,
+		Data: fmt.Sprintf(`{"contract":"%s", "verifier":"%s", "verify_tx":"%s", "code_hash":"%s"}`,
+			tx.to.String(), tx.from.String(), tx.hash.String(), byteutils.Hash(codeHash).String()),
+	}
+	if err := ctx.block.recordEvent(tx.hash, event); err != nil {
+		return ZeroGasCount, "", err
+	}
+	return ZeroGasCount, "", nil
+}
+
+// compile returns the code compiled from the source and the source type of the code.
+func (payload *VerifyPayload) compile(ctx *PayloadContext, contract state.Account) (string, string, error) {
+	switch payload.Compiler {
+	case "":
+		return payload.Source, payload.SourceType, nil
+	case VerifyCompilerTypeScript:
+		if payload.SourceType != nvm.SourceTypeTypeScript || payload.CompilerVersion != nvm.TypeScriptCompilerVersion {
+			return "", "", ErrUnsupportedVerifyCompiler
+		}
+		owner := ctx.accState.GetOrCreateUserAccount(ctx.tx.from.Bytes())
+		engine := nvm.NewV8Engine(nvm.NewContext(ctx.block, convertNvmTx(ctx.tx), owner, contract, ctx.accState))
+		defer engine.Dispose()
+
+		code, _, err := engine.TranspileTypeScript(payload.Source)
+		if err != nil {
+			return "", "", err
+		}
+		return code, nvm.SourceTypeJavaScript, nil
+	}
+	return "", "", ErrUnsupportedVerifyCompiler
+}
+
+// contractCodeTx returns the hash of the tx carrying the current code of the contract.
+func contractCodeTx(contract state.Account) (byteutils.Hash, error) {
+	upgradeHash, err := ContractUpgradeTx(contract)
+	if err != nil || upgradeHash != nil {
+		return upgradeHash, err
+	}
+	return contract.BirthPlace(), nil
+}
+
+// ContractVerifyTx returns the hash of the tx verified the current code of the contract,
+// nil if never verified or upgraded since verified.
+func ContractVerifyTx(contract state.Account) (byteutils.Hash, error) {
+	value, err := contract.Get(contractVerifiedKey)
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	codeTx, err := contractCodeTx(contract)
+	if err != nil {
+		return nil, err
+	}
+	n := len(value) - len(codeTx)
+	if n <= 0 || !bytes.Equal(value[n:], codeTx) {
+		return nil, nil
+	}
+	return value[:n], nil
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util"
 )
@@ -35,6 +36,13 @@ const (
 	TxPayloadCallType      = "call"
 	TxPayloadDelegateType  = "delegate"
 	TxPayloadCandidateType = "candidate"
+	TxPayloadMultisigType  = "multisig"
+	TxPayloadBatchType     = "batch"
+	TxPayloadTimelockType  = "timelock"
+	TxPayloadSlashType     = "slash"
+	TxPayloadUpgradeType   = "upgrade"
+	TxPayloadVerifyType    = "verify"
+	TxPayloadPauseType     = "pause"
 )
 
 // Error Types
@@ -45,6 +53,9 @@ var (
 	ErrCannotFindBlockAtGivenHeight                      = errors.New("cannot find a block at given height which is less than tail block's height")
 	ErrLinkToWrongParentBlock                            = errors.New("link the block to a block who is not its parent")
 	ErrInvalidContractAddress                            = errors.New("invalid contract address")
+	ErrContractNotFound                                  = errors.New("contract not found")
+	ErrInvalidEventConsumer                              = errors.New("invalid event consumer")
+	ErrInvalidEventCursor                                = errors.New("invalid event cursor")
 	ErrInsufficientBalance                               = errors.New("insufficient balance")
 	ErrBelowGasPrice                                     = errors.New("below the gas price")
 	ErrOutOfGasLimit                                     = errors.New("out of gas limit")
@@ -52,7 +63,7 @@ var (
 	ErrInvalidSignature                                  = errors.New("invalid transaction signature")
 	ErrInvalidTransactionHash                            = errors.New("invalid transaction hash")
 	ErrMissingParentBlock                                = errors.New("cannot find the block's parent block in storage")
-	ErrTooFewCandidates                                  = errors.New("the size of candidates in consensus is un-safe, should be greater than or equal " + strconv.Itoa(SafeSize))
+	ErrTooFewCandidates                                  = errors.New("the size of candidates in consensus is un-safe, should be greater than a third of dynasty size")
 	ErrNotBlockForgTime                                  = errors.New("now is not time to forg block")
 	ErrInvalidBlockHash                                  = errors.New("invalid block hash")
 	ErrInvalidBlockStateRoot                             = errors.New("invalid block state root hash")
@@ -61,8 +72,14 @@ var (
 	ErrInvalidBlockDposContextRoot                       = errors.New("invalid block dpos context root hash")
 	ErrInvalidChainID                                    = errors.New("invalid transaction chainID")
 	ErrDuplicatedTransaction                             = errors.New("duplicated transaction")
+	ErrReplaceUnderpriced                                = errors.New("replacement transaction underpriced")
+	ErrFutureTransactionsFull                            = errors.New("too many transactions with nonce gap of the account")
+	ErrAccountTransactionsFull                           = errors.New("too many transactions of the account in pool")
 	ErrSmallTransactionNonce                             = errors.New("cannot accept a transaction with smaller nonce")
 	ErrLargeTransactionNonce                             = errors.New("cannot accept a transaction with too bigger nonce")
+	ErrNonSequentialTransactionNonce                     = errors.New("transaction nonces are not sequential")
+	ErrTransactionBatchTooLarge                          = errors.New("transaction batch is larger than tx pool")
+	ErrTransactionBatchRejected                          = errors.New("transaction batch rejected")
 	ErrDuplicatedBlock                                   = errors.New("duplicated block")
 	ErrDoubleBlockMinted                                 = errors.New("double block minted")
 	ErrInvalidAddress                                    = errors.New("address: invalid address")
@@ -72,8 +89,32 @@ var (
 	ErrInvalidDelegatePayloadAction                      = errors.New("invalid transaction vote payload action")
 	ErrInvalidDelegateToNonCandidate                     = errors.New("cannot delegate to non-candidate")
 	ErrInvalidUnDelegateFromNonDelegatee                 = errors.New("cannot un-delegate from non-delegatee")
+	ErrInvalidMultisigThreshold                          = errors.New("multisig threshold should be in [1, count of signers]")
+	ErrTooManyMultisigSigners                            = errors.New("too many multisig signers")
+	ErrDuplicatedMultisigSigner                          = errors.New("duplicated multisig signer")
+	ErrInvalidMultisigSigner                             = errors.New("invalid multisig signer")
+	ErrInvalidMultisigSignature                          = errors.New("invalid multisig signature")
+	ErrMultisigSignaturesNotEnough                       = errors.New("multisig signatures not enough")
+	ErrInvalidMultisigValue                              = errors.New("invalid multisig value")
+	ErrInvalidBatchRecipients                            = errors.New("count of batch recipients should be in [1, " + strconv.Itoa(BatchMaxRecipients) + "]")
+	ErrInvalidBatchValue                                 = errors.New("invalid batch transfer value")
+	ErrInvalidNodeMode                                   = errors.New("invalid node mode, should be archive or full")
+	ErrStateUnavailable                                  = errors.New("state unavailable in this mode")
+	ErrInvalidSnapshot                                   = errors.New("invalid snapshot")
+	ErrSnapshotStorageNotEmpty                           = errors.New("snapshot can only be restored to an empty storage")
+	ErrInvalidCheckpoint                                 = errors.New("invalid checkpoint, should be unique height with hex hash")
+	ErrCheckpointMismatch                                = errors.New("block contradicts the checkpoint")
+	ErrInvalidGenesisInterval                            = errors.New("invalid genesis interval, dynasty interval should be a multiple of block interval * dynasty size")
+	ErrInvalidGenesisDynastySize                         = errors.New("invalid genesis dynasty size, should be between " + strconv.Itoa(MinDynastySize) + " and " + strconv.Itoa(MaxDynastySize))
+	ErrInvalidGenesisRewardSchedule                      = errors.New("invalid genesis reward schedule, the start heights should be increasing")
+	ErrInvalidGenesisGas                                 = errors.New("invalid genesis gas price or gas limit")
+	ErrInvalidGenesisBalance                             = errors.New("invalid genesis token distribution value")
+	ErrInvalidBlockGasLimit                              = errors.New("invalid block gas limit")
+	ErrBlockGasLimitExceeded                             = errors.New("block gas limit exceeded")
+	ErrContractAddressCollision                          = errors.New("contract already deployed at the address")
+	ErrDuplicatedGenesisAddress                          = errors.New("duplicated address in genesis")
 	ErrInvalidBaseAndNextDynastyID                       = errors.New("cannot kickout from baseDynastyID to nextDynastyID if nextDynastyID <= baseDynastyID")
-	ErrInitialDynastyNotEnough                           = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than or equal " + strconv.Itoa(SafeSize))
+	ErrInitialDynastyNotEnough                           = errors.New("the size of initial dynasty in genesis block is un-safe, should be greater than a third of dynasty size")
 	ErrInvalidTransactionSigner                          = errors.New("transaction recover public key address not equal to from")
 	ErrNotBlockInCanonicalChain                          = errors.New("cannot find the block in canonical chain")
 	ErrCloneAccountState                                 = errors.New("Failed to clone account state")
@@ -95,6 +136,34 @@ var (
 	ErrCannotLoadGenesisBlock                            = errors.New("cannot load genesis block from storage")
 	ErrCannotLoadLIBBlock                                = errors.New("cannot load tail block from storage")
 	ErrCannotLoadTailBlock                               = errors.New("cannot load latest irreversible block from storage")
+	ErrAccountHistoryDisabled                            = errors.New("account history is not enabled")
+	ErrBlockNotIrreversible                              = errors.New("block is not irreversible")
+	ErrInvalidTimelockAction                             = errors.New("invalid timelock action")
+	ErrInvalidTimelockValue                              = errors.New("invalid timelock value")
+	ErrInvalidTimelockCondition                          = errors.New("timelock requires unlock height or unlock time")
+	ErrTimelockNotFound                                  = errors.New("timelock not found")
+	ErrTimelockNotUnlocked                               = errors.New("timelock is not unlocked yet")
+	ErrTimelockReleased                                  = errors.New("timelock is released already")
+	ErrTransactionExpired                                = errors.New("transaction is expired")
+	ErrInvalidSlashEvidence                              = errors.New("invalid double mint evidence")
+	ErrMinerAlreadySlashed                               = errors.New("miner has been slashed")
+	ErrInvalidMinerStatsRange                            = errors.New("invalid height range of miner stats")
+	ErrInvalidGenesisSigners                             = errors.New("invalid genesis poa signers, should be unique addresses")
+	ErrInvalidLIBConfirmations                           = errors.New("invalid lib confirmations, should be between consensus size and dynasty size")
+	ErrTransactionNotInBlock                             = errors.New("transaction not found in block")
+	ErrInvalidAncestorHeight                             = errors.New("ancestor height out of range")
+	ErrInvalidUpgradeSource                              = errors.New("invalid contract source to upgrade")
+	ErrUpgradeNotAuthorized                              = errors.New("only the contract deployer can upgrade the contract")
+	ErrContractNotUpgradable                             = errors.New("contract is not upgradable")
+	ErrInvalidVerifySource                               = errors.New("invalid contract source to verify")
+	ErrUnsupportedVerifyCompiler                         = errors.New("unsupported compiler to verify contract source")
+	ErrInvalidPauseAction                                = errors.New("invalid pause action")
+	ErrContractPauseUnchanged                            = errors.New("contract is already paused or unpaused")
+	ErrNotDynastyValidator                               = errors.New("only the validators of the current dynasty can vote")
+	ErrContractPaused                                    = errors.New("contract is paused by the dynasty vote")
+	ErrContractSourceMismatch                            = errors.New("compiled source mismatches the contract code")
+	ErrTypeScriptVersionMismatch                         = errors.New("bundled typescript compiler version mismatches the chain")
+	ErrInvalidBalanceChange                              = errors.New("invalid balance change")
 )
 
 // Default gas count
@@ -117,6 +186,11 @@ const (
 	MessageTypeNewTx                = "newtx"
 )
 
+func init() {
+	// blocks are never queued behind the gossiped transactions.
+	net.RegisterConsensusMessage(MessageTypeNewBlock, MessageTypeDownloadedBlock, MessageTypeDownloadedBlockReply)
+}
+
 // Consensus interface of consensus algorithm.
 type Consensus interface {
 	SuspendMining()
@@ -0,0 +1,101 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package core
+
+import (
+	"sort"
+
+	"github.com/nebulasio/go-nebulas/storage"
+)
+
+// Delegation is a delegator voting for a delegatee.
+type Delegation struct {
+	Delegator *Address
+	Delegatee *Address
+}
+
+// VoteSnapshot is the candidates with their vote weight and the delegations in the state of a block.
+type VoteSnapshot struct {
+	Height      uint64
+	Candidates  Candidates
+	Delegations []*Delegation
+}
+
+// VoteSnapshot returns the vote snapshot of the block at height on the canonical chain,
+// the tail block is used if height is 0. The candidates are sorted by vote weight as in election.
+func (bc *BlockChain) VoteSnapshot(height uint64) (*VoteSnapshot, error) {
+	block := bc.TailBlock()
+	if height > 0 {
+		if block = bc.GetBlockOnCanonicalChainByHeight(height); block == nil {
+			return nil, ErrNotBlockInCanonicalChain
+		}
+	}
+
+	// the votes are tallied on the clones, the missing delegators are created in state.
+	accounts, err := block.accState.Clone()
+	if err != nil {
+		return nil, err
+	}
+	dposContext, err := block.dposContext.Clone()
+	if err != nil {
+		return nil, err
+	}
+	dc := &DynastyContext{
+		DelegateTrie:  dposContext.delegateTrie,
+		CandidateTrie: dposContext.candidateTrie,
+		Accounts:      accounts,
+	}
+	votes, err := dc.tallyVotes()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &VoteSnapshot{Height: block.Height()}
+	for k, v := range votes {
+		candidate, err := AddressParse(k)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Candidates = append(snapshot.Candidates, &Candidate{candidate, v})
+	}
+	sort.Sort(snapshot.Candidates)
+
+	for _, candidate := range snapshot.Candidates {
+		iter, err := dposContext.delegateTrie.Iterator(candidate.Address.Bytes())
+		if err == storage.ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		exist, err := iter.Next()
+		for exist {
+			delegator, perr := AddressParseFromBytes(iter.Value())
+			if perr != nil {
+				return nil, perr
+			}
+			snapshot.Delegations = append(snapshot.Delegations, &Delegation{delegator, candidate.Address})
+			exist, err = iter.Next()
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,139 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package metrics
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultLatencyBuckets are the upper bounds in seconds of latency histograms.
+var DefaultLatencyBuckets = []float64{.001, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+var (
+	histogramsLock sync.RWMutex
+	histograms     = make(map[string]*Histogram)
+)
+
+// Histogram counts the observations in cumulative buckets, it's exported in Prometheus
+// format only, the go-metrics registry does not accept custom metric types.
+type Histogram struct {
+	name    string
+	labels  map[string]string
+	buckets []float64
+
+	mu     sync.Mutex
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// HistogramSnapshot is a read-only copy of the histogram.
+type HistogramSnapshot struct {
+	Name    string
+	Labels  map[string]string
+	Buckets []float64
+	// Counts are the cumulative counts of each bucket.
+	Counts []uint64
+	Sum    float64
+	Count  uint64
+}
+
+// GetOrRegisterHistogram returns the histogram of the name and labels, a new one with the
+// buckets is registered if not found.
+func GetOrRegisterHistogram(name string, labels map[string]string, buckets []float64) *Histogram {
+	key := histogramKey(name, labels)
+
+	histogramsLock.RLock()
+	h, ok := histograms[key]
+	histogramsLock.RUnlock()
+	if ok {
+		return h
+	}
+
+	histogramsLock.Lock()
+	defer histogramsLock.Unlock()
+	if h, ok := histograms[key]; ok {
+		return h
+	}
+	h = &Histogram{
+		name:    name,
+		labels:  labels,
+		buckets: buckets,
+		counts:  make([]uint64, len(buckets)),
+	}
+	histograms[key] = h
+	return h
+}
+
+// Histograms returns the snapshots of all registered histograms.
+func Histograms() []*HistogramSnapshot {
+	histogramsLock.RLock()
+	defer histogramsLock.RUnlock()
+
+	snapshots := make([]*HistogramSnapshot, 0, len(histograms))
+	for _, h := range histograms {
+		snapshots = append(snapshots, h.Snapshot())
+	}
+	return snapshots
+}
+
+// Observe adds an observation.
+func (h *Histogram) Observe(v float64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for i, bound := range h.buckets {
+		if v <= bound {
+			h.counts[i]++
+		}
+	}
+	h.sum += v
+	h.count++
+}
+
+// Snapshot returns a copy of the histogram.
+func (h *Histogram) Snapshot() *HistogramSnapshot {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return &HistogramSnapshot{
+		Name:    h.name,
+		Labels:  h.labels,
+		Buckets: h.buckets,
+		Counts:  append([]uint64(nil), h.counts...),
+		Sum:     h.sum,
+		Count:   h.count,
+	}
+}
+
+func histogramKey(name string, labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := []string{name}
+	for _, k := range keys {
+		parts = append(parts, k+"="+labels[k])
+	}
+	return strings.Join(parts, ",")
+}
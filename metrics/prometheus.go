@@ -0,0 +1,157 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	metrics "github.com/rcrowley/go-metrics"
+)
+
+// PrometheusContentType is the content type of Prometheus text format.
+const PrometheusContentType = "text/plain; version=0.0.4"
+
+// quantiles of the sampled go-metrics histograms and timers.
+var prometheusQuantiles = []float64{0.5, 0.75, 0.95, 0.99}
+
+// PrometheusHandler serves the metrics of the default registry and the histograms in Prometheus text format.
+func PrometheusHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", PrometheusContentType)
+		WritePrometheus(w, metrics.DefaultRegistry, Histograms())
+	})
+}
+
+// WritePrometheus writes the metrics in Prometheus text format, meters are exported as
+// counters, sampled histograms and timers as summaries, timers are in seconds.
+func WritePrometheus(w io.Writer, r metrics.Registry, histograms []*HistogramSnapshot) error {
+	buf := new(bytes.Buffer)
+
+	var names []string
+	values := make(map[string]interface{})
+	r.Each(func(name string, i interface{}) {
+		names = append(names, name)
+		values[name] = i
+	})
+	sort.Strings(names)
+
+	for _, name := range names {
+		n := prometheusName(name)
+		switch m := values[name].(type) {
+		case metrics.Counter:
+			writePrometheusValue(buf, n, "counter", float64(m.Count()))
+		case metrics.Gauge:
+			writePrometheusValue(buf, n, "gauge", float64(m.Value()))
+		case metrics.GaugeFloat64:
+			writePrometheusValue(buf, n, "gauge", m.Value())
+		case metrics.Meter:
+			writePrometheusValue(buf, n, "counter", float64(m.Count()))
+		case metrics.Timer:
+			s := m.Snapshot()
+			writePrometheusSummary(buf, n, s.Percentiles(prometheusQuantiles), float64(s.Sum()), s.Count(), 1e-9)
+		case metrics.Histogram:
+			s := m.Snapshot()
+			writePrometheusSummary(buf, n, s.Percentiles(prometheusQuantiles), float64(s.Sum()), s.Count(), 1)
+		}
+	}
+
+	sort.Slice(histograms, func(i, j int) bool {
+		if histograms[i].Name != histograms[j].Name {
+			return histograms[i].Name < histograms[j].Name
+		}
+		return formatPrometheusLabels(histograms[i].Labels) < formatPrometheusLabels(histograms[j].Labels)
+	})
+	for i, h := range histograms {
+		if i == 0 || histograms[i-1].Name != h.Name {
+			fmt.Fprintf(buf, "# TYPE %s histogram\n", prometheusName(h.Name))
+		}
+		writePrometheusHistogram(buf, h)
+	}
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func writePrometheusValue(buf *bytes.Buffer, name, typ string, v float64) {
+	fmt.Fprintf(buf, "# TYPE %s %s\n%s %s\n", name, typ, name, formatPrometheusFloat(v))
+}
+
+func writePrometheusSummary(buf *bytes.Buffer, name string, values []float64, sum float64, count int64, scale float64) {
+	fmt.Fprintf(buf, "# TYPE %s summary\n", name)
+	for i, q := range prometheusQuantiles {
+		fmt.Fprintf(buf, "%s{quantile=\"%s\"} %s\n", name, formatPrometheusFloat(q), formatPrometheusFloat(values[i]*scale))
+	}
+	fmt.Fprintf(buf, "%s_sum %s\n", name, formatPrometheusFloat(sum*scale))
+	fmt.Fprintf(buf, "%s_count %d\n", name, count)
+}
+
+func writePrometheusHistogram(buf *bytes.Buffer, h *HistogramSnapshot) {
+	name := prometheusName(h.Name)
+	labels := formatPrometheusLabels(h.Labels)
+	withLe := func(le string) string {
+		if len(labels) == 0 {
+			return "{le=\"" + le + "\"}"
+		}
+		return labels[:len(labels)-1] + ",le=\"" + le + "\"}"
+	}
+
+	for i, bound := range h.Buckets {
+		fmt.Fprintf(buf, "%s_bucket%s %d\n", name, withLe(formatPrometheusFloat(bound)), h.Counts[i])
+	}
+	fmt.Fprintf(buf, "%s_bucket%s %d\n", name, withLe("+Inf"), h.Count)
+	fmt.Fprintf(buf, "%s_sum%s %s\n", name, labels, formatPrometheusFloat(h.Sum))
+	fmt.Fprintf(buf, "%s_count%s %d\n", name, labels, h.Count)
+}
+
+// prometheusName replaces the characters not allowed in metric names, e.g. "neb.rpc.request" to "neb_rpc_request".
+func prometheusName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == ':' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+func formatPrometheusLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = prometheusName(k) + "=" + strconv.Quote(labels[k])
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatPrometheusFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
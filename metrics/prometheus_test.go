@@ -0,0 +1,67 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package metrics
+
+import (
+	"bytes"
+	"testing"
+
+	metrics "github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWritePrometheus(t *testing.T) {
+	r := metrics.NewRegistry()
+	metrics.GetOrRegisterCounter("neb.test.counter", r).Inc(2)
+	metrics.GetOrRegisterGauge("neb.test.gauge", r).Update(5)
+	metrics.GetOrRegisterMeter("neb.test.meter", r).Mark(3)
+
+	h := &Histogram{
+		name:    "neb.test.latency",
+		labels:  map[string]string{"method": "Call"},
+		buckets: []float64{0.1, 1},
+		counts:  make([]uint64, 2),
+	}
+	h.Observe(0.05)
+	h.Observe(0.5)
+	h.Observe(2)
+
+	buf := new(bytes.Buffer)
+	assert.Nil(t, WritePrometheus(buf, r, []*HistogramSnapshot{h.Snapshot()}))
+	expected := `# TYPE neb_test_counter counter
+neb_test_counter 2
+# TYPE neb_test_gauge gauge
+neb_test_gauge 5
+# TYPE neb_test_meter counter
+neb_test_meter 3
+# TYPE neb_test_latency histogram
+neb_test_latency_bucket{method="Call",le="0.1"} 1
+neb_test_latency_bucket{method="Call",le="1"} 2
+neb_test_latency_bucket{method="Call",le="+Inf"} 3
+neb_test_latency_sum{method="Call"} 2.55
+neb_test_latency_count{method="Call"} 3
+`
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestGetOrRegisterHistogram(t *testing.T) {
+	h := GetOrRegisterHistogram("neb.test.registered", map[string]string{"method": "Call"}, DefaultLatencyBuckets)
+	assert.Equal(t, h, GetOrRegisterHistogram("neb.test.registered", map[string]string{"method": "Call"}, DefaultLatencyBuckets))
+	assert.NotEqual(t, h, GetOrRegisterHistogram("neb.test.registered", map[string]string{"method": "Send"}, DefaultLatencyBuckets))
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package neblet
+
+import (
+	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+)
+
+// Settings of the local dev network. The dev accounts are the keys in the keydir
+// of the source tree locked by DevPassphrase, never use them on a public network.
+const (
+	DevChainID    = 1000
+	DevPassphrase = "passphrase"
+	DevBalance    = "1000000000000000000000000"
+)
+
+// DevAccounts are pre-funded on the dev network, the first ones are the validators.
+var DevAccounts = []string{
+	"1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+	"2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8",
+	"333cb3ed8c417971845382ede3cf67a0a96270c05fe2f700",
+	"48f981ed38910f1232c1bab124f650c482a57271632db9e3",
+	"59fc526072b09af8a8ca9732dae17132c4e9127e43cf2232",
+	"75e4e5a71d647298b88928d8cb5da43d90ab1a6c52d0905f",
+	"7da9dabedb4c6e121146fb4250a9883d6180570e63d6b080",
+	"98a3eed687640b75ec55bf5c9e284371bdcaeab943524d51",
+	"a8f1f53952c535c6600c77cf92b65e0c9b64496a8a328569",
+	"b040353ec0f2c113d5639444f7253681aecda1f8b91f179f",
+}
+
+// NewDevGenesisConf returns the genesis conf of the dev network, the dev validators
+// mint a block every second and all the dev accounts are pre-funded.
+func NewDevGenesisConf() *corepb.Genesis {
+	conf := core.NewGenesisConf(DevChainID, DevAccounts[:core.MinDynastySize], DevBalance)
+	for _, v := range DevAccounts[core.MinDynastySize:] {
+		conf.TokenDistribution = append(conf.TokenDistribution, &corepb.GenesisTokenDistribution{
+			Address: v,
+			Value:   DevBalance,
+		})
+	}
+	conf.Params.BlockInterval = core.MinBlockInterval
+	conf.Params.DynastySize = core.MinDynastySize
+	return conf
+}
+
+// setupDevConfig runs the node alone on the dev network, it starts minting at launch
+// with all the dev validators unlocked. The chain data is kept apart from the other networks.
+func setupDevConfig(config *nebletpb.Config) {
+	if config.Network != nil {
+		config.Network.Seed = nil
+	}
+	config.Chain.ChainId = DevChainID
+	config.Chain.Datadir += ".dev"
+	config.Chain.Miner = DevAccounts[0]
+	config.Chain.StartMine = true
+	config.Chain.Passphrase = DevPassphrase
+	config.Chain.RemoteSigner = ""
+	config.Chain.Consensus = consensus.DposEngine
+}
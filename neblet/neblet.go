@@ -14,6 +14,7 @@ import (
 	"github.com/nebulasio/go-nebulas/account"
 	"github.com/nebulasio/go-nebulas/consensus"
 	"github.com/nebulasio/go-nebulas/consensus/dpos"
+	"github.com/nebulasio/go-nebulas/consensus/poa"
 	"github.com/nebulasio/go-nebulas/core"
 	"github.com/nebulasio/go-nebulas/core/pb"
 	"github.com/nebulasio/go-nebulas/metrics"
@@ -22,6 +23,7 @@ import (
 	"github.com/nebulasio/go-nebulas/rpc"
 	"github.com/nebulasio/go-nebulas/storage"
 	nsync "github.com/nebulasio/go-nebulas/sync"
+	"github.com/nebulasio/go-nebulas/tracing"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	m "github.com/rcrowley/go-metrics"
@@ -33,6 +35,9 @@ var (
 
 	// ErrIncompatibleStorageSchemeVersion throws when the storage schema has been changed
 	ErrIncompatibleStorageSchemeVersion = errors.New("incompatible storage schema version, pls migrate your storage")
+
+	// ErrUnknownConsensusEngine throws when the consensus engine in config is not supported.
+	ErrUnknownConsensusEngine = errors.New("unknown consensus engine, should be dpos or poa")
 )
 
 var (
@@ -70,8 +75,10 @@ type Neblet struct {
 func New(config *nebletpb.Config) (*Neblet, error) {
 	var err error
 	n := &Neblet{config: config}
-	n.genesis, err = core.LoadGenesisConf(config.Chain.Genesis)
-	if err != nil {
+	if config.Chain.Dev {
+		setupDevConfig(config)
+		n.genesis = NewDevGenesisConf()
+	} else if n.genesis, err = core.LoadGenesisConf(config.Chain.Genesis); err != nil {
 		return nil, err
 	}
 	n.accountManager = account.NewManager(n)
@@ -89,7 +96,11 @@ func (n *Neblet) Setup() {
 
 	// storage
 	// n.storage, err = storage.NewMemoryStorage()
-	n.storage, err = storage.NewDiskStorage(n.config.Chain.Datadir)
+	if n.config.Chain.SplitStorage {
+		n.storage, err = storage.NewSplitDiskStorage(n.config.Chain.Datadir)
+	} else {
+		n.storage, err = storage.NewDiskStorage(n.config.Chain.Datadir)
+	}
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
 			"dir": n.config.Chain.Datadir,
@@ -113,14 +124,33 @@ func (n *Neblet) Setup() {
 			"err": err,
 		}).Fatal("Failed to setup blockchain.")
 	}
-	gasPrice := util.NewUint128FromString(n.config.Chain.GasPrice)
-	gasLimit := util.NewUint128FromString(n.config.Chain.GasLimit)
+	// the gas config of node overrides the genesis params.
+	params := core.GenesisParams(n.genesis)
+	if len(n.config.Chain.GasPrice) > 0 {
+		params.GasPrice = n.config.Chain.GasPrice
+	}
+	if len(n.config.Chain.GasLimit) > 0 {
+		params.GasLimit = n.config.Chain.GasLimit
+	}
+	gasPrice := util.NewUint128FromString(params.GasPrice)
+	gasLimit := util.NewUint128FromString(params.GasLimit)
 	n.blockChain.TransactionPool().SetGasConfig(gasPrice, gasLimit)
+	n.blockChain.TransactionPool().SetPriceBump(n.config.Chain.PriceBump)
+	n.blockChain.TransactionPool().SetFutureLimit(n.config.Chain.FutureLimit)
+	n.blockChain.TransactionPool().SetAccountLimit(n.config.Chain.AccountTxLimit)
+	n.blockChain.TransactionPool().SetMaxAge(time.Duration(n.config.Chain.TxMaxAge) * time.Second)
 	n.blockChain.BlockPool().RegisterInNetwork(n.netService)
 	n.blockChain.TransactionPool().RegisterInNetwork(n.netService)
 
 	// consensus
-	n.consensus, err = dpos.NewDpos(n)
+	switch n.config.Chain.Consensus {
+	case "", consensus.DposEngine:
+		n.consensus, err = dpos.NewDpos(n)
+	case consensus.PoaEngine:
+		n.consensus, err = poa.NewPoa(n)
+	default:
+		err = ErrUnknownConsensusEngine
+	}
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
 			"err": err,
@@ -155,6 +185,7 @@ func (n *Neblet) Start() {
 	if n.config.Stats.EnableMetrics {
 		metrics.Start(n)
 	}
+	tracing.Start(n)
 
 	if err := n.netService.Start(); err != nil {
 		logging.CLog().WithFields(logrus.Fields{
@@ -255,6 +286,7 @@ func (n *Neblet) Stop() {
 	if n.config.Stats.EnableMetrics {
 		metrics.Stop()
 	}
+	tracing.Stop()
 
 	n.accountManager = nil
 
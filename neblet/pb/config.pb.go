@@ -11,10 +11,14 @@ It has these top-level messages:
 	Config
 	NetworkConfig
 	ChainConfig
+	CheckpointConfig
 	RPCConfig
+	RPCListenerConfig
+	EventSchemaConfig
 	AppConfig
 	MiscConfig
 	StatsConfig
+	TracingConfig
 	InfluxdbConfig
 */
 package nebletpb
@@ -52,7 +56,7 @@ func (x StatsConfig_ReportingModule) String() string {
 	return proto.EnumName(StatsConfig_ReportingModule_name, int32(x))
 }
 func (StatsConfig_ReportingModule) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorConfig, []int{6, 0}
+	return fileDescriptorConfig, []int{9, 0}
 }
 
 // Neblet global configurations.
@@ -127,6 +131,24 @@ type NetworkConfig struct {
 	PrivateKey string `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
 	// Network ID
 	NetworkId uint32 `protobuf:"varint,4,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
+	// Allowed peers, ip, cidr or peer id. If not empty, only these peers are accepted.
+	AllowList []string `protobuf:"bytes,5,rep,name=allow_list,json=allowList" json:"allow_list,omitempty"`
+	// Denied peers, ip, cidr or peer id.
+	DenyList []string `protobuf:"bytes,6,rep,name=deny_list,json=denyList" json:"deny_list,omitempty"`
+	// Disable mapping the listen ports on the router by UPnP or NAT-PMP.
+	DisableNat bool `protobuf:"varint,7,opt,name=disable_nat,json=disableNat,proto3" json:"disable_nat,omitempty"`
+	// Static peers, ipfs addresses of the peers always kept connected.
+	StaticPeers []string `protobuf:"bytes,8,rep,name=static_peers,json=staticPeers" json:"static_peers,omitempty"`
+	// Trusted peer ids, exempt from banning and connection limits.
+	TrustedPeers []string `protobuf:"bytes,9,rep,name=trusted_peers,json=trustedPeers" json:"trusted_peers,omitempty"`
+	// DNS seed domains, whose TXT records are the seed peers signed by dns_seed_pubkey.
+	DnsSeed []string `protobuf:"bytes,10,rep,name=dns_seed,json=dnsSeed" json:"dns_seed,omitempty"`
+	// Hex encoded secp256k1 public key signing the DNS seed records.
+	DnsSeedPubkey string `protobuf:"bytes,11,opt,name=dns_seed_pubkey,json=dnsSeedPubkey,proto3" json:"dns_seed_pubkey,omitempty"`
+	// Maximum of the connections in total, inbound and outbound, 0 for the defaults.
+	MaxConns         uint32 `protobuf:"varint,12,opt,name=max_conns,json=maxConns,proto3" json:"max_conns,omitempty"`
+	MaxInboundConns  uint32 `protobuf:"varint,13,opt,name=max_inbound_conns,json=maxInboundConns,proto3" json:"max_inbound_conns,omitempty"`
+	MaxOutboundConns uint32 `protobuf:"varint,14,opt,name=max_outbound_conns,json=maxOutboundConns,proto3" json:"max_outbound_conns,omitempty"`
 }
 
 func (m *NetworkConfig) Reset()                    { *m = NetworkConfig{} }
@@ -162,6 +184,76 @@ func (m *NetworkConfig) GetNetworkId() uint32 {
 	return 0
 }
 
+func (m *NetworkConfig) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetDenyList() []string {
+	if m != nil {
+		return m.DenyList
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetDisableNat() bool {
+	if m != nil {
+		return m.DisableNat
+	}
+	return false
+}
+
+func (m *NetworkConfig) GetStaticPeers() []string {
+	if m != nil {
+		return m.StaticPeers
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetTrustedPeers() []string {
+	if m != nil {
+		return m.TrustedPeers
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetDnsSeed() []string {
+	if m != nil {
+		return m.DnsSeed
+	}
+	return nil
+}
+
+func (m *NetworkConfig) GetDnsSeedPubkey() string {
+	if m != nil {
+		return m.DnsSeedPubkey
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetMaxConns() uint32 {
+	if m != nil {
+		return m.MaxConns
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetMaxInboundConns() uint32 {
+	if m != nil {
+		return m.MaxInboundConns
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetMaxOutboundConns() uint32 {
+	if m != nil {
+		return m.MaxOutboundConns
+	}
+	return 0
+}
+
 type ChainConfig struct {
 	// ChainID.
 	ChainId uint32 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
@@ -173,9 +265,10 @@ type ChainConfig struct {
 	Keydir string `protobuf:"bytes,12,opt,name=keydir,proto3" json:"keydir,omitempty"`
 	// start mine at launch
 	StartMine bool `protobuf:"varint,20,opt,name=start_mine,json=startMine,proto3" json:"start_mine,omitempty"`
-	// Coinbase.
+	// Coinbase, the payout address of the block rewards, default is the miner.
 	Coinbase string `protobuf:"bytes,21,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
-	// Miner.
+	// Miner, the address signing the blocks, its key should be unlocked on the node
+	// unless a remote signer is used.
 	Miner string `protobuf:"bytes,22,opt,name=miner,proto3" json:"miner,omitempty"`
 	// Passphrase.
 	Passphrase string `protobuf:"bytes,23,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
@@ -185,6 +278,64 @@ type ChainConfig struct {
 	GasLimit string `protobuf:"bytes,25,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 	// Supported signature cipher list. ["ECC_SECP256K1"]
 	SignatureCiphers []string `protobuf:"bytes,26,rep,name=signature_ciphers,json=signatureCiphers" json:"signature_ciphers,omitempty"`
+	// Record the execution error of failed transactions, returned in receipts.
+	ExecutionResultLog bool `protobuf:"varint,27,opt,name=execution_result_log,json=executionResultLog,proto3" json:"execution_result_log,omitempty"`
+	// Store small values and large values in separate keyspaces, incompatible with the single keyspace datadir.
+	SplitStorage bool `protobuf:"varint,28,opt,name=split_storage,json=splitStorage,proto3" json:"split_storage,omitempty"`
+	// Resubmit the transactions of reverted blocks minted by the miner with priority.
+	ReorgResubmit bool `protobuf:"varint,29,opt,name=reorg_resubmit,json=reorgResubmit,proto3" json:"reorg_resubmit,omitempty"`
+	// Min gas price bump in percent to replace a pending transaction with the same from and nonce, default is 10.
+	PriceBump uint32 `protobuf:"varint,30,opt,name=price_bump,json=priceBump,proto3" json:"price_bump,omitempty"`
+	// Max count of transactions with nonce gap queued for each account, default is 64.
+	FutureLimit uint32 `protobuf:"varint,31,opt,name=future_limit,json=futureLimit,proto3" json:"future_limit,omitempty"`
+	// Max count of transactions in the transaction pool, default is 40960.
+	TxPoolSize uint32 `protobuf:"varint,32,opt,name=tx_pool_size,json=txPoolSize,proto3" json:"tx_pool_size,omitempty"`
+	// Max count of transactions of each account in the transaction pool, 0 means unlimited.
+	AccountTxLimit uint32 `protobuf:"varint,33,opt,name=account_tx_limit,json=accountTxLimit,proto3" json:"account_tx_limit,omitempty"`
+	// Max seconds a transaction stays in the transaction pool, 0 means no expiry.
+	TxMaxAge uint32 `protobuf:"varint,34,opt,name=tx_max_age,json=txMaxAge,proto3" json:"tx_max_age,omitempty"`
+	// Keep the state of the latest N blocks and prune the older, 0 means keeping the state of all blocks.
+	StatePruneBlocks uint32 `protobuf:"varint,35,opt,name=state_prune_blocks,json=statePruneBlocks,proto3" json:"state_prune_blocks,omitempty"`
+	// Node mode, "archive" keeps the state of all blocks, "full" prunes the state older than
+	// state_prune_blocks. Default is "full" if state_prune_blocks is set, otherwise "archive".
+	NodeMode string `protobuf:"bytes,36,opt,name=node_mode,json=nodeMode,proto3" json:"node_mode,omitempty"`
+	// Trusted blocks on the canonical chain, the branches contradicting them are rejected.
+	Checkpoints []*CheckpointConfig `protobuf:"bytes,37,rep,name=checkpoints" json:"checkpoints,omitempty"`
+	// Block gas limit the miner votes for, the gas limit of the minted blocks moves towards it
+	// by at most 1/1024 of the parent's. Default is 0 to keep the parent's.
+	TargetBlockGasLimit uint64 `protobuf:"varint,38,opt,name=target_block_gas_limit,json=targetBlockGasLimit,proto3" json:"target_block_gas_limit,omitempty"`
+	// Record the balance changes of accounts on the canonical chain, served by GetAccountHistory.
+	AccountHistory bool `protobuf:"varint,39,opt,name=account_history,json=accountHistory,proto3" json:"account_history,omitempty"`
+	// Remote signer holding the miner key, "host:port" or "unix:///path/to/socket".
+	// The blocks are signed locally if not specified.
+	RemoteSigner string `protobuf:"bytes,40,opt,name=remote_signer,json=remoteSigner,proto3" json:"remote_signer,omitempty"`
+	// Run alone on a local dev network, the dev validators in keydir mint a block every second
+	// and the dev accounts are pre-funded. Never use it on a public network.
+	Dev bool `protobuf:"varint,41,opt,name=dev,proto3" json:"dev,omitempty"`
+	// Consensus engine, dpos or poa, default is dpos. The poa signers are specified in genesis.
+	Consensus string `protobuf:"bytes,42,opt,name=consensus,proto3" json:"consensus,omitempty"`
+	// Distinct miners in a dynasty confirming a block to make it irreversible, between
+	// 2/3 of the dynasty size + 1 and the dynasty size. Default is 2/3 of the dynasty size + 1.
+	LibConfirmations uint32 `protobuf:"varint,43,opt,name=lib_confirmations,json=libConfirmations,proto3" json:"lib_confirmations,omitempty"`
+	// TypeScript compiler version pinned by the chain, the node refuses to start if its bundled
+	// compiler differs, so TypeScript contracts are transpiled identically by every node.
+	TypescriptVersion string `protobuf:"bytes,44,opt,name=typescript_version,json=typescriptVersion,proto3" json:"typescript_version,omitempty"`
+	// Max milliseconds of a contract execution in nvm, default is 10000. The execution is
+	// aborted with an execution timeout error.
+	NvmExecutionTimeout uint32 `protobuf:"varint,45,opt,name=nvm_execution_timeout,json=nvmExecutionTimeout,proto3" json:"nvm_execution_timeout,omitempty"`
+	// Max bytes of memory of a local contract call or gas estimate in nvm, default is 40000000.
+	// The execution fails with an exceed memory limits error. The transactions in blocks are
+	// always limited by the default on every node.
+	NvmMemoryLimit uint64 `protobuf:"varint,46,opt,name=nvm_memory_limit,json=nvmMemoryLimit,proto3" json:"nvm_memory_limit,omitempty"`
+	// Max instructions of a local contract call or gas estimate in nvm regardless of its gas
+	// limit, 0 means limited by gas only. The execution fails with an exceed instruction limits
+	// error. The transactions in blocks are always limited by their gas limit.
+	NvmInstructionLimit uint64 `protobuf:"varint,47,opt,name=nvm_instruction_limit,json=nvmInstructionLimit,proto3" json:"nvm_instruction_limit,omitempty"`
+	// Mutual tls between the remote signer on tcp and the neblets, both sides present the
+	// cert and key, and verify the peer's cert against the ca. Required by the signer on tcp.
+	RemoteSignerCert string `protobuf:"bytes,48,opt,name=remote_signer_cert,json=remoteSignerCert,proto3" json:"remote_signer_cert,omitempty"`
+	RemoteSignerKey  string `protobuf:"bytes,49,opt,name=remote_signer_key,json=remoteSignerKey,proto3" json:"remote_signer_key,omitempty"`
+	RemoteSignerCa   string `protobuf:"bytes,50,opt,name=remote_signer_ca,json=remoteSignerCa,proto3" json:"remote_signer_ca,omitempty"`
 }
 
 func (m *ChainConfig) Reset()                    { *m = ChainConfig{} }
@@ -269,6 +420,199 @@ func (m *ChainConfig) GetSignatureCiphers() []string {
 	return nil
 }
 
+func (m *ChainConfig) GetExecutionResultLog() bool {
+	if m != nil {
+		return m.ExecutionResultLog
+	}
+	return false
+}
+
+func (m *ChainConfig) GetSplitStorage() bool {
+	if m != nil {
+		return m.SplitStorage
+	}
+	return false
+}
+
+func (m *ChainConfig) GetReorgResubmit() bool {
+	if m != nil {
+		return m.ReorgResubmit
+	}
+	return false
+}
+
+func (m *ChainConfig) GetPriceBump() uint32 {
+	if m != nil {
+		return m.PriceBump
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetFutureLimit() uint32 {
+	if m != nil {
+		return m.FutureLimit
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetTxPoolSize() uint32 {
+	if m != nil {
+		return m.TxPoolSize
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetAccountTxLimit() uint32 {
+	if m != nil {
+		return m.AccountTxLimit
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetTxMaxAge() uint32 {
+	if m != nil {
+		return m.TxMaxAge
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetStatePruneBlocks() uint32 {
+	if m != nil {
+		return m.StatePruneBlocks
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetNodeMode() string {
+	if m != nil {
+		return m.NodeMode
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetCheckpoints() []*CheckpointConfig {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+func (m *ChainConfig) GetTargetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.TargetBlockGasLimit
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetAccountHistory() bool {
+	if m != nil {
+		return m.AccountHistory
+	}
+	return false
+}
+
+func (m *ChainConfig) GetRemoteSigner() string {
+	if m != nil {
+		return m.RemoteSigner
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetDev() bool {
+	if m != nil {
+		return m.Dev
+	}
+	return false
+}
+
+func (m *ChainConfig) GetConsensus() string {
+	if m != nil {
+		return m.Consensus
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetLibConfirmations() uint32 {
+	if m != nil {
+		return m.LibConfirmations
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetTypescriptVersion() string {
+	if m != nil {
+		return m.TypescriptVersion
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetNvmExecutionTimeout() uint32 {
+	if m != nil {
+		return m.NvmExecutionTimeout
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetNvmMemoryLimit() uint64 {
+	if m != nil {
+		return m.NvmMemoryLimit
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetNvmInstructionLimit() uint64 {
+	if m != nil {
+		return m.NvmInstructionLimit
+	}
+	return 0
+}
+
+func (m *ChainConfig) GetRemoteSignerCert() string {
+	if m != nil {
+		return m.RemoteSignerCert
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetRemoteSignerKey() string {
+	if m != nil {
+		return m.RemoteSignerKey
+	}
+	return ""
+}
+
+func (m *ChainConfig) GetRemoteSignerCa() string {
+	if m != nil {
+		return m.RemoteSignerCa
+	}
+	return ""
+}
+
+type CheckpointConfig struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the block hash.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *CheckpointConfig) Reset()                    { *m = CheckpointConfig{} }
+func (m *CheckpointConfig) String() string            { return proto.CompactTextString(m) }
+func (*CheckpointConfig) ProtoMessage()               {}
+func (*CheckpointConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{3} }
+
+func (m *CheckpointConfig) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CheckpointConfig) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
 type RPCConfig struct {
 	// RPC listen addresses.
 	RpcListen []string `protobuf:"bytes,1,rep,name=rpc_listen,json=rpcListen" json:"rpc_listen,omitempty"`
@@ -276,12 +620,50 @@ type RPCConfig struct {
 	HttpListen []string `protobuf:"bytes,2,rep,name=http_listen,json=httpListen" json:"http_listen,omitempty"`
 	// Enabled HTTP modules.["api", "admin"]
 	HttpModule []string `protobuf:"bytes,3,rep,name=http_module,json=httpModule" json:"http_module,omitempty"`
+	// Max blocks the tail block can fall behind before /readyz fails, default is 10.
+	ReadyMaxBlockLag uint32 `protobuf:"varint,4,opt,name=ready_max_block_lag,json=readyMaxBlockLag,proto3" json:"ready_max_block_lag,omitempty"`
+	// JSON schemas of event data, used to decode the events in responses.
+	EventSchemas []*EventSchemaConfig `protobuf:"bytes,5,rep,name=event_schemas,json=eventSchemas" json:"event_schemas,omitempty"`
+	// Listen addresses of the JSON-RPC server serving a subset of the Ethereum methods on the Nebulas
+	// addresses and transactions, see rpc.EthService. Disabled if empty.
+	EthListen []string `protobuf:"bytes,6,rep,name=eth_listen,json=ethListen" json:"eth_listen,omitempty"`
+	// Timeouts of rpc methods in milliseconds, keyed by method name, e.g. "Call" or "eth_getBalance".
+	MethodTimeouts map[string]uint32 `protobuf:"bytes,7,rep,name=method_timeouts,json=methodTimeouts" json:"method_timeouts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// gRPC unix domain socket path serving both api and admin services, disabled if empty.
+	UnixSocket string `protobuf:"bytes,8,opt,name=unix_socket,json=unixSocket,proto3" json:"unix_socket,omitempty"`
+	// Gateway unix domain socket path serving both api and admin modules, requires unix_socket.
+	HttpUnixSocket string `protobuf:"bytes,9,opt,name=http_unix_socket,json=httpUnixSocket,proto3" json:"http_unix_socket,omitempty"`
+	// File mode of the unix sockets, default is 0600.
+	UnixSocketMode uint32 `protobuf:"varint,10,opt,name=unix_socket_mode,json=unixSocketMode,proto3" json:"unix_socket_mode,omitempty"`
+	// Serve the admin service only on the unix sockets.
+	AdminUnixOnly bool `protobuf:"varint,11,opt,name=admin_unix_only,json=adminUnixOnly,proto3" json:"admin_unix_only,omitempty"`
+	// Max size of request message in bytes, default is 4MB.
+	MaxMessageSize uint32 `protobuf:"varint,12,opt,name=max_message_size,json=maxMessageSize,proto3" json:"max_message_size,omitempty"`
+	// Max size of contract source in bytes, default is 128KB.
+	MaxContractSourceSize uint32 `protobuf:"varint,13,opt,name=max_contract_source_size,json=maxContractSourceSize,proto3" json:"max_contract_source_size,omitempty"`
+	// Max size of contract args in bytes, default is 16KB.
+	MaxContractArgsSize uint32 `protobuf:"varint,14,opt,name=max_contract_args_size,json=maxContractArgsSize,proto3" json:"max_contract_args_size,omitempty"`
+	// Max count of transactions in a batch submission, default is 100.
+	MaxBatchTransactions uint32 `protobuf:"varint,15,opt,name=max_batch_transactions,json=maxBatchTransactions,proto3" json:"max_batch_transactions,omitempty"`
+	// Expose Prometheus format metrics at /metrics on the gateway.
+	PrometheusMetrics bool `protobuf:"varint,16,opt,name=prometheus_metrics,json=prometheusMetrics,proto3" json:"prometheus_metrics,omitempty"`
+	// Max count of events buffered for each Subscribe stream, default is 1024.
+	SubscribeBufferSize uint32 `protobuf:"varint,17,opt,name=subscribe_buffer_size,json=subscribeBufferSize,proto3" json:"subscribe_buffer_size,omitempty"`
+	// RPC listeners with the services served on them, in addition to rpc_listen.
+	Listeners []*RPCListenerConfig `protobuf:"bytes,18,rep,name=listeners" json:"listeners,omitempty"`
+	// Requests taking longer than the threshold in milliseconds are written to the slow request log, 0 means disabled.
+	SlowRequestThreshold uint32 `protobuf:"varint,19,opt,name=slow_request_threshold,json=slowRequestThreshold,proto3" json:"slow_request_threshold,omitempty"`
+	// Slow request log file, slow requests are written to the verbose log if not set.
+	SlowRequestLog string `protobuf:"bytes,20,opt,name=slow_request_log,json=slowRequestLog,proto3" json:"slow_request_log,omitempty"`
+	// Serve GetAccountState, Call and GetBlockByHeight on the latest irreversible block,
+	// as if finalized_only is set in every request.
+	FinalizedOnly bool `protobuf:"varint,21,opt,name=finalized_only,json=finalizedOnly,proto3" json:"finalized_only,omitempty"`
 }
 
 func (m *RPCConfig) Reset()                    { *m = RPCConfig{} }
 func (m *RPCConfig) String() string            { return proto.CompactTextString(m) }
 func (*RPCConfig) ProtoMessage()               {}
-func (*RPCConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{3} }
+func (*RPCConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{4} }
 
 func (m *RPCConfig) GetRpcListen() []string {
 	if m != nil {
@@ -304,9 +686,189 @@ func (m *RPCConfig) GetHttpModule() []string {
 	return nil
 }
 
+func (m *RPCConfig) GetReadyMaxBlockLag() uint32 {
+	if m != nil {
+		return m.ReadyMaxBlockLag
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetEventSchemas() []*EventSchemaConfig {
+	if m != nil {
+		return m.EventSchemas
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetEthListen() []string {
+	if m != nil {
+		return m.EthListen
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetMethodTimeouts() map[string]uint32 {
+	if m != nil {
+		return m.MethodTimeouts
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetUnixSocket() string {
+	if m != nil {
+		return m.UnixSocket
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetHttpUnixSocket() string {
+	if m != nil {
+		return m.HttpUnixSocket
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetUnixSocketMode() uint32 {
+	if m != nil {
+		return m.UnixSocketMode
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetAdminUnixOnly() bool {
+	if m != nil {
+		return m.AdminUnixOnly
+	}
+	return false
+}
+
+func (m *RPCConfig) GetMaxMessageSize() uint32 {
+	if m != nil {
+		return m.MaxMessageSize
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetMaxContractSourceSize() uint32 {
+	if m != nil {
+		return m.MaxContractSourceSize
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetMaxContractArgsSize() uint32 {
+	if m != nil {
+		return m.MaxContractArgsSize
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetMaxBatchTransactions() uint32 {
+	if m != nil {
+		return m.MaxBatchTransactions
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetPrometheusMetrics() bool {
+	if m != nil {
+		return m.PrometheusMetrics
+	}
+	return false
+}
+
+func (m *RPCConfig) GetSubscribeBufferSize() uint32 {
+	if m != nil {
+		return m.SubscribeBufferSize
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetListeners() []*RPCListenerConfig {
+	if m != nil {
+		return m.Listeners
+	}
+	return nil
+}
+
+func (m *RPCConfig) GetSlowRequestThreshold() uint32 {
+	if m != nil {
+		return m.SlowRequestThreshold
+	}
+	return 0
+}
+
+func (m *RPCConfig) GetSlowRequestLog() string {
+	if m != nil {
+		return m.SlowRequestLog
+	}
+	return ""
+}
+
+func (m *RPCConfig) GetFinalizedOnly() bool {
+	if m != nil {
+		return m.FinalizedOnly
+	}
+	return false
+}
+
+type RPCListenerConfig struct {
+	// Listen address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Services served on the listener, "api", "public" or "admin". The public service
+	// is the read-only subset of api, without the methods using the node accounts.
+	Services []string `protobuf:"bytes,2,rep,name=services" json:"services,omitempty"`
+}
+
+func (m *RPCListenerConfig) Reset()                    { *m = RPCListenerConfig{} }
+func (m *RPCListenerConfig) String() string            { return proto.CompactTextString(m) }
+func (*RPCListenerConfig) ProtoMessage()               {}
+func (*RPCListenerConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{5} }
+
+func (m *RPCListenerConfig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RPCListenerConfig) GetServices() []string {
+	if m != nil {
+		return m.Services
+	}
+	return nil
+}
+
+type EventSchemaConfig struct {
+	// Event topic, such as "chain.contract.transfer".
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	// JSON schema file path.
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *EventSchemaConfig) Reset()                    { *m = EventSchemaConfig{} }
+func (m *EventSchemaConfig) String() string            { return proto.CompactTextString(m) }
+func (*EventSchemaConfig) ProtoMessage()               {}
+func (*EventSchemaConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{6} }
+
+func (m *EventSchemaConfig) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *EventSchemaConfig) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
 type AppConfig struct {
-	LogLevel          string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
-	LogFile           string `protobuf:"bytes,2,opt,name=log_file,json=logFile,proto3" json:"log_file,omitempty"`
+	LogLevel string `protobuf:"bytes,1,opt,name=log_level,json=logLevel,proto3" json:"log_level,omitempty"`
+	LogFile  string `protobuf:"bytes,2,opt,name=log_file,json=logFile,proto3" json:"log_file,omitempty"`
+	// log file age, unit is s.
 	LogAge            uint32 `protobuf:"varint,3,opt,name=log_age,json=logAge,proto3" json:"log_age,omitempty"`
 	EnableCrashReport bool   `protobuf:"varint,4,opt,name=enable_crash_report,json=enableCrashReport,proto3" json:"enable_crash_report,omitempty"`
 	CrashReportUrl    string `protobuf:"bytes,5,opt,name=crash_report_url,json=crashReportUrl,proto3" json:"crash_report_url,omitempty"`
@@ -316,7 +878,7 @@ type AppConfig struct {
 func (m *AppConfig) Reset()                    { *m = AppConfig{} }
 func (m *AppConfig) String() string            { return proto.CompactTextString(m) }
 func (*AppConfig) ProtoMessage()               {}
-func (*AppConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{4} }
+func (*AppConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{7} }
 
 func (m *AppConfig) GetLogLevel() string {
 	if m != nil {
@@ -368,7 +930,7 @@ type MiscConfig struct {
 func (m *MiscConfig) Reset()                    { *m = MiscConfig{} }
 func (m *MiscConfig) String() string            { return proto.CompactTextString(m) }
 func (*MiscConfig) ProtoMessage()               {}
-func (*MiscConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{5} }
+func (*MiscConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{8} }
 
 func (m *MiscConfig) GetDefaultKeystoreFileCiper() string {
 	if m != nil {
@@ -384,12 +946,14 @@ type StatsConfig struct {
 	// Influxdb config.
 	Influxdb    *InfluxdbConfig `protobuf:"bytes,11,opt,name=influxdb" json:"influxdb,omitempty"`
 	MetricsTags []string        `protobuf:"bytes,12,rep,name=metrics_tags,json=metricsTags" json:"metrics_tags,omitempty"`
+	// Tracing config.
+	Tracing *TracingConfig `protobuf:"bytes,13,opt,name=tracing" json:"tracing,omitempty"`
 }
 
 func (m *StatsConfig) Reset()                    { *m = StatsConfig{} }
 func (m *StatsConfig) String() string            { return proto.CompactTextString(m) }
 func (*StatsConfig) ProtoMessage()               {}
-func (*StatsConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{6} }
+func (*StatsConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{9} }
 
 func (m *StatsConfig) GetEnableMetrics() bool {
 	if m != nil {
@@ -419,6 +983,48 @@ func (m *StatsConfig) GetMetricsTags() []string {
 	return nil
 }
 
+func (m *StatsConfig) GetTracing() *TracingConfig {
+	if m != nil {
+		return m.Tracing
+	}
+	return nil
+}
+
+type TracingConfig struct {
+	// OTLP/HTTP endpoint of the collector, e.g. http://localhost:4318. Tracing is disabled if empty.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Service name of the spans, default is neb.
+	ServiceName string `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// Ratio of the sampled traces in (0, 1], sample all if not specified.
+	SampleRatio float64 `protobuf:"fixed64,3,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"`
+}
+
+func (m *TracingConfig) Reset()                    { *m = TracingConfig{} }
+func (m *TracingConfig) String() string            { return proto.CompactTextString(m) }
+func (*TracingConfig) ProtoMessage()               {}
+func (*TracingConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{10} }
+
+func (m *TracingConfig) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *TracingConfig) GetServiceName() string {
+	if m != nil {
+		return m.ServiceName
+	}
+	return ""
+}
+
+func (m *TracingConfig) GetSampleRatio() float64 {
+	if m != nil {
+		return m.SampleRatio
+	}
+	return 0
+}
+
 type InfluxdbConfig struct {
 	// Host.
 	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
@@ -435,7 +1041,7 @@ type InfluxdbConfig struct {
 func (m *InfluxdbConfig) Reset()                    { *m = InfluxdbConfig{} }
 func (m *InfluxdbConfig) String() string            { return proto.CompactTextString(m) }
 func (*InfluxdbConfig) ProtoMessage()               {}
-func (*InfluxdbConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{7} }
+func (*InfluxdbConfig) Descriptor() ([]byte, []int) { return fileDescriptorConfig, []int{11} }
 
 func (m *InfluxdbConfig) GetHost() string {
 	if m != nil {
@@ -476,10 +1082,14 @@ func init() {
 	proto.RegisterType((*Config)(nil), "nebletpb.Config")
 	proto.RegisterType((*NetworkConfig)(nil), "nebletpb.NetworkConfig")
 	proto.RegisterType((*ChainConfig)(nil), "nebletpb.ChainConfig")
+	proto.RegisterType((*CheckpointConfig)(nil), "nebletpb.CheckpointConfig")
 	proto.RegisterType((*RPCConfig)(nil), "nebletpb.RPCConfig")
+	proto.RegisterType((*RPCListenerConfig)(nil), "nebletpb.RPCListenerConfig")
+	proto.RegisterType((*EventSchemaConfig)(nil), "nebletpb.EventSchemaConfig")
 	proto.RegisterType((*AppConfig)(nil), "nebletpb.AppConfig")
 	proto.RegisterType((*MiscConfig)(nil), "nebletpb.MiscConfig")
 	proto.RegisterType((*StatsConfig)(nil), "nebletpb.StatsConfig")
+	proto.RegisterType((*TracingConfig)(nil), "nebletpb.TracingConfig")
 	proto.RegisterType((*InfluxdbConfig)(nil), "nebletpb.InfluxdbConfig")
 	proto.RegisterEnum("nebletpb.StatsConfig_ReportingModule", StatsConfig_ReportingModule_name, StatsConfig_ReportingModule_value)
 }
@@ -487,55 +1097,129 @@ func init() {
 func init() { proto.RegisterFile("config.proto", fileDescriptorConfig) }
 
 var fileDescriptorConfig = []byte{
-	// 786 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xc1, 0x6e, 0x1b, 0x37,
-	0x10, 0xad, 0xe4, 0xd8, 0xd6, 0x8e, 0x64, 0xc5, 0x61, 0x9c, 0x98, 0x49, 0xd0, 0xc6, 0x5d, 0xc0,
-	0x80, 0x80, 0x00, 0x02, 0xea, 0xf6, 0xda, 0x43, 0x20, 0xa0, 0x80, 0x61, 0xbb, 0x30, 0xb6, 0xed,
-	0x79, 0x41, 0xed, 0x8e, 0x56, 0x84, 0xa9, 0x5d, 0x82, 0xa4, 0x9c, 0x18, 0xbd, 0xf4, 0x07, 0xfa,
-	0x67, 0x3d, 0xf4, 0x93, 0x8a, 0x99, 0xe5, 0xae, 0x6c, 0xa3, 0x37, 0xce, 0x7b, 0x8f, 0x43, 0xce,
-	0xbc, 0x21, 0x61, 0x52, 0x34, 0xf5, 0x4a, 0x57, 0x73, 0xeb, 0x9a, 0xd0, 0x88, 0x51, 0x8d, 0x4b,
-	0x83, 0xc1, 0x2e, 0xd3, 0xbf, 0x87, 0x70, 0xb0, 0x60, 0x4a, 0xfc, 0x00, 0x87, 0x35, 0x86, 0x2f,
-	0x8d, 0xbb, 0x93, 0x83, 0xb3, 0xc1, 0x6c, 0x7c, 0x71, 0x3a, 0xef, 0x64, 0xf3, 0x5f, 0x5b, 0xa2,
-	0x55, 0x66, 0x9d, 0x4e, 0x7c, 0x82, 0xfd, 0x62, 0xad, 0x74, 0x2d, 0x87, 0xbc, 0xe1, 0xcd, 0x6e,
-	0xc3, 0x82, 0xe0, 0x28, 0x6f, 0x35, 0xe2, 0x1c, 0xf6, 0x9c, 0x2d, 0xe4, 0x1e, 0x4b, 0x5f, 0xef,
-	0xa4, 0xd9, 0xed, 0x22, 0x0a, 0x89, 0xa7, 0x9c, 0x3e, 0xa8, 0xe0, 0x65, 0xf9, 0x3c, 0xe7, 0x6f,
-	0x04, 0x77, 0x39, 0x59, 0x23, 0x66, 0xf0, 0x62, 0xa3, 0x7d, 0x21, 0x91, 0xb5, 0x27, 0x3b, 0xed,
-	0x8d, 0xf6, 0x45, 0x94, 0xb2, 0x82, 0x4e, 0x57, 0xd6, 0xca, 0xd5, 0xf3, 0xd3, 0x3f, 0x5b, 0xdb,
-	0x9d, 0xae, 0xac, 0x4d, 0xff, 0x84, 0xa3, 0x27, 0xb5, 0x0a, 0x01, 0x2f, 0x3c, 0x62, 0x29, 0x07,
-	0x67, 0x7b, 0xb3, 0x24, 0xe3, 0xb5, 0x78, 0x0b, 0x07, 0x46, 0xfb, 0x80, 0x54, 0x37, 0xa1, 0x31,
-	0x12, 0x1f, 0x61, 0x6c, 0x9d, 0xbe, 0x57, 0x01, 0xf3, 0x3b, 0x7c, 0xe0, 0x4a, 0x93, 0x0c, 0x22,
-	0x74, 0x85, 0x0f, 0xe2, 0x5b, 0x80, 0xd8, 0xba, 0x5c, 0x97, 0xf2, 0xc5, 0xd9, 0x60, 0x76, 0x94,
-	0x25, 0x11, 0xb9, 0x2c, 0xd3, 0x7f, 0x86, 0x30, 0x7e, 0xd4, 0x38, 0xf1, 0x0e, 0x46, 0xdc, 0x3a,
-	0x12, 0x0f, 0x58, 0x7c, 0xc8, 0xf1, 0x65, 0x29, 0x24, 0x1c, 0x56, 0x58, 0xa3, 0xd7, 0x9e, 0x7b,
-	0x9f, 0x64, 0x5d, 0x48, 0x4c, 0xa9, 0x82, 0x2a, 0xb5, 0x93, 0xe3, 0x96, 0x89, 0x21, 0x5d, 0xfb,
-	0x0e, 0x1f, 0x88, 0x98, 0x30, 0x11, 0x23, 0xba, 0x95, 0x0f, 0xca, 0x85, 0x7c, 0xa3, 0x6b, 0x94,
-	0x27, 0x67, 0x83, 0xd9, 0x28, 0x4b, 0x18, 0xb9, 0xd1, 0x35, 0x8a, 0xf7, 0x30, 0x2a, 0x1a, 0x5d,
-	0x2f, 0x95, 0x47, 0xf9, 0x86, 0x37, 0xf6, 0xb1, 0x38, 0x81, 0x7d, 0xda, 0xe4, 0xe4, 0x5b, 0x26,
-	0xda, 0x40, 0x7c, 0x07, 0x60, 0x95, 0xf7, 0x76, 0xed, 0x68, 0xcf, 0x69, 0x6c, 0x43, 0x8f, 0x88,
-	0x0f, 0x90, 0x54, 0xca, 0xe7, 0xd6, 0xe9, 0x02, 0xa5, 0x6c, 0x53, 0x56, 0xca, 0xdf, 0x52, 0xdc,
-	0x91, 0x46, 0x6f, 0x74, 0x90, 0xef, 0x7a, 0xf2, 0x9a, 0x62, 0xf1, 0x09, 0x5e, 0x79, 0x5d, 0xd5,
-	0x2a, 0x6c, 0x1d, 0xe6, 0x85, 0xb6, 0x6b, 0x74, 0x5e, 0xbe, 0x67, 0x13, 0x8e, 0x7b, 0x62, 0xd1,
-	0xe2, 0xa9, 0x81, 0xa4, 0x9f, 0x2d, 0x2a, 0xd2, 0xd9, 0x22, 0x8f, 0xbe, 0xb5, 0x6e, 0x26, 0xce,
-	0x16, 0xd7, 0xbd, 0x75, 0xeb, 0x10, 0x6c, 0xfe, 0xc4, 0x57, 0x20, 0xe8, 0x99, 0x60, 0xd3, 0x94,
-	0x5b, 0x83, 0x72, 0x6f, 0x27, 0xb8, 0x61, 0x24, 0xfd, 0x77, 0x00, 0x49, 0x3f, 0x4c, 0x54, 0x85,
-	0x69, 0xaa, 0xdc, 0xe0, 0x3d, 0x1a, 0xf6, 0x2e, 0xc9, 0x46, 0xa6, 0xa9, 0xae, 0x29, 0x26, 0x5f,
-	0x89, 0x5c, 0x69, 0x83, 0x9d, 0x7b, 0xa6, 0xa9, 0x7e, 0xd1, 0x06, 0xc5, 0x29, 0xd0, 0x32, 0x57,
-	0x15, 0xf2, 0xf8, 0x1c, 0x65, 0x07, 0xa6, 0xa9, 0x3e, 0x57, 0x28, 0xe6, 0xf0, 0x1a, 0x6b, 0xb5,
-	0x34, 0x98, 0x17, 0x4e, 0xf9, 0x75, 0xee, 0xd0, 0x36, 0x2e, 0xf0, 0x0c, 0x8d, 0xb2, 0x57, 0x2d,
-	0xb5, 0x20, 0x26, 0x63, 0x42, 0xcc, 0xe0, 0xf8, 0xb1, 0x30, 0xdf, 0x3a, 0x23, 0xf7, 0xf9, 0xac,
-	0x69, 0xb1, 0x93, 0xfd, 0xe1, 0x0c, 0x0d, 0xcc, 0x3d, 0x3a, 0xaf, 0x9b, 0x9a, 0x9f, 0x5c, 0x92,
-	0x75, 0x61, 0x7a, 0x05, 0xb0, 0x7b, 0x47, 0xe2, 0x67, 0xf8, 0x50, 0xe2, 0x4a, 0x6d, 0x4d, 0xa0,
-	0xe9, 0xf6, 0xa1, 0x71, 0xc8, 0x25, 0x90, 0x0f, 0xe8, 0x62, 0x91, 0x32, 0x4a, 0xae, 0xa2, 0x82,
-	0x8a, 0x5a, 0x10, 0x9f, 0xfe, 0x35, 0x84, 0xf1, 0xa3, 0x17, 0x2c, 0xce, 0x61, 0x1a, 0x0b, 0xda,
-	0x60, 0x70, 0xba, 0xf0, 0x9c, 0x61, 0x94, 0x1d, 0xb5, 0xe8, 0x4d, 0x0b, 0x8a, 0x5b, 0x38, 0x6e,
-	0x2b, 0xd0, 0x75, 0xd5, 0x35, 0x9f, 0xdc, 0x99, 0x5e, 0x9c, 0xff, 0xef, 0xcf, 0x30, 0xcf, 0x3a,
-	0x75, 0xeb, 0x4b, 0xf6, 0xd2, 0x3d, 0x05, 0xc4, 0x4f, 0x30, 0xd2, 0xf5, 0xca, 0x6c, 0xbf, 0x96,
-	0x4b, 0x7e, 0x21, 0xe3, 0x0b, 0xb9, 0xcb, 0x74, 0x19, 0x99, 0xf8, 0x27, 0xf4, 0x4a, 0xf1, 0x3d,
-	0x4c, 0xe2, 0x3d, 0xf3, 0xa0, 0x2a, 0x2f, 0x27, 0x3c, 0x00, 0xe3, 0x88, 0xfd, 0xae, 0x2a, 0x9f,
-	0x7e, 0x84, 0x97, 0xcf, 0x0e, 0x17, 0x13, 0x18, 0x75, 0x19, 0x8f, 0xbf, 0x49, 0xbf, 0xc2, 0xf4,
-	0x69, 0x7e, 0xfa, 0x5d, 0xd6, 0x8d, 0x0f, 0xb1, 0x79, 0xbc, 0x26, 0x8c, 0xad, 0x1d, 0xb2, 0xff,
-	0xbc, 0x16, 0x53, 0x18, 0x96, 0xcb, 0xf8, 0xa1, 0x0c, 0xcb, 0x25, 0x69, 0xb6, 0x1e, 0x1d, 0xdb,
-	0x9f, 0x64, 0xbc, 0xa6, 0x77, 0x4a, 0x6f, 0xec, 0x4b, 0xe3, 0xca, 0xe8, 0x74, 0x1f, 0x2f, 0x0f,
-	0xf8, 0xdf, 0xff, 0xf1, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xed, 0xb8, 0xca, 0xb2, 0x07, 0x06,
-	0x00, 0x00,
+	// 1972 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x58, 0xdd, 0x72, 0x1c, 0xb7,
+	0xd1, 0xfd, 0x96, 0x94, 0xc4, 0x5d, 0x2c, 0x77, 0x49, 0x0e, 0x29, 0x19, 0x96, 0x6c, 0x8b, 0x5e,
+	0x7f, 0x92, 0x18, 0x4b, 0x62, 0x6c, 0xca, 0x55, 0xf9, 0xa9, 0x24, 0x15, 0x89, 0xa5, 0x24, 0x2c,
+	0x91, 0x32, 0x6b, 0x48, 0xe7, 0x16, 0x85, 0x9d, 0x69, 0xce, 0xa2, 0x38, 0x03, 0x8c, 0x01, 0xcc,
+	0x6a, 0xa9, 0x77, 0xc8, 0x43, 0xa4, 0x2a, 0x6f, 0x90, 0x17, 0xc9, 0x75, 0x9e, 0x26, 0xd5, 0x0d,
+	0xcc, 0xfe, 0xd0, 0xba, 0x1b, 0x9c, 0x73, 0xd0, 0x8b, 0x6e, 0x34, 0xba, 0x81, 0x65, 0x9b, 0x99,
+	0xd1, 0x57, 0xaa, 0x38, 0xac, 0xad, 0xf1, 0x26, 0xe9, 0x6a, 0x18, 0x97, 0xe0, 0xeb, 0xf1, 0xe8,
+	0x1f, 0x6b, 0xec, 0xde, 0x31, 0x51, 0xc9, 0xf7, 0x6c, 0x43, 0x83, 0xff, 0x60, 0xec, 0x35, 0xef,
+	0xec, 0x77, 0x0e, 0xfa, 0x47, 0x9f, 0x1d, 0xb6, 0xb2, 0xc3, 0xf7, 0x81, 0x08, 0xca, 0xb4, 0xd5,
+	0x25, 0xcf, 0xd9, 0xdd, 0x6c, 0x22, 0x95, 0xe6, 0x6b, 0x34, 0xe1, 0xfe, 0x62, 0xc2, 0x31, 0xc2,
+	0x51, 0x1e, 0x34, 0xc9, 0x13, 0xb6, 0x6e, 0xeb, 0x8c, 0xaf, 0x93, 0x74, 0x77, 0x21, 0x4d, 0xcf,
+	0x8f, 0xa3, 0x10, 0x79, 0xb4, 0xe9, 0xbc, 0xf4, 0x8e, 0xe7, 0xb7, 0x6d, 0x5e, 0x20, 0xdc, 0xda,
+	0x24, 0x4d, 0x72, 0xc0, 0xee, 0x54, 0xca, 0x65, 0x1c, 0x48, 0xbb, 0xb7, 0xd0, 0x9e, 0x29, 0x97,
+	0x45, 0x29, 0x29, 0xf0, 0xd7, 0x65, 0x5d, 0xf3, 0xab, 0xdb, 0xbf, 0xfe, 0xba, 0xae, 0xdb, 0x5f,
+	0x97, 0x75, 0x3d, 0xfa, 0xef, 0x3a, 0x1b, 0xac, 0x38, 0x9b, 0x24, 0xec, 0x8e, 0x03, 0xc8, 0x79,
+	0x67, 0x7f, 0xfd, 0xa0, 0x97, 0xd2, 0x77, 0xf2, 0x80, 0xdd, 0x2b, 0x95, 0xf3, 0x80, 0x8e, 0x23,
+	0x1a, 0x47, 0xc9, 0x63, 0xd6, 0xaf, 0xad, 0x9a, 0x4a, 0x0f, 0xe2, 0x1a, 0x6e, 0xc8, 0xd5, 0x5e,
+	0xca, 0x22, 0xf4, 0x0e, 0x6e, 0x92, 0x2f, 0x19, 0x8b, 0xb1, 0x13, 0x2a, 0xe7, 0x77, 0xf6, 0x3b,
+	0x07, 0x83, 0xb4, 0x17, 0x91, 0x93, 0x1c, 0x69, 0x59, 0x96, 0xe6, 0x83, 0x40, 0x7b, 0xfc, 0x2e,
+	0xd9, 0xee, 0x11, 0x72, 0xaa, 0x9c, 0x4f, 0x1e, 0xb1, 0x5e, 0x0e, 0xfa, 0x26, 0xb0, 0xf7, 0x88,
+	0xed, 0x22, 0x40, 0xe4, 0x63, 0xd6, 0xcf, 0x95, 0x93, 0xe3, 0x12, 0x84, 0x96, 0x9e, 0x6f, 0xec,
+	0x77, 0x0e, 0xba, 0x29, 0x8b, 0xd0, 0x7b, 0xe9, 0x93, 0xaf, 0xd9, 0x26, 0x06, 0x4d, 0x65, 0xa2,
+	0x06, 0xb0, 0x8e, 0x77, 0xc9, 0x40, 0x3f, 0x60, 0xe7, 0x08, 0x25, 0xdf, 0xb0, 0x81, 0xb7, 0x8d,
+	0xf3, 0x90, 0x47, 0x4d, 0x8f, 0x34, 0x9b, 0x11, 0x0c, 0xa2, 0xcf, 0x59, 0x37, 0xd7, 0x4e, 0x50,
+	0x50, 0x18, 0xf1, 0x1b, 0xb9, 0x76, 0x17, 0x18, 0x97, 0xa7, 0x6c, 0xab, 0xa5, 0x44, 0xdd, 0x8c,
+	0x31, 0x06, 0x7d, 0x8a, 0xc1, 0x20, 0x2a, 0xce, 0x09, 0x44, 0x47, 0x2a, 0x39, 0x13, 0x99, 0xd1,
+	0xda, 0xf1, 0x4d, 0x8a, 0x42, 0xb7, 0x92, 0xb3, 0x63, 0x1c, 0x27, 0xdf, 0xb2, 0x1d, 0x24, 0x95,
+	0x1e, 0x9b, 0x46, 0xe7, 0x51, 0x34, 0x20, 0xd1, 0x56, 0x25, 0x67, 0x27, 0x01, 0x0f, 0xda, 0x17,
+	0x2c, 0x41, 0xad, 0x69, 0xfc, 0xb2, 0x78, 0x48, 0xe2, 0xed, 0x4a, 0xce, 0x7e, 0x6c, 0xfc, 0x42,
+	0x3d, 0xfa, 0x17, 0x63, 0xfd, 0xa5, 0xc4, 0x44, 0x4f, 0x28, 0x35, 0x71, 0x2f, 0x3a, 0x34, 0x67,
+	0x83, 0xc6, 0x27, 0x79, 0xc2, 0xd9, 0x46, 0x01, 0x1a, 0x9c, 0x72, 0x94, 0xdb, 0xbd, 0xb4, 0x1d,
+	0x22, 0x93, 0x4b, 0x2f, 0x73, 0x65, 0xa3, 0x6f, 0xed, 0x10, 0xb3, 0xe2, 0x1a, 0x6e, 0x90, 0xd8,
+	0x24, 0x22, 0x8e, 0x70, 0x57, 0x9d, 0x97, 0xd6, 0x8b, 0x4a, 0x69, 0xe0, 0x7b, 0xb4, 0x31, 0x3d,
+	0x42, 0xce, 0x94, 0x86, 0xe4, 0x21, 0xeb, 0x66, 0x46, 0xe9, 0xb1, 0x74, 0xc0, 0xef, 0xd3, 0xc4,
+	0xf9, 0x38, 0xd9, 0x63, 0x77, 0x71, 0x92, 0xe5, 0x0f, 0x88, 0x08, 0x83, 0xe4, 0x2b, 0xc6, 0x6a,
+	0xe9, 0x5c, 0x3d, 0xb1, 0x38, 0xe7, 0xb3, 0x98, 0x65, 0x73, 0x04, 0xc3, 0x5b, 0x48, 0x27, 0x6a,
+	0xab, 0x32, 0xe0, 0x3c, 0x98, 0x2c, 0xa4, 0x3b, 0xc7, 0x71, 0x4b, 0x96, 0xaa, 0x52, 0x9e, 0x7f,
+	0x3e, 0x27, 0x4f, 0x71, 0x9c, 0x3c, 0x67, 0x3b, 0x4e, 0x15, 0x5a, 0xfa, 0xc6, 0x82, 0xc8, 0x54,
+	0x3d, 0xc1, 0x24, 0x78, 0x48, 0x9b, 0xbc, 0x3d, 0x27, 0x8e, 0x03, 0x9e, 0x7c, 0xc7, 0xf6, 0x60,
+	0x06, 0x59, 0xe3, 0x95, 0xd1, 0xc2, 0x82, 0x6b, 0x4a, 0x2f, 0x4a, 0x53, 0xf0, 0x47, 0xe4, 0x61,
+	0x32, 0xe7, 0x52, 0xa2, 0x4e, 0x4d, 0x81, 0xf9, 0xe5, 0xea, 0x52, 0x79, 0xe1, 0xbc, 0xb1, 0xb2,
+	0x00, 0xfe, 0x05, 0x49, 0x37, 0x09, 0xbc, 0x08, 0x58, 0xf2, 0x84, 0x0d, 0x2d, 0x18, 0x5b, 0x90,
+	0xc9, 0x31, 0xae, 0xf2, 0x4b, 0x52, 0x0d, 0x08, 0x4d, 0x23, 0x88, 0x51, 0x25, 0x07, 0xc5, 0xb8,
+	0xa9, 0x6a, 0xfe, 0x55, 0x38, 0x4a, 0x84, 0xbc, 0x69, 0xaa, 0x1a, 0xb3, 0xfd, 0xaa, 0x21, 0x37,
+	0x82, 0xa7, 0x8f, 0x49, 0xd0, 0x0f, 0x58, 0x70, 0x76, 0x9f, 0x6d, 0xfa, 0x99, 0xa8, 0x8d, 0x29,
+	0x85, 0x53, 0x1f, 0x81, 0xef, 0x93, 0x84, 0xf9, 0xd9, 0xb9, 0x31, 0xe5, 0x85, 0xfa, 0x08, 0xc9,
+	0x01, 0xdb, 0x96, 0x59, 0x66, 0x1a, 0xed, 0x85, 0x9f, 0x45, 0x43, 0x5f, 0x93, 0x6a, 0x18, 0xf1,
+	0xcb, 0x59, 0xb0, 0xf5, 0x05, 0x63, 0x7e, 0x26, 0x30, 0x17, 0xd1, 0xad, 0x51, 0x48, 0x69, 0x3f,
+	0x3b, 0x93, 0xb3, 0xd7, 0x05, 0x60, 0x9a, 0xe2, 0x31, 0x03, 0x51, 0xdb, 0x46, 0x83, 0x18, 0x97,
+	0x26, 0xbb, 0x76, 0xfc, 0x9b, 0x90, 0xa6, 0xc4, 0x9c, 0x23, 0xf1, 0x86, 0x70, 0xdc, 0x21, 0x6d,
+	0x72, 0x10, 0x95, 0xc9, 0x81, 0xff, 0x7f, 0xd8, 0x21, 0x04, 0xce, 0x4c, 0x0e, 0xc9, 0x1f, 0x58,
+	0x3f, 0x9b, 0x40, 0x76, 0x5d, 0x1b, 0xa5, 0xbd, 0xe3, 0x4f, 0xf6, 0xd7, 0x0f, 0xfa, 0x47, 0x0f,
+	0x97, 0x0b, 0x6f, 0x4b, 0xc6, 0xb2, 0xb6, 0x2c, 0x4f, 0x5e, 0xb1, 0x07, 0x5e, 0xda, 0x02, 0x7c,
+	0x58, 0x83, 0x58, 0x64, 0xc2, 0xd3, 0xfd, 0xce, 0xc1, 0x9d, 0x74, 0x37, 0xb0, 0xb4, 0x90, 0xbf,
+	0xb6, 0x49, 0xf1, 0x8c, 0x6d, 0xb5, 0x51, 0x98, 0x28, 0xdc, 0xb9, 0x1b, 0xfe, 0x8c, 0x76, 0xa4,
+	0x0d, 0xc2, 0xdf, 0x02, 0x8a, 0xdb, 0x6b, 0xa1, 0x32, 0x1e, 0x04, 0xe6, 0x0a, 0x58, 0x7e, 0x40,
+	0x8b, 0xdf, 0x0c, 0xe0, 0x05, 0x61, 0xc9, 0x36, 0x5b, 0xcf, 0x61, 0xca, 0x7f, 0x45, 0x16, 0xf0,
+	0x33, 0xf9, 0x82, 0xf5, 0x32, 0xa3, 0x1d, 0x68, 0xd7, 0x38, 0xfe, 0x2d, 0x4d, 0x59, 0x00, 0x98,
+	0x92, 0xa5, 0x1a, 0x0b, 0xea, 0x5f, 0xb6, 0x92, 0x98, 0x50, 0x8e, 0x3f, 0x0f, 0xa1, 0x2b, 0xd5,
+	0xf8, 0x78, 0x19, 0x4f, 0x5e, 0xb2, 0xc4, 0xdf, 0xd4, 0xe0, 0x32, 0xab, 0x6a, 0x2f, 0xa6, 0x60,
+	0x9d, 0x32, 0x9a, 0xbf, 0x20, 0x9b, 0x3b, 0x0b, 0xe6, 0xef, 0x81, 0x48, 0x8e, 0xd8, 0x7d, 0x3d,
+	0xad, 0xc4, 0x22, 0x8b, 0xbd, 0xaa, 0xc0, 0x34, 0x9e, 0xbf, 0x24, 0xfb, 0xbb, 0x7a, 0x5a, 0xbd,
+	0x6d, 0xb9, 0xcb, 0x40, 0x61, 0x4e, 0xe0, 0x9c, 0x0a, 0x2a, 0x63, 0x6f, 0x62, 0xf0, 0x0e, 0x29,
+	0x78, 0x43, 0x3d, 0xad, 0xce, 0x08, 0x0e, 0x71, 0x8b, 0xd6, 0x95, 0x76, 0xde, 0x36, 0x19, 0xd9,
+	0x0f, 0xf2, 0x5f, 0x87, 0x58, 0xeb, 0x69, 0x75, 0xb2, 0xe0, 0xc2, 0x9c, 0x17, 0x2c, 0x59, 0x09,
+	0xa1, 0xc8, 0xc0, 0x7a, 0xfe, 0x1d, 0x39, 0xb0, 0xbd, 0x1c, 0xc7, 0x63, 0xb0, 0x1e, 0x4b, 0xe5,
+	0xaa, 0x1a, 0x2b, 0xee, 0xf7, 0x24, 0xde, 0x5a, 0x16, 0x63, 0xeb, 0x39, 0x60, 0xdb, 0xb7, 0x2c,
+	0x4b, 0x7e, 0x44, 0xd2, 0xe1, 0x8a, 0x5d, 0x39, 0xfa, 0x13, 0xdb, 0xbe, 0x9d, 0x45, 0x58, 0xdb,
+	0x26, 0xa0, 0x8a, 0x89, 0xa7, 0x42, 0x79, 0x27, 0x8d, 0x23, 0xec, 0x8e, 0x13, 0xe9, 0x26, 0xb1,
+	0x48, 0xd2, 0xf7, 0xe8, 0x9f, 0x5d, 0xd6, 0x9b, 0x37, 0x75, 0x3c, 0xa7, 0xb6, 0xce, 0x44, 0xec,
+	0x97, 0xa1, 0x8b, 0xf6, 0x6c, 0x9d, 0x9d, 0xce, 0x5b, 0xe6, 0xc4, 0xfb, 0x5a, 0xac, 0xf4, 0x53,
+	0x86, 0xd0, 0x2d, 0x41, 0x65, 0xf2, 0xa6, 0x04, 0xbe, 0xbe, 0x10, 0x9c, 0x11, 0x92, 0xbc, 0x64,
+	0xbb, 0x16, 0x64, 0x7e, 0x43, 0xa7, 0x2f, 0xa4, 0x75, 0x29, 0x8b, 0xd8, 0x5c, 0xb7, 0x89, 0x3a,
+	0x93, 0x33, 0x4a, 0xe9, 0x53, 0x59, 0x24, 0x7f, 0x66, 0x03, 0x98, 0x82, 0xf6, 0xc2, 0x65, 0x13,
+	0xa8, 0xa4, 0xa3, 0x36, 0xdb, 0x3f, 0x7a, 0xb4, 0x38, 0x42, 0x6f, 0x91, 0xbe, 0x20, 0x36, 0x9e,
+	0xa1, 0x4d, 0x58, 0x40, 0x0e, 0x3d, 0x02, 0x3f, 0x69, 0x57, 0x1c, 0xfa, 0x70, 0x0f, 0xfc, 0x24,
+	0x2e, 0xf8, 0x9c, 0x6d, 0x55, 0xe0, 0x27, 0x26, 0x6f, 0xb3, 0xc9, 0xf1, 0x0d, 0xfa, 0x89, 0x67,
+	0x9f, 0xb8, 0xf3, 0x1c, 0x9e, 0x91, 0x34, 0x26, 0x97, 0x7b, 0xab, 0xbd, 0xbd, 0x49, 0x87, 0xd5,
+	0x0a, 0x88, 0x21, 0x68, 0xb4, 0x9a, 0x09, 0x67, 0xb2, 0x6b, 0xf0, 0xbc, 0x1b, 0x0a, 0x3e, 0x42,
+	0x17, 0x84, 0xe0, 0xde, 0x52, 0x8c, 0x96, 0x55, 0xbd, 0xb0, 0xb7, 0x88, 0xff, 0xb4, 0xa2, 0x5c,
+	0x12, 0x85, 0x12, 0xc3, 0x42, 0x45, 0x5b, 0xd8, 0xa3, 0x42, 0xf3, 0x94, 0x6d, 0xc9, 0xbc, 0x52,
+	0x3a, 0x18, 0x35, 0xba, 0x0c, 0xbd, 0xbc, 0x9b, 0x0e, 0x08, 0x46, 0x9b, 0x3f, 0xea, 0x92, 0xf2,
+	0x0a, 0x03, 0x5f, 0x81, 0x73, 0xb2, 0x80, 0x50, 0x49, 0x43, 0x4b, 0x1f, 0x56, 0x72, 0x76, 0x16,
+	0x60, 0xaa, 0xa6, 0xbf, 0x61, 0x3c, 0x76, 0x7d, 0x6f, 0x65, 0xe6, 0x85, 0x33, 0x8d, 0xcd, 0xe2,
+	0x8c, 0xd0, 0xdf, 0xef, 0x87, 0x4b, 0x00, 0xd1, 0x17, 0xc4, 0xd2, 0xc4, 0x57, 0xec, 0xc1, 0xca,
+	0x44, 0x69, 0x0b, 0x17, 0xa6, 0x85, 0x4e, 0xbf, 0xbb, 0x34, 0xed, 0xb5, 0x2d, 0x1c, 0x4d, 0xfa,
+	0x21, 0x4c, 0x1a, 0x4b, 0x9f, 0x4d, 0x84, 0xb7, 0x52, 0x3b, 0x99, 0x85, 0xe2, 0xb1, 0x45, 0x93,
+	0xf6, 0x2a, 0x39, 0x7b, 0x83, 0xe4, 0xe5, 0x12, 0x87, 0x05, 0xa4, 0xb6, 0x06, 0xe3, 0x0f, 0x8d,
+	0x13, 0x15, 0x78, 0xab, 0x32, 0xc7, 0xb7, 0xc9, 0xf1, 0x9d, 0x05, 0x73, 0x16, 0x08, 0x3c, 0xe2,
+	0xae, 0x19, 0x63, 0x51, 0x19, 0x63, 0x23, 0xba, 0xba, 0x02, 0x1b, 0x16, 0xb6, 0x13, 0x16, 0x36,
+	0x27, 0xdf, 0x10, 0x47, 0x0b, 0xfb, 0x1d, 0xeb, 0x85, 0xd4, 0xc1, 0xde, 0x9a, 0xdc, 0x4e, 0xbe,
+	0xf4, 0xfc, 0xf8, 0x34, 0xb2, 0x31, 0xf9, 0x16, 0x6a, 0xf4, 0xc9, 0xe1, 0xf5, 0xd0, 0xc2, 0xcf,
+	0x0d, 0x38, 0x2f, 0xfc, 0xc4, 0x82, 0x9b, 0x98, 0x32, 0xe7, 0xbb, 0xc1, 0x27, 0x64, 0xd3, 0x40,
+	0x5e, 0xb6, 0x1c, 0xee, 0xd0, 0xca, 0x2c, 0xec, 0xd1, 0x7b, 0x21, 0x3b, 0x96, 0xf4, 0xd8, 0x9f,
+	0x9f, 0xb0, 0xe1, 0x95, 0xd2, 0xb2, 0x54, 0x1f, 0x21, 0x0f, 0x5b, 0x7e, 0x3f, 0x6c, 0xf9, 0x1c,
+	0xc5, 0x2d, 0x7f, 0xf8, 0x9a, 0xed, 0x7e, 0x22, 0x6d, 0xb1, 0xb2, 0x63, 0xfd, 0xe9, 0x90, 0x69,
+	0xfc, 0xc4, 0xeb, 0xcb, 0x54, 0x96, 0x0d, 0x50, 0x79, 0x18, 0xa4, 0x61, 0xf0, 0xfb, 0xb5, 0xdf,
+	0x76, 0x46, 0x27, 0x6c, 0xe7, 0x17, 0x9e, 0xe2, 0xd5, 0x4a, 0xe6, 0xb9, 0x05, 0xe7, 0xa2, 0x91,
+	0x76, 0x88, 0x77, 0x24, 0x07, 0x76, 0xaa, 0x32, 0x70, 0xb1, 0x44, 0xcc, 0xc7, 0xa3, 0xd7, 0x6c,
+	0xe7, 0x17, 0x27, 0x16, 0x7f, 0xd9, 0x9b, 0x5a, 0x65, 0xd1, 0x50, 0x18, 0x60, 0x15, 0x0b, 0xa7,
+	0x3e, 0xd6, 0xab, 0x38, 0x1a, 0xfd, 0xa7, 0xc3, 0x7a, 0xf3, 0x87, 0x00, 0xf6, 0xdf, 0xd2, 0x14,
+	0xa2, 0x84, 0x29, 0x94, 0x71, 0x7e, 0xb7, 0x34, 0xc5, 0x29, 0x8e, 0xf1, 0xce, 0x88, 0xe4, 0x95,
+	0x2a, 0xa1, 0xbd, 0x19, 0x96, 0xa6, 0xf8, 0x8b, 0x2a, 0x21, 0xf9, 0x8c, 0xe1, 0x27, 0x5d, 0x00,
+	0xd6, 0xc9, 0xdf, 0x7b, 0xa5, 0x29, 0xb0, 0xfd, 0x1f, 0xb2, 0x5d, 0xd0, 0x74, 0x33, 0xcf, 0xac,
+	0x74, 0x13, 0x61, 0xa1, 0x36, 0xd6, 0x53, 0x85, 0xea, 0xa6, 0x3b, 0x81, 0x3a, 0x46, 0x26, 0x25,
+	0x02, 0x37, 0x6c, 0x59, 0x28, 0x1a, 0x5b, 0xf2, 0xbb, 0x61, 0xc3, 0xb2, 0x85, 0xec, 0x27, 0x5b,
+	0x62, 0xc4, 0xda, 0x26, 0x97, 0x87, 0xc5, 0xc4, 0xe1, 0xe8, 0x1d, 0x63, 0x8b, 0x37, 0x50, 0xf2,
+	0x47, 0xf6, 0x28, 0x87, 0x2b, 0x89, 0x37, 0xb4, 0x6b, 0xb8, 0xc1, 0x6e, 0x0d, 0xe4, 0x02, 0xde,
+	0xf1, 0xc0, 0x46, 0x27, 0x79, 0x94, 0xbc, 0x8b, 0x0a, 0x74, 0xea, 0x18, 0xf9, 0xd1, 0xbf, 0xd7,
+	0x58, 0x7f, 0xe9, 0xf5, 0x85, 0x79, 0x12, 0x1d, 0x6a, 0x4f, 0x48, 0x27, 0xe4, 0x49, 0x40, 0xdb,
+	0xd3, 0x71, 0x8e, 0x2d, 0x07, 0x97, 0xaa, 0x74, 0xd1, 0xd6, 0x6f, 0xdc, 0xbd, 0xe1, 0xd1, 0x93,
+	0x4f, 0xbe, 0xea, 0x0e, 0xd3, 0x56, 0x1d, 0x4a, 0x3b, 0x36, 0xb1, 0x15, 0x20, 0xf9, 0x81, 0x75,
+	0x95, 0xbe, 0x2a, 0x9b, 0x59, 0x3e, 0xa6, 0x6a, 0xd4, 0x3f, 0xe2, 0x0b, 0x4b, 0x27, 0x91, 0x89,
+	0xe7, 0x66, 0xae, 0xc4, 0xbb, 0x60, 0x5c, 0xa7, 0xf0, 0xb2, 0xc0, 0x17, 0x07, 0xbd, 0x7c, 0x22,
+	0x76, 0x29, 0x0b, 0x87, 0x8f, 0x5f, 0x2c, 0x1f, 0x4a, 0x17, 0x7c, 0x70, 0xfb, 0xf1, 0x7b, 0x19,
+	0x88, 0xf6, 0xf1, 0x1b, 0x75, 0xa3, 0xc7, 0x6c, 0xeb, 0xd6, 0x7a, 0x93, 0x4d, 0xd6, 0x6d, 0x17,
+	0xb1, 0xfd, 0x7f, 0xa3, 0x9f, 0xd9, 0x60, 0x65, 0x2a, 0x66, 0x31, 0xe8, 0x9c, 0xda, 0x6a, 0x9b,
+	0x57, 0xed, 0x98, 0x5e, 0x67, 0x21, 0xa3, 0x85, 0x96, 0x55, 0x9b, 0x5b, 0xfd, 0x88, 0xbd, 0x97,
+	0x15, 0x90, 0x44, 0x56, 0x75, 0x09, 0xc2, 0xe2, 0x75, 0x87, 0x92, 0xac, 0x93, 0xf6, 0x03, 0x96,
+	0x22, 0x34, 0x9a, 0xb1, 0xe1, 0x6a, 0x14, 0xa8, 0x41, 0x1b, 0xd7, 0xfe, 0x1e, 0x7d, 0x23, 0x46,
+	0x09, 0x18, 0x4e, 0x25, 0x7d, 0x27, 0x43, 0xb6, 0x96, 0x8f, 0xe3, 0x8b, 0x75, 0x2d, 0x1f, 0xa3,
+	0xa6, 0x71, 0x60, 0x29, 0x49, 0x7b, 0x29, 0x7d, 0xe3, 0xfa, 0xf1, 0x95, 0xf1, 0xc1, 0xd8, 0x3c,
+	0xe6, 0xe3, 0x7c, 0x3c, 0xbe, 0x47, 0xff, 0x2c, 0xbc, 0xfa, 0xdf, 0x00, 0x6b, 0x36, 0x15, 0x4e,
+	0x69, 0x10, 0x00, 0x00,
 }
@@ -0,0 +1,161 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"net"
+	"strings"
+	"sync"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// Errors
+var (
+	ErrInvalidAccessControlEntry = errors.New("invalid access control entry, should be ip, cidr or peer id")
+	ErrPeerAccessDenied          = errors.New("peer access denied")
+)
+
+// accessList is a list of ip ranges and peer ids.
+type accessList struct {
+	nets  []*net.IPNet
+	peers map[string]bool
+}
+
+func newAccessList(entries []string) (*accessList, error) {
+	list := &accessList{
+		nets:  []*net.IPNet{},
+		peers: make(map[string]bool),
+	}
+	for _, v := range entries {
+		if strings.Contains(v, "/") {
+			_, ipnet, err := net.ParseCIDR(v)
+			if err != nil {
+				return nil, ErrInvalidAccessControlEntry
+			}
+			list.nets = append(list.nets, ipnet)
+			continue
+		}
+		if ip := net.ParseIP(v); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			list.nets = append(list.nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		if _, err := peer.IDB58Decode(v); err != nil {
+			return nil, ErrInvalidAccessControlEntry
+		}
+		list.peers[v] = true
+	}
+	return list, nil
+}
+
+func (list *accessList) empty() bool {
+	return len(list.nets) == 0 && len(list.peers) == 0
+}
+
+func (list *accessList) contains(pid peer.ID, ip net.IP) bool {
+	if list.peers[pid.Pretty()] {
+		return true
+	}
+	if ip == nil {
+		return false
+	}
+	for _, ipnet := range list.nets {
+		if ipnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// AccessControl filters the peers by ip cidr and peer id.
+// The denied peers are always rejected, if allow list is not empty,
+// only the peers in allow list are accepted.
+type AccessControl struct {
+	mu    sync.RWMutex
+	allow *accessList
+	deny  *accessList
+}
+
+// NewAccessControl return a new AccessControl.
+func NewAccessControl(allow, deny []string) (*AccessControl, error) {
+	ac := &AccessControl{}
+	if err := ac.Reload(allow, deny); err != nil {
+		return nil, err
+	}
+	return ac, nil
+}
+
+// Reload replace the allow and deny list.
+func (ac *AccessControl) Reload(allow, deny []string) error {
+	allowList, err := newAccessList(allow)
+	if err != nil {
+		return err
+	}
+	denyList, err := newAccessList(deny)
+	if err != nil {
+		return err
+	}
+
+	ac.mu.Lock()
+	defer ac.mu.Unlock()
+	ac.allow = allowList
+	ac.deny = denyList
+	return nil
+}
+
+// Allowed return if the peer with the address is allowed to connect.
+// The address may be nil before connected, then only peer id is checked.
+func (ac *AccessControl) Allowed(pid peer.ID, addr ma.Multiaddr) bool {
+	ip := ipFromMultiaddr(addr)
+
+	ac.mu.RLock()
+	defer ac.mu.RUnlock()
+
+	if ac.deny.contains(pid, ip) {
+		return false
+	}
+	if ac.allow.empty() {
+		return true
+	}
+	if addr == nil && len(ac.allow.nets) > 0 {
+		// ip is unknown until connected, check it later.
+		return true
+	}
+	return ac.allow.contains(pid, ip)
+}
+
+func ipFromMultiaddr(addr ma.Multiaddr) net.IP {
+	if addr == nil {
+		return nil
+	}
+	if v, err := addr.ValueForProtocol(ma.P_IP4); err == nil {
+		return net.ParseIP(v)
+	}
+	if v, err := addr.ValueForProtocol(ma.P_IP6); err == nil {
+		return net.ParseIP(v)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessControl(t *testing.T) {
+	pid1, _ := peer.IDB58Decode("QmPyr4ZbDmwF1nWxymTktdzspcBFPL6X1v3Q5nT7PGNtUN")
+	pid2, _ := peer.IDB58Decode("QmXtvEYFUQWcWVjunKdB3fgZZ17NGf2dDaBw6TXEs2tMrF")
+	addr1, _ := ma.NewMultiaddr("/ip4/192.168.1.10/tcp/8680")
+	addr2, _ := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/8680")
+
+	_, err := NewAccessControl([]string{"192.168.1.0/33"}, nil)
+	assert.Equal(t, ErrInvalidAccessControlEntry, err)
+	_, err = NewAccessControl(nil, []string{"invalid"})
+	assert.Equal(t, ErrInvalidAccessControlEntry, err)
+
+	ac, err := NewAccessControl(nil, nil)
+	assert.Nil(t, err)
+	assert.True(t, ac.Allowed(pid1, addr1))
+	assert.True(t, ac.Allowed(pid2, addr2))
+
+	assert.Nil(t, ac.Reload([]string{"192.168.1.0/24"}, []string{pid2.Pretty()}))
+	assert.True(t, ac.Allowed(pid1, addr1))
+	assert.True(t, ac.Allowed(pid1, nil))
+	assert.False(t, ac.Allowed(pid1, addr2))
+	assert.False(t, ac.Allowed(pid2, addr1))
+	assert.False(t, ac.Allowed(pid2, nil))
+
+	assert.Nil(t, ac.Reload([]string{pid2.Pretty()}, []string{"10.0.0.1"}))
+	assert.False(t, ac.Allowed(pid1, addr1))
+	assert.False(t, ac.Allowed(pid1, nil))
+	assert.True(t, ac.Allowed(pid2, addr1))
+	assert.False(t, ac.Allowed(pid2, addr2))
+}
@@ -23,8 +23,10 @@ import (
 	"net"
 	"time"
 
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 )
 
 // const
@@ -39,6 +41,9 @@ const (
 	DefaultStreamStoreSize        = 128
 	DefaultStreamStoreExtendSize  = 32
 	DefaultNetworkID              = 1
+	DefaultMaxConns               = DefaultStreamStoreSize + DefaultStreamStoreExtendSize
+	DefaultMaxInboundConns        = 96
+	DefaultMaxOutboundConns       = 64
 	DefaultRoutingTableDir        = ""
 )
 
@@ -68,6 +73,16 @@ type Config struct {
 	StreamStoreExtendSize int
 	NetworkID             uint32
 	RoutingTableDir       string
+	AllowList             []string
+	DenyList              []string
+	DisableNAT            bool
+	StaticPeers           []multiaddr.Multiaddr
+	TrustedPeers          []string
+	DNSSeeds              []string
+	DNSSeedPubKey         []byte
+	MaxConns              int
+	MaxInboundConns       int
+	MaxOutboundConns      int
 }
 
 // Neblet interface breaks cycle import dependency.
@@ -109,6 +124,57 @@ func NewP2PConfig(n Neblet) *Config {
 	}
 	config.RoutingTableDir = chainConf.Datadir
 
+	// access control list.
+	config.AllowList = networkConf.AllowList
+	config.DenyList = networkConf.DenyList
+
+	// port mapping on NAT.
+	config.DisableNAT = networkConf.DisableNat
+
+	// static peers, always kept connected.
+	if len(networkConf.StaticPeers) > 0 {
+		config.StaticPeers = make([]multiaddr.Multiaddr, len(networkConf.StaticPeers))
+		for i, v := range networkConf.StaticPeers {
+			addr, err := multiaddr.NewMultiaddr(v)
+			if err != nil {
+				panic(fmt.Sprintf("Invalid static peer address config: err is %s, config value is %s.", err, v))
+			}
+			if _, _, err := ParseFromIPFSAddr(addr); err != nil {
+				panic(fmt.Sprintf("Invalid static peer address config: err is %s, config value is %s.", err, v))
+			}
+			config.StaticPeers[i] = addr
+		}
+	}
+
+	// trusted peers, exempt from banning and connection limits.
+	for _, v := range networkConf.TrustedPeers {
+		if _, err := peer.IDB58Decode(v); err != nil {
+			panic(fmt.Sprintf("Invalid trusted peer config: err is %s, config value is %s.", err, v))
+		}
+	}
+	config.TrustedPeers = networkConf.TrustedPeers
+
+	// dns seeds, resolved to the signed seed peers.
+	if len(networkConf.DnsSeed) > 0 {
+		pubKey, err := byteutils.FromHex(networkConf.DnsSeedPubkey)
+		if err != nil || len(pubKey) == 0 {
+			panic(fmt.Sprintf("Invalid dns seed public key config: err is %s, config value is %s.", err, networkConf.DnsSeedPubkey))
+		}
+		config.DNSSeeds = networkConf.DnsSeed
+		config.DNSSeedPubKey = pubKey
+	}
+
+	// connection limits.
+	if networkConf.MaxConns > 0 {
+		config.MaxConns = int(networkConf.MaxConns)
+	}
+	if networkConf.MaxInboundConns > 0 {
+		config.MaxInboundConns = int(networkConf.MaxInboundConns)
+	}
+	if networkConf.MaxOutboundConns > 0 {
+		config.MaxOutboundConns = int(networkConf.MaxOutboundConns)
+	}
+
 	// seed server address.
 	seeds := networkConf.Seed
 	if len(seeds) > 0 {
@@ -157,5 +223,15 @@ func NewConfigFromDefaults() *Config {
 		DefaultStreamStoreExtendSize,
 		DefaultNetworkID,
 		DefaultRoutingTableDir,
+		[]string{},
+		[]string{},
+		false,
+		[]multiaddr.Multiaddr{},
+		[]string{},
+		[]string{},
+		nil,
+		DefaultMaxConns,
+		DefaultMaxInboundConns,
+		DefaultMaxOutboundConns,
 	}
 }
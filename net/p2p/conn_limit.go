@@ -0,0 +1,172 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// ConnLimitCheckInterval is the interval to evict the streams exceeding the connection limits.
+var ConnLimitCheckInterval = 30 * time.Second
+
+// Errors
+var (
+	ErrStreamEvicted = errors.New("stream evicted by connection limits")
+)
+
+// connSlot is a connection counted by the connection limits.
+type connSlot struct {
+	stream      *Stream
+	id          string
+	inbound     bool
+	exempt      bool
+	score       float64
+	connectedAt int64
+}
+
+// evictions returns the slots to close to keep the connections within the limits.
+// The lowest scoring peers are evicted first, the newest connected one if the scores
+// are equal. The static and trusted peers are counted but never evicted.
+func evictions(slots []*connSlot, maxConns, maxInbound, maxOutbound int) []*connSlot {
+	inbound, outbound := 0, 0
+	candidates := make([]*connSlot, 0, len(slots))
+	for _, v := range slots {
+		if v.inbound {
+			inbound++
+		} else {
+			outbound++
+		}
+		if !v.exempt {
+			candidates = append(candidates, v)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score < candidates[j].score
+		}
+		return candidates[i].connectedAt > candidates[j].connectedAt
+	})
+
+	evicted := make([]*connSlot, 0)
+	for _, v := range candidates {
+		overTotal := inbound+outbound > maxConns
+		overInbound := inbound > maxInbound
+		overOutbound := outbound > maxOutbound
+		if !overTotal && !overInbound && !overOutbound {
+			break
+		}
+		if v.inbound && (overTotal || overInbound) {
+			evicted = append(evicted, v)
+			inbound--
+		} else if !v.inbound && (overTotal || overOutbound) {
+			evicted = append(evicted, v)
+			outbound--
+		}
+	}
+	return evicted
+}
+
+// exemptFromConnLimits returns if the peer is a static or trusted peer.
+func (node *Node) exemptFromConnLimits(pid peer.ID) bool {
+	return node.staticPeers.contains(pid) || node.reputation.Trusted(pid.Pretty())
+}
+
+func (node *Node) connSlots() []*connSlot {
+	slots := make([]*connSlot, 0)
+	node.streamManager.allStreams.Range(func(key, value interface{}) bool {
+		stream := value.(*Stream)
+		slots = append(slots, &connSlot{
+			stream:      stream,
+			id:          stream.pid.Pretty(),
+			inbound:     stream.inbound,
+			exempt:      node.exemptFromConnLimits(stream.pid),
+			score:       node.reputation.Score(stream.pid.Pretty()),
+			connectedAt: stream.connectedAt,
+		})
+		return true
+	})
+	return slots
+}
+
+// acceptInbound returns if the new inbound stream from the peer is accepted, a lower
+// scoring peer is evicted for it if the inbound slots are full.
+func (node *Node) acceptInbound(pid peer.ID) bool {
+	if node.exemptFromConnLimits(pid) {
+		return true
+	}
+
+	incoming := &connSlot{
+		id:          pid.Pretty(),
+		inbound:     true,
+		score:       node.reputation.Score(pid.Pretty()),
+		connectedAt: time.Now().Unix(),
+	}
+	slots := append(node.connSlots(), incoming)
+	evicted := evictions(slots, node.config.MaxConns, node.config.MaxInboundConns, node.config.MaxOutboundConns)
+	for _, v := range evicted {
+		if v == incoming {
+			return false
+		}
+	}
+	node.evict(evicted)
+	return true
+}
+
+// outboundSlotAvailable returns if a new outbound stream is allowed.
+func (node *Node) outboundSlotAvailable() bool {
+	inbound, outbound := node.streamManager.CountByDirection()
+	return outbound < node.config.MaxOutboundConns && inbound+outbound < node.config.MaxConns
+}
+
+// enforceConnLimits evicts the streams exceeding the connection limits.
+func (node *Node) enforceConnLimits() {
+	node.evict(evictions(node.connSlots(), node.config.MaxConns, node.config.MaxInboundConns, node.config.MaxOutboundConns))
+}
+
+func (node *Node) evict(slots []*connSlot) {
+	for _, v := range slots {
+		metricsStreamEvicted.Mark(1)
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":     v.id,
+			"inbound": v.inbound,
+			"score":   v.score,
+		}).Debug("Evicted the stream exceeding the connection limits.")
+		v.stream.Close(ErrStreamEvicted)
+	}
+}
+
+func (node *Node) loop() {
+	ticker := time.NewTicker(ConnLimitCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-node.quitCh:
+			return
+		case <-ticker.C:
+			node.enforceConnLimits()
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func evictedIDs(slots []*connSlot) []string {
+	ids := make([]string, len(slots))
+	for i, v := range slots {
+		ids[i] = v.id
+	}
+	return ids
+}
+
+func TestConnLimitEvictions(t *testing.T) {
+	slots := []*connSlot{
+		{id: "in1", inbound: true, score: 0, connectedAt: 1},
+		{id: "in2", inbound: true, score: -50, connectedAt: 2},
+		{id: "in3", inbound: true, score: 0, connectedAt: 3},
+		{id: "in4", inbound: true, score: -80, exempt: true, connectedAt: 4},
+		{id: "out1", inbound: false, score: -10, connectedAt: 5},
+		{id: "out2", inbound: false, score: 0, connectedAt: 6},
+	}
+
+	// within the limits.
+	assert.Empty(t, evictions(slots, 6, 4, 2))
+
+	// the lowest scoring inbound peer, the exempt peer is kept.
+	assert.Equal(t, []string{"in2"}, evictedIDs(evictions(slots, 6, 3, 2)))
+
+	// the newest peer of the equal scores.
+	assert.Equal(t, []string{"in2", "in3"}, evictedIDs(evictions(slots, 6, 2, 2)))
+
+	// the outbound peers.
+	assert.Equal(t, []string{"out1"}, evictedIDs(evictions(slots, 6, 4, 1)))
+
+	// the total limit evicts the lowest scoring peers of both directions.
+	assert.Equal(t, []string{"in2", "out1", "out2"}, evictedIDs(evictions(slots, 3, 4, 2)))
+
+	// only the exempt peers are left.
+	assert.Equal(t, 5, len(evictions(slots, 0, 0, 0)))
+}
+
+func TestConnLimitIncoming(t *testing.T) {
+	slots := []*connSlot{
+		{id: "in1", inbound: true, score: 0, connectedAt: 1},
+		{id: "in2", inbound: true, score: -20, connectedAt: 2},
+	}
+
+	// a new peer replaces the lower scoring one.
+	incoming := &connSlot{id: "new", inbound: true, score: 0, connectedAt: 3}
+	assert.Equal(t, []string{"in2"}, evictedIDs(evictions(append(slots, incoming), 10, 2, 2)))
+
+	// a new peer is rejected if no peer is scored lower.
+	incoming = &connSlot{id: "new", inbound: true, score: -20, connectedAt: 3}
+	assert.Equal(t, []string{"new"}, evictedIDs(evictions(append(slots, incoming), 10, 2, 2)))
+}
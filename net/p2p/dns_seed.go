@@ -0,0 +1,174 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// DNSSeedRecordPrefix is the prefix of the TXT records of a DNS seed.
+// Each record is a signed seed peer, formatted as "nebseed=v1 <expires> <ipfsAddr> <signature>",
+// the expiration is a unix timestamp and the signature is base64 encoded. The signature covers
+// the prefix, the seed domain, the expiration and the address, so the records can be rotated
+// by the DNS server without a new release.
+const DNSSeedRecordPrefix = "nebseed=v1"
+
+// DNSSeedResolveInterval is the interval to resolve the DNS seeds again.
+var DNSSeedResolveInterval = 30 * time.Minute
+
+// DNS seed errors
+var (
+	ErrInvalidDNSSeedRecord    = errors.New("invalid dns seed record")
+	ErrDNSSeedRecordExpired    = errors.New("dns seed record expired")
+	ErrInvalidDNSSeedSignature = errors.New("invalid dns seed record signature")
+)
+
+func dnsSeedRecordHash(domain string, expires int64, addr string) []byte {
+	return hash.Sha3256([]byte(fmt.Sprintf("%s %s %d %s", DNSSeedRecordPrefix, domain, expires, addr)))
+}
+
+// SignDNSSeedRecord returns the TXT record of the seed peer address signed by the key.
+func SignDNSSeedRecord(priv keystore.PrivateKey, domain string, addr ma.Multiaddr, expires int64) (string, error) {
+	if _, _, err := ParseFromIPFSAddr(addr); err != nil {
+		return "", err
+	}
+	signature, err := crypto.NewSignature(priv.Algorithm())
+	if err != nil {
+		return "", err
+	}
+	if err := signature.InitSign(priv); err != nil {
+		return "", err
+	}
+	sig, err := signature.Sign(dnsSeedRecordHash(domain, expires, addr.String()))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %d %s %s", DNSSeedRecordPrefix, expires, addr, base64.StdEncoding.EncodeToString(sig)), nil
+}
+
+// ParseDNSSeedRecord verifies the TXT record of the seed domain signed by the public key,
+// and returns the ipfs address of the seed peer.
+func ParseDNSSeedRecord(domain string, record string, pubKey []byte, now time.Time) (ma.Multiaddr, error) {
+	fields := strings.Fields(record)
+	if len(fields) != 4 || fields[0] != DNSSeedRecordPrefix {
+		return nil, ErrInvalidDNSSeedRecord
+	}
+	expires, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil, ErrInvalidDNSSeedRecord
+	}
+	addr, err := ma.NewMultiaddr(fields[2])
+	if err != nil {
+		return nil, ErrInvalidDNSSeedRecord
+	}
+	if _, _, err := ParseFromIPFSAddr(addr); err != nil {
+		return nil, ErrInvalidDNSSeedRecord
+	}
+	sig, err := base64.StdEncoding.DecodeString(fields[3])
+	if err != nil {
+		return nil, ErrInvalidDNSSeedRecord
+	}
+
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := signature.RecoverPublic(dnsSeedRecordHash(domain, expires, fields[2]), sig)
+	if err != nil {
+		return nil, ErrInvalidDNSSeedSignature
+	}
+	signer, err := pub.Encoded()
+	if err != nil || !bytes.Equal(signer, pubKey) {
+		return nil, ErrInvalidDNSSeedSignature
+	}
+
+	if now.Unix() >= expires {
+		return nil, ErrDNSSeedRecordExpired
+	}
+	return addr, nil
+}
+
+// dnsSeeds resolves the seed peers from the TXT records of the seed domains.
+type dnsSeeds struct {
+	domains   []string
+	pubKey    []byte
+	lookupTXT func(domain string) ([]string, error)
+}
+
+func newDNSSeeds(domains []string, pubKey []byte) *dnsSeeds {
+	return &dnsSeeds{
+		domains:   domains,
+		pubKey:    pubKey,
+		lookupTXT: net.LookupTXT,
+	}
+}
+
+// resolve returns the verified seed peers, the invalid or expired records are ignored.
+func (ds *dnsSeeds) resolve() []ma.Multiaddr {
+	addrs := make([]ma.Multiaddr, 0)
+	now := time.Now()
+	for _, domain := range ds.domains {
+		records, err := ds.lookupTXT(domain)
+		if err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"domain": domain,
+				"err":    err,
+			}).Warn("Failed to resolve dns seed.")
+			continue
+		}
+
+		count := 0
+		for _, record := range records {
+			if !strings.HasPrefix(record, DNSSeedRecordPrefix) {
+				continue
+			}
+			addr, err := ParseDNSSeedRecord(domain, record, ds.pubKey, now)
+			if err != nil {
+				logging.VLog().WithFields(logrus.Fields{
+					"domain": domain,
+					"record": record,
+					"err":    err,
+				}).Debug("Ignored dns seed record.")
+				continue
+			}
+			addrs = append(addrs, addr)
+			count++
+		}
+
+		logging.VLog().WithFields(logrus.Fields{
+			"domain": domain,
+			"count":  count,
+		}).Debug("Resolved dns seed.")
+	}
+	return addrs
+}
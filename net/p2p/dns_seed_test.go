@@ -0,0 +1,109 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDNSSeedRecord(t *testing.T) {
+	priv := secp256k1.GeneratePrivateKey()
+	pub, _ := priv.PublicKey().Encoded()
+	other, _ := secp256k1.GeneratePrivateKey().PublicKey().Encoded()
+
+	addr, _ := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/8680/ipfs/QmPyr4ZbDmwF1nWxymTktdzspcBFPL6X1v3Q5nT7PGNtUN")
+	noID, _ := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/8680")
+	now := time.Unix(1500000000, 0)
+	expires := now.Add(time.Hour).Unix()
+
+	_, err := SignDNSSeedRecord(priv, "seed.example.com", noID, expires)
+	assert.NotNil(t, err)
+
+	record, err := SignDNSSeedRecord(priv, "seed.example.com", addr, expires)
+	assert.Nil(t, err)
+
+	got, err := ParseDNSSeedRecord("seed.example.com", record, pub, now)
+	assert.Nil(t, err)
+	assert.Equal(t, addr.String(), got.String())
+
+	// signed for another domain or by another key.
+	_, err = ParseDNSSeedRecord("seed.example.org", record, pub, now)
+	assert.Equal(t, ErrInvalidDNSSeedSignature, err)
+	_, err = ParseDNSSeedRecord("seed.example.com", record, other, now)
+	assert.Equal(t, ErrInvalidDNSSeedSignature, err)
+
+	// expired.
+	_, err = ParseDNSSeedRecord("seed.example.com", record, pub, now.Add(time.Hour))
+	assert.Equal(t, ErrDNSSeedRecordExpired, err)
+
+	// the expiration is extended.
+	fields := strings.Fields(record)
+	fields[1] = fmt.Sprintf("%d", expires+3600)
+	_, err = ParseDNSSeedRecord("seed.example.com", strings.Join(fields, " "), pub, now)
+	assert.Equal(t, ErrInvalidDNSSeedSignature, err)
+
+	invalid := []string{
+		"",
+		"nebseed=v1",
+		"nebseed=v2 " + record[len("nebseed=v1 "):],
+		"nebseed=v1 x /ip4/127.0.0.1/tcp/8680/ipfs/QmPyr4ZbDmwF1nWxymTktdzspcBFPL6X1v3Q5nT7PGNtUN c2ln",
+		"nebseed=v1 1 /ip4/127.0.0.1/tcp/8680 c2ln",
+		"nebseed=v1 1 /ip4/127.0.0.1/tcp/8680/ipfs/QmPyr4ZbDmwF1nWxymTktdzspcBFPL6X1v3Q5nT7PGNtUN !!",
+	}
+	for _, v := range invalid {
+		_, err = ParseDNSSeedRecord("seed.example.com", v, pub, now)
+		assert.Equal(t, ErrInvalidDNSSeedRecord, err, v)
+	}
+}
+
+func TestDNSSeedsResolve(t *testing.T) {
+	priv := secp256k1.GeneratePrivateKey()
+	pub, _ := priv.PublicKey().Encoded()
+
+	addr1, _ := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/8680/ipfs/QmPyr4ZbDmwF1nWxymTktdzspcBFPL6X1v3Q5nT7PGNtUN")
+	addr2, _ := ma.NewMultiaddr("/ip4/127.0.0.2/tcp/8680/ipfs/QmXtvEYFUQWcWVjunKdB3fgZZ17NGf2dDaBw6TXEs2tMrF")
+	expires := time.Now().Add(time.Hour).Unix()
+	record1, _ := SignDNSSeedRecord(priv, "a.example.com", addr1, expires)
+	record2, _ := SignDNSSeedRecord(priv, "b.example.com", addr2, expires)
+	expired, _ := SignDNSSeedRecord(priv, "a.example.com", addr2, time.Now().Unix()-1)
+
+	ds := newDNSSeeds([]string{"a.example.com", "b.example.com", "c.example.com"}, pub)
+	ds.lookupTXT = func(domain string) ([]string, error) {
+		switch domain {
+		case "a.example.com":
+			return []string{"v=spf1 -all", record1, expired, record2}, nil
+		case "b.example.com":
+			return []string{record2}, nil
+		}
+		return nil, errors.New("no such host")
+	}
+
+	addrs := ds.resolve()
+	assert.Equal(t, 2, len(addrs))
+	assert.Equal(t, addr1.String(), addrs[0].String())
+	assert.Equal(t, addr2.String(), addrs[1].String())
+}
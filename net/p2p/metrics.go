@@ -31,6 +31,10 @@ var (
 
 	metricsPacketsOut = metrics.NewMeter("neb.net.packets.out")
 	metricsBytesOut   = metrics.NewMeter("neb.net.bytes.out")
+
+	metricsPeerMisbehavior = metrics.NewMeter("neb.net.peer.misbehavior")
+	metricsPeerBanned      = metrics.NewMeter("neb.net.peer.banned")
+	metricsStreamEvicted   = metrics.NewMeter("neb.net.stream.evicted")
 )
 
 func metricsPacketsInByMessageName(messageName string, size uint64) {
@@ -0,0 +1,231 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"sync"
+	"time"
+
+	inat "github.com/libp2p/go-libp2p-nat"
+	swarm "github.com/libp2p/go-libp2p-swarm"
+	multiaddr "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr-net"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// NAT port mapping intervals, the mappings are renewed by the NAT every third of
+// inat.MappingDuration until closed.
+var (
+	NATDiscoverInterval = 5 * time.Minute
+	NATCheckInterval    = time.Minute
+)
+
+// NATMapping is a port mapping on the NAT device.
+type NATMapping struct {
+	Protocol     string
+	InternalPort int
+	ExternalPort int
+	ExternalAddr string
+}
+
+// NATStatus is the port mapping status of the node.
+type NATStatus struct {
+	Enabled         bool
+	Discovered      bool
+	Mappings        []*NATMapping
+	MappingLifetime time.Duration
+}
+
+// PublicAddrs returns the external addresses of the mappings.
+func (s *NATStatus) PublicAddrs() []string {
+	addrs := make([]string, 0, len(s.Mappings))
+	for _, m := range s.Mappings {
+		if len(m.ExternalAddr) > 0 {
+			addrs = append(addrs, m.ExternalAddr)
+		}
+	}
+	return addrs
+}
+
+// natManager maps the listen ports of the node on the NAT device found by UPnP or NAT-PMP.
+// The discovery is retried until a device is found, and the listen addresses without a
+// mapping are mapped again, eg. after the device is restarted.
+type natManager struct {
+	network *swarm.Network
+
+	mu  sync.RWMutex
+	nat *inat.NAT
+
+	ready     chan struct{}
+	readyOnce sync.Once
+	quitCh    chan struct{}
+	closeOnce sync.Once
+}
+
+func newNATManager(network *swarm.Network) *natManager {
+	m := &natManager{
+		network: network,
+		ready:   make(chan struct{}),
+		quitCh:  make(chan struct{}),
+	}
+	go m.loop()
+	return m
+}
+
+// NAT returns the NAT device, nil if not found yet.
+func (m *natManager) NAT() *inat.NAT {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.nat
+}
+
+// Ready is closed when the first discovery is done.
+func (m *natManager) Ready() <-chan struct{} {
+	return m.ready
+}
+
+// Close stops the port mapping and removes the mappings.
+func (m *natManager) Close() error {
+	m.closeOnce.Do(func() {
+		close(m.quitCh)
+	})
+	return nil
+}
+
+// Status returns the port mapping status.
+func (m *natManager) Status() *NATStatus {
+	status := &NATStatus{
+		Enabled:         true,
+		Mappings:        make([]*NATMapping, 0),
+		MappingLifetime: inat.MappingDuration,
+	}
+	nat := m.NAT()
+	if nat == nil {
+		return status
+	}
+	status.Discovered = true
+	for _, v := range nat.Mappings() {
+		mapping := &NATMapping{
+			Protocol:     v.Protocol(),
+			InternalPort: v.InternalPort(),
+			ExternalPort: v.ExternalPort(),
+		}
+		if addr, err := v.ExternalAddr(); err == nil {
+			mapping.ExternalAddr = addr.String()
+		}
+		status.Mappings = append(status.Mappings, mapping)
+	}
+	return status
+}
+
+func (m *natManager) loop() {
+	discoverTicker := time.NewTicker(NATDiscoverInterval)
+	defer discoverTicker.Stop()
+	checkTicker := time.NewTicker(NATCheckInterval)
+	defer checkTicker.Stop()
+
+	m.discover()
+	for {
+		select {
+		case <-m.quitCh:
+			if nat := m.NAT(); nat != nil {
+				nat.Close()
+			}
+			return
+		case <-discoverTicker.C:
+			if m.NAT() == nil {
+				m.discover()
+			}
+		case <-checkTicker.C:
+			m.mapListenAddrs()
+		}
+	}
+}
+
+func (m *natManager) discover() {
+	defer m.readyOnce.Do(func() {
+		close(m.ready)
+	})
+
+	// the discovery blocks until a device is found or it times out.
+	nat := inat.DiscoverNAT()
+	if nat == nil {
+		logging.VLog().Debug("No NAT device found.")
+		return
+	}
+
+	select {
+	case <-m.quitCh:
+		nat.Close()
+		return
+	default:
+	}
+
+	m.mu.Lock()
+	m.nat = nat
+	m.mu.Unlock()
+
+	logging.CLog().Info("Found NAT device, mapping listen ports.")
+	m.mapListenAddrs()
+}
+
+// mapListenAddrs maps the listen addresses without a mapping.
+func (m *natManager) mapListenAddrs() {
+	nat := m.NAT()
+	if nat == nil {
+		return
+	}
+
+	mapped := make(map[string]bool)
+	for _, v := range nat.Mappings() {
+		mapped[v.InternalAddr().String()] = true
+	}
+
+	for _, addr := range m.network.ListenAddresses() {
+		if mapped[addr.String()] || manet.IsIPLoopback(addr) {
+			continue
+		}
+		m.mapListenAddr(nat, addr)
+	}
+}
+
+func (m *natManager) mapListenAddr(nat *inat.NAT, addr multiaddr.Multiaddr) {
+	mapping, err := nat.NewMapping(addr)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":  err,
+			"addr": addr,
+		}).Warn("Failed to map listen address on NAT.")
+		return
+	}
+
+	extAddr, err := mapping.ExternalAddr()
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"err":  err,
+			"addr": addr,
+		}).Debug("Mapped listen address without external address.")
+		return
+	}
+	logging.CLog().WithFields(logrus.Fields{
+		"addr":     addr,
+		"external": extAddr,
+	}).Info("Mapped listen address on NAT.")
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNATStatus(t *testing.T) {
+	// the status of the node with port mapping disabled.
+	node := &Node{config: &Config{DisableNAT: true}}
+	status := node.NATStatus()
+	assert.False(t, status.Enabled)
+	assert.False(t, status.Discovered)
+	assert.Equal(t, 0, len(status.PublicAddrs()))
+
+	status = &NATStatus{
+		Enabled:    true,
+		Discovered: true,
+		Mappings: []*NATMapping{
+			{Protocol: "tcp", InternalPort: 8680, ExternalPort: 18680, ExternalAddr: "/ip4/1.2.3.4/tcp/18680"},
+			{Protocol: "tcp", InternalPort: 8681, ExternalPort: 8681},
+		},
+	}
+	assert.Equal(t, []string{"/ip4/1.2.3.4/tcp/18680"}, status.PublicAddrs())
+}
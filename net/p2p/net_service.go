@@ -138,3 +138,8 @@ func (ns *NetService) SendMessageToPeer(messageName string, data []byte, priorit
 func (ns *NetService) ClosePeer(peerID string, reason error) {
 	ns.node.streamManager.CloseStream(peerID, reason)
 }
+
+// ReportPeer report a misbehavior of a peer, the peer is banned if its score is too low.
+func (ns *NetService) ReportPeer(peerID string, misbehavior Misbehavior) {
+	ns.node.ReportMisbehavior(peerID, misbehavior)
+}
@@ -62,6 +62,10 @@ type Node struct {
 	host          *basichost.BasicHost
 	streamManager *StreamManager
 	routeTable    *RouteTable
+	accessControl *AccessControl
+	reputation    *PeerReputation
+	staticPeers   *staticPeers
+	natManager    *natManager
 }
 
 // NewNode return new Node according to the config.
@@ -75,13 +79,26 @@ func NewNode(config *Config) (*Node, error) {
 		return nil, err
 	}
 
+	accessControl, err := NewAccessControl(config.AllowList, config.DenyList)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err":   err,
+			"allow": config.AllowList,
+			"deny":  config.DenyList,
+		}).Error("Invalid access control list.")
+		return nil, err
+	}
+
 	node := &Node{
 		quitCh:        make(chan bool, 10),
 		config:        config,
 		context:       context.Background(),
 		streamManager: NewStreamManager(),
 		synchronizing: false,
+		accessControl: accessControl,
+		reputation:    NewPeerReputation(config.TrustedPeers),
 	}
+	node.staticPeers = newStaticPeers(config.StaticPeers, node.staticPeerStatus, node.dialStaticPeer)
 
 	initP2PNetworkKey(config, node)
 	initP2PRouteTable(config, node)
@@ -104,6 +121,8 @@ func (node *Node) Start() error {
 	}
 
 	node.routeTable.Start()
+	node.staticPeers.start()
+	go node.loop()
 
 	logging.CLog().WithFields(logrus.Fields{
 		"id":                node.ID(),
@@ -120,6 +139,8 @@ func (node *Node) Stop() {
 		"listening address": node.host.Addrs(),
 	}).Info("Stopping NetService Node...")
 
+	node.quitCh <- true
+	node.staticPeers.stop()
 	node.routeTable.Stop()
 	node.stopHost()
 	node.streamManager.Stop()
@@ -128,7 +149,10 @@ func (node *Node) Stop() {
 func (node *Node) startHost() error {
 	// add nat manager
 	options := &basichost.HostOpts{}
-	options.NATManager = basichost.NewNATManager(node.network)
+	if !node.config.DisableNAT {
+		node.natManager = newNATManager(node.network)
+		options.NATManager = node.natManager
+	}
 	host, err := basichost.NewHost(node.context, node.network, options)
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
@@ -159,6 +183,14 @@ func (node *Node) Config() *Config {
 	return node.config
 }
 
+// NATStatus returns the port mapping status of the node.
+func (node *Node) NATStatus() *NATStatus {
+	if node.natManager == nil {
+		return &NATStatus{Mappings: make([]*NATMapping, 0)}
+	}
+	return node.natManager.Status()
+}
+
 // SetNetService set netService
 func (node *Node) SetNetService(ns *NetService) {
 	node.netService = ns
@@ -189,6 +221,50 @@ func (node *Node) RouteTable() *RouteTable {
 	return node.routeTable
 }
 
+// ReloadAccessControl replace the access control list, and close the streams denied.
+func (node *Node) ReloadAccessControl(allow, deny []string) error {
+	if err := node.accessControl.Reload(allow, deny); err != nil {
+		return err
+	}
+
+	node.streamManager.allStreams.Range(func(key, value interface{}) bool {
+		stream := value.(*Stream)
+		if !node.accessControl.Allowed(stream.pid, stream.addr) {
+			stream.Close(ErrPeerAccessDenied)
+		}
+		return true
+	})
+
+	logging.CLog().WithFields(logrus.Fields{
+		"allow": allow,
+		"deny":  deny,
+	}).Info("Reloaded access control list.")
+	return nil
+}
+
+// ReportMisbehavior decreases the reputation score of the peer, and closes the stream
+// to the peer if it is banned.
+func (node *Node) ReportMisbehavior(peerID string, misbehavior Misbehavior) {
+	metricsPeerMisbehavior.Mark(1)
+	if !node.reputation.Report(peerID, misbehavior) {
+		return
+	}
+
+	metricsPeerBanned.Mark(1)
+	logging.CLog().WithFields(logrus.Fields{
+		"pid":         peerID,
+		"misbehavior": misbehavior,
+		"duration":    PeerBanDuration,
+	}).Info("Banned the peer for misbehavior.")
+
+	node.streamManager.CloseStream(peerID, ErrPeerBanned)
+}
+
+// PeerScores return the reputation scores of the misbehaving peers.
+func (node *Node) PeerScores() []*PeerScore {
+	return node.reputation.Scores()
+}
+
 func initP2PNetworkKey(config *Config, node *Node) {
 	// init p2p network key.
 	networkKey, err := LoadNetworkKeyFromFileOrCreateNew(config.PrivateKeyPath)
@@ -266,9 +342,49 @@ func initP2PSwarmNetwork(config *Config, node *Node) error {
 }
 
 func (node *Node) onStreamConnected(s libnet.Stream) {
+	if !node.accessControl.Allowed(s.Conn().RemotePeer(), s.Conn().RemoteMultiaddr()) {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":  s.Conn().RemotePeer().Pretty(),
+			"addr": s.Conn().RemoteMultiaddr(),
+		}).Debug("Rejected the stream denied by access control.")
+		s.Close()
+		return
+	}
+	if node.reputation.Banned(s.Conn().RemotePeer().Pretty()) {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":  s.Conn().RemotePeer().Pretty(),
+			"addr": s.Conn().RemoteMultiaddr(),
+		}).Debug("Rejected the stream from banned peer.")
+		s.Close()
+		return
+	}
+	if !node.acceptInbound(s.Conn().RemotePeer()) {
+		logging.VLog().WithFields(logrus.Fields{
+			"pid":   s.Conn().RemotePeer().Pretty(),
+			"addr":  s.Conn().RemoteMultiaddr(),
+			"count": node.streamManager.Count(),
+		}).Debug("Rejected the stream exceeding the connection limits.")
+		s.Close()
+		return
+	}
 	node.streamManager.Add(s, node)
 }
 
+func (node *Node) staticPeerStatus(pid peer.ID) (bool, bool) {
+	stream := node.streamManager.Find(pid)
+	if stream == nil {
+		return false, false
+	}
+	return true, stream.IsHandshakeSucceed()
+}
+
+func (node *Node) dialStaticPeer(pid peer.ID, addr multiaddr.Multiaddr) {
+	node.routeTable.AddPeer(pid, addr)
+
+	stream := NewStreamFromPID(pid, node)
+	node.streamManager.AddStream(stream)
+}
+
 // SendMessageToPeer send message to a peer.
 func (node *Node) SendMessageToPeer(messageName string, data []byte, priority int, peerID string) error {
 	stream := node.streamManager.FindByPeerID(peerID)
@@ -0,0 +1,214 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Misbehavior is a kind of misbehavior of a peer.
+type Misbehavior int
+
+// Misbehaviors of the peers.
+const (
+	MisbehaviorInvalidBlock Misbehavior = iota
+	MisbehaviorMalformedMessage
+	MisbehaviorDuplicateMessage
+	MisbehaviorSyncTimeout
+)
+
+// Peer reputation parameters. The score of a peer starts from zero, is decreased by the
+// penalty of each misbehavior and recovers to zero by half every PeerScoreHalfLife.
+// The peers whose score falls below PeerBanThreshold are disconnected and banned for
+// PeerBanDuration.
+var (
+	PeerScoreHalfLife = time.Minute
+	PeerBanThreshold  = -100.0
+	PeerBanDuration   = 30 * time.Minute
+
+	misbehaviorPenalties = map[Misbehavior]float64{
+		MisbehaviorInvalidBlock:     40,
+		MisbehaviorMalformedMessage: 25,
+		MisbehaviorDuplicateMessage: 1,
+		MisbehaviorSyncTimeout:      10,
+	}
+)
+
+const (
+	// maxTrackedPeers is the count of peers to start pruning the recovered scores.
+	maxTrackedPeers = 1024
+	// recoveredScore is the score regarded as fully recovered.
+	recoveredScore = -0.5
+)
+
+// Errors
+var (
+	ErrPeerBanned = errors.New("peer is banned for misbehavior")
+)
+
+// String returns the name of the misbehavior.
+func (m Misbehavior) String() string {
+	switch m {
+	case MisbehaviorInvalidBlock:
+		return "invalid block"
+	case MisbehaviorMalformedMessage:
+		return "malformed message"
+	case MisbehaviorDuplicateMessage:
+		return "duplicate message"
+	case MisbehaviorSyncTimeout:
+		return "sync timeout"
+	}
+	return "unknown"
+}
+
+// PeerScore is the reputation of a peer.
+type PeerScore struct {
+	ID          string
+	Score       float64
+	BannedUntil time.Time
+}
+
+// Banned returns if the peer is banned at the time.
+func (s *PeerScore) Banned(at time.Time) bool {
+	return at.Before(s.BannedUntil)
+}
+
+// PeerReputation tracks the decaying misbehavior scores of the peers.
+// The trusted peers are scored but never banned.
+type PeerReputation struct {
+	mu     sync.Mutex
+	scores map[string]*PeerScore
+	// updatedAt is the time the score decayed to.
+	updatedAt map[string]time.Time
+	trusted   map[string]bool
+	now       func() time.Time
+}
+
+// NewPeerReputation returns a new PeerReputation with the trusted peer ids.
+func NewPeerReputation(trusted []string) *PeerReputation {
+	r := &PeerReputation{
+		scores:    make(map[string]*PeerScore),
+		updatedAt: make(map[string]time.Time),
+		trusted:   make(map[string]bool),
+		now:       time.Now,
+	}
+	for _, id := range trusted {
+		r.trusted[id] = true
+	}
+	return r
+}
+
+// Trusted returns if the peer is trusted.
+func (r *PeerReputation) Trusted(id string) bool {
+	return r.trusted[id]
+}
+
+// decay recovers the score of the peer to now, must be called with the lock held.
+func (r *PeerReputation) decay(id string, now time.Time) *PeerScore {
+	s, ok := r.scores[id]
+	if !ok {
+		return nil
+	}
+	if elapsed := now.Sub(r.updatedAt[id]); elapsed > 0 {
+		s.Score *= math.Pow(0.5, float64(elapsed)/float64(PeerScoreHalfLife))
+		r.updatedAt[id] = now
+	}
+	return s
+}
+
+// Report records a misbehavior of the peer, returns true if the peer is banned by it.
+func (r *PeerReputation) Report(id string, m Misbehavior) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	s := r.decay(id, now)
+	if s == nil {
+		if len(r.scores) >= maxTrackedPeers {
+			r.prune(now)
+		}
+		s = &PeerScore{ID: id}
+		r.scores[id] = s
+		r.updatedAt[id] = now
+	}
+	s.Score -= misbehaviorPenalties[m]
+
+	if s.Score >= PeerBanThreshold || s.Banned(now) || r.trusted[id] {
+		return false
+	}
+	s.BannedUntil = now.Add(PeerBanDuration)
+	return true
+}
+
+// Banned returns if the peer is banned.
+func (r *PeerReputation) Banned(id string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.scores[id]
+	return ok && s.Banned(r.now())
+}
+
+// Score returns the current score of the peer.
+func (r *PeerReputation) Score(id string) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if s := r.decay(id, r.now()); s != nil {
+		return s.Score
+	}
+	return 0
+}
+
+// Scores returns the peers with a misbehavior score or banned, the lowest first.
+func (r *PeerReputation) Scores() []*PeerScore {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	r.prune(now)
+
+	scores := make([]*PeerScore, 0, len(r.scores))
+	for id := range r.scores {
+		s := *r.decay(id, now)
+		scores = append(scores, &s)
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score < scores[j].Score
+		}
+		return scores[i].ID < scores[j].ID
+	})
+	return scores
+}
+
+// prune removes the recovered peers which are not banned, must be called with the lock held.
+func (r *PeerReputation) prune(now time.Time) {
+	for id := range r.scores {
+		s := r.decay(id, now)
+		if s.Score >= recoveredScore && !s.Banned(now) {
+			delete(r.scores, id)
+			delete(r.updatedAt, id)
+		}
+	}
+}
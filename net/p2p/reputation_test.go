@@ -0,0 +1,103 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerReputation(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	r := NewPeerReputation(nil)
+	r.now = func() time.Time { return now }
+
+	assert.Equal(t, 0.0, r.Score("peer1"))
+	assert.False(t, r.Banned("peer1"))
+	assert.Empty(t, r.Scores())
+
+	assert.False(t, r.Report("peer1", MisbehaviorInvalidBlock))
+	assert.False(t, r.Report("peer2", MisbehaviorDuplicateMessage))
+	assert.Equal(t, -40.0, r.Score("peer1"))
+	assert.Equal(t, -1.0, r.Score("peer2"))
+
+	// the score recovers by half every half life.
+	now = now.Add(PeerScoreHalfLife)
+	assert.InDelta(t, -20.0, r.Score("peer1"), 1e-9)
+	assert.InDelta(t, -0.5, r.Score("peer2"), 1e-9)
+
+	// the recovered peers are pruned.
+	scores := r.Scores()
+	assert.Equal(t, 1, len(scores))
+	assert.Equal(t, "peer1", scores[0].ID)
+
+	// banned below the threshold, and only reported once.
+	assert.False(t, r.Report("peer1", MisbehaviorInvalidBlock))
+	assert.False(t, r.Report("peer1", MisbehaviorInvalidBlock))
+	assert.True(t, r.Report("peer1", MisbehaviorMalformedMessage))
+	assert.True(t, r.Banned("peer1"))
+	assert.False(t, r.Report("peer1", MisbehaviorSyncTimeout))
+	assert.Equal(t, now.Add(PeerBanDuration), r.Scores()[0].BannedUntil)
+
+	// the ban expires.
+	now = now.Add(PeerBanDuration - time.Second)
+	assert.True(t, r.Banned("peer1"))
+	now = now.Add(time.Second)
+	assert.False(t, r.Banned("peer1"))
+	assert.Empty(t, r.Scores())
+}
+
+func TestPeerReputationDuplicates(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	r := NewPeerReputation(nil)
+	r.now = func() time.Time { return now }
+
+	// occasional duplicates are tolerated.
+	for i := 0; i < 600; i++ {
+		assert.False(t, r.Report("peer1", MisbehaviorDuplicateMessage))
+		now = now.Add(time.Second)
+	}
+
+	// excessive duplicates are banned.
+	banned := false
+	for i := 0; i < 100 && !banned; i++ {
+		banned = r.Report("peer1", MisbehaviorDuplicateMessage)
+	}
+	assert.True(t, banned)
+	assert.True(t, r.Banned("peer1"))
+}
+
+func TestPeerReputationTrusted(t *testing.T) {
+	r := NewPeerReputation([]string{"peer1"})
+	assert.True(t, r.Trusted("peer1"))
+	assert.False(t, r.Trusted("peer2"))
+
+	for i := 0; i < 10; i++ {
+		assert.False(t, r.Report("peer1", MisbehaviorInvalidBlock))
+	}
+	assert.False(t, r.Banned("peer1"))
+	assert.True(t, r.Score("peer1") < PeerBanThreshold)
+
+	assert.False(t, r.Report("peer2", MisbehaviorInvalidBlock))
+	assert.False(t, r.Report("peer2", MisbehaviorInvalidBlock))
+	assert.True(t, r.Report("peer2", MisbehaviorInvalidBlock))
+	assert.True(t, r.Banned("peer2"))
+}
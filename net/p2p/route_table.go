@@ -54,6 +54,7 @@ type RouteTable struct {
 	maxPeersCountToSync      int
 	cacheFilePath            string
 	seedNodes                []ma.Multiaddr
+	dnsSeeds                 *dnsSeeds
 	node                     *Node
 	streamManager            *StreamManager
 	latestUpdatedAt          int64
@@ -68,6 +69,7 @@ func NewRouteTable(config *Config, node *Node) *RouteTable {
 		maxPeersCountToSync:      config.MaxSyncNodes,
 		cacheFilePath:            path.Join(config.RoutingTableDir, RouteTableCacheFileName),
 		seedNodes:                config.BootNodes,
+		dnsSeeds:                 newDNSSeeds(config.DNSSeeds, config.DNSSeedPubKey),
 		node:                     node,
 		streamManager:            node.streamManager,
 		latestUpdatedAt:          0,
@@ -113,6 +115,7 @@ func (table *RouteTable) Peers() map[peer.ID][]ma.Multiaddr {
 func (table *RouteTable) syncLoop() {
 	// Load Route Table.
 	table.LoadSeedNodes()
+	table.LoadDNSSeeds()
 	table.LoadRouteTableFromFile()
 
 	// trigger first sync.
@@ -122,6 +125,7 @@ func (table *RouteTable) syncLoop() {
 
 	syncLoopTicker := time.NewTicker(RouteTableSyncLoopInterval)
 	saveRouteTableToDiskTicker := time.NewTicker(RouteTableSaveToDiskInterval)
+	resolveDNSSeedsTicker := time.NewTicker(DNSSeedResolveInterval)
 	latestUpdatedAt := table.latestUpdatedAt
 
 	for {
@@ -131,6 +135,8 @@ func (table *RouteTable) syncLoop() {
 			return
 		case <-syncLoopTicker.C:
 			table.SyncRouteTable()
+		case <-resolveDNSSeedsTicker.C:
+			table.LoadDNSSeeds()
 		case <-saveRouteTableToDiskTicker.C:
 			if latestUpdatedAt < table.latestUpdatedAt {
 				table.SaveRouteTableToFile()
@@ -235,6 +241,16 @@ func (table *RouteTable) LoadSeedNodes() {
 	}
 }
 
+// LoadDNSSeeds load the seed nodes resolved from dns seeds.
+func (table *RouteTable) LoadDNSSeeds() {
+	if len(table.dnsSeeds.domains) == 0 {
+		return
+	}
+	for _, ipfsAddr := range table.dnsSeeds.resolve() {
+		table.AddIPFSPeerAddr(ipfsAddr)
+	}
+}
+
 // LoadRouteTableFromFile load route table from file.
 func (table *RouteTable) LoadRouteTableFromFile() {
 	file, err := os.Open(table.cacheFilePath)
@@ -351,6 +367,9 @@ func (table *RouteTable) SyncWithPeer(pid peer.ID) {
 	stream := table.streamManager.Find(pid)
 
 	if stream == nil {
+		if !table.node.outboundSlotAvailable() {
+			return
+		}
 		stream = NewStreamFromPID(pid, table.node)
 		table.streamManager.AddStream(stream)
 	}
@@ -0,0 +1,151 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Static peers reconnecting intervals, the backoff is doubled after each failed dial.
+var (
+	StaticPeerCheckInterval = 5 * time.Second
+	StaticPeerMinBackoff    = 5 * time.Second
+	StaticPeerMaxBackoff    = 5 * time.Minute
+)
+
+type staticPeer struct {
+	id         peer.ID
+	addr       ma.Multiaddr
+	failures   uint
+	nextDialAt time.Time
+}
+
+// staticPeers keeps the static peers connected, the disconnected peers are dialed
+// again with an exponential backoff.
+type staticPeers struct {
+	peers []*staticPeer
+	// status returns if the stream to the peer exists and if its handshake succeeded.
+	status func(pid peer.ID) (exists bool, connected bool)
+	dial   func(pid peer.ID, addr ma.Multiaddr)
+	quitCh chan bool
+}
+
+func newStaticPeers(addrs []ma.Multiaddr, status func(peer.ID) (bool, bool), dial func(peer.ID, ma.Multiaddr)) *staticPeers {
+	sp := &staticPeers{
+		peers:  make([]*staticPeer, 0, len(addrs)),
+		status: status,
+		dial:   dial,
+		quitCh: make(chan bool, 1),
+	}
+	for _, v := range addrs {
+		id, addr, err := ParseFromIPFSAddr(v)
+		if err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err":  err,
+				"addr": v,
+			}).Warn("Invalid static peer address.")
+			continue
+		}
+		sp.peers = append(sp.peers, &staticPeer{id: id, addr: addr})
+	}
+	return sp
+}
+
+// contains returns if the peer is a static peer.
+func (sp *staticPeers) contains(pid peer.ID) bool {
+	for _, v := range sp.peers {
+		if v.id == pid {
+			return true
+		}
+	}
+	return false
+}
+
+func (sp *staticPeers) start() {
+	if len(sp.peers) == 0 {
+		return
+	}
+	go sp.loop()
+}
+
+func (sp *staticPeers) stop() {
+	if len(sp.peers) == 0 {
+		return
+	}
+	sp.quitCh <- true
+}
+
+func (sp *staticPeers) loop() {
+	ticker := time.NewTicker(StaticPeerCheckInterval)
+	defer ticker.Stop()
+
+	sp.check(time.Now())
+	for {
+		select {
+		case <-sp.quitCh:
+			return
+		case <-ticker.C:
+			sp.check(time.Now())
+		}
+	}
+}
+
+// check dials the disconnected static peers whose backoff is over.
+func (sp *staticPeers) check(now time.Time) {
+	for _, v := range sp.peers {
+		exists, connected := sp.status(v.id)
+		if connected {
+			// redial at once when disconnected.
+			v.failures = 0
+			v.nextDialAt = time.Time{}
+			continue
+		}
+		if exists || now.Before(v.nextDialAt) {
+			// connecting or waiting for the backoff.
+			continue
+		}
+
+		if v.failures > 0 {
+			logging.VLog().WithFields(logrus.Fields{
+				"pid":      v.id.Pretty(),
+				"addr":     v.addr,
+				"failures": v.failures,
+			}).Debug("Reconnecting to static peer.")
+		}
+		sp.dial(v.id, v.addr)
+		v.failures++
+		v.nextDialAt = now.Add(staticPeerBackoff(v.failures))
+	}
+}
+
+func staticPeerBackoff(failures uint) time.Duration {
+	backoff := StaticPeerMinBackoff
+	for i := uint(1); i < failures && backoff < StaticPeerMaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > StaticPeerMaxBackoff {
+		backoff = StaticPeerMaxBackoff
+	}
+	return backoff
+}
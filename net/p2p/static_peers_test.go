@@ -0,0 +1,92 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaticPeerBackoff(t *testing.T) {
+	assert.Equal(t, StaticPeerMinBackoff, staticPeerBackoff(0))
+	assert.Equal(t, StaticPeerMinBackoff, staticPeerBackoff(1))
+	assert.Equal(t, 2*StaticPeerMinBackoff, staticPeerBackoff(2))
+	assert.Equal(t, 8*StaticPeerMinBackoff, staticPeerBackoff(4))
+	assert.Equal(t, StaticPeerMaxBackoff, staticPeerBackoff(100))
+}
+
+func TestStaticPeers(t *testing.T) {
+	addr1, _ := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/8680/ipfs/QmPyr4ZbDmwF1nWxymTktdzspcBFPL6X1v3Q5nT7PGNtUN")
+	addr2, _ := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/8680")
+	pid1, _ := peer.IDB58Decode("QmPyr4ZbDmwF1nWxymTktdzspcBFPL6X1v3Q5nT7PGNtUN")
+	pid2, _ := peer.IDB58Decode("QmXtvEYFUQWcWVjunKdB3fgZZ17NGf2dDaBw6TXEs2tMrF")
+
+	exists, connected := false, false
+	dialed := 0
+	sp := newStaticPeers([]ma.Multiaddr{addr1, addr2},
+		func(pid peer.ID) (bool, bool) { return exists, connected },
+		func(pid peer.ID, addr ma.Multiaddr) {
+			assert.Equal(t, pid1, pid)
+			assert.Equal(t, "/ip4/127.0.0.1/tcp/8680", addr.String())
+			dialed++
+		})
+
+	// the address without peer id is ignored.
+	assert.Equal(t, 1, len(sp.peers))
+	assert.True(t, sp.contains(pid1))
+	assert.False(t, sp.contains(pid2))
+
+	now := time.Unix(1500000000, 0)
+	sp.check(now)
+	assert.Equal(t, 1, dialed)
+
+	// connecting.
+	exists = true
+	sp.check(now.Add(time.Hour))
+	assert.Equal(t, 1, dialed)
+
+	// failed, dial again after the backoff doubled.
+	exists = false
+	sp.check(now.Add(StaticPeerMinBackoff - time.Second))
+	assert.Equal(t, 1, dialed)
+	now = now.Add(StaticPeerMinBackoff)
+	sp.check(now)
+	assert.Equal(t, 2, dialed)
+	sp.check(now.Add(2*StaticPeerMinBackoff - time.Second))
+	assert.Equal(t, 2, dialed)
+	now = now.Add(2 * StaticPeerMinBackoff)
+	sp.check(now)
+	assert.Equal(t, 3, dialed)
+
+	// the backoff is reset once connected.
+	exists, connected = true, true
+	sp.check(now)
+	exists, connected = false, false
+	now = now.Add(time.Second)
+	sp.check(now)
+	assert.Equal(t, 4, dialed)
+	sp.check(now.Add(StaticPeerMinBackoff - time.Second))
+	assert.Equal(t, 4, dialed)
+	sp.check(now.Add(StaticPeerMinBackoff))
+	assert.Equal(t, 5, dialed)
+}
@@ -67,6 +67,7 @@ type Stream struct {
 	lowPriorityMessageChan    chan *NebMessage
 	quitWriteCh               chan bool
 	handshakeSucceed          bool
+	inbound                   bool
 	connectedAt               int64
 	latestReadAt              int64
 	latestWriteAt             int64
@@ -74,7 +75,9 @@ type Stream struct {
 
 // NewStream return a new Stream
 func NewStream(stream libnet.Stream, node *Node) *Stream {
-	return newStreamInstance(stream.Conn().RemotePeer(), stream.Conn().RemoteMultiaddr(), stream, node)
+	s := newStreamInstance(stream.Conn().RemotePeer(), stream.Conn().RemoteMultiaddr(), stream, node)
+	s.inbound = true
+	return s
 }
 
 // NewStreamFromPID return a new Stream based on the pid
@@ -89,7 +92,7 @@ func newStreamInstance(pid peer.ID, addr ma.Multiaddr, stream libnet.Stream, nod
 		stream:                    stream,
 		node:                      node,
 		handshakeSucceedCh:        make(chan bool, 1),
-		messageNotifChan:          make(chan int, 4*1024),
+		messageNotifChan:          make(chan int, 1),
 		highPriorityMessageChan:   make(chan *NebMessage, 2*1024),
 		normalPriorityMessageChan: make(chan *NebMessage, 2*1024),
 		lowPriorityMessageChan:    make(chan *NebMessage, 2*1024),
@@ -107,6 +110,13 @@ func (s *Stream) Connect() error {
 		"stream": s.String(),
 	}).Debug("Connecting to peer.")
 
+	if !s.node.accessControl.Allowed(s.pid, nil) {
+		return ErrPeerAccessDenied
+	}
+	if s.node.reputation.Banned(s.pid.Pretty()) {
+		return ErrPeerBanned
+	}
+
 	// connect to host.
 	stream, err := s.node.host.NewStream(
 		s.node.context,
@@ -120,6 +130,10 @@ func (s *Stream) Connect() error {
 		}).Debug("Failed to connect to host.")
 		return err
 	}
+	if !s.node.accessControl.Allowed(s.pid, stream.Conn().RemoteMultiaddr()) {
+		stream.Close()
+		return ErrPeerAccessDenied
+	}
 	s.stream = stream
 	s.addr = stream.Conn().RemoteMultiaddr()
 
@@ -173,7 +187,7 @@ func (s *Stream) SendMessage(messageName string, data []byte, priority int) erro
 	// send to pool.
 	message.FlagSendMessageAt()
 
-	switch priority {
+	switch net.MessagePriority(messageName, priority) {
 	case net.MessagePriorityHigh:
 		s.highPriorityMessageChan <- message
 	case net.MessagePriorityNormal:
@@ -181,7 +195,12 @@ func (s *Stream) SendMessage(messageName string, data []byte, priority int) erro
 	default:
 		s.lowPriorityMessageChan <- message
 	}
-	s.messageNotifChan <- 1
+
+	// wake up the write loop, it drains all the queues once notified.
+	select {
+	case s.messageNotifChan <- 1:
+	default:
+	}
 
 	return nil
 }
@@ -313,6 +332,7 @@ func (s *Stream) readLoop() {
 
 				message, err = ParseNebMessage(messageBuffer)
 				if err != nil {
+					s.node.ReportMisbehavior(s.pid.Pretty(), MisbehaviorMalformedMessage)
 					s.Bye()
 					return
 				}
@@ -340,6 +360,7 @@ func (s *Stream) readLoop() {
 			}
 
 			if err := message.ParseMessageData(messageBuffer); err != nil {
+				s.node.ReportMisbehavior(s.pid.Pretty(), MisbehaviorMalformedMessage)
 				s.Bye()
 				return
 			}
@@ -403,30 +424,49 @@ func (s *Stream) writeLoop() {
 			}).Debug("Quiting Stream Write Loop.")
 			return
 		case <-s.messageNotifChan:
-			select {
-			case message := <-s.highPriorityMessageChan:
-				s.WriteNebMessage(message)
-				continue
-			default:
-			}
-
-			select {
-			case message := <-s.normalPriorityMessageChan:
-				s.WriteNebMessage(message)
-				continue
-			default:
-			}
+			for {
+				select {
+				case <-s.quitWriteCh:
+					logging.VLog().WithFields(logrus.Fields{
+						"stream": s.String(),
+					}).Debug("Quiting Stream Write Loop.")
+					return
+				default:
+				}
 
-			select {
-			case message := <-s.lowPriorityMessageChan:
+				message := s.nextMessage()
+				if message == nil {
+					break
+				}
 				s.WriteNebMessage(message)
-				continue
-			default:
 			}
 		}
 	}
 }
 
+// nextMessage returns the pending message with the highest priority, the higher
+// priority queues are always emptied before serving a lower one.
+func (s *Stream) nextMessage() *NebMessage {
+	select {
+	case message := <-s.highPriorityMessageChan:
+		return message
+	default:
+	}
+
+	select {
+	case message := <-s.normalPriorityMessageChan:
+		return message
+	default:
+	}
+
+	select {
+	case message := <-s.lowPriorityMessageChan:
+		return message
+	default:
+	}
+	return nil
+}
+
 func (s *Stream) handleMessage(message *NebMessage) error {
 	messageName := message.MessageName()
 	switch messageName {
@@ -451,6 +491,9 @@ func (s *Stream) handleMessage(message *NebMessage) error {
 	case RECVEDMSG:
 		return s.onRecvedMsg(message)
 	default:
+		if HasRecvMessage(s, message.DataCheckSum()) {
+			s.node.ReportMisbehavior(s.pid.Pretty(), MisbehaviorDuplicateMessage)
+		}
 		s.node.netService.PutMessage(messages.NewBaseMessage(message.MessageName(), s.pid.Pretty(), message.Data()))
 		// record recv message.
 		RecordRecvMessage(s, message.DataCheckSum())
@@ -54,6 +54,20 @@ func (sm *StreamManager) Count() int32 {
 	return sm.activePeersCount
 }
 
+// CountByDirection return the inbound and outbound streams count
+func (sm *StreamManager) CountByDirection() (int, int) {
+	inbound, outbound := 0, 0
+	sm.allStreams.Range(func(key, value interface{}) bool {
+		if value.(*Stream).inbound {
+			inbound++
+		} else {
+			outbound++
+		}
+		return true
+	})
+	return inbound, outbound
+}
+
 // Start stream manager service
 func (sm *StreamManager) Start() {
 	logging.CLog().Info("Starting NetService StreamManager...")
@@ -0,0 +1,49 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package p2p
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/net"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream_MessagePriority(t *testing.T) {
+	net.RegisterConsensusMessage("vote")
+	defer net.UnregisterConsensusMessage("vote")
+
+	node := &Node{config: &Config{ChainID: DefaultChainID}}
+	s := NewStreamFromPID("", node)
+
+	assert.Nil(t, s.SendMessage("newtx", []byte("tx1"), net.MessagePriorityNormal))
+	assert.Nil(t, s.SendMessage("chunks", []byte("chunk"), net.MessagePriorityLow))
+	assert.Nil(t, s.SendMessage("newtx", []byte("tx2"), net.MessagePriorityNormal))
+	assert.Nil(t, s.SendMessage("vote", []byte("vote"), net.MessagePriorityLow))
+	assert.Nil(t, s.SendMessage("newblock", []byte("block"), net.MessagePriorityHigh))
+
+	// a single pending notification wakes up the write loop for all messages.
+	assert.Equal(t, 1, len(s.messageNotifChan))
+
+	var names []string
+	for message := s.nextMessage(); message != nil; message = s.nextMessage() {
+		names = append(names, message.MessageName())
+	}
+	assert.Equal(t, []string{"vote", "newblock", "newtx", "newtx", "chunks"}, names)
+}
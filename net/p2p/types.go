@@ -39,6 +39,7 @@ type Manager interface {
 	SendMessageToPeer(messageName string, data []byte, priority int, peerID string) error
 
 	ClosePeer(peerID string, reason error)
+	ReportPeer(peerID string, misbehavior Misbehavior)
 
 	BroadcastNetworkID([]byte)
 
@@ -20,6 +20,7 @@ package net
 
 import (
 	"errors"
+	"sync"
 
 	"github.com/gogo/protobuf/proto"
 )
@@ -31,6 +32,33 @@ const (
 	MessagePriorityLow
 )
 
+// consensusMessages the message types always sent with high priority ahead of the
+// bulk traffic, e.g. gossiped transactions.
+var consensusMessages = new(sync.Map)
+
+// RegisterConsensusMessage marks the message types as consensus critical.
+func RegisterConsensusMessage(messageNames ...string) {
+	for _, name := range messageNames {
+		consensusMessages.Store(name, true)
+	}
+}
+
+// UnregisterConsensusMessage removes the message types from the consensus critical ones.
+func UnregisterConsensusMessage(messageNames ...string) {
+	for _, name := range messageNames {
+		consensusMessages.Delete(name)
+	}
+}
+
+// MessagePriority returns the priority to send the message, consensus critical
+// messages are promoted to high priority.
+func MessagePriority(messageName string, priority int) int {
+	if _, ok := consensusMessages.Load(messageName); ok {
+		return MessagePriorityHigh
+	}
+	return priority
+}
+
 // Sync Message Type
 const (
 	ChainSync      = "sync"
@@ -0,0 +1,36 @@
+// Code generated by gen_bindings.go from bindings.json. DO NOT EDIT.
+
+package nvm
+
+// libModules are the lib modules able to be required.
+var libModules = map[string]bool{
+	"lib/assert.js":              true,
+	"lib/bignumber.js":           true,
+	"lib/bindings.js":            true,
+	"lib/blockchain.js":          true,
+	"lib/console.js":             true,
+	"lib/crypto.js":              true,
+	"lib/decimal.js":             true,
+	"lib/esprima.js":             true,
+	"lib/event.js":               true,
+	"lib/instruction_counter.js": true,
+	"lib/nrc20.js":               true,
+	"lib/random.js":              true,
+	"lib/storage.js":             true,
+	"lib/tsc.js":                 true,
+	"lib/typescriptServices.js":  true,
+	"lib/util.js":                true,
+}
+
+// hostGlobals are the globals installed by the native side, not accessible to contracts.
+var hostGlobals = []string{
+	"NativeStorage",
+	"_instruction_counter",
+	"_native_blockchain",
+	"_native_crypto_hash",
+	"_native_crypto_recover_address",
+	"_native_event_trigger",
+	"_native_log",
+	"_native_require",
+	"_native_storage_handlers",
+}
@@ -0,0 +1,240 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core/state"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func newBindingsTestEngine(checkHeight uint64) *V8Engine {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	block := &mockBlock{heights: ActivationHeights{HostBindingsCheck: checkHeight}}
+	ctx := NewContext(block, testContextTransaction(), owner, contract, context)
+
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(1000000, 10000000)
+	return engine
+}
+
+func TestLibSource(t *testing.T) {
+	tests := []struct {
+		id      string
+		allowed bool
+	}{
+		{"lib/console.js", true},
+		{"lib/bindings.js", true},
+		{"lib/execution_env.js", false},
+		{"lib/not_exist.js", false},
+		{"lib/../engine_v8.go", false},
+		{"engine_v8.go", false},
+		{"contract.js", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		source, ok := libSource(tt.id)
+		assert.Equal(t, tt.allowed, ok, tt.id)
+		assert.Equal(t, tt.allowed, len(source) > 0, tt.id)
+	}
+}
+
+func TestRequireAllowList(t *testing.T) {
+	tests := []struct {
+		source      string
+		expectedErr error
+	}{
+		{`require("console.js");`, nil},
+		{`require("bignumber.js");`, nil},
+		{`require("./engine_v8.go");`, ErrExecutionFailed},
+		{`require("../bindings.json");`, ErrExecutionFailed},
+		{`require("./test/ERC20.js");`, ErrExecutionFailed},
+		{`_native_require("lib/../engine_v8.go");`, ErrExecutionFailed},
+		{`_native_require("/etc/hosts");`, ErrExecutionFailed},
+	}
+	for _, tt := range tests {
+		engine := newBindingsTestEngine(0)
+		_, err := engine.RunScriptSource(tt.source, 0)
+		assert.Equal(t, tt.expectedErr, err, tt.source)
+		engine.Dispose()
+	}
+}
+
+func TestHostBindingsCheck(t *testing.T) {
+	tests := []struct {
+		body     string
+		rejected bool
+	}{
+		{`_native_log(1, "init");`, true},
+		{`var s = NativeStorage;`, true},
+		{`var h = _native_storage_handlers.lcs;`, true},
+		{`var o = {}; o[_native_require] = 1;`, true},
+		{`this.NativeStorage = 1;`, false},
+		{`var o = {_native_log: 1}; o._native_require = 2;`, false},
+		{`console.log("init");`, false},
+	}
+	for _, tt := range tests {
+		source := "var Contract = function () {};\nContract.prototype = {\n\tinit: function () {\n" + tt.body +
+			"\n\t}\n};\nmodule.exports = Contract;"
+
+		// the contracts are not checked until the check height.
+		engine := newBindingsTestEngine(3)
+		_, err := engine.DeployAndInit(source, "js", "")
+		assert.NotEqual(t, ErrInjectTracingInstructionFailed, err, tt.body)
+		engine.Dispose()
+
+		engine = newBindingsTestEngine(2)
+		_, err = engine.DeployAndInit(source, "js", "")
+		if tt.rejected {
+			assert.Equal(t, ErrInjectTracingInstructionFailed, err, tt.body)
+		} else {
+			assert.Nil(t, err, tt.body)
+		}
+		engine.Dispose()
+	}
+}
+
+// randomModuleID returns a random module id made of path segments likely to escape lib.
+func randomModuleID(r *rand.Rand) string {
+	segments := []string{"lib", "..", ".", "", "test", "v8", "console.js", "bindings.js", "engine_v8.go",
+		"bindings.json", "execution_env.js", "ERC20.js", "\\", "%s", "é"}
+	var parts []string
+	for i := r.Intn(6); i >= 0; i-- {
+		if r.Intn(8) == 0 {
+			b := make([]byte, r.Intn(8)+1)
+			for j := range b {
+				b[j] = byte(r.Intn(94) + 33)
+			}
+			parts = append(parts, string(b))
+		} else {
+			parts = append(parts, segments[r.Intn(len(segments))])
+		}
+	}
+	id := strings.Join(parts, "/")
+	switch r.Intn(3) {
+	case 0:
+		id = "/" + id
+	case 1:
+		id = "./" + id
+	}
+	return id
+}
+
+func TestFuzzReformatModuleID(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		id := randomModuleID(r)
+		formatted := reformatModuleID(id)
+		assert.Equal(t, formatted, reformatModuleID(formatted), id)
+
+		// ".." is kept only in the leading segments.
+		leading := true
+		for _, p := range strings.Split(formatted, "/") {
+			if len(formatted) == 0 {
+				break
+			}
+			assert.NotEqual(t, "", p, id)
+			assert.NotEqual(t, ".", p, id)
+			if p != ".." {
+				leading = false
+			}
+			assert.False(t, p == ".." && !leading, id)
+		}
+
+		_, ok := libSource(id)
+		assert.Equal(t, libModules[id], ok, id)
+	}
+}
+
+func TestFuzzNativeRequire(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	ids := make([]string, 500)
+	for i := range ids {
+		ids[i] = randomModuleID(r)
+	}
+	ids = append(ids, "lib/console.js", "lib/bindings.js")
+	data, _ := json.Marshal(ids)
+
+	source := "var loaded = [];\n" + string(data) + ".forEach(function (id) {\n" +
+		"\ttry {\n\t\t_native_require(id);\n\t\tloaded.push(id);\n\t} catch (e) {\n\t}\n});\nloaded;"
+
+	engine := newBindingsTestEngine(0)
+	defer engine.Dispose()
+	result, err := engine.RunScriptSource(source, 0)
+	assert.Nil(t, err)
+
+	var loaded []string
+	assert.Nil(t, json.Unmarshal([]byte(result), &loaded))
+	assert.Contains(t, loaded, "lib/console.js")
+	for _, id := range loaded {
+		assert.True(t, libModules[id], "module %s out of bindings manifest is loaded", id)
+	}
+}
+
+func TestFuzzHostBindingsCheck(t *testing.T) {
+	safe := []string{
+		"var a = 1;",
+		"this.NativeStorage = 2;",
+		"var o = {_native_log: 3};",
+		"o._native_require = 4;",
+		"console.log('x');",
+		"var s = '_native_blockchain';",
+	}
+	unsafe := []string{
+		"_native_log(1, 'x');",
+		"var n = NativeStorage;",
+		"var h = _native_storage_handlers.lcs;",
+		"o[_native_require] = 1;",
+		"(function () { return _native_blockchain; })();",
+		"_instruction_counter.incr(-1);",
+		"if (_native_crypto_hash) {}",
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		rejected := false
+		var body []string
+		for j := r.Intn(5); j >= 0; j-- {
+			if r.Intn(3) == 0 {
+				body = append(body, unsafe[r.Intn(len(unsafe))])
+				rejected = true
+			} else {
+				body = append(body, safe[r.Intn(len(safe))])
+			}
+		}
+
+		engine := newBindingsTestEngine(1)
+		_, err := engine.DeployAndInit("var Contract = function () {};\nContract.prototype = {\n\tinit: function () {\n"+
+			strings.Join(body, "\n")+"\n\t}\n};\nmodule.exports = Contract;", "js", "")
+		engine.Dispose()
+		if rejected {
+			assert.Equal(t, ErrInjectTracingInstructionFailed, err, body)
+		} else {
+			assert.NotEqual(t, ErrInjectTracingInstructionFailed, err, body)
+		}
+	}
+}
@@ -22,13 +22,26 @@ import "C"
 
 import (
 	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
 	"unsafe"
 
 	"github.com/nebulasio/go-nebulas/util"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
 )
 
+// addressBytes returns the bytes of the verified hex address, which is the key of account in state.
+// The raw string was the key before the contract transfer height.
+func (e *V8Engine) addressBytes(addr string) ([]byte, error) {
+	if !e.transferChecked() {
+		return []byte(addr), nil
+	}
+	return byteutils.FromHex(strings.TrimPrefix(addr, "0x"))
+}
+
 // GetTxByHashFunc returns tx info by hash
 //export GetTxByHashFunc
 func GetTxByHashFunc(handler unsafe.Pointer, hash *C.char) *C.char {
@@ -37,6 +50,7 @@ func GetTxByHashFunc(handler unsafe.Pointer, hash *C.char) *C.char {
 		return nil
 	}
 	tx, err := engine.ctx.SerializeTxByHash([]byte(C.GoString(hash)))
+	engine.trace(TraceOpGetTransaction, string(tx), C.GoString(hash))
 	if err != nil {
 		logging.VLog().WithFields(logrus.Fields{
 			"handler": uint64(uintptr(handler)),
@@ -58,6 +72,7 @@ func GetAccountStateFunc(handler unsafe.Pointer, address *C.char) *C.char {
 	addr := C.GoString(address)
 	valid := engine.ctx.block.VerifyAddress(addr)
 	if !valid {
+		engine.trace(TraceOpGetAccountState, "", addr)
 		logging.VLog().WithFields(logrus.Fields{
 			"handler": uint64(uintptr(handler)),
 			"key":     C.GoString(address),
@@ -65,22 +80,30 @@ func GetAccountStateFunc(handler unsafe.Pointer, address *C.char) *C.char {
 		return nil
 	}
 
-	acc := engine.ctx.state.GetOrCreateUserAccount([]byte(addr))
+	key, err := engine.addressBytes(addr)
+	if err != nil {
+		return nil
+	}
+	acc := engine.ctx.state.GetOrCreateUserAccount(key)
 	state := &AccountState{
 		Nonce:   acc.Nonce(),
 		Balance: acc.Balance().String(),
 	}
 	json, _ := json.Marshal(state)
+	engine.trace(TraceOpGetAccountState, string(json), addr)
 	return C.CString(string(json))
 }
 
 // TransferFunc transfer vale to address
 //export TransferFunc
-func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char) int {
+func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char) (ret int) {
 	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if engine == nil || engine.ctx.block == nil {
 		return 1
 	}
+	defer func() {
+		engine.trace(TraceOpTransfer, strconv.Itoa(ret), C.GoString(to), C.GoString(v))
+	}()
 
 	addr := C.GoString(to)
 	valid := engine.ctx.block.VerifyAddress(addr)
@@ -92,13 +115,24 @@ func TransferFunc(handler unsafe.Pointer, to *C.char, v *C.char) int {
 		return 1
 	}
 
-	toAcc := engine.ctx.state.GetOrCreateUserAccount([]byte(addr))
+	key, err := engine.addressBytes(addr)
+	if err != nil {
+		return 1
+	}
+	toAcc := engine.ctx.state.GetOrCreateUserAccount(key)
 
-	var (
-		amount *util.Uint128
-		err    error
-	)
-	amount = util.NewUint128FromString(C.GoString(v))
+	amount := util.NewUint128FromString(C.GoString(v))
+	if engine.transferChecked() {
+		value, ok := new(big.Int).SetString(C.GoString(v), 10)
+		if !ok || value.Sign() < 0 {
+			logging.VLog().WithFields(logrus.Fields{
+				"handler": uint64(uintptr(handler)),
+				"value":   C.GoString(v),
+			}).Debug("TransferFunc parse value failed.")
+			return 1
+		}
+		amount = util.NewUint128FromBigInt(value)
+	}
 
 	// update balance
 	err = engine.ctx.contract.SubBalance(amount)
@@ -123,8 +157,43 @@ func VerifyAddressFunc(handler unsafe.Pointer, address *C.char) int {
 		return 0
 	}
 
-	if engine.ctx.block.VerifyAddress(C.GoString(address)) {
+	valid := engine.ctx.block.VerifyAddress(C.GoString(address))
+	engine.trace(TraceOpVerifyAddress, strconv.FormatBool(valid), C.GoString(address))
+	if valid {
 		return 1
 	}
 	return 0
 }
+
+// GetBlockHashFunc returns the hash of a recent ancestor block by height
+//export GetBlockHashFunc
+func GetBlockHashFunc(handler unsafe.Pointer, height *C.char) *C.char {
+	engine, _ := getEngineByStorageHandler(uint64(uintptr(handler)))
+	if engine == nil || engine.ctx.block == nil {
+		return nil
+	}
+
+	h, err := strconv.ParseUint(C.GoString(height), 10, 64)
+	if err != nil {
+		engine.trace(TraceOpGetBlockHash, "", C.GoString(height))
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": uint64(uintptr(handler)),
+			"height":  C.GoString(height),
+			"err":     err,
+		}).Debug("GetBlockHashFunc parse height failed.")
+		return nil
+	}
+
+	hash, err := engine.ctx.block.AncestorHash(h)
+	if err != nil {
+		engine.trace(TraceOpGetBlockHash, "", C.GoString(height))
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": uint64(uintptr(handler)),
+			"height":  h,
+			"err":     err,
+		}).Debug("GetBlockHashFunc get block hash failed.")
+		return nil
+	}
+	engine.trace(TraceOpGetBlockHash, hash.String(), C.GoString(height))
+	return C.CString(hash.String())
+}
@@ -31,15 +31,21 @@ char *RequireDelegateFunc(void *handler, const char *filename, size_t *lineOffse
 char *StorageGetFunc(void *handler, const char *key);
 int StoragePutFunc(void *handler, const char *key, const char *value);
 int StorageDelFunc(void *handler, const char *key);
+char *StorageIterFunc(void *handler, const char *domain, size_t offset, size_t limit, size_t *usage);
 
 // blockchain.
 char *GetTxByHashFunc(void *handler, const char *hash);
 char *GetAccountStateFunc(void *handler, const char *address);
 int TransferFunc(void *handler, const char *to, const char *value);
 int VerifyAddressFunc(void *handler, const char *address);
+char *GetBlockHashFunc(void *handler, const char *height);
 
 // event.
-void EventTriggerFunc(void *handler, const char *topic, const char *data);
+void EventTriggerFunc(void *handler, const char *topic, const char *data, const char *indexed);
+
+// crypto.
+char *CryptoHashFunc(void *handler, const char *alg, const char *data);
+char *RecoverAddressFunc(void *handler, int alg, const char *hash, const char *sign);
 
 // The gateway functions.
 void V8Log_cgo(int level, const char *msg) {
@@ -59,6 +65,9 @@ int StoragePutFunc_cgo(void *handler, const char *key, const char *value) {
 int StorageDelFunc_cgo(void *handler, const char *key) {
 	return StorageDelFunc(handler, key);
 };
+char *StorageIterFunc_cgo(void *handler, const char *domain, size_t offset, size_t limit, size_t *usage) {
+	return StorageIterFunc(handler, domain, offset, limit, usage);
+};
 
 char *GetTxByHashFunc_cgo(void *handler, const char *hash) {
 	return GetTxByHashFunc(handler, hash);
@@ -72,9 +81,19 @@ int TransferFunc_cgo(void *handler, const char *to, const char *value) {
 int VerifyAddressFunc_cgo(void *handler, const char *address) {
 	return VerifyAddressFunc(handler, address);
 };
+char *GetBlockHashFunc_cgo(void *handler, const char *height) {
+	return GetBlockHashFunc(handler, height);
+};
 
-void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data) {
-	EventTriggerFunc(handler, topic, data);
+void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data, const char *indexed) {
+	EventTriggerFunc(handler, topic, data, indexed);
+};
+
+char *CryptoHashFunc_cgo(void *handler, const char *alg, const char *data) {
+	return CryptoHashFunc(handler, alg, data);
+};
+char *RecoverAddressFunc_cgo(void *handler, int alg, const char *hash, const char *sign) {
+	return RecoverAddressFunc(handler, alg, hash, sign);
 };
 
 */
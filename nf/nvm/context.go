@@ -22,6 +22,8 @@ import (
 	"encoding/json"
 
 	"errors"
+	"sync"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core/pb"
@@ -33,17 +35,82 @@ import (
 const (
 	// DefaultLimitsOfTotalMemorySize default limits of total memory size
 	DefaultLimitsOfTotalMemorySize uint64 = 40 * 1000 * 1000
+
+	// DefaultExecutionTimeout default limits of the wall-clock time of an execution
+	DefaultExecutionTimeout = 10 * time.Second
 )
 
+// ExecutionLimits are the limits of the contract executions configured by the node.
+// The memory size and instructions limit the local executions only, the executions in
+// blocks must fail the same on every node.
+type ExecutionLimits struct {
+	// Timeout aborts the execution with ErrExecutionTimeout.
+	Timeout time.Duration
+	// TotalMemorySize fails the local execution with ErrExceedMemoryLimits.
+	TotalMemorySize uint64
+	// Instructions fails the local execution with ErrExceedInstructionLimits, 0 means limited by gas only.
+	Instructions uint64
+}
+
+var (
+	executionLimits = ExecutionLimits{
+		Timeout:         DefaultExecutionTimeout,
+		TotalMemorySize: DefaultLimitsOfTotalMemorySize,
+	}
+	executionLimitsLock = sync.RWMutex{}
+)
+
+// SetExecutionLimits sets the limits of the contract executions, the zero timeout and memory
+// size keep the defaults.
+func SetExecutionLimits(limits ExecutionLimits) {
+	if limits.Timeout == 0 {
+		limits.Timeout = DefaultExecutionTimeout
+	}
+	if limits.TotalMemorySize == 0 {
+		limits.TotalMemorySize = DefaultLimitsOfTotalMemorySize
+	}
+
+	executionLimitsLock.Lock()
+	defer executionLimitsLock.Unlock()
+	executionLimits = limits
+}
+
+// GetExecutionLimits returns the limits of the contract executions.
+func GetExecutionLimits() ExecutionLimits {
+	executionLimitsLock.RLock()
+	defer executionLimitsLock.RUnlock()
+	return executionLimits
+}
+
+// ActivationHeights are the heights from which the consensus changes of the contract
+// execution take effect, set by the genesis params, 0 means never.
+type ActivationHeights struct {
+	// HostBindingsCheck rejects the contracts accessing the host bindings.
+	HostBindingsCheck uint64
+	// SeededRandom replaces Math.random by the generator seeded by the parent block and transaction hash.
+	SeededRandom uint64
+	// ContractTransfer credits the contract transfers to the decoded address, checks the values
+	// and charges gas for every transfer.
+	ContractTransfer uint64
+	// BlockContext adds the timestamp and parent hash to the block seen by the contracts.
+	BlockContext uint64
+}
+
 // Block interface breaks cycle import dependency and hides unused services.
 type Block interface {
 	CoinbaseHash() byteutils.Hash
 	Nonce() uint64
 	Hash() byteutils.Hash
 	Height() uint64
+	Timestamp() int64
+	ParentHash() byteutils.Hash
+	AncestorHash(height uint64) (byteutils.Hash, error)
 	VerifyAddress(str string) bool
+	RecoverAddress(alg uint8, hash, sign byteutils.Hash) (string, error)
 	SerializeTxByHash(hash byteutils.Hash) (proto.Message, error)
 	RecordEvent(txHash byteutils.Hash, topic, data string) error
+	RecordIndexedEvent(txHash byteutils.Hash, topic, data string, indexed []string) error
+	ActivationHeights() ActivationHeights
 }
 
 // AccountState context account state
@@ -53,11 +120,15 @@ type AccountState struct {
 }
 
 // ContextBlock warpper block
+// Timestamp and ParentHash are only set from the block context activation height,
+// below it the block keeps its original shape.
 type ContextBlock struct {
-	Coinbase string `json:"coinbase"`
-	Nonce    uint64 `json:"nonce"`
-	Hash     string `json:"hash"`
-	Height   uint64 `json:"height"`
+	Coinbase   string `json:"coinbase"`
+	Nonce      uint64 `json:"nonce"`
+	Hash       string `json:"hash"`
+	Height     uint64 `json:"height"`
+	Timestamp  int64  `json:"timestamp,omitempty"`
+	ParentHash string `json:"parentHash,omitempty"`
 }
 
 // ContextTransaction warpper transaction
@@ -108,6 +179,11 @@ func (ctx *Context) Contract() state.Account {
 	return ctx.contract
 }
 
+// activated returns whether the change activated at the height takes effect in current block.
+func (ctx *Context) activated(height uint64) bool {
+	return height > 0 && ctx.block.Height() >= height
+}
+
 // SerializeContextBlock Serialize current block
 func (ctx *Context) SerializeContextBlock() ([]byte, error) {
 
@@ -118,6 +194,10 @@ func (ctx *Context) SerializeContextBlock() ([]byte, error) {
 			Hash:     ctx.block.Hash().String(),
 			Height:   ctx.block.Height(),
 		}
+		if ctx.activated(ctx.block.ActivationHeights().BlockContext) {
+			block.Timestamp = ctx.block.Timestamp()
+			block.ParentHash = ctx.block.ParentHash().String()
+		}
 		return json.Marshal(block)
 	}
 	return nil, errors.New("no block in context")
@@ -0,0 +1,98 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import "C"
+import (
+	"unsafe"
+
+	"github.com/nebulasio/go-nebulas/crypto/hash"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// hash algorithms available to contracts.
+const (
+	CryptoSha256    = "sha256"
+	CryptoSha3256   = "sha3256"
+	CryptoRipemd160 = "ripemd160"
+)
+
+// CryptoHashFunc returns the hex hash of data
+//export CryptoHashFunc
+func CryptoHashFunc(handler unsafe.Pointer, alg, data *C.char) *C.char {
+	gAlg := C.GoString(alg)
+	gData := C.GoString(data)
+
+	var h []byte
+	switch gAlg {
+	case CryptoSha256:
+		h = hash.Sha256([]byte(gData))
+	case CryptoSha3256:
+		h = hash.Sha3256([]byte(gData))
+	case CryptoRipemd160:
+		h = hash.Ripemd160([]byte(gData))
+	default:
+		logging.VLog().WithFields(logrus.Fields{
+			"alg": gAlg,
+		}).Debug("CryptoHashFunc unsupported hash algorithm.")
+		return nil
+	}
+
+	ret := byteutils.Hex(h)
+	if e := getEngineByEngineHandler(handler); e != nil {
+		e.trace(TraceOpCryptoHash, ret, gAlg, gData)
+	}
+	return C.CString(ret)
+}
+
+// RecoverAddressFunc returns the address which signed the hash
+//export RecoverAddressFunc
+func RecoverAddressFunc(handler unsafe.Pointer, alg C.int, hash, sign *C.char) *C.char {
+	e := getEngineByEngineHandler(handler)
+	if e == nil || e.ctx.block == nil {
+		return nil
+	}
+
+	gHash := C.GoString(hash)
+	gSign := C.GoString(sign)
+	h, err := byteutils.FromHex(gHash)
+	if err != nil {
+		e.trace(TraceOpRecoverAddress, "", gHash, gSign)
+		return nil
+	}
+	s, err := byteutils.FromHex(gSign)
+	if err != nil {
+		e.trace(TraceOpRecoverAddress, "", gHash, gSign)
+		return nil
+	}
+
+	addr, err := e.ctx.block.RecoverAddress(uint8(alg), h, s)
+	e.trace(TraceOpRecoverAddress, addr, gHash, gSign)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"hash": gHash,
+			"sign": gSign,
+			"err":  err,
+		}).Debug("RecoverAddressFunc recover address failed.")
+		return nil
+	}
+	return C.CString(addr)
+}
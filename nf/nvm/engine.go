@@ -0,0 +1,61 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import "context"
+
+// Engine executes smart contracts, the payloads pick the engine by the source type of
+// the contract. V8 is the only runtime, it executes both JavaScript and TypeScript.
+type Engine interface {
+	SetCancelContext(ctx context.Context)
+	SetTracer(tracer *Tracer)
+	SetLocalExecution(local bool)
+	SetExecutionLimits(limitsOfExecutionInstructions, limitsOfTotalMemorySize uint64)
+	DeployAndInit(source, sourceType, args string) (string, error)
+	Call(source, sourceType, function, args string) (string, error)
+	ExecutionInstructions() uint64
+	GasRefund() uint64
+	Dispose()
+}
+
+// engineFactories create the engines by source type. The set is fixed, a new runtime
+// changes which contracts are valid and must be activated by the chain.
+var engineFactories = map[string]func(ctx *Context) Engine{
+	SourceTypeJavaScript: newV8Engine,
+	SourceTypeTypeScript: newV8Engine,
+}
+
+func newV8Engine(ctx *Context) Engine {
+	return NewV8Engine(ctx)
+}
+
+// IsSupportedSourceType returns if an engine executes the contracts of the source type.
+func IsSupportedSourceType(sourceType string) bool {
+	_, ok := engineFactories[sourceType]
+	return ok
+}
+
+// NewEngine returns the engine executing the contracts of the source type.
+func NewEngine(ctx *Context, sourceType string) (Engine, error) {
+	factory, ok := engineFactories[sourceType]
+	if !ok {
+		return nil, ErrUnsupportedSourceType
+	}
+	return factory(ctx), nil
+}
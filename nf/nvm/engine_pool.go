@@ -0,0 +1,172 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+/*
+#include <stdlib.h>
+#include "v8/engine.h"
+*/
+import "C"
+import (
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// const
+const (
+	// DefaultEnginePoolSize is the max count of idle isolates kept warm for reuse.
+	DefaultEnginePoolSize = 8
+
+	// DefaultEngineMaxUses is the count of executions after which an isolate is recreated,
+	// so the garbage left in the heap is bounded.
+	DefaultEngineMaxUses = 1000
+
+	// DefaultEngineLeakThreshold is the duration after which an acquired isolate not
+	// returned to the pool is reported as leaked.
+	DefaultEngineLeakThreshold = time.Minute
+)
+
+var (
+	enginePool = newV8EnginePool(DefaultEnginePoolSize, DefaultEngineMaxUses, DefaultEngineLeakThreshold)
+)
+
+type pooledEngineState struct {
+	uses       int
+	acquiredAt time.Time
+	leaked     bool
+}
+
+// v8EnginePool keeps the isolates of finished executions warm, only the executions not
+// affecting consensus use it since the heap statistics count in the memory limits.
+type v8EnginePool struct {
+	mu            sync.Mutex
+	idle          []*C.V8Engine
+	states        map[*C.V8Engine]*pooledEngineState
+	inUse         map[*C.V8Engine]*pooledEngineState
+	size          int
+	maxUses       int
+	leakThreshold time.Duration
+}
+
+func newV8EnginePool(size, maxUses int, leakThreshold time.Duration) *v8EnginePool {
+	return &v8EnginePool{
+		idle:          make([]*C.V8Engine, 0, size),
+		states:        make(map[*C.V8Engine]*pooledEngineState),
+		inUse:         make(map[*C.V8Engine]*pooledEngineState),
+		size:          size,
+		maxUses:       maxUses,
+		leakThreshold: leakThreshold,
+	}
+}
+
+// acquire returns an idle isolate, or a new one if the pool is empty.
+func (p *v8EnginePool) acquire() *C.V8Engine {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.detectLeaks()
+
+	var v8engine *C.V8Engine
+	if n := len(p.idle); n > 0 {
+		v8engine = p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		metricsEnginePoolHit.Inc(1)
+	} else {
+		v8engine = C.CreateEngine()
+		p.states[v8engine] = &pooledEngineState{}
+		metricsEnginePoolMiss.Inc(1)
+	}
+
+	state := p.states[v8engine]
+	state.uses++
+	state.acquiredAt = time.Now()
+	state.leaked = false
+	p.inUse[v8engine] = state
+
+	p.updateMetrics()
+	return v8engine
+}
+
+// release resets the isolate and keeps it for reuse, the isolate is deleted if the
+// execution was terminated, it is worn out or the pool is full.
+func (p *v8EnginePool) release(v8engine *C.V8Engine) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	state, ok := p.inUse[v8engine]
+	if !ok {
+		C.DeleteEngine(v8engine)
+		return
+	}
+	delete(p.inUse, v8engine)
+
+	if v8engine.is_requested_terminate_execution != 0 || state.uses >= p.maxUses || len(p.idle) >= p.size {
+		delete(p.states, v8engine)
+		C.DeleteEngine(v8engine)
+		p.updateMetrics()
+		return
+	}
+
+	C.ResetEngine(v8engine)
+	p.idle = append(p.idle, v8engine)
+	p.updateMetrics()
+}
+
+// detectLeaks reports the isolates held longer than the threshold, once per acquisition.
+func (p *v8EnginePool) detectLeaks() {
+	for _, state := range p.inUse {
+		if state.leaked || time.Since(state.acquiredAt) < p.leakThreshold {
+			continue
+		}
+		state.leaked = true
+		metricsEnginePoolLeak.Inc(1)
+		logging.VLog().WithFields(logrus.Fields{
+			"acquiredAt": state.acquiredAt,
+			"uses":       state.uses,
+		}).Warn("Pooled nvm engine is not disposed.")
+	}
+}
+
+func (p *v8EnginePool) updateMetrics() {
+	metricsEnginePoolIdle.Update(int64(len(p.idle)))
+	metricsEnginePoolInUse.Update(int64(len(p.inUse)))
+}
+
+// NewPooledV8Engine returns a V8Engine running on a warm isolate from the pool, the isolate
+// is returned to the pool when the engine is disposed. The heap of a reused isolate is not
+// fresh, so the engine must not be used for the executions affecting consensus.
+func NewPooledV8Engine(ctx *Context) *V8Engine {
+	v8engineOnce.Do(func() {
+		InitV8Engine()
+	})
+	return newV8EngineWith(ctx, enginePool.acquire(), enginePool)
+}
+
+// NewPooledEngine returns the engine executing the contracts of the source type, V8 engines
+// run on warm isolates from the pool. It is meant for the read-only local executions.
+func NewPooledEngine(ctx *Context, sourceType string) (Engine, error) {
+	switch sourceType {
+	case SourceTypeJavaScript, SourceTypeTypeScript:
+		return NewPooledV8Engine(ctx), nil
+	}
+	return NewEngine(ctx, sourceType)
+}
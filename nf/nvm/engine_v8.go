@@ -33,17 +33,23 @@ char *RequireDelegateFunc_cgo(void *handler, const char *filename, size_t *lineO
 char *StorageGetFunc_cgo(void *handler, const char *key);
 int StoragePutFunc_cgo(void *handler, const char *key, const char *value);
 int StorageDelFunc_cgo(void *handler, const char *key);
+char *StorageIterFunc_cgo(void *handler, const char *domain, size_t offset, size_t limit, size_t *usage);
 
 char *GetTxByHashFunc_cgo(void *handler, const char *hash);
 char *GetAccountStateFunc_cgo(void *handler, const char *address);
 int TransferFunc_cgo(void *handler, const char *to, const char *value);
 int VerifyAddressFunc_cgo(void *handler, const char *address);
+char *GetBlockHashFunc_cgo(void *handler, const char *height);
 
-void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data);
+void EventTriggerFunc_cgo(void *handler, const char *topic, const char *data, const char *indexed);
+
+char *CryptoHashFunc_cgo(void *handler, const char *alg, const char *data);
+char *RecoverAddressFunc_cgo(void *handler, int alg, const char *hash, const char *sign);
 
 */
 import "C"
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -61,6 +67,10 @@ import (
 const (
 	SourceTypeJavaScript = "js"
 	SourceTypeTypeScript = "ts"
+
+	// TypeScriptCompilerVersion is the version of the bundled TypeScript compiler, which must
+	// transpile every TypeScript contract so that all nodes execute the same JavaScript.
+	TypeScriptCompilerVersion = "2.6.1"
 )
 
 // Errors
@@ -68,8 +78,10 @@ var (
 	ErrExecutionFailed                = errors.New("execution failed")
 	ErrDisallowCallPrivateFunction    = errors.New("disallow call private function")
 	ErrExecutionTimeout               = errors.New("execution timeout")
+	ErrExecutionCancelled             = errors.New("execution cancelled")
 	ErrInsufficientGas                = errors.New("insufficient gas")
 	ErrExceedMemoryLimits             = errors.New("exceed memory limits")
+	ErrExceedInstructionLimits        = errors.New("exceed instruction limits")
 	ErrInjectTracingInstructionFailed = errors.New("inject tracing instructions failed")
 	ErrTranspileTypeScriptFailed      = errors.New("transpile TypeScript failed")
 	ErrUnsupportedSourceType          = errors.New("unsupported source type")
@@ -97,6 +109,13 @@ type V8Engine struct {
 	actualTotalMemorySize              uint64
 	lcsHandler                         uint64
 	gcsHandler                         uint64
+	cancelCtx                          context.Context
+	tracer                             *Tracer
+	pool                               *v8EnginePool
+	executionTimeout                   time.Duration
+	gasRefund                          uint64
+	localExecution                     bool
+	limitedByInstructions              bool
 }
 
 // InitV8Engine initialize the v8 engine.
@@ -110,13 +129,16 @@ func InitV8Engine() {
 	C.InitializeRequireDelegate((C.RequireDelegate)(unsafe.Pointer(C.RequireDelegateFunc_cgo)))
 
 	// Storage.
-	C.InitializeStorage((C.StorageGetFunc)(unsafe.Pointer(C.StorageGetFunc_cgo)), (C.StoragePutFunc)(unsafe.Pointer(C.StoragePutFunc_cgo)), (C.StorageDelFunc)(unsafe.Pointer(C.StorageDelFunc_cgo)))
+	C.InitializeStorage((C.StorageGetFunc)(unsafe.Pointer(C.StorageGetFunc_cgo)), (C.StoragePutFunc)(unsafe.Pointer(C.StoragePutFunc_cgo)), (C.StorageDelFunc)(unsafe.Pointer(C.StorageDelFunc_cgo)), (C.StorageIterFunc)(unsafe.Pointer(C.StorageIterFunc_cgo)))
 
 	// Blockchain.
-	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)))
+	C.InitializeBlockchain((C.GetTxByHashFunc)(unsafe.Pointer(C.GetTxByHashFunc_cgo)), (C.GetAccountStateFunc)(unsafe.Pointer(C.GetAccountStateFunc_cgo)), (C.TransferFunc)(unsafe.Pointer(C.TransferFunc_cgo)), (C.VerifyAddressFunc)(unsafe.Pointer(C.VerifyAddressFunc_cgo)), (C.GetBlockHashFunc)(unsafe.Pointer(C.GetBlockHashFunc_cgo)))
 
 	// Event.
 	C.InitializeEvent((C.EventTriggerFunc)(unsafe.Pointer(C.EventTriggerFunc_cgo)))
+
+	// Crypto.
+	C.InitializeCrypto((C.CryptoHashFunc)(unsafe.Pointer(C.CryptoHashFunc_cgo)), (C.RecoverAddressFunc)(unsafe.Pointer(C.RecoverAddressFunc_cgo)))
 }
 
 // DisposeV8Engine dispose the v8 engine.
@@ -130,10 +152,16 @@ func NewV8Engine(ctx *Context) *V8Engine {
 		InitV8Engine()
 	})
 
+	return newV8EngineWith(ctx, C.CreateEngine(), nil)
+}
+
+func newV8EngineWith(ctx *Context, v8engine *C.V8Engine, pool *v8EnginePool) *V8Engine {
 	engine := &V8Engine{
 		ctx:                                ctx,
 		modules:                            NewModules(),
-		v8engine:                           C.CreateEngine(),
+		v8engine:                           v8engine,
+		pool:                               pool,
+		executionTimeout:                   GetExecutionLimits().Timeout,
 		enableLimits:                       false,
 		limitsOfExecutionInstructions:      0,
 		limitsOfTotalMemorySize:            0,
@@ -173,9 +201,38 @@ func (e *V8Engine) Dispose() {
 	delete(engines, e.v8engine)
 	enginesLock.Unlock()
 
+	if e.pool != nil {
+		e.pool.release(e.v8engine)
+		return
+	}
 	C.DeleteEngine(e.v8engine)
 }
 
+// SetCancelContext terminates the execution when ctx is done.
+func (e *V8Engine) SetCancelContext(ctx context.Context) {
+	e.cancelCtx = ctx
+}
+
+// SetTracer set the tracer to record the execution steps, nil disables tracing.
+func (e *V8Engine) SetTracer(tracer *Tracer) {
+	e.tracer = tracer
+}
+
+// SetLocalExecution set whether the execution is local and never affects consensus,
+// the limits configured by the node apply to the local executions only. It must be set
+// before the execution limits.
+func (e *V8Engine) SetLocalExecution(local bool) {
+	e.localExecution = local
+}
+
+// trace records a contract api call when tracing is enabled.
+func (e *V8Engine) trace(op, result string, args ...string) {
+	if e.tracer == nil {
+		return
+	}
+	e.tracer.record(uint64(e.v8engine.stats.count_of_executed_instructions), op, result, args...)
+}
+
 // Context returns engine context
 func (e *V8Engine) Context() *Context {
 	return e.ctx
@@ -192,6 +249,18 @@ func (e *V8Engine) SetTestingFlag(flag bool) {
 
 // SetExecutionLimits set execution limits of V8 Engine, prevent Halting Problem.
 func (e *V8Engine) SetExecutionLimits(limitsOfExecutionInstructions, limitsOfTotalMemorySize uint64) {
+	// the limits configured by the node replace the memory limits and cap the gas limit of
+	// the local executions, the executions in blocks are limited the same on every node.
+	e.limitedByInstructions = false
+	if e.localExecution {
+		limits := GetExecutionLimits()
+		limitsOfTotalMemorySize = limits.TotalMemorySize
+		if limit := limits.Instructions; limit > 0 && (limitsOfExecutionInstructions == 0 || limitsOfExecutionInstructions > limit) {
+			limitsOfExecutionInstructions = limit
+			e.limitedByInstructions = true
+		}
+	}
+
 	e.v8engine.limits_of_executed_instructions = C.size_t(limitsOfExecutionInstructions)
 	e.v8engine.limits_of_total_memory_size = C.size_t(limitsOfTotalMemorySize)
 
@@ -215,13 +284,20 @@ func (e *V8Engine) ExecutionInstructions() uint64 {
 	return e.actualCountOfExecutionInstructions
 }
 
+// GasRefund returns the gas refunded for the storage entries deleted in execution.
+func (e *V8Engine) GasRefund() uint64 {
+	return e.gasRefund
+}
+
 // TranspileTypeScript transpile typescript to javascript and return it.
 func (e *V8Engine) TranspileTypeScript(source string) (string, int, error) {
 	cSource := C.CString(source)
 	defer C.free(unsafe.Pointer(cSource))
+	cVersion := C.CString(TypeScriptCompilerVersion)
+	defer C.free(unsafe.Pointer(cVersion))
 
 	lineOffset := C.int(0)
-	jsSource := C.TranspileTypeScriptModule(e.v8engine, cSource, &lineOffset)
+	jsSource := C.TranspileTypeScriptModule(e.v8engine, cSource, cVersion, &lineOffset)
 	if jsSource == nil {
 		return "", 0, ErrTranspileTypeScriptFailed
 	}
@@ -231,13 +307,34 @@ func (e *V8Engine) TranspileTypeScript(source string) (string, int, error) {
 
 }
 
+// transferChecked returns whether the contract transfers are checked and charged, from the
+// contract transfer height in genesis params.
+func (e *V8Engine) transferChecked() bool {
+	return e.ctx.block != nil && e.ctx.activated(e.ctx.block.ActivationHeights().ContractTransfer)
+}
+
 // InjectTracingInstructions process the source to inject tracing instructions.
 func (e *V8Engine) InjectTracingInstructions(source string) (string, int, error) {
+	return e.injectTracingInstructions(source, false)
+}
+
+// injectTracingInstructions process the source to inject tracing instructions, the source
+// accessing the host bindings is rejected if checkBindings.
+func (e *V8Engine) injectTracingInstructions(source string, checkBindings bool) (string, int, error) {
 	cSource := C.CString(source)
 	defer C.free(unsafe.Pointer(cSource))
 
+	cCheckBindings := C.int(0)
+	if checkBindings {
+		cCheckBindings = C.int(1)
+	}
+	cChargeTransfer := C.int(0)
+	if e.transferChecked() {
+		cChargeTransfer = C.int(1)
+	}
+
 	lineOffset := C.int(0)
-	traceableCSource := C.InjectTracingInstructions(e.v8engine, cSource, &lineOffset)
+	traceableCSource := C.InjectTracingInstructions(e.v8engine, cSource, &lineOffset, cCheckBindings, cChargeTransfer)
 	if traceableCSource == nil {
 		return "", 0, ErrInjectTracingInstructionFailed
 	}
@@ -265,6 +362,11 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (result
 		source = traceableSource
 		sourceLineOffset += traceableSourceLineOffset
 	}
+	// the values of the contract transfers are checked from the activation, the flag
+	// is set untraced and in the first line.
+	if e.transferChecked() {
+		source = "Blockchain.transferChecked = true;" + source
+	}
 
 	cSource := C.CString(source)
 	defer C.free(unsafe.Pointer(cSource))
@@ -278,6 +380,7 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (result
 		}
 	}()
 
+	start := time.Now()
 	done := make(chan bool, 1)
 	go func() {
 		ret = C.RunScriptSource(&cJSONResult, e.v8engine, cSource, C.int(sourceLineOffset), C.uintptr_t(e.lcsHandler),
@@ -285,12 +388,26 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (result
 		done <- true
 	}()
 
+	// nil channel never receives if no cancel context is set.
+	var cancelCh <-chan struct{}
+	if e.cancelCtx != nil {
+		cancelCh = e.cancelCtx.Done()
+	}
+
 	select {
 	case <-done:
 		if ret != 0 {
 			err = ErrExecutionFailed
 		}
-	case <-time.After(10 * time.Second):
+	case <-cancelCh:
+		C.TerminateExecution(e.v8engine)
+		err = ErrExecutionCancelled
+
+		// wait for C.RunScriptSource() returns.
+		select {
+		case <-done:
+		}
+	case <-time.After(e.executionTimeout):
 		C.TerminateExecution(e.v8engine)
 		err = ErrExecutionTimeout
 
@@ -300,13 +417,17 @@ func (e *V8Engine) RunScriptSource(source string, sourceLineOffset int) (result
 		}
 	}
 
+	metricsExecutionDuration.Observe(time.Since(start).Seconds())
+
 	// collect tracing stats.
 	e.CollectTracingStats()
 
 	if e.enableLimits {
 		// check limits.
 		ret = C.IsEngineLimitsExceeded(e.v8engine)
-		if ret == 1 {
+		if ret == 1 && e.limitedByInstructions {
+			err = ErrExceedInstructionLimits
+		} else if ret == 1 {
 			err = ErrInsufficientGas
 		} else if ret == 2 {
 			err = ErrExceedMemoryLimits
@@ -344,8 +465,8 @@ func (e *V8Engine) RunContractScript(source, sourceType, function, args string)
 		runnableSource, sourceLineOffset, err = e.prepareRunnableContractScript(source, function, args)
 	case SourceTypeTypeScript:
 		// transpile to javascript.
-		jsSource, _, err := e.TranspileTypeScript(source)
-		if err != nil {
+		var jsSource string
+		if jsSource, _, err = e.TranspileTypeScript(source); err != nil {
 			return "", err
 		}
 		runnableSource, sourceLineOffset, err = e.prepareRunnableContractScript(jsSource, function, args)
@@ -357,14 +478,28 @@ func (e *V8Engine) RunContractScript(source, sourceType, function, args string)
 		return "", err
 	}
 
-	return e.RunScriptSource(runnableSource, sourceLineOffset)
+	if e.tracer == nil {
+		return e.RunScriptSource(runnableSource, sourceLineOffset)
+	}
+
+	result, err := e.RunScriptSource(fmt.Sprintf(tracedScriptTemplate, runnableSource), sourceLineOffset)
+	if err != nil {
+		e.tracer.Stack = result
+	}
+	e.trace(TraceOpExecutionResult, result)
+	return result, err
 }
 
 // AddModule add module.
 func (e *V8Engine) AddModule(id, source string, sourceLineOffset int) error {
+	return e.addModule(id, source, sourceLineOffset, false)
+}
+
+// addModule add module, the source accessing the host bindings is rejected if checkBindings.
+func (e *V8Engine) addModule(id, source string, sourceLineOffset int, checkBindings bool) error {
 	// inject tracing instruction when enable limits.
 	if e.enableLimits {
-		traceableSource, lineOffset, err := e.InjectTracingInstructions(source)
+		traceableSource, lineOffset, err := e.injectTracingInstructions(source, checkBindings)
 		if err != nil {
 			logging.VLog().WithFields(logrus.Fields{
 				"err": err,
@@ -384,7 +519,8 @@ func (e *V8Engine) prepareRunnableContractScript(source, function, args string)
 
 	// add module.
 	const ModuleID string = "contract.js"
-	if err := e.AddModule(ModuleID, source, sourceLineOffset); err != nil {
+	checkBindings := e.ctx.activated(e.ctx.block.ActivationHeights().HostBindingsCheck)
+	if err := e.addModule(ModuleID, source, sourceLineOffset, checkBindings); err != nil {
 		return "", 0, err
 	}
 
@@ -393,10 +529,14 @@ func (e *V8Engine) prepareRunnableContractScript(source, function, args string)
 	txJSON, _ := e.ctx.SerializeContextTx()
 	var runnableSource string
 
+	// the native Math.random is kept before the seeded one activated.
+	if e.ctx.activated(e.ctx.block.ActivationHeights().SeededRandom) {
+		runnableSource = "require(\"random.js\").install();\n"
+	}
 	if len(args) > 0 {
-		runnableSource = fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n __instance[\"%s\"].apply(__instance, JSON.parse(\"%s\"));\n", ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function, formatArgs(args))
+		runnableSource += fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n __instance[\"%s\"].apply(__instance, JSON.parse(\"%s\"));\n", ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function, formatArgs(args))
 	} else {
-		runnableSource = fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n __instance[\"%s\"].apply(__instance);\n", ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function)
+		runnableSource += fmt.Sprintf("var __contract = require(\"%s\");\n var __instance = new __contract();\n Blockchain.blockParse(\"%s\");\n Blockchain.transactionParse(\"%s\");\n __instance[\"%s\"].apply(__instance);\n", ModuleID, formatArgs(string(blockJSON)), formatArgs(string(txJSON)), function)
 	}
 	return runnableSource, 0, nil
 }
@@ -21,6 +21,8 @@ package nvm
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"io/ioutil"
@@ -28,6 +30,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"encoding/json"
 
@@ -47,6 +50,7 @@ func TestMain(m *testing.M) {
 }
 
 type mockBlock struct {
+	heights ActivationHeights
 }
 
 func (m *mockBlock) CoinbaseHash() byteutils.Hash {
@@ -65,14 +69,45 @@ func (m *mockBlock) Height() uint64 {
 	return 2
 }
 
+func (m *mockBlock) Timestamp() int64 {
+	return 1517389120
+}
+
+func (m *mockBlock) ParentHash() byteutils.Hash {
+	hash, _ := byteutils.FromHex("5e6d587f26121f96a07cf4b8b569aac1")
+	return hash
+}
+
+func (m *mockBlock) AncestorHash(height uint64) (byteutils.Hash, error) {
+	if height != m.Height()-1 {
+		return nil, errors.New("ancestor height out of range")
+	}
+	return m.ParentHash(), nil
+}
+
 func (m *mockBlock) VerifyAddress(str string) bool {
 	return true
 }
 
+func (m *mockBlock) RecoverAddress(alg uint8, hash, sign byteutils.Hash) (string, error) {
+	if alg != 1 || len(sign) != 65 {
+		return "", errors.New("invalid signature")
+	}
+	return "8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf", nil
+}
+
 func (m *mockBlock) RecordEvent(txHash byteutils.Hash, topic, data string) error {
 	return nil
 }
 
+func (m *mockBlock) RecordIndexedEvent(txHash byteutils.Hash, topic, data string, indexed []string) error {
+	return nil
+}
+
+func (m *mockBlock) ActivationHeights() ActivationHeights {
+	return m.heights
+}
+
 func (m *mockBlock) SerializeTxByHash(hash byteutils.Hash) (proto.Message, error) {
 	from, _ := byteutils.FromHex("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf")
 	to, _ := byteutils.FromHex("22ac3a9a2b1c31b7a9084e46eae16e761f83f02324092b09")
@@ -116,6 +151,8 @@ func TestRunScriptSource(t *testing.T) {
 		{"test/test_storage_handlers.js", nil},
 		{"test/test_storage_class.js", nil},
 		{"test/test_storage.js", nil},
+		{"test/test_random.js", nil},
+		{"test/test_decimal.js", nil},
 		{"test/test_eval.js", ErrExecutionFailed},
 	}
 
@@ -143,15 +180,16 @@ func TestRunScriptSource(t *testing.T) {
 func TestRunScriptSourceInModule(t *testing.T) {
 	tests := []struct {
 		filepath    string
+		deps        []string
 		expectedErr error
 	}{
-		{"./test/test_require.js", nil},
-		{"./test/test_console.js", nil},
-		{"./test/test_storage_handlers.js", nil},
-		{"./test/test_storage_class.js", nil},
-		{"./test/test_storage.js", nil},
-		{"./test/test_ERC20.js", nil},
-		{"./test/test_eval.js", ErrExecutionFailed},
+		{"./test/test_require.js", nil, nil},
+		{"./test/test_console.js", nil, nil},
+		{"./test/test_storage_handlers.js", nil, nil},
+		{"./test/test_storage_class.js", nil, nil},
+		{"./test/test_storage.js", nil, nil},
+		{"./test/test_ERC20.js", []string{"./test/ERC20.js"}, nil},
+		{"./test/test_eval.js", nil, ErrExecutionFailed},
 	}
 
 	for _, tt := range tests {
@@ -168,6 +206,12 @@ func TestRunScriptSourceInModule(t *testing.T) {
 
 			engine := NewV8Engine(ctx)
 			engine.SetExecutionLimits(100000, 10000000)
+			// the modules not added are never read from file.
+			for _, dep := range tt.deps {
+				depData, err := ioutil.ReadFile(dep)
+				assert.Nil(t, err, "dep read error")
+				engine.AddModule(dep, string(depData), 0)
+			}
 			engine.AddModule(tt.filepath, string(data), 0)
 			runnableSource := fmt.Sprintf("require(\"%s\");", tt.filepath)
 			_, err = engine.RunScriptSource(runnableSource, 0)
@@ -273,6 +317,26 @@ func TestRunScriptSourceTimeout(t *testing.T) {
 	}
 }
 
+func TestRunScriptSourceCancel(t *testing.T) {
+	data, err := ioutil.ReadFile("test/test_infinite_loop.js")
+	assert.Nil(t, err, "filepath read error")
+
+	mem, _ := storage.NewMemoryStorage()
+	accState, _ := state.NewAccountState(nil, mem)
+	owner := accState.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := accState.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, accState)
+
+	cancelCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	engine := NewV8Engine(ctx)
+	engine.SetCancelContext(cancelCtx)
+	_, err = engine.RunScriptSource(string(data), 0)
+	assert.Equal(t, ErrExecutionCancelled, err)
+	engine.Dispose()
+}
+
 func TestDeployAndInitAndCall(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -330,6 +394,76 @@ func TestDeployAndInitAndCall(t *testing.T) {
 	}
 }
 
+func TestDeployAndInitError(t *testing.T) {
+	source := `var Contract = function () {};
+Contract.prototype = {
+	init: function (name) {
+		if (!name) {
+			throw new Error("bad init");
+		}
+	}
+};
+module.exports = Contract;`
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(10000, 10000000)
+	result, err := engine.DeployAndInit(source, "js", "")
+	assert.Equal(t, ErrExecutionFailed, err)
+	assert.Contains(t, result, "Error: bad init")
+	assert.Contains(t, result, "contract.js:")
+	engine.Dispose()
+}
+
+func TestTracer(t *testing.T) {
+	data, err := ioutil.ReadFile("./test/sample_contract.js")
+	assert.Nil(t, err, "contract path read error")
+	args := "[\"TEST001\", 123,[{\"name\":\"robin\",\"count\":2}]]"
+
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	owner.AddBalance(util.NewUint128FromInt(10000000))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	tracer := NewTracer()
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(10000, 10000000)
+	engine.SetTracer(tracer)
+	_, err = engine.DeployAndInit(string(data), "js", args)
+	assert.Nil(t, err)
+	engine.Dispose()
+
+	assert.True(t, len(tracer.Steps) > 1)
+	assert.Equal(t, TraceOpStoragePut, tracer.Steps[0].Op)
+	assert.Equal(t, TraceOpExecutionResult, tracer.Steps[len(tracer.Steps)-1].Op)
+	gas := uint64(0)
+	for _, step := range tracer.Steps {
+		gas += step.Gas
+	}
+	assert.Equal(t, engine.ExecutionInstructions(), gas)
+	assert.Equal(t, "", tracer.Stack)
+
+	// the stack is recorded on failure.
+	tracer = NewTracer()
+	engine = NewV8Engine(ctx)
+	engine.SetExecutionLimits(10000, 10000000)
+	engine.SetTracer(tracer)
+	_, err = engine.Call(string(data), "js", "verify", "[\"TEST002\", 123, []]")
+	assert.Equal(t, ErrExecutionFailed, err)
+	engine.Dispose()
+
+	assert.Equal(t, TraceOpStorageGet, tracer.Steps[0].Op)
+	assert.Contains(t, tracer.Stack, "name is not the same")
+	assert.Contains(t, tracer.Stack, "contract.js")
+}
+
 func TestContracts(t *testing.T) {
 	type fields struct {
 		function string
@@ -644,6 +778,114 @@ func TestBlockChain(t *testing.T) {
 	}
 }
 
+func TestSerializeContextBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		height   uint64
+		expected string
+	}{
+		{"inactive", 0, `{"coinbase":"386132303963656330326362656162376532663734616439363964326466653864643234343136616136353538396266","nonce":1,"hash":"6337313734373539653836633539646362376466383764656638326636316562","height":2}`},
+		{"not reached", 3, `{"coinbase":"386132303963656330326362656162376532663734616439363964326466653864643234343136616136353538396266","nonce":1,"hash":"6337313734373539653836633539646362376466383764656638326636316562","height":2}`},
+		{"active", 2, `{"coinbase":"386132303963656330326362656162376532663734616439363964326466653864643234343136616136353538396266","nonce":1,"hash":"6337313734373539653836633539646362376466383764656638326636316562","height":2,"timestamp":1517389120,"parentHash":"5e6d587f26121f96a07cf4b8b569aac1"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			block := &mockBlock{heights: ActivationHeights{BlockContext: tt.height}}
+			ctx := NewContext(block, testContextTransaction(), nil, nil, nil)
+			data, err := ctx.SerializeContextBlock()
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, string(data))
+		})
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	contract.AddBalance(util.NewUint128FromInt(10))
+	block := &mockBlock{heights: ActivationHeights{ContractTransfer: 2}}
+	ctx := NewContext(block, testContextTransaction(), owner, contract, context)
+
+	to := "8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"
+	toKey, _ := byteutils.FromHex(to)
+
+	tests := []struct {
+		source   string
+		err      error
+		contract int64
+		balance  int64
+	}{
+		{"if (Blockchain.transfer(\"" + to + "\", 3) != 0) { throw new Error(); }", nil, 7, 3},
+		{"if (Blockchain.transfer(\"0x" + to + "\", new BigNumber(2)) != 0) { throw new Error(); }", nil, 5, 5},
+		{"if (Blockchain.transfer(\"" + to + "\", 6) != 0) { throw new Error(); }", ErrExecutionFailed, 5, 5},
+		{"Blockchain.transfer(\"" + to + "\", -1);", ErrExecutionFailed, 5, 5},
+		{"Blockchain.transfer(\"" + to + "\", 1.5);", ErrExecutionFailed, 5, 5},
+	}
+
+	for _, tt := range tests {
+		engine := NewV8Engine(ctx)
+		engine.SetExecutionLimits(1000, 10000000)
+		_, err := engine.RunScriptSource(tt.source, 0)
+		assert.Equal(t, tt.err, err)
+		if err == nil {
+			// the transfer is charged.
+			assert.True(t, engine.ExecutionInstructions() >= 100)
+		}
+		engine.Dispose()
+
+		assert.Equal(t, tt.contract, contract.Balance().Int64())
+		assert.Equal(t, tt.balance, context.GetOrCreateUserAccount(toKey).Balance().Int64())
+	}
+
+	// before the activation the raw address is credited and the transfer is not charged.
+	block.heights.ContractTransfer = 3
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(1000, 10000000)
+	_, err := engine.RunScriptSource("if (Blockchain.transfer(\""+to+"\", 3) != 0) { throw new Error(); }", 0)
+	assert.Nil(t, err)
+	assert.True(t, engine.ExecutionInstructions() < 100)
+	engine.Dispose()
+	assert.Equal(t, int64(2), contract.Balance().Int64())
+	assert.Equal(t, int64(5), context.GetOrCreateUserAccount(toKey).Balance().Int64())
+	assert.Equal(t, int64(3), context.GetOrCreateUserAccount([]byte(to)).Balance().Int64())
+}
+
+func TestCrypto(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	hash := "77bf6aaa1445aafcb4d6ffe3803d868e3b92cb7663a57d0d48fc3c2bbde35f23"
+	sign := strings.Repeat("01", 65)
+	signer := "8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf"
+
+	tests := []struct {
+		source string
+		err    error
+	}{
+		{"if (Crypto.sha256(\"nebulas\") !== \"8dfd52731ae376870f7622eb64d5b9d5683107924f93ddba7bcc19bbe1a0ee80\") { throw new Error(); }", nil},
+		{"if (Crypto.sha3256(\"nebulas\") !== \"" + hash + "\") { throw new Error(); }", nil},
+		{"if (Crypto.ripemd160(\"nebulas\") !== \"c8962fc4788bfb5d3d69c690bddf243bc6ad4592\") { throw new Error(); }", nil},
+		{"if (Crypto.recoverAddress(Crypto.SECP256K1, \"" + hash + "\", \"" + sign + "\") !== \"" + signer + "\") { throw new Error(); }", nil},
+		{"if (!Crypto.verifySignature(Crypto.SECP256K1, \"" + hash + "\", \"" + sign + "\", \"0x" + signer + "\")) { throw new Error(); }", nil},
+		{"if (Crypto.recoverAddress(Crypto.SECP256K1, \"" + hash + "\", \"01\") !== null) { throw new Error(); }", nil},
+		{"Crypto.recoverAddress(\"1\", \"" + hash + "\", \"" + sign + "\");", ErrExecutionFailed},
+	}
+
+	for _, tt := range tests {
+		engine := NewV8Engine(ctx)
+		engine.SetExecutionLimits(10000, 10000000)
+		_, err := engine.RunScriptSource(tt.source, 0)
+		assert.Equal(t, tt.err, err, tt.source)
+		engine.Dispose()
+	}
+}
+
 func TestBankVaultContract(t *testing.T) {
 	type TakeoutTest struct {
 		args          string
@@ -773,7 +1015,284 @@ func TestEvent(t *testing.T) {
 			engine := NewV8Engine(ctx)
 			engine.SetExecutionLimits(100000, 10000000)
 			_, err = engine.RunScriptSource(string(data), 0)
+			assert.Nil(t, err)
 			engine.Dispose()
 		})
 	}
 }
+
+func TestNewEngine(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	context, _ := state.NewAccountState(nil, mem)
+	owner := context.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := context.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, context)
+
+	for _, sourceType := range []string{SourceTypeJavaScript, SourceTypeTypeScript} {
+		assert.True(t, IsSupportedSourceType(sourceType))
+		engine, err := NewEngine(ctx, sourceType)
+		assert.Nil(t, err)
+		_, ok := engine.(*V8Engine)
+		assert.True(t, ok)
+		engine.Dispose()
+	}
+
+	assert.False(t, IsSupportedSourceType("unknown"))
+	_, err := NewEngine(ctx, "unknown")
+	assert.Equal(t, ErrUnsupportedSourceType, err)
+}
+
+func TestPooledV8Engine(t *testing.T) {
+	data, err := ioutil.ReadFile("test/test_storage.js")
+	assert.Nil(t, err, "filepath read error")
+
+	mem, _ := storage.NewMemoryStorage()
+	accState, _ := state.NewAccountState(nil, mem)
+	owner := accState.GetOrCreateUserAccount([]byte("account1"))
+	owner.AddBalance(util.NewUint128FromInt(1000000000))
+	contract, _ := accState.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, accState)
+
+	engine := NewPooledV8Engine(ctx)
+	engine.SetExecutionLimits(900000, 10000000)
+	_, err = engine.RunScriptSource(string(data), 0)
+	assert.Nil(t, err)
+	instructions := engine.ExecutionInstructions()
+	v8engine := engine.v8engine
+	engine.Dispose()
+
+	// the warm isolate is reused with its state reset.
+	engine = NewPooledV8Engine(ctx)
+	assert.Equal(t, v8engine, engine.v8engine)
+	engine.SetExecutionLimits(900000, 10000000)
+	_, err = engine.RunScriptSource(string(data), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, instructions, engine.ExecutionInstructions())
+	engine.Dispose()
+
+	// the isolate of a terminated execution is not reused.
+	cancelCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	engine = NewPooledV8Engine(ctx)
+	assert.Equal(t, v8engine, engine.v8engine)
+	engine.SetCancelContext(cancelCtx)
+	_, err = engine.RunScriptSource("while (true) {}", 0)
+	assert.Equal(t, ErrExecutionCancelled, err)
+	engine.Dispose()
+
+	enginePool.mu.Lock()
+	for _, idle := range enginePool.idle {
+		assert.NotEqual(t, v8engine, idle)
+	}
+	enginePool.mu.Unlock()
+
+	// engines of unknown source types are not pooled.
+	_, err = NewPooledEngine(ctx, "unknown")
+	assert.Equal(t, ErrUnsupportedSourceType, err)
+}
+
+func TestConfiguredExecutionLimits(t *testing.T) {
+	source := "while (true) {}"
+
+	mem, _ := storage.NewMemoryStorage()
+	accState, _ := state.NewAccountState(nil, mem)
+	owner := accState.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := accState.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, accState)
+	defer SetExecutionLimits(ExecutionLimits{})
+
+	// the configured instructions cap the gas limit of the local executions.
+	SetExecutionLimits(ExecutionLimits{Instructions: 1000})
+	assert.Equal(t, DefaultExecutionTimeout, GetExecutionLimits().Timeout)
+	assert.Equal(t, DefaultLimitsOfTotalMemorySize, GetExecutionLimits().TotalMemorySize)
+
+	engine := NewV8Engine(ctx)
+	engine.SetLocalExecution(true)
+	engine.SetExecutionLimits(100000, 10000000)
+	_, err := engine.RunScriptSource(source, 0)
+	assert.Equal(t, ErrExceedInstructionLimits, err)
+	assert.Equal(t, uint64(1000), engine.ExecutionInstructions())
+	engine.Dispose()
+
+	// the executions in blocks are limited by the gas limit only.
+	engine = NewV8Engine(ctx)
+	engine.SetExecutionLimits(100000, 10000000)
+	_, err = engine.RunScriptSource(source, 0)
+	assert.Equal(t, ErrInsufficientGas, err)
+	assert.Equal(t, uint64(100000), engine.ExecutionInstructions())
+	engine.Dispose()
+
+	// the gas limit below the configured instructions runs out of gas.
+	engine = NewV8Engine(ctx)
+	engine.SetLocalExecution(true)
+	engine.SetExecutionLimits(500, 10000000)
+	_, err = engine.RunScriptSource(source, 0)
+	assert.Equal(t, ErrInsufficientGas, err)
+	engine.Dispose()
+
+	// the execution is aborted after the configured timeout.
+	SetExecutionLimits(ExecutionLimits{Timeout: 100 * time.Millisecond})
+	engine = NewV8Engine(ctx)
+	_, err = engine.RunScriptSource(source, 0)
+	assert.Equal(t, ErrExecutionTimeout, err)
+	engine.Dispose()
+}
+
+func TestStorageDelGasRefund(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	accState, _ := state.NewAccountState(nil, mem)
+	owner := accState.GetOrCreateUserAccount([]byte("account1"))
+	contract, _ := accState.CreateContractAccount([]byte("account2"), nil)
+	ctx := NewContext(testContextBlock(), testContextTransaction(), owner, contract, accState)
+
+	// only the existing entries deleted are refunded.
+	source := `var stor = new NativeStorage(_native_storage_handlers.lcs);
+stor.put('key', 'value');
+stor.del('key');
+stor.del('missing');`
+
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(100000, 10000000)
+	_, err := engine.RunScriptSource(source, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(len("key")+len("value")), engine.GasRefund())
+	engine.Dispose()
+}
+
+func TestIterateStorage(t *testing.T) {
+	mem, _ := storage.NewMemoryStorage()
+	accState, _ := state.NewAccountState(nil, mem)
+	contract, _ := accState.CreateContractAccount([]byte("account2"), nil)
+
+	values, usage, err := iterateStorage(contract, "items", 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(values))
+	assert.Equal(t, uint64(0), usage)
+
+	for i := 0; i < MaxStorageIterLimit+10; i++ {
+		assert.Nil(t, contract.Put(HashStorageKey(fmt.Sprintf("@items[%d]", i)), []byte("v")))
+	}
+	assert.Nil(t, contract.Put(HashStorageKey("other"), []byte("v")))
+
+	// the limit is capped.
+	values, usage, err = iterateStorage(contract, "items", 0, 1000)
+	assert.Nil(t, err)
+	assert.Equal(t, MaxStorageIterLimit, len(values))
+	assert.Equal(t, uint64(MaxStorageIterLimit*(StorageIterIncr+1)), usage)
+
+	// the skipped entries are charged too.
+	values, usage, err = iterateStorage(contract, "items", MaxStorageIterLimit, 1000)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(values))
+	assert.Equal(t, uint64((MaxStorageIterLimit+10)*StorageIterIncr+10), usage)
+}
+
+func TestDeterministicRandom(t *testing.T) {
+	source := `var Contract = function () {};
+Contract.prototype = {
+	init: function () {},
+	draw: function () {
+		return [Math.random(), Math.random(), Math.random()];
+	}
+};
+module.exports = Contract;`
+
+	draw := func(seededHeight uint64, tx *ContextTransaction) string {
+		mem, _ := storage.NewMemoryStorage()
+		accState, _ := state.NewAccountState(nil, mem)
+		owner := accState.GetOrCreateUserAccount([]byte("account1"))
+		owner.AddBalance(util.NewUint128FromInt(1000000000))
+		contract, _ := accState.CreateContractAccount([]byte("account2"), nil)
+		block := &mockBlock{heights: ActivationHeights{SeededRandom: seededHeight}}
+		ctx := NewContext(block, tx, owner, contract, accState)
+
+		engine := NewV8Engine(ctx)
+		defer engine.Dispose()
+		engine.SetExecutionLimits(900000, 10000000)
+		result, err := engine.Call(source, "js", "draw", "")
+		assert.Nil(t, err)
+		return result
+	}
+
+	tx := testContextTransaction()
+	result := draw(2, tx)
+	var values []float64
+	assert.Nil(t, json.Unmarshal([]byte(result), &values))
+	assert.Equal(t, 3, len(values))
+	assert.NotEqual(t, values[0], values[1])
+
+	// every node executing the same transaction draws the same numbers.
+	assert.Equal(t, result, draw(2, tx))
+
+	other := testContextTransaction()
+	other.Hash = "d7174759e86c59dcb7df87def82f61eb"
+	assert.NotEqual(t, result, draw(2, other))
+
+	// the native Math.random is kept before the activation height.
+	assert.NotEqual(t, result, draw(3, tx))
+	assert.NotEqual(t, result, draw(0, tx))
+}
+
+func TestNRC20(t *testing.T) {
+	source := `var NRC20 = require('nrc20.js');
+var Token = function () {
+	NRC20.call(this);
+};
+Token.prototype = Object.create(NRC20.prototype);
+module.exports = Token;`
+
+	mem, _ := storage.NewMemoryStorage()
+	accState, _ := state.NewAccountState(nil, mem)
+	owner := accState.GetOrCreateUserAccount([]byte("account1"))
+	owner.AddBalance(util.NewUint128FromInt(1000000000))
+	contract, _ := accState.CreateContractAccount([]byte("account2"), nil)
+	tx := testContextTransaction()
+	ctx := NewContext(testContextBlock(), tx, owner, contract, accState)
+
+	call := func(function, args string) (string, error) {
+		engine := NewV8Engine(ctx)
+		defer engine.Dispose()
+		engine.SetExecutionLimits(1000000, 10000000)
+		return engine.Call(source, "js", function, args)
+	}
+
+	engine := NewV8Engine(ctx)
+	engine.SetExecutionLimits(1000000, 10000000)
+	_, err := engine.DeployAndInit(source, "js", `["Token", "TKN", 18, "1000"]`)
+	assert.Nil(t, err)
+	engine.Dispose()
+
+	tests := []struct {
+		function string
+		args     string
+		result   string
+	}{
+		{"name", "", `"Token"`},
+		{"symbol", "", `"TKN"`},
+		{"decimals", "", "18"},
+		{"totalSupply", "", `"1000"`},
+		{"balanceOf", fmt.Sprintf(`["%s"]`, tx.From), `"1000"`},
+	}
+	for _, tt := range tests {
+		result, err := call(tt.function, tt.args)
+		assert.Nil(t, err, tt.function)
+		assert.Equal(t, tt.result, result, tt.function)
+	}
+
+	_, err = call("transfer", fmt.Sprintf(`["%s", "10"]`, tx.To))
+	assert.Nil(t, err)
+	result, err := call("balanceOf", fmt.Sprintf(`["%s"]`, tx.To))
+	assert.Nil(t, err)
+	assert.Equal(t, `"10"`, result)
+	_, err = call("transfer", fmt.Sprintf(`["%s", "1000"]`, tx.To))
+	assert.Equal(t, ErrExecutionFailed, err)
+
+	_, err = call("approve", fmt.Sprintf(`["%s", "0", "5"]`, tx.To))
+	assert.Nil(t, err)
+	result, err = call("allowance", fmt.Sprintf(`["%s", "%s"]`, tx.From, tx.To))
+	assert.Nil(t, err)
+	assert.Equal(t, `"5"`, result)
+	_, err = call("approve", fmt.Sprintf(`["%s", "0", "6"]`, tx.To))
+	assert.Equal(t, ErrExecutionFailed, err)
+}
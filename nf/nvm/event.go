@@ -20,6 +20,7 @@ package nvm
 
 import "C"
 import (
+	"encoding/json"
 	"unsafe"
 
 	"github.com/nebulasio/go-nebulas/util/byteutils"
@@ -31,13 +32,17 @@ const (
 
 	// EventNameSpaceContract the topic of contract.
 	EventNameSpaceContract = "chain.contract"
+
+	// MaxIndexedEventParams is the max count of indexed params of a contract event.
+	MaxIndexedEventParams = 3
 )
 
 // EventTriggerFunc export EventTriggerFunc
 //export EventTriggerFunc
-func EventTriggerFunc(handler unsafe.Pointer, topic, data *C.char) {
+func EventTriggerFunc(handler unsafe.Pointer, topic, data, indexed *C.char) {
 	gTopic := C.GoString(topic)
 	gData := C.GoString(data)
+	gIndexed := C.GoString(indexed)
 
 	e := getEngineByEngineHandler(handler)
 	if e == nil {
@@ -55,7 +60,23 @@ func EventTriggerFunc(handler unsafe.Pointer, topic, data *C.char) {
 		"data":     gData,
 	}).Debug("Event triggered from V8 engine.")
 
+	e.trace(TraceOpEventTrigger, "", gTopic, gData, gIndexed)
+
 	txHash, _ := byteutils.FromHex(e.ctx.tx.Hash)
 	contractTopic := EventNameSpaceContract + "." + gTopic
-	e.ctx.block.RecordEvent(txHash, contractTopic, gData)
+	if len(gIndexed) == 0 {
+		e.ctx.block.RecordEvent(txHash, contractTopic, gData)
+		return
+	}
+
+	var params []string
+	if err := json.Unmarshal([]byte(gIndexed), &params); err != nil || len(params) > MaxIndexedEventParams {
+		logging.VLog().WithFields(logrus.Fields{
+			"topic":   gTopic,
+			"indexed": gIndexed,
+			"err":     err,
+		}).Debug("Invalid indexed params of event, dropped.")
+		params = nil
+	}
+	e.ctx.block.RecordIndexedEvent(txHash, contractTopic, gData, params)
 }
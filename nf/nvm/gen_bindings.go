@@ -0,0 +1,98 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+// +build ignore
+
+// gen_bindings generates the allow-list of the host bindings of nvm from bindings.json,
+// bindings.go for the modules able to be required and v8/lib/bindings.js for the globals
+// installed by the native side.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+)
+
+const header = `// Code generated by gen_bindings.go from bindings.json. DO NOT EDIT.
+`
+
+type manifest struct {
+	Modules []string `json:"modules"`
+	Globals []string `json:"globals"`
+}
+
+func main() {
+	data, err := ioutil.ReadFile("bindings.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	m := new(manifest)
+	if err := json.Unmarshal(data, m); err != nil {
+		log.Fatal(err)
+	}
+	sort.Strings(m.Modules)
+	sort.Strings(m.Globals)
+
+	for _, v := range m.Modules {
+		if strings.ContainsAny(v, "/\"\\") || !strings.HasSuffix(v, ".js") {
+			log.Fatalf("invalid module %s in manifest", v)
+		}
+	}
+
+	goSrc := new(bytes.Buffer)
+	fmt.Fprint(goSrc, header)
+	fmt.Fprint(goSrc, "\npackage nvm\n\n")
+	fmt.Fprint(goSrc, "// libModules are the lib modules able to be required.\n")
+	fmt.Fprint(goSrc, "var libModules = map[string]bool{\n")
+	for _, v := range m.Modules {
+		fmt.Fprintf(goSrc, "\t%q: true,\n", "lib/"+v)
+	}
+	fmt.Fprint(goSrc, "}\n\n")
+	fmt.Fprint(goSrc, "// hostGlobals are the globals installed by the native side, not accessible to contracts.\n")
+	fmt.Fprint(goSrc, "var hostGlobals = []string{\n")
+	for _, v := range m.Globals {
+		fmt.Fprintf(goSrc, "\t%q,\n", v)
+	}
+	fmt.Fprint(goSrc, "}\n")
+	formatted, err := format.Source(goSrc.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("bindings.go", formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	globals, err := json.MarshalIndent(m.Globals, "", "    ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	jsSrc := new(bytes.Buffer)
+	fmt.Fprint(jsSrc, header)
+	fmt.Fprint(jsSrc, "\n'use strict';\n\n")
+	fmt.Fprint(jsSrc, "// the globals installed by the native side, not accessible to contracts.\n")
+	fmt.Fprintf(jsSrc, "exports.hostGlobals = %s;\n", globals)
+	if err := ioutil.WriteFile("v8/lib/bindings.js", jsSrc.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1 @@
+../v8/lib/bindings.js
\ No newline at end of file
@@ -0,0 +1 @@
+../v8/lib/crypto.js
\ No newline at end of file
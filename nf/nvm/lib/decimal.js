@@ -0,0 +1 @@
+../v8/lib/decimal.js
\ No newline at end of file
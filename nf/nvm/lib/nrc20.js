@@ -0,0 +1 @@
+../v8/lib/nrc20.js
\ No newline at end of file
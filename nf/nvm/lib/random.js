@@ -0,0 +1 @@
+../v8/lib/random.js
\ No newline at end of file
@@ -0,0 +1,34 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+import (
+	metrics "github.com/nebulasio/go-nebulas/metrics"
+)
+
+// Metrics for nvm
+var (
+	metricsExecutionDuration = metrics.GetOrRegisterHistogram("neb.nvm.execution.seconds", nil, metrics.DefaultLatencyBuckets)
+
+	metricsEnginePoolIdle  = metrics.NewGauge("neb.nvm.pool.idle")
+	metricsEnginePoolInUse = metrics.NewGauge("neb.nvm.pool.inuse")
+	metricsEnginePoolHit   = metrics.NewCounter("neb.nvm.pool.hit")
+	metricsEnginePoolMiss  = metrics.NewCounter("neb.nvm.pool.miss")
+	metricsEnginePoolLeak  = metrics.NewCounter("neb.nvm.pool.leak")
+)
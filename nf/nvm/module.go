@@ -22,8 +22,10 @@ import "C"
 
 import (
 	"fmt"
+	"io/ioutil"
 	"regexp"
 	"strings"
+	"sync"
 	"unsafe"
 
 	"github.com/nebulasio/go-nebulas/util/logging"
@@ -32,6 +34,10 @@ import (
 
 var (
 	pathRe = regexp.MustCompile("^\\.{0,2}/")
+
+	// sources of the lib modules read.
+	libSources     = make(map[string]string)
+	libSourcesLock sync.Mutex
 )
 
 // Module module structure.
@@ -88,7 +94,16 @@ func RequireDelegateFunc(handler unsafe.Pointer, filename *C.char, lineOffset *C
 
 	module := e.modules.Get(id)
 	if module == nil {
-		return nil
+		// the modules not added are read from lib only if allowed by the bindings manifest.
+		source, ok := libSource(id)
+		if !ok {
+			logging.VLog().WithFields(logrus.Fields{
+				"filename": id,
+			}).Debug("require of module not in bindings manifest is rejected.")
+			return nil
+		}
+		*lineOffset = 0
+		return C.CString(source)
 	}
 
 	*lineOffset = C.size_t(module.lineOffset)
@@ -96,6 +111,30 @@ func RequireDelegateFunc(handler unsafe.Pointer, filename *C.char, lineOffset *C
 	return cSource
 }
 
+// libSource returns the source of the lib module if it is in the bindings manifest.
+func libSource(id string) (string, bool) {
+	if !libModules[id] {
+		return "", false
+	}
+
+	libSourcesLock.Lock()
+	defer libSourcesLock.Unlock()
+
+	if source, ok := libSources[id]; ok {
+		return source, true
+	}
+	data, err := ioutil.ReadFile(id)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"filename": id,
+			"err":      err,
+		}).Error("Failed to read lib module.")
+		return "", false
+	}
+	libSources[id] = string(data)
+	return libSources[id], true
+}
+
 func reformatModuleID(id string) string {
 	paths := make([]string, 0)
 	for _, p := range strings.Split(id, "/") {
@@ -21,10 +21,13 @@ package nvm
 import "C"
 
 import (
+	"encoding/json"
 	"regexp"
+	"strconv"
 	"unsafe"
 
 	"github.com/nebulasio/go-nebulas/common/trie"
+	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/storage"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"github.com/sirupsen/logrus"
@@ -35,17 +38,26 @@ var (
 	ErrKeyNotFound = storage.ErrKeyNotFound
 )
 
+const (
+	// MaxStorageIterLimit is the max count of entries returned by an iteration of storage.
+	MaxStorageIterLimit = 100
+
+	// StorageIterIncr is the instructions charged for each entry visited by an iteration,
+	// besides the size of the returned values.
+	StorageIterIncr = 10
+)
+
 var (
 	keyPattern = regexp.MustCompile("^@([a-zA-Z_].*?)\\[(.+?)\\]$")
 )
 
-// hashStorageKey return the key hash.
+// HashStorageKey return the key hash.
 // There are two kinds of key, the one is ItemKey, the other is Map-ItemKey.
 // ItemKey in SmartContract is used for object storage.
 // For example, the ItemKey for the statement "token.totalSupply = 1000" is "totalSupply".
 // Map-ItemKey in SmartContrat is used for Map storage.
 // For example, the Map-ItemKey for the statement "token.balances.set('addr1', 100)" is "@balances[addr1]".
-func hashStorageKey(key string) []byte {
+func HashStorageKey(key string) []byte {
 	var domainKey, itemKey string
 
 	matches := keyPattern.FindAllStringSubmatch(key, -1)
@@ -63,12 +75,13 @@ func hashStorageKey(key string) []byte {
 // StorageGetFunc export StorageGetFunc
 //export StorageGetFunc
 func StorageGetFunc(handler unsafe.Pointer, key *C.char) *C.char {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if storage == nil {
 		return nil
 	}
 
-	val, err := storage.Get([]byte(hashStorageKey(C.GoString(key))))
+	val, err := storage.Get([]byte(HashStorageKey(C.GoString(key))))
+	engine.trace(TraceOpStorageGet, string(val), C.GoString(key))
 	if err != nil {
 		if err != ErrKeyNotFound {
 			logging.VLog().WithFields(logrus.Fields{
@@ -86,12 +99,13 @@ func StorageGetFunc(handler unsafe.Pointer, key *C.char) *C.char {
 // StoragePutFunc export StoragePutFunc
 //export StoragePutFunc
 func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if storage == nil {
 		return 1
 	}
 
-	err := storage.Put([]byte(hashStorageKey(C.GoString(key))), []byte(C.GoString(value)))
+	err := storage.Put([]byte(HashStorageKey(C.GoString(key))), []byte(C.GoString(value)))
+	engine.trace(TraceOpStoragePut, "", C.GoString(key), C.GoString(value))
 	if err != nil && err != ErrKeyNotFound {
 		logging.VLog().WithFields(logrus.Fields{
 			"handler": uint64(uintptr(handler)),
@@ -106,12 +120,19 @@ func StoragePutFunc(handler unsafe.Pointer, key *C.char, value *C.char) int {
 // StorageDelFunc export StorageDelFunc
 //export StorageDelFunc
 func StorageDelFunc(handler unsafe.Pointer, key *C.char) int {
-	_, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
 	if storage == nil {
 		return 1
 	}
 
-	err := storage.Del([]byte(hashStorageKey(C.GoString(key))))
+	// deleting an existing entry refunds the gas of storing it.
+	hashedKey := HashStorageKey(C.GoString(key))
+	if val, err := storage.Get(hashedKey); err == nil {
+		engine.gasRefund += uint64(len(C.GoString(key)) + len(val))
+	}
+
+	err := storage.Del(hashedKey)
+	engine.trace(TraceOpStorageDel, "", C.GoString(key))
 
 	if err != nil && err != ErrKeyNotFound {
 		logging.VLog().WithFields(logrus.Fields{
@@ -124,3 +145,63 @@ func StorageDelFunc(handler unsafe.Pointer, key *C.char) int {
 
 	return 0
 }
+
+// StorageIterFunc export StorageIterFunc
+//export StorageIterFunc
+func StorageIterFunc(handler unsafe.Pointer, domain *C.char, offset, limit C.size_t, usage *C.size_t) *C.char {
+	engine, storage := getEngineByStorageHandler(uint64(uintptr(handler)))
+	if storage == nil {
+		return nil
+	}
+
+	values, visited, err := iterateStorage(storage, C.GoString(domain), uint64(offset), uint64(limit))
+	*usage = C.size_t(visited)
+	if err != nil {
+		logging.VLog().WithFields(logrus.Fields{
+			"handler": uint64(uintptr(handler)),
+			"domain":  C.GoString(domain),
+			"err":     err,
+		}).Debug("StorageIterFunc iterate domain failed.")
+		return nil
+	}
+
+	data, _ := json.Marshal(values)
+	engine.trace(TraceOpStorageIter, string(data), C.GoString(domain), strconv.FormatUint(uint64(offset), 10), strconv.FormatUint(uint64(limit), 10))
+	return C.CString(string(data))
+}
+
+// iterateStorage returns the values of at most limit entries of the domain after skipping offset
+// entries, and the instructions to charge for the visited entries. The keys are hashed in storage,
+// so the entries of a map are iterated by the hash of its name in key order and only the values
+// are returned.
+func iterateStorage(storage state.Account, domain string, offset, limit uint64) ([]string, uint64, error) {
+	if limit > MaxStorageIterLimit {
+		limit = MaxStorageIterLimit
+	}
+
+	values := make([]string, 0)
+	iter, err := storage.Iterator(trie.HashDomainsPrefix(domain))
+	if err == ErrKeyNotFound {
+		return values, 0, nil
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+
+	usage := uint64(0)
+	for visited := uint64(0); visited < offset+limit; visited++ {
+		exist, err := iter.Next()
+		if err != nil {
+			return nil, usage, err
+		}
+		if !exist {
+			break
+		}
+		usage += StorageIterIncr
+		if visited >= offset {
+			values = append(values, string(iter.Value()))
+			usage += uint64(len(iter.Value()))
+		}
+	}
+	return values, usage, nil
+}
@@ -20,6 +20,8 @@ SampleContract.prototype = {
         console.log('init: Blockchain.block.coinbase = ' + Blockchain.block.coinbase);
         console.log('init: Blockchain.block.nonce = ' + Blockchain.block.nonce);
         console.log('init: Blockchain.block.hash = ' + Blockchain.block.hash);
+        console.log('init: Blockchain.block.timestamp = ' + Blockchain.block.timestamp);
+        console.log('init: Blockchain.block.parentHash = ' + Blockchain.block.parentHash);
         console.log('init: Blockchain.block.height = ' + Blockchain.block.height);
         console.log('init: Blockchain.transaction.from = ' + Blockchain.transaction.from);
         console.log('init: Blockchain.transaction.to = ' + Blockchain.transaction.to);
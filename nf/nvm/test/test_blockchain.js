@@ -33,3 +33,16 @@ console.log("transfer:" + ok)
 
 var result = Blockchain.verifyAddress("70e30fcae5e7f4b2460faaa9e5b1bd912332ebb5");
 console.log("verifyAddress:" + result)
+
+if (Blockchain.block.hash === undefined) {
+    throw new Error("unexpected block context:" + JSON.stringify(Blockchain.block));
+}
+
+var parentHash = Blockchain.getBlockHash(Blockchain.block.height - 1);
+console.log("getBlockHash:" + parentHash)
+if (parentHash !== Blockchain.block.parentHash) {
+    throw new Error("getBlockHash of parent should be block.parentHash");
+}
+if (Blockchain.getBlockHash(Blockchain.block.height) !== null) {
+    throw new Error("getBlockHash of current block should be null");
+}
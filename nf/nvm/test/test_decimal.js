@@ -0,0 +1,72 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+'use strict';
+
+var Decimal = require('decimal.js');
+
+var expectEqual = function (actual, expected) {
+    if (actual !== expected) {
+        throw new Error("expected " + expected + ", but is " + actual);
+    }
+};
+
+var expectThrow = function (f, msg) {
+    try {
+        f();
+    } catch (e) {
+        return;
+    }
+    throw new Error(msg);
+};
+
+// the default precision is 18.
+expectEqual(new Decimal("1").toString(), "1.000000000000000000");
+expectEqual(new Decimal("0.1", 1).add("0.2").toString(), "0.3");
+expectEqual(new Decimal("0.1", 1).add("0.2").eq("0.3"), true);
+
+// the results keep the larger precision, the extra digits are truncated.
+expectEqual(new Decimal("1.25", 2).mul(new Decimal("3", 0)).toString(), "3.75");
+expectEqual(new Decimal("1.25", 2).mul(new Decimal("0.5", 1)).toString(), "0.62");
+expectEqual(new Decimal("5", 0).sub(7).toString(), "-2");
+expectEqual(new Decimal("1", 6).div("3").toString(), "0.333333");
+expectEqual(new Decimal("10", 0).div(4, 3).toString(), "2.500");
+expectEqual(new Decimal("-1", 2).div("3").toString(), "-0.33");
+
+// the rounding modes.
+expectEqual(new Decimal("2", 6).div("3").round(2, Decimal.ROUND_HALF_UP).toString(), "0.67");
+expectEqual(new Decimal("1.005", 3).round(2, Decimal.ROUND_HALF_EVEN).toString(), "1.00");
+expectEqual(new Decimal("1.001", 3).round(2, Decimal.ROUND_UP).toString(), "1.01");
+expectEqual(new Decimal("1.009", 3).round(2).toString(), "1.00");
+
+// the comparisons.
+expectEqual(new Decimal("1.5", 1).gt("1.49"), true);
+expectEqual(new Decimal("1.5", 1).lt(new Decimal("2", 0)), true);
+expectEqual(new Decimal("0", 2).isZero(), true);
+expectEqual(new Decimal("-0.01", 2).isNegative(), true);
+
+// the storage descriptor keeps the precision.
+expectEqual(Decimal.descriptor.parse(Decimal.descriptor.stringify(new Decimal("2.50", 2))).precision, 2);
+expectEqual(JSON.stringify({v: new Decimal("1.5", 1)}), '{"v":"1.5"}');
+
+// the floating point numbers and the invalid values are rejected.
+expectThrow(function () { new Decimal(0.1); }, "floating point number should be rejected.");
+expectThrow(function () { new Decimal("1.234", 2); }, "value exceeding precision should be rejected.");
+expectThrow(function () { new Decimal("abc"); }, "invalid value should be rejected.");
+expectThrow(function () { new Decimal("1", 65); }, "invalid precision should be rejected.");
+expectThrow(function () { new Decimal("1").div(0); }, "division by zero should be rejected.");
+expectThrow(function () { new Decimal("1").round(2, 7); }, "invalid rounding mode should be rejected.");
@@ -30,3 +30,21 @@ Event.Trigger("ERC20", {
         value: 2234,
     }
 });
+
+Event.Trigger("ERC20", {
+    Transfer: {
+        from: "0x0",
+        to: "0x1",
+        value: 1234,
+    }
+}, ["0x0", "0x1"]);
+
+var exceeded = false;
+try {
+    Event.Trigger("ERC20", {}, [1, 2, 3, 4]);
+} catch (e) {
+    exceeded = true;
+}
+if (!exceeded) {
+    throw new Error("Event.Trigger should limit the indexed params.");
+}
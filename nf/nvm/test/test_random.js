@@ -0,0 +1,34 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+'use strict';
+
+if (typeof Math.random !== 'function') {
+    throw new Error("Math.random is not a function.");
+}
+
+var seen = {};
+for (var i = 0; i < 100; i++) {
+    var r = Math.random();
+    if (typeof r !== 'number' || r < 0 || r >= 1) {
+        throw new Error("Math.random out of range: " + r);
+    }
+    if (seen[r]) {
+        throw new Error("Math.random repeated: " + r);
+    }
+    seen[r] = true;
+}
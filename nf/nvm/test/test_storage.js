@@ -88,3 +88,37 @@ try {
         }
     });
 });
+
+// iterate the entries of a map.
+var obj = {};
+LocalContractStorage.defineMapProperty(obj, "items");
+[1, 2, 3, 4, 5].forEach(function (v) {
+    obj.items.put('item-' + v, {
+        key: 'item-' + v,
+        value: v
+    });
+});
+
+var all = obj.items.iterate();
+if (all.length !== 5) {
+    throw new Error("items should have 5 entries, actual is " + all.length);
+}
+var sum = all.reduce(function (acc, item) {
+    return acc + item.value;
+}, 0);
+if (sum !== 15) {
+    throw new Error("sum of items should be 15, actual is " + sum);
+}
+
+var page = obj.items.iterate(3, 10);
+if (page.length !== 2 || JSON.stringify(page) !== JSON.stringify(all.slice(3))) {
+    throw new Error("items after offset 3 should be " + JSON.stringify(all.slice(3)));
+}
+
+if (obj.items.iterate(0, 0).length !== 0) {
+    throw new Error("items with limit 0 should be empty.");
+}
+
+if (LocalContractStorage.iterate("empty").length !== 0) {
+    throw new Error("empty map should have no entries.");
+}
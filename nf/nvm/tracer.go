@@ -0,0 +1,77 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package nvm
+
+// Trace operations of the contract api calls.
+const (
+	TraceOpStorageGet      = "storage.get"
+	TraceOpStoragePut      = "storage.put"
+	TraceOpStorageDel      = "storage.del"
+	TraceOpStorageIter     = "storage.iter"
+	TraceOpGetTransaction  = "blockchain.getTransactionByHash"
+	TraceOpGetAccountState = "blockchain.getAccountState"
+	TraceOpTransfer        = "blockchain.transfer"
+	TraceOpVerifyAddress   = "blockchain.verifyAddress"
+	TraceOpGetBlockHash    = "blockchain.getBlockHash"
+	TraceOpEventTrigger    = "event.trigger"
+	TraceOpCryptoHash      = "crypto.hash"
+	TraceOpRecoverAddress  = "crypto.recoverAddress"
+	TraceOpExecutionResult = "execution.result"
+)
+
+// tracedScriptTemplate rethrows the stack of the error thrown in contract as the exception.
+const tracedScriptTemplate = "try {\n%s} catch (e) {\n throw (e instanceof Error) ? e.stack : e;\n}\n"
+
+// TraceStep is a contract api call in the execution.
+type TraceStep struct {
+	Op     string   `json:"op"`
+	Args   []string `json:"args"`
+	Result string   `json:"result"`
+
+	// Gas is the instructions executed since the previous step.
+	Gas uint64 `json:"gas"`
+}
+
+// Tracer records the contract api calls, the storage reads and writes and the gas
+// consumed per step in the execution, and the javascript stack on failure.
+type Tracer struct {
+	Steps []*TraceStep
+	Stack string
+
+	instructions uint64
+}
+
+// NewTracer returns a new Tracer.
+func NewTracer() *Tracer {
+	return &Tracer{Steps: make([]*TraceStep, 0)}
+}
+
+func (t *Tracer) record(instructions uint64, op, result string, args ...string) {
+	gas := uint64(0)
+	if instructions > t.instructions {
+		gas = instructions - t.instructions
+	}
+	t.instructions = instructions
+	t.Steps = append(t.Steps, &TraceStep{
+		Op:     op,
+		Args:   args,
+		Result: result,
+		Gas:    gas,
+	})
+}
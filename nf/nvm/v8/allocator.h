@@ -51,6 +51,12 @@ public:
 
   size_t peak_allocated_size();
 
+  /**
+   * Restart peak tracking from the current allocation, so a reused isolate
+   * does not carry the peak of a previous execution.
+   */
+  void reset_peak_allocated_size();
+
 private:
   size_t total_allocated_size_;
   size_t peak_allocated_size_;
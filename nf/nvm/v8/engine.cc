@@ -99,6 +99,21 @@ V8Engine *CreateEngine() {
   return e;
 }
 
+void ResetEngine(V8Engine *e) {
+  Isolate *isolate = static_cast<Isolate *>(e->isolate);
+  isolate->CancelTerminateExecution();
+  isolate->LowMemoryNotification();
+
+  static_cast<ArrayBufferAllocator *>(e->allocator)
+      ->reset_peak_allocated_size();
+
+  e->limits_of_executed_instructions = 0;
+  e->limits_of_total_memory_size = 0;
+  e->is_requested_terminate_execution = 0;
+  e->testing = 0;
+  memset(&e->stats, 0, sizeof(V8EngineStats));
+}
+
 void DeleteEngine(V8Engine *e) {
   Isolate *isolate = static_cast<Isolate *>(e->isolate);
   isolate->Dispose();
@@ -132,6 +147,22 @@ int ExecuteSourceDataDelegate(char **result, Isolate *isolate,
   MaybeLocal<Value> ret = script.ToLocalChecked()->Run(context);
   if (ret.IsEmpty()) {
     PrintException(context, trycatch);
+
+    // set exception as result, with the location it is thrown in the contract.
+    if (result != NULL && !trycatch.Exception().IsEmpty()) {
+      String::Utf8Value str(trycatch.Exception());
+      Local<Message> message = trycatch.Message();
+      if (!message.IsEmpty()) {
+        String::Utf8Value filename(message->GetScriptResourceName());
+        int linenum = message->GetLineNumber(context).FromMaybe(0);
+        if (strcmp(*filename, "_contract_runner.js") != 0 && linenum > 0) {
+          asprintf(result, "%s (%s:%d)", *str, *filename, linenum);
+          return 1;
+        }
+      }
+      *result = (char *)malloc(str.length() + 1);
+      strcpy(*result, *str);
+    }
     return 1;
   }
 
@@ -150,10 +181,13 @@ int ExecuteSourceDataDelegate(char **result, Isolate *isolate,
 }
 
 char *InjectTracingInstructions(V8Engine *e, const char *source,
-                                int *source_line_offset) {
+                                int *source_line_offset, int check_bindings,
+                                int charge_transfer) {
   TracingContext tContext;
   tContext.source_line_offset = 0;
   tContext.tracable_source = NULL;
+  tContext.check_bindings = check_bindings;
+  tContext.charge_transfer = charge_transfer;
 
   Execute(NULL, e, source, 0, 0L, 0L, InjectTracingInstructionDelegate,
           (void *)&tContext);
@@ -163,10 +197,12 @@ char *InjectTracingInstructions(V8Engine *e, const char *source,
 }
 
 char *TranspileTypeScriptModule(V8Engine *e, const char *source,
+                                const char *version,
                                 int *source_line_offset) {
   TypeScriptContext tContext;
   tContext.source_line_offset = 0;
   tContext.js_source = NULL;
+  tContext.version = version;
 
   Execute(NULL, e, source, 0, 0L, 0L, TypeScriptTranspileDelegate,
           (void *)&tContext);
@@ -47,27 +47,39 @@ EXPORT void InitializeLogger(LogFunc f);
 
 // event.
 typedef void (*EventTriggerFunc)(void *handler, const char *topic,
-                                 const char *data);
+                                 const char *data, const char *indexed);
 EXPORT void InitializeEvent(EventTriggerFunc trigger);
 
+// crypto.
+typedef char *(*CryptoHashFunc)(void *handler, const char *alg,
+                                const char *data);
+typedef char *(*RecoverAddressFunc)(void *handler, int alg, const char *hash,
+                                    const char *sign);
+EXPORT void InitializeCrypto(CryptoHashFunc hash,
+                             RecoverAddressFunc recoverAddress);
+
 // storage
 typedef char *(*StorageGetFunc)(void *handler, const char *key);
 typedef int (*StoragePutFunc)(void *handler, const char *key,
                               const char *value);
 typedef int (*StorageDelFunc)(void *handler, const char *key);
+typedef char *(*StorageIterFunc)(void *handler, const char *domain,
+                                 size_t offset, size_t limit, size_t *usage);
 EXPORT void InitializeStorage(StorageGetFunc get, StoragePutFunc put,
-                              StorageDelFunc del);
+                              StorageDelFunc del, StorageIterFunc iter);
 
 // blockchain
 typedef char *(*GetTxByHashFunc)(void *handler, const char *hash);
 typedef char *(*GetAccountStateFunc)(void *handler, const char *address);
 typedef int (*TransferFunc)(void *handler, const char *to, const char *value);
 typedef int (*VerifyAddressFunc)(void *handler, const char *address);
+typedef char *(*GetBlockHashFunc)(void *handler, const char *height);
 
 EXPORT void InitializeBlockchain(GetTxByHashFunc getTx,
                                  GetAccountStateFunc getAccount,
                                  TransferFunc transfer,
-                                 VerifyAddressFunc verifyAddress);
+                                 VerifyAddressFunc verifyAddress,
+                                 GetBlockHashFunc getBlockHash);
 
 // version
 EXPORT char *GetV8Version();
@@ -112,9 +124,11 @@ EXPORT int RunScriptSource(char **result, V8Engine *e, const char *source,
                            uintptr_t gcsHandler);
 
 EXPORT char *InjectTracingInstructions(V8Engine *e, const char *source,
-                                       int *source_line_offset);
+                                       int *source_line_offset,
+                                       int check_bindings, int charge_transfer);
 
 EXPORT char *TranspileTypeScriptModule(V8Engine *e, const char *source,
+                                       const char *version,
                                        int *source_line_offset);
 
 EXPORT int IsEngineLimitsExceeded(V8Engine *e);
@@ -123,6 +137,7 @@ EXPORT void ReadMemoryStatistics(V8Engine *e);
 
 EXPORT void TerminateExecution(V8Engine *e);
 
+EXPORT void ResetEngine(V8Engine *e);
 EXPORT void DeleteEngine(V8Engine *e);
 
 #ifdef __cplusplus
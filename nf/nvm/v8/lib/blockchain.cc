@@ -19,19 +19,23 @@
 
 #include "blockchain.h"
 #include "../engine.h"
+#include "instruction_counter.h"
 
 static GetTxByHashFunc sGetTxByHash = NULL;
 static GetAccountStateFunc sGetAccountState = NULL;
 static TransferFunc sTransfer = NULL;
 static VerifyAddressFunc sVerifyAddress = NULL;
+static GetBlockHashFunc sGetBlockHash = NULL;
 
 void InitializeBlockchain(GetTxByHashFunc getTx,
                           GetAccountStateFunc getAccount,
-                          TransferFunc transfer, VerifyAddressFunc verifyAddress) {
+                          TransferFunc transfer, VerifyAddressFunc verifyAddress,
+                          GetBlockHashFunc getBlockHash) {
   sGetTxByHash = getTx;
   sGetAccountState = getAccount;
   sTransfer = transfer;
   sVerifyAddress = verifyAddress;
+  sGetBlockHash = getBlockHash;
 }
 
 void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
@@ -59,6 +63,11 @@ void NewBlockchainInstance(Isolate *isolate, Local<Context> context,
                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                                PropertyAttribute::ReadOnly));
 
+  blockTpl->Set(String::NewFromUtf8(isolate, "getBlockHash"),
+                FunctionTemplate::New(isolate, GetBlockHashCallback),
+                static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                               PropertyAttribute::ReadOnly));
+
   Local<Object> instance = blockTpl->NewInstance(context).ToLocalChecked();
   instance->SetInternalField(0, External::New(isolate, handler));
 
@@ -150,6 +159,9 @@ void TransferCallback(const FunctionCallbackInfo<Value> &info) {
     return;
   }
 
+  // record transfer usage.
+  RecordTransferUsage(isolate, isolate->GetCurrentContext());
+
   int ret = sTransfer(handler->Value(), *String::Utf8Value(address->ToString()),
                       *String::Utf8Value(amount->ToString()));
   info.GetReturnValue().Set(ret);
@@ -177,3 +189,32 @@ void VerifyAddressCallback(const FunctionCallbackInfo<Value> &info) {
   int ret = sVerifyAddress(handler->Value(), *String::Utf8Value(address->ToString()));
   info.GetReturnValue().Set(ret);
 }
+
+// GetBlockHashCallback
+void GetBlockHashCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 1) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Blockchain.getBlockHash() requires only 1 argument"));
+    return;
+  }
+
+  Local<Value> height = info[0];
+  if (!height->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "height must be string"));
+    return;
+  }
+
+  char *value =
+      sGetBlockHash(handler->Value(), *String::Utf8Value(height->ToString()));
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+}
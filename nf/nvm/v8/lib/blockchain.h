@@ -32,5 +32,6 @@ void GetTransactionByHashCallback(const FunctionCallbackInfo<Value> &info);
 void GetAccountStateCallback(const FunctionCallbackInfo<Value> &info);
 void TransferCallback(const FunctionCallbackInfo<Value> &info);
 void VerifyAddressCallback(const FunctionCallbackInfo<Value> &info);
+void GetBlockHashCallback(const FunctionCallbackInfo<Value> &info);
 
 #endif //_NEBULAS_NF_NVM_V8_LIB_BLOCKCHAIN_H_
@@ -69,10 +69,27 @@ Blockchain.prototype = {
         return acc
     },
     transfer: function (address, value) {
-        return this.nativeBlockchain.transfer(address, value.toString());
+        // the value is checked from the contract transfer height only.
+        if (!this.transferChecked) {
+            return this.nativeBlockchain.transfer(address, value.toString());
+        }
+        value = new BigNumber(value);
+        if (!value.isInteger() || value.lessThan(0)) {
+            throw new Error("Blockchain.transfer: value must be a non-negative integer");
+        }
+        // toString(10) never returns the exponential notation.
+        return this.nativeBlockchain.transfer(address, value.toString(10));
     },
     verifyAddress: function (address) {
         return this.nativeBlockchain.verifyAddress(address);
+    },
+    getBlockHash: function (height) {
+        height = new BigNumber(height);
+        if (!height.isInteger() || height.lessThan(0)) {
+            throw new Error("Blockchain.getBlockHash: height must be a non-negative integer");
+        }
+        // only the recent ancestors of the current block are available, others return null.
+        return this.nativeBlockchain.getBlockHash(height.toString(10));
     }
 };
 
@@ -0,0 +1,141 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or
+// modify it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see
+// <http://www.gnu.org/licenses/>.
+//
+#include "crypto.h"
+#include "../engine.h"
+#include "global.h"
+#include "instruction_counter.h"
+
+static CryptoHashFunc sHash = NULL;
+static RecoverAddressFunc sRecoverAddress = NULL;
+
+void InitializeCrypto(CryptoHashFunc hash, RecoverAddressFunc recoverAddress) {
+  sHash = hash;
+  sRecoverAddress = recoverAddress;
+}
+
+void NewNativeCryptoFunction(Isolate *isolate,
+                             Local<ObjectTemplate> globalTpl) {
+  globalTpl->Set(String::NewFromUtf8(isolate, "_native_crypto_hash"),
+                 FunctionTemplate::New(isolate, CryptoHashCallback),
+                 static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                                PropertyAttribute::ReadOnly));
+
+  globalTpl->Set(
+      String::NewFromUtf8(isolate, "_native_crypto_recover_address"),
+      FunctionTemplate::New(isolate, RecoverAddressCallback),
+      static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                     PropertyAttribute::ReadOnly));
+}
+
+void CryptoHashCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Context> context = isolate->GetCurrentContext();
+
+  if (info.Length() != 2) {
+    isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+        isolate, "_native_crypto_hash: requires 2 arguments")));
+    return;
+  }
+
+  Local<Value> alg = info[0];
+  if (!alg->IsString()) {
+    isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+        isolate, "_native_crypto_hash: alg must be string")));
+    return;
+  }
+
+  Local<Value> data = info[1];
+  if (!data->IsString()) {
+    isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+        isolate, "_native_crypto_hash: data must be string")));
+    return;
+  }
+
+  // record crypto usage.
+  RecordCryptoUsage(isolate, context, data->ToString()->Utf8Length(), false);
+
+  if (sHash == NULL) {
+    info.GetReturnValue().SetNull();
+    return;
+  }
+
+  V8Engine *e = GetV8EngineInstance(context);
+  char *value = sHash(e, *String::Utf8Value(alg->ToString()),
+                      *String::Utf8Value(data->ToString()));
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+}
+
+void RecoverAddressCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Context> context = isolate->GetCurrentContext();
+
+  if (info.Length() != 3) {
+    isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+        isolate, "_native_crypto_recover_address: requires 3 arguments")));
+    return;
+  }
+
+  Local<Value> alg = info[0];
+  if (!alg->IsInt32()) {
+    isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+        isolate, "_native_crypto_recover_address: alg must be integer")));
+    return;
+  }
+
+  Local<Value> hash = info[1];
+  if (!hash->IsString()) {
+    isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+        isolate, "_native_crypto_recover_address: hash must be string")));
+    return;
+  }
+
+  Local<Value> sign = info[2];
+  if (!sign->IsString()) {
+    isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+        isolate, "_native_crypto_recover_address: sign must be string")));
+    return;
+  }
+
+  // record crypto usage.
+  RecordCryptoUsage(isolate, context,
+                    hash->ToString()->Utf8Length() +
+                        sign->ToString()->Utf8Length(),
+                    true);
+
+  if (sRecoverAddress == NULL) {
+    info.GetReturnValue().SetNull();
+    return;
+  }
+
+  V8Engine *e = GetV8EngineInstance(context);
+  char *value = sRecoverAddress(e, alg->Int32Value(),
+                                *String::Utf8Value(hash->ToString()),
+                                *String::Utf8Value(sign->ToString()));
+  if (value == NULL) {
+    info.GetReturnValue().SetNull();
+  } else {
+    info.GetReturnValue().Set(String::NewFromUtf8(isolate, value));
+    free(value);
+  }
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+'use strict';
+
+var SECP256K1 = 1;
+
+var Crypto = function () {
+};
+
+// hashes are computed over the utf-8 bytes of data and returned in hex.
+Crypto.prototype = {
+    SECP256K1: SECP256K1,
+
+    sha256: function (data) {
+        return _native_crypto_hash("sha256", data);
+    },
+    sha3256: function (data) {
+        return _native_crypto_hash("sha3256", data);
+    },
+    ripemd160: function (data) {
+        return _native_crypto_hash("ripemd160", data);
+    },
+    // recoverAddress returns the address which signed the hex hash, or null if the signature is invalid.
+    recoverAddress: function (alg, hash, sign) {
+        return _native_crypto_recover_address(alg, hash, sign);
+    },
+    verifySignature: function (alg, hash, sign, address) {
+        var addr = this.recoverAddress(alg, hash, sign);
+        if (addr === null || typeof address !== "string") {
+            return false;
+        }
+        if (address.indexOf("0x") === 0) {
+            address = address.substring(2);
+        }
+        return addr === address.toLowerCase();
+    }
+};
+
+module.exports = new Crypto();
+module.exports.Crypto = Crypto;
@@ -0,0 +1,222 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+'use strict';
+
+// Decimal is the fixed-point decimal math for contracts, use it instead of the numbers to keep
+// the results exact and same on every node:
+//
+//     var Decimal = require('decimal.js');
+//     var price = new Decimal("1.25", 2);
+//     var total = price.mul(new Decimal("3")).add("0.5"); // "4.250000000000000000"
+//
+// A decimal keeps precision digits after the decimal point, 18 if not specified. The results of
+// add, sub and mul keep the larger precision of the operands, and div keeps the precision of the
+// dividend unless specified, the extra digits are truncated. The operands not decimal keep all
+// their digits. Use round() for other rounding
+// modes. The floating point numbers are rejected, the values are strings or integers.
+//
+// The operations are metered by the digits of the operands.
+
+var DEFAULT_PRECISION = 18;
+var MAX_PRECISION = 64;
+
+// base usage of an operation.
+var DECIMAL_INCR = 10;
+
+// the constructor private to the decimals, the config of BigNumber in contracts is not used.
+var BN = BigNumber.another({
+    DECIMAL_PLACES: 0,
+    ROUNDING_MODE: BigNumber.ROUND_DOWN,
+    EXPONENTIAL_AT: 1e9,
+    ERRORS: true
+});
+
+var digitsOf = function (v) {
+    return Math.max(v.e + 1, 1);
+};
+
+var incr = function (value) {
+    if (typeof _instruction_counter !== 'undefined') {
+        _instruction_counter.incr(value);
+    }
+};
+
+var checkPrecision = function (precision) {
+    if (precision === undefined || precision === null) {
+        return DEFAULT_PRECISION;
+    }
+    if (typeof precision !== 'number' || precision % 1 !== 0 || precision < 0 || precision > MAX_PRECISION) {
+        throw new Error("Decimal: invalid precision " + precision);
+    }
+    return precision;
+};
+
+// rescale returns the scaled integer v of precision from in precision to, rounded by mode.
+var rescale = function (v, from, to, mode) {
+    if (to >= from) {
+        return v.shift(to - from);
+    }
+    return v.shift(to - from).round(0, mode === undefined ? BN.ROUND_DOWN : mode);
+};
+
+var Decimal = function (value, precision) {
+    if (!(this instanceof Decimal)) {
+        return new Decimal(value, precision);
+    }
+    precision = checkPrecision(precision);
+
+    var v;
+    if (value instanceof Decimal) {
+        v = rescale(value._value, value.precision, precision);
+    } else {
+        if (typeof value === 'number' && (value % 1 !== 0 || !isFinite(value))) {
+            throw new Error("Decimal: floating point number " + value + " is not allowed");
+        }
+        if (typeof value !== 'number' && typeof value !== 'string' && !(value instanceof BigNumber)) {
+            throw new Error("Decimal: invalid value " + value);
+        }
+        var n = new BN(value.toString());
+        if (!n.isFinite() || n.decimalPlaces() > precision) {
+            throw new Error("Decimal: invalid value " + value + " of precision " + precision);
+        }
+        v = n.shift(precision);
+    }
+
+    Object.defineProperty(this, "_value", {
+        value: v
+    });
+    Object.defineProperty(this, "precision", {
+        enumerable: true,
+        value: precision
+    });
+};
+
+// operand returns the decimal of value, the values not decimal keep their digits and at least
+// the precision of base.
+var operand = function (value, base) {
+    if (value instanceof Decimal) {
+        return value;
+    }
+    var precision = base.precision;
+    if (typeof value === 'string') {
+        var n = new BN(value);
+        if (n.isFinite()) {
+            precision = Math.min(Math.max(precision, n.decimalPlaces()), MAX_PRECISION);
+        }
+    }
+    return new Decimal(value, precision);
+};
+
+var fromScaled = function (v, precision) {
+    var d = Object.create(Decimal.prototype);
+    Object.defineProperty(d, "_value", {
+        value: v
+    });
+    Object.defineProperty(d, "precision", {
+        enumerable: true,
+        value: precision
+    });
+    return d;
+};
+
+Decimal.DEFAULT_PRECISION = DEFAULT_PRECISION;
+Decimal.MAX_PRECISION = MAX_PRECISION;
+Decimal.ROUND_UP = BN.ROUND_UP;
+Decimal.ROUND_DOWN = BN.ROUND_DOWN;
+Decimal.ROUND_HALF_UP = BN.ROUND_HALF_UP;
+Decimal.ROUND_HALF_EVEN = BN.ROUND_HALF_EVEN;
+
+// descriptor stores the decimals in LocalContractStorage with their precision.
+Decimal.descriptor = {
+    parse: function (value) {
+        var point = value.indexOf(".");
+        return new Decimal(value, point < 0 ? 0 : value.length - point - 1);
+    },
+    stringify: function (d) {
+        return d.toString();
+    }
+};
+
+Decimal.prototype = {
+    add: function (other) {
+        var o = operand(other, this);
+        var p = Math.max(this.precision, o.precision);
+        incr(DECIMAL_INCR + digitsOf(this._value) + digitsOf(o._value));
+        return fromScaled(rescale(this._value, this.precision, p).plus(rescale(o._value, o.precision, p)), p);
+    },
+    sub: function (other) {
+        var o = operand(other, this);
+        var p = Math.max(this.precision, o.precision);
+        incr(DECIMAL_INCR + digitsOf(this._value) + digitsOf(o._value));
+        return fromScaled(rescale(this._value, this.precision, p).minus(rescale(o._value, o.precision, p)), p);
+    },
+    mul: function (other) {
+        var o = operand(other, this);
+        var p = Math.max(this.precision, o.precision);
+        incr(DECIMAL_INCR + Math.ceil(digitsOf(this._value) * digitsOf(o._value) / 8));
+        return fromScaled(rescale(this._value.times(o._value), this.precision + o.precision, p), p);
+    },
+    div: function (other, precision) {
+        var o = operand(other, this);
+        var p = precision === undefined ? this.precision : checkPrecision(precision);
+        if (o._value.isZero()) {
+            throw new Error("Decimal: division by zero");
+        }
+        incr(DECIMAL_INCR + Math.ceil(digitsOf(this._value) * digitsOf(o._value) / 8));
+        // the dividend is scaled to keep p digits in the quotient of the scaled integers.
+        var dividend = this._value.shift(p + o.precision - this.precision);
+        return fromScaled(dividend.dividedToIntegerBy(o._value), p);
+    },
+    round: function (precision, mode) {
+        precision = checkPrecision(precision);
+        if (mode !== undefined && [BN.ROUND_UP, BN.ROUND_DOWN, BN.ROUND_HALF_UP, BN.ROUND_HALF_EVEN].indexOf(mode) < 0) {
+            throw new Error("Decimal: invalid rounding mode " + mode);
+        }
+        incr(DECIMAL_INCR + digitsOf(this._value));
+        return fromScaled(rescale(this._value, this.precision, precision, mode), precision);
+    },
+    cmp: function (other) {
+        var o = operand(other, this);
+        var p = Math.max(this.precision, o.precision);
+        incr(DECIMAL_INCR + digitsOf(this._value) + digitsOf(o._value));
+        return rescale(this._value, this.precision, p).cmp(rescale(o._value, o.precision, p));
+    },
+    eq: function (other) {
+        return this.cmp(other) === 0;
+    },
+    lt: function (other) {
+        return this.cmp(other) < 0;
+    },
+    gt: function (other) {
+        return this.cmp(other) > 0;
+    },
+    isZero: function () {
+        return this._value.isZero();
+    },
+    isNegative: function () {
+        return this._value.isNegative() && !this._value.isZero();
+    },
+    toString: function () {
+        return this._value.shift(-this.precision).toFixed(this.precision);
+    },
+    toJSON: function () {
+        return this.toString();
+    }
+};
+
+module.exports = Decimal;
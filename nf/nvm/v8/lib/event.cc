@@ -57,10 +57,22 @@ void EventTriggerCallback(const FunctionCallbackInfo<Value> &info) {
     return;
   }
 
+  // indexed params in JSON array, optional.
+  Local<Value> indexed = String::Empty(isolate);
+  if (info.Length() > 2 && !info[2]->IsUndefined()) {
+    indexed = info[2];
+    if (!indexed->IsString()) {
+      isolate->ThrowException(Exception::Error(String::NewFromUtf8(
+          isolate, "_native_event_trigger: indexed must be string")));
+      return;
+    }
+  }
+
   // record event usage.
   RecordEventUsage(isolate, context,
                    topic->ToString()->Utf8Length() +
-                       data->ToString()->Utf8Length());
+                       data->ToString()->Utf8Length() +
+                       indexed->ToString()->Utf8Length());
 
   if (TRIGGER == NULL) {
     return;
@@ -69,6 +81,7 @@ void EventTriggerCallback(const FunctionCallbackInfo<Value> &info) {
   V8Engine *e = GetV8EngineInstance(context);
   String::Utf8Value sTopic(topic);
   String::Utf8Value sData(data);
+  String::Utf8Value sIndexed(indexed);
 
-  TRIGGER(e, *sTopic, *sData);
+  TRIGGER(e, *sTopic, *sData, *sIndexed);
 }
@@ -18,6 +18,22 @@
 
 'use strict';
 
-exports["Trigger"] = function (topic, data) {
-    _native_event_trigger(topic, JSON.stringify(data));
+const MAX_INDEXED_PARAMS = 3;
+
+// indexed is an optional array of params added to the event index of block, the events can be
+// filtered by the params at their positions without decoding data.
+exports["Trigger"] = function (topic, data, indexed) {
+    if (!Array.isArray(indexed)) {
+        _native_event_trigger(topic, JSON.stringify(data));
+        return;
+    }
+    if (indexed.length > MAX_INDEXED_PARAMS) {
+        throw new Error("Event.Trigger: indexed params exceed the limit " + MAX_INDEXED_PARAMS);
+    }
+    var params = indexed.map(function (v) {
+        return String(v);
+    });
+    _native_event_trigger(topic, JSON.stringify(data), JSON.stringify(params));
 };
+
+exports["MAX_INDEXED_PARAMS"] = MAX_INDEXED_PARAMS;
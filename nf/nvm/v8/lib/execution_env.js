@@ -107,3 +107,13 @@ const GlobalContractStorage = ContractStorage.gcs;
 const BigNumber = require('bignumber.js');
 const Blockchain = require('blockchain.js');
 const Event = require('event.js');
+const Crypto = require('crypto.js');
+
+// the native side installs no globals other than those in the bindings manifest.
+(function (global, bindings) {
+    Object.getOwnPropertyNames(global).forEach(function (name) {
+        if (/^(_|Native)/.test(name) && bindings.hostGlobals.indexOf(name) < 0) {
+            throw new Error("host binding " + name + " is not in the bindings manifest.");
+        }
+    });
+})(this, require('bindings.js'));
@@ -49,3 +49,11 @@ char *GetAccountState(void *handler, const char *address) {
 int Transfer(void *handler, const char *to, const char *value) { return 1; }
 
 int VerifyAddress(void *handler, const char *address) { return 1; }
+
+char *GetBlockHash(void *handler, const char *height) {
+  char *ret = NULL;
+  string value = "c7174759e86c59dcb7df87def82f61eb";
+  ret = (char *)calloc(value.length() + 1, sizeof(char));
+  strncpy(ret, value.c_str(), value.length());
+  return ret;
+}
@@ -147,3 +147,48 @@ void RecordEventUsage(Isolate *isolate, Local<Context> context,
   argv[0] = Number::New(isolate, msg_length);
   event_incr_func->Call(context, counter, 1, argv);
 }
+
+void RecordTransferUsage(Isolate *isolate, Local<Context> context) {
+  Local<Object> global = context->Global();
+  HandleScope handle_scope(isolate);
+
+  Local<Object> counter = Local<Object>::Cast(
+      global->Get(String::NewFromUtf8(isolate, sInstructionCounter)));
+
+  Local<Value> prop =
+      counter->Get(String::NewFromUtf8(isolate, "transferIncr"));
+  if (!prop->IsFunction()) {
+    LogDebugf(
+        "RecordTransferUsage: %s.transferIncr is not a "
+        "Function, instruction_count.js may not be called before execution.",
+        sInstructionCounter);
+    return;
+  }
+
+  Local<Function> transfer_incr_func = Local<Function>::Cast(prop);
+  transfer_incr_func->Call(context, counter, 0, NULL);
+}
+
+void RecordCryptoUsage(Isolate *isolate, Local<Context> context,
+                       size_t data_length, bool recover) {
+  Local<Object> global = context->Global();
+  HandleScope handle_scope(isolate);
+
+  Local<Object> counter = Local<Object>::Cast(
+      global->Get(String::NewFromUtf8(isolate, sInstructionCounter)));
+
+  Local<Value> prop = counter->Get(String::NewFromUtf8(isolate, "cryptoIncr"));
+  if (!prop->IsFunction()) {
+    LogDebugf(
+        "RecordCryptoUsage: %s.cryptoIncr is not a "
+        "Function, instruction_count.js may not be called before execution.",
+        sInstructionCounter);
+    return;
+  }
+
+  Local<Function> crypto_incr_func = Local<Function>::Cast(prop);
+  Local<Value> argv[2];
+  argv[0] = Number::New(isolate, data_length);
+  argv[1] = Boolean::New(isolate, recover);
+  crypto_incr_func->Call(context, counter, 2, argv);
+}
@@ -41,4 +41,9 @@ void RecordStorageUsage(Isolate *isolate, Local<Context> context,
 void RecordEventUsage(Isolate *isolate, Local<Context> context,
                       size_t msg_length);
 
+void RecordTransferUsage(Isolate *isolate, Local<Context> context);
+
+void RecordCryptoUsage(Isolate *isolate, Local<Context> context,
+                       size_t data_length, bool recover);
+
 #endif // _NEBULAS_NF_NVM_V8_LIB_INSTRUCTION_COUNTER_H_
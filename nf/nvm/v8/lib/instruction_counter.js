@@ -19,6 +19,7 @@
 
 const module_path_prefix = (typeof process !== 'undefined') && (process.release.name === 'node') ? './' : '';
 const esprima = require(module_path_prefix + 'esprima.js');
+const bindings = require(module_path_prefix + 'bindings.js');
 
 function traverse(object, visitor, master, injection_context_from_parent) {
     var key, child, parent, path;
@@ -68,6 +69,20 @@ var eventIncrFunc = function (data_len) {
     _instruction_counter.incr(incr_val);
 };
 
+// record the usage of a value transfer from contract.
+var transferIncrFunc = function () {
+    const TRANSFER_INCR = 100;
+    _instruction_counter.incr(TRANSFER_INCR);
+};
+
+// calculate and record the usage of a hash or a signature recovery.
+var cryptoIncrFunc = function (data_len, recover) {
+    const HASH_INCR = 20;
+    const RECOVER_INCR = 1000;
+    var incr_val = Math.ceil(data_len) + (recover ? RECOVER_INCR : HASH_INCR);
+    _instruction_counter.incr(incr_val);
+};
+
 // key is the Expression, value is the count of instruction of the Expression.
 const TrackingExpressions = {
     CallExpression: 8,
@@ -101,7 +116,13 @@ const InjectionType = {
 const InjectionCodeGenerators = {
     StorageAndEventUsageFunc: function () {
         return "_instruction_counter.storIncr = " + storIncrFunc.toString() + ";\n" +
-            "_instruction_counter.eventIncr = " + eventIncrFunc.toString() + ";\n";
+            "_instruction_counter.eventIncr = " + eventIncrFunc.toString() + ";\n" +
+            "_instruction_counter.cryptoIncr = " + cryptoIncrFunc.toString() + ";\n";
+    },
+    // the transfers are charged from the contract transfer height only.
+    StorageEventAndTransferUsageFunc: function () {
+        return InjectionCodeGenerators.StorageAndEventUsageFunc() +
+            "_instruction_counter.transferIncr = " + transferIncrFunc.toString() + ";\n";
     },
     CounterIncrFunc: function (value) {
         return "_instruction_counter.incr(" + value + ");";
@@ -156,7 +177,7 @@ function record_injection_info(injection_records, pos, value, injection_func) {
     item.value += value;
 };
 
-function processScript(source) {
+function processScript(source, checkBindings, chargeTransfer) {
     var injection_records = new Map();
     var record_injection = function (pos, value, injection_func) {
         return record_injection_info(injection_records, pos, value, injection_func);
@@ -188,14 +209,21 @@ function processScript(source) {
     traverse(ast, function (node, parents, injection_context_from_parent) {
         // get the ast begin offset, after comments before first statement.
         if (!setStorageAndEventUsageFuncInjection) {
-            source_line_offset = -9;
-            record_injection(node.range[0], 0, InjectionCodeGenerators.StorageAndEventUsageFunc);
+            var usageFunc = chargeTransfer ? InjectionCodeGenerators.StorageEventAndTransferUsageFunc : InjectionCodeGenerators.StorageAndEventUsageFunc;
+            // the lines of the injected usage functions.
+            source_line_offset = 1 - usageFunc().split("\n").length;
+            record_injection(node.range[0], 0, usageFunc);
             setStorageAndEventUsageFuncInjection = true;
         }
 
         // throw error when "_instruction_counter" was redefined in source.
         disallowRedefineOfInstructionCounter(node, parents);
 
+        // throw error when the host bindings are accessed in source.
+        if (checkBindings) {
+            disallowAccessOfHostBindings(node, parents);
+        }
+
         // 1. flag find the injection point, eg a Expression/Statement can inject code directly.
         if (node.type == "IfStatement") {
             ensure_block_statement(node.consequent);
@@ -395,6 +423,24 @@ function disallowRedefineOfInstructionCounter(node, parents) {
     }
 };
 
+// throw error when the globals installed by the native side are accessed.
+function disallowAccessOfHostBindings(node, parents) {
+    if (node.type != 'Identifier' || bindings.hostGlobals.indexOf(node.name) < 0) {
+        return;
+    }
+
+    // the property names are not the globals.
+    var parent = parents[0];
+    if (parent.node && !parent.node.computed && ((parent.node.type == 'MemberExpression' && parent.key == 'property') ||
+            (parent.node.type in {
+                Property: "",
+                MethodDefinition: "",
+            } && parent.key == 'key'))) {
+        return;
+    }
+
+    throw new Error("access to host binding " + node.name + " is not allowed.");
+};
 
 exports["parseScript"] = esprima.parseScript;
 exports["processScript"] = processScript;
@@ -18,6 +18,7 @@
 //
 
 #include "memory_modules.h"
+#include "file.h"
 #include "logger.h"
 
 #include <atomic>
@@ -81,7 +82,7 @@ char *RequireDelegateFunc(void *handler, const char *filepath,
   // LogInfof("RequireDelegateFunc: %s -> %s", "", filepath);
 
   char id[128];
-  sprintf(id, "%zu:%s", (uintptr_t)handler, filepath);
+  snprintf(id, 128, "%zu:%s", (uintptr_t)handler, filepath);
 
   char *ret = NULL;
   *lineOffset = 0;
@@ -96,6 +97,12 @@ char *RequireDelegateFunc(void *handler, const char *filepath,
   }
   m.unlock();
 
+  // the modules not added are read from file, as the require callback never
+  // does when the delegate is set.
+  if (ret == NULL) {
+    ret = readFile(filepath, NULL);
+  }
+
   return ret;
 }
 
@@ -18,10 +18,12 @@
 //
 #include "memory_storage.h"
 
+#include <algorithm>
 #include <atomic>
 #include <mutex>
 #include <string>
 #include <unordered_map>
+#include <vector>
 
 #include <stdio.h>
 #include <stdlib.h>
@@ -80,3 +82,53 @@ int StorageDel(void *handler, const char *key) {
 
   return 0;
 }
+
+static string quoteJSON(const string &value) {
+  string quoted = "\"";
+  for (char c : value) {
+    if (c == '"' || c == '\\') {
+      quoted.push_back('\\');
+      quoted.push_back(c);
+    } else if ((unsigned char)c < 0x20) {
+      char buf[8];
+      snprintf(buf, sizeof(buf), "\\u%04x", c);
+      quoted.append(buf);
+    } else {
+      quoted.push_back(c);
+    }
+  }
+  quoted.push_back('"');
+  return quoted;
+}
+
+char *StorageIter(void *handler, const char *domain, size_t offset,
+                  size_t limit, size_t *usage) {
+  string prefix = genKey(handler, "@");
+  prefix.append(domain);
+  prefix.append("[");
+
+  // the entries of the domain are visited in key order.
+  vector<pair<string, string>> entries;
+  mapMutex.lock();
+  for (auto &it : memoryMap) {
+    if (it.first.compare(0, prefix.length(), prefix) == 0) {
+      entries.push_back(it);
+    }
+  }
+  mapMutex.unlock();
+  sort(entries.begin(), entries.end());
+
+  string values = "[";
+  for (size_t i = offset; i < entries.size() && i < offset + limit; i++) {
+    if (values.length() > 1) {
+      values.append(",");
+    }
+    values.append(quoteJSON(entries[i].second));
+    *usage += entries[i].second.length();
+  }
+  values.append("]");
+
+  char *ret = (char *)calloc(values.length() + 1, sizeof(char));
+  strncpy(ret, values.c_str(), values.length());
+  return ret;
+}
@@ -20,11 +20,15 @@
 #ifndef _NEBULAS_NF_NVM_V8_LIB_MEMORY_STORAGE_H_
 #define _NEBULAS_NF_NVM_V8_LIB_MEMORY_STORAGE_H_
 
+#include <stddef.h>
+
 void *CreateStorageHandler();
 void DeleteStorageHandler(void *handler);
 
 char *StorageGet(void *handler, const char *key);
 int StoragePut(void *handler, const char *key, const char *value);
 int StorageDel(void *handler, const char *key);
+char *StorageIter(void *handler, const char *domain, size_t offset,
+                  size_t limit, size_t *usage);
 
 #endif // _NEBULAS_NF_NVM_V8_LIB_MEMORY_STORAGE_H_
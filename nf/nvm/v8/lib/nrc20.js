@@ -0,0 +1,175 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+'use strict';
+
+// NRC20 is the reference implementation of the NRC20 token standard. A token contract exports
+// it directly, or inherits it to add its own functions:
+//
+//     var NRC20 = require('nrc20.js');
+//     var MyToken = function () {
+//         NRC20.call(this);
+//     };
+//     MyToken.prototype = Object.create(NRC20.prototype);
+//     module.exports = MyToken;
+//
+// The amounts are strings of integers in the smallest unit of the token. The transfers and
+// approvals trigger the "transfer" and "approve" events indexed by the accounts involved.
+
+var bigNumberDescriptor = {
+    parse: function (value) {
+        return new BigNumber(value);
+    },
+    stringify: function (o) {
+        return o.toString(10);
+    }
+};
+
+var toAmount = function (value) {
+    var amount = new BigNumber(value);
+    if (!amount.isFinite() || amount.isNaN() || amount.lessThan(0) || !amount.isInteger()) {
+        throw new Error("NRC20: invalid amount " + value);
+    }
+    return amount;
+};
+
+var checkAddress = function (address) {
+    if (!Blockchain.verifyAddress(address)) {
+        throw new Error("NRC20: invalid address " + address);
+    }
+};
+
+var NRC20 = function () {
+    LocalContractStorage.defineProperties(this, {
+        _name: null,
+        _symbol: null,
+        _decimals: null,
+        _totalSupply: bigNumberDescriptor
+    });
+    LocalContractStorage.defineMapProperties(this, {
+        balances: bigNumberDescriptor,
+        allowed: bigNumberDescriptor
+    });
+};
+
+NRC20.prototype = {
+    // init issues the total supply to the deployer.
+    init: function (name, symbol, decimals, totalSupply) {
+        var from = Blockchain.transaction.from;
+        decimals = decimals || 0;
+        if (typeof decimals !== 'number' || decimals < 0 || decimals % 1 !== 0) {
+            throw new Error("NRC20: invalid decimals " + decimals);
+        }
+        this._name = name;
+        this._symbol = symbol;
+        this._decimals = decimals;
+        this._totalSupply = toAmount(totalSupply);
+        this.balances.set(from, this._totalSupply);
+        this._transferEvent(true, "", from, this._totalSupply);
+    },
+
+    name: function () {
+        return this._name;
+    },
+
+    symbol: function () {
+        return this._symbol;
+    },
+
+    decimals: function () {
+        return this._decimals;
+    },
+
+    totalSupply: function () {
+        return this._totalSupply.toString(10);
+    },
+
+    balanceOf: function (owner) {
+        var balance = this.balances.get(owner);
+        return balance ? balance.toString(10) : "0";
+    },
+
+    transfer: function (to, value) {
+        checkAddress(to);
+        value = toAmount(value);
+        this._transfer(Blockchain.transaction.from, to, value);
+    },
+
+    transferFrom: function (from, to, value) {
+        checkAddress(to);
+        value = toAmount(value);
+        var spender = Blockchain.transaction.from;
+        var allowed = new BigNumber(this.allowance(from, spender));
+        if (allowed.lessThan(value)) {
+            throw new Error("NRC20: transfer exceeds the allowance");
+        }
+        this._transfer(from, to, value);
+        this.allowed.set(this._allowedKey(from, spender), allowed.sub(value));
+    },
+
+    // approve replaces the allowance of spender only if it is still currentValue, so that a
+    // spender can't spend both the old and the new allowance.
+    approve: function (spender, currentValue, value) {
+        checkAddress(spender);
+        value = toAmount(value);
+        var owner = Blockchain.transaction.from;
+        if (this.allowance(owner, spender) !== toAmount(currentValue).toString(10)) {
+            throw new Error("NRC20: current allowance mismatch");
+        }
+        this.allowed.set(this._allowedKey(owner, spender), value);
+        Event.Trigger("approve", {
+            Status: true,
+            Approve: {
+                owner: owner,
+                spender: spender,
+                value: value.toString(10)
+            }
+        }, [owner, spender]);
+    },
+
+    allowance: function (owner, spender) {
+        var allowed = this.allowed.get(this._allowedKey(owner, spender));
+        return allowed ? allowed.toString(10) : "0";
+    },
+
+    _allowedKey: function (owner, spender) {
+        return owner + ":" + spender;
+    },
+
+    _transfer: function (from, to, value) {
+        var balance = new BigNumber(this.balanceOf(from));
+        if (balance.lessThan(value)) {
+            throw new Error("NRC20: transfer exceeds the balance");
+        }
+        this.balances.set(from, balance.sub(value));
+        this.balances.set(to, new BigNumber(this.balanceOf(to)).plus(value));
+        this._transferEvent(true, from, to, value);
+    },
+
+    _transferEvent: function (status, from, to, value) {
+        Event.Trigger("transfer", {
+            Status: status,
+            Transfer: {
+                from: from,
+                to: to,
+                value: value.toString(10)
+            }
+        }, [from, to]);
+    }
+};
+
+module.exports = NRC20;
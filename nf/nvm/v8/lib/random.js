@@ -0,0 +1,52 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+'use strict';
+
+// Math.random is replaced by a deterministic generator once activated by the genesis params,
+// the same contract execution on every node draws the same numbers. The seed is the hash of the parent block and the transaction,
+// the hash of the block itself is unknown while its transactions are executed. The numbers
+// are predictable from the chain, never use them for anything a player can gain by guessing.
+var Random = function () {
+    this.seed = null;
+    this.counter = 0;
+};
+
+Random.prototype = {
+    random: function () {
+        if (this.seed === null) {
+            var block = Blockchain.block || {};
+            var tx = Blockchain.transaction || {};
+            this.seed = _native_crypto_hash("sha3256", (block.parentHash || "") + ":" + (tx.hash || ""));
+        }
+        this.counter++;
+        // 52 bits of the hash make a double in [0, 1).
+        var hash = _native_crypto_hash("sha3256", this.seed + ":" + this.counter);
+        return parseInt(hash.substring(0, 13), 16) / Math.pow(2, 52);
+    }
+};
+
+module.exports = new Random();
+module.exports.Random = Random;
+
+// install replaces Math.random of the contract execution by the generator.
+module.exports.install = function () {
+    var random = module.exports;
+    Math.random = function () {
+        return random.random();
+    };
+};
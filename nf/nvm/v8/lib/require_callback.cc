@@ -50,13 +50,15 @@ static int readSource(Local<Context> context, const char *filename, char **data,
 
   char *content = NULL;
 
-  // try sRequireDelegate.
+  // the delegate is the only source of modules if set, the modules rejected by
+  // it are never read from file.
   if (sRequireDelegate != NULL) {
     V8Engine *e = GetV8EngineInstance(context);
     content = sRequireDelegate(e, filename, lineOffset);
-  }
-
-  if (content == NULL) {
+    if (content == NULL) {
+      return 1;
+    }
+  } else {
     size_t file_size = 0;
     content = readFile(filename, &file_size);
     if (content == NULL) {
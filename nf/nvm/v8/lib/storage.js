@@ -20,6 +20,9 @@
 
 var fieldNameRe = /^[a-zA-Z_$].*/;
 
+// max count of entries returned by an iteration.
+var MAX_ITERATE_LIMIT = 100;
+
 var combineStorageMapKey = function (fieldName, key) {
     return "@" + fieldName + "[" + key + "]";
 };
@@ -124,6 +127,14 @@ StorageMap.prototype = {
     set: function (key, value) {
         var val = this.stringify(value);
         return this.contractStorage.rawSet(combineStorageMapKey(this.fieldName, key), val);
+    },
+    // iterate returns the values of at most limit (MAX_ITERATE_LIMIT by default) entries after skipping
+    // offset entries, the keys are hashed in storage so they are not returned, store the key in value if needed.
+    iterate: function (offset, limit) {
+        var $this = this;
+        return this.contractStorage.rawIterate(this.fieldName, offset, limit).map(function (val) {
+            return $this.parse(val);
+        });
     }
 };
 StorageMap.prototype.put = StorageMap.prototype.set;
@@ -141,6 +152,17 @@ ContractStorage.prototype = {
         }
         return ret;
     },
+    rawIterate: function (fieldName, offset, limit) {
+        if (limit === undefined) {
+            limit = MAX_ITERATE_LIMIT;
+        }
+        return JSON.parse(this.nativeStorage.iter(fieldName, offset || 0, limit));
+    },
+    iterate: function (fieldName, offset, limit) {
+        return this.rawIterate(fieldName, offset, limit).map(function (val) {
+            return JSON.parse(val);
+        });
+    },
     del: function (key) {
         var ret = this.nativeStorage.del(key);
         if (ret != 0) {
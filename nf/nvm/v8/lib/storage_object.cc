@@ -26,6 +26,7 @@
 static StorageGetFunc GET = NULL;
 static StoragePutFunc PUT = NULL;
 static StorageDelFunc DEL = NULL;
+static StorageIterFunc ITER = NULL;
 
 void NewStorageType(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
   Local<FunctionTemplate> type =
@@ -55,6 +56,11 @@ void NewStorageType(Isolate *isolate, Local<ObjectTemplate> globalTpl) {
       FunctionTemplate::New(isolate, StorageDelCallback),
       static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
                                      PropertyAttribute::ReadOnly));
+  instanceTpl->Set(
+      String::NewFromUtf8(isolate, "iter"),
+      FunctionTemplate::New(isolate, StorageIterCallback),
+      static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
+                                     PropertyAttribute::ReadOnly));
 
   globalTpl->Set(className, type,
                  static_cast<PropertyAttribute>(PropertyAttribute::DontDelete |
@@ -86,10 +92,11 @@ void NewStorageTypeInstance(Isolate *isolate, Local<Context> context,
 }
 
 void InitializeStorage(StorageGetFunc get, StoragePutFunc put,
-                       StorageDelFunc del) {
+                       StorageDelFunc del, StorageIterFunc iter) {
   GET = get;
   PUT = put;
   DEL = del;
+  ITER = iter;
 }
 
 void StorageConstructor(const FunctionCallbackInfo<Value> &info) {
@@ -196,3 +203,47 @@ void StorageDelCallback(const FunctionCallbackInfo<Value> &info) {
   int ret = DEL(handler->Value(), *String::Utf8Value(key->ToString()));
   info.GetReturnValue().Set(ret);
 }
+
+void StorageIterCallback(const FunctionCallbackInfo<Value> &info) {
+  Isolate *isolate = info.GetIsolate();
+  Local<Object> thisArg = info.Holder();
+  Local<External> handler = Local<External>::Cast(thisArg->GetInternalField(0));
+
+  if (info.Length() != 3) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "Storage.iter() requires 3 arguments"));
+    return;
+  }
+
+  Local<Value> domain = info[0];
+  if (!domain->IsString()) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "domain must be string"));
+    return;
+  }
+
+  Local<Value> offset = info[1];
+  Local<Value> limit = info[2];
+  if (!offset->IsUint32() || !limit->IsUint32()) {
+    isolate->ThrowException(String::NewFromUtf8(
+        isolate, "offset and limit must be non-negative integer"));
+    return;
+  }
+
+  size_t usage = 0;
+  char *values =
+      ITER(handler->Value(), *String::Utf8Value(domain->ToString()),
+           (size_t)offset->Uint32Value(), (size_t)limit->Uint32Value(), &usage);
+
+  // record storage usage of the visited entries.
+  Local<Context> context = isolate->GetCurrentContext();
+  RecordStorageUsage(isolate, context, usage, 0);
+
+  if (values == NULL) {
+    isolate->ThrowException(
+        String::NewFromUtf8(isolate, "Storage.iter() failed"));
+    return;
+  }
+  info.GetReturnValue().Set(String::NewFromUtf8(isolate, values));
+  free(values);
+}
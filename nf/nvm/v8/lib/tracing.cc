@@ -32,7 +32,7 @@ static char inject_tracer_source_template[] =
     "(function(){\n"
     "const instCounter = require(\"instruction_counter.js\");\n"
     "const source = \"%s\";\n"
-    "return instCounter.processScript(source);\n"
+    "return instCounter.processScript(source, %s, %s);\n"
     "})();";
 
 int InjectTracingInstructionDelegate(char **result, Isolate *isolate,
@@ -49,7 +49,9 @@ int InjectTracingInstructionDelegate(char **result, Isolate *isolate,
   s = ReplaceAll(s, "\"", "\\\"");
 
   char *injectTracerSource = NULL;
-  asprintf(&injectTracerSource, inject_tracer_source_template, s.c_str());
+  asprintf(&injectTracerSource, inject_tracer_source_template, s.c_str(),
+           tContext->check_bindings ? "true" : "false",
+           tContext->charge_transfer ? "true" : "false");
 
   // Create a string containing the JavaScript source code.
   Local<String> src =
@@ -27,6 +27,8 @@ using namespace v8;
 typedef struct {
   int source_line_offset;
   char *tracable_source;
+  int check_bindings; // reject the access to host bindings in source.
+  int charge_transfer; // charge the value transfers from contract.
 } TracingContext;
 
 int InjectTracingInstructionDelegate(char **result, Isolate *isolate,
@@ -17,11 +17,18 @@
 //
 const ts = require('typescriptServices.js');
 
+// the target is pinned rather than left to the default of the compiler.
 var compilerOptions = {
     module: ts.ModuleKind.CommonJS,
+    target: ts.ScriptTarget.ES3,
 };
 
-function transpileModule(input) {
+// transpileModule transpiles the input, version is the required compiler version if not empty.
+function transpileModule(input, version) {
+    if (version && version !== ts.version) {
+        throw new Error("fail to transpile TypeScript: compiler version " + ts.version + " mismatches " + version);
+    }
+
     var ret = ts.transpileModule(input, {
         compilerOptions: compilerOptions,
         reportDiagnostics: true,
@@ -50,3 +57,4 @@ function transpileModule(input) {
 };
 
 exports.transpileModule = transpileModule;
+exports.version = ts.version;
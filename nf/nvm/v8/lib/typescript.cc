@@ -29,7 +29,7 @@ static char ts_transpile_source_template[] =
     "(function(){\n"
     "const tsc = require(\"tsc.js\");\n"
     "const source = \"%s\";\n"
-    "return tsc.transpileModule(source);\n"
+    "return tsc.transpileModule(source, \"%s\");\n"
     "})();";
 
 int TypeScriptTranspileDelegate(char **result, Isolate *isolate,
@@ -47,7 +47,8 @@ int TypeScriptTranspileDelegate(char **result, Isolate *isolate,
   s = ReplaceAll(s, "\"", "\\\"");
 
   char *runnableSource = NULL;
-  asprintf(&runnableSource, ts_transpile_source_template, s.c_str());
+  const char *version = tContext->version != NULL ? tContext->version : "";
+  asprintf(&runnableSource, ts_transpile_source_template, s.c_str(), version);
 
   // Create a string containing the JavaScript source code.
   Local<String> src =
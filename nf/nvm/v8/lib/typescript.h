@@ -28,6 +28,8 @@ using namespace v8;
 typedef struct {
   int source_line_offset;
   char *js_source;
+  // the required compiler version, NULL accepts any version.
+  const char *version;
 } TypeScriptContext;
 
 int TypeScriptTranspileDelegate(char **result, Isolate *isolate,
@@ -52,8 +52,18 @@ void logFunc(int level, const char *msg) {
           msg);
 }
 
-void eventTriggerFunc(void *handler, const char *topic, const char *data) {
-  fprintf(stdout, "[Event] [%s] %s\n", topic, data);
+void eventTriggerFunc(void *handler, const char *topic, const char *data,
+                      const char *indexed) {
+  fprintf(stdout, "[Event] [%s] %s %s\n", topic, data, indexed);
+}
+
+char *cryptoHashFunc(void *handler, const char *alg, const char *data) {
+  return strdup("c7174759e86c59dcb7df87def82f61eb");
+}
+
+char *recoverAddressFunc(void *handler, int alg, const char *hash,
+                         const char *sign) {
+  return strdup("8a209cec02cbeab7e2f74ad969d2dfe8dd24416aa65589bf");
 }
 
 void help(const char *name) {
@@ -85,7 +95,7 @@ void RunScriptSourceDelegate(V8Engine *e, const char *data,
     e->limits_of_executed_instructions = limits_of_executed_instructions;
     e->limits_of_total_memory_size = limits_of_total_memory_size;
 
-    char *traceableSource = InjectTracingInstructions(e, data, &lineOffset);
+    char *traceableSource = InjectTracingInstructions(e, data, &lineOffset, 0, 1);
     if (traceableSource == NULL) {
       fprintf(stderr, "Inject tracing instructions failed.\n");
     } else {
@@ -173,7 +183,7 @@ void ExecuteScript(const char *filename, V8ExecutionDelegate delegate) {
   if (filenameLen > 3 && filename[filenameLen - 3] == '.' &&
       filename[filenameLen - 2] == 't' && filename[filenameLen - 1] == 's') {
     size = 0;
-    char *jsSource = TranspileTypeScriptModule(e, source, &lineOffset);
+    char *jsSource = TranspileTypeScriptModule(e, source, NULL, &lineOffset);
     if (jsSource == NULL) {
       fprintf(stderr, "%s is not a valid TypeScript file.\n", filename);
       free(source);
@@ -185,7 +195,7 @@ void ExecuteScript(const char *filename, V8ExecutionDelegate delegate) {
 
   // inject tracing code.
   if (enable_tracer_injection) {
-    char *traceableSource = InjectTracingInstructions(e, source, &lineOffset);
+    char *traceableSource = InjectTracingInstructions(e, source, &lineOffset, 0, 1);
     if (traceableSource == NULL) {
       fprintf(stderr, "Inject tracing instructions failed.\n");
       free(source);
@@ -224,9 +234,11 @@ int main(int argc, const char *argv[]) {
   Initialize();
   InitializeLogger(logFunc);
   InitializeRequireDelegate(RequireDelegateFunc);
-  InitializeStorage(StorageGet, StoragePut, StorageDel);
-  InitializeBlockchain(GetTxByHash, GetAccountState, Transfer, VerifyAddress);
+  InitializeStorage(StorageGet, StoragePut, StorageDel, StorageIter);
+  InitializeBlockchain(GetTxByHash, GetAccountState, Transfer, VerifyAddress,
+                       GetBlockHash);
   InitializeEvent(eventTriggerFunc);
+  InitializeCrypto(cryptoHashFunc, recoverAddressFunc);
 
   int argcIdx = 1;
   const char *filename = NULL;
@@ -19,30 +19,33 @@
 package rpc
 
 import (
-	"errors"
+	"encoding/json"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/common/trie"
 	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/state"
 	"github.com/nebulasio/go-nebulas/crypto/keystore"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
 	"github.com/nebulasio/go-nebulas/util/logging"
-	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 )
 
 // AdminService implements the RPC admin service interface.
 type AdminService struct {
 	server GRPCServer
+
+	webhooks *WebhookManager
+
+	pprof *pprofServer
+
+	multisig *multisigStore
 }
 
 // NewAccount generate a new address with passphrase
 func (s *AdminService) NewAccount(ctx context.Context, req *rpcpb.NewAccountRequest) (*rpcpb.NewAccountResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/account/new",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -55,9 +58,6 @@ func (s *AdminService) NewAccount(ctx context.Context, req *rpcpb.NewAccountRequ
 
 // UnlockAccount unlock address with the passphrase
 func (s *AdminService) UnlockAccount(ctx context.Context, req *rpcpb.UnlockAccountRequest) (*rpcpb.UnlockAccountResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/account/unlock",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -82,9 +82,6 @@ func (s *AdminService) UnlockAccount(ctx context.Context, req *rpcpb.UnlockAccou
 
 // LockAccount lock address
 func (s *AdminService) LockAccount(ctx context.Context, req *rpcpb.LockAccountRequest) (*rpcpb.LockAccountResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/account/lock",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -101,9 +98,6 @@ func (s *AdminService) LockAccount(ctx context.Context, req *rpcpb.LockAccountRe
 
 // SignTransaction sign transaction with the from addr passphrase
 func (s *AdminService) SignTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.SignTransactionResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/sign",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -133,9 +127,6 @@ func (s *AdminService) SignTransaction(ctx context.Context, req *rpcpb.Transacti
 
 // SendTransactionWithPassphrase send transaction with the from addr passphrase
 func (s *AdminService) SendTransactionWithPassphrase(ctx context.Context, req *rpcpb.SendTransactionPassphraseRequest) (*rpcpb.SendTransactionPassphraseResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/transactionWithPassphrase",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -152,11 +143,93 @@ func (s *AdminService) SendTransactionWithPassphrase(ctx context.Context, req *r
 	return &rpcpb.SendTransactionPassphraseResponse{Hash: tx.Hash().String()}, nil
 }
 
+// CreateMultisigTransaction keep the multisig transaction on node for the signers to co-sign
+func (s *AdminService) CreateMultisigTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.MultisigTransactionResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	hash, err := multisigHash(neb.BlockChain().ChainID(), req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := toMultisigPayload(req.Multisig).Wallet(); err != nil {
+		return nil, err
+	}
+	if err := s.multisig.add(hash.String(), req); err != nil {
+		return nil, err
+	}
+	return toMultisigTransactionResponse(hash, req)
+}
+
+// SignMultisigTransaction co-sign the multisig transaction with the signer passphrase
+func (s *AdminService) SignMultisigTransaction(ctx context.Context, req *rpcpb.SignMultisigTransactionRequest) (*rpcpb.MultisigTransactionResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	signer, err := core.AddressParse(req.Signer)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := byteutils.FromHex(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := s.multisig.update(req.Hash, func(payload *core.MultisigPayload) error {
+		return neb.AccountManager().SignMultisigPayloadWithPassphrase(signer, payload, hash, []byte(req.Passphrase))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toMultisigTransactionResponse(hash, tx)
+}
+
+// GetMultisigTransaction return the partially-signed multisig transaction
+func (s *AdminService) GetMultisigTransaction(ctx context.Context, req *rpcpb.HashRequest) (*rpcpb.MultisigTransactionResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	hash, err := byteutils.FromHex(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := s.multisig.get(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return toMultisigTransactionResponse(hash, tx)
+}
+
+// SendMultisigTransaction sign the multisig transaction with the from passphrase and send it
+func (s *AdminService) SendMultisigTransaction(ctx context.Context, req *rpcpb.SendMultisigTransactionRequest) (*rpcpb.SendTransactionPassphraseResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	hash, err := byteutils.FromHex(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	reqTx, err := s.multisig.get(req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if err := toMultisigPayload(reqTx.Multisig).Verify(hash); err != nil {
+		return nil, err
+	}
+	tx, err := parseTransaction(neb, reqTx)
+	if err != nil {
+		return nil, err
+	}
+	if err := neb.AccountManager().SignTransactionWithPassphrase(tx.From(), tx, []byte(req.Passphrase)); err != nil {
+		return nil, err
+	}
+	if err := neb.BlockChain().TransactionPool().PushAndBroadcast(tx); err != nil {
+		return nil, err
+	}
+	s.multisig.remove(req.Hash)
+	return &rpcpb.SendTransactionPassphraseResponse{Hash: tx.Hash().String()}, nil
+}
+
 // StatisticsNodeInfo is the RPC API handler.
 func (s *AdminService) StatisticsNodeInfo(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.StatisticsNodeInfoResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/statistics/nodeInfo",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -172,10 +245,6 @@ func (s *AdminService) StatisticsNodeInfo(ctx context.Context, req *rpcpb.NonPar
 
 // GetDynasty is the RPC API handler.
 func (s *AdminService) GetDynasty(ctx context.Context, req *rpcpb.ByBlockHeightRequest) (*rpcpb.GetDynastyResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api":    "/v1/admin/dynasty",
-		"height": req.Height,
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -199,12 +268,151 @@ func (s *AdminService) GetDynasty(ctx context.Context, req *rpcpb.ByBlockHeightR
 	return &rpcpb.GetDynastyResponse{Delegatees: result}, nil
 }
 
+// GetTransactionDependency is the RPC API handler.
+func (s *AdminService) GetTransactionDependency(ctx context.Context, req *rpcpb.ByBlockHeightRequest) (*rpcpb.GetTransactionDependencyResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	block := neb.BlockChain().TailBlock()
+	if req.Height > 0 {
+		block = neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
+		if block == nil {
+			return nil, ErrBlockNotFound
+		}
+	}
+
+	txs := block.Transactions()
+	dependencies := []*rpcpb.TransactionDependency{}
+	for i, depends := range block.TransactionsDependency() {
+		dependency := &rpcpb.TransactionDependency{Hash: txs[i].Hash().String()}
+		for _, idx := range depends {
+			dependency.Depends = append(dependency.Depends, txs[idx].Hash().String())
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	return &rpcpb.GetTransactionDependencyResponse{Dependencies: dependencies}, nil
+}
+
+// GetForks is the RPC API handler.
+func (s *AdminService) GetForks(ctx context.Context, req *rpcpb.GetForksRequest) (*rpcpb.GetForksResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	forks := []*rpcpb.Fork{}
+	for _, v := range neb.BlockChain().Forks(req.Depth) {
+		fork := &rpcpb.Fork{
+			TipHash:        v.Tip.Hash().String(),
+			Height:         v.Tip.Height(),
+			Timestamp:      v.Tip.Timestamp(),
+			Length:         v.Length,
+			AncestorHash:   v.Ancestor.Hash().String(),
+			AncestorHeight: v.Ancestor.Height(),
+		}
+		if miner := v.Tip.Miner(); miner != nil {
+			fork.Miner = miner.String()
+		}
+		forks = append(forks, fork)
+	}
+	return &rpcpb.GetForksResponse{Forks: forks}, nil
+}
+
+// GetContractStats is the RPC API handler.
+func (s *AdminService) GetContractStats(ctx context.Context, req *rpcpb.ContractStatsRequest) (*rpcpb.ContractStatsResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	var address *core.Address
+	if len(req.Address) > 0 {
+		addr, err := core.AddressParse(req.Address)
+		if err != nil {
+			return nil, err
+		}
+		address = addr
+	}
+
+	stats := []*rpcpb.ContractStats{}
+	for _, v := range neb.BlockChain().ContractExecutionStats(address, int(req.Limit)) {
+		addr, err := core.AddressParseFromBytes(v.Address)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, &rpcpb.ContractStats{
+			Address:         addr.String(),
+			Calls:           v.Calls,
+			Failures:        v.Failures,
+			FailureRate:     v.FailureRate(),
+			GasUsed:         v.GasUsed,
+			AverageDuration: int64(v.AverageDuration()),
+		})
+	}
+	return &rpcpb.ContractStatsResponse{Stats: stats}, nil
+}
+
+// DefaultIterateAccountsRate is the default max count of accounts streamed per second by IterateAccounts.
+const DefaultIterateAccountsRate = 1000
+
+// IterateAccounts is the RPC API handler.
+func (s *AdminService) IterateAccounts(req *rpcpb.IterateAccountsRequest, gs rpcpb.AdminService_IterateAccountsServer) error {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	var after byteutils.Hash
+	if len(req.After) > 0 {
+		addr, err := core.AddressParse(req.After)
+		if err != nil {
+			return err
+		}
+		after = addr.Bytes()
+	}
+	height := req.Height
+	if height == 0 {
+		height = neb.BlockChain().LatestIrreversibleBlock().Height()
+	}
+	rate := uint64(req.Rate)
+	if rate == 0 {
+		rate = DefaultIterateAccountsRate
+	}
+
+	var sent uint64
+	start := time.Now()
+	return neb.BlockChain().IterateAccounts(height, after, func(acc state.Account) (bool, error) {
+		addr, err := core.AddressParseFromBytes(acc.Address())
+		if err != nil {
+			return false, err
+		}
+		entry := &rpcpb.AccountEntry{
+			Height:     height,
+			Address:    addr.String(),
+			Balance:    acc.Balance().String(),
+			Nonce:      acc.Nonce(),
+			IsContract: len(acc.BirthPlace()) > 0,
+		}
+		if err := gs.Send(entry); err != nil {
+			return false, err
+		}
+		sent++
+		if req.Limit > 0 && sent >= req.Limit {
+			return false, nil
+		}
+
+		// wait for the next second once the rate of this second is used up.
+		if sent%rate == 0 {
+			if wait := time.Duration(sent/rate)*time.Second - time.Since(start); wait > 0 {
+				select {
+				case <-gs.Context().Done():
+					return false, gs.Context().Err()
+				case <-time.After(wait):
+				}
+			}
+		}
+		return true, nil
+	})
+}
+
 // GetCandidates is the RPC API handler.
 func (s *AdminService) GetCandidates(ctx context.Context, req *rpcpb.ByBlockHeightRequest) (*rpcpb.GetCandidatesResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api":    "/v1/admin/candidates",
-		"height": req.Height,
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -230,10 +438,6 @@ func (s *AdminService) GetCandidates(ctx context.Context, req *rpcpb.ByBlockHeig
 
 // GetDelegateVoters is the RPC API handler.
 func (s *AdminService) GetDelegateVoters(ctx context.Context, req *rpcpb.GetDelegateVotersRequest) (*rpcpb.GetDelegateVotersResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"delegatee": req.Delegatee,
-		"api":       "/v1/admin/delegateVoters",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -267,11 +471,65 @@ func (s *AdminService) GetDelegateVoters(ctx context.Context, req *rpcpb.GetDele
 	return &rpcpb.GetDelegateVotersResponse{Voters: voters}, nil
 }
 
+// GetVoteSnapshot is the RPC API handler.
+func (s *AdminService) GetVoteSnapshot(ctx context.Context, req *rpcpb.ByBlockHeightRequest) (*rpcpb.VoteSnapshotResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	snapshot, err := s.server.Neblet().BlockChain().VoteSnapshot(req.Height)
+	if err != nil {
+		return nil, err
+	}
+	return voteSnapshotResponse(snapshot), nil
+}
+
+// DebugCall is the RPC API handler.
+func (s *AdminService) DebugCall(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.DebugResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	tx, err := parseTransaction(neb, req)
+	if err != nil {
+		return nil, err
+	}
+	return debugResponse(neb.BlockChain().DebugCall(ctx, tx)), nil
+}
+
+// DebugTransaction is the RPC API handler.
+func (s *AdminService) DebugTransaction(ctx context.Context, req *rpcpb.HashRequest) (*rpcpb.DebugResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	hash, err := byteutils.FromHex(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	trace, err := s.server.Neblet().BlockChain().DebugTransaction(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	return debugResponse(trace), nil
+}
+
+func debugResponse(trace *core.ExecutionTrace) *rpcpb.DebugResponse {
+	resp := &rpcpb.DebugResponse{
+		GasUsed:      trace.GasUsed.String(),
+		Result:       trace.Result,
+		ExecuteError: trace.Err,
+		Steps:        make([]*rpcpb.TraceStep, len(trace.Steps)),
+		Stack:        trace.Stack,
+	}
+	for i, step := range trace.Steps {
+		resp.Steps[i] = &rpcpb.TraceStep{
+			Op:     step.Op,
+			Args:   step.Args,
+			Result: step.Result,
+			Gas:    step.Gas,
+		}
+	}
+	return resp
+}
+
 // ChangeNetworkID change the network id
 func (s *AdminService) ChangeNetworkID(ctx context.Context, req *rpcpb.ChangeNetworkIDRequest) (*rpcpb.ChangeNetworkIDResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/changeNetworkID",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -281,17 +539,25 @@ func (s *AdminService) ChangeNetworkID(ctx context.Context, req *rpcpb.ChangeNet
 	return &rpcpb.ChangeNetworkIDResponse{Result: true}, nil
 }
 
+// ReloadPeerAccessControl replace the allow and deny list of p2p peers.
+func (s *AdminService) ReloadPeerAccessControl(ctx context.Context, req *rpcpb.PeerAccessControlRequest) (*rpcpb.PeerAccessControlResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	if err := neb.NetManager().Node().ReloadAccessControl(req.AllowList, req.DenyList); err != nil {
+		return nil, err
+	}
+	return &rpcpb.PeerAccessControlResponse{Result: true}, nil
+}
+
 // StartMining start mining
 func (s *AdminService) StartMining(ctx context.Context, req *rpcpb.StartMiningRequest) (*rpcpb.MiningResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/startMining",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
 
 	if neb.Consensus().Enable() {
-		return nil, errors.New("consensus has already been started")
+		return nil, ErrConsensusAlreadyStarted
 	}
 
 	err := neb.Consensus().EnableMining(req.Passphrase)
@@ -303,15 +569,12 @@ func (s *AdminService) StartMining(ctx context.Context, req *rpcpb.StartMiningRe
 
 // StopMining stop mining
 func (s *AdminService) StopMining(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.MiningResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/admin/stopMining",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
 
 	if !neb.Consensus().Enable() {
-		return nil, errors.New("consensus not start yet")
+		return nil, ErrConsensusNotStarted
 	}
 
 	if err := neb.Consensus().DisableMining(); err != nil {
@@ -319,3 +582,72 @@ func (s *AdminService) StopMining(ctx context.Context, req *rpcpb.NonParamsReque
 	}
 	return &rpcpb.MiningResponse{Result: true}, nil
 }
+
+// RegisterWebhook register a webhook to post the matching chain events to
+func (s *AdminService) RegisterWebhook(ctx context.Context, req *rpcpb.RegisterWebhookRequest) (*rpcpb.RegisterWebhookResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	id, err := s.webhooks.Register(&WebhookConfig{
+		URL:       req.Url,
+		Topics:    req.Topics,
+		Contracts: req.Contracts,
+		Secret:    req.Secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.RegisterWebhookResponse{Id: id}, nil
+}
+
+// UnregisterWebhook remove the webhook
+func (s *AdminService) UnregisterWebhook(ctx context.Context, req *rpcpb.UnregisterWebhookRequest) (*rpcpb.UnregisterWebhookResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	if err := s.webhooks.Unregister(req.Id); err != nil {
+		return nil, err
+	}
+	return &rpcpb.UnregisterWebhookResponse{Result: true}, nil
+}
+
+// StartPprof start the pprof server
+func (s *AdminService) StartPprof(ctx context.Context, req *rpcpb.PprofRequest) (*rpcpb.PprofResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	listen, err := s.pprof.start(req.Listen)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.PprofResponse{Result: true, Listen: listen}, nil
+}
+
+// StopPprof stop the pprof server
+func (s *AdminService) StopPprof(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.PprofResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	if err := s.pprof.stop(); err != nil {
+		return nil, err
+	}
+	return &rpcpb.PprofResponse{Result: true}, nil
+}
+
+// SetLogLevel change the log level of the module
+func (s *AdminService) SetLogLevel(ctx context.Context, req *rpcpb.LogLevelRequest) (*rpcpb.LogLevelResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	if err := logging.SetLevel(req.Module, req.Level); err != nil {
+		return nil, err
+	}
+	return &rpcpb.LogLevelResponse{Result: true}, nil
+}
+
+// GetConfig return the effective config with secrets redacted
+func (s *AdminService) GetConfig(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GetConfigResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	config, err := json.Marshal(sanitizeConfig(neb.Config()))
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetConfigResponse{Config: string(config)}, nil
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/consensus"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/core/pb"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/net/p2p"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/storage"
+	nsync "github.com/nebulasio/go-nebulas/sync"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+var testDynasty = []string{
+	"1a263547d167c74cf4b8f9166cfa244de0481c514a45aa2c",
+	"2fe3f9f51f9a05dd5f7c5329127f7c917917149b4e16b0b8",
+	"333cb3ed8c417971845382ede3cf67a0a96270c05fe2f700",
+}
+
+type mockNeb struct {
+	config  *nebletpb.Config
+	genesis *corepb.Genesis
+	storage storage.Storage
+	emitter *core.EventEmitter
+	chain   *core.BlockChain
+}
+
+func newMockNeb(t *testing.T) *mockNeb {
+	storage, _ := storage.NewMemoryStorage()
+	neb := &mockNeb{
+		genesis: &corepb.Genesis{
+			Meta: &corepb.GenesisMeta{ChainId: 100},
+			Consensus: &corepb.GenesisConsensus{
+				Dpos: &corepb.GenesisConsensusDpos{Dynasty: testDynasty},
+			},
+			Params: &corepb.GenesisParams{DynastySize: core.MinDynastySize, DynastyInterval: 30},
+		},
+		config:  &nebletpb.Config{Chain: &nebletpb.ChainConfig{ChainId: 100}, Rpc: &nebletpb.RPCConfig{}},
+		storage: storage,
+		emitter: core.NewEventEmitter(1024),
+	}
+	chain, err := core.NewBlockChain(neb)
+	assert.Nil(t, err)
+	neb.chain = chain
+	return neb
+}
+
+func (n *mockNeb) Config() *nebletpb.Config {
+	return n.config
+}
+
+func (n *mockNeb) Genesis() *corepb.Genesis {
+	return n.genesis
+}
+
+func (n *mockNeb) Storage() storage.Storage {
+	return n.storage
+}
+
+func (n *mockNeb) EventEmitter() *core.EventEmitter {
+	return n.emitter
+}
+
+func (n *mockNeb) BlockChain() *core.BlockChain {
+	return n.chain
+}
+
+func (n *mockNeb) AccountManager() *account.Manager {
+	return nil
+}
+
+func (n *mockNeb) NetManager() p2p.Manager {
+	return nil
+}
+
+func (n *mockNeb) Consensus() consensus.Consensus {
+	return nil
+}
+
+func (n *mockNeb) SyncService() *nsync.Service {
+	return nil
+}
+
+func TestAdminService_GetTransactionDependency(t *testing.T) {
+	neb := newMockNeb(t)
+	admin := &AdminService{server: &Server{neblet: neb, rpcConfig: neb.config.Rpc}}
+
+	// the tail block is used if the height is not set.
+	resp, err := admin.GetTransactionDependency(context.Background(), &rpcpb.ByBlockHeightRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(resp.Dependencies))
+	_, err = admin.GetTransactionDependency(context.Background(), &rpcpb.ByBlockHeightRequest{Height: neb.chain.TailBlock().Height()})
+	assert.Nil(t, err)
+
+	_, err = admin.GetTransactionDependency(context.Background(), &rpcpb.ByBlockHeightRequest{Height: neb.chain.TailBlock().Height() + 1})
+	assert.Equal(t, ErrBlockNotFound, err)
+}
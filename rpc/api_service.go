@@ -19,10 +19,10 @@
 package rpc
 
 import (
-	"errors"
 	"fmt"
 
 	"encoding/json"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/nebulasio/go-nebulas/core"
@@ -30,25 +30,24 @@ import (
 	"github.com/nebulasio/go-nebulas/crypto/hash"
 	"github.com/nebulasio/go-nebulas/net/p2p"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/tracing"
 	"github.com/nebulasio/go-nebulas/util"
 	"github.com/nebulasio/go-nebulas/util/byteutils"
-	"github.com/nebulasio/go-nebulas/util/logging"
-	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 
 	nnet "github.com/nebulasio/go-nebulas/net"
 )
 
 // APIService implements the RPC API service interface.
 type APIService struct {
-	server GRPCServer
+	server       GRPCServer
+	eventSchemas *EventSchemaRegistry
 }
 
 // GetNebState is the RPC API handler.
 func (s *APIService) GetNebState(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GetNebStateResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/nebstate",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -68,11 +67,24 @@ func (s *APIService) GetNebState(ctx context.Context, req *rpcpb.NonParamsReques
 	return resp, nil
 }
 
+// GetSyncStatus is the RPC API handler.
+func (s *APIService) GetSyncStatus(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.SyncStatusResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	status := neb.SyncService().Status()
+	return &rpcpb.SyncStatusResponse{
+		Syncing:         status.Syncing,
+		Height:          status.CurrentHeight,
+		PeerHeight:      status.TargetHeight,
+		BlocksPerSecond: status.BlocksPerSecond,
+		Eta:             int64(status.ETA / time.Second),
+	}, nil
+}
+
 // NodeInfo is the PRC API handler
 func (s *APIService) NodeInfo(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.NodeInfoResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/nodeinfo",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -99,24 +111,56 @@ func (s *APIService) NodeInfo(ctx context.Context, req *rpcpb.NonParamsRequest)
 		resp.RouteTable = append(resp.RouteTable, routeTable)
 	}
 
+	nat := node.NATStatus()
+	resp.Nat = &rpcpb.NATStatus{
+		Enabled:         nat.Enabled,
+		Discovered:      nat.Discovered,
+		PublicAddrs:     nat.PublicAddrs(),
+		MappingLifetime: int64(nat.MappingLifetime / time.Second),
+	}
+	for _, v := range nat.Mappings {
+		resp.Nat.Mappings = append(resp.Nat.Mappings, &rpcpb.NATMapping{
+			Protocol:     v.Protocol,
+			InternalPort: uint32(v.InternalPort),
+			ExternalPort: uint32(v.ExternalPort),
+			ExternalAddr: v.ExternalAddr,
+		})
+	}
+
+	now := time.Now()
+	for _, v := range node.PeerScores() {
+		score := &rpcpb.PeerScore{
+			Id:    v.ID,
+			Score: v.Score,
+		}
+		if v.Banned(now) {
+			score.BannedUntil = v.BannedUntil.Unix()
+		}
+		resp.PeerScores = append(resp.PeerScores, score)
+	}
+
 	return resp, nil
 }
 
 // Accounts is the RPC API handler.
-func (s *APIService) Accounts(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.AccountsResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/accounts",
-	}).Info("Rpc request.")
+func (s *APIService) Accounts(ctx context.Context, req *rpcpb.AccountsRequest) (*rpcpb.AccountsResponse, error) {
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
 
-	accs := neb.AccountManager().Accounts()
+	accs, next := neb.AccountManager().AccountsInfo(req.Cursor, int(req.Limit))
+	tail := neb.BlockChain().TailBlock()
 
-	resp := new(rpcpb.AccountsResponse)
+	resp := &rpcpb.AccountsResponse{NextCursor: next}
 	addrs := make([]string, len(accs))
-	for index, addr := range accs {
-		addrs[index] = addr.String()
+	for index, acc := range accs {
+		addrs[index] = acc.Address.String()
+		resp.Accounts = append(resp.Accounts, &rpcpb.AccountInfo{
+			Address:  acc.Address.String(),
+			Unlocked: acc.Unlocked,
+			PathHash: acc.PathHash.String(),
+			Nonce:    tail.GetNonce(acc.Address.Bytes()),
+		})
 	}
 	resp.Addresses = addrs
 	return resp, nil
@@ -124,11 +168,6 @@ func (s *APIService) Accounts(ctx context.Context, req *rpcpb.NonParamsRequest)
 
 // GetAccountState is the RPC API handler.
 func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountStateRequest) (*rpcpb.GetAccountStateResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"address": req.Address,
-		"height":  req.Height,
-		"api":     "/v1/user/accountstate",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -139,13 +178,10 @@ func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountS
 		return nil, err
 	}
 
-	block := neb.BlockChain().TailBlock()
-	if req.Height > 0 {
-		block = neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
-		if block == nil {
-			metricsAccountStateFailed.Mark(1)
-			return nil, errors.New("block not found")
-		}
+	block, err := stateBlock(neb.BlockChain(), req.Height, s.finalizedOnly(req.FinalizedOnly))
+	if err != nil {
+		metricsAccountStateFailed.Mark(1)
+		return nil, err
 	}
 
 	balance := block.GetBalance(addr.Bytes())
@@ -155,21 +191,71 @@ func (s *APIService) GetAccountState(ctx context.Context, req *rpcpb.GetAccountS
 	return &rpcpb.GetAccountStateResponse{Balance: balance.String(), Nonce: fmt.Sprintf("%d", nonce)}, nil
 }
 
+// stateBlock returns the block at height on the canonical chain whose state is
+// available in the node mode, 0 means the tail block, or the latest irreversible
+// block if finalized.
+func stateBlock(bc *core.BlockChain, height uint64, finalized bool) (*core.Block, error) {
+	block := bc.TailBlock()
+	if finalized {
+		block = bc.LatestIrreversibleBlock()
+		if height > block.Height() {
+			return nil, core.ErrBlockNotIrreversible
+		}
+	}
+	if height > 0 {
+		block = bc.GetBlockOnCanonicalChainByHeight(height)
+		if block == nil {
+			return nil, ErrBlockNotFound
+		}
+	}
+	if err := bc.CheckStateAvailable(block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+// GetAccountStateProof is the RPC API handler.
+func (s *APIService) GetAccountStateProof(ctx context.Context, req *rpcpb.GetAccountStateRequest) (*rpcpb.GetAccountStateProofResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := stateBlock(neb.BlockChain(), req.Height, s.finalizedOnly(req.FinalizedOnly))
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := block.ProveAccount(addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetAccountStateProofResponse{
+		Balance:   block.GetBalance(addr.Bytes()).String(),
+		Nonce:     fmt.Sprintf("%d", block.GetNonce(addr.Bytes())),
+		BlockHash: block.Hash().String(),
+		StateRoot: block.StateRoot().String(),
+	}
+	for _, node := range proof {
+		resp.Proof = append(resp.Proof, &rpcpb.MerkleProofNode{Val: node})
+	}
+	return resp, nil
+}
+
 // SendTransaction is the RPC API handler.
 func (s *APIService) SendTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/transaction",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
-	return s.sendTransaction(req)
+	return s.sendTransaction(ctx, req)
 }
 
 // Call is the RPC API handler.
 func (s *APIService) Call(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.CallResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/call",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -177,14 +263,37 @@ func (s *APIService) Call(ctx context.Context, req *rpcpb.TransactionRequest) (*
 	if err != nil {
 		return nil, err
 	}
-	result, err := neb.BlockChain().Call(tx)
+	var block *core.Block
+	if s.finalizedOnly(req.FinalizedOnly) {
+		block, err = stateBlock(neb.BlockChain(), 0, true)
+	} else {
+		block, err = neb.BlockChain().TailSnapshot()
+	}
 	if err != nil {
 		return nil, err
 	}
-	return &rpcpb.CallResponse{Result: result}, nil
+
+	resp := new(rpcpb.CallResponse)
+	// the dry run goes first, the local execution raises the gas limit of tx.
+	if req.StateDiff {
+		diff, err := tx.DryRun(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+		resp.StateDiff = stateDiffResponse(diff)
+	}
+	if _, resp.Result, err = tx.LocalExecution(ctx, block); err != nil {
+		return nil, core.NewExecutionError(err, resp.Result)
+	}
+	return resp, nil
+}
+
+// finalizedOnly returns if the request reads the latest irreversible block instead of the tail.
+func (s *APIService) finalizedOnly(requested bool) bool {
+	return requested || s.server.Neblet().Config().Rpc.FinalizedOnly
 }
 
-func (s *APIService) sendTransaction(req *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
+func (s *APIService) sendTransaction(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.SendTransactionResponse, error) {
 	neb := s.server.Neblet()
 	tail := neb.BlockChain().TailBlock()
 	addr, err := core.AddressParse(req.From)
@@ -194,7 +303,7 @@ func (s *APIService) sendTransaction(req *rpcpb.TransactionRequest) (*rpcpb.Send
 	}
 	if req.Nonce <= tail.GetNonce(addr.Bytes()) {
 		metricsSendTxFailed.Mark(1)
-		return nil, errors.New("nonce is invalid")
+		return nil, ErrInvalidNonce
 	}
 
 	tx, err := parseTransaction(neb, req)
@@ -202,10 +311,17 @@ func (s *APIService) sendTransaction(req *rpcpb.TransactionRequest) (*rpcpb.Send
 		metricsSendTxFailed.Mark(1)
 		return nil, err
 	}
+	span, _ := tracing.StartSpan(ctx, "account.sign")
 	if err := neb.AccountManager().SignTransaction(tx.From(), tx); err != nil {
+		span.SetError(err)
+		span.End()
 		metricsSendTxFailed.Mark(1)
 		return nil, err
 	}
+	span.End()
+
+	// the pool admission, broadcast and execution of the tx join the trace of the request.
+	tracing.Bind(tx.Hash(), ctx)
 	if err := neb.BlockChain().TransactionPool().PushAndBroadcast(tx); err != nil {
 		metricsSendTxFailed.Mark(1)
 		return nil, err
@@ -238,9 +354,23 @@ func parseTransaction(neb Neblet, reqTx *rpcpb.TransactionRequest) (*core.Transa
 		payloadType string
 		payload     []byte
 	)
-	if reqTx.Contract != nil && len(reqTx.Contract.Source) > 0 {
+	if reqTx.Contract != nil {
+		if err := newRequestLimits(neb.Config().Rpc).checkContract(reqTx.Contract.Source, reqTx.Contract.Args); err != nil {
+			return nil, err
+		}
+	}
+
+	if reqTx.Contract != nil && reqTx.Contract.Upgrade {
+		payloadType = core.TxPayloadUpgradeType
+		payload, err = core.NewUpgradePayload(reqTx.Contract.Source, reqTx.Contract.SourceType).ToBytes()
+	} else if reqTx.Contract != nil && reqTx.Contract.Verify {
+		payloadType = core.TxPayloadVerifyType
+		payload, err = core.NewVerifyPayload(reqTx.Contract.Source, reqTx.Contract.SourceType, reqTx.Contract.Compiler, reqTx.Contract.CompilerVersion).ToBytes()
+	} else if reqTx.Contract != nil && len(reqTx.Contract.Source) > 0 {
 		payloadType = core.TxPayloadDeployType
-		payload, err = core.NewDeployPayload(reqTx.Contract.Source, reqTx.Contract.SourceType, reqTx.Contract.Args).ToBytes()
+		deploy := core.NewDeployPayload(reqTx.Contract.Source, reqTx.Contract.SourceType, reqTx.Contract.Args)
+		deploy.Upgradable = reqTx.Contract.Upgradable
+		payload, err = deploy.ToBytes()
 	} else if reqTx.Contract != nil && len(reqTx.Contract.Function) > 0 {
 		payloadType = core.TxPayloadCallType
 		payload, err = core.NewCallPayload(reqTx.Contract.Function, reqTx.Contract.Args).ToBytes()
@@ -250,6 +380,22 @@ func parseTransaction(neb Neblet, reqTx *rpcpb.TransactionRequest) (*core.Transa
 	} else if reqTx.Delegate != nil {
 		payloadType = core.TxPayloadDelegateType
 		payload, err = core.NewDelegatePayload(reqTx.Delegate.Action, reqTx.Delegate.Delegatee).ToBytes()
+	} else if reqTx.Multisig != nil {
+		payloadType = core.TxPayloadMultisigType
+		payload, err = toMultisigPayload(reqTx.Multisig).ToBytes()
+	} else if reqTx.Batch != nil {
+		payloadType = core.TxPayloadBatchType
+		transfers := make([]*core.BatchTransfer, len(reqTx.Batch.Transfers))
+		for i, v := range reqTx.Batch.Transfers {
+			transfers[i] = &core.BatchTransfer{To: v.To, Value: v.Value}
+		}
+		payload, err = core.NewBatchPayload(transfers).ToBytes()
+	} else if reqTx.Timelock != nil {
+		payloadType = core.TxPayloadTimelockType
+		payload, err = toTimelockPayload(reqTx.Timelock).ToBytes()
+	} else if reqTx.Pause != nil {
+		payloadType = core.TxPayloadPauseType
+		payload, err = core.NewPausePayload(reqTx.Pause.Action).ToBytes()
 	} else {
 		payloadType = core.TxPayloadBinaryType
 	}
@@ -258,14 +404,21 @@ func parseTransaction(neb Neblet, reqTx *rpcpb.TransactionRequest) (*core.Transa
 	}
 
 	tx := core.NewTransaction(neb.BlockChain().ChainID(), fromAddr, toAddr, value, reqTx.Nonce, payloadType, payload, gasPrice, gasLimit)
+	tx.SetValidUntil(reqTx.ValidUntilHeight, reqTx.ValidUntil)
 	return tx, nil
 }
 
+func toTimelockPayload(req *rpcpb.TimelockRequest) *core.TimelockPayload {
+	if req.Action == core.TimelockReleaseAction {
+		return core.NewTimelockReleasePayload(req.Lock)
+	}
+	payload := core.NewTimelockPayload(req.Value, req.UnlockHeight, req.UnlockTime)
+	payload.Action = req.Action
+	return payload
+}
+
 // SendRawTransaction submit the signed transaction raw data to txpool
 func (s *APIService) SendRawTransaction(ctx context.Context, req *rpcpb.SendRawTransactionRequest) (*rpcpb.SendTransactionResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/rawtransaction",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	// Validate and sign the tx, then submit it to the tx pool.
@@ -281,7 +434,12 @@ func (s *APIService) SendRawTransaction(ctx context.Context, req *rpcpb.SendRawT
 		metricsSendRawTxFailed.Mark(1)
 		return nil, err
 	}
+	if err := newRequestLimits(neb.Config().Rpc).checkTransaction(tx); err != nil {
+		metricsSendRawTxFailed.Mark(1)
+		return nil, err
+	}
 
+	tracing.Bind(tx.Hash(), ctx)
 	if err := neb.BlockChain().TransactionPool().PushAndBroadcast(tx); err != nil {
 		metricsSendRawTxFailed.Mark(1)
 		return nil, err
@@ -297,12 +455,80 @@ func (s *APIService) SendRawTransaction(ctx context.Context, req *rpcpb.SendRawT
 	return &rpcpb.SendTransactionResponse{Txhash: tx.Hash().String()}, nil
 }
 
+// SendRawTransactions submit the signed transactions atomically, the transactions
+// are pushed into tx pool only if all of them are valid.
+func (s *APIService) SendRawTransactions(ctx context.Context, req *rpcpb.SendRawTransactionsRequest) (*rpcpb.SendRawTransactionsResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	limits := newRequestLimits(neb.Config().Rpc)
+	if err := limits.checkBatch(len(req.Data)); err != nil {
+		return nil, err
+	}
+
+	txs := make([]*core.Transaction, len(req.Data))
+	errs := make([]error, len(req.Data))
+	valid := true
+	for i, data := range req.Data {
+		txs[i], errs[i] = parseRawTransaction(data, limits)
+		if errs[i] != nil {
+			valid = false
+		}
+	}
+
+	accepted := false
+	if valid {
+		var err error
+		if errs, err = neb.BlockChain().TransactionPool().PushBatchAndBroadcast(txs); err == nil {
+			accepted = true
+		} else if errs == nil {
+			metricsSendRawTxFailed.Mark(int64(len(txs)))
+			return nil, err
+		}
+	}
+
+	resp := &rpcpb.SendRawTransactionsResponse{Accepted: accepted}
+	for i, tx := range txs {
+		result := new(rpcpb.SendRawTransactionResult)
+		if tx != nil {
+			result.Txhash = tx.Hash().String()
+			if tx.Type() == core.TxPayloadDeployType {
+				address, _ := core.NewContractAddressFromHash(hash.Sha3256(tx.From().Bytes(), byteutils.FromUint64(tx.Nonce())))
+				result.ContractAddress = address.String()
+			}
+		}
+		if errs[i] != nil {
+			result.Error = grpc.ErrorDesc(errs[i])
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	if accepted {
+		metricsSendRawTxSuccess.Mark(int64(len(txs)))
+	} else {
+		metricsSendRawTxFailed.Mark(int64(len(txs)))
+	}
+	return resp, nil
+}
+
+func parseRawTransaction(data []byte, limits *requestLimits) (*core.Transaction, error) {
+	pbTx := new(corepb.Transaction)
+	if err := proto.Unmarshal(data, pbTx); err != nil {
+		return nil, err
+	}
+	tx := new(core.Transaction)
+	if err := tx.FromProto(pbTx); err != nil {
+		return nil, err
+	}
+	if err := limits.checkTransaction(tx); err != nil {
+		return tx, err
+	}
+	return tx, nil
+}
+
 // GetBlockByHash get block info by the block hash
 func (s *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByHashRequest) (*rpcpb.BlockResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"hash": req.Hash,
-		"api":  "/v1/user/getBlockByHash",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -314,27 +540,42 @@ func (s *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByHa
 
 	block := neb.BlockChain().GetBlock(bhash)
 
-	return s.toBlockResponse(block, req.FullTransaction)
+	return s.toBlockResponse(block, req.FullTransaction, req.FullDpos, req.HeaderOnly)
 }
 
 // GetBlockByHeight get block info by the block hash
 func (s *APIService) GetBlockByHeight(ctx context.Context, req *rpcpb.GetBlockByHeightRequest) (*rpcpb.BlockResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"height": req.Height,
-		"api":    "/v1/user/getBlockByHash",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
 
+	if s.finalizedOnly(req.FinalizedOnly) && req.Height > neb.BlockChain().LatestIrreversibleBlock().Height() {
+		return nil, core.ErrBlockNotIrreversible
+	}
 	block := neb.BlockChain().GetBlockOnCanonicalChainByHeight(req.Height)
 
-	return s.toBlockResponse(block, req.FullTransaction)
+	return s.toBlockResponse(block, req.FullTransaction, req.FullDpos, req.HeaderOnly)
+}
+
+// GetBlockByTimestamp get the block on canonical chain nearest to the timestamp
+func (s *APIService) GetBlockByTimestamp(ctx context.Context, req *rpcpb.GetBlockByTimestampRequest) (*rpcpb.BlockResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	block := neb.BlockChain().GetBlockOnCanonicalChainByTimestamp(req.Timestamp)
+
+	return s.toBlockResponse(block, req.FullTransaction, req.FullDpos, req.HeaderOnly)
 }
 
-func (s *APIService) toBlockResponse(block *core.Block, fullTransaction bool) (*rpcpb.BlockResponse, error) {
+func (s *APIService) toBlockResponse(block *core.Block, fullTransaction, fullDpos, headerOnly bool) (*rpcpb.BlockResponse, error) {
 	if block == nil {
-		return nil, errors.New("block not found")
+		return nil, ErrBlockNotFound
+	}
+
+	msg, err := block.ToProto()
+	if err != nil {
+		return nil, err
 	}
 
 	resp := &rpcpb.BlockResponse{
@@ -349,7 +590,10 @@ func (s *APIService) toBlockResponse(block *core.Block, fullTransaction bool) (*
 		StateRoot:  block.StateRoot().String(),
 		TxsRoot:    block.TxsRoot().String(),
 		EventsRoot: block.EventsRoot().String(),
+		Size:       uint64(proto.Size(msg)),
+		TxCount:    uint64(len(block.Transactions())),
 	}
+	resp.Confirmations, resp.IsIrreversible = s.server.Neblet().BlockChain().Confirmations(block.Hash(), block.Height())
 
 	// dpos context
 	dposContextResp := &rpcpb.DposContext{
@@ -360,29 +604,39 @@ func (s *APIService) toBlockResponse(block *core.Block, fullTransaction bool) (*
 		VoteRoot:        byteutils.Hex(block.DposContext().VoteRoot),
 		MintCntRoot:     byteutils.Hex(block.DposContext().MintCntRoot),
 	}
+	if fullDpos {
+		dynasty, err := block.Dynasty()
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range dynasty {
+			dposContextResp.Dynasty = append(dposContextResp.Dynasty, v.String())
+		}
+	}
 	resp.DposContext = dposContextResp
 
+	if headerOnly {
+		return resp, nil
+	}
+
 	// add block transactions
 	txs := []*rpcpb.TransactionResponse{}
 	for _, v := range block.Transactions() {
-		var tx *rpcpb.TransactionResponse
+		tx := &rpcpb.TransactionResponse{Hash: v.Hash().String()}
 		if fullTransaction {
-			tx, _ = s.toTransactionResponse(v)
-		} else {
-			tx = &rpcpb.TransactionResponse{Hash: v.Hash().String()}
+			if tx, err = s.toTransactionResponse(v); err != nil {
+				return nil, err
+			}
 		}
 		txs = append(txs, tx)
 	}
+	resp.Transactions = txs
 
 	return resp, nil
 }
 
 // BlockDump is the RPC API handler.
 func (s *APIService) BlockDump(ctx context.Context, req *rpcpb.BlockDumpRequest) (*rpcpb.BlockDumpResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"count": req.Count,
-		"api":   "/v1/user/blockdump",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -392,30 +646,23 @@ func (s *APIService) BlockDump(ctx context.Context, req *rpcpb.BlockDumpRequest)
 
 // LatestIrreversibleBlock is the RPC API handler.
 func (s *APIService) LatestIrreversibleBlock(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.BlockResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/lib",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
 	block := neb.BlockChain().LatestIrreversibleBlock()
 
-	return s.toBlockResponse(block, false)
+	return s.toBlockResponse(block, false, false, false)
 }
 
 // GetTransactionReceipt get transaction info by the transaction hash
 func (s *APIService) GetTransactionReceipt(ctx context.Context, req *rpcpb.GetTransactionByHashRequest) (*rpcpb.TransactionResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"hash": req.Hash,
-		"api":  "/v1/user/getTransactionReceipt",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
 	bhash, _ := byteutils.FromHex(req.GetHash())
 	tx := neb.BlockChain().GetTransaction(bhash)
 	if tx == nil {
-		return nil, errors.New("transaction not found")
+		return nil, ErrTransactionNotFound
 	}
 
 	return s.toTransactionResponse(tx)
@@ -454,6 +701,30 @@ func (s *APIService) toTransactionResponse(tx *core.Transaction) (*rpcpb.Transac
 		GasPrice:  tx.GasPrice().String(),
 		GasLimit:  tx.GasLimit().String(),
 		Status:    status,
+
+		ValidUntilHeight: tx.ValidUntilHeight(),
+		ValidUntil:       tx.ValidUntil(),
+	}
+
+	if receipt, err := neb.BlockChain().GetReceipt(tx.Hash()); err == nil {
+		resp.Status = receipt.Status()
+		resp.ExecuteError = receipt.Error()
+		resp.GasUsed = receipt.GasUsed().String()
+		resp.GasRefund = receipt.GasRefund().String()
+		resp.Confirmations, resp.IsIrreversible = neb.BlockChain().Confirmations(receipt.BlockHash(), receipt.Height())
+	} else if status == 0 {
+		if executeError, err := neb.BlockChain().GetExecutionError(tx.Hash()); err == nil {
+			resp.ExecuteError = executeError
+		}
+	}
+
+	// the receipt is missing or of the block on a reverted branch.
+	if resp.Confirmations == 0 && status != 2 {
+		if height, err := neb.BlockChain().GetTransactionHeight(tx.Hash()); err == nil {
+			if block := neb.BlockChain().GetBlockOnCanonicalChainByHeight(height); block != nil {
+				resp.Confirmations, resp.IsIrreversible = neb.BlockChain().Confirmations(block.Hash(), height)
+			}
+		}
 	}
 
 	if tx.Type() == core.TxPayloadDeployType {
@@ -468,14 +739,19 @@ func (s *APIService) toTransactionResponse(tx *core.Transaction) (*rpcpb.Transac
 
 // Subscribe ..
 func (s *APIService) Subscribe(req *rpcpb.SubscribeRequest, gs rpcpb.ApiService_SubscribeServer) error {
-	logging.VLog().WithFields(logrus.Fields{
-		"topic": req.Topic,
-		"api":   "/v1/user/subscribe",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
 
+	buf, err := newEventBuffer(int(neb.Config().Rpc.SubscribeBufferSize), req.OverflowPolicy)
+	if err != nil {
+		return err
+	}
+
+	// stop moving events to buffer after the channels are deregistered.
+	quitCh := make(chan struct{})
+	defer close(quitCh)
+
 	chainEventCh := make(chan *core.Event, 128)
 	emitter := neb.EventEmitter()
 	for _, v := range req.Topic {
@@ -495,54 +771,144 @@ func (s *APIService) Subscribe(req *rpcpb.SubscribeRequest, gs rpcpb.ApiService_
 	defer net.Deregister(nnet.NewSubscriber(s, netEventCh, core.MessageTypeNewBlock))
 	defer net.Deregister(nnet.NewSubscriber(s, netEventCh, core.MessageTypeNewTx))
 
-	var err error
+	// the emitter and dispatcher never block on the slow stream.
+	go func() {
+		for {
+			select {
+			case <-quitCh:
+				return
+			case event := <-chainEventCh:
+				buf.push(event)
+			case msg := <-netEventCh:
+				buf.push(msg)
+			}
+		}
+	}()
+
 	for {
 		select {
-		case event := <-chainEventCh:
-			err = gs.Send(&rpcpb.SubscribeResponse{MsgType: event.Topic, Data: event.Data})
+		case <-gs.Context().Done():
+			return gs.Context().Err()
+		case <-buf.notifyCh:
+		}
+
+		for {
+			item, dropped, overflowed := buf.pop()
+			if dropped > 0 {
+				notice := &rpcpb.SubscribeResponse{MsgType: TopicEventsDropped, Data: eventsDroppedNotice(dropped)}
+				if err := gs.Send(notice); err != nil {
+					return err
+				}
+			}
+			if overflowed {
+				metricsSubscribeDisconnected.Mark(1)
+				return grpc.Errorf(codes.ResourceExhausted, "subscriber is too slow, %d events dropped", dropped)
+			}
+			if item == nil {
+				break
+			}
+
+			resp, err := s.toSubscribeResponse(item)
 			if err != nil {
 				return err
 			}
-		case event := <-netEventCh:
-			switch event.MessageType() {
-			case core.MessageTypeNewBlock:
-				block := new(core.Block)
-				pbblock := new(corepb.Block)
-				if err := proto.Unmarshal(event.Data().([]byte), pbblock); err != nil {
-					return err
-				}
-				if err := block.FromProto(pbblock); err != nil {
-					return err
-				}
-				blockjson, err := json.Marshal(block)
-				if err != nil {
-					return err
-				}
-				err = gs.Send(&rpcpb.SubscribeResponse{MsgType: event.MessageType(), Data: string(blockjson)})
-			case core.MessageTypeNewTx:
-				tx := new(core.Transaction)
-				pbTx := new(corepb.Transaction)
-				if err := proto.Unmarshal(event.Data().([]byte), pbTx); err != nil {
-					return err
-				}
-				if err := tx.FromProto(pbTx); err != nil {
-					return err
-				}
-				txjson, err := json.Marshal(tx)
-				if err != nil {
-					return err
-				}
-				err = gs.Send(&rpcpb.SubscribeResponse{MsgType: event.MessageType(), Data: string(txjson)})
+			if err := gs.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SubscribeTransaction stream the status changes of the transaction.
+func (s *APIService) SubscribeTransaction(req *rpcpb.SubscribeTransactionRequest, gs rpcpb.ApiService_SubscribeTransactionServer) error {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	hash, err := byteutils.FromHex(req.Hash)
+	if err != nil {
+		return err
+	}
+	tracker := newTxStatusTracker(hash.String(), req.Confirmations, neb.BlockChain().Params().BlockInterval)
+
+	ticker := time.NewTicker(TxStatusPollInterval)
+	defer ticker.Stop()
+	for {
+		bc := neb.BlockChain()
+		tail := bc.TailBlock()
+
+		var blockHash string
+		height, err := bc.GetTransactionHeight(hash)
+		if err != nil {
+			height = 0
+		} else if block := bc.GetBlockOnCanonicalChainByHeight(height); block != nil {
+			blockHash = block.Hash().String()
+		}
+		pending := bc.TransactionPool().GetTransaction(hash) != nil
+
+		status, done := tracker.update(time.Now(), height, blockHash, pending, tail.Height(), bc.LatestIrreversibleBlock().Height())
+		if status != nil {
+			if err := gs.Send(status); err != nil {
+				return err
 			}
 		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-gs.Context().Done():
+			return gs.Context().Err()
+		case <-ticker.C:
+		}
 	}
 }
 
+func (s *APIService) toSubscribeResponse(item interface{}) (*rpcpb.SubscribeResponse, error) {
+	switch event := item.(type) {
+	case *core.Event:
+		resp := &rpcpb.SubscribeResponse{MsgType: event.Topic, Data: event.Data}
+		resp.Decoded, resp.SchemaError = s.eventSchemas.Decode(event.Topic, event.Data)
+		return resp, nil
+	case nnet.Message:
+		var data []byte
+		switch event.MessageType() {
+		case core.MessageTypeNewBlock:
+			block := new(core.Block)
+			pbblock := new(corepb.Block)
+			if err := proto.Unmarshal(event.Data().([]byte), pbblock); err != nil {
+				return nil, err
+			}
+			if err := block.FromProto(pbblock); err != nil {
+				return nil, err
+			}
+			blockjson, err := json.Marshal(block)
+			if err != nil {
+				return nil, err
+			}
+			data = blockjson
+		case core.MessageTypeNewTx:
+			tx := new(core.Transaction)
+			pbTx := new(corepb.Transaction)
+			if err := proto.Unmarshal(event.Data().([]byte), pbTx); err != nil {
+				return nil, err
+			}
+			if err := tx.FromProto(pbTx); err != nil {
+				return nil, err
+			}
+			txjson, err := json.Marshal(tx)
+			if err != nil {
+				return nil, err
+			}
+			data = txjson
+		}
+		return &rpcpb.SubscribeResponse{MsgType: event.MessageType(), Data: string(data)}, nil
+	}
+	return nil, fmt.Errorf("unknown subscribe event %T", item)
+}
+
 // GetGasPrice get gas price from chain.
 func (s *APIService) GetGasPrice(ctx context.Context, req *rpcpb.NonParamsRequest) (*rpcpb.GasPriceResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/getGasPrice",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -552,9 +918,6 @@ func (s *APIService) GetGasPrice(ctx context.Context, req *rpcpb.NonParamsReques
 
 // EstimateGas Compute the smart contract gas consumption.
 func (s *APIService) EstimateGas(ctx context.Context, req *rpcpb.TransactionRequest) (*rpcpb.GasResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/estimateGas",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -562,18 +925,25 @@ func (s *APIService) EstimateGas(ctx context.Context, req *rpcpb.TransactionRequ
 	if err != nil {
 		return nil, err
 	}
-	estimateGas, err := neb.BlockChain().EstimateGas(tx)
+	resp := new(rpcpb.GasResponse)
+	// the dry run goes first, the estimation raises the gas limit of tx.
+	if req.StateDiff {
+		diff, err := neb.BlockChain().DryRun(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+		resp.StateDiff = stateDiffResponse(diff)
+	}
+	estimateGas, err := neb.BlockChain().EstimateGas(ctx, tx)
 	if err != nil {
 		return nil, err
 	}
-	return &rpcpb.GasResponse{Gas: estimateGas.String()}, nil
+	resp.Gas = estimateGas.String()
+	return resp, nil
 }
 
 // GetGasUsed Compute the transaction gasused.
 func (s *APIService) GetGasUsed(ctx context.Context, req *rpcpb.HashRequest) (*rpcpb.GasResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/GetGasUsed",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -584,10 +954,15 @@ func (s *APIService) GetGasUsed(ctx context.Context, req *rpcpb.HashRequest) (*r
 
 	tx := neb.BlockChain().GetTransaction(hash)
 	if tx == nil {
-		return nil, errors.New("transaction not found")
+		return nil, ErrTransactionNotFound
+	}
+
+	if receipt, err := neb.BlockChain().GetReceipt(hash); err == nil {
+		return &rpcpb.GasResponse{Gas: receipt.GasUsed().String()}, nil
 	}
 
-	gas, err := neb.BlockChain().EstimateGas(tx)
+	// the txs on chain before the receipts are introduced have no receipt.
+	gas, err := neb.BlockChain().EstimateGas(ctx, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -595,11 +970,275 @@ func (s *APIService) GetGasUsed(ctx context.Context, req *rpcpb.HashRequest) (*r
 	return &rpcpb.GasResponse{Gas: gas.String()}, nil
 }
 
+// GetContractState is the RPC API handler.
+func (s *APIService) GetContractState(ctx context.Context, req *rpcpb.GetContractStateRequest) (*rpcpb.GetContractStateResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := stateBlock(neb.BlockChain(), req.Height, false)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := block.GetContractStorage(addr.Bytes(), req.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.GetContractStateResponse{Value: string(value)}, nil
+}
+
+// GetContractMetadata is the RPC API handler.
+func (s *APIService) GetContractMetadata(ctx context.Context, req *rpcpb.GetContractMetadataRequest) (*rpcpb.GetContractMetadataResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	tail := neb.BlockChain().TailBlock()
+	contract, err := tail.GetContract(addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	tx, payload, err := tail.ContractCode(contract)
+	if err != nil {
+		return nil, err
+	}
+	upgradeTx, err := core.ContractUpgradeTx(contract)
+	if err != nil {
+		return nil, err
+	}
+	height, err := neb.BlockChain().GetTransactionHeight(tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.GetContractMetadataResponse{
+		Address:    addr.String(),
+		Creator:    tx.From().String(),
+		DeployTx:   tx.Hash().String(),
+		Height:     height,
+		SourceType: payload.SourceType,
+		Source:     payload.Source,
+		Args:       payload.Args,
+		Upgradable: payload.Upgradable,
+		UpgradeTx:  upgradeTx.String(),
+	}
+
+	verifyTx, err := core.ContractVerifyTx(contract)
+	if err != nil {
+		return nil, err
+	}
+	if verifyTx != nil {
+		vtx, err := tail.GetTransaction(verifyTx)
+		if err != nil {
+			return nil, err
+		}
+		verify, err := core.LoadVerifyPayload(vtx.Data())
+		if err != nil {
+			return nil, err
+		}
+		resp.Verified = true
+		resp.VerifyTx = verifyTx.String()
+		resp.VerifiedSource = verify.Source
+		resp.VerifiedSourceType = verify.SourceType
+		resp.Compiler = verify.Compiler
+		resp.CompilerVersion = verify.CompilerVersion
+	}
+	return resp, nil
+}
+
+// GetTokenBalance is the RPC API handler.
+func (s *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.TokenBalanceRequest) (*rpcpb.TokenBalanceResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	contract, err := core.AddressParse(req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := callToken(ctx, neb.BlockChain(), contract, "balanceOf", addr.String())
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.TokenBalanceResponse{Balance: balance}, nil
+}
+
+// GetTokenMetadata is the RPC API handler.
+func (s *APIService) GetTokenMetadata(ctx context.Context, req *rpcpb.TokenMetadataRequest) (*rpcpb.TokenMetadataResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	contract, err := core.AddressParse(req.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(rpcpb.TokenMetadataResponse)
+	if resp.Name, err = callToken(ctx, neb.BlockChain(), contract, "name"); err != nil {
+		return nil, err
+	}
+	if resp.Symbol, err = callToken(ctx, neb.BlockChain(), contract, "symbol"); err != nil {
+		return nil, err
+	}
+	decimals, err := callToken(ctx, neb.BlockChain(), contract, "decimals")
+	if err != nil {
+		return nil, err
+	}
+	if resp.Decimals, err = parseTokenDecimals(decimals); err != nil {
+		return nil, err
+	}
+	if resp.TotalSupply, err = callToken(ctx, neb.BlockChain(), contract, "totalSupply"); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetContractAddress is the RPC API handler.
+func (s *APIService) GetContractAddress(ctx context.Context, req *rpcpb.GetContractAddressRequest) (*rpcpb.GetContractAddressResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	from, err := core.AddressParse(req.From)
+	if err != nil {
+		return nil, err
+	}
+
+	tail := neb.BlockChain().TailBlock()
+	nonce := req.Nonce
+	if nonce == 0 {
+		nonce = tail.GetNonce(from.Bytes()) + 1
+	}
+	addr, err := core.NewContractAddress(from, nonce)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tail.GetContract(addr.Bytes())
+
+	return &rpcpb.GetContractAddressResponse{
+		Address:  addr.String(),
+		Nonce:    nonce,
+		Deployed: err == nil,
+	}, nil
+}
+
+// GetAccountHistory is the RPC API handler.
+func (s *APIService) GetAccountHistory(ctx context.Context, req *rpcpb.AccountHistoryRequest) (*rpcpb.AccountHistoryResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	addr, err := core.AddressParse(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	changes, total, err := neb.BlockChain().GetAccountHistory(addr, req.Offset, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.AccountHistoryResponse{Total: total}
+	for _, v := range changes {
+		resp.Changes = append(resp.Changes, &rpcpb.BalanceChange{
+			Height: v.Height,
+			TxHash: v.TxHash.String(),
+			Reason: v.Reason,
+			Delta:  v.Delta.String(),
+		})
+	}
+	return resp, nil
+}
+
+// GetChainStats is the RPC API handler.
+func (s *APIService) GetChainStats(ctx context.Context, req *rpcpb.ChainStatsRequest) (*rpcpb.ChainStatsResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	window := req.Window
+	if window == 0 {
+		window = core.DefaultChainStatsWindow
+	}
+	stats, err := neb.BlockChain().Stats(window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcpb.ChainStatsResponse{
+		Height:               stats.Height,
+		TotalTxs:             stats.TotalTxs,
+		TotalContracts:       stats.TotalContracts,
+		Tps:                  stats.TPS,
+		AverageBlockInterval: stats.AverageBlockInterval,
+		DynastyMissRate:      stats.DynastyMissRate,
+	}, nil
+}
+
+// GetMinerStats is the RPC API handler.
+func (s *APIService) GetMinerStats(ctx context.Context, req *rpcpb.MinerStatsRequest) (*rpcpb.MinerStatsResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	stats, err := neb.BlockChain().MinerStats(req.Start, req.End)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.MinerStatsResponse{}
+	for _, v := range stats {
+		resp.Stats = append(resp.Stats, &rpcpb.MinerStats{
+			Address:  v.Address.String(),
+			Minted:   v.Minted,
+			Missed:   v.Missed,
+			Expected: v.Expected(),
+			Uptime:   v.Uptime(),
+		})
+	}
+	return resp, nil
+}
+
+// GetTotalSupply is the RPC API handler.
+func (s *APIService) GetTotalSupply(ctx context.Context, req *rpcpb.TotalSupplyRequest) (*rpcpb.TotalSupplyResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+
+	block, err := stateBlock(neb.BlockChain(), req.Height, false)
+	if err != nil {
+		return nil, err
+	}
+	supply, err := neb.BlockChain().Supply(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rpcpb.TotalSupplyResponse{
+		Height:      supply.Height,
+		Genesis:     supply.Genesis.String(),
+		Minted:      supply.Minted.String(),
+		Burned:      supply.Burned.String(),
+		Total:       supply.Total.String(),
+		Circulating: supply.Circulating.String(),
+	}, nil
+}
+
 // GetEventsByHash return events by tx hash.
 func (s *APIService) GetEventsByHash(ctx context.Context, req *rpcpb.HashRequest) (*rpcpb.EventsResponse, error) {
-	logging.VLog().WithFields(logrus.Fields{
-		"api": "/v1/user/getEventsByHash",
-	}).Info("Rpc request.")
 	metricsRPCCounter.Mark(1)
 
 	neb := s.server.Neblet()
@@ -615,7 +1254,8 @@ func (s *APIService) GetEventsByHash(ctx context.Context, req *rpcpb.HashRequest
 		}
 		events := []*rpcpb.Event{}
 		for _, v := range result {
-			event := &rpcpb.Event{Topic: v.Topic, Data: v.Data}
+			event := &rpcpb.Event{Topic: v.Topic, Data: v.Data, Indexed: v.Indexed}
+			event.Decoded, event.SchemaError = s.eventSchemas.Decode(v.Topic, v.Data)
 			events = append(events, event)
 		}
 
@@ -625,3 +1265,84 @@ func (s *APIService) GetEventsByHash(ctx context.Context, req *rpcpb.HashRequest
 	return nil, nil
 
 }
+
+// GetEventsByCursor return the events after the committed cursor of the consumer.
+func (s *APIService) GetEventsByCursor(ctx context.Context, req *rpcpb.EventCursorRequest) (*rpcpb.EventCursorResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	cursor, err := neb.BlockChain().LoadEventCursor(req.Consumer)
+	if err != nil {
+		return nil, err
+	}
+	result, next, err := neb.BlockChain().EventsAfterCursor(cursor, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.EventCursorResponse{}
+	for _, v := range result {
+		event := &rpcpb.Event{Topic: v.Topic, Data: v.Data, Indexed: v.Indexed}
+		event.Decoded, event.SchemaError = s.eventSchemas.Decode(v.Topic, v.Data)
+		resp.Events = append(resp.Events, &rpcpb.CursorEvent{
+			Height: v.Height,
+			Index:  v.Index,
+			TxHash: v.TxHash.String(),
+			Event:  event,
+		})
+	}
+	if next != nil {
+		resp.Height, resp.Index = next.Height, next.Index
+	}
+	return resp, nil
+}
+
+// FilterEvents return the events matching the topics and contracts in a range of blocks.
+func (s *APIService) FilterEvents(ctx context.Context, req *rpcpb.FilterEventsRequest) (*rpcpb.FilterEventsResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	filter := &core.EventFilter{
+		FromHeight: req.FromHeight,
+		ToHeight:   req.ToHeight,
+		Topics:     req.Topics,
+		Indexed:    req.Indexed,
+		Limit:      int(req.Limit),
+	}
+	for _, v := range req.Contracts {
+		addr, err := core.AddressParse(v)
+		if err != nil {
+			return nil, err
+		}
+		filter.Contracts = append(filter.Contracts, addr)
+	}
+	result, next, err := neb.BlockChain().FilterEvents(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &rpcpb.FilterEventsResponse{NextHeight: next}
+	for _, v := range result {
+		event := &rpcpb.Event{Topic: v.Topic, Data: v.Data, Indexed: v.Indexed}
+		event.Decoded, event.SchemaError = s.eventSchemas.Decode(v.Topic, v.Data)
+		resp.Events = append(resp.Events, &rpcpb.CursorEvent{
+			Height: v.Height,
+			Index:  v.Index,
+			TxHash: v.TxHash.String(),
+			Event:  event,
+		})
+	}
+	return resp, nil
+}
+
+// CommitEventCursor commit the position of the last acknowledged event of the consumer.
+func (s *APIService) CommitEventCursor(ctx context.Context, req *rpcpb.CommitEventCursorRequest) (*rpcpb.CommitEventCursorResponse, error) {
+	metricsRPCCounter.Mark(1)
+
+	neb := s.server.Neblet()
+	cursor := &core.EventCursor{Height: req.Height, Index: req.Index}
+	if err := neb.BlockChain().CommitEventCursor(req.Consumer, cursor); err != nil {
+		return nil, err
+	}
+	return &rpcpb.CommitEventCursorResponse{Result: true}, nil
+}
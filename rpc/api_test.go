@@ -20,8 +20,13 @@ package rpc
 
 import (
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/crypto"
+	"github.com/nebulasio/go-nebulas/crypto/keystore"
+	"github.com/nebulasio/go-nebulas/crypto/keystore/secp256k1"
 	"github.com/nebulasio/go-nebulas/rpc/mock_pb"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
 	"github.com/nebulasio/go-nebulas/util"
@@ -77,3 +82,104 @@ func TestSendTransaction(t *testing.T) {
 
 	// TODO: test with mock neblet.
 }
+
+type mockConsensus struct{}
+
+func (c mockConsensus) SuspendMining() {}
+
+func (c mockConsensus) ResumeMining() {}
+
+func (c mockConsensus) VerifyBlock(block *core.Block, parent *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+func (c mockConsensus) FastVerifyBlock(block *core.Block) error {
+	block.SetMiner(block.Coinbase())
+	return nil
+}
+
+func (c mockConsensus) ForkChoice() error {
+	return nil
+}
+
+func mintBlock(t *testing.T, bc *core.BlockChain, coinbase *core.Address, txs ...*core.Transaction) *core.Block {
+	for _, tx := range txs {
+		assert.Nil(t, bc.TransactionPool().Push(tx))
+	}
+	block, err := bc.NewBlock(coinbase)
+	assert.Nil(t, err)
+	block.SetTimestamp(bc.TailBlock().Timestamp() + core.DefaultBlockInterval)
+	if len(txs) > 0 {
+		block.CollectTransactions(time.Now().Unix() + 2)
+		assert.Equal(t, len(txs), len(block.Transactions()))
+	}
+	block.SetMiner(coinbase)
+	assert.Nil(t, block.Seal())
+
+	pb, err := block.ToProto()
+	assert.Nil(t, err)
+	received := new(core.Block)
+	assert.Nil(t, received.FromProto(pb))
+	assert.Nil(t, bc.BlockPool().Push(received))
+	assert.Nil(t, bc.SetTailBlock(block))
+	return block
+}
+
+func TestAPIService_ContractRPCs(t *testing.T) {
+	neb := newMockNeb(t)
+	neb.chain.SetConsensusHandler(mockConsensus{})
+	api := &APIService{server: &Server{neblet: neb, rpcConfig: neb.config.Rpc}}
+
+	ks := keystore.DefaultKS
+	priv := secp256k1.GeneratePrivateKey()
+	pubdata, _ := priv.PublicKey().Encoded()
+	from, _ := core.NewAddressFromPublicKey(pubdata)
+	ks.SetKey(from.String(), priv, []byte("passphrase"))
+	ks.Unlock(from.String(), []byte("passphrase"), time.Second*60*60*24*365)
+	key, _ := ks.GetUnlocked(from.String())
+	signature, _ := crypto.NewSignature(keystore.SECP256K1)
+	signature.InitSign(key.(keystore.PrivateKey))
+
+	// the coinbase reward pays the gas of the txs below.
+	mintBlock(t, neb.chain, from)
+
+	source := "var C = function() {}; C.prototype = {init: function() {LocalContractStorage.set('name', 'neb');}, fail: function() {throw new Error('fail on purpose');}}; module.exports = C;"
+	deploy, err := core.NewDeployPayload(source, "js", "").ToBytes()
+	assert.Nil(t, err)
+	deployTx := core.NewTransaction(neb.chain.ChainID(), from, from, util.NewUint128(), 1, core.TxPayloadDeployType, deploy, core.TransactionGasPrice, core.TransactionMaxGas)
+	assert.Nil(t, deployTx.Sign(signature))
+	deployed := mintBlock(t, neb.chain, from, deployTx)
+	contract, err := deployTx.GenerateContractAddress()
+	assert.Nil(t, err)
+
+	call, err := core.NewCallPayload("fail", "").ToBytes()
+	assert.Nil(t, err)
+	callTx := core.NewTransaction(neb.chain.ChainID(), from, contract, util.NewUint128(), 2, core.TxPayloadCallType, call, core.TransactionGasPrice, core.TransactionMaxGas)
+	assert.Nil(t, callTx.Sign(signature))
+	mintBlock(t, neb.chain, from, callTx)
+
+	receipt, err := api.GetTransactionReceipt(context.Background(), &rpcpb.GetTransactionByHashRequest{Hash: callTx.Hash().String()})
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), receipt.Status)
+	assert.NotEqual(t, "", receipt.ExecuteError)
+
+	state, err := api.GetContractState(context.Background(), &rpcpb.GetContractStateRequest{Address: contract.String(), Key: "name"})
+	assert.Nil(t, err)
+	assert.Equal(t, `"neb"`, state.Value)
+	// the state is read at the requested height, before the deploy it is missing.
+	_, err = api.GetContractState(context.Background(), &rpcpb.GetContractStateRequest{Address: contract.String(), Key: "name", Height: deployed.Height() - 1})
+	assert.NotNil(t, err)
+
+	metadata, err := api.GetContractMetadata(context.Background(), &rpcpb.GetContractMetadataRequest{Address: contract.String()})
+	assert.Nil(t, err)
+	assert.Equal(t, contract.String(), metadata.Address)
+	assert.Equal(t, from.String(), metadata.Creator)
+	assert.Equal(t, deployTx.Hash().String(), metadata.DeployTx)
+	assert.Equal(t, deployed.Height(), metadata.Height)
+	assert.Equal(t, "js", metadata.SourceType)
+	assert.Equal(t, source, metadata.Source)
+
+	_, err = api.GetContractMetadata(context.Background(), &rpcpb.GetContractMetadataRequest{Address: from.String()})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package client
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// AccountState is the state of an account.
+type AccountState struct {
+	Balance *big.Int
+	Nonce   uint64
+}
+
+// Receipt is the receipt of a transaction.
+type Receipt struct {
+	Hash            string
+	From            *core.Address
+	To              *core.Address
+	Value           *big.Int
+	Nonce           uint64
+	Timestamp       int64
+	Type            string
+	GasPrice        *big.Int
+	GasLimit        *big.Int
+	ContractAddress *core.Address
+
+	// Status 0 failed, 1 success, 2 pending.
+	Status       uint32
+	ExecuteError string
+}
+
+// NebState return the state of the node.
+func (c *Client) NebState(ctx context.Context) (*rpcpb.GetNebStateResponse, error) {
+	var resp *rpcpb.GetNebStateResponse
+	err := c.invoke(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = rpcpb.NewApiServiceClient(conn).GetNebState(ctx, &rpcpb.NonParamsRequest{})
+		return err
+	})
+	return resp, err
+}
+
+// AccountState return the state of the account at height, 0 means the tail block.
+func (c *Client) AccountState(ctx context.Context, addr *core.Address, height uint64) (*AccountState, error) {
+	var resp *rpcpb.GetAccountStateResponse
+	err := c.invoke(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = rpcpb.NewApiServiceClient(conn).GetAccountState(ctx, &rpcpb.GetAccountStateRequest{Address: addr.String(), Height: height})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := parseBigInt(resp.Balance)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := strconv.ParseUint(resp.Nonce, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountState{Balance: balance, Nonce: nonce}, nil
+}
+
+// GasPrice return the lowest gas price of the node.
+func (c *Client) GasPrice(ctx context.Context) (*big.Int, error) {
+	var resp *rpcpb.GasPriceResponse
+	err := c.invoke(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = rpcpb.NewApiServiceClient(conn).GetGasPrice(ctx, &rpcpb.NonParamsRequest{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseBigInt(resp.GasPrice)
+}
+
+// EstimateGas return the gas cost of the transaction.
+func (c *Client) EstimateGas(ctx context.Context, req *rpcpb.TransactionRequest) (*big.Int, error) {
+	var resp *rpcpb.GasResponse
+	err := c.invoke(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = rpcpb.NewApiServiceClient(conn).EstimateGas(ctx, req)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseBigInt(resp.Gas)
+}
+
+// Call the smart contract without submitting the transaction, return the result.
+func (c *Client) Call(ctx context.Context, req *rpcpb.TransactionRequest) (string, error) {
+	var resp *rpcpb.CallResponse
+	err := c.invoke(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = rpcpb.NewApiServiceClient(conn).Call(ctx, req)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.Result, nil
+}
+
+// SendRawTransaction submit the signed transaction in protobuf, return the hex of tx hash.
+// It's safe to retry since the duplicated transactions are rejected.
+func (c *Client) SendRawTransaction(ctx context.Context, data []byte) (string, error) {
+	var resp *rpcpb.SendTransactionResponse
+	err := c.invoke(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = rpcpb.NewApiServiceClient(conn).SendRawTransaction(ctx, &rpcpb.SendRawTransactionRequest{Data: data})
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.Txhash, nil
+}
+
+// BlockByHeight return the block on canonical chain at height.
+func (c *Client) BlockByHeight(ctx context.Context, height uint64, fullTransaction bool) (*rpcpb.BlockResponse, error) {
+	var resp *rpcpb.BlockResponse
+	err := c.invoke(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = rpcpb.NewApiServiceClient(conn).GetBlockByHeight(ctx, &rpcpb.GetBlockByHeightRequest{Height: height, FullTransaction: fullTransaction})
+		return err
+	})
+	return resp, err
+}
+
+// TransactionReceipt return the receipt of the transaction of hash.
+func (c *Client) TransactionReceipt(ctx context.Context, hash string) (*Receipt, error) {
+	var resp *rpcpb.TransactionResponse
+	err := c.invoke(ctx, func(conn *grpc.ClientConn) (err error) {
+		resp, err = rpcpb.NewApiServiceClient(conn).GetTransactionReceipt(ctx, &rpcpb.GetTransactionByHashRequest{Hash: hash})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toReceipt(resp)
+}
+
+func toReceipt(resp *rpcpb.TransactionResponse) (*Receipt, error) {
+	receipt := &Receipt{
+		Hash:         resp.Hash,
+		Nonce:        resp.Nonce,
+		Timestamp:    resp.Timestamp,
+		Type:         resp.Type,
+		Status:       resp.Status,
+		ExecuteError: resp.ExecuteError,
+	}
+
+	var err error
+	if receipt.From, err = core.AddressParse(resp.From); err != nil {
+		return nil, err
+	}
+	if receipt.To, err = core.AddressParse(resp.To); err != nil {
+		return nil, err
+	}
+	if len(resp.ContractAddress) > 0 {
+		if receipt.ContractAddress, err = core.AddressParse(resp.ContractAddress); err != nil {
+			return nil, err
+		}
+	}
+	if receipt.Value, err = parseBigInt(resp.Value); err != nil {
+		return nil, err
+	}
+	if receipt.GasPrice, err = parseBigInt(resp.GasPrice); err != nil {
+		return nil, err
+	}
+	if receipt.GasLimit, err = parseBigInt(resp.GasLimit); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func parseBigInt(s string) (*big.Int, error) {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+	return v, nil
+}
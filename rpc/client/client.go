@@ -0,0 +1,183 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package client
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Default config of Client.
+const (
+	DefaultMaxRetries    = 3
+	DefaultRetryInterval = 500 * time.Millisecond
+)
+
+// Errors
+var (
+	ErrEmptyEndpoints = errors.New("empty endpoints")
+	ErrClientClosed   = errors.New("client closed")
+)
+
+// Config is the config of Client.
+type Config struct {
+	// Endpoints are the rpc addresses of nodes, switched in order on failover.
+	Endpoints []string
+
+	// MaxRetries is the max times to retry a request on transient errors.
+	MaxRetries int
+
+	// RetryInterval is the interval before the first retry, doubled on each retry.
+	RetryInterval time.Duration
+
+	// DialOptions are appended to the default insecure dial option.
+	DialOptions []grpc.DialOption
+}
+
+// Client is the nebulas rpc client, it keeps a connection to each endpoint,
+// retries the requests on transient errors and switches to the next endpoint.
+type Client struct {
+	config *Config
+
+	mu      sync.Mutex
+	conns   map[string]*grpc.ClientConn
+	current int
+	closed  bool
+}
+
+// NewClient create a new Client.
+func NewClient(config *Config) (*Client, error) {
+	if len(config.Endpoints) == 0 {
+		return nil, ErrEmptyEndpoints
+	}
+
+	cfg := *config
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = DefaultMaxRetries
+	}
+	if cfg.RetryInterval <= 0 {
+		cfg.RetryInterval = DefaultRetryInterval
+	}
+
+	return &Client{
+		config: &cfg,
+		conns:  make(map[string]*grpc.ClientConn),
+	}, nil
+}
+
+// Close all the connections.
+func (c *Client) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.closed = true
+	for endpoint, conn := range c.conns {
+		if err := conn.Close(); err != nil {
+			logging.VLog().WithFields(logrus.Fields{
+				"endpoint": endpoint,
+				"err":      err,
+			}).Debug("Failed to close rpc connection.")
+		}
+		delete(c.conns, endpoint)
+	}
+	return nil
+}
+
+// conn return the connection to current endpoint, the connection is dialed on first use.
+func (c *Client) conn() (*grpc.ClientConn, string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.closed {
+		return nil, "", ErrClientClosed
+	}
+
+	endpoint := c.config.Endpoints[c.current]
+	if conn, ok := c.conns[endpoint]; ok {
+		return conn, endpoint, nil
+	}
+
+	opts := append([]grpc.DialOption{grpc.WithInsecure()}, c.config.DialOptions...)
+	conn, err := grpc.Dial(endpoint, opts...)
+	if err != nil {
+		return nil, endpoint, err
+	}
+	c.conns[endpoint] = conn
+	return conn, endpoint, nil
+}
+
+// failover switch to the next endpoint if the failed one is still in use.
+func (c *Client) failover(endpoint string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.config.Endpoints[c.current] != endpoint {
+		return
+	}
+	c.current = (c.current + 1) % len(c.config.Endpoints)
+
+	logging.VLog().WithFields(logrus.Fields{
+		"failed": endpoint,
+		"next":   c.config.Endpoints[c.current],
+	}).Debug("Switched rpc endpoint.")
+}
+
+// invoke the call and retry it on the next endpoint if failed with transient errors.
+func (c *Client) invoke(ctx context.Context, call func(conn *grpc.ClientConn) error) error {
+	interval := c.config.RetryInterval
+	for retries := 0; ; retries++ {
+		conn, endpoint, err := c.conn()
+		if err == ErrClientClosed {
+			return err
+		}
+		if err == nil {
+			err = call(conn)
+			if err == nil || !isTransientError(err) {
+				return err
+			}
+		}
+		if retries >= c.config.MaxRetries {
+			return err
+		}
+		c.failover(endpoint)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
+
+// isTransientError return if the request may succeed on retry.
+func isTransientError(err error) bool {
+	switch grpc.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestNewClient(t *testing.T) {
+	_, err := NewClient(&Config{})
+	assert.Equal(t, ErrEmptyEndpoints, err)
+
+	c, err := NewClient(&Config{Endpoints: []string{"127.0.0.1:8684"}})
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultMaxRetries, c.config.MaxRetries)
+	assert.Equal(t, DefaultRetryInterval, c.config.RetryInterval)
+}
+
+func TestClient_Failover(t *testing.T) {
+	endpoints := []string{"127.0.0.1:8684", "127.0.0.1:8685"}
+	c, err := NewClient(&Config{Endpoints: endpoints, MaxRetries: 2, RetryInterval: time.Millisecond})
+	assert.Nil(t, err)
+	defer c.Close()
+
+	// switch to the next endpoint on transient errors.
+	var used []*grpc.ClientConn
+	err = c.invoke(context.Background(), func(conn *grpc.ClientConn) error {
+		used = append(used, conn)
+		if len(used) == 1 {
+			return grpc.Errorf(codes.Unavailable, "unavailable")
+		}
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(used))
+	assert.Equal(t, c.conns[endpoints[0]], used[0])
+	assert.Equal(t, c.conns[endpoints[1]], used[1])
+
+	// give up after max retries.
+	calls := 0
+	err = c.invoke(context.Background(), func(conn *grpc.ClientConn) error {
+		calls++
+		return grpc.Errorf(codes.ResourceExhausted, "busy")
+	})
+	assert.Equal(t, codes.ResourceExhausted, grpc.Code(err))
+	assert.Equal(t, 3, calls)
+
+	// not retry on other errors.
+	calls = 0
+	err = c.invoke(context.Background(), func(conn *grpc.ClientConn) error {
+		calls++
+		return grpc.Errorf(codes.InvalidArgument, "invalid")
+	})
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(err))
+	assert.Equal(t, 1, calls)
+
+	c.Close()
+	err = c.invoke(context.Background(), func(conn *grpc.ClientConn) error {
+		return nil
+	})
+	assert.Equal(t, ErrClientClosed, err)
+}
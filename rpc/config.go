@@ -0,0 +1,48 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+)
+
+// RedactedValue replaces the secrets in config.
+const RedactedValue = "******"
+
+// sanitizeConfig return a copy of the config with passphrases, keys and passwords redacted.
+func sanitizeConfig(cfg *nebletpb.Config) *nebletpb.Config {
+	sanitized := proto.Clone(cfg).(*nebletpb.Config)
+	if sanitized.Network != nil {
+		redact(&sanitized.Network.PrivateKey)
+	}
+	if sanitized.Chain != nil {
+		redact(&sanitized.Chain.Passphrase)
+	}
+	if sanitized.Stats != nil && sanitized.Stats.Influxdb != nil {
+		redact(&sanitized.Stats.Influxdb.Password)
+	}
+	return sanitized
+}
+
+func redact(v *string) {
+	if len(*v) > 0 {
+		*v = RedactedValue
+	}
+}
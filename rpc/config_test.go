@@ -0,0 +1,45 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeConfig(t *testing.T) {
+	cfg := &nebletpb.Config{
+		Network: &nebletpb.NetworkConfig{PrivateKey: "conf/network/ed25519key"},
+		Chain:   &nebletpb.ChainConfig{ChainId: 100, Passphrase: "passphrase"},
+		Stats:   &nebletpb.StatsConfig{Influxdb: &nebletpb.InfluxdbConfig{Password: ""}},
+	}
+
+	sanitized := sanitizeConfig(cfg)
+	assert.Equal(t, RedactedValue, sanitized.Network.PrivateKey)
+	assert.Equal(t, RedactedValue, sanitized.Chain.Passphrase)
+	assert.Equal(t, uint32(100), sanitized.Chain.ChainId)
+	// empty secrets are kept empty.
+	assert.Equal(t, "", sanitized.Stats.Influxdb.Password)
+
+	// the loaded config is not changed.
+	assert.Equal(t, "passphrase", cfg.Chain.Passphrase)
+	assert.Nil(t, sanitizeConfig(&nebletpb.Config{}).Chain)
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+
+	"github.com/nebulasio/go-nebulas/account"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Errors
+var (
+	ErrBlockNotFound           = errors.New("block not found")
+	ErrTransactionNotFound     = errors.New("transaction not found")
+	ErrInvalidNonce            = errors.New("nonce is invalid")
+	ErrConsensusAlreadyStarted = errors.New("consensus has already been started")
+	ErrConsensusNotStarted     = errors.New("consensus not start yet")
+)
+
+// errorCodes is the catalogue of the errors returned by rpc services, the errors are
+// returned with the gRPC status codes, which are mapped to HTTP status codes by the gateway.
+var errorCodes = map[error]codes.Code{
+	// not found.
+	ErrBlockNotFound:                     codes.NotFound,
+	ErrTransactionNotFound:               codes.NotFound,
+	core.ErrContractNotFound:             codes.NotFound,
+	core.ErrNotBlockInCanonicalChain:     codes.NotFound,
+	core.ErrCannotFindBlockAtGivenHeight: codes.NotFound,
+	storage.ErrKeyNotFound:               codes.NotFound,
+	account.ErrAddrNotFind:               codes.NotFound,
+	ErrWebhookNotFound:                   codes.NotFound,
+	ErrMultisigTransactionNotFound:       codes.NotFound,
+
+	// invalid request.
+	ErrInvalidNonce:                       codes.InvalidArgument,
+	core.ErrInvalidAddress:                codes.InvalidArgument,
+	core.ErrInvalidAddressDataLength:      codes.InvalidArgument,
+	core.ErrInvalidContractAddress:        codes.InvalidArgument,
+	core.ErrInvalidEventConsumer:          codes.InvalidArgument,
+	core.ErrInvalidEventCursor:            codes.InvalidArgument,
+	core.ErrInvalidTxPayloadType:          codes.InvalidArgument,
+	core.ErrInvalidCandidatePayloadAction: codes.InvalidArgument,
+	core.ErrInvalidDelegatePayloadAction:  codes.InvalidArgument,
+	core.ErrInvalidSignature:              codes.InvalidArgument,
+	core.ErrInvalidTransactionHash:        codes.InvalidArgument,
+	core.ErrInvalidTransactionSigner:      codes.InvalidArgument,
+	core.ErrInvalidChainID:                codes.InvalidArgument,
+	core.ErrBelowGasPrice:                 codes.InvalidArgument,
+	core.ErrOutOfGasLimit:                 codes.InvalidArgument,
+	core.ErrTransactionBatchTooLarge:      codes.InvalidArgument,
+	core.ErrInvalidMinerStatsRange:        codes.InvalidArgument,
+	account.ErrTxSignFrom:                 codes.InvalidArgument,
+	ErrWebhookInvalidURL:                  codes.InvalidArgument,
+	ErrInvalidOverflowPolicy:              codes.InvalidArgument,
+	logging.ErrInvalidLogLevel:            codes.InvalidArgument,
+	ErrMultisigRequired:                   codes.InvalidArgument,
+	core.ErrInvalidMultisigThreshold:      codes.InvalidArgument,
+	core.ErrTooManyMultisigSigners:        codes.InvalidArgument,
+	core.ErrDuplicatedMultisigSigner:      codes.InvalidArgument,
+	core.ErrInvalidMultisigSigner:         codes.InvalidArgument,
+	core.ErrInvalidMultisigSignature:      codes.InvalidArgument,
+	core.ErrInvalidBatchRecipients:        codes.InvalidArgument,
+	core.ErrInvalidBatchValue:             codes.InvalidArgument,
+
+	// rejected in current state.
+	core.ErrDuplicatedTransaction:       codes.AlreadyExists,
+	core.ErrReplaceUnderpriced:          codes.FailedPrecondition,
+	core.ErrSmallTransactionNonce:       codes.FailedPrecondition,
+	core.ErrLargeTransactionNonce:       codes.FailedPrecondition,
+	core.ErrInsufficientBalance:         codes.FailedPrecondition,
+	account.ErrTxAddressLocked:          codes.FailedPrecondition,
+	ErrConsensusAlreadyStarted:          codes.FailedPrecondition,
+	ErrConsensusNotStarted:              codes.FailedPrecondition,
+	ErrPprofAlreadyStarted:              codes.FailedPrecondition,
+	ErrPprofNotStarted:                  codes.FailedPrecondition,
+	ErrTooManyWebhooks:                  codes.ResourceExhausted,
+	core.ErrFutureTransactionsFull:      codes.ResourceExhausted,
+	core.ErrAccountTransactionsFull:     codes.ResourceExhausted,
+	core.ErrMultisigSignaturesNotEnough: codes.FailedPrecondition,
+	ErrTooManyMultisigTransactions:      codes.ResourceExhausted,
+	core.ErrStateUnavailable:            codes.FailedPrecondition,
+	core.ErrBlockNotIrreversible:        codes.FailedPrecondition,
+	core.ErrTransactionExpired:          codes.FailedPrecondition,
+	core.ErrAccountHistoryDisabled:      codes.FailedPrecondition,
+	core.ErrContractPaused:              codes.FailedPrecondition,
+
+	// contract execution.
+	nvm.ErrExecutionFailed:             codes.Aborted,
+	nvm.ErrInsufficientGas:             codes.ResourceExhausted,
+	nvm.ErrExceedMemoryLimits:          codes.ResourceExhausted,
+	nvm.ErrExceedInstructionLimits:     codes.ResourceExhausted,
+	nvm.ErrDisallowCallPrivateFunction: codes.PermissionDenied,
+	nvm.ErrExecutionTimeout:            codes.DeadlineExceeded,
+	nvm.ErrExecutionCancelled:          codes.Canceled,
+
+	context.DeadlineExceeded: codes.DeadlineExceeded,
+	context.Canceled:         codes.Canceled,
+}
+
+// toStatusError return the error with the gRPC status code in catalogue,
+// the errors not in catalogue are returned as is with Unknown code.
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	cause := err
+	if e, ok := err.(*core.ExecutionError); ok {
+		cause = e.Err
+	}
+	if code, ok := errorCodes[cause]; ok {
+		return grpc.Errorf(code, "%s", err.Error())
+	}
+	return err
+}
+
+// errorsInterceptor converts the errors returned by rpc methods to status errors.
+func errorsInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, toStatusError(err)
+	}
+}
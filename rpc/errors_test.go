@@ -0,0 +1,55 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/nf/nvm"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestToStatusError(t *testing.T) {
+	assert.Nil(t, toStatusError(nil))
+
+	err := toStatusError(ErrBlockNotFound)
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
+	assert.Equal(t, ErrBlockNotFound.Error(), grpc.ErrorDesc(err))
+
+	assert.Equal(t, codes.AlreadyExists, grpc.Code(toStatusError(core.ErrDuplicatedTransaction)))
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(toStatusError(core.ErrInvalidAddress)))
+
+	// the failed executions are coded by their cause.
+	err = toStatusError(core.NewExecutionError(nvm.ErrExecutionFailed, "Error: bad args (contract.js:3)"))
+	assert.Equal(t, codes.Aborted, grpc.Code(err))
+	assert.Equal(t, "execution failed: Error: bad args (contract.js:3)", grpc.ErrorDesc(err))
+
+	// status errors are returned as is.
+	err = grpc.Errorf(codes.ResourceExhausted, "too large")
+	assert.Equal(t, err, toStatusError(err))
+
+	// unknown errors.
+	err = errors.New("unknown")
+	assert.Equal(t, err, toStatusError(err))
+	assert.Equal(t, codes.Unknown, grpc.Code(toStatusError(err)))
+}
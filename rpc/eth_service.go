@@ -0,0 +1,312 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// JSON-RPC 2.0 error codes.
+const (
+	ethErrParse          = -32700
+	ethErrInvalidRequest = -32600
+	ethErrMethodNotFound = -32601
+	ethErrInvalidParams  = -32602
+	ethErrServer         = -32000
+)
+
+// Errors
+var (
+	ErrEthInvalidParams = errors.New("invalid params")
+	ErrEthInvalidBlock  = errors.New("invalid block number")
+)
+
+type ethRequest struct {
+	JSONRPC string            `json:"jsonrpc"`
+	ID      json.RawMessage   `json:"id"`
+	Method  string            `json:"method"`
+	Params  []json.RawMessage `json:"params"`
+}
+
+type ethError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type ethResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result"`
+}
+
+type ethErrorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   *ethError       `json:"error"`
+}
+
+type ethReceipt struct {
+	TransactionHash string `json:"transactionHash"`
+	BlockNumber     string `json:"blockNumber"`
+	From            string `json:"from"`
+	To              string `json:"to"`
+	ContractAddress string `json:"contractAddress,omitempty"`
+	Status          string `json:"status"`
+}
+
+// ethMethods are the supported JSON-RPC methods.
+var ethMethods = map[string]func(s *EthService, ctx context.Context, params []json.RawMessage) (interface{}, error){
+	"eth_blockNumber": func(s *EthService, ctx context.Context, params []json.RawMessage) (interface{}, error) {
+		return s.blockNumber(ctx)
+	},
+	"eth_getBalance":            (*EthService).getBalance,
+	"eth_getTransactionReceipt": (*EthService).getTransactionReceipt,
+	"eth_sendRawTransaction":    (*EthService).sendRawTransaction,
+	"eth_chainId":               (*EthService).chainID,
+	"net_version":               (*EthService).chainID,
+}
+
+// EthService serves a small subset of the Ethereum JSON-RPC methods over the APIService, it is
+// not compatible with the Ethereum tools. Only eth_blockNumber, eth_getBalance,
+// eth_getTransactionReceipt, eth_sendRawTransaction, eth_chainId and net_version are supported,
+// there is no eth_call, eth_getTransactionCount, eth_gasPrice or eth_estimateGas.
+// The addresses and hashes are the 0x prefixed hex of the 24-byte Nebulas addresses and the
+// Nebulas hashes, and eth_sendRawTransaction accepts the Nebulas protobuf encoded transactions only.
+type EthService struct {
+	api *APIService
+
+	// maxRequestSize is the max size of the request body in bytes.
+	maxRequestSize int64
+
+	// interceptor applies the timeouts, metrics and logging of the rpc server to each call.
+	interceptor grpc.UnaryServerInterceptor
+}
+
+// NewEthService return a new EthService, the calls are wrapped by the interceptors.
+func NewEthService(api *APIService, maxRequestSize int, interceptors ...grpc.UnaryServerInterceptor) *EthService {
+	return &EthService{
+		api:            api,
+		maxRequestSize: int64(maxRequestSize),
+		interceptor:    chainUnaryInterceptors(interceptors...),
+	}
+}
+
+// ServeHTTP handle the JSON-RPC requests, batch requests are supported.
+func (s *EthService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	r.Body = http.MaxBytesReader(w, r.Body, s.maxRequestSize)
+	var raw json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+		json.NewEncoder(w).Encode(newEthErrorResponse(nil, ethErrParse, err))
+		return
+	}
+
+	if len(raw) > 0 && raw[0] == '[' {
+		var reqs []*ethRequest
+		if err := json.Unmarshal(raw, &reqs); err != nil {
+			json.NewEncoder(w).Encode(newEthErrorResponse(nil, ethErrParse, err))
+			return
+		}
+		resps := make([]interface{}, len(reqs))
+		for i, req := range reqs {
+			resps[i] = s.handle(ethContext(r), req)
+		}
+		json.NewEncoder(w).Encode(resps)
+		return
+	}
+
+	req := new(ethRequest)
+	if err := json.Unmarshal(raw, req); err != nil {
+		json.NewEncoder(w).Encode(newEthErrorResponse(nil, ethErrInvalidRequest, err))
+		return
+	}
+	json.NewEncoder(w).Encode(s.handle(ethContext(r), req))
+}
+
+// ethContext returns the context of the request with the metadata read by the request logger.
+func ethContext(r *http.Request) context.Context {
+	md := metadata.Pairs(forwardedForKey, r.RemoteAddr)
+	if id := r.Header.Get(RequestIDKey); len(id) > 0 {
+		md.Set(RequestIDKey, id)
+	}
+	return metadata.NewIncomingContext(r.Context(), md)
+}
+
+func (s *EthService) handle(ctx context.Context, req *ethRequest) interface{} {
+	method, ok := ethMethods[req.Method]
+	if !ok {
+		return newEthErrorResponse(req.ID, ethErrMethodNotFound, fmt.Errorf("method %s not found", req.Method))
+	}
+
+	info := &grpc.UnaryServerInfo{Server: s, FullMethod: "/eth/" + req.Method}
+	result, err := s.interceptor(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return method(s, ctx, req.(*ethRequest).Params)
+	})
+	if err == ErrEthInvalidParams || err == ErrEthInvalidBlock {
+		return newEthErrorResponse(req.ID, ethErrInvalidParams, err)
+	}
+	if err != nil {
+		return newEthErrorResponse(req.ID, ethErrServer, err)
+	}
+	return &ethResponse{JSONRPC: "2.0", ID: req.ID, Result: result}
+}
+
+func (s *EthService) chainID(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	return encodeEthQuantity(uint64(s.api.server.Neblet().BlockChain().ChainID())), nil
+}
+
+func (s *EthService) blockNumber(ctx context.Context) (interface{}, error) {
+	resp, err := s.api.GetNebState(ctx, &rpcpb.NonParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return encodeEthQuantity(resp.Height), nil
+}
+
+func (s *EthService) getBalance(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var address, block string
+	if err := parseEthParams(params, &address, &block); err != nil {
+		return nil, err
+	}
+	height, err := parseEthBlockNumber(block)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.api.GetAccountState(ctx, &rpcpb.GetAccountStateRequest{Address: trimHexPrefix(address), Height: height})
+	if err != nil {
+		return nil, err
+	}
+	balance, ok := new(big.Int).SetString(resp.Balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid balance %s", resp.Balance)
+	}
+	return "0x" + balance.Text(16), nil
+}
+
+func (s *EthService) getTransactionReceipt(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var hash string
+	if err := parseEthParams(params, &hash); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.api.GetTransactionReceipt(ctx, &rpcpb.GetTransactionByHashRequest{Hash: trimHexPrefix(hash)})
+	if err != nil || resp.Status == 2 {
+		// unknown or pending transactions have no receipt.
+		return nil, nil
+	}
+
+	txHash, err := byteutils.FromHex(resp.Hash)
+	if err != nil {
+		return nil, err
+	}
+	height, err := s.api.server.Neblet().BlockChain().GetTransactionHeight(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := &ethReceipt{
+		TransactionHash: "0x" + resp.Hash,
+		BlockNumber:     encodeEthQuantity(height),
+		From:            "0x" + resp.From,
+		To:              "0x" + resp.To,
+		Status:          encodeEthQuantity(uint64(resp.Status)),
+	}
+	if len(resp.ContractAddress) > 0 {
+		receipt.ContractAddress = "0x" + resp.ContractAddress
+	}
+	return receipt, nil
+}
+
+// sendRawTransaction accepts the hex of the Nebulas protobuf encoded transaction.
+func (s *EthService) sendRawTransaction(ctx context.Context, params []json.RawMessage) (interface{}, error) {
+	var data string
+	if err := parseEthParams(params, &data); err != nil {
+		return nil, err
+	}
+	bytes, err := byteutils.FromHex(trimHexPrefix(data))
+	if err != nil {
+		return nil, ErrEthInvalidParams
+	}
+
+	resp, err := s.api.SendRawTransaction(ctx, &rpcpb.SendRawTransactionRequest{Data: bytes})
+	if err != nil {
+		return nil, err
+	}
+	return "0x" + resp.Txhash, nil
+}
+
+func parseEthParams(params []json.RawMessage, values ...*string) error {
+	if len(params) < len(values) {
+		return ErrEthInvalidParams
+	}
+	for i, v := range values {
+		if err := json.Unmarshal(params[i], v); err != nil {
+			return ErrEthInvalidParams
+		}
+	}
+	return nil
+}
+
+// parseEthBlockNumber return the block height, 0 means the tail block.
+func parseEthBlockNumber(block string) (uint64, error) {
+	switch block {
+	case "latest", "pending":
+		return 0, nil
+	case "earliest":
+		return 1, nil
+	}
+	if !strings.HasPrefix(block, "0x") {
+		return 0, ErrEthInvalidBlock
+	}
+	height, err := strconv.ParseUint(block[2:], 16, 64)
+	if err != nil {
+		return 0, ErrEthInvalidBlock
+	}
+	return height, nil
+}
+
+func encodeEthQuantity(v uint64) string {
+	return "0x" + strconv.FormatUint(v, 16)
+}
+
+func trimHexPrefix(s string) string {
+	return strings.TrimPrefix(s, "0x")
+}
+
+func newEthErrorResponse(id json.RawMessage, code int, err error) *ethErrorResponse {
+	return &ethErrorResponse{
+		JSONRPC: "2.0",
+		ID:      id,
+		Error:   &ethError{Code: code, Message: err.Error()},
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestParseEthBlockNumber(t *testing.T) {
+	tests := []struct {
+		block  string
+		height uint64
+		err    error
+	}{
+		{"latest", 0, nil},
+		{"pending", 0, nil},
+		{"earliest", 1, nil},
+		{"0x1b4", 436, nil},
+		{"436", 0, ErrEthInvalidBlock},
+		{"0xzz", 0, ErrEthInvalidBlock},
+	}
+	for _, tt := range tests {
+		height, err := parseEthBlockNumber(tt.block)
+		assert.Equal(t, tt.err, err, tt.block)
+		assert.Equal(t, tt.height, height, tt.block)
+	}
+}
+
+func TestEncodeEthQuantity(t *testing.T) {
+	assert.Equal(t, "0x0", encodeEthQuantity(0))
+	assert.Equal(t, "0x1b4", encodeEthQuantity(436))
+}
+
+func TestEthService_ServeHTTP(t *testing.T) {
+	var methods []string
+	record := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		methods = append(methods, info.FullMethod)
+		return "0x64", nil
+	}
+	s := NewEthService(nil, 128, record)
+	serve := func(body string) string {
+		w := httptest.NewRecorder()
+		s.ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(body)))
+		return w.Body.String()
+	}
+
+	// the calls go through the interceptors.
+	assert.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0x64"}`+"\n", serve(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":[]}`))
+	assert.Equal(t, []string{"/eth/eth_chainId"}, methods)
+
+	// the unsupported methods are rejected before the interceptors.
+	assert.True(t, strings.Contains(serve(`{"jsonrpc":"2.0","id":2,"method":"eth_call","params":[]}`), `"code":-32601`))
+
+	// the body larger than the limit is not read.
+	assert.True(t, strings.Contains(serve(`{"jsonrpc":"2.0","id":3,"method":"eth_chainId","params":["`+strings.Repeat("0", 128)+`"]}`), `"code":-32700`))
+	assert.Equal(t, 1, len(methods))
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+)
+
+// Errors
+var (
+	ErrEventSchemaTypeMismatch = errors.New("event data type mismatch")
+	ErrEventSchemaMissingField = errors.New("event data missing required field")
+)
+
+// EventSchema is a subset of JSON schema to validate the event data,
+// supports type, properties, required and items keywords.
+type EventSchema struct {
+	Type       string                  `json:"type"`
+	Properties map[string]*EventSchema `json:"properties"`
+	Required   []string                `json:"required"`
+	Items      *EventSchema            `json:"items"`
+}
+
+// Validate check the value decoded from JSON against the schema.
+func (schema *EventSchema) Validate(value interface{}) error {
+	return schema.validate("$", value)
+}
+
+func (schema *EventSchema) validate(path string, value interface{}) error {
+	switch schema.Type {
+	case "":
+		// any type.
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: %s, expect object", ErrEventSchemaTypeMismatch, path)
+		}
+		for _, name := range schema.Required {
+			if _, ok := obj[name]; !ok {
+				return fmt.Errorf("%s: %s.%s", ErrEventSchemaMissingField, path, name)
+			}
+		}
+		for name, property := range schema.Properties {
+			if v, ok := obj[name]; ok {
+				if err := property.validate(path+"."+name, v); err != nil {
+					return err
+				}
+			}
+		}
+	case "array":
+		arr, ok := value.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: %s, expect array", ErrEventSchemaTypeMismatch, path)
+		}
+		if schema.Items != nil {
+			for i, v := range arr {
+				if err := schema.Items.validate(fmt.Sprintf("%s[%d]", path, i), v); err != nil {
+					return err
+				}
+			}
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("%s: %s, expect string", ErrEventSchemaTypeMismatch, path)
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			return fmt.Errorf("%s: %s, expect number", ErrEventSchemaTypeMismatch, path)
+		}
+	case "integer":
+		if v, ok := value.(float64); !ok || v != float64(int64(v)) {
+			return fmt.Errorf("%s: %s, expect integer", ErrEventSchemaTypeMismatch, path)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: %s, expect boolean", ErrEventSchemaTypeMismatch, path)
+		}
+	case "null":
+		if value != nil {
+			return fmt.Errorf("%s: %s, expect null", ErrEventSchemaTypeMismatch, path)
+		}
+	default:
+		return fmt.Errorf("unsupported schema type %s at %s", schema.Type, path)
+	}
+	return nil
+}
+
+// EventSchemaRegistry holds the event data schemas of topics.
+type EventSchemaRegistry struct {
+	schemas map[string]*EventSchema
+}
+
+// NewEventSchemaRegistry load the event schemas in config.
+func NewEventSchemaRegistry(conf []*nebletpb.EventSchemaConfig) (*EventSchemaRegistry, error) {
+	registry := &EventSchemaRegistry{schemas: make(map[string]*EventSchema)}
+	for _, v := range conf {
+		content, err := ioutil.ReadFile(v.Schema)
+		if err != nil {
+			return nil, err
+		}
+		if err := registry.Register(v.Topic, content); err != nil {
+			return nil, err
+		}
+	}
+	return registry, nil
+}
+
+// Register the JSON schema for the event data of topic.
+func (registry *EventSchemaRegistry) Register(topic string, content []byte) error {
+	schema := new(EventSchema)
+	if err := json.Unmarshal(content, schema); err != nil {
+		return err
+	}
+	registry.schemas[topic] = schema
+	return nil
+}
+
+// Decode the event data of topic with the registered schema, return the decoded
+// data in JSON and the validation error. Both are empty if no schema registered.
+func (registry *EventSchemaRegistry) Decode(topic, data string) (string, string) {
+	schema, ok := registry.schemas[topic]
+	if !ok {
+		return "", ""
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(data), &value); err != nil {
+		return "", err.Error()
+	}
+	if err := schema.Validate(value); err != nil {
+		return "", err.Error()
+	}
+	decoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err.Error()
+	}
+	return string(decoded), ""
+}
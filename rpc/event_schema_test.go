@@ -0,0 +1,64 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventSchemaRegistry_Decode(t *testing.T) {
+	registry, err := NewEventSchemaRegistry(nil)
+	assert.Nil(t, err)
+
+	schema := `{
+		"type": "object",
+		"required": ["from", "value"],
+		"properties": {
+			"from": {"type": "string"},
+			"value": {"type": "integer"},
+			"tags": {"type": "array", "items": {"type": "string"}}
+		}
+	}`
+	assert.Nil(t, registry.Register("chain.contract.transfer", []byte(schema)))
+	assert.NotNil(t, registry.Register("chain.contract.invalid", []byte("{")))
+
+	tests := []struct {
+		name    string
+		topic   string
+		data    string
+		decoded string
+		invalid bool
+	}{
+		{"no schema", "chain.contract.other", `{"a":1}`, "", false},
+		{"valid", "chain.contract.transfer", `{"value": 10, "from": "n1", "tags": ["a"]}`, `{"from":"n1","tags":["a"],"value":10}`, false},
+		{"invalid json", "chain.contract.transfer", `{"value"`, "", true},
+		{"missing field", "chain.contract.transfer", `{"from": "n1"}`, "", true},
+		{"type mismatch", "chain.contract.transfer", `{"from": "n1", "value": 1.5}`, "", true},
+		{"items mismatch", "chain.contract.transfer", `{"from": "n1", "value": 1, "tags": [1]}`, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, schemaErr := registry.Decode(tt.topic, tt.data)
+			assert.Equal(t, tt.decoded, decoded)
+			assert.Equal(t, tt.invalid, schemaErr != "")
+		})
+	}
+}
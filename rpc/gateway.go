@@ -3,6 +3,7 @@ package rpc
 import (
 	"flag"
 	"net/http"
+	"os"
 	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -13,30 +14,29 @@ import (
 
 // const
 const (
-	API   = "api"
-	Admin = "admin"
+	API    = "api"
+	Admin  = "admin"
+	Public = "public"
 )
 
 // Run start gateway proxy to mapping grpc to http.
-func Run(rpcListen string, gatewayListen []string, httpModule []string) error {
+// The grpc-web requests of the enabled modules are proxied to the rpc server as well.
+func Run(rpcListen string, gatewayListen []string, httpModule []string, handlers map[string]http.HandlerFunc, maxMessageSize int) error {
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
 	opts := []grpc.DialOption{grpc.WithInsecure()}
 	echoEndpoint := flag.String("rpc", rpcListen, "")
-	for _, v := range httpModule {
-		switch v {
-		case API:
-			rpcpb.RegisterApiServiceHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
-		case Admin:
-			rpcpb.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, *echoEndpoint, opts)
-		}
+	httpMux := newGatewayMux(ctx, *echoEndpoint, opts, httpModule, handlers)
+	proxy, err := newGRPCWebProxy(*echoEndpoint, opts, httpModule, maxMessageSize)
+	if err != nil {
+		return err
 	}
+	handler := allowCORS(grpcWebHandler(proxy, httpMux))
 
 	for _, v := range gatewayListen {
-		err := http.ListenAndServe(v, allowCORS(mux))
+		err := http.ListenAndServe(v, handler)
 		if err != nil {
 			return err
 		}
@@ -45,10 +45,45 @@ func Run(rpcListen string, gatewayListen []string, httpModule []string) error {
 	return nil
 }
 
+// RunUnix start gateway proxy on the unix socket to mapping grpc on the rpc unix socket to http.
+func RunUnix(rpcSocket string, gatewaySocket string, mode os.FileMode, handlers map[string]http.HandlerFunc) error {
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithDialer(dialUnix)}
+	httpMux := newGatewayMux(ctx, rpcSocket, opts, []string{API, Admin}, handlers)
+
+	listener, err := listenUnix(gatewaySocket, mode)
+	if err != nil {
+		return err
+	}
+	return http.Serve(listener, httpMux)
+}
+
+func newGatewayMux(ctx context.Context, endpoint string, opts []grpc.DialOption, httpModule []string, handlers map[string]http.HandlerFunc) *http.ServeMux {
+	mux := runtime.NewServeMux()
+	if hasService(httpModule, API) || hasService(httpModule, Public) {
+		rpcpb.RegisterApiServiceHandlerFromEndpoint(ctx, mux, endpoint, opts)
+	}
+	if hasService(httpModule, Admin) {
+		rpcpb.RegisterAdminServiceHandlerFromEndpoint(ctx, mux, endpoint, opts)
+	}
+
+	httpMux := http.NewServeMux()
+	for path, handler := range handlers {
+		httpMux.HandleFunc(path, handler)
+	}
+	httpMux.Handle("/", mux)
+	return httpMux
+}
+
 func allowCORS(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if origin := r.Header.Get("Origin"); origin != "" {
 			w.Header().Set("Access-Control-Allow-Origin", origin)
+			// grpc-web clients may read the status from the response headers.
+			w.Header().Set("Access-Control-Expose-Headers", "Grpc-Status,Grpc-Message")
 			if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" {
 				preflightHandler(w, r)
 				return
@@ -59,7 +94,7 @@ func allowCORS(h http.Handler) http.Handler {
 }
 
 func preflightHandler(w http.ResponseWriter, r *http.Request) {
-	headers := []string{"Content-Type", "Accept"}
+	headers := []string{"Content-Type", "Accept", "X-Grpc-Web", "X-User-Agent", "Grpc-Timeout"}
 	w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
 	methods := []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
 	w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
@@ -0,0 +1,257 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// grpc-web content types, the text format encodes the frames in base64.
+const (
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+)
+
+// grpc-web frame flags.
+const (
+	grpcWebDataFrame    byte = 0x00
+	grpcWebTrailerFrame byte = 0x80
+)
+
+// Errors
+var (
+	ErrGRPCWebCompressedFrame = errors.New("compressed grpc-web frame is not supported")
+	ErrGRPCWebFrameTooLarge   = errors.New("grpc-web frame exceeds the max message size")
+)
+
+// services served by the http modules.
+var grpcWebServices = map[string]string{
+	API:    "/rpcpb.ApiService/",
+	Admin:  "/rpcpb.AdminService/",
+	Public: "/rpcpb.ApiService/",
+}
+
+// rawCodec passes the encoded messages through, the proxy does not decode them.
+type rawCodec struct{}
+
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	return *(v.(*[]byte)), nil
+}
+
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	*(v.(*[]byte)) = append([]byte(nil), data...)
+	return nil
+}
+
+func (rawCodec) String() string {
+	return "raw"
+}
+
+// grpcWebProxy translates the grpc-web requests of browsers into grpc calls,
+// both unary and server streaming methods are supported.
+type grpcWebProxy struct {
+	conn           *grpc.ClientConn
+	services       []string
+	maxMessageSize int
+}
+
+func newGRPCWebProxy(endpoint string, opts []grpc.DialOption, httpModule []string, maxMessageSize int) (*grpcWebProxy, error) {
+	conn, err := grpc.Dial(endpoint, append(opts, grpc.WithCodec(rawCodec{}))...)
+	if err != nil {
+		return nil, err
+	}
+
+	proxy := &grpcWebProxy{conn: conn, maxMessageSize: maxMessageSize}
+	for _, v := range httpModule {
+		if service, ok := grpcWebServices[v]; ok {
+			proxy.services = append(proxy.services, service)
+		}
+	}
+	return proxy, nil
+}
+
+// isGRPCWebRequest return if the request is sent by a grpc-web client.
+func isGRPCWebRequest(r *http.Request) bool {
+	return r.Method == "POST" && strings.HasPrefix(r.Header.Get("Content-Type"), grpcWebContentType)
+}
+
+// grpcWebHandler serves the grpc-web requests by proxy and others by h.
+func grpcWebHandler(proxy *grpcWebProxy, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isGRPCWebRequest(r) {
+			proxy.ServeHTTP(w, r)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func (p *grpcWebProxy) allowed(method string) bool {
+	for _, service := range p.services {
+		if strings.HasPrefix(method, service) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *grpcWebProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	method := r.URL.Path
+	if !p.allowed(method) {
+		http.NotFound(w, r)
+		return
+	}
+
+	text := strings.HasPrefix(r.Header.Get("Content-Type"), grpcWebTextContentType)
+	var body io.Reader = r.Body
+	if text {
+		body = base64.NewDecoder(base64.StdEncoding, r.Body)
+	}
+	req, err := readGRPCWebFrame(body, p.maxMessageSize)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if text {
+		w.Header().Set("Content-Type", grpcWebTextContentType+"+proto")
+	} else {
+		w.Header().Set("Content-Type", grpcWebContentType+"+proto")
+	}
+	fw := &grpcWebFrameWriter{w: w, text: text}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	desc := &grpc.StreamDesc{ServerStreams: true, ClientStreams: true}
+	stream, err := grpc.NewClientStream(ctx, desc, p.conn, method)
+	if err == nil {
+		err = p.forward(stream, req, fw)
+	}
+
+	var md metadata.MD
+	if stream != nil {
+		md = stream.Trailer()
+	}
+	fw.writeTrailer(err, md)
+}
+
+// forward send the request to the grpc server and write back the responses.
+func (p *grpcWebProxy) forward(stream grpc.ClientStream, req []byte, fw *grpcWebFrameWriter) error {
+	if err := stream.SendMsg(&req); err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	for {
+		var resp []byte
+		err := stream.RecvMsg(&resp)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		fw.write(grpcWebDataFrame, resp)
+	}
+}
+
+// readGRPCWebFrame read the uncompressed message of the request.
+func readGRPCWebFrame(r io.Reader, maxSize int) ([]byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	if header[0] != grpcWebDataFrame {
+		return nil, ErrGRPCWebCompressedFrame
+	}
+	size := binary.BigEndian.Uint32(header[1:])
+	if uint64(size) > uint64(maxSize) {
+		return nil, ErrGRPCWebFrameTooLarge
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// grpcWebFrameWriter writes the frames to response, each frame is flushed
+// immediately so that the streamed messages reach the client in time.
+type grpcWebFrameWriter struct {
+	w    http.ResponseWriter
+	text bool
+}
+
+func (fw *grpcWebFrameWriter) write(flag byte, data []byte) {
+	frame := make([]byte, 5+len(data))
+	frame[0] = flag
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(data)))
+	copy(frame[5:], data)
+
+	if fw.text {
+		fw.w.Write([]byte(base64.StdEncoding.EncodeToString(frame)))
+	} else {
+		fw.w.Write(frame)
+	}
+	if flusher, ok := fw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// writeTrailer writes the status and trailer metadata as the last frame.
+func (fw *grpcWebFrameWriter) writeTrailer(err error, md metadata.MD) {
+	var trailer bytes.Buffer
+	fmt.Fprintf(&trailer, "grpc-status: %d\r\n", grpc.Code(err))
+	if err != nil {
+		fmt.Fprintf(&trailer, "grpc-message: %s\r\n", encodeGRPCMessage(grpc.ErrorDesc(err)))
+	}
+	for k, values := range md {
+		for _, v := range values {
+			fmt.Fprintf(&trailer, "%s: %s\r\n", k, v)
+		}
+	}
+	fw.write(grpcWebTrailerFrame, []byte(trailer.String()))
+}
+
+// encodeGRPCMessage percent-encodes the message as required by grpc protocol.
+func encodeGRPCMessage(msg string) string {
+	var b bytes.Buffer
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		if c >= ' ' && c <= '~' && c != '%' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestReadGRPCWebFrame(t *testing.T) {
+	data, err := readGRPCWebFrame(bytes.NewReader([]byte{0, 0, 0, 0, 3, 1, 2, 3}), 4)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, data)
+
+	_, err = readGRPCWebFrame(bytes.NewReader([]byte{1, 0, 0, 0, 1, 1}), 4)
+	assert.Equal(t, ErrGRPCWebCompressedFrame, err)
+
+	_, err = readGRPCWebFrame(bytes.NewReader([]byte{0, 0, 0, 0, 5, 1, 2, 3, 4, 5}), 4)
+	assert.Equal(t, ErrGRPCWebFrameTooLarge, err)
+}
+
+func TestGRPCWebFrameWriter(t *testing.T) {
+	w := httptest.NewRecorder()
+	fw := &grpcWebFrameWriter{w: w}
+	fw.write(grpcWebDataFrame, []byte{1, 2})
+	fw.writeTrailer(grpc.Errorf(codes.NotFound, "not found\n"), metadata.Pairs("k", "v"))
+
+	expected := []byte{0, 0, 0, 0, 2, 1, 2}
+	trailer := "grpc-status: 5\r\ngrpc-message: not found%0A\r\nk: v\r\n"
+	expected = append(expected, 0x80, 0, 0, 0, byte(len(trailer)))
+	expected = append(expected, trailer...)
+	assert.Equal(t, expected, w.Body.Bytes())
+	assert.True(t, w.Flushed)
+
+	w = httptest.NewRecorder()
+	fw = &grpcWebFrameWriter{w: w, text: true}
+	fw.write(grpcWebDataFrame, []byte{1, 2})
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte{0, 0, 0, 0, 2, 1, 2}), w.Body.String())
+}
+
+func TestGRPCWebProxyAllowed(t *testing.T) {
+	proxy := &grpcWebProxy{services: []string{grpcWebServices[API]}}
+	assert.True(t, proxy.allowed("/rpcpb.ApiService/Subscribe"))
+	assert.False(t, proxy.allowed("/rpcpb.AdminService/NewAccount"))
+
+	r := httptest.NewRequest("POST", "/rpcpb.ApiService/GetNebState", nil)
+	r.Header.Set("Content-Type", "application/grpc-web-text")
+	assert.True(t, isGRPCWebRequest(r))
+	r.Header.Set("Content-Type", "application/json")
+	assert.False(t, isGRPCWebRequest(r))
+
+	w := httptest.NewRecorder()
+	proxy.ServeHTTP(w, httptest.NewRequest("POST", "/rpcpb.AdminService/NewAccount", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
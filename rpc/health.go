@@ -0,0 +1,88 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// Health check paths on gateway.
+const (
+	HealthzPath = "/healthz"
+	ReadyzPath  = "/readyz"
+)
+
+// DefaultReadyMaxBlockLag is the default max blocks that the tail block can fall behind.
+const DefaultReadyMaxBlockLag = 10
+
+var healthCheckKey = []byte("rpc_health_check")
+
+type healthStatus struct {
+	Synced          bool   `json:"synced"`
+	StorageWritable bool   `json:"storage_writable"`
+	TxPoolAccepting bool   `json:"tx_pool_accepting"`
+	Height          uint64 `json:"height"`
+	BlockLag        int64  `json:"block_lag"`
+}
+
+func checkHealth(neb Neblet, maxBlockLag uint32) *healthStatus {
+	tail := neb.BlockChain().TailBlock()
+	status := &healthStatus{Height: tail.Height()}
+
+	// the tail block should not fall behind the current time for too many blocks.
+	status.BlockLag = (time.Now().Unix() - tail.Timestamp()) / neb.BlockChain().Params().BlockInterval
+	if status.BlockLag < 0 {
+		status.BlockLag = 0
+	}
+	status.Synced = !neb.NetManager().Node().IsSynchronizing() && status.BlockLag <= int64(maxBlockLag)
+
+	stor := neb.BlockChain().Storage()
+	status.StorageWritable = stor.Put(healthCheckKey, healthCheckKey) == nil && stor.Del(healthCheckKey) == nil
+
+	status.TxPoolAccepting = neb.BlockChain().TransactionPool().Accepting()
+	return status
+}
+
+// healthzHandler reports if the node is alive, it only fails when the storage is not writable.
+func healthzHandler(neb Neblet, maxBlockLag uint32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status := checkHealth(neb, maxBlockLag)
+		writeHealthStatus(w, status, status.StorageWritable)
+	}
+}
+
+// readyzHandler reports if the node is ready to serve requests.
+func readyzHandler(neb Neblet, maxBlockLag uint32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		status := checkHealth(neb, maxBlockLag)
+		writeHealthStatus(w, status, status.Synced && status.StorageWritable && status.TxPoolAccepting)
+	}
+}
+
+func writeHealthStatus(w http.ResponseWriter, status *healthStatus, ok bool) {
+	w.Header().Set("Content-Type", "application/json")
+	if ok {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(status)
+}
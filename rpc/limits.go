@@ -0,0 +1,158 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Default limits of rpc requests.
+const (
+	DefaultMaxMessageSize        = 4 * 1024 * 1024
+	DefaultMaxContractSourceSize = 128 * 1024
+	DefaultMaxContractArgsSize   = 16 * 1024
+	DefaultMaxBatchTransactions  = 100
+)
+
+type requestLimits struct {
+	maxMessageSize        int
+	maxContractSourceSize int
+	maxContractArgsSize   int
+	maxBatchTransactions  int
+}
+
+func newRequestLimits(cfg *nebletpb.RPCConfig) *requestLimits {
+	limits := &requestLimits{
+		maxMessageSize:        DefaultMaxMessageSize,
+		maxContractSourceSize: DefaultMaxContractSourceSize,
+		maxContractArgsSize:   DefaultMaxContractArgsSize,
+		maxBatchTransactions:  DefaultMaxBatchTransactions,
+	}
+	if cfg.MaxMessageSize > 0 {
+		limits.maxMessageSize = int(cfg.MaxMessageSize)
+	}
+	if cfg.MaxContractSourceSize > 0 {
+		limits.maxContractSourceSize = int(cfg.MaxContractSourceSize)
+	}
+	if cfg.MaxContractArgsSize > 0 {
+		limits.maxContractArgsSize = int(cfg.MaxContractArgsSize)
+	}
+	if cfg.MaxBatchTransactions > 0 {
+		limits.maxBatchTransactions = int(cfg.MaxBatchTransactions)
+	}
+	return limits
+}
+
+// checkContract returns InvalidArgument error if the contract source or args exceeds the limits.
+func (limits *requestLimits) checkContract(source, args string) error {
+	if len(source) > limits.maxContractSourceSize {
+		return grpc.Errorf(codes.InvalidArgument, "contract source size %d exceeds the limit %d", len(source), limits.maxContractSourceSize)
+	}
+	if len(args) > limits.maxContractArgsSize {
+		return grpc.Errorf(codes.InvalidArgument, "contract args size %d exceeds the limit %d", len(args), limits.maxContractArgsSize)
+	}
+	return nil
+}
+
+// checkBatch returns InvalidArgument error if the batch is empty or exceeds the max count.
+func (limits *requestLimits) checkBatch(count int) error {
+	if count == 0 {
+		return grpc.Errorf(codes.InvalidArgument, "empty transaction batch")
+	}
+	if count > limits.maxBatchTransactions {
+		return grpc.Errorf(codes.InvalidArgument, "transaction batch size %d exceeds the limit %d", count, limits.maxBatchTransactions)
+	}
+	return nil
+}
+
+// checkTransaction check the contract payload of the transaction.
+func (limits *requestLimits) checkTransaction(tx *core.Transaction) error {
+	switch tx.Type() {
+	case core.TxPayloadDeployType:
+		payload, err := core.LoadDeployPayload(tx.Data())
+		if err != nil {
+			return err
+		}
+		return limits.checkContract(payload.Source, payload.Args)
+	case core.TxPayloadCallType:
+		payload, err := core.LoadCallPayload(tx.Data())
+		if err != nil {
+			return err
+		}
+		return limits.checkContract("", payload.Args)
+	case core.TxPayloadUpgradeType:
+		payload, err := core.LoadUpgradePayload(tx.Data())
+		if err != nil {
+			return err
+		}
+		return limits.checkContract(payload.Source, "")
+	case core.TxPayloadVerifyType:
+		payload, err := core.LoadVerifyPayload(tx.Data())
+		if err != nil {
+			return err
+		}
+		return limits.checkContract(payload.Source, "")
+	}
+	return nil
+}
+
+// limitsInterceptor rejects the request messages exceeding the max message size.
+func limitsInterceptor(limits *requestLimits) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if msg, ok := req.(proto.Message); ok {
+			if size := proto.Size(msg); size > limits.maxMessageSize {
+				return nil, grpc.Errorf(codes.ResourceExhausted, "request message size %d exceeds the limit %d", size, limits.maxMessageSize)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// chainUnaryInterceptors chains the interceptors, the first one is the outermost.
+func chainUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return chained(ctx, req)
+	}
+}
+
+// chainStreamInterceptors chains the stream interceptors, the first one is the outermost.
+func chainStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		chained := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, next)
+			}
+		}
+		return chained(srv, ss)
+	}
+}
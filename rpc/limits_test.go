@@ -0,0 +1,79 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestRequestLimits(t *testing.T) {
+	limits := newRequestLimits(&nebletpb.RPCConfig{})
+	assert.Equal(t, DefaultMaxMessageSize, limits.maxMessageSize)
+	assert.Equal(t, DefaultMaxContractSourceSize, limits.maxContractSourceSize)
+	assert.Equal(t, DefaultMaxContractArgsSize, limits.maxContractArgsSize)
+
+	limits = newRequestLimits(&nebletpb.RPCConfig{MaxMessageSize: 64, MaxContractSourceSize: 8, MaxContractArgsSize: 4})
+	assert.Nil(t, limits.checkContract("source", "[1]"))
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(limits.checkContract("long source", "")))
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(limits.checkContract("", "[1,2]")))
+
+	assert.Equal(t, DefaultMaxBatchTransactions, newRequestLimits(&nebletpb.RPCConfig{}).maxBatchTransactions)
+	limits.maxBatchTransactions = 2
+	assert.Nil(t, limits.checkBatch(2))
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(limits.checkBatch(0)))
+	assert.Equal(t, codes.InvalidArgument, grpc.Code(limits.checkBatch(3)))
+
+	interceptor := limitsInterceptor(limits)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/rpcpb.ApiService/SendRawTransaction"}
+	_, err := interceptor(context.Background(), &rpcpb.SendRawTransactionRequest{Data: []byte("data")}, info, handler)
+	assert.Nil(t, err)
+	_, err = interceptor(context.Background(), &rpcpb.SendRawTransactionRequest{Data: []byte(strings.Repeat("d", 64))}, info, handler)
+	assert.Equal(t, codes.ResourceExhausted, grpc.Code(err))
+}
+
+func TestChainUnaryInterceptors(t *testing.T) {
+	var calls []string
+	newInterceptor := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			calls = append(calls, name)
+			return handler(ctx, req)
+		}
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls = append(calls, "handler")
+		return req, nil
+	}
+
+	chained := chainUnaryInterceptors(newInterceptor("first"), newInterceptor("second"))
+	resp, err := chained(context.Background(), "req", &grpc.UnaryServerInfo{}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "req", resp)
+	assert.Equal(t, []string{"first", "second", "handler"}, calls)
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// Errors
+var (
+	ErrEmptyRPCServices     = errors.New("empty services of rpc listener")
+	ErrInvalidRPCService    = errors.New("invalid rpc service, must be api, public or admin")
+	ErrAdminServiceUnixOnly = errors.New("admin service is only served on unix socket")
+	ErrNoGatewayRPCListener = errors.New("no rpc listener serves the http modules")
+)
+
+const apiServicePrefix = "/rpcpb.ApiService/"
+
+// publicMethods are the methods of the public service, the methods using the node
+// accounts or changing the node state are excluded. New methods are not public
+// unless they are added here.
+var publicMethods = map[string]bool{
+	"/rpcpb.ApiService/GetNebState":             true,
+	"/rpcpb.ApiService/SubscribeTransaction":    true,
+	"/rpcpb.ApiService/GetSyncStatus":           true,
+	"/rpcpb.ApiService/NodeInfo":                true,
+	"/rpcpb.ApiService/LatestIrreversibleBlock": true,
+	"/rpcpb.ApiService/GetAccountState":         true,
+	"/rpcpb.ApiService/GetAccountStateProof":    true,
+	"/rpcpb.ApiService/Call":                    true,
+	"/rpcpb.ApiService/SendRawTransaction":      true,
+	"/rpcpb.ApiService/SendRawTransactions":     true,
+	"/rpcpb.ApiService/GetBlockByHash":          true,
+	"/rpcpb.ApiService/GetBlockByHeight":        true,
+	"/rpcpb.ApiService/GetBlockByTimestamp":     true,
+	"/rpcpb.ApiService/GetTransactionReceipt":   true,
+	"/rpcpb.ApiService/Subscribe":               true,
+	"/rpcpb.ApiService/GetGasPrice":             true,
+	"/rpcpb.ApiService/EstimateGas":             true,
+	"/rpcpb.ApiService/GetGasUsed":              true,
+	"/rpcpb.ApiService/GetContractState":        true,
+	"/rpcpb.ApiService/GetContractMetadata":     true,
+	"/rpcpb.ApiService/GetTokenBalance":         true,
+	"/rpcpb.ApiService/GetTokenMetadata":        true,
+	"/rpcpb.ApiService/GetContractAddress":      true,
+	"/rpcpb.ApiService/GetAccountHistory":       true,
+	"/rpcpb.ApiService/GetChainStats":           true,
+	"/rpcpb.ApiService/GetMinerStats":           true,
+	"/rpcpb.ApiService/GetTotalSupply":          true,
+	"/rpcpb.ApiService/GetEventsByHash":         true,
+	"/rpcpb.ApiService/GetEventsByCursor":       true,
+	"/rpcpb.ApiService/FilterEvents":            true,
+}
+
+// rpcListener is a listener with its own grpc server serving the configured services.
+type rpcListener struct {
+	address  string
+	services []string
+	server   *grpc.Server
+}
+
+func hasService(services []string, service string) bool {
+	for _, v := range services {
+		if v == service {
+			return true
+		}
+	}
+	return false
+}
+
+// checkListenerServices validate the services of a listener.
+func checkListenerServices(services []string, adminUnixOnly bool) error {
+	if len(services) == 0 {
+		return ErrEmptyRPCServices
+	}
+	for _, v := range services {
+		switch v {
+		case API, Public:
+		case Admin:
+			if adminUnixOnly {
+				return ErrAdminServiceUnixOnly
+			}
+		default:
+			return ErrInvalidRPCService
+		}
+	}
+	return nil
+}
+
+// legacyServices return the services served on rpc_listen.
+func legacyServices(cfg *nebletpb.RPCConfig) []string {
+	if cfg.AdminUnixOnly {
+		return []string{API}
+	}
+	return []string{API, Admin}
+}
+
+// gatewayEndpoint return the address of the first listener serving the http modules,
+// the public module is proxied to a listener without the api service.
+func gatewayEndpoint(cfg *nebletpb.RPCConfig, httpModule []string) (string, error) {
+	var listeners []*nebletpb.RPCListenerConfig
+	for _, v := range cfg.RpcListen {
+		listeners = append(listeners, &nebletpb.RPCListenerConfig{Address: v, Services: legacyServices(cfg)})
+	}
+	listeners = append(listeners, cfg.Listeners...)
+
+	public := hasService(httpModule, Public) && !hasService(httpModule, API)
+	for _, l := range listeners {
+		if public && hasService(l.Services, API) {
+			continue
+		}
+		serves := true
+		for _, m := range httpModule {
+			switch m {
+			case API, Admin:
+				serves = serves && hasService(l.Services, m)
+			case Public:
+				serves = serves && (hasService(l.Services, Public) || hasService(l.Services, API))
+			}
+		}
+		if serves {
+			return l.Address, nil
+		}
+	}
+	return "", ErrNoGatewayRPCListener
+}
+
+// checkPublicMethod rejects the api methods not in the public service.
+func checkPublicMethod(method string) error {
+	if strings.HasPrefix(method, apiServicePrefix) && !publicMethods[method] {
+		return grpc.Errorf(codes.PermissionDenied, "method %s is not public", method)
+	}
+	return nil
+}
+
+// publicInterceptor rejects the unary methods not in the public service.
+func publicInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := checkPublicMethod(info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// publicStreamInterceptor rejects the streaming methods not in the public service.
+func publicStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := checkPublicMethod(info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
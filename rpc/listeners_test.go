@@ -0,0 +1,81 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCheckListenerServices(t *testing.T) {
+	assert.Nil(t, checkListenerServices([]string{Public}, true))
+	assert.Nil(t, checkListenerServices([]string{API, Admin}, false))
+	assert.Equal(t, ErrEmptyRPCServices, checkListenerServices(nil, false))
+	assert.Equal(t, ErrInvalidRPCService, checkListenerServices([]string{"debug"}, false))
+	assert.Equal(t, ErrAdminServiceUnixOnly, checkListenerServices([]string{Public, Admin}, true))
+}
+
+func TestGatewayEndpoint(t *testing.T) {
+	cfg := &nebletpb.RPCConfig{
+		RpcListen: []string{"127.0.0.1:8684"},
+		Listeners: []*nebletpb.RPCListenerConfig{
+			{Address: "0.0.0.0:8686", Services: []string{Public}},
+		},
+	}
+
+	addr, err := gatewayEndpoint(cfg, []string{API, Admin})
+	assert.Nil(t, err)
+	assert.Equal(t, "127.0.0.1:8684", addr)
+
+	// public module must not be proxied to a listener serving the full api.
+	addr, err = gatewayEndpoint(cfg, []string{Public})
+	assert.Nil(t, err)
+	assert.Equal(t, "0.0.0.0:8686", addr)
+
+	_, err = gatewayEndpoint(cfg, []string{Public, Admin})
+	assert.Equal(t, ErrNoGatewayRPCListener, err)
+
+	cfg.AdminUnixOnly = true
+	_, err = gatewayEndpoint(cfg, []string{Admin})
+	assert.Equal(t, ErrNoGatewayRPCListener, err)
+}
+
+func TestPublicInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	interceptor := publicInterceptor()
+
+	resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/rpcpb.ApiService/GetAccountState"}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "ok", resp)
+
+	for _, method := range []string{"SendTransaction", "Accounts", "BlockDump", "CommitEventCursor", "NewMethod"} {
+		_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: apiServicePrefix + method}, handler)
+		assert.Equal(t, codes.PermissionDenied, grpc.Code(err), method)
+	}
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/rpcpb.AdminService/NodeInfo"}, handler)
+	assert.Nil(t, err)
+}
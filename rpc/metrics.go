@@ -41,4 +41,7 @@ var (
 
 	metricsUnlockSuccess = metrics.GetOrRegisterMeter("neb.rpc.unlock.success", nil)
 	metricsUnlockFailed  = metrics.GetOrRegisterMeter("neb.rpc.unlock.failed", nil)
+
+	metricsSubscribeDropped      = metrics.GetOrRegisterMeter("neb.rpc.subscribe.dropped", nil)
+	metricsSubscribeDisconnected = metrics.GetOrRegisterMeter("neb.rpc.subscribe.disconnected", nil)
 )
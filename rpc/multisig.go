@@ -0,0 +1,145 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+)
+
+// MaxMultisigTransactions is the max count of multisig transactions waiting for co-signing.
+const MaxMultisigTransactions = 1024
+
+// Errors
+var (
+	ErrMultisigRequired            = errors.New("multisig request is required")
+	ErrMultisigTransactionNotFound = errors.New("multisig transaction not found")
+	ErrTooManyMultisigTransactions = errors.New("too many multisig transactions")
+)
+
+// multisigStore keeps the partially-signed multisig transactions by the hash of payload.
+type multisigStore struct {
+	mu  sync.Mutex
+	txs map[string]*rpcpb.TransactionRequest
+}
+
+func newMultisigStore() *multisigStore {
+	return &multisigStore{txs: make(map[string]*rpcpb.TransactionRequest)}
+}
+
+// add the multisig transaction, the one with the same hash is replaced.
+func (st *multisigStore) add(hash string, req *rpcpb.TransactionRequest) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if _, ok := st.txs[hash]; !ok && len(st.txs) >= MaxMultisigTransactions {
+		return ErrTooManyMultisigTransactions
+	}
+	st.txs[hash] = proto.Clone(req).(*rpcpb.TransactionRequest)
+	return nil
+}
+
+func (st *multisigStore) get(hash string) (*rpcpb.TransactionRequest, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	req, ok := st.txs[hash]
+	if !ok {
+		return nil, ErrMultisigTransactionNotFound
+	}
+	return proto.Clone(req).(*rpcpb.TransactionRequest), nil
+}
+
+// update the payload of the multisig transaction with fn atomically.
+func (st *multisigStore) update(hash string, fn func(payload *core.MultisigPayload) error) (*rpcpb.TransactionRequest, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	req, ok := st.txs[hash]
+	if !ok {
+		return nil, ErrMultisigTransactionNotFound
+	}
+	payload := toMultisigPayload(req.Multisig)
+	if err := fn(payload); err != nil {
+		return nil, err
+	}
+	req.Multisig = toMultisigRequest(payload)
+	return proto.Clone(req).(*rpcpb.TransactionRequest), nil
+}
+
+func (st *multisigStore) remove(hash string) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	delete(st.txs, hash)
+}
+
+func toMultisigPayload(req *rpcpb.MultisigRequest) *core.MultisigPayload {
+	payload := core.NewMultisigPayload(req.Threshold, req.Signers, req.Value)
+	for _, v := range req.Signatures {
+		payload.Signatures = append(payload.Signatures, &core.MultisigSignature{Signer: v.Signer, Alg: uint8(v.Alg), Sign: v.Sign})
+	}
+	return payload
+}
+
+func toMultisigRequest(payload *core.MultisigPayload) *rpcpb.MultisigRequest {
+	req := &rpcpb.MultisigRequest{
+		Threshold: payload.Threshold,
+		Signers:   payload.Signers,
+		Value:     payload.Value,
+	}
+	for _, v := range payload.Signatures {
+		req.Signatures = append(req.Signatures, &rpcpb.MultisigSignature{Signer: v.Signer, Alg: uint32(v.Alg), Sign: v.Sign})
+	}
+	return req
+}
+
+// multisigHash return the hash of the multisig payload in req to be signed by the signers.
+func multisigHash(chainID uint32, req *rpcpb.TransactionRequest) (byteutils.Hash, error) {
+	if req.Multisig == nil {
+		return nil, ErrMultisigRequired
+	}
+	from, err := core.AddressParse(req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := core.AddressParse(req.To)
+	if err != nil {
+		return nil, err
+	}
+	return toMultisigPayload(req.Multisig).Hash(chainID, from, to, req.Nonce)
+}
+
+func toMultisigTransactionResponse(hash byteutils.Hash, req *rpcpb.TransactionRequest) (*rpcpb.MultisigTransactionResponse, error) {
+	payload := toMultisigPayload(req.Multisig)
+	wallet, err := payload.Wallet()
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.MultisigTransactionResponse{
+		Hash:        hash.String(),
+		Wallet:      wallet.String(),
+		Transaction: req,
+		Ready:       payload.Verify(hash) == nil,
+	}, nil
+}
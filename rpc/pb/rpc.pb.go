@@ -8,32 +8,92 @@ It is generated from these files:
 	rpc.proto
 
 It has these top-level messages:
+	PeerAccessControlRequest
+	PeerAccessControlResponse
 	SubscribeRequest
+	SubscribeTransactionRequest
+	TransactionStatusResponse
 	ChangeNetworkIDRequest
 	ChangeNetworkIDResponse
 	SubscribeResponse
 	NonParamsRequest
 	NodeInfoResponse
+	PeerScore
+	NATStatus
+	NATMapping
 	StatisticsNodeInfoResponse
 	RouteTable
 	GetNebStateResponse
+	SyncStatusResponse
+	AccountsRequest
+	AccountInfo
 	AccountsResponse
 	GetAccountStateRequest
 	GetAccountStateResponse
+	GetContractStateRequest
+	GetContractStateResponse
+	GetContractAddressRequest
+	GetContractAddressResponse
+	AccountHistoryRequest
+	BalanceChange
+	AccountHistoryResponse
+	GetContractMetadataRequest
+	GetContractMetadataResponse
+	TokenBalanceRequest
+	TokenBalanceResponse
+	TokenMetadataRequest
+	TokenMetadataResponse
+	GetAccountStateProofResponse
+	MerkleProofNode
+	ChainStatsRequest
+	ChainStatsResponse
+	MinerStatsRequest
+	MinerStats
+	MinerStatsResponse
+	TotalSupplyRequest
+	TotalSupplyResponse
 	CallResponse
+	StateDiff
+	BalanceDelta
+	StorageWrite
 	ByBlockHeightRequest
 	GetCandidatesResponse
 	GetDynastyResponse
+	GetTransactionDependencyResponse
+	TransactionDependency
+	IterateAccountsRequest
+	AccountEntry
+	GetForksRequest
+	GetForksResponse
+	Fork
+	ContractStatsRequest
+	ContractStatsResponse
+	ContractStats
+	VoteSnapshotResponse
+	CandidateVotes
+	Delegation
+	DebugResponse
+	TraceStep
 	GetDelegateVotersRequest
 	GetDelegateVotersResponse
 	TransactionRequest
 	ContractRequest
 	CandidateRequest
 	DelegateRequest
+	MultisigRequest
+	BatchRequest
+	TimelockRequest
+	PauseRequest
+	BatchTransfer
+	MultisigSignature
 	SendRawTransactionRequest
 	SendTransactionResponse
+	SendRawTransactionsRequest
+	SendRawTransactionResult
+	SendRawTransactionsResponse
 	GetBlockByHashRequest
 	GetBlockByHeightRequest
+	GetBlockByTimestampRequest
 	GetTransactionByHashRequest
 	BlockDumpRequest
 	BlockDumpResponse
@@ -49,13 +109,32 @@ It has these top-level messages:
 	SignTransactionResponse
 	SendTransactionPassphraseRequest
 	SendTransactionPassphraseResponse
+	SignMultisigTransactionRequest
+	SendMultisigTransactionRequest
+	MultisigTransactionResponse
 	GasPriceResponse
 	HashRequest
 	GasResponse
 	EventsResponse
 	Event
+	EventCursorRequest
+	CursorEvent
+	EventCursorResponse
+	FilterEventsRequest
+	FilterEventsResponse
+	CommitEventCursorRequest
+	CommitEventCursorResponse
 	StartMiningRequest
 	MiningResponse
+	RegisterWebhookRequest
+	RegisterWebhookResponse
+	UnregisterWebhookRequest
+	UnregisterWebhookResponse
+	PprofRequest
+	PprofResponse
+	LogLevelRequest
+	LogLevelResponse
+	GetConfigResponse
 */
 package rpcpb
 
@@ -80,15 +159,61 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// Request message of reload peer access control.
+type PeerAccessControlRequest struct {
+	// Allowed peers, ip, cidr or peer id. If not empty, only these peers are accepted.
+	AllowList []string `protobuf:"bytes,1,rep,name=allow_list,json=allowList" json:"allow_list,omitempty"`
+	// Denied peers, ip, cidr or peer id.
+	DenyList []string `protobuf:"bytes,2,rep,name=deny_list,json=denyList" json:"deny_list,omitempty"`
+}
+
+func (m *PeerAccessControlRequest) Reset()                    { *m = PeerAccessControlRequest{} }
+func (m *PeerAccessControlRequest) String() string            { return proto.CompactTextString(m) }
+func (*PeerAccessControlRequest) ProtoMessage()               {}
+func (*PeerAccessControlRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{0} }
+
+func (m *PeerAccessControlRequest) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
+func (m *PeerAccessControlRequest) GetDenyList() []string {
+	if m != nil {
+		return m.DenyList
+	}
+	return nil
+}
+
+// Response message of reload peer access control.
+type PeerAccessControlResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *PeerAccessControlResponse) Reset()                    { *m = PeerAccessControlResponse{} }
+func (m *PeerAccessControlResponse) String() string            { return proto.CompactTextString(m) }
+func (*PeerAccessControlResponse) ProtoMessage()               {}
+func (*PeerAccessControlResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{1} }
+
+func (m *PeerAccessControlResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
 // Request message of Subscribe rpc
 type SubscribeRequest struct {
 	Topic []string `protobuf:"bytes,1,rep,name=topic" json:"topic,omitempty"`
+	// policy when the buffer of slow subscriber is full, "drop_oldest" (default) or "disconnect".
+	OverflowPolicy string `protobuf:"bytes,2,opt,name=overflow_policy,json=overflowPolicy,proto3" json:"overflow_policy,omitempty"`
 }
 
 func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
 func (m *SubscribeRequest) String() string            { return proto.CompactTextString(m) }
 func (*SubscribeRequest) ProtoMessage()               {}
-func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{0} }
+func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{2} }
 
 func (m *SubscribeRequest) GetTopic() []string {
 	if m != nil {
@@ -97,6 +222,92 @@ func (m *SubscribeRequest) GetTopic() []string {
 	return nil
 }
 
+func (m *SubscribeRequest) GetOverflowPolicy() string {
+	if m != nil {
+		return m.OverflowPolicy
+	}
+	return ""
+}
+
+type SubscribeTransactionRequest struct {
+	// Hex string of transaction hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// finish the stream after the confirmations, if not specified, finish when the tx is irreversible.
+	Confirmations uint64 `protobuf:"varint,2,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (m *SubscribeTransactionRequest) Reset()                    { *m = SubscribeTransactionRequest{} }
+func (m *SubscribeTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeTransactionRequest) ProtoMessage()               {}
+func (*SubscribeTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{3} }
+
+func (m *SubscribeTransactionRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SubscribeTransactionRequest) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+type TransactionStatusResponse struct {
+	// Hex string of transaction hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// "pending", "included", "irreversible" or "dropped".
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// height of the block including the tx.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the block including the tx.
+	BlockHash string `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// count of the blocks on canonical chain since the tx is included, include the block of tx.
+	Confirmations uint64 `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+}
+
+func (m *TransactionStatusResponse) Reset()                    { *m = TransactionStatusResponse{} }
+func (m *TransactionStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*TransactionStatusResponse) ProtoMessage()               {}
+func (*TransactionStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{4} }
+
+func (m *TransactionStatusResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TransactionStatusResponse) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *TransactionStatusResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TransactionStatusResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *TransactionStatusResponse) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
 // Request message of change networkID.
 type ChangeNetworkIDRequest struct {
 	NetworkId uint32 `protobuf:"varint,1,opt,name=network_id,json=networkId,proto3" json:"network_id,omitempty"`
@@ -105,7 +316,7 @@ type ChangeNetworkIDRequest struct {
 func (m *ChangeNetworkIDRequest) Reset()                    { *m = ChangeNetworkIDRequest{} }
 func (m *ChangeNetworkIDRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChangeNetworkIDRequest) ProtoMessage()               {}
-func (*ChangeNetworkIDRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{1} }
+func (*ChangeNetworkIDRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{5} }
 
 func (m *ChangeNetworkIDRequest) GetNetworkId() uint32 {
 	if m != nil {
@@ -122,7 +333,7 @@ type ChangeNetworkIDResponse struct {
 func (m *ChangeNetworkIDResponse) Reset()                    { *m = ChangeNetworkIDResponse{} }
 func (m *ChangeNetworkIDResponse) String() string            { return proto.CompactTextString(m) }
 func (*ChangeNetworkIDResponse) ProtoMessage()               {}
-func (*ChangeNetworkIDResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{2} }
+func (*ChangeNetworkIDResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{6} }
 
 func (m *ChangeNetworkIDResponse) GetResult() bool {
 	if m != nil {
@@ -135,12 +346,16 @@ func (m *ChangeNetworkIDResponse) GetResult() bool {
 type SubscribeResponse struct {
 	MsgType string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
 	Data    string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// event data in JSON validated by the schema registered for topic.
+	Decoded string `protobuf:"bytes,3,opt,name=decoded,proto3" json:"decoded,omitempty"`
+	// schema validation error of event data.
+	SchemaError string `protobuf:"bytes,4,opt,name=schema_error,json=schemaError,proto3" json:"schema_error,omitempty"`
 }
 
 func (m *SubscribeResponse) Reset()                    { *m = SubscribeResponse{} }
 func (m *SubscribeResponse) String() string            { return proto.CompactTextString(m) }
 func (*SubscribeResponse) ProtoMessage()               {}
-func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{3} }
+func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{7} }
 
 func (m *SubscribeResponse) GetMsgType() string {
 	if m != nil {
@@ -156,6 +371,20 @@ func (m *SubscribeResponse) GetData() string {
 	return ""
 }
 
+func (m *SubscribeResponse) GetDecoded() string {
+	if m != nil {
+		return m.Decoded
+	}
+	return ""
+}
+
+func (m *SubscribeResponse) GetSchemaError() string {
+	if m != nil {
+		return m.SchemaError
+	}
+	return ""
+}
+
 // Request message of non params.
 type NonParamsRequest struct {
 }
@@ -163,7 +392,7 @@ type NonParamsRequest struct {
 func (m *NonParamsRequest) Reset()                    { *m = NonParamsRequest{} }
 func (m *NonParamsRequest) String() string            { return proto.CompactTextString(m) }
 func (*NonParamsRequest) ProtoMessage()               {}
-func (*NonParamsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{4} }
+func (*NonParamsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{8} }
 
 // Response message of node info.
 type NodeInfoResponse struct {
@@ -188,12 +417,16 @@ type NodeInfoResponse struct {
 	// the network protocol version.
 	ProtocolVersion string        `protobuf:"bytes,10,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
 	RouteTable      []*RouteTable `protobuf:"bytes,11,rep,name=route_table,json=routeTable" json:"route_table,omitempty"`
+	// the port mapping status on NAT.
+	Nat *NATStatus `protobuf:"bytes,12,opt,name=nat" json:"nat,omitempty"`
+	// the reputation of the misbehaving or banned peers, the lowest score first.
+	PeerScores []*PeerScore `protobuf:"bytes,13,rep,name=peer_scores,json=peerScores" json:"peer_scores,omitempty"`
 }
 
 func (m *NodeInfoResponse) Reset()                    { *m = NodeInfoResponse{} }
 func (m *NodeInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*NodeInfoResponse) ProtoMessage()               {}
-func (*NodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{5} }
+func (*NodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{9} }
 
 func (m *NodeInfoResponse) GetId() string {
 	if m != nil {
@@ -272,6 +505,147 @@ func (m *NodeInfoResponse) GetRouteTable() []*RouteTable {
 	return nil
 }
 
+func (m *NodeInfoResponse) GetNat() *NATStatus {
+	if m != nil {
+		return m.Nat
+	}
+	return nil
+}
+
+func (m *NodeInfoResponse) GetPeerScores() []*PeerScore {
+	if m != nil {
+		return m.PeerScores
+	}
+	return nil
+}
+
+type PeerScore struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the decaying misbehavior score, the peer is banned below the threshold.
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// unix timestamp the ban expires, 0 if the peer is not banned.
+	BannedUntil int64 `protobuf:"varint,3,opt,name=banned_until,json=bannedUntil,proto3" json:"banned_until,omitempty"`
+}
+
+func (m *PeerScore) Reset()                    { *m = PeerScore{} }
+func (m *PeerScore) String() string            { return proto.CompactTextString(m) }
+func (*PeerScore) ProtoMessage()               {}
+func (*PeerScore) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{10} }
+
+func (m *PeerScore) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PeerScore) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *PeerScore) GetBannedUntil() int64 {
+	if m != nil {
+		return m.BannedUntil
+	}
+	return 0
+}
+
+type NATStatus struct {
+	// whether the port mapping is enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// whether a NAT device is found by UPnP or NAT-PMP.
+	Discovered bool `protobuf:"varint,2,opt,name=discovered,proto3" json:"discovered,omitempty"`
+	// public addresses of the node discovered by the mappings.
+	PublicAddrs []string      `protobuf:"bytes,3,rep,name=public_addrs,json=publicAddrs" json:"public_addrs,omitempty"`
+	Mappings    []*NATMapping `protobuf:"bytes,4,rep,name=mappings" json:"mappings,omitempty"`
+	// lifetime of the mappings in seconds, renewed before expiring.
+	MappingLifetime int64 `protobuf:"varint,5,opt,name=mapping_lifetime,json=mappingLifetime,proto3" json:"mapping_lifetime,omitempty"`
+}
+
+func (m *NATStatus) Reset()                    { *m = NATStatus{} }
+func (m *NATStatus) String() string            { return proto.CompactTextString(m) }
+func (*NATStatus) ProtoMessage()               {}
+func (*NATStatus) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{11} }
+
+func (m *NATStatus) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *NATStatus) GetDiscovered() bool {
+	if m != nil {
+		return m.Discovered
+	}
+	return false
+}
+
+func (m *NATStatus) GetPublicAddrs() []string {
+	if m != nil {
+		return m.PublicAddrs
+	}
+	return nil
+}
+
+func (m *NATStatus) GetMappings() []*NATMapping {
+	if m != nil {
+		return m.Mappings
+	}
+	return nil
+}
+
+func (m *NATStatus) GetMappingLifetime() int64 {
+	if m != nil {
+		return m.MappingLifetime
+	}
+	return 0
+}
+
+type NATMapping struct {
+	Protocol     string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	InternalPort uint32 `protobuf:"varint,2,opt,name=internal_port,json=internalPort,proto3" json:"internal_port,omitempty"`
+	ExternalPort uint32 `protobuf:"varint,3,opt,name=external_port,json=externalPort,proto3" json:"external_port,omitempty"`
+	// external multiaddr of the mapping.
+	ExternalAddr string `protobuf:"bytes,4,opt,name=external_addr,json=externalAddr,proto3" json:"external_addr,omitempty"`
+}
+
+func (m *NATMapping) Reset()                    { *m = NATMapping{} }
+func (m *NATMapping) String() string            { return proto.CompactTextString(m) }
+func (*NATMapping) ProtoMessage()               {}
+func (*NATMapping) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{12} }
+
+func (m *NATMapping) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *NATMapping) GetInternalPort() uint32 {
+	if m != nil {
+		return m.InternalPort
+	}
+	return 0
+}
+
+func (m *NATMapping) GetExternalPort() uint32 {
+	if m != nil {
+		return m.ExternalPort
+	}
+	return 0
+}
+
+func (m *NATMapping) GetExternalAddr() string {
+	if m != nil {
+		return m.ExternalAddr
+	}
+	return ""
+}
+
 type StatisticsNodeInfoResponse struct {
 	NodeID    string `protobuf:"bytes,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
 	Height    uint64 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
@@ -282,7 +656,7 @@ type StatisticsNodeInfoResponse struct {
 func (m *StatisticsNodeInfoResponse) Reset()                    { *m = StatisticsNodeInfoResponse{} }
 func (m *StatisticsNodeInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*StatisticsNodeInfoResponse) ProtoMessage()               {}
-func (*StatisticsNodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{6} }
+func (*StatisticsNodeInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{13} }
 
 func (m *StatisticsNodeInfoResponse) GetNodeID() string {
 	if m != nil {
@@ -320,7 +694,7 @@ type RouteTable struct {
 func (m *RouteTable) Reset()                    { *m = RouteTable{} }
 func (m *RouteTable) String() string            { return proto.CompactTextString(m) }
 func (*RouteTable) ProtoMessage()               {}
-func (*RouteTable) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{7} }
+func (*RouteTable) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{14} }
 
 func (m *RouteTable) GetId() string {
 	if m != nil {
@@ -361,7 +735,7 @@ type GetNebStateResponse struct {
 func (m *GetNebStateResponse) Reset()                    { *m = GetNebStateResponse{} }
 func (m *GetNebStateResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetNebStateResponse) ProtoMessage()               {}
-func (*GetNebStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{8} }
+func (*GetNebStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{15} }
 
 func (m *GetNebStateResponse) GetChainId() uint32 {
 	if m != nil {
@@ -426,16 +800,145 @@ func (m *GetNebStateResponse) GetVersion() string {
 	return ""
 }
 
+// Response message of GetSyncStatus rpc.
+type SyncStatusResponse struct {
+	// true if a sync task is running.
+	Syncing bool `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	// height of the tail block.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// best known height of the peers.
+	PeerHeight uint64 `protobuf:"varint,3,opt,name=peer_height,json=peerHeight,proto3" json:"peer_height,omitempty"`
+	// sync speed in the latest 30 seconds.
+	BlocksPerSecond float64 `protobuf:"fixed64,4,opt,name=blocks_per_second,json=blocksPerSecond,proto3" json:"blocks_per_second,omitempty"`
+	// estimated seconds to reach the peer height, 0 if unknown or synced.
+	Eta int64 `protobuf:"varint,5,opt,name=eta,proto3" json:"eta,omitempty"`
+}
+
+func (m *SyncStatusResponse) Reset()                    { *m = SyncStatusResponse{} }
+func (m *SyncStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*SyncStatusResponse) ProtoMessage()               {}
+func (*SyncStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{16} }
+
+func (m *SyncStatusResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *SyncStatusResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SyncStatusResponse) GetPeerHeight() uint64 {
+	if m != nil {
+		return m.PeerHeight
+	}
+	return 0
+}
+
+func (m *SyncStatusResponse) GetBlocksPerSecond() float64 {
+	if m != nil {
+		return m.BlocksPerSecond
+	}
+	return 0
+}
+
+func (m *SyncStatusResponse) GetEta() int64 {
+	if m != nil {
+		return m.Eta
+	}
+	return 0
+}
+
+// Request message of Accounts rpc.
+type AccountsRequest struct {
+	// address of the last account in previous page, accounts are sorted by address.
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// max count of accounts returned, if not specified, return all accounts.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *AccountsRequest) Reset()                    { *m = AccountsRequest{} }
+func (m *AccountsRequest) String() string            { return proto.CompactTextString(m) }
+func (*AccountsRequest) ProtoMessage()               {}
+func (*AccountsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{17} }
+
+func (m *AccountsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *AccountsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AccountInfo struct {
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// true if the account is unlocked.
+	Unlocked bool `protobuf:"varint,2,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	// Hex string of the hash of the key file path, empty if the key is not in keydir.
+	PathHash string `protobuf:"bytes,3,opt,name=path_hash,json=pathHash,proto3" json:"path_hash,omitempty"`
+	// nonce of the account on the tail block.
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *AccountInfo) Reset()                    { *m = AccountInfo{} }
+func (m *AccountInfo) String() string            { return proto.CompactTextString(m) }
+func (*AccountInfo) ProtoMessage()               {}
+func (*AccountInfo) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{18} }
+
+func (m *AccountInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountInfo) GetUnlocked() bool {
+	if m != nil {
+		return m.Unlocked
+	}
+	return false
+}
+
+func (m *AccountInfo) GetPathHash() string {
+	if m != nil {
+		return m.PathHash
+	}
+	return ""
+}
+
+func (m *AccountInfo) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 // Response message of Accounts rpc.
 type AccountsResponse struct {
 	// Account list
 	Addresses []string `protobuf:"bytes,1,rep,name=addresses" json:"addresses,omitempty"`
+	// metadata of the accounts in the same order of addresses.
+	Accounts []*AccountInfo `protobuf:"bytes,2,rep,name=accounts" json:"accounts,omitempty"`
+	// cursor of the next page, empty if no more accounts.
+	NextCursor string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
 func (m *AccountsResponse) Reset()                    { *m = AccountsResponse{} }
 func (m *AccountsResponse) String() string            { return proto.CompactTextString(m) }
 func (*AccountsResponse) ProtoMessage()               {}
-func (*AccountsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{9} }
+func (*AccountsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{19} }
 
 func (m *AccountsResponse) GetAddresses() []string {
 	if m != nil {
@@ -444,18 +947,34 @@ func (m *AccountsResponse) GetAddresses() []string {
 	return nil
 }
 
+func (m *AccountsResponse) GetAccounts() []*AccountInfo {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *AccountsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 // Request message of GetAccountState rpc.
 type GetAccountStateRequest struct {
 	// Hex string of the account addresss.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// block account state with height. If not specified, use 0 as tail height.
 	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// read the state of the latest irreversible block instead of the tail, the height must not be above it.
+	FinalizedOnly bool `protobuf:"varint,3,opt,name=finalized_only,json=finalizedOnly,proto3" json:"finalized_only,omitempty"`
 }
 
 func (m *GetAccountStateRequest) Reset()                    { *m = GetAccountStateRequest{} }
 func (m *GetAccountStateRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetAccountStateRequest) ProtoMessage()               {}
-func (*GetAccountStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{10} }
+func (*GetAccountStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{20} }
 
 func (m *GetAccountStateRequest) GetAddress() string {
 	if m != nil {
@@ -471,6 +990,13 @@ func (m *GetAccountStateRequest) GetHeight() uint64 {
 	return 0
 }
 
+func (m *GetAccountStateRequest) GetFinalizedOnly() bool {
+	if m != nil {
+		return m.FinalizedOnly
+	}
+	return false
+}
+
 // Response message of GetAccountState rpc.
 type GetAccountStateResponse struct {
 	// Current balance in unit of 1/(10^18) nas.
@@ -482,7 +1008,7 @@ type GetAccountStateResponse struct {
 func (m *GetAccountStateResponse) Reset()                    { *m = GetAccountStateResponse{} }
 func (m *GetAccountStateResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetAccountStateResponse) ProtoMessage()               {}
-func (*GetAccountStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{11} }
+func (*GetAccountStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{21} }
 
 func (m *GetAccountStateResponse) GetBalance() string {
 	if m != nil {
@@ -498,1086 +1024,3783 @@ func (m *GetAccountStateResponse) GetNonce() string {
 	return ""
 }
 
-// Response message of Call rpc.
-type CallResponse struct {
-	// result of smart contract method call.
-	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+// Request message of GetContractState rpc.
+type GetContractStateRequest struct {
+	// Hex string of the contract addresss.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// storage key of the contract, such as "totalSupply" or "@balances[addr1]".
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// block contract state with height. If not specified, use 0 as tail height.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *CallResponse) Reset()                    { *m = CallResponse{} }
-func (m *CallResponse) String() string            { return proto.CompactTextString(m) }
-func (*CallResponse) ProtoMessage()               {}
-func (*CallResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{12} }
+func (m *GetContractStateRequest) Reset()                    { *m = GetContractStateRequest{} }
+func (m *GetContractStateRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetContractStateRequest) ProtoMessage()               {}
+func (*GetContractStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{22} }
 
-func (m *CallResponse) GetResult() string {
+func (m *GetContractStateRequest) GetAddress() string {
 	if m != nil {
-		return m.Result
+		return m.Address
 	}
 	return ""
 }
 
-// ByBlockHeightRequest message
-type ByBlockHeightRequest struct {
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+func (m *GetContractStateRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *ByBlockHeightRequest) Reset()                    { *m = ByBlockHeightRequest{} }
-func (m *ByBlockHeightRequest) String() string            { return proto.CompactTextString(m) }
-func (*ByBlockHeightRequest) ProtoMessage()               {}
-func (*ByBlockHeightRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{13} }
-
-func (m *ByBlockHeightRequest) GetHeight() uint64 {
+func (m *GetContractStateRequest) GetHeight() uint64 {
 	if m != nil {
 		return m.Height
 	}
 	return 0
 }
 
-// GetCandidatesRequest message
-type GetCandidatesResponse struct {
-	Candidates []string `protobuf:"bytes,1,rep,name=candidates" json:"candidates,omitempty"`
+// Response message of GetContractState rpc.
+type GetContractStateResponse struct {
+	// raw value stored in contract storage.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *GetCandidatesResponse) Reset()                    { *m = GetCandidatesResponse{} }
-func (m *GetCandidatesResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetCandidatesResponse) ProtoMessage()               {}
-func (*GetCandidatesResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{14} }
+func (m *GetContractStateResponse) Reset()                    { *m = GetContractStateResponse{} }
+func (m *GetContractStateResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetContractStateResponse) ProtoMessage()               {}
+func (*GetContractStateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{23} }
 
-func (m *GetCandidatesResponse) GetCandidates() []string {
+func (m *GetContractStateResponse) GetValue() string {
 	if m != nil {
-		return m.Candidates
+		return m.Value
 	}
-	return nil
+	return ""
 }
 
-// Response message of GetDynastyRequest rpc
-type GetDynastyResponse struct {
-	Delegatees []string `protobuf:"bytes,1,rep,name=delegatees" json:"delegatees,omitempty"`
+// Request message of GetContractMetadata rpc.
+type GetContractAddressRequest struct {
+	// Hex string of the deployer addresss.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Nonce of the deploy transaction, default is the next nonce of the deployer on chain.
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *GetDynastyResponse) Reset()                    { *m = GetDynastyResponse{} }
-func (m *GetDynastyResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetDynastyResponse) ProtoMessage()               {}
-func (*GetDynastyResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{15} }
+func (m *GetContractAddressRequest) Reset()                    { *m = GetContractAddressRequest{} }
+func (m *GetContractAddressRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetContractAddressRequest) ProtoMessage()               {}
+func (*GetContractAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{24} }
 
-func (m *GetDynastyResponse) GetDelegatees() []string {
+func (m *GetContractAddressRequest) GetFrom() string {
 	if m != nil {
-		return m.Delegatees
+		return m.From
 	}
-	return nil
+	return ""
 }
 
-// Response message of GetDelegateVoters rpc
-type GetDelegateVotersRequest struct {
-	Delegatee string `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
-	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+func (m *GetContractAddressRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
 
-func (m *GetDelegateVotersRequest) Reset()                    { *m = GetDelegateVotersRequest{} }
-func (m *GetDelegateVotersRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetDelegateVotersRequest) ProtoMessage()               {}
-func (*GetDelegateVotersRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{16} }
+// Response message of GetContractAddress rpc.
+type GetContractAddressResponse struct {
+	// Hex string of the contract addresss.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Nonce of the deploy transaction.
+	Nonce uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Whether a contract is deployed at the address already, the deployment would fail then.
+	Deployed bool `protobuf:"varint,3,opt,name=deployed,proto3" json:"deployed,omitempty"`
+}
 
-func (m *GetDelegateVotersRequest) GetDelegatee() string {
+func (m *GetContractAddressResponse) Reset()                    { *m = GetContractAddressResponse{} }
+func (m *GetContractAddressResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetContractAddressResponse) ProtoMessage()               {}
+func (*GetContractAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{25} }
+
+func (m *GetContractAddressResponse) GetAddress() string {
 	if m != nil {
-		return m.Delegatee
+		return m.Address
 	}
 	return ""
 }
 
-func (m *GetDelegateVotersRequest) GetHeight() uint64 {
+func (m *GetContractAddressResponse) GetNonce() uint64 {
 	if m != nil {
-		return m.Height
+		return m.Nonce
 	}
 	return 0
 }
 
-// Response message of GetDelegateVoters rpc
-type GetDelegateVotersResponse struct {
-	Voters []string `protobuf:"bytes,1,rep,name=voters" json:"voters,omitempty"`
-}
-
-func (m *GetDelegateVotersResponse) Reset()                    { *m = GetDelegateVotersResponse{} }
-func (m *GetDelegateVotersResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetDelegateVotersResponse) ProtoMessage()               {}
-func (*GetDelegateVotersResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{17} }
-
-func (m *GetDelegateVotersResponse) GetVoters() []string {
+func (m *GetContractAddressResponse) GetDeployed() bool {
 	if m != nil {
-		return m.Voters
+		return m.Deployed
 	}
-	return nil
+	return false
 }
 
-// Request message of SendTransaction rpc.
-type TransactionRequest struct {
-	// Hex string of the sender account addresss.
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	// Hex string of the receiver account addresss.
-	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	// Amount of value sending with this transaction.
-	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	// Transaction nonce.
-	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// gasPrice sending with this transaction.
-	GasPrice string `protobuf:"bytes,5,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	// gasLimit sending with this transaction.
-	GasLimit string `protobuf:"bytes,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	// contract sending with this transaction
-	Contract *ContractRequest `protobuf:"bytes,7,opt,name=contract" json:"contract,omitempty"`
-	// candidate application sending with this transaction.
-	Candidate *CandidateRequest `protobuf:"bytes,8,opt,name=candidate" json:"candidate,omitempty"`
-	// delegate vote sending with this transaction.
-	Delegate *DelegateRequest `protobuf:"bytes,9,opt,name=delegate" json:"delegate,omitempty"`
+type AccountHistoryRequest struct {
+	// Hex string of the account addresss.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// count of the newest changes skipped.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// max count of changes returned, default is 100.
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *TransactionRequest) Reset()                    { *m = TransactionRequest{} }
-func (m *TransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*TransactionRequest) ProtoMessage()               {}
-func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{18} }
+func (m *AccountHistoryRequest) Reset()                    { *m = AccountHistoryRequest{} }
+func (m *AccountHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*AccountHistoryRequest) ProtoMessage()               {}
+func (*AccountHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{26} }
 
-func (m *TransactionRequest) GetFrom() string {
+func (m *AccountHistoryRequest) GetAddress() string {
 	if m != nil {
-		return m.From
+		return m.Address
 	}
 	return ""
 }
 
-func (m *TransactionRequest) GetTo() string {
+func (m *AccountHistoryRequest) GetOffset() uint64 {
 	if m != nil {
-		return m.To
+		return m.Offset
 	}
-	return ""
+	return 0
 }
 
-func (m *TransactionRequest) GetValue() string {
+func (m *AccountHistoryRequest) GetLimit() uint64 {
 	if m != nil {
-		return m.Value
+		return m.Limit
 	}
-	return ""
+	return 0
 }
 
-func (m *TransactionRequest) GetNonce() uint64 {
+type BalanceChange struct {
+	// height of the block of the change.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the tx hash, empty for the block reward.
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// reason of the change, "transfer", "coinbase" or "gas".
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// signed change of the balance.
+	Delta string `protobuf:"bytes,4,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (m *BalanceChange) Reset()                    { *m = BalanceChange{} }
+func (m *BalanceChange) String() string            { return proto.CompactTextString(m) }
+func (*BalanceChange) ProtoMessage()               {}
+func (*BalanceChange) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{27} }
+
+func (m *BalanceChange) GetHeight() uint64 {
 	if m != nil {
-		return m.Nonce
+		return m.Height
 	}
 	return 0
 }
 
-func (m *TransactionRequest) GetGasPrice() string {
+func (m *BalanceChange) GetTxHash() string {
 	if m != nil {
-		return m.GasPrice
+		return m.TxHash
 	}
 	return ""
 }
 
-func (m *TransactionRequest) GetGasLimit() string {
+func (m *BalanceChange) GetReason() string {
 	if m != nil {
-		return m.GasLimit
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *TransactionRequest) GetContract() *ContractRequest {
+func (m *BalanceChange) GetDelta() string {
 	if m != nil {
-		return m.Contract
+		return m.Delta
 	}
-	return nil
+	return ""
 }
 
-func (m *TransactionRequest) GetCandidate() *CandidateRequest {
+// Response message of GetAccountHistory rpc.
+type AccountHistoryResponse struct {
+	Changes []*BalanceChange `protobuf:"bytes,1,rep,name=changes" json:"changes,omitempty"`
+	// total count of the changes of the account.
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *AccountHistoryResponse) Reset()                    { *m = AccountHistoryResponse{} }
+func (m *AccountHistoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*AccountHistoryResponse) ProtoMessage()               {}
+func (*AccountHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{28} }
+
+func (m *AccountHistoryResponse) GetChanges() []*BalanceChange {
 	if m != nil {
-		return m.Candidate
+		return m.Changes
 	}
 	return nil
 }
 
-func (m *TransactionRequest) GetDelegate() *DelegateRequest {
+func (m *AccountHistoryResponse) GetTotal() uint64 {
 	if m != nil {
-		return m.Delegate
+		return m.Total
 	}
-	return nil
+	return 0
 }
 
-type ContractRequest struct {
-	// contract source code.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// contract source type, support javascript and typescript
-	SourceType string `protobuf:"bytes,2,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
-	// call contract function name
-	Function string `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
-	// the params of contract.
-	Args string `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+type GetContractMetadataRequest struct {
+	// Hex string of the contract addresss.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *ContractRequest) Reset()                    { *m = ContractRequest{} }
-func (m *ContractRequest) String() string            { return proto.CompactTextString(m) }
-func (*ContractRequest) ProtoMessage()               {}
-func (*ContractRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{19} }
+func (m *GetContractMetadataRequest) Reset()                    { *m = GetContractMetadataRequest{} }
+func (m *GetContractMetadataRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetContractMetadataRequest) ProtoMessage()               {}
+func (*GetContractMetadataRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{29} }
 
-func (m *ContractRequest) GetSource() string {
+func (m *GetContractMetadataRequest) GetAddress() string {
 	if m != nil {
-		return m.Source
+		return m.Address
 	}
 	return ""
 }
 
-func (m *ContractRequest) GetSourceType() string {
+// Response message of GetContractMetadata rpc.
+type GetContractMetadataResponse struct {
+	// Hex string of the contract addresss.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Hex string of the contract creator address.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Hex string of the deploy transaction hash.
+	DeployTx string `protobuf:"bytes,3,opt,name=deploy_tx,json=deployTx,proto3" json:"deploy_tx,omitempty"`
+	// height of the block which the contract deployed in.
+	Height uint64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// contract source type, js or ts.
+	SourceType string `protobuf:"bytes,5,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	// contract source code.
+	Source string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	// the params of contract init function.
+	Args string `protobuf:"bytes,7,opt,name=args,proto3" json:"args,omitempty"`
+	// whether the contract can be upgraded by its creator.
+	Upgradable bool `protobuf:"varint,8,opt,name=upgradable,proto3" json:"upgradable,omitempty"`
+	// Hex string of the latest upgrade transaction hash, empty if never upgraded.
+	UpgradeTx string `protobuf:"bytes,9,opt,name=upgrade_tx,json=upgradeTx,proto3" json:"upgrade_tx,omitempty"`
+	// whether the current code of the contract is verified with its original source.
+	Verified bool `protobuf:"varint,10,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Hex string of the verify transaction hash, empty if not verified.
+	VerifyTx string `protobuf:"bytes,11,opt,name=verify_tx,json=verifyTx,proto3" json:"verify_tx,omitempty"`
+	// original source of the contract, empty if not verified.
+	VerifiedSource string `protobuf:"bytes,12,opt,name=verified_source,json=verifiedSource,proto3" json:"verified_source,omitempty"`
+	// source type of the original source.
+	VerifiedSourceType string `protobuf:"bytes,13,opt,name=verified_source_type,json=verifiedSourceType,proto3" json:"verified_source_type,omitempty"`
+	// compiler and its version compiling the original source.
+	Compiler        string `protobuf:"bytes,14,opt,name=compiler,proto3" json:"compiler,omitempty"`
+	CompilerVersion string `protobuf:"bytes,15,opt,name=compiler_version,json=compilerVersion,proto3" json:"compiler_version,omitempty"`
+}
+
+func (m *GetContractMetadataResponse) Reset()                    { *m = GetContractMetadataResponse{} }
+func (m *GetContractMetadataResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetContractMetadataResponse) ProtoMessage()               {}
+func (*GetContractMetadataResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{30} }
+
+func (m *GetContractMetadataResponse) GetAddress() string {
 	if m != nil {
-		return m.SourceType
+		return m.Address
 	}
 	return ""
 }
 
-func (m *ContractRequest) GetFunction() string {
+func (m *GetContractMetadataResponse) GetCreator() string {
 	if m != nil {
-		return m.Function
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *ContractRequest) GetArgs() string {
+func (m *GetContractMetadataResponse) GetDeployTx() string {
 	if m != nil {
-		return m.Args
+		return m.DeployTx
 	}
 	return ""
 }
 
-type CandidateRequest struct {
-	// candidate action.
-	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+func (m *GetContractMetadataResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *CandidateRequest) Reset()                    { *m = CandidateRequest{} }
-func (m *CandidateRequest) String() string            { return proto.CompactTextString(m) }
-func (*CandidateRequest) ProtoMessage()               {}
-func (*CandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{20} }
-
-func (m *CandidateRequest) GetAction() string {
+func (m *GetContractMetadataResponse) GetSourceType() string {
 	if m != nil {
-		return m.Action
+		return m.SourceType
 	}
 	return ""
 }
 
-type DelegateRequest struct {
-	// delegate action.
-	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
-	// delegatee.
-	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
-}
-
-func (m *DelegateRequest) Reset()                    { *m = DelegateRequest{} }
-func (m *DelegateRequest) String() string            { return proto.CompactTextString(m) }
-func (*DelegateRequest) ProtoMessage()               {}
-func (*DelegateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{21} }
-
-func (m *DelegateRequest) GetAction() string {
+func (m *GetContractMetadataResponse) GetSource() string {
 	if m != nil {
-		return m.Action
+		return m.Source
 	}
 	return ""
 }
 
-func (m *DelegateRequest) GetDelegatee() string {
+func (m *GetContractMetadataResponse) GetArgs() string {
 	if m != nil {
-		return m.Delegatee
+		return m.Args
 	}
 	return ""
 }
 
-// Request message of SendRawTransactionRequest rpc.
-type SendRawTransactionRequest struct {
-	// Signed data of transaction
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (m *GetContractMetadataResponse) GetUpgradable() bool {
+	if m != nil {
+		return m.Upgradable
+	}
+	return false
 }
 
-func (m *SendRawTransactionRequest) Reset()                    { *m = SendRawTransactionRequest{} }
-func (m *SendRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
-func (*SendRawTransactionRequest) ProtoMessage()               {}
-func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{22} }
+func (m *GetContractMetadataResponse) GetUpgradeTx() string {
+	if m != nil {
+		return m.UpgradeTx
+	}
+	return ""
+}
 
-func (m *SendRawTransactionRequest) GetData() []byte {
+func (m *GetContractMetadataResponse) GetVerified() bool {
 	if m != nil {
-		return m.Data
+		return m.Verified
 	}
-	return nil
+	return false
 }
 
-// Response message of SendTransaction rpc.
-type SendTransactionResponse struct {
-	// Hex string of transaction hash.
-	Txhash          string `protobuf:"bytes,1,opt,name=txhash,proto3" json:"txhash,omitempty"`
-	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	Result          bool   `protobuf:"varint,3,opt,name=result,proto3" json:"result,omitempty"`
+func (m *GetContractMetadataResponse) GetVerifyTx() string {
+	if m != nil {
+		return m.VerifyTx
+	}
+	return ""
 }
 
-func (m *SendTransactionResponse) Reset()                    { *m = SendTransactionResponse{} }
-func (m *SendTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*SendTransactionResponse) ProtoMessage()               {}
-func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{23} }
+func (m *GetContractMetadataResponse) GetVerifiedSource() string {
+	if m != nil {
+		return m.VerifiedSource
+	}
+	return ""
+}
 
-func (m *SendTransactionResponse) GetTxhash() string {
+func (m *GetContractMetadataResponse) GetVerifiedSourceType() string {
 	if m != nil {
-		return m.Txhash
+		return m.VerifiedSourceType
 	}
 	return ""
 }
 
-func (m *SendTransactionResponse) GetContractAddress() string {
+func (m *GetContractMetadataResponse) GetCompiler() string {
 	if m != nil {
-		return m.ContractAddress
+		return m.Compiler
 	}
 	return ""
 }
 
-func (m *SendTransactionResponse) GetResult() bool {
+func (m *GetContractMetadataResponse) GetCompilerVersion() string {
 	if m != nil {
-		return m.Result
+		return m.CompilerVersion
 	}
-	return false
+	return ""
 }
 
-// Request message of GetBlockByHash rpc.
-type GetBlockByHashRequest struct {
-	// Hex string of block hash.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// If true it returns the full transaction objects, if false only the hashes of the transactions.
-	FullTransaction bool `protobuf:"varint,2,opt,name=full_transaction,json=fullTransaction,proto3" json:"full_transaction,omitempty"`
+type TokenBalanceRequest struct {
+	// Hex string of the token contract address.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// Hex string of the token holder address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *GetBlockByHashRequest) Reset()                    { *m = GetBlockByHashRequest{} }
-func (m *GetBlockByHashRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetBlockByHashRequest) ProtoMessage()               {}
-func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{24} }
+func (m *TokenBalanceRequest) Reset()                    { *m = TokenBalanceRequest{} }
+func (m *TokenBalanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*TokenBalanceRequest) ProtoMessage()               {}
+func (*TokenBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{31} }
 
-func (m *GetBlockByHashRequest) GetHash() string {
+func (m *TokenBalanceRequest) GetContract() string {
 	if m != nil {
-		return m.Hash
+		return m.Contract
 	}
 	return ""
 }
 
-func (m *GetBlockByHashRequest) GetFullTransaction() bool {
+func (m *TokenBalanceRequest) GetAddress() string {
 	if m != nil {
-		return m.FullTransaction
+		return m.Address
 	}
-	return false
+	return ""
 }
 
-// Request message of GetBlockByHeight rpc.
-type GetBlockByHeightRequest struct {
-	// block height.
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
-	// If true it returns the full transaction objects, if false only the hashes of the transactions.
-	FullTransaction bool `protobuf:"varint,2,opt,name=full_transaction,json=fullTransaction,proto3" json:"full_transaction,omitempty"`
+type TokenBalanceResponse struct {
+	// balance in the smallest unit of the token.
+	Balance string `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (m *GetBlockByHeightRequest) Reset()                    { *m = GetBlockByHeightRequest{} }
-func (m *GetBlockByHeightRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetBlockByHeightRequest) ProtoMessage()               {}
-func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{25} }
+func (m *TokenBalanceResponse) Reset()                    { *m = TokenBalanceResponse{} }
+func (m *TokenBalanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*TokenBalanceResponse) ProtoMessage()               {}
+func (*TokenBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{32} }
 
-func (m *GetBlockByHeightRequest) GetHeight() uint64 {
+func (m *TokenBalanceResponse) GetBalance() string {
 	if m != nil {
-		return m.Height
+		return m.Balance
 	}
-	return 0
+	return ""
 }
 
-func (m *GetBlockByHeightRequest) GetFullTransaction() bool {
+type TokenMetadataRequest struct {
+	// Hex string of the token contract address.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *TokenMetadataRequest) Reset()                    { *m = TokenMetadataRequest{} }
+func (m *TokenMetadataRequest) String() string            { return proto.CompactTextString(m) }
+func (*TokenMetadataRequest) ProtoMessage()               {}
+func (*TokenMetadataRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{33} }
+
+func (m *TokenMetadataRequest) GetContract() string {
 	if m != nil {
-		return m.FullTransaction
+		return m.Contract
 	}
-	return false
+	return ""
 }
 
-// Request message of GetTransactionByHash rpc.
-type GetTransactionByHashRequest struct {
-	// Hex string of transaction hash.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+type TokenMetadataResponse struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol   string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// total supply in the smallest unit of the token.
+	TotalSupply string `protobuf:"bytes,4,opt,name=total_supply,json=totalSupply,proto3" json:"total_supply,omitempty"`
 }
 
-func (m *GetTransactionByHashRequest) Reset()                    { *m = GetTransactionByHashRequest{} }
-func (m *GetTransactionByHashRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetTransactionByHashRequest) ProtoMessage()               {}
-func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{26} }
+func (m *TokenMetadataResponse) Reset()                    { *m = TokenMetadataResponse{} }
+func (m *TokenMetadataResponse) String() string            { return proto.CompactTextString(m) }
+func (*TokenMetadataResponse) ProtoMessage()               {}
+func (*TokenMetadataResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{34} }
 
-func (m *GetTransactionByHashRequest) GetHash() string {
+func (m *TokenMetadataResponse) GetName() string {
 	if m != nil {
-		return m.Hash
+		return m.Name
 	}
 	return ""
 }
 
-// Request message of BlockDump.
-type BlockDumpRequest struct {
-	// the count of blocks to dump before current tail.
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+func (m *TokenMetadataResponse) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
 }
 
-func (m *BlockDumpRequest) Reset()                    { *m = BlockDumpRequest{} }
-func (m *BlockDumpRequest) String() string            { return proto.CompactTextString(m) }
-func (*BlockDumpRequest) ProtoMessage()               {}
-func (*BlockDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{27} }
-
-func (m *BlockDumpRequest) GetCount() int32 {
+func (m *TokenMetadataResponse) GetDecimals() uint32 {
 	if m != nil {
-		return m.Count
+		return m.Decimals
 	}
 	return 0
 }
 
-// Response message of BlockDump.
-type BlockDumpResponse struct {
-	// block dump info.
-	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-}
-
-func (m *BlockDumpResponse) Reset()                    { *m = BlockDumpResponse{} }
-func (m *BlockDumpResponse) String() string            { return proto.CompactTextString(m) }
-func (*BlockDumpResponse) ProtoMessage()               {}
-func (*BlockDumpResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{28} }
-
-func (m *BlockDumpResponse) GetData() string {
+func (m *TokenMetadataResponse) GetTotalSupply() string {
 	if m != nil {
-		return m.Data
+		return m.TotalSupply
 	}
 	return ""
 }
 
-// Response message of Block.
-type BlockResponse struct {
-	// Hex string of block hash.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// Hex string of block parent hash.
-	ParentHash string `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
-	// block height
-	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	// block nonce
-	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// Hex string of coinbase address.
-	Coinbase string `protobuf:"bytes,5,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
-	// Hex string of miner address.
-	Miner string `protobuf:"bytes,6,opt,name=miner,proto3" json:"miner,omitempty"`
-	// block timestamp.
-	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// block chain id
-	ChainId uint32 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Hex string of state root.
-	StateRoot string `protobuf:"bytes,11,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
-	// Hex string of txs root.
-	TxsRoot string `protobuf:"bytes,12,opt,name=txs_root,json=txsRoot,proto3" json:"txs_root,omitempty"`
-	// Hex string of event root.
-	EventsRoot string `protobuf:"bytes,13,opt,name=events_root,json=eventsRoot,proto3" json:"events_root,omitempty"`
-	// dpos context
-	DposContext *DposContext `protobuf:"bytes,14,opt,name=dpos_context,json=dposContext" json:"dpos_context,omitempty"`
-	// transaction slice
-	Transactions []*TransactionResponse `protobuf:"bytes,100,rep,name=transactions" json:"transactions,omitempty"`
+// Response message of GetAccountStateProof rpc.
+type GetAccountStateProofResponse struct {
+	// Current balance in unit of 1/(10^18) nas.
+	Balance string `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Current transaction count.
+	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Hex string of the block hash.
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// Hex string of the block state root.
+	StateRoot string `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// merkle proof from the state root to the account.
+	Proof []*MerkleProofNode `protobuf:"bytes,5,rep,name=proof" json:"proof,omitempty"`
 }
 
-func (m *BlockResponse) Reset()                    { *m = BlockResponse{} }
-func (m *BlockResponse) String() string            { return proto.CompactTextString(m) }
-func (*BlockResponse) ProtoMessage()               {}
-func (*BlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{29} }
+func (m *GetAccountStateProofResponse) Reset()         { *m = GetAccountStateProofResponse{} }
+func (m *GetAccountStateProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountStateProofResponse) ProtoMessage()    {}
+func (*GetAccountStateProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{35}
+}
 
-func (m *BlockResponse) GetHash() string {
+func (m *GetAccountStateProofResponse) GetBalance() string {
 	if m != nil {
-		return m.Hash
+		return m.Balance
 	}
 	return ""
 }
 
-func (m *BlockResponse) GetParentHash() string {
+func (m *GetAccountStateProofResponse) GetNonce() string {
 	if m != nil {
-		return m.ParentHash
+		return m.Nonce
 	}
 	return ""
 }
 
-func (m *BlockResponse) GetHeight() uint64 {
+func (m *GetAccountStateProofResponse) GetBlockHash() string {
 	if m != nil {
-		return m.Height
+		return m.BlockHash
 	}
-	return 0
+	return ""
 }
 
-func (m *BlockResponse) GetNonce() uint64 {
+func (m *GetAccountStateProofResponse) GetStateRoot() string {
 	if m != nil {
-		return m.Nonce
+		return m.StateRoot
 	}
-	return 0
+	return ""
 }
 
-func (m *BlockResponse) GetCoinbase() string {
+func (m *GetAccountStateProofResponse) GetProof() []*MerkleProofNode {
 	if m != nil {
-		return m.Coinbase
+		return m.Proof
 	}
-	return ""
+	return nil
 }
 
-func (m *BlockResponse) GetMiner() string {
+// MerkleProofNode is the value of node in merkle proof path.
+type MerkleProofNode struct {
+	Val [][]byte `protobuf:"bytes,1,rep,name=val" json:"val,omitempty"`
+}
+
+func (m *MerkleProofNode) Reset()                    { *m = MerkleProofNode{} }
+func (m *MerkleProofNode) String() string            { return proto.CompactTextString(m) }
+func (*MerkleProofNode) ProtoMessage()               {}
+func (*MerkleProofNode) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{36} }
+
+func (m *MerkleProofNode) GetVal() [][]byte {
 	if m != nil {
-		return m.Miner
+		return m.Val
 	}
-	return ""
+	return nil
 }
 
-func (m *BlockResponse) GetTimestamp() int64 {
+// Request message of GetChainStats rpc.
+type ChainStatsRequest struct {
+	// count of the latest blocks to calculate tps and block interval. If not specified, use 120.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *ChainStatsRequest) Reset()                    { *m = ChainStatsRequest{} }
+func (m *ChainStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChainStatsRequest) ProtoMessage()               {}
+func (*ChainStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{37} }
+
+func (m *ChainStatsRequest) GetWindow() uint64 {
 	if m != nil {
-		return m.Timestamp
+		return m.Window
 	}
 	return 0
 }
 
-func (m *BlockResponse) GetChainId() uint32 {
+// Response message of GetChainStats rpc.
+type ChainStatsResponse struct {
+	// height of the tail block.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// total transactions on chain.
+	TotalTxs uint64 `protobuf:"varint,2,opt,name=total_txs,json=totalTxs,proto3" json:"total_txs,omitempty"`
+	// total contracts deployed on chain.
+	TotalContracts uint64 `protobuf:"varint,3,opt,name=total_contracts,json=totalContracts,proto3" json:"total_contracts,omitempty"`
+	// transactions per second in window.
+	Tps float64 `protobuf:"fixed64,4,opt,name=tps,proto3" json:"tps,omitempty"`
+	// average block interval in window, unit is s.
+	AverageBlockInterval float64 `protobuf:"fixed64,5,opt,name=average_block_interval,json=averageBlockInterval,proto3" json:"average_block_interval,omitempty"`
+	// missed block rate in current dynasty.
+	DynastyMissRate float64 `protobuf:"fixed64,6,opt,name=dynasty_miss_rate,json=dynastyMissRate,proto3" json:"dynasty_miss_rate,omitempty"`
+}
+
+func (m *ChainStatsResponse) Reset()                    { *m = ChainStatsResponse{} }
+func (m *ChainStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChainStatsResponse) ProtoMessage()               {}
+func (*ChainStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{38} }
+
+func (m *ChainStatsResponse) GetHeight() uint64 {
 	if m != nil {
-		return m.ChainId
+		return m.Height
 	}
 	return 0
 }
 
-func (m *BlockResponse) GetStateRoot() string {
+func (m *ChainStatsResponse) GetTotalTxs() uint64 {
 	if m != nil {
-		return m.StateRoot
+		return m.TotalTxs
 	}
-	return ""
+	return 0
 }
 
-func (m *BlockResponse) GetTxsRoot() string {
+func (m *ChainStatsResponse) GetTotalContracts() uint64 {
 	if m != nil {
-		return m.TxsRoot
+		return m.TotalContracts
 	}
-	return ""
+	return 0
 }
 
-func (m *BlockResponse) GetEventsRoot() string {
+func (m *ChainStatsResponse) GetTps() float64 {
 	if m != nil {
-		return m.EventsRoot
+		return m.Tps
 	}
-	return ""
+	return 0
 }
 
-func (m *BlockResponse) GetDposContext() *DposContext {
+func (m *ChainStatsResponse) GetAverageBlockInterval() float64 {
 	if m != nil {
-		return m.DposContext
+		return m.AverageBlockInterval
 	}
-	return nil
+	return 0
 }
 
-func (m *BlockResponse) GetTransactions() []*TransactionResponse {
+func (m *ChainStatsResponse) GetDynastyMissRate() float64 {
 	if m != nil {
-		return m.Transactions
+		return m.DynastyMissRate
 	}
-	return nil
+	return 0
 }
 
-// dpos context
-type DposContext struct {
-	// dynasty root
-	DynastyRoot string `protobuf:"bytes,1,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
-	// next dynasty
-	NextDynastyRoot string `protobuf:"bytes,2,opt,name=next_dynasty_root,json=nextDynastyRoot,proto3" json:"next_dynasty_root,omitempty"`
-	// delegate root
-	DelegateRoot string `protobuf:"bytes,3,opt,name=delegate_root,json=delegateRoot,proto3" json:"delegate_root,omitempty"`
-	// candidate root
-	CandidateRoot string `protobuf:"bytes,4,opt,name=candidate_root,json=candidateRoot,proto3" json:"candidate_root,omitempty"`
-	// vote root
-	VoteRoot string `protobuf:"bytes,5,opt,name=vote_root,json=voteRoot,proto3" json:"vote_root,omitempty"`
-	// mint cnt root
-	MintCntRoot string `protobuf:"bytes,6,opt,name=mint_cnt_root,json=mintCntRoot,proto3" json:"mint_cnt_root,omitempty"`
+// Request message of GetMinerStats rpc.
+type MinerStatsRequest struct {
+	// start height of the range. If not specified, use the latest 120 blocks.
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// end height of the range. If not specified, use the tail block.
+	End uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (m *DposContext) Reset()                    { *m = DposContext{} }
-func (m *DposContext) String() string            { return proto.CompactTextString(m) }
-func (*DposContext) ProtoMessage()               {}
-func (*DposContext) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{30} }
-
-func (m *DposContext) GetDynastyRoot() string {
-	if m != nil {
-		return m.DynastyRoot
-	}
-	return ""
-}
+func (m *MinerStatsRequest) Reset()                    { *m = MinerStatsRequest{} }
+func (m *MinerStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*MinerStatsRequest) ProtoMessage()               {}
+func (*MinerStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{39} }
 
-func (m *DposContext) GetNextDynastyRoot() string {
+func (m *MinerStatsRequest) GetStart() uint64 {
 	if m != nil {
-		return m.NextDynastyRoot
+		return m.Start
 	}
-	return ""
+	return 0
 }
 
-func (m *DposContext) GetDelegateRoot() string {
+func (m *MinerStatsRequest) GetEnd() uint64 {
 	if m != nil {
-		return m.DelegateRoot
+		return m.End
 	}
-	return ""
+	return 0
 }
 
-func (m *DposContext) GetCandidateRoot() string {
+// Statistics of a validator in GetMinerStats rpc.
+type MinerStats struct {
+	// validator address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// count of the blocks minted.
+	Minted uint64 `protobuf:"varint,2,opt,name=minted,proto3" json:"minted,omitempty"`
+	// count of the slots missed.
+	Missed uint64 `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	// count of the slots assigned, minted + missed.
+	Expected uint64 `protobuf:"varint,4,opt,name=expected,proto3" json:"expected,omitempty"`
+	// percentage of the assigned slots minted.
+	Uptime float64 `protobuf:"fixed64,5,opt,name=uptime,proto3" json:"uptime,omitempty"`
+}
+
+func (m *MinerStats) Reset()                    { *m = MinerStats{} }
+func (m *MinerStats) String() string            { return proto.CompactTextString(m) }
+func (*MinerStats) ProtoMessage()               {}
+func (*MinerStats) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{40} }
+
+func (m *MinerStats) GetAddress() string {
 	if m != nil {
-		return m.CandidateRoot
+		return m.Address
 	}
 	return ""
 }
 
-func (m *DposContext) GetVoteRoot() string {
+func (m *MinerStats) GetMinted() uint64 {
 	if m != nil {
-		return m.VoteRoot
+		return m.Minted
 	}
-	return ""
+	return 0
 }
 
-func (m *DposContext) GetMintCntRoot() string {
+func (m *MinerStats) GetMissed() uint64 {
 	if m != nil {
-		return m.MintCntRoot
+		return m.Missed
 	}
-	return ""
-}
-
-// Response message of TransactionReceipt.
-type TransactionResponse struct {
-	// Hex string of tx hash.
-	Hash    string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	ChainId uint32 `protobuf:"varint,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
-	// Hex string of the sender account addresss.
-	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
-	// Hex string of the receiver account addresss.
-	To    string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
-	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
-	// Transaction nonce.
-	Nonce           uint64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Timestamp       int64  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Type            string `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
-	Data            []byte `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
-	GasPrice        string `protobuf:"bytes,10,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	GasLimit        string `protobuf:"bytes,11,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	ContractAddress string `protobuf:"bytes,12,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	// transaction status 0 failed, 1 success, 2 pending
-	Status uint32 `protobuf:"varint,13,opt,name=status,proto3" json:"status,omitempty"`
+	return 0
 }
 
-func (m *TransactionResponse) Reset()                    { *m = TransactionResponse{} }
-func (m *TransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*TransactionResponse) ProtoMessage()               {}
-func (*TransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{31} }
-
-func (m *TransactionResponse) GetHash() string {
+func (m *MinerStats) GetExpected() uint64 {
 	if m != nil {
-		return m.Hash
+		return m.Expected
 	}
-	return ""
+	return 0
 }
 
-func (m *TransactionResponse) GetChainId() uint32 {
+func (m *MinerStats) GetUptime() float64 {
 	if m != nil {
-		return m.ChainId
+		return m.Uptime
 	}
 	return 0
 }
 
-func (m *TransactionResponse) GetFrom() string {
-	if m != nil {
-		return m.From
-	}
-	return ""
+// Response message of GetMinerStats rpc.
+type MinerStatsResponse struct {
+	// statistics of the validators, sorted by address.
+	Stats []*MinerStats `protobuf:"bytes,1,rep,name=stats" json:"stats,omitempty"`
 }
 
-func (m *TransactionResponse) GetTo() string {
+func (m *MinerStatsResponse) Reset()                    { *m = MinerStatsResponse{} }
+func (m *MinerStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*MinerStatsResponse) ProtoMessage()               {}
+func (*MinerStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{41} }
+
+func (m *MinerStatsResponse) GetStats() []*MinerStats {
 	if m != nil {
-		return m.To
+		return m.Stats
 	}
-	return ""
+	return nil
 }
 
-func (m *TransactionResponse) GetValue() string {
-	if m != nil {
-		return m.Value
-	}
-	return ""
+type TotalSupplyRequest struct {
+	// block height, if not specified, use the tail block.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *TransactionResponse) GetNonce() uint64 {
+func (m *TotalSupplyRequest) Reset()                    { *m = TotalSupplyRequest{} }
+func (m *TotalSupplyRequest) String() string            { return proto.CompactTextString(m) }
+func (*TotalSupplyRequest) ProtoMessage()               {}
+func (*TotalSupplyRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{42} }
+
+func (m *TotalSupplyRequest) GetHeight() uint64 {
 	if m != nil {
-		return m.Nonce
+		return m.Height
 	}
 	return 0
 }
 
-func (m *TransactionResponse) GetTimestamp() int64 {
+// Response message of GetTotalSupply rpc, the amounts are in Wei.
+type TotalSupplyResponse struct {
+	// height of the block.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// token distributed in the genesis block.
+	Genesis string `protobuf:"bytes,2,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	// total reward minted to the coinbases.
+	Minted string `protobuf:"bytes,3,opt,name=minted,proto3" json:"minted,omitempty"`
+	// balance of the burn address.
+	Burned string `protobuf:"bytes,4,opt,name=burned,proto3" json:"burned,omitempty"`
+	// sum of the genesis distribution and the minted reward.
+	Total string `protobuf:"bytes,5,opt,name=total,proto3" json:"total,omitempty"`
+	// total supply excluding the burned.
+	Circulating string `protobuf:"bytes,6,opt,name=circulating,proto3" json:"circulating,omitempty"`
+}
+
+func (m *TotalSupplyResponse) Reset()                    { *m = TotalSupplyResponse{} }
+func (m *TotalSupplyResponse) String() string            { return proto.CompactTextString(m) }
+func (*TotalSupplyResponse) ProtoMessage()               {}
+func (*TotalSupplyResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{43} }
+
+func (m *TotalSupplyResponse) GetHeight() uint64 {
 	if m != nil {
-		return m.Timestamp
+		return m.Height
 	}
 	return 0
 }
 
-func (m *TransactionResponse) GetType() string {
+func (m *TotalSupplyResponse) GetGenesis() string {
 	if m != nil {
-		return m.Type
+		return m.Genesis
 	}
 	return ""
 }
 
-func (m *TransactionResponse) GetData() []byte {
+func (m *TotalSupplyResponse) GetMinted() string {
 	if m != nil {
-		return m.Data
+		return m.Minted
 	}
-	return nil
+	return ""
 }
 
-func (m *TransactionResponse) GetGasPrice() string {
+func (m *TotalSupplyResponse) GetBurned() string {
 	if m != nil {
-		return m.GasPrice
+		return m.Burned
 	}
 	return ""
 }
 
-func (m *TransactionResponse) GetGasLimit() string {
+func (m *TotalSupplyResponse) GetTotal() string {
 	if m != nil {
-		return m.GasLimit
+		return m.Total
 	}
 	return ""
 }
 
-func (m *TransactionResponse) GetContractAddress() string {
+func (m *TotalSupplyResponse) GetCirculating() string {
 	if m != nil {
-		return m.ContractAddress
+		return m.Circulating
 	}
 	return ""
 }
 
-func (m *TransactionResponse) GetStatus() uint32 {
+// Response message of Call rpc.
+type CallResponse struct {
+	// result of smart contract method call.
+	Result string `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	// state changes of the transaction if state_diff is requested.
+	StateDiff *StateDiff `protobuf:"bytes,2,opt,name=state_diff,json=stateDiff" json:"state_diff,omitempty"`
+}
+
+func (m *CallResponse) Reset()                    { *m = CallResponse{} }
+func (m *CallResponse) String() string            { return proto.CompactTextString(m) }
+func (*CallResponse) ProtoMessage()               {}
+func (*CallResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{44} }
+
+func (m *CallResponse) GetResult() string {
 	if m != nil {
-		return m.Status
+		return m.Result
 	}
-	return 0
+	return ""
 }
 
-type NewAccountRequest struct {
-	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+func (m *CallResponse) GetStateDiff() *StateDiff {
+	if m != nil {
+		return m.StateDiff
+	}
+	return nil
 }
 
-func (m *NewAccountRequest) Reset()                    { *m = NewAccountRequest{} }
-func (m *NewAccountRequest) String() string            { return proto.CompactTextString(m) }
-func (*NewAccountRequest) ProtoMessage()               {}
-func (*NewAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{32} }
+// State changes made by a transaction executed as in a block, not committed.
+type StateDiff struct {
+	GasUsed      string `protobuf:"bytes,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	ExecuteError string `protobuf:"bytes,2,opt,name=execute_error,json=executeError,proto3" json:"execute_error,omitempty"`
+	// balance changes sorted by address.
+	Balances []*BalanceDelta `protobuf:"bytes,3,rep,name=balances" json:"balances,omitempty"`
+	// contract storage writes in execution order.
+	Storage []*StorageWrite `protobuf:"bytes,4,rep,name=storage" json:"storage,omitempty"`
+	Events  []*Event        `protobuf:"bytes,5,rep,name=events" json:"events,omitempty"`
+}
 
-func (m *NewAccountRequest) GetPassphrase() string {
+func (m *StateDiff) Reset()                    { *m = StateDiff{} }
+func (m *StateDiff) String() string            { return proto.CompactTextString(m) }
+func (*StateDiff) ProtoMessage()               {}
+func (*StateDiff) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{45} }
+
+func (m *StateDiff) GetGasUsed() string {
 	if m != nil {
-		return m.Passphrase
+		return m.GasUsed
 	}
 	return ""
 }
 
-type NewAccountResponse struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *StateDiff) GetExecuteError() string {
+	if m != nil {
+		return m.ExecuteError
+	}
+	return ""
 }
 
-func (m *NewAccountResponse) Reset()                    { *m = NewAccountResponse{} }
-func (m *NewAccountResponse) String() string            { return proto.CompactTextString(m) }
-func (*NewAccountResponse) ProtoMessage()               {}
-func (*NewAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{33} }
+func (m *StateDiff) GetBalances() []*BalanceDelta {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
 
-func (m *NewAccountResponse) GetAddress() string {
+func (m *StateDiff) GetStorage() []*StorageWrite {
 	if m != nil {
-		return m.Address
+		return m.Storage
 	}
-	return ""
+	return nil
 }
 
-type UnlockAccountRequest struct {
-	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	Duration   uint64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+func (m *StateDiff) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
 }
 
-func (m *UnlockAccountRequest) Reset()                    { *m = UnlockAccountRequest{} }
-func (m *UnlockAccountRequest) String() string            { return proto.CompactTextString(m) }
-func (*UnlockAccountRequest) ProtoMessage()               {}
-func (*UnlockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{34} }
+type BalanceDelta struct {
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// signed balance change.
+	Delta string `protobuf:"bytes,2,opt,name=delta,proto3" json:"delta,omitempty"`
+}
 
-func (m *UnlockAccountRequest) GetAddress() string {
+func (m *BalanceDelta) Reset()                    { *m = BalanceDelta{} }
+func (m *BalanceDelta) String() string            { return proto.CompactTextString(m) }
+func (*BalanceDelta) ProtoMessage()               {}
+func (*BalanceDelta) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{46} }
+
+func (m *BalanceDelta) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *UnlockAccountRequest) GetPassphrase() string {
+func (m *BalanceDelta) GetDelta() string {
 	if m != nil {
-		return m.Passphrase
+		return m.Delta
 	}
 	return ""
 }
 
-func (m *UnlockAccountRequest) GetDuration() uint64 {
+type StorageWrite struct {
+	// Hex string of the contract address.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Key      string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value    string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Deleted  bool   `protobuf:"varint,4,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *StorageWrite) Reset()                    { *m = StorageWrite{} }
+func (m *StorageWrite) String() string            { return proto.CompactTextString(m) }
+func (*StorageWrite) ProtoMessage()               {}
+func (*StorageWrite) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{47} }
+
+func (m *StorageWrite) GetContract() string {
 	if m != nil {
-		return m.Duration
+		return m.Contract
 	}
-	return 0
+	return ""
 }
 
-type UnlockAccountResponse struct {
-	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+func (m *StorageWrite) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
 }
 
-func (m *UnlockAccountResponse) Reset()                    { *m = UnlockAccountResponse{} }
-func (m *UnlockAccountResponse) String() string            { return proto.CompactTextString(m) }
-func (*UnlockAccountResponse) ProtoMessage()               {}
-func (*UnlockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{35} }
+func (m *StorageWrite) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
 
-func (m *UnlockAccountResponse) GetResult() bool {
+func (m *StorageWrite) GetDeleted() bool {
 	if m != nil {
-		return m.Result
+		return m.Deleted
 	}
 	return false
 }
 
-type LockAccountRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+// ByBlockHeightRequest message
+type ByBlockHeightRequest struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (m *LockAccountRequest) Reset()                    { *m = LockAccountRequest{} }
-func (m *LockAccountRequest) String() string            { return proto.CompactTextString(m) }
-func (*LockAccountRequest) ProtoMessage()               {}
-func (*LockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{36} }
+func (m *ByBlockHeightRequest) Reset()                    { *m = ByBlockHeightRequest{} }
+func (m *ByBlockHeightRequest) String() string            { return proto.CompactTextString(m) }
+func (*ByBlockHeightRequest) ProtoMessage()               {}
+func (*ByBlockHeightRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{48} }
 
-func (m *LockAccountRequest) GetAddress() string {
+func (m *ByBlockHeightRequest) GetHeight() uint64 {
 	if m != nil {
-		return m.Address
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-type LockAccountResponse struct {
-	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+// GetCandidatesRequest message
+type GetCandidatesResponse struct {
+	Candidates []string `protobuf:"bytes,1,rep,name=candidates" json:"candidates,omitempty"`
 }
 
-func (m *LockAccountResponse) Reset()                    { *m = LockAccountResponse{} }
-func (m *LockAccountResponse) String() string            { return proto.CompactTextString(m) }
-func (*LockAccountResponse) ProtoMessage()               {}
-func (*LockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{37} }
+func (m *GetCandidatesResponse) Reset()                    { *m = GetCandidatesResponse{} }
+func (m *GetCandidatesResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetCandidatesResponse) ProtoMessage()               {}
+func (*GetCandidatesResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{49} }
 
-func (m *LockAccountResponse) GetResult() bool {
+func (m *GetCandidatesResponse) GetCandidates() []string {
 	if m != nil {
-		return m.Result
+		return m.Candidates
 	}
-	return false
+	return nil
 }
 
-type SignTransactionResponse struct {
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+// Response message of GetDynastyRequest rpc
+type GetDynastyResponse struct {
+	Delegatees []string `protobuf:"bytes,1,rep,name=delegatees" json:"delegatees,omitempty"`
 }
 
-func (m *SignTransactionResponse) Reset()                    { *m = SignTransactionResponse{} }
-func (m *SignTransactionResponse) String() string            { return proto.CompactTextString(m) }
-func (*SignTransactionResponse) ProtoMessage()               {}
-func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{38} }
+func (m *GetDynastyResponse) Reset()                    { *m = GetDynastyResponse{} }
+func (m *GetDynastyResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDynastyResponse) ProtoMessage()               {}
+func (*GetDynastyResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{50} }
 
-func (m *SignTransactionResponse) GetData() []byte {
+func (m *GetDynastyResponse) GetDelegatees() []string {
 	if m != nil {
-		return m.Data
+		return m.Delegatees
 	}
 	return nil
 }
 
-type SendTransactionPassphraseRequest struct {
-	// transaction struct
-	Transaction *TransactionRequest `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
-	// from account passphrase
-	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+// Response message of GetTransactionDependency rpc
+type GetTransactionDependencyResponse struct {
+	Dependencies []*TransactionDependency `protobuf:"bytes,1,rep,name=dependencies" json:"dependencies,omitempty"`
 }
 
-func (m *SendTransactionPassphraseRequest) Reset()         { *m = SendTransactionPassphraseRequest{} }
-func (m *SendTransactionPassphraseRequest) String() string { return proto.CompactTextString(m) }
-func (*SendTransactionPassphraseRequest) ProtoMessage()    {}
-func (*SendTransactionPassphraseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{39}
+func (m *GetTransactionDependencyResponse) Reset()         { *m = GetTransactionDependencyResponse{} }
+func (m *GetTransactionDependencyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransactionDependencyResponse) ProtoMessage()    {}
+func (*GetTransactionDependencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{51}
 }
 
-func (m *SendTransactionPassphraseRequest) GetTransaction() *TransactionRequest {
+func (m *GetTransactionDependencyResponse) GetDependencies() []*TransactionDependency {
 	if m != nil {
-		return m.Transaction
+		return m.Dependencies
 	}
 	return nil
 }
 
-func (m *SendTransactionPassphraseRequest) GetPassphrase() string {
-	if m != nil {
-		return m.Passphrase
-	}
-	return ""
-}
-
-type SendTransactionPassphraseResponse struct {
-	// Hex string of transaction hash.
+type TransactionDependency struct {
+	// Hex string of the transaction hash.
 	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of the former transactions' hash it depends on.
+	Depends []string `protobuf:"bytes,2,rep,name=depends" json:"depends,omitempty"`
 }
 
-func (m *SendTransactionPassphraseResponse) Reset()         { *m = SendTransactionPassphraseResponse{} }
-func (m *SendTransactionPassphraseResponse) String() string { return proto.CompactTextString(m) }
-func (*SendTransactionPassphraseResponse) ProtoMessage()    {}
-func (*SendTransactionPassphraseResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{40}
-}
+func (m *TransactionDependency) Reset()                    { *m = TransactionDependency{} }
+func (m *TransactionDependency) String() string            { return proto.CompactTextString(m) }
+func (*TransactionDependency) ProtoMessage()               {}
+func (*TransactionDependency) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{52} }
 
-func (m *SendTransactionPassphraseResponse) GetHash() string {
+func (m *TransactionDependency) GetHash() string {
 	if m != nil {
 		return m.Hash
 	}
 	return ""
 }
 
-type GasPriceResponse struct {
-	GasPrice string `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-}
-
-func (m *GasPriceResponse) Reset()                    { *m = GasPriceResponse{} }
-func (m *GasPriceResponse) String() string            { return proto.CompactTextString(m) }
-func (*GasPriceResponse) ProtoMessage()               {}
-func (*GasPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{41} }
-
-func (m *GasPriceResponse) GetGasPrice() string {
+func (m *TransactionDependency) GetDepends() []string {
 	if m != nil {
-		return m.GasPrice
+		return m.Depends
 	}
-	return ""
+	return nil
 }
 
-// Request message of GetTransactionByHash rpc.
-type HashRequest struct {
-	// Hex string of block/transaction hash.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+// Request message of GetForks rpc
+type IterateAccountsRequest struct {
+	// height of the irreversible block, default is the latest irreversible block.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the last address received, the iteration resumes after it.
+	After string `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	// max count of accounts returned, 0 means all.
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// max count of accounts streamed per second, default is 1000.
+	Rate uint32 `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"`
 }
 
-func (m *HashRequest) Reset()                    { *m = HashRequest{} }
-func (m *HashRequest) String() string            { return proto.CompactTextString(m) }
-func (*HashRequest) ProtoMessage()               {}
-func (*HashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{42} }
+func (m *IterateAccountsRequest) Reset()                    { *m = IterateAccountsRequest{} }
+func (m *IterateAccountsRequest) String() string            { return proto.CompactTextString(m) }
+func (*IterateAccountsRequest) ProtoMessage()               {}
+func (*IterateAccountsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{53} }
 
-func (m *HashRequest) GetHash() string {
+func (m *IterateAccountsRequest) GetHeight() uint64 {
 	if m != nil {
-		return m.Hash
+		return m.Height
 	}
-	return ""
+	return 0
 }
 
-type GasResponse struct {
-	Gas string `protobuf:"bytes,1,opt,name=gas,proto3" json:"gas,omitempty"`
+func (m *IterateAccountsRequest) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
 }
 
-func (m *GasResponse) Reset()                    { *m = GasResponse{} }
-func (m *GasResponse) String() string            { return proto.CompactTextString(m) }
-func (*GasResponse) ProtoMessage()               {}
-func (*GasResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{43} }
+func (m *IterateAccountsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
 
-func (m *GasResponse) GetGas() string {
+func (m *IterateAccountsRequest) GetRate() uint32 {
 	if m != nil {
-		return m.Gas
+		return m.Rate
 	}
-	return ""
+	return 0
 }
 
-type EventsResponse struct {
-	Events []*Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+type AccountEntry struct {
+	// height of the block of the state.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Hex string of the account address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Current balance in unit of 1/(10^18) nas.
+	Balance    string `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Nonce      uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	IsContract bool   `protobuf:"varint,5,opt,name=is_contract,json=isContract,proto3" json:"is_contract,omitempty"`
 }
 
-func (m *EventsResponse) Reset()                    { *m = EventsResponse{} }
-func (m *EventsResponse) String() string            { return proto.CompactTextString(m) }
-func (*EventsResponse) ProtoMessage()               {}
-func (*EventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{44} }
+func (m *AccountEntry) Reset()                    { *m = AccountEntry{} }
+func (m *AccountEntry) String() string            { return proto.CompactTextString(m) }
+func (*AccountEntry) ProtoMessage()               {}
+func (*AccountEntry) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{54} }
 
-func (m *EventsResponse) GetEvents() []*Event {
+func (m *AccountEntry) GetHeight() uint64 {
 	if m != nil {
-		return m.Events
+		return m.Height
 	}
-	return nil
+	return 0
 }
 
-type Event struct {
-	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
-	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+func (m *AccountEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *Event) Reset()                    { *m = Event{} }
-func (m *Event) String() string            { return proto.CompactTextString(m) }
-func (*Event) ProtoMessage()               {}
-func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{45} }
-
-func (m *Event) GetTopic() string {
+func (m *AccountEntry) GetBalance() string {
 	if m != nil {
-		return m.Topic
+		return m.Balance
 	}
 	return ""
 }
 
-func (m *Event) GetData() string {
+func (m *AccountEntry) GetNonce() uint64 {
 	if m != nil {
-		return m.Data
+		return m.Nonce
 	}
-	return ""
+	return 0
 }
 
-type StartMiningRequest struct {
-	// miner address passphrase
-	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+func (m *AccountEntry) GetIsContract() bool {
+	if m != nil {
+		return m.IsContract
+	}
+	return false
 }
 
-func (m *StartMiningRequest) Reset()                    { *m = StartMiningRequest{} }
-func (m *StartMiningRequest) String() string            { return proto.CompactTextString(m) }
-func (*StartMiningRequest) ProtoMessage()               {}
-func (*StartMiningRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{46} }
+type GetForksRequest struct {
+	// max depth below the tail of the common ancestor of forks, default is 128.
+	Depth uint64 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+}
 
-func (m *StartMiningRequest) GetPassphrase() string {
+func (m *GetForksRequest) Reset()                    { *m = GetForksRequest{} }
+func (m *GetForksRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetForksRequest) ProtoMessage()               {}
+func (*GetForksRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{55} }
+
+func (m *GetForksRequest) GetDepth() uint64 {
 	if m != nil {
-		return m.Passphrase
+		return m.Depth
 	}
-	return ""
+	return 0
 }
 
-type MiningResponse struct {
-	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+// Response message of GetForks rpc
+type GetForksResponse struct {
+	Forks []*Fork `protobuf:"bytes,1,rep,name=forks" json:"forks,omitempty"`
 }
 
-func (m *MiningResponse) Reset()                    { *m = MiningResponse{} }
-func (m *MiningResponse) String() string            { return proto.CompactTextString(m) }
-func (*MiningResponse) ProtoMessage()               {}
-func (*MiningResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{47} }
+func (m *GetForksResponse) Reset()                    { *m = GetForksResponse{} }
+func (m *GetForksResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetForksResponse) ProtoMessage()               {}
+func (*GetForksResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{56} }
 
-func (m *MiningResponse) GetResult() bool {
+func (m *GetForksResponse) GetForks() []*Fork {
 	if m != nil {
-		return m.Result
+		return m.Forks
 	}
-	return false
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
+type Fork struct {
+	// Hex string of the tip block hash.
+	TipHash   string `protobuf:"bytes,1,opt,name=tip_hash,json=tipHash,proto3" json:"tip_hash,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Hex string of the miner address of the tip block.
+	Miner string `protobuf:"bytes,4,opt,name=miner,proto3" json:"miner,omitempty"`
+	// count of blocks in the branch since the common ancestor.
+	Length uint64 `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+	// Hex string of the common ancestor hash with the canonical chain.
+	AncestorHash   string `protobuf:"bytes,6,opt,name=ancestor_hash,json=ancestorHash,proto3" json:"ancestor_hash,omitempty"`
+	AncestorHeight uint64 `protobuf:"varint,7,opt,name=ancestor_height,json=ancestorHeight,proto3" json:"ancestor_height,omitempty"`
+}
+
+func (m *Fork) Reset()                    { *m = Fork{} }
+func (m *Fork) String() string            { return proto.CompactTextString(m) }
+func (*Fork) ProtoMessage()               {}
+func (*Fork) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{57} }
+
+func (m *Fork) GetTipHash() string {
+	if m != nil {
+		return m.TipHash
+	}
+	return ""
+}
+
+func (m *Fork) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Fork) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Fork) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *Fork) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *Fork) GetAncestorHash() string {
+	if m != nil {
+		return m.AncestorHash
+	}
+	return ""
+}
+
+func (m *Fork) GetAncestorHeight() uint64 {
+	if m != nil {
+		return m.AncestorHeight
+	}
+	return 0
+}
+
+type ContractStatsRequest struct {
+	// Hex string of the contract address, if not specified, return the contracts using the most gas.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// max count of contracts returned, default is 20.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *ContractStatsRequest) Reset()                    { *m = ContractStatsRequest{} }
+func (m *ContractStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContractStatsRequest) ProtoMessage()               {}
+func (*ContractStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{58} }
+
+func (m *ContractStatsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractStatsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// Response message of GetContractStats rpc
+type ContractStatsResponse struct {
+	// statistics of the contracts, sorted by gas used.
+	Stats []*ContractStats `protobuf:"bytes,1,rep,name=stats" json:"stats,omitempty"`
+}
+
+func (m *ContractStatsResponse) Reset()                    { *m = ContractStatsResponse{} }
+func (m *ContractStatsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ContractStatsResponse) ProtoMessage()               {}
+func (*ContractStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{59} }
+
+func (m *ContractStatsResponse) GetStats() []*ContractStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+type ContractStats struct {
+	// Hex string of the contract address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// count of the executions in the blocks minted and verified by the node.
+	Calls uint64 `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	// count of the failed executions.
+	Failures uint64 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	// rate of the failed executions.
+	FailureRate float64 `protobuf:"fixed64,4,opt,name=failure_rate,json=failureRate,proto3" json:"failure_rate,omitempty"`
+	// total gas used by the executions.
+	GasUsed uint64 `protobuf:"varint,5,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// average execution time, unit is ns.
+	AverageDuration int64 `protobuf:"varint,6,opt,name=average_duration,json=averageDuration,proto3" json:"average_duration,omitempty"`
+}
+
+func (m *ContractStats) Reset()                    { *m = ContractStats{} }
+func (m *ContractStats) String() string            { return proto.CompactTextString(m) }
+func (*ContractStats) ProtoMessage()               {}
+func (*ContractStats) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{60} }
+
+func (m *ContractStats) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContractStats) GetCalls() uint64 {
+	if m != nil {
+		return m.Calls
+	}
+	return 0
+}
+
+func (m *ContractStats) GetFailures() uint64 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *ContractStats) GetFailureRate() float64 {
+	if m != nil {
+		return m.FailureRate
+	}
+	return 0
+}
+
+func (m *ContractStats) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ContractStats) GetAverageDuration() int64 {
+	if m != nil {
+		return m.AverageDuration
+	}
+	return 0
+}
+
+// Response message of GetVoteSnapshot rpc
+type VoteSnapshotResponse struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// candidates sorted by vote weight as in dynasty election.
+	Candidates  []*CandidateVotes `protobuf:"bytes,2,rep,name=candidates" json:"candidates,omitempty"`
+	Delegations []*Delegation     `protobuf:"bytes,3,rep,name=delegations" json:"delegations,omitempty"`
+}
+
+func (m *VoteSnapshotResponse) Reset()                    { *m = VoteSnapshotResponse{} }
+func (m *VoteSnapshotResponse) String() string            { return proto.CompactTextString(m) }
+func (*VoteSnapshotResponse) ProtoMessage()               {}
+func (*VoteSnapshotResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{61} }
+
+func (m *VoteSnapshotResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *VoteSnapshotResponse) GetCandidates() []*CandidateVotes {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *VoteSnapshotResponse) GetDelegations() []*Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+type CandidateVotes struct {
+	// Hex string of the candidate address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sum of the balance of the delegators.
+	Votes string `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (m *CandidateVotes) Reset()                    { *m = CandidateVotes{} }
+func (m *CandidateVotes) String() string            { return proto.CompactTextString(m) }
+func (*CandidateVotes) ProtoMessage()               {}
+func (*CandidateVotes) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{62} }
+
+func (m *CandidateVotes) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CandidateVotes) GetVotes() string {
+	if m != nil {
+		return m.Votes
+	}
+	return ""
+}
+
+type Delegation struct {
+	// Hex string of the delegator address.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	// Hex string of the delegatee address.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+}
+
+func (m *Delegation) Reset()                    { *m = Delegation{} }
+func (m *Delegation) String() string            { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()               {}
+func (*Delegation) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{63} }
+
+func (m *Delegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *Delegation) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+// Response message of DebugCall and DebugTransaction rpc
+type DebugResponse struct {
+	GasUsed string `protobuf:"bytes,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// result of the contract execution, the exception if failed.
+	Result       string `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	ExecuteError string `protobuf:"bytes,3,opt,name=execute_error,json=executeError,proto3" json:"execute_error,omitempty"`
+	// contract api calls in execution order.
+	Steps []*TraceStep `protobuf:"bytes,4,rep,name=steps" json:"steps,omitempty"`
+	// javascript stack of the exception if failed.
+	Stack string `protobuf:"bytes,5,opt,name=stack,proto3" json:"stack,omitempty"`
+}
+
+func (m *DebugResponse) Reset()                    { *m = DebugResponse{} }
+func (m *DebugResponse) String() string            { return proto.CompactTextString(m) }
+func (*DebugResponse) ProtoMessage()               {}
+func (*DebugResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{64} }
+
+func (m *DebugResponse) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+func (m *DebugResponse) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *DebugResponse) GetExecuteError() string {
+	if m != nil {
+		return m.ExecuteError
+	}
+	return ""
+}
+
+func (m *DebugResponse) GetSteps() []*TraceStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *DebugResponse) GetStack() string {
+	if m != nil {
+		return m.Stack
+	}
+	return ""
+}
+
+type TraceStep struct {
+	// contract api, e.g. storage.get, blockchain.transfer.
+	Op     string   `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Args   []string `protobuf:"bytes,2,rep,name=args" json:"args,omitempty"`
+	Result string   `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	// instructions executed since the previous step.
+	Gas uint64 `protobuf:"varint,4,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *TraceStep) Reset()                    { *m = TraceStep{} }
+func (m *TraceStep) String() string            { return proto.CompactTextString(m) }
+func (*TraceStep) ProtoMessage()               {}
+func (*TraceStep) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{65} }
+
+func (m *TraceStep) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *TraceStep) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *TraceStep) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *TraceStep) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// Response message of GetDelegateVoters rpc
+type GetDelegateVotersRequest struct {
+	Delegatee string `protobuf:"bytes,1,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *GetDelegateVotersRequest) Reset()                    { *m = GetDelegateVotersRequest{} }
+func (m *GetDelegateVotersRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetDelegateVotersRequest) ProtoMessage()               {}
+func (*GetDelegateVotersRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{66} }
+
+func (m *GetDelegateVotersRequest) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+func (m *GetDelegateVotersRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Response message of GetDelegateVoters rpc
+type GetDelegateVotersResponse struct {
+	Voters []string `protobuf:"bytes,1,rep,name=voters" json:"voters,omitempty"`
+}
+
+func (m *GetDelegateVotersResponse) Reset()                    { *m = GetDelegateVotersResponse{} }
+func (m *GetDelegateVotersResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetDelegateVotersResponse) ProtoMessage()               {}
+func (*GetDelegateVotersResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{67} }
+
+func (m *GetDelegateVotersResponse) GetVoters() []string {
+	if m != nil {
+		return m.Voters
+	}
+	return nil
+}
+
+// Request message of SendTransaction rpc.
+type TransactionRequest struct {
+	// Hex string of the sender account addresss.
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// Hex string of the receiver account addresss.
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Amount of value sending with this transaction.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// Transaction nonce.
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// gasPrice sending with this transaction.
+	GasPrice string `protobuf:"bytes,5,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	// gasLimit sending with this transaction.
+	GasLimit string `protobuf:"bytes,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// contract sending with this transaction
+	Contract *ContractRequest `protobuf:"bytes,7,opt,name=contract" json:"contract,omitempty"`
+	// candidate application sending with this transaction.
+	Candidate *CandidateRequest `protobuf:"bytes,8,opt,name=candidate" json:"candidate,omitempty"`
+	// delegate vote sending with this transaction.
+	Delegate *DelegateRequest `protobuf:"bytes,9,opt,name=delegate" json:"delegate,omitempty"`
+	// transfer from multisig wallet sending with this transaction.
+	Multisig *MultisigRequest `protobuf:"bytes,10,opt,name=multisig" json:"multisig,omitempty"`
+	// batch transfers sending with this transaction.
+	Batch *BatchRequest `protobuf:"bytes,11,opt,name=batch" json:"batch,omitempty"`
+	// timelock action sending with this transaction.
+	Timelock *TimelockRequest `protobuf:"bytes,12,opt,name=timelock" json:"timelock,omitempty"`
+	// the last block height the transaction can be included in, 0 means no expiry.
+	ValidUntilHeight uint64 `protobuf:"varint,13,opt,name=valid_until_height,json=validUntilHeight,proto3" json:"valid_until_height,omitempty"`
+	// the last block timestamp the transaction can be included in, 0 means no expiry.
+	ValidUntil int64 `protobuf:"varint,14,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	// call on the state of the latest irreversible block instead of the tail.
+	FinalizedOnly bool `protobuf:"varint,15,opt,name=finalized_only,json=finalizedOnly,proto3" json:"finalized_only,omitempty"`
+	// Call and EstimateGas also return the state changes the transaction would make.
+	StateDiff bool `protobuf:"varint,16,opt,name=state_diff,json=stateDiff,proto3" json:"state_diff,omitempty"`
+	// vote of a validator to pause or unpause the contract at the receiver.
+	Pause *PauseRequest `protobuf:"bytes,17,opt,name=pause" json:"pause,omitempty"`
+}
+
+func (m *TransactionRequest) Reset()                    { *m = TransactionRequest{} }
+func (m *TransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*TransactionRequest) ProtoMessage()               {}
+func (*TransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{68} }
+
+func (m *TransactionRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TransactionRequest) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetContract() *ContractRequest {
+	if m != nil {
+		return m.Contract
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetCandidate() *CandidateRequest {
+	if m != nil {
+		return m.Candidate
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetDelegate() *DelegateRequest {
+	if m != nil {
+		return m.Delegate
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetMultisig() *MultisigRequest {
+	if m != nil {
+		return m.Multisig
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetBatch() *BatchRequest {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetTimelock() *TimelockRequest {
+	if m != nil {
+		return m.Timelock
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetValidUntilHeight() uint64 {
+	if m != nil {
+		return m.ValidUntilHeight
+	}
+	return 0
+}
+
+func (m *TransactionRequest) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+func (m *TransactionRequest) GetFinalizedOnly() bool {
+	if m != nil {
+		return m.FinalizedOnly
+	}
+	return false
+}
+
+func (m *TransactionRequest) GetStateDiff() bool {
+	if m != nil {
+		return m.StateDiff
+	}
+	return false
+}
+
+func (m *TransactionRequest) GetPause() *PauseRequest {
+	if m != nil {
+		return m.Pause
+	}
+	return nil
+}
+
+type ContractRequest struct {
+	// contract source code.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// contract source type, support javascript and typescript
+	SourceType string `protobuf:"bytes,2,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty"`
+	// call contract function name
+	Function string `protobuf:"bytes,3,opt,name=function,proto3" json:"function,omitempty"`
+	// the params of contract.
+	Args string `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+	// deploy the contract as upgradable by its deployer, contracts are immutable by default.
+	Upgradable bool `protobuf:"varint,5,opt,name=upgradable,proto3" json:"upgradable,omitempty"`
+	// replace the code of the contract at the receiver with the source, the storage is preserved.
+	Upgrade bool `protobuf:"varint,6,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	// verify the source as the original source of the contract at the receiver.
+	Verify bool `protobuf:"varint,7,opt,name=verify,proto3" json:"verify,omitempty"`
+	// compiler of the source to verify, empty if the contract code is the source itself.
+	Compiler string `protobuf:"bytes,8,opt,name=compiler,proto3" json:"compiler,omitempty"`
+	// version of the compiler.
+	CompilerVersion string `protobuf:"bytes,9,opt,name=compiler_version,json=compilerVersion,proto3" json:"compiler_version,omitempty"`
+}
+
+func (m *ContractRequest) Reset()                    { *m = ContractRequest{} }
+func (m *ContractRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContractRequest) ProtoMessage()               {}
+func (*ContractRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{69} }
+
+func (m *ContractRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ContractRequest) GetSourceType() string {
+	if m != nil {
+		return m.SourceType
+	}
+	return ""
+}
+
+func (m *ContractRequest) GetFunction() string {
+	if m != nil {
+		return m.Function
+	}
+	return ""
+}
+
+func (m *ContractRequest) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+func (m *ContractRequest) GetUpgradable() bool {
+	if m != nil {
+		return m.Upgradable
+	}
+	return false
+}
+
+func (m *ContractRequest) GetUpgrade() bool {
+	if m != nil {
+		return m.Upgrade
+	}
+	return false
+}
+
+func (m *ContractRequest) GetVerify() bool {
+	if m != nil {
+		return m.Verify
+	}
+	return false
+}
+
+func (m *ContractRequest) GetCompiler() string {
+	if m != nil {
+		return m.Compiler
+	}
+	return ""
+}
+
+func (m *ContractRequest) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+type CandidateRequest struct {
+	// candidate action.
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (m *CandidateRequest) Reset()                    { *m = CandidateRequest{} }
+func (m *CandidateRequest) String() string            { return proto.CompactTextString(m) }
+func (*CandidateRequest) ProtoMessage()               {}
+func (*CandidateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{70} }
+
+func (m *CandidateRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type DelegateRequest struct {
+	// delegate action.
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// delegatee.
+	Delegatee string `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+}
+
+func (m *DelegateRequest) Reset()                    { *m = DelegateRequest{} }
+func (m *DelegateRequest) String() string            { return proto.CompactTextString(m) }
+func (*DelegateRequest) ProtoMessage()               {}
+func (*DelegateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{71} }
+
+func (m *DelegateRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *DelegateRequest) GetDelegatee() string {
+	if m != nil {
+		return m.Delegatee
+	}
+	return ""
+}
+
+type MultisigRequest struct {
+	// the count of signatures required.
+	Threshold uint32 `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// Hex string of the signer account addresses.
+	Signers []string `protobuf:"bytes,2,rep,name=signers" json:"signers,omitempty"`
+	// Amount of value transferring from the multisig wallet.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// the signatures collected.
+	Signatures []*MultisigSignature `protobuf:"bytes,4,rep,name=signatures" json:"signatures,omitempty"`
+}
+
+func (m *MultisigRequest) Reset()                    { *m = MultisigRequest{} }
+func (m *MultisigRequest) String() string            { return proto.CompactTextString(m) }
+func (*MultisigRequest) ProtoMessage()               {}
+func (*MultisigRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{72} }
+
+func (m *MultisigRequest) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MultisigRequest) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *MultisigRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *MultisigRequest) GetSignatures() []*MultisigSignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+type BatchRequest struct {
+	// the transfers to the recipients.
+	Transfers []*BatchTransfer `protobuf:"bytes,1,rep,name=transfers" json:"transfers,omitempty"`
+}
+
+func (m *BatchRequest) Reset()                    { *m = BatchRequest{} }
+func (m *BatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*BatchRequest) ProtoMessage()               {}
+func (*BatchRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{73} }
+
+func (m *BatchRequest) GetTransfers() []*BatchTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+type TimelockRequest struct {
+	// timelock action, "lock" or "release".
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// Amount of value locked, released to the tx receiver.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// the value is locked until the height, 0 means no height condition.
+	UnlockHeight uint64 `protobuf:"varint,3,opt,name=unlock_height,json=unlockHeight,proto3" json:"unlock_height,omitempty"`
+	// the value is locked until the timestamp, 0 means no time condition.
+	UnlockTime int64 `protobuf:"varint,4,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty"`
+	// Hex string of the lock account address to release.
+	Lock string `protobuf:"bytes,5,opt,name=lock,proto3" json:"lock,omitempty"`
+}
+
+func (m *TimelockRequest) Reset()                    { *m = TimelockRequest{} }
+func (m *TimelockRequest) String() string            { return proto.CompactTextString(m) }
+func (*TimelockRequest) ProtoMessage()               {}
+func (*TimelockRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{74} }
+
+func (m *TimelockRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *TimelockRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TimelockRequest) GetUnlockHeight() uint64 {
+	if m != nil {
+		return m.UnlockHeight
+	}
+	return 0
+}
+
+func (m *TimelockRequest) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+func (m *TimelockRequest) GetLock() string {
+	if m != nil {
+		return m.Lock
+	}
+	return ""
+}
+
+type PauseRequest struct {
+	// pause action, "pause" or "unpause".
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (m *PauseRequest) Reset()                    { *m = PauseRequest{} }
+func (m *PauseRequest) String() string            { return proto.CompactTextString(m) }
+func (*PauseRequest) ProtoMessage()               {}
+func (*PauseRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{75} }
+
+func (m *PauseRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type BatchTransfer struct {
+	// Hex string of the recipient account address.
+	To string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	// Amount of value transferring to the recipient.
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *BatchTransfer) Reset()                    { *m = BatchTransfer{} }
+func (m *BatchTransfer) String() string            { return proto.CompactTextString(m) }
+func (*BatchTransfer) ProtoMessage()               {}
+func (*BatchTransfer) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{76} }
+
+func (m *BatchTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *BatchTransfer) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type MultisigSignature struct {
+	// Hex string of the signer account address.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// signature algorithm.
+	Alg uint32 `protobuf:"varint,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	// signature of the multisig payload hash.
+	Sign []byte `protobuf:"bytes,3,opt,name=sign,proto3" json:"sign,omitempty"`
+}
+
+func (m *MultisigSignature) Reset()                    { *m = MultisigSignature{} }
+func (m *MultisigSignature) String() string            { return proto.CompactTextString(m) }
+func (*MultisigSignature) ProtoMessage()               {}
+func (*MultisigSignature) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{77} }
+
+func (m *MultisigSignature) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MultisigSignature) GetAlg() uint32 {
+	if m != nil {
+		return m.Alg
+	}
+	return 0
+}
+
+func (m *MultisigSignature) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+// Request message of SendRawTransactionRequest rpc.
+type SendRawTransactionRequest struct {
+	// Signed data of transaction
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *SendRawTransactionRequest) Reset()                    { *m = SendRawTransactionRequest{} }
+func (m *SendRawTransactionRequest) String() string            { return proto.CompactTextString(m) }
+func (*SendRawTransactionRequest) ProtoMessage()               {}
+func (*SendRawTransactionRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{78} }
+
+func (m *SendRawTransactionRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// Response message of SendTransaction rpc.
+type SendTransactionResponse struct {
+	// Hex string of transaction hash.
+	Txhash          string `protobuf:"bytes,1,opt,name=txhash,proto3" json:"txhash,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Result          bool   `protobuf:"varint,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *SendTransactionResponse) Reset()                    { *m = SendTransactionResponse{} }
+func (m *SendTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*SendTransactionResponse) ProtoMessage()               {}
+func (*SendTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{79} }
+
+func (m *SendTransactionResponse) GetTxhash() string {
+	if m != nil {
+		return m.Txhash
+	}
+	return ""
+}
+
+func (m *SendTransactionResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *SendTransactionResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type SendRawTransactionsRequest struct {
+	// Signed data of transactions
+	Data [][]byte `protobuf:"bytes,1,rep,name=data" json:"data,omitempty"`
+}
+
+func (m *SendRawTransactionsRequest) Reset()                    { *m = SendRawTransactionsRequest{} }
+func (m *SendRawTransactionsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SendRawTransactionsRequest) ProtoMessage()               {}
+func (*SendRawTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{80} }
+
+func (m *SendRawTransactionsRequest) GetData() [][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type SendRawTransactionResult struct {
+	// Hex string of transaction hash.
+	Txhash          string `protobuf:"bytes,1,opt,name=txhash,proto3" json:"txhash,omitempty"`
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// Error of the transaction, empty if it's valid.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *SendRawTransactionResult) Reset()                    { *m = SendRawTransactionResult{} }
+func (m *SendRawTransactionResult) String() string            { return proto.CompactTextString(m) }
+func (*SendRawTransactionResult) ProtoMessage()               {}
+func (*SendRawTransactionResult) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{81} }
+
+func (m *SendRawTransactionResult) GetTxhash() string {
+	if m != nil {
+		return m.Txhash
+	}
+	return ""
+}
+
+func (m *SendRawTransactionResult) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *SendRawTransactionResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SendRawTransactionsResponse struct {
+	// Results in the order of request data.
+	Results []*SendRawTransactionResult `protobuf:"bytes,1,rep,name=results" json:"results,omitempty"`
+	// Whether the transactions are pushed into tx pool.
+	Accepted bool `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+}
+
+func (m *SendRawTransactionsResponse) Reset()                    { *m = SendRawTransactionsResponse{} }
+func (m *SendRawTransactionsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SendRawTransactionsResponse) ProtoMessage()               {}
+func (*SendRawTransactionsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{82} }
+
+func (m *SendRawTransactionsResponse) GetResults() []*SendRawTransactionResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *SendRawTransactionsResponse) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+// Request message of GetBlockByHash rpc.
+type GetBlockByHashRequest struct {
+	// Hex string of block hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// If true it returns the full transaction objects, if false only the hashes of the transactions.
+	FullTransaction bool `protobuf:"varint,2,opt,name=full_transaction,json=fullTransaction,proto3" json:"full_transaction,omitempty"`
+	// If true it returns the validators of the block's dynasty.
+	FullDpos bool `protobuf:"varint,3,opt,name=full_dpos,json=fullDpos,proto3" json:"full_dpos,omitempty"`
+	// If true it returns the block header without the transactions.
+	HeaderOnly bool `protobuf:"varint,4,opt,name=header_only,json=headerOnly,proto3" json:"header_only,omitempty"`
+}
+
+func (m *GetBlockByHashRequest) Reset()                    { *m = GetBlockByHashRequest{} }
+func (m *GetBlockByHashRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockByHashRequest) ProtoMessage()               {}
+func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{83} }
+
+func (m *GetBlockByHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetBlockByHashRequest) GetFullTransaction() bool {
+	if m != nil {
+		return m.FullTransaction
+	}
+	return false
+}
+
+func (m *GetBlockByHashRequest) GetFullDpos() bool {
+	if m != nil {
+		return m.FullDpos
+	}
+	return false
+}
+
+func (m *GetBlockByHashRequest) GetHeaderOnly() bool {
+	if m != nil {
+		return m.HeaderOnly
+	}
+	return false
+}
+
+// Request message of GetBlockByHeight rpc.
+type GetBlockByHeightRequest struct {
+	// block height.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// If true it returns the full transaction objects, if false only the hashes of the transactions.
+	FullTransaction bool `protobuf:"varint,2,opt,name=full_transaction,json=fullTransaction,proto3" json:"full_transaction,omitempty"`
+	// If true it returns the validators of the block's dynasty.
+	FullDpos bool `protobuf:"varint,3,opt,name=full_dpos,json=fullDpos,proto3" json:"full_dpos,omitempty"`
+	// If true it returns the block header without the transactions.
+	HeaderOnly bool `protobuf:"varint,4,opt,name=header_only,json=headerOnly,proto3" json:"header_only,omitempty"`
+	// If true the blocks above the latest irreversible block are not returned.
+	FinalizedOnly bool `protobuf:"varint,5,opt,name=finalized_only,json=finalizedOnly,proto3" json:"finalized_only,omitempty"`
+}
+
+func (m *GetBlockByHeightRequest) Reset()                    { *m = GetBlockByHeightRequest{} }
+func (m *GetBlockByHeightRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockByHeightRequest) ProtoMessage()               {}
+func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{84} }
+
+func (m *GetBlockByHeightRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetBlockByHeightRequest) GetFullTransaction() bool {
+	if m != nil {
+		return m.FullTransaction
+	}
+	return false
+}
+
+func (m *GetBlockByHeightRequest) GetFullDpos() bool {
+	if m != nil {
+		return m.FullDpos
+	}
+	return false
+}
+
+func (m *GetBlockByHeightRequest) GetHeaderOnly() bool {
+	if m != nil {
+		return m.HeaderOnly
+	}
+	return false
+}
+
+func (m *GetBlockByHeightRequest) GetFinalizedOnly() bool {
+	if m != nil {
+		return m.FinalizedOnly
+	}
+	return false
+}
+
+// Request message of GetBlockByTimestamp rpc.
+type GetBlockByTimestampRequest struct {
+	// block timestamp in seconds.
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// If true it returns the full transaction objects, if false only the hashes of the transactions.
+	FullTransaction bool `protobuf:"varint,2,opt,name=full_transaction,json=fullTransaction,proto3" json:"full_transaction,omitempty"`
+	// If true it returns the validators of the block's dynasty.
+	FullDpos bool `protobuf:"varint,3,opt,name=full_dpos,json=fullDpos,proto3" json:"full_dpos,omitempty"`
+	// If true it returns the block header without the transactions.
+	HeaderOnly bool `protobuf:"varint,4,opt,name=header_only,json=headerOnly,proto3" json:"header_only,omitempty"`
+}
+
+func (m *GetBlockByTimestampRequest) Reset()                    { *m = GetBlockByTimestampRequest{} }
+func (m *GetBlockByTimestampRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetBlockByTimestampRequest) ProtoMessage()               {}
+func (*GetBlockByTimestampRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{85} }
+
+func (m *GetBlockByTimestampRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *GetBlockByTimestampRequest) GetFullTransaction() bool {
+	if m != nil {
+		return m.FullTransaction
+	}
+	return false
+}
+
+func (m *GetBlockByTimestampRequest) GetFullDpos() bool {
+	if m != nil {
+		return m.FullDpos
+	}
+	return false
+}
+
+func (m *GetBlockByTimestampRequest) GetHeaderOnly() bool {
+	if m != nil {
+		return m.HeaderOnly
+	}
+	return false
+}
+
+// Request message of GetTransactionByHash rpc.
+type GetTransactionByHashRequest struct {
+	// Hex string of transaction hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *GetTransactionByHashRequest) Reset()                    { *m = GetTransactionByHashRequest{} }
+func (m *GetTransactionByHashRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetTransactionByHashRequest) ProtoMessage()               {}
+func (*GetTransactionByHashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{86} }
+
+func (m *GetTransactionByHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// Request message of BlockDump.
+type BlockDumpRequest struct {
+	// the count of blocks to dump before current tail.
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *BlockDumpRequest) Reset()                    { *m = BlockDumpRequest{} }
+func (m *BlockDumpRequest) String() string            { return proto.CompactTextString(m) }
+func (*BlockDumpRequest) ProtoMessage()               {}
+func (*BlockDumpRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{87} }
+
+func (m *BlockDumpRequest) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// Response message of BlockDump.
+type BlockDumpResponse struct {
+	// block dump info.
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *BlockDumpResponse) Reset()                    { *m = BlockDumpResponse{} }
+func (m *BlockDumpResponse) String() string            { return proto.CompactTextString(m) }
+func (*BlockDumpResponse) ProtoMessage()               {}
+func (*BlockDumpResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{88} }
+
+func (m *BlockDumpResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// Response message of Block.
+type BlockResponse struct {
+	// Hex string of block hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of block parent hash.
+	ParentHash string `protobuf:"bytes,2,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	// block height
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// block nonce
+	Nonce uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// Hex string of coinbase address.
+	Coinbase string `protobuf:"bytes,5,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+	// Hex string of miner address.
+	Miner string `protobuf:"bytes,6,opt,name=miner,proto3" json:"miner,omitempty"`
+	// block timestamp.
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// block chain id
+	ChainId uint32 `protobuf:"varint,8,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Hex string of state root.
+	StateRoot string `protobuf:"bytes,11,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// Hex string of txs root.
+	TxsRoot string `protobuf:"bytes,12,opt,name=txs_root,json=txsRoot,proto3" json:"txs_root,omitempty"`
+	// Hex string of event root.
+	EventsRoot string `protobuf:"bytes,13,opt,name=events_root,json=eventsRoot,proto3" json:"events_root,omitempty"`
+	// dpos context
+	DposContext *DposContext `protobuf:"bytes,14,opt,name=dpos_context,json=dposContext" json:"dpos_context,omitempty"`
+	// block size in bytes.
+	Size_ uint64 `protobuf:"varint,15,opt,name=size,proto3" json:"size,omitempty"`
+	// count of transactions in the block.
+	TxCount uint64 `protobuf:"varint,16,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// count of blocks on canonical chain from the block to the tail, 0 if not on canonical chain.
+	Confirmations uint64 `protobuf:"varint,17,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// whether the block is at or below the latest irreversible block on canonical chain.
+	IsIrreversible bool `protobuf:"varint,18,opt,name=is_irreversible,json=isIrreversible,proto3" json:"is_irreversible,omitempty"`
+	// transaction slice
+	Transactions []*TransactionResponse `protobuf:"bytes,100,rep,name=transactions" json:"transactions,omitempty"`
+}
+
+func (m *BlockResponse) Reset()                    { *m = BlockResponse{} }
+func (m *BlockResponse) String() string            { return proto.CompactTextString(m) }
+func (*BlockResponse) ProtoMessage()               {}
+func (*BlockResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{89} }
+
+func (m *BlockResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetParentHash() string {
+	if m != nil {
+		return m.ParentHash
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetCoinbase() string {
+	if m != nil {
+		return m.Coinbase
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetTxsRoot() string {
+	if m != nil {
+		return m.TxsRoot
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetEventsRoot() string {
+	if m != nil {
+		return m.EventsRoot
+	}
+	return ""
+}
+
+func (m *BlockResponse) GetDposContext() *DposContext {
+	if m != nil {
+		return m.DposContext
+	}
+	return nil
+}
+
+func (m *BlockResponse) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *BlockResponse) GetIsIrreversible() bool {
+	if m != nil {
+		return m.IsIrreversible
+	}
+	return false
+}
+
+func (m *BlockResponse) GetTransactions() []*TransactionResponse {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+// dpos context
+type DposContext struct {
+	// dynasty root
+	DynastyRoot string `protobuf:"bytes,1,opt,name=dynasty_root,json=dynastyRoot,proto3" json:"dynasty_root,omitempty"`
+	// next dynasty
+	NextDynastyRoot string `protobuf:"bytes,2,opt,name=next_dynasty_root,json=nextDynastyRoot,proto3" json:"next_dynasty_root,omitempty"`
+	// delegate root
+	DelegateRoot string `protobuf:"bytes,3,opt,name=delegate_root,json=delegateRoot,proto3" json:"delegate_root,omitempty"`
+	// candidate root
+	CandidateRoot string `protobuf:"bytes,4,opt,name=candidate_root,json=candidateRoot,proto3" json:"candidate_root,omitempty"`
+	// vote root
+	VoteRoot string `protobuf:"bytes,5,opt,name=vote_root,json=voteRoot,proto3" json:"vote_root,omitempty"`
+	// mint cnt root
+	MintCntRoot string `protobuf:"bytes,6,opt,name=mint_cnt_root,json=mintCntRoot,proto3" json:"mint_cnt_root,omitempty"`
+	// Hex string of the dynasty validators in slot order, only returned if full_dpos is true.
+	Dynasty []string `protobuf:"bytes,7,rep,name=dynasty" json:"dynasty,omitempty"`
+}
+
+func (m *DposContext) Reset()                    { *m = DposContext{} }
+func (m *DposContext) String() string            { return proto.CompactTextString(m) }
+func (*DposContext) ProtoMessage()               {}
+func (*DposContext) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{90} }
+
+func (m *DposContext) GetDynastyRoot() string {
+	if m != nil {
+		return m.DynastyRoot
+	}
+	return ""
+}
+
+func (m *DposContext) GetNextDynastyRoot() string {
+	if m != nil {
+		return m.NextDynastyRoot
+	}
+	return ""
+}
+
+func (m *DposContext) GetDelegateRoot() string {
+	if m != nil {
+		return m.DelegateRoot
+	}
+	return ""
+}
+
+func (m *DposContext) GetCandidateRoot() string {
+	if m != nil {
+		return m.CandidateRoot
+	}
+	return ""
+}
+
+func (m *DposContext) GetVoteRoot() string {
+	if m != nil {
+		return m.VoteRoot
+	}
+	return ""
+}
+
+func (m *DposContext) GetMintCntRoot() string {
+	if m != nil {
+		return m.MintCntRoot
+	}
+	return ""
+}
+
+func (m *DposContext) GetDynasty() []string {
+	if m != nil {
+		return m.Dynasty
+	}
+	return nil
+}
+
+// Response message of TransactionReceipt.
+type TransactionResponse struct {
+	// Hex string of tx hash.
+	Hash    string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ChainId uint32 `protobuf:"varint,2,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	// Hex string of the sender account addresss.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Hex string of the receiver account addresss.
+	To    string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Value string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	// Transaction nonce.
+	Nonce           uint64 `protobuf:"varint,6,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Timestamp       int64  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type            string `protobuf:"bytes,8,opt,name=type,proto3" json:"type,omitempty"`
+	Data            []byte `protobuf:"bytes,9,opt,name=data,proto3" json:"data,omitempty"`
+	GasPrice        string `protobuf:"bytes,10,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	GasLimit        string `protobuf:"bytes,11,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	ContractAddress string `protobuf:"bytes,12,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// transaction status 0 failed, 1 success, 2 pending
+	Status uint32 `protobuf:"varint,13,opt,name=status,proto3" json:"status,omitempty"`
+	// transaction execution error.
+	ExecuteError string `protobuf:"bytes,14,opt,name=execute_error,json=executeError,proto3" json:"execute_error,omitempty"`
+	// gas used by the transaction, empty if pending.
+	GasUsed string `protobuf:"bytes,15,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// count of blocks on canonical chain from the block including the transaction to the tail.
+	Confirmations uint64 `protobuf:"varint,16,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	// whether the block including the transaction is irreversible.
+	IsIrreversible bool `protobuf:"varint,17,opt,name=is_irreversible,json=isIrreversible,proto3" json:"is_irreversible,omitempty"`
+	// the last block height the transaction can be included in, 0 means no expiry.
+	ValidUntilHeight uint64 `protobuf:"varint,18,opt,name=valid_until_height,json=validUntilHeight,proto3" json:"valid_until_height,omitempty"`
+	// the last block timestamp the transaction can be included in, 0 means no expiry.
+	ValidUntil int64 `protobuf:"varint,19,opt,name=valid_until,json=validUntil,proto3" json:"valid_until,omitempty"`
+	// gas refunded for the contract storage deleted by the transaction, deducted from gas_used.
+	GasRefund string `protobuf:"bytes,20,opt,name=gas_refund,json=gasRefund,proto3" json:"gas_refund,omitempty"`
+}
+
+func (m *TransactionResponse) Reset()                    { *m = TransactionResponse{} }
+func (m *TransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*TransactionResponse) ProtoMessage()               {}
+func (*TransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{91} }
+
+func (m *TransactionResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetChainId() uint32 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *TransactionResponse) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetExecuteError() string {
+	if m != nil {
+		return m.ExecuteError
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetGasUsed() string {
+	if m != nil {
+		return m.GasUsed
+	}
+	return ""
+}
+
+func (m *TransactionResponse) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetIsIrreversible() bool {
+	if m != nil {
+		return m.IsIrreversible
+	}
+	return false
+}
+
+func (m *TransactionResponse) GetValidUntilHeight() uint64 {
+	if m != nil {
+		return m.ValidUntilHeight
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetValidUntil() int64 {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+func (m *TransactionResponse) GetGasRefund() string {
+	if m != nil {
+		return m.GasRefund
+	}
+	return ""
+}
+
+type NewAccountRequest struct {
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *NewAccountRequest) Reset()                    { *m = NewAccountRequest{} }
+func (m *NewAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewAccountRequest) ProtoMessage()               {}
+func (*NewAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{92} }
+
+func (m *NewAccountRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type NewAccountResponse struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *NewAccountResponse) Reset()                    { *m = NewAccountResponse{} }
+func (m *NewAccountResponse) String() string            { return proto.CompactTextString(m) }
+func (*NewAccountResponse) ProtoMessage()               {}
+func (*NewAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{93} }
+
+func (m *NewAccountResponse) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type UnlockAccountRequest struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Duration   uint64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *UnlockAccountRequest) Reset()                    { *m = UnlockAccountRequest{} }
+func (m *UnlockAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnlockAccountRequest) ProtoMessage()               {}
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{94} }
+
+func (m *UnlockAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UnlockAccountRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+func (m *UnlockAccountRequest) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type UnlockAccountResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *UnlockAccountResponse) Reset()                    { *m = UnlockAccountResponse{} }
+func (m *UnlockAccountResponse) String() string            { return proto.CompactTextString(m) }
+func (*UnlockAccountResponse) ProtoMessage()               {}
+func (*UnlockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{95} }
+
+func (m *UnlockAccountResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type LockAccountRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *LockAccountRequest) Reset()                    { *m = LockAccountRequest{} }
+func (m *LockAccountRequest) String() string            { return proto.CompactTextString(m) }
+func (*LockAccountRequest) ProtoMessage()               {}
+func (*LockAccountRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{96} }
+
+func (m *LockAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type LockAccountResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *LockAccountResponse) Reset()                    { *m = LockAccountResponse{} }
+func (m *LockAccountResponse) String() string            { return proto.CompactTextString(m) }
+func (*LockAccountResponse) ProtoMessage()               {}
+func (*LockAccountResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{97} }
+
+func (m *LockAccountResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type SignTransactionResponse struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *SignTransactionResponse) Reset()                    { *m = SignTransactionResponse{} }
+func (m *SignTransactionResponse) String() string            { return proto.CompactTextString(m) }
+func (*SignTransactionResponse) ProtoMessage()               {}
+func (*SignTransactionResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{98} }
+
+func (m *SignTransactionResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type SendTransactionPassphraseRequest struct {
+	// transaction struct
+	Transaction *TransactionRequest `protobuf:"bytes,1,opt,name=transaction" json:"transaction,omitempty"`
+	// from account passphrase
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *SendTransactionPassphraseRequest) Reset()         { *m = SendTransactionPassphraseRequest{} }
+func (m *SendTransactionPassphraseRequest) String() string { return proto.CompactTextString(m) }
+func (*SendTransactionPassphraseRequest) ProtoMessage()    {}
+func (*SendTransactionPassphraseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{99}
+}
+
+func (m *SendTransactionPassphraseRequest) GetTransaction() *TransactionRequest {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *SendTransactionPassphraseRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type SendTransactionPassphraseResponse struct {
+	// Hex string of transaction hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *SendTransactionPassphraseResponse) Reset()         { *m = SendTransactionPassphraseResponse{} }
+func (m *SendTransactionPassphraseResponse) String() string { return proto.CompactTextString(m) }
+func (*SendTransactionPassphraseResponse) ProtoMessage()    {}
+func (*SendTransactionPassphraseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{100}
+}
+
+func (m *SendTransactionPassphraseResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type SignMultisigTransactionRequest struct {
+	// Hex string of the multisig payload hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of the signer account address.
+	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	// signer account passphrase.
+	Passphrase string `protobuf:"bytes,3,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *SignMultisigTransactionRequest) Reset()         { *m = SignMultisigTransactionRequest{} }
+func (m *SignMultisigTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SignMultisigTransactionRequest) ProtoMessage()    {}
+func (*SignMultisigTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{101}
+}
+
+func (m *SignMultisigTransactionRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SignMultisigTransactionRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *SignMultisigTransactionRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type SendMultisigTransactionRequest struct {
+	// Hex string of the multisig payload hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// from account passphrase.
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *SendMultisigTransactionRequest) Reset()         { *m = SendMultisigTransactionRequest{} }
+func (m *SendMultisigTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*SendMultisigTransactionRequest) ProtoMessage()    {}
+func (*SendMultisigTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{102}
+}
+
+func (m *SendMultisigTransactionRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SendMultisigTransactionRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type MultisigTransactionResponse struct {
+	// Hex string of the multisig payload hash signed by the signers.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Hex string of the multisig wallet address.
+	Wallet string `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	// the transaction with signatures collected.
+	Transaction *TransactionRequest `protobuf:"bytes,3,opt,name=transaction" json:"transaction,omitempty"`
+	// whether the signatures reach the threshold.
+	Ready bool `protobuf:"varint,4,opt,name=ready,proto3" json:"ready,omitempty"`
+}
+
+func (m *MultisigTransactionResponse) Reset()         { *m = MultisigTransactionResponse{} }
+func (m *MultisigTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*MultisigTransactionResponse) ProtoMessage()    {}
+func (*MultisigTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorRpc, []int{103}
+}
+
+func (m *MultisigTransactionResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MultisigTransactionResponse) GetWallet() string {
+	if m != nil {
+		return m.Wallet
+	}
+	return ""
+}
+
+func (m *MultisigTransactionResponse) GetTransaction() *TransactionRequest {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *MultisigTransactionResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+type GasPriceResponse struct {
+	GasPrice string `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+}
+
+func (m *GasPriceResponse) Reset()                    { *m = GasPriceResponse{} }
+func (m *GasPriceResponse) String() string            { return proto.CompactTextString(m) }
+func (*GasPriceResponse) ProtoMessage()               {}
+func (*GasPriceResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{104} }
+
+func (m *GasPriceResponse) GetGasPrice() string {
+	if m != nil {
+		return m.GasPrice
+	}
+	return ""
+}
+
+// Request message of GetTransactionByHash rpc.
+type HashRequest struct {
+	// Hex string of block/transaction hash.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *HashRequest) Reset()                    { *m = HashRequest{} }
+func (m *HashRequest) String() string            { return proto.CompactTextString(m) }
+func (*HashRequest) ProtoMessage()               {}
+func (*HashRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{105} }
+
+func (m *HashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type GasResponse struct {
+	Gas string `protobuf:"bytes,1,opt,name=gas,proto3" json:"gas,omitempty"`
+	// state changes of the transaction if state_diff is requested in EstimateGas.
+	StateDiff *StateDiff `protobuf:"bytes,2,opt,name=state_diff,json=stateDiff" json:"state_diff,omitempty"`
+}
+
+func (m *GasResponse) Reset()                    { *m = GasResponse{} }
+func (m *GasResponse) String() string            { return proto.CompactTextString(m) }
+func (*GasResponse) ProtoMessage()               {}
+func (*GasResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{106} }
+
+func (m *GasResponse) GetGas() string {
+	if m != nil {
+		return m.Gas
+	}
+	return ""
+}
+
+func (m *GasResponse) GetStateDiff() *StateDiff {
+	if m != nil {
+		return m.StateDiff
+	}
+	return nil
+}
+
+type EventsResponse struct {
+	Events []*Event `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+}
+
+func (m *EventsResponse) Reset()                    { *m = EventsResponse{} }
+func (m *EventsResponse) String() string            { return proto.CompactTextString(m) }
+func (*EventsResponse) ProtoMessage()               {}
+func (*EventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{107} }
+
+func (m *EventsResponse) GetEvents() []*Event {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type Event struct {
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// event data in JSON validated by the schema registered for topic.
+	Decoded string `protobuf:"bytes,3,opt,name=decoded,proto3" json:"decoded,omitempty"`
+	// schema validation error of event data.
+	SchemaError string `protobuf:"bytes,4,opt,name=schema_error,json=schemaError,proto3" json:"schema_error,omitempty"`
+	// indexed params of contract event.
+	Indexed []string `protobuf:"bytes,5,rep,name=indexed" json:"indexed,omitempty"`
+}
+
+func (m *Event) Reset()                    { *m = Event{} }
+func (m *Event) String() string            { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()               {}
+func (*Event) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{108} }
+
+func (m *Event) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *Event) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *Event) GetDecoded() string {
+	if m != nil {
+		return m.Decoded
+	}
+	return ""
+}
+
+func (m *Event) GetSchemaError() string {
+	if m != nil {
+		return m.SchemaError
+	}
+	return ""
+}
+
+func (m *Event) GetIndexed() []string {
+	if m != nil {
+		return m.Indexed
+	}
+	return nil
+}
+
+type EventCursorRequest struct {
+	// consumer id of the cursor.
+	Consumer string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// max count of events returned, default is 100.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *EventCursorRequest) Reset()                    { *m = EventCursorRequest{} }
+func (m *EventCursorRequest) String() string            { return proto.CompactTextString(m) }
+func (*EventCursorRequest) ProtoMessage()               {}
+func (*EventCursorRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{109} }
+
+func (m *EventCursorRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *EventCursorRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type CursorEvent struct {
+	// height of the block containing the event.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// index of the event in the block.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Hex string of the tx hash.
+	TxHash string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Event  *Event `protobuf:"bytes,4,opt,name=event" json:"event,omitempty"`
+}
+
+func (m *CursorEvent) Reset()                    { *m = CursorEvent{} }
+func (m *CursorEvent) String() string            { return proto.CompactTextString(m) }
+func (*CursorEvent) ProtoMessage()               {}
+func (*CursorEvent) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{110} }
+
+func (m *CursorEvent) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CursorEvent) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *CursorEvent) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *CursorEvent) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type EventCursorResponse struct {
+	Events []*CursorEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	// cursor to commit after the events are processed.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Index  uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *EventCursorResponse) Reset()                    { *m = EventCursorResponse{} }
+func (m *EventCursorResponse) String() string            { return proto.CompactTextString(m) }
+func (*EventCursorResponse) ProtoMessage()               {}
+func (*EventCursorResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{111} }
+
+func (m *EventCursorResponse) GetEvents() []*CursorEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *EventCursorResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventCursorResponse) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type FilterEventsRequest struct {
+	// first block height to scan, default is the genesis block.
+	FromHeight uint64 `protobuf:"varint,1,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	// last block height to scan, default is the tail block.
+	ToHeight uint64 `protobuf:"varint,2,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// topics of the events, empty matches all topics.
+	Topics []string `protobuf:"bytes,3,rep,name=topics" json:"topics,omitempty"`
+	// contract addresses emitting the events, empty matches all contracts.
+	Contracts []string `protobuf:"bytes,4,rep,name=contracts" json:"contracts,omitempty"`
+	// max count of events returned, default is 100.
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// indexed params expected at their positions, empty matches any param.
+	Indexed []string `protobuf:"bytes,6,rep,name=indexed" json:"indexed,omitempty"`
+}
+
+func (m *FilterEventsRequest) Reset()                    { *m = FilterEventsRequest{} }
+func (m *FilterEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*FilterEventsRequest) ProtoMessage()               {}
+func (*FilterEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{112} }
+
+func (m *FilterEventsRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *FilterEventsRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *FilterEventsRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *FilterEventsRequest) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *FilterEventsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *FilterEventsRequest) GetIndexed() []string {
+	if m != nil {
+		return m.Indexed
+	}
+	return nil
+}
+
+type FilterEventsResponse struct {
+	Events []*CursorEvent `protobuf:"bytes,1,rep,name=events" json:"events,omitempty"`
+	// height of the next block to scan, greater than to_height if the scan is done.
+	NextHeight uint64 `protobuf:"varint,2,opt,name=next_height,json=nextHeight,proto3" json:"next_height,omitempty"`
+}
+
+func (m *FilterEventsResponse) Reset()                    { *m = FilterEventsResponse{} }
+func (m *FilterEventsResponse) String() string            { return proto.CompactTextString(m) }
+func (*FilterEventsResponse) ProtoMessage()               {}
+func (*FilterEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{113} }
+
+func (m *FilterEventsResponse) GetEvents() []*CursorEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *FilterEventsResponse) GetNextHeight() uint64 {
+	if m != nil {
+		return m.NextHeight
+	}
+	return 0
+}
+
+type CommitEventCursorRequest struct {
+	// consumer id of the cursor.
+	Consumer string `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	// position of the last acknowledged event.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Index  uint32 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *CommitEventCursorRequest) Reset()                    { *m = CommitEventCursorRequest{} }
+func (m *CommitEventCursorRequest) String() string            { return proto.CompactTextString(m) }
+func (*CommitEventCursorRequest) ProtoMessage()               {}
+func (*CommitEventCursorRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{114} }
+
+func (m *CommitEventCursorRequest) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *CommitEventCursorRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *CommitEventCursorRequest) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type CommitEventCursorResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *CommitEventCursorResponse) Reset()                    { *m = CommitEventCursorResponse{} }
+func (m *CommitEventCursorResponse) String() string            { return proto.CompactTextString(m) }
+func (*CommitEventCursorResponse) ProtoMessage()               {}
+func (*CommitEventCursorResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{115} }
+
+func (m *CommitEventCursorResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type StartMiningRequest struct {
+	// miner address passphrase
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (m *StartMiningRequest) Reset()                    { *m = StartMiningRequest{} }
+func (m *StartMiningRequest) String() string            { return proto.CompactTextString(m) }
+func (*StartMiningRequest) ProtoMessage()               {}
+func (*StartMiningRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{116} }
+
+func (m *StartMiningRequest) GetPassphrase() string {
+	if m != nil {
+		return m.Passphrase
+	}
+	return ""
+}
+
+type MiningResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *MiningResponse) Reset()                    { *m = MiningResponse{} }
+func (m *MiningResponse) String() string            { return proto.CompactTextString(m) }
+func (*MiningResponse) ProtoMessage()               {}
+func (*MiningResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{117} }
+
+func (m *MiningResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type RegisterWebhookRequest struct {
+	// HTTPS url the events are posted to.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Topics of the events to post, all topics if empty.
+	Topics []string `protobuf:"bytes,2,rep,name=topics" json:"topics,omitempty"`
+	// Contract addresses of the events to post, all events if empty.
+	Contracts []string `protobuf:"bytes,3,rep,name=contracts" json:"contracts,omitempty"`
+	// Secret to sign the posted body with HMAC-SHA256.
+	Secret string `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (m *RegisterWebhookRequest) Reset()                    { *m = RegisterWebhookRequest{} }
+func (m *RegisterWebhookRequest) String() string            { return proto.CompactTextString(m) }
+func (*RegisterWebhookRequest) ProtoMessage()               {}
+func (*RegisterWebhookRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{118} }
+
+func (m *RegisterWebhookRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *RegisterWebhookRequest) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *RegisterWebhookRequest) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *RegisterWebhookRequest) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type RegisterWebhookResponse struct {
+	// id of the webhook.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *RegisterWebhookResponse) Reset()                    { *m = RegisterWebhookResponse{} }
+func (m *RegisterWebhookResponse) String() string            { return proto.CompactTextString(m) }
+func (*RegisterWebhookResponse) ProtoMessage()               {}
+func (*RegisterWebhookResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{119} }
+
+func (m *RegisterWebhookResponse) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UnregisterWebhookRequest struct {
+	// id of the webhook.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *UnregisterWebhookRequest) Reset()                    { *m = UnregisterWebhookRequest{} }
+func (m *UnregisterWebhookRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnregisterWebhookRequest) ProtoMessage()               {}
+func (*UnregisterWebhookRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{120} }
+
+func (m *UnregisterWebhookRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UnregisterWebhookResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *UnregisterWebhookResponse) Reset()                    { *m = UnregisterWebhookResponse{} }
+func (m *UnregisterWebhookResponse) String() string            { return proto.CompactTextString(m) }
+func (*UnregisterWebhookResponse) ProtoMessage()               {}
+func (*UnregisterWebhookResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{121} }
+
+func (m *UnregisterWebhookResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type PprofRequest struct {
+	// listen address of pprof server, default is 127.0.0.1:6060.
+	Listen string `protobuf:"bytes,1,opt,name=listen,proto3" json:"listen,omitempty"`
+}
+
+func (m *PprofRequest) Reset()                    { *m = PprofRequest{} }
+func (m *PprofRequest) String() string            { return proto.CompactTextString(m) }
+func (*PprofRequest) ProtoMessage()               {}
+func (*PprofRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{122} }
+
+func (m *PprofRequest) GetListen() string {
+	if m != nil {
+		return m.Listen
+	}
+	return ""
+}
+
+type PprofResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+	// listen address of the started pprof server.
+	Listen string `protobuf:"bytes,2,opt,name=listen,proto3" json:"listen,omitempty"`
+}
+
+func (m *PprofResponse) Reset()                    { *m = PprofResponse{} }
+func (m *PprofResponse) String() string            { return proto.CompactTextString(m) }
+func (*PprofResponse) ProtoMessage()               {}
+func (*PprofResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{123} }
+
+func (m *PprofResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+func (m *PprofResponse) GetListen() string {
+	if m != nil {
+		return m.Listen
+	}
+	return ""
+}
+
+type LogLevelRequest struct {
+	// package name of the module, e.g. "core" or "p2p", all modules if empty.
+	Module string `protobuf:"bytes,1,opt,name=module,proto3" json:"module,omitempty"`
+	// log level, one of debug, info, warn, error, fatal and panic. The module level is removed if empty.
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (m *LogLevelRequest) Reset()                    { *m = LogLevelRequest{} }
+func (m *LogLevelRequest) String() string            { return proto.CompactTextString(m) }
+func (*LogLevelRequest) ProtoMessage()               {}
+func (*LogLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{124} }
+
+func (m *LogLevelRequest) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *LogLevelRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+type LogLevelResponse struct {
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *LogLevelResponse) Reset()                    { *m = LogLevelResponse{} }
+func (m *LogLevelResponse) String() string            { return proto.CompactTextString(m) }
+func (*LogLevelResponse) ProtoMessage()               {}
+func (*LogLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{125} }
+
+func (m *LogLevelResponse) GetResult() bool {
+	if m != nil {
+		return m.Result
+	}
+	return false
+}
+
+type GetConfigResponse struct {
+	// JSON of the effective config, the secrets are redacted.
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (m *GetConfigResponse) Reset()                    { *m = GetConfigResponse{} }
+func (m *GetConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetConfigResponse) ProtoMessage()               {}
+func (*GetConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{126} }
+
+func (m *GetConfigResponse) GetConfig() string {
+	if m != nil {
+		return m.Config
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PeerAccessControlRequest)(nil), "rpcpb.PeerAccessControlRequest")
+	proto.RegisterType((*PeerAccessControlResponse)(nil), "rpcpb.PeerAccessControlResponse")
+	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
+	proto.RegisterType((*SubscribeTransactionRequest)(nil), "rpcpb.SubscribeTransactionRequest")
+	proto.RegisterType((*TransactionStatusResponse)(nil), "rpcpb.TransactionStatusResponse")
 	proto.RegisterType((*ChangeNetworkIDRequest)(nil), "rpcpb.ChangeNetworkIDRequest")
 	proto.RegisterType((*ChangeNetworkIDResponse)(nil), "rpcpb.ChangeNetworkIDResponse")
 	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
 	proto.RegisterType((*NonParamsRequest)(nil), "rpcpb.NonParamsRequest")
 	proto.RegisterType((*NodeInfoResponse)(nil), "rpcpb.NodeInfoResponse")
+	proto.RegisterType((*PeerScore)(nil), "rpcpb.PeerScore")
+	proto.RegisterType((*NATStatus)(nil), "rpcpb.NATStatus")
+	proto.RegisterType((*NATMapping)(nil), "rpcpb.NATMapping")
 	proto.RegisterType((*StatisticsNodeInfoResponse)(nil), "rpcpb.StatisticsNodeInfoResponse")
 	proto.RegisterType((*RouteTable)(nil), "rpcpb.RouteTable")
 	proto.RegisterType((*GetNebStateResponse)(nil), "rpcpb.GetNebStateResponse")
+	proto.RegisterType((*SyncStatusResponse)(nil), "rpcpb.SyncStatusResponse")
+	proto.RegisterType((*AccountsRequest)(nil), "rpcpb.AccountsRequest")
+	proto.RegisterType((*AccountInfo)(nil), "rpcpb.AccountInfo")
 	proto.RegisterType((*AccountsResponse)(nil), "rpcpb.AccountsResponse")
 	proto.RegisterType((*GetAccountStateRequest)(nil), "rpcpb.GetAccountStateRequest")
 	proto.RegisterType((*GetAccountStateResponse)(nil), "rpcpb.GetAccountStateResponse")
+	proto.RegisterType((*GetContractStateRequest)(nil), "rpcpb.GetContractStateRequest")
+	proto.RegisterType((*GetContractStateResponse)(nil), "rpcpb.GetContractStateResponse")
+	proto.RegisterType((*GetContractAddressRequest)(nil), "rpcpb.GetContractAddressRequest")
+	proto.RegisterType((*GetContractAddressResponse)(nil), "rpcpb.GetContractAddressResponse")
+	proto.RegisterType((*AccountHistoryRequest)(nil), "rpcpb.AccountHistoryRequest")
+	proto.RegisterType((*BalanceChange)(nil), "rpcpb.BalanceChange")
+	proto.RegisterType((*AccountHistoryResponse)(nil), "rpcpb.AccountHistoryResponse")
+	proto.RegisterType((*GetContractMetadataRequest)(nil), "rpcpb.GetContractMetadataRequest")
+	proto.RegisterType((*GetContractMetadataResponse)(nil), "rpcpb.GetContractMetadataResponse")
+	proto.RegisterType((*TokenBalanceRequest)(nil), "rpcpb.TokenBalanceRequest")
+	proto.RegisterType((*TokenBalanceResponse)(nil), "rpcpb.TokenBalanceResponse")
+	proto.RegisterType((*TokenMetadataRequest)(nil), "rpcpb.TokenMetadataRequest")
+	proto.RegisterType((*TokenMetadataResponse)(nil), "rpcpb.TokenMetadataResponse")
+	proto.RegisterType((*GetAccountStateProofResponse)(nil), "rpcpb.GetAccountStateProofResponse")
+	proto.RegisterType((*MerkleProofNode)(nil), "rpcpb.MerkleProofNode")
+	proto.RegisterType((*ChainStatsRequest)(nil), "rpcpb.ChainStatsRequest")
+	proto.RegisterType((*ChainStatsResponse)(nil), "rpcpb.ChainStatsResponse")
+	proto.RegisterType((*MinerStatsRequest)(nil), "rpcpb.MinerStatsRequest")
+	proto.RegisterType((*MinerStats)(nil), "rpcpb.MinerStats")
+	proto.RegisterType((*MinerStatsResponse)(nil), "rpcpb.MinerStatsResponse")
+	proto.RegisterType((*TotalSupplyRequest)(nil), "rpcpb.TotalSupplyRequest")
+	proto.RegisterType((*TotalSupplyResponse)(nil), "rpcpb.TotalSupplyResponse")
 	proto.RegisterType((*CallResponse)(nil), "rpcpb.CallResponse")
+	proto.RegisterType((*StateDiff)(nil), "rpcpb.StateDiff")
+	proto.RegisterType((*BalanceDelta)(nil), "rpcpb.BalanceDelta")
+	proto.RegisterType((*StorageWrite)(nil), "rpcpb.StorageWrite")
 	proto.RegisterType((*ByBlockHeightRequest)(nil), "rpcpb.ByBlockHeightRequest")
 	proto.RegisterType((*GetCandidatesResponse)(nil), "rpcpb.GetCandidatesResponse")
 	proto.RegisterType((*GetDynastyResponse)(nil), "rpcpb.GetDynastyResponse")
+	proto.RegisterType((*GetTransactionDependencyResponse)(nil), "rpcpb.GetTransactionDependencyResponse")
+	proto.RegisterType((*TransactionDependency)(nil), "rpcpb.TransactionDependency")
+	proto.RegisterType((*IterateAccountsRequest)(nil), "rpcpb.IterateAccountsRequest")
+	proto.RegisterType((*AccountEntry)(nil), "rpcpb.AccountEntry")
+	proto.RegisterType((*GetForksRequest)(nil), "rpcpb.GetForksRequest")
+	proto.RegisterType((*GetForksResponse)(nil), "rpcpb.GetForksResponse")
+	proto.RegisterType((*Fork)(nil), "rpcpb.Fork")
+	proto.RegisterType((*ContractStatsRequest)(nil), "rpcpb.ContractStatsRequest")
+	proto.RegisterType((*ContractStatsResponse)(nil), "rpcpb.ContractStatsResponse")
+	proto.RegisterType((*ContractStats)(nil), "rpcpb.ContractStats")
+	proto.RegisterType((*VoteSnapshotResponse)(nil), "rpcpb.VoteSnapshotResponse")
+	proto.RegisterType((*CandidateVotes)(nil), "rpcpb.CandidateVotes")
+	proto.RegisterType((*Delegation)(nil), "rpcpb.Delegation")
+	proto.RegisterType((*DebugResponse)(nil), "rpcpb.DebugResponse")
+	proto.RegisterType((*TraceStep)(nil), "rpcpb.TraceStep")
 	proto.RegisterType((*GetDelegateVotersRequest)(nil), "rpcpb.GetDelegateVotersRequest")
 	proto.RegisterType((*GetDelegateVotersResponse)(nil), "rpcpb.GetDelegateVotersResponse")
 	proto.RegisterType((*TransactionRequest)(nil), "rpcpb.TransactionRequest")
 	proto.RegisterType((*ContractRequest)(nil), "rpcpb.ContractRequest")
 	proto.RegisterType((*CandidateRequest)(nil), "rpcpb.CandidateRequest")
 	proto.RegisterType((*DelegateRequest)(nil), "rpcpb.DelegateRequest")
+	proto.RegisterType((*MultisigRequest)(nil), "rpcpb.MultisigRequest")
+	proto.RegisterType((*BatchRequest)(nil), "rpcpb.BatchRequest")
+	proto.RegisterType((*TimelockRequest)(nil), "rpcpb.TimelockRequest")
+	proto.RegisterType((*PauseRequest)(nil), "rpcpb.PauseRequest")
+	proto.RegisterType((*BatchTransfer)(nil), "rpcpb.BatchTransfer")
+	proto.RegisterType((*MultisigSignature)(nil), "rpcpb.MultisigSignature")
 	proto.RegisterType((*SendRawTransactionRequest)(nil), "rpcpb.SendRawTransactionRequest")
 	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
+	proto.RegisterType((*SendRawTransactionsRequest)(nil), "rpcpb.SendRawTransactionsRequest")
+	proto.RegisterType((*SendRawTransactionResult)(nil), "rpcpb.SendRawTransactionResult")
+	proto.RegisterType((*SendRawTransactionsResponse)(nil), "rpcpb.SendRawTransactionsResponse")
 	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
 	proto.RegisterType((*GetBlockByHeightRequest)(nil), "rpcpb.GetBlockByHeightRequest")
+	proto.RegisterType((*GetBlockByTimestampRequest)(nil), "rpcpb.GetBlockByTimestampRequest")
 	proto.RegisterType((*GetTransactionByHashRequest)(nil), "rpcpb.GetTransactionByHashRequest")
 	proto.RegisterType((*BlockDumpRequest)(nil), "rpcpb.BlockDumpRequest")
 	proto.RegisterType((*BlockDumpResponse)(nil), "rpcpb.BlockDumpResponse")
@@ -1593,1055 +4816,2274 @@ func init() {
 	proto.RegisterType((*SignTransactionResponse)(nil), "rpcpb.SignTransactionResponse")
 	proto.RegisterType((*SendTransactionPassphraseRequest)(nil), "rpcpb.SendTransactionPassphraseRequest")
 	proto.RegisterType((*SendTransactionPassphraseResponse)(nil), "rpcpb.SendTransactionPassphraseResponse")
+	proto.RegisterType((*SignMultisigTransactionRequest)(nil), "rpcpb.SignMultisigTransactionRequest")
+	proto.RegisterType((*SendMultisigTransactionRequest)(nil), "rpcpb.SendMultisigTransactionRequest")
+	proto.RegisterType((*MultisigTransactionResponse)(nil), "rpcpb.MultisigTransactionResponse")
 	proto.RegisterType((*GasPriceResponse)(nil), "rpcpb.GasPriceResponse")
 	proto.RegisterType((*HashRequest)(nil), "rpcpb.HashRequest")
 	proto.RegisterType((*GasResponse)(nil), "rpcpb.GasResponse")
 	proto.RegisterType((*EventsResponse)(nil), "rpcpb.EventsResponse")
 	proto.RegisterType((*Event)(nil), "rpcpb.Event")
+	proto.RegisterType((*EventCursorRequest)(nil), "rpcpb.EventCursorRequest")
+	proto.RegisterType((*CursorEvent)(nil), "rpcpb.CursorEvent")
+	proto.RegisterType((*EventCursorResponse)(nil), "rpcpb.EventCursorResponse")
+	proto.RegisterType((*FilterEventsRequest)(nil), "rpcpb.FilterEventsRequest")
+	proto.RegisterType((*FilterEventsResponse)(nil), "rpcpb.FilterEventsResponse")
+	proto.RegisterType((*CommitEventCursorRequest)(nil), "rpcpb.CommitEventCursorRequest")
+	proto.RegisterType((*CommitEventCursorResponse)(nil), "rpcpb.CommitEventCursorResponse")
 	proto.RegisterType((*StartMiningRequest)(nil), "rpcpb.StartMiningRequest")
 	proto.RegisterType((*MiningResponse)(nil), "rpcpb.MiningResponse")
+	proto.RegisterType((*RegisterWebhookRequest)(nil), "rpcpb.RegisterWebhookRequest")
+	proto.RegisterType((*RegisterWebhookResponse)(nil), "rpcpb.RegisterWebhookResponse")
+	proto.RegisterType((*UnregisterWebhookRequest)(nil), "rpcpb.UnregisterWebhookRequest")
+	proto.RegisterType((*UnregisterWebhookResponse)(nil), "rpcpb.UnregisterWebhookResponse")
+	proto.RegisterType((*PprofRequest)(nil), "rpcpb.PprofRequest")
+	proto.RegisterType((*PprofResponse)(nil), "rpcpb.PprofResponse")
+	proto.RegisterType((*LogLevelRequest)(nil), "rpcpb.LogLevelRequest")
+	proto.RegisterType((*LogLevelResponse)(nil), "rpcpb.LogLevelResponse")
+	proto.RegisterType((*GetConfigResponse)(nil), "rpcpb.GetConfigResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for ApiService service
+
+type ApiServiceClient interface {
+	// Return the state of the neb.
+	GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error)
+	// Stream the status changes of the transaction until it's irreversible or dropped.
+	SubscribeTransaction(ctx context.Context, in *SubscribeTransactionRequest, opts ...grpc.CallOption) (ApiService_SubscribeTransactionClient, error)
+	// Return the sync progress of the node.
+	GetSyncStatus(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*SyncStatusResponse, error)
+	// Return the p2p node info.
+	NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
+	// Return the dump info of blockchain.
+	BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error)
+	// Return the dump info of blockchain.
+	LatestIrreversibleBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// Accounts return account list.
+	Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
+	// Return the state of the account.
+	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
+	// Return the state of the account with merkle proof.
+	GetAccountStateProof(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateProofResponse, error)
+	// Verify, sign, and send the transaction.
+	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// Call smart contract.
+	Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*CallResponse, error)
+	// Submit the signed transaction.
+	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
+	// Submit the signed transactions atomically, none is submitted if any of them is invalid.
+	SendRawTransactions(ctx context.Context, in *SendRawTransactionsRequest, opts ...grpc.CallOption) (*SendRawTransactionsResponse, error)
+	// Get block info by the block hash.
+	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// Get block info by the block height.
+	GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// Get the block on canonical chain nearest to the timestamp.
+	GetBlockByTimestamp(ctx context.Context, in *GetBlockByTimestampRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// Get transactionReceipt info by tansaction hash.
+	GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	// Subscribe message
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
+	// Get GasPrice
+	GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
+	// EstimateGas
+	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error)
+	// Get GasUsed
+	GetGasUsed(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*GasResponse, error)
+	// Return the value of the storage key in contract.
+	GetContractState(ctx context.Context, in *GetContractStateRequest, opts ...grpc.CallOption) (*GetContractStateResponse, error)
+	// Return the deploy information of the contract.
+	GetContractMetadata(ctx context.Context, in *GetContractMetadataRequest, opts ...grpc.CallOption) (*GetContractMetadataResponse, error)
+	// Return the balance of the address in the NRC20 token contract.
+	GetTokenBalance(ctx context.Context, in *TokenBalanceRequest, opts ...grpc.CallOption) (*TokenBalanceResponse, error)
+	// Return the name, symbol, decimals and total supply of the NRC20 token contract.
+	GetTokenMetadata(ctx context.Context, in *TokenMetadataRequest, opts ...grpc.CallOption) (*TokenMetadataResponse, error)
+	// Return the address of the contract deployed by the transaction of from and nonce.
+	GetContractAddress(ctx context.Context, in *GetContractAddressRequest, opts ...grpc.CallOption) (*GetContractAddressResponse, error)
+	// Return the balance changes of the account on the canonical chain, newest first.
+	GetAccountHistory(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (*AccountHistoryResponse, error)
+	// Return the statistics of the chain.
+	GetChainStats(ctx context.Context, in *ChainStatsRequest, opts ...grpc.CallOption) (*ChainStatsResponse, error)
+	// Return the minted blocks and missed slots of the validators in a height range.
+	GetMinerStats(ctx context.Context, in *MinerStatsRequest, opts ...grpc.CallOption) (*MinerStatsResponse, error)
+	// Return the token supply of the chain.
+	GetTotalSupply(ctx context.Context, in *TotalSupplyRequest, opts ...grpc.CallOption) (*TotalSupplyResponse, error)
+	GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+	// Get the events after the committed cursor of the consumer.
+	GetEventsByCursor(ctx context.Context, in *EventCursorRequest, opts ...grpc.CallOption) (*EventCursorResponse, error)
+	// Filter the events by topics and contracts in a range of blocks.
+	FilterEvents(ctx context.Context, in *FilterEventsRequest, opts ...grpc.CallOption) (*FilterEventsResponse, error)
+	// Commit the position of the last acknowledged event of the consumer.
+	CommitEventCursor(ctx context.Context, in *CommitEventCursorRequest, opts ...grpc.CallOption) (*CommitEventCursorResponse, error)
+}
+
+type apiServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
+	return &apiServiceClient{cc}
+}
+
+func (c *apiServiceClient) GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error) {
+	out := new(GetNebStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNebState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SubscribeTransaction(ctx context.Context, in *SubscribeTransactionRequest, opts ...grpc.CallOption) (ApiService_SubscribeTransactionClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[0], c.cc, "/rpcpb.ApiService/SubscribeTransaction", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeTransactionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeTransactionClient interface {
+	Recv() (*TransactionStatusResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeTransactionClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeTransactionClient) Recv() (*TransactionStatusResponse, error) {
+	m := new(TransactionStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetSyncStatus(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*SyncStatusResponse, error) {
+	out := new(SyncStatusResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetSyncStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error) {
+	out := new(NodeInfoResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/NodeInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error) {
+	out := new(BlockDumpResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/BlockDump", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) LatestIrreversibleBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/LatestIrreversibleBlock", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Accounts(ctx context.Context, in *AccountsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
+	out := new(AccountsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Accounts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error) {
+	out := new(GetAccountStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAccountStateProof(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateProofResponse, error) {
+	out := new(GetAccountStateProofResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountStateProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*CallResponse, error) {
+	out := new(CallResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Call", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
+	out := new(SendTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendRawTransaction", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SendRawTransactions(ctx context.Context, in *SendRawTransactionsRequest, opts ...grpc.CallOption) (*SendRawTransactionsResponse, error) {
+	out := new(SendRawTransactionsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendRawTransactions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHeight", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetBlockByTimestamp(ctx context.Context, in *GetBlockByTimestampRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByTimestamp", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
+	out := new(TransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionReceipt", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[1], c.cc, "/rpcpb.ApiService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
+	out := new(GasPriceResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasPrice", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error) {
+	out := new(GasResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/EstimateGas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetGasUsed(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*GasResponse, error) {
+	out := new(GasResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasUsed", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContractState(ctx context.Context, in *GetContractStateRequest, opts ...grpc.CallOption) (*GetContractStateResponse, error) {
+	out := new(GetContractStateResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetContractState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContractMetadata(ctx context.Context, in *GetContractMetadataRequest, opts ...grpc.CallOption) (*GetContractMetadataResponse, error) {
+	out := new(GetContractMetadataResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetContractMetadata", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTokenBalance(ctx context.Context, in *TokenBalanceRequest, opts ...grpc.CallOption) (*TokenBalanceResponse, error) {
+	out := new(TokenBalanceResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTokenBalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTokenMetadata(ctx context.Context, in *TokenMetadataRequest, opts ...grpc.CallOption) (*TokenMetadataResponse, error) {
+	out := new(TokenMetadataResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTokenMetadata", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetContractAddress(ctx context.Context, in *GetContractAddressRequest, opts ...grpc.CallOption) (*GetContractAddressResponse, error) {
+	out := new(GetContractAddressResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetContractAddress", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetAccountHistory(ctx context.Context, in *AccountHistoryRequest, opts ...grpc.CallOption) (*AccountHistoryResponse, error) {
+	out := new(AccountHistoryResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetChainStats(ctx context.Context, in *ChainStatsRequest, opts ...grpc.CallOption) (*ChainStatsResponse, error) {
+	out := new(ChainStatsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetChainStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetMinerStats(ctx context.Context, in *MinerStatsRequest, opts ...grpc.CallOption) (*MinerStatsResponse, error) {
+	out := new(MinerStatsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetMinerStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTotalSupply(ctx context.Context, in *TotalSupplyRequest, opts ...grpc.CallOption) (*TotalSupplyResponse, error) {
+	out := new(TotalSupplyResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTotalSupply", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
+	out := new(EventsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEventsByHash", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetEventsByCursor(ctx context.Context, in *EventCursorRequest, opts ...grpc.CallOption) (*EventCursorResponse, error) {
+	out := new(EventCursorResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEventsByCursor", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) FilterEvents(ctx context.Context, in *FilterEventsRequest, opts ...grpc.CallOption) (*FilterEventsResponse, error) {
+	out := new(FilterEventsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/FilterEvents", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) CommitEventCursor(ctx context.Context, in *CommitEventCursorRequest, opts ...grpc.CallOption) (*CommitEventCursorResponse, error) {
+	out := new(CommitEventCursorResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.ApiService/CommitEventCursor", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ApiService service
+
+type ApiServiceServer interface {
+	// Return the state of the neb.
+	GetNebState(context.Context, *NonParamsRequest) (*GetNebStateResponse, error)
+	// Stream the status changes of the transaction until it's irreversible or dropped.
+	SubscribeTransaction(*SubscribeTransactionRequest, ApiService_SubscribeTransactionServer) error
+	// Return the sync progress of the node.
+	GetSyncStatus(context.Context, *NonParamsRequest) (*SyncStatusResponse, error)
+	// Return the p2p node info.
+	NodeInfo(context.Context, *NonParamsRequest) (*NodeInfoResponse, error)
+	// Return the dump info of blockchain.
+	BlockDump(context.Context, *BlockDumpRequest) (*BlockDumpResponse, error)
+	// Return the dump info of blockchain.
+	LatestIrreversibleBlock(context.Context, *NonParamsRequest) (*BlockResponse, error)
+	// Accounts return account list.
+	Accounts(context.Context, *AccountsRequest) (*AccountsResponse, error)
+	// Return the state of the account.
+	GetAccountState(context.Context, *GetAccountStateRequest) (*GetAccountStateResponse, error)
+	// Return the state of the account with merkle proof.
+	GetAccountStateProof(context.Context, *GetAccountStateRequest) (*GetAccountStateProofResponse, error)
+	// Verify, sign, and send the transaction.
+	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
+	// Call smart contract.
+	Call(context.Context, *TransactionRequest) (*CallResponse, error)
+	// Submit the signed transaction.
+	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendTransactionResponse, error)
+	// Submit the signed transactions atomically, none is submitted if any of them is invalid.
+	SendRawTransactions(context.Context, *SendRawTransactionsRequest) (*SendRawTransactionsResponse, error)
+	// Get block info by the block hash.
+	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*BlockResponse, error)
+	// Get block info by the block height.
+	GetBlockByHeight(context.Context, *GetBlockByHeightRequest) (*BlockResponse, error)
+	// Get the block on canonical chain nearest to the timestamp.
+	GetBlockByTimestamp(context.Context, *GetBlockByTimestampRequest) (*BlockResponse, error)
+	// Get transactionReceipt info by tansaction hash.
+	GetTransactionReceipt(context.Context, *GetTransactionByHashRequest) (*TransactionResponse, error)
+	// Subscribe message
+	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
+	// Get GasPrice
+	GetGasPrice(context.Context, *NonParamsRequest) (*GasPriceResponse, error)
+	// EstimateGas
+	EstimateGas(context.Context, *TransactionRequest) (*GasResponse, error)
+	// Get GasUsed
+	GetGasUsed(context.Context, *HashRequest) (*GasResponse, error)
+	// Return the value of the storage key in contract.
+	GetContractState(context.Context, *GetContractStateRequest) (*GetContractStateResponse, error)
+	// Return the deploy information of the contract.
+	GetContractMetadata(context.Context, *GetContractMetadataRequest) (*GetContractMetadataResponse, error)
+	// Return the balance of the address in the NRC20 token contract.
+	GetTokenBalance(context.Context, *TokenBalanceRequest) (*TokenBalanceResponse, error)
+	// Return the name, symbol, decimals and total supply of the NRC20 token contract.
+	GetTokenMetadata(context.Context, *TokenMetadataRequest) (*TokenMetadataResponse, error)
+	// Return the address of the contract deployed by the transaction of from and nonce.
+	GetContractAddress(context.Context, *GetContractAddressRequest) (*GetContractAddressResponse, error)
+	// Return the balance changes of the account on the canonical chain, newest first.
+	GetAccountHistory(context.Context, *AccountHistoryRequest) (*AccountHistoryResponse, error)
+	// Return the statistics of the chain.
+	GetChainStats(context.Context, *ChainStatsRequest) (*ChainStatsResponse, error)
+	// Return the minted blocks and missed slots of the validators in a height range.
+	GetMinerStats(context.Context, *MinerStatsRequest) (*MinerStatsResponse, error)
+	// Return the token supply of the chain.
+	GetTotalSupply(context.Context, *TotalSupplyRequest) (*TotalSupplyResponse, error)
+	GetEventsByHash(context.Context, *HashRequest) (*EventsResponse, error)
+	// Get the events after the committed cursor of the consumer.
+	GetEventsByCursor(context.Context, *EventCursorRequest) (*EventCursorResponse, error)
+	// Filter the events by topics and contracts in a range of blocks.
+	FilterEvents(context.Context, *FilterEventsRequest) (*FilterEventsResponse, error)
+	// Commit the position of the last acknowledged event of the consumer.
+	CommitEventCursor(context.Context, *CommitEventCursorRequest) (*CommitEventCursorResponse, error)
+}
+
+func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
+	s.RegisterService(&_ApiService_serviceDesc, srv)
+}
+
+func _ApiService_GetNebState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetNebState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetNebState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetNebState(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SubscribeTransaction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTransactionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeTransaction(m, &apiServiceSubscribeTransactionServer{stream})
+}
+
+type ApiService_SubscribeTransactionServer interface {
+	Send(*TransactionStatusResponse) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeTransactionServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeTransactionServer) Send(m *TransactionStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_GetSyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetSyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetSyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetSyncStatus(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_NodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).NodeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/NodeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).NodeInfo(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_BlockDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).BlockDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/BlockDump",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).BlockDump(ctx, req.(*BlockDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_LatestIrreversibleBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).LatestIrreversibleBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/LatestIrreversibleBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).LatestIrreversibleBlock(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).Accounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/Accounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).Accounts(ctx, req.(*AccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetAccountState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountState(ctx, req.(*GetAccountStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetAccountStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountStateProof(ctx, req.(*GetAccountStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SendTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/SendTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SendTransaction(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).Call(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/Call",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).Call(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRawTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/SendRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SendRawTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendRawTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).SendRawTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/SendRawTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).SendRawTransactions(ctx, req.(*SendRawTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetBlockByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetBlockByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockByHeight(ctx, req.(*GetBlockByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockByTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockByTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetBlockByTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockByTimestamp(ctx, req.(*GetBlockByTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTransactionReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, req.(*GetTransactionByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).Subscribe(m, &apiServiceSubscribeServer{stream})
+}
+
+type ApiService_SubscribeServer interface {
+	Send(*SubscribeResponse) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_GetGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetGasPrice(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).EstimateGas(ctx, req.(*TransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetGasUsed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetGasUsed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetGasUsed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetGasUsed(ctx, req.(*HashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetContractState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContractState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetContractState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContractState(ctx, req.(*GetContractStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetContractMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContractMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetContractMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContractMetadata(ctx, req.(*GetContractMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTokenBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTokenBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTokenBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTokenBalance(ctx, req.(*TokenBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTokenMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTokenMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTokenMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTokenMetadata(ctx, req.(*TokenMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetContractAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetContractAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetContractAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetContractAddress(ctx, req.(*GetContractAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetAccountHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetAccountHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetAccountHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetAccountHistory(ctx, req.(*AccountHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetChainStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetChainStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetChainStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetChainStats(ctx, req.(*ChainStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetMinerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetMinerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetMinerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetMinerStats(ctx, req.(*MinerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTotalSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TotalSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTotalSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTotalSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTotalSupply(ctx, req.(*TotalSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetEventsByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetEventsByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetEventsByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetEventsByHash(ctx, req.(*HashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetEventsByCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetEventsByCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetEventsByCursor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetEventsByCursor(ctx, req.(*EventCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_FilterEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).FilterEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/FilterEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).FilterEvents(ctx, req.(*FilterEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_CommitEventCursor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitEventCursorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).CommitEventCursor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/CommitEventCursor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).CommitEventCursor(ctx, req.(*CommitEventCursorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApiService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.ApiService",
+	HandlerType: (*ApiServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNebState",
+			Handler:    _ApiService_GetNebState_Handler,
+		},
+		{
+			MethodName: "GetSyncStatus",
+			Handler:    _ApiService_GetSyncStatus_Handler,
+		},
+		{
+			MethodName: "NodeInfo",
+			Handler:    _ApiService_NodeInfo_Handler,
+		},
+		{
+			MethodName: "BlockDump",
+			Handler:    _ApiService_BlockDump_Handler,
+		},
+		{
+			MethodName: "LatestIrreversibleBlock",
+			Handler:    _ApiService_LatestIrreversibleBlock_Handler,
+		},
+		{
+			MethodName: "Accounts",
+			Handler:    _ApiService_Accounts_Handler,
+		},
+		{
+			MethodName: "GetAccountState",
+			Handler:    _ApiService_GetAccountState_Handler,
+		},
+		{
+			MethodName: "GetAccountStateProof",
+			Handler:    _ApiService_GetAccountStateProof_Handler,
+		},
+		{
+			MethodName: "SendTransaction",
+			Handler:    _ApiService_SendTransaction_Handler,
+		},
+		{
+			MethodName: "Call",
+			Handler:    _ApiService_Call_Handler,
+		},
+		{
+			MethodName: "SendRawTransaction",
+			Handler:    _ApiService_SendRawTransaction_Handler,
+		},
+		{
+			MethodName: "SendRawTransactions",
+			Handler:    _ApiService_SendRawTransactions_Handler,
+		},
+		{
+			MethodName: "GetBlockByHash",
+			Handler:    _ApiService_GetBlockByHash_Handler,
+		},
+		{
+			MethodName: "GetBlockByHeight",
+			Handler:    _ApiService_GetBlockByHeight_Handler,
+		},
+		{
+			MethodName: "GetBlockByTimestamp",
+			Handler:    _ApiService_GetBlockByTimestamp_Handler,
+		},
+		{
+			MethodName: "GetTransactionReceipt",
+			Handler:    _ApiService_GetTransactionReceipt_Handler,
+		},
+		{
+			MethodName: "GetGasPrice",
+			Handler:    _ApiService_GetGasPrice_Handler,
+		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _ApiService_EstimateGas_Handler,
+		},
+		{
+			MethodName: "GetGasUsed",
+			Handler:    _ApiService_GetGasUsed_Handler,
+		},
+		{
+			MethodName: "GetContractState",
+			Handler:    _ApiService_GetContractState_Handler,
+		},
+		{
+			MethodName: "GetContractMetadata",
+			Handler:    _ApiService_GetContractMetadata_Handler,
+		},
+		{
+			MethodName: "GetTokenBalance",
+			Handler:    _ApiService_GetTokenBalance_Handler,
+		},
+		{
+			MethodName: "GetTokenMetadata",
+			Handler:    _ApiService_GetTokenMetadata_Handler,
+		},
+		{
+			MethodName: "GetContractAddress",
+			Handler:    _ApiService_GetContractAddress_Handler,
+		},
+		{
+			MethodName: "GetAccountHistory",
+			Handler:    _ApiService_GetAccountHistory_Handler,
+		},
+		{
+			MethodName: "GetChainStats",
+			Handler:    _ApiService_GetChainStats_Handler,
+		},
+		{
+			MethodName: "GetMinerStats",
+			Handler:    _ApiService_GetMinerStats_Handler,
+		},
+		{
+			MethodName: "GetTotalSupply",
+			Handler:    _ApiService_GetTotalSupply_Handler,
+		},
+		{
+			MethodName: "GetEventsByHash",
+			Handler:    _ApiService_GetEventsByHash_Handler,
+		},
+		{
+			MethodName: "GetEventsByCursor",
+			Handler:    _ApiService_GetEventsByCursor_Handler,
+		},
+		{
+			MethodName: "FilterEvents",
+			Handler:    _ApiService_FilterEvents_Handler,
+		},
+		{
+			MethodName: "CommitEventCursor",
+			Handler:    _ApiService_CommitEventCursor_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTransaction",
+			Handler:       _ApiService_SubscribeTransaction_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _ApiService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc.proto",
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// Client API for AdminService service
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type AdminServiceClient interface {
+	// NewAccount create a new account with passphrase
+	NewAccount(ctx context.Context, in *NewAccountRequest, opts ...grpc.CallOption) (*NewAccountResponse, error)
+	// UnlockAccount unlock account with passphrase
+	UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error)
+	// LockAccount lock account
+	LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*LockAccountResponse, error)
+	// Sign sign transaction
+	SignTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
+	// SendTransactionWithPassphrase send transaction with passphrase
+	SendTransactionWithPassphrase(ctx context.Context, in *SendTransactionPassphraseRequest, opts ...grpc.CallOption) (*SendTransactionPassphraseResponse, error)
+	StatisticsNodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*StatisticsNodeInfoResponse, error)
+	GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error)
+	GetCandidates(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetCandidatesResponse, error)
+	GetDelegateVoters(ctx context.Context, in *GetDelegateVotersRequest, opts ...grpc.CallOption) (*GetDelegateVotersResponse, error)
+	// Return the conflict dependency of transactions in block.
+	GetTransactionDependency(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetTransactionDependencyResponse, error)
+	ChangeNetworkID(ctx context.Context, in *ChangeNetworkIDRequest, opts ...grpc.CallOption) (*ChangeNetworkIDResponse, error)
+	ReloadPeerAccessControl(ctx context.Context, in *PeerAccessControlRequest, opts ...grpc.CallOption) (*PeerAccessControlResponse, error)
+	StartMining(ctx context.Context, in *StartMiningRequest, opts ...grpc.CallOption) (*MiningResponse, error)
+	StopMining(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*MiningResponse, error)
+	// Register a webhook to post the matching chain events to.
+	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error)
+	UnregisterWebhook(ctx context.Context, in *UnregisterWebhookRequest, opts ...grpc.CallOption) (*UnregisterWebhookResponse, error)
+	// Start the pprof server to capture profiles of the running node.
+	StartPprof(ctx context.Context, in *PprofRequest, opts ...grpc.CallOption) (*PprofResponse, error)
+	StopPprof(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*PprofResponse, error)
+	// Change the log level of a module at runtime.
+	SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error)
+	// Return the effective config of the node with secrets redacted.
+	GetConfig(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	// Create a multisig transaction waiting for the signers to co-sign.
+	CreateMultisigTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*MultisigTransactionResponse, error)
+	// Co-sign the multisig transaction with the signer passphrase.
+	SignMultisigTransaction(ctx context.Context, in *SignMultisigTransactionRequest, opts ...grpc.CallOption) (*MultisigTransactionResponse, error)
+	// Return the partially-signed multisig transaction.
+	GetMultisigTransaction(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*MultisigTransactionResponse, error)
+	// Sign the multisig transaction with the from passphrase and send it when the signatures are enough.
+	SendMultisigTransaction(ctx context.Context, in *SendMultisigTransactionRequest, opts ...grpc.CallOption) (*SendTransactionPassphraseResponse, error)
+	// Return the side branches competing with the canonical chain seen recently.
+	GetForks(ctx context.Context, in *GetForksRequest, opts ...grpc.CallOption) (*GetForksResponse, error)
+	// Return the execution statistics of the contracts since the node started.
+	GetContractStats(ctx context.Context, in *ContractStatsRequest, opts ...grpc.CallOption) (*ContractStatsResponse, error)
+	// Return the candidates with their vote weight and the delegations at a height, the tail if height is 0.
+	GetVoteSnapshot(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*VoteSnapshotResponse, error)
+	// Execute the transaction on the tail block in nvm with tracing enabled.
+	DebugCall(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*DebugResponse, error)
+	// Re-execute the transaction on chain in nvm with tracing enabled.
+	DebugTransaction(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*DebugResponse, error)
+	// Stream the accounts in the state of an irreversible block in the order of address.
+	IterateAccounts(ctx context.Context, in *IterateAccountsRequest, opts ...grpc.CallOption) (AdminService_IterateAccountsClient, error)
+}
 
-// Client API for ApiService service
+type adminServiceClient struct {
+	cc *grpc.ClientConn
+}
 
-type ApiServiceClient interface {
-	// Return the state of the neb.
-	GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error)
-	// Return the p2p node info.
-	NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
-	// Return the dump info of blockchain.
-	BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error)
-	// Return the dump info of blockchain.
-	LatestIrreversibleBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*BlockResponse, error)
-	// Accounts return account list.
-	Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error)
-	// Return the state of the account.
-	GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error)
-	// Verify, sign, and send the transaction.
-	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Call smart contract.
-	Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*CallResponse, error)
-	// Submit the signed transaction.
-	SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
-	// Get block info by the block hash.
-	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error)
-	// Get block info by the block height.
-	GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*BlockResponse, error)
-	// Get transactionReceipt info by tansaction hash.
-	GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
-	// Subscribe message
-	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error)
-	// Get GasPrice
-	GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
-	// EstimateGas
-	EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error)
-	// Get GasUsed
-	GetGasUsed(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*GasResponse, error)
-	GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
 }
 
-type apiServiceClient struct {
-	cc *grpc.ClientConn
+func (c *adminServiceClient) NewAccount(ctx context.Context, in *NewAccountRequest, opts ...grpc.CallOption) (*NewAccountResponse, error) {
+	out := new(NewAccountResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/NewAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func NewApiServiceClient(cc *grpc.ClientConn) ApiServiceClient {
-	return &apiServiceClient{cc}
+func (c *adminServiceClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error) {
+	out := new(UnlockAccountResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/UnlockAccount", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *apiServiceClient) GetNebState(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetNebStateResponse, error) {
-	out := new(GetNebStateResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetNebState", in, out, c.cc, opts...)
+func (c *adminServiceClient) LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*LockAccountResponse, error) {
+	out := new(LockAccountResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/LockAccount", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) NodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error) {
-	out := new(NodeInfoResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/NodeInfo", in, out, c.cc, opts...)
+func (c *adminServiceClient) SignTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
+	out := new(SignTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SignTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) BlockDump(ctx context.Context, in *BlockDumpRequest, opts ...grpc.CallOption) (*BlockDumpResponse, error) {
-	out := new(BlockDumpResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/BlockDump", in, out, c.cc, opts...)
+func (c *adminServiceClient) SendTransactionWithPassphrase(ctx context.Context, in *SendTransactionPassphraseRequest, opts ...grpc.CallOption) (*SendTransactionPassphraseResponse, error) {
+	out := new(SendTransactionPassphraseResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SendTransactionWithPassphrase", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StatisticsNodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*StatisticsNodeInfoResponse, error) {
+	out := new(StatisticsNodeInfoResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StatisticsNodeInfo", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error) {
+	out := new(GetDynastyResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetDynasty", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetCandidates(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetCandidatesResponse, error) {
+	out := new(GetCandidatesResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetCandidates", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDelegateVoters(ctx context.Context, in *GetDelegateVotersRequest, opts ...grpc.CallOption) (*GetDelegateVotersResponse, error) {
+	out := new(GetDelegateVotersResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetDelegateVoters", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetTransactionDependency(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetTransactionDependencyResponse, error) {
+	out := new(GetTransactionDependencyResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetTransactionDependency", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ChangeNetworkID(ctx context.Context, in *ChangeNetworkIDRequest, opts ...grpc.CallOption) (*ChangeNetworkIDResponse, error) {
+	out := new(ChangeNetworkIDResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/ChangeNetworkID", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReloadPeerAccessControl(ctx context.Context, in *PeerAccessControlRequest, opts ...grpc.CallOption) (*PeerAccessControlResponse, error) {
+	out := new(PeerAccessControlResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/ReloadPeerAccessControl", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StartMining(ctx context.Context, in *StartMiningRequest, opts ...grpc.CallOption) (*MiningResponse, error) {
+	out := new(MiningResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StartMining", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) StopMining(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*MiningResponse, error) {
+	out := new(MiningResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StopMining", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) LatestIrreversibleBlock(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
-	out := new(BlockResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/LatestIrreversibleBlock", in, out, c.cc, opts...)
+func (c *adminServiceClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error) {
+	out := new(RegisterWebhookResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/RegisterWebhook", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) Accounts(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*AccountsResponse, error) {
-	out := new(AccountsResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Accounts", in, out, c.cc, opts...)
+func (c *adminServiceClient) UnregisterWebhook(ctx context.Context, in *UnregisterWebhookRequest, opts ...grpc.CallOption) (*UnregisterWebhookResponse, error) {
+	out := new(UnregisterWebhookResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/UnregisterWebhook", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetAccountState(ctx context.Context, in *GetAccountStateRequest, opts ...grpc.CallOption) (*GetAccountStateResponse, error) {
-	out := new(GetAccountStateResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetAccountState", in, out, c.cc, opts...)
+func (c *adminServiceClient) StartPprof(ctx context.Context, in *PprofRequest, opts ...grpc.CallOption) (*PprofResponse, error) {
+	out := new(PprofResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StartPprof", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
-	out := new(SendTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, c.cc, opts...)
+func (c *adminServiceClient) StopPprof(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*PprofResponse, error) {
+	out := new(PprofResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StopPprof", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) Call(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*CallResponse, error) {
-	out := new(CallResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/Call", in, out, c.cc, opts...)
+func (c *adminServiceClient) SetLogLevel(ctx context.Context, in *LogLevelRequest, opts ...grpc.CallOption) (*LogLevelResponse, error) {
+	out := new(LogLevelResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SetLogLevel", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) SendRawTransaction(ctx context.Context, in *SendRawTransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
-	out := new(SendTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/SendRawTransaction", in, out, c.cc, opts...)
+func (c *adminServiceClient) GetConfig(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	out := new(GetConfigResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetConfig", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
-	out := new(BlockResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, c.cc, opts...)
+func (c *adminServiceClient) CreateMultisigTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*MultisigTransactionResponse, error) {
+	out := new(MultisigTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/CreateMultisigTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetBlockByHeight(ctx context.Context, in *GetBlockByHeightRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
-	out := new(BlockResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHeight", in, out, c.cc, opts...)
+func (c *adminServiceClient) SignMultisigTransaction(ctx context.Context, in *SignMultisigTransactionRequest, opts ...grpc.CallOption) (*MultisigTransactionResponse, error) {
+	out := new(MultisigTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SignMultisigTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetTransactionReceipt(ctx context.Context, in *GetTransactionByHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error) {
-	out := new(TransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetTransactionReceipt", in, out, c.cc, opts...)
+func (c *adminServiceClient) GetMultisigTransaction(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*MultisigTransactionResponse, error) {
+	out := new(MultisigTransactionResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetMultisigTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_ApiService_serviceDesc.Streams[0], c.cc, "/rpcpb.ApiService/Subscribe", opts...)
+func (c *adminServiceClient) SendMultisigTransaction(ctx context.Context, in *SendMultisigTransactionRequest, opts ...grpc.CallOption) (*SendTransactionPassphraseResponse, error) {
+	out := new(SendTransactionPassphraseResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SendMultisigTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &apiServiceSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type ApiService_SubscribeClient interface {
-	Recv() (*SubscribeResponse, error)
-	grpc.ClientStream
-}
-
-type apiServiceSubscribeClient struct {
-	grpc.ClientStream
+	return out, nil
 }
 
-func (x *apiServiceSubscribeClient) Recv() (*SubscribeResponse, error) {
-	m := new(SubscribeResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *adminServiceClient) GetForks(ctx context.Context, in *GetForksRequest, opts ...grpc.CallOption) (*GetForksResponse, error) {
+	out := new(GetForksResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetForks", in, out, c.cc, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *apiServiceClient) GetGasPrice(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
-	out := new(GasPriceResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasPrice", in, out, c.cc, opts...)
+func (c *adminServiceClient) GetContractStats(ctx context.Context, in *ContractStatsRequest, opts ...grpc.CallOption) (*ContractStatsResponse, error) {
+	out := new(ContractStatsResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetContractStats", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) EstimateGas(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*GasResponse, error) {
-	out := new(GasResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/EstimateGas", in, out, c.cc, opts...)
+func (c *adminServiceClient) GetVoteSnapshot(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*VoteSnapshotResponse, error) {
+	out := new(VoteSnapshotResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetVoteSnapshot", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetGasUsed(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*GasResponse, error) {
-	out := new(GasResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetGasUsed", in, out, c.cc, opts...)
+func (c *adminServiceClient) DebugCall(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*DebugResponse, error) {
+	out := new(DebugResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/DebugCall", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *apiServiceClient) GetEventsByHash(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
-	out := new(EventsResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.ApiService/GetEventsByHash", in, out, c.cc, opts...)
+func (c *adminServiceClient) DebugTransaction(ctx context.Context, in *HashRequest, opts ...grpc.CallOption) (*DebugResponse, error) {
+	out := new(DebugResponse)
+	err := grpc.Invoke(ctx, "/rpcpb.AdminService/DebugTransaction", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// Server API for ApiService service
-
-type ApiServiceServer interface {
-	// Return the state of the neb.
-	GetNebState(context.Context, *NonParamsRequest) (*GetNebStateResponse, error)
-	// Return the p2p node info.
-	NodeInfo(context.Context, *NonParamsRequest) (*NodeInfoResponse, error)
-	// Return the dump info of blockchain.
-	BlockDump(context.Context, *BlockDumpRequest) (*BlockDumpResponse, error)
-	// Return the dump info of blockchain.
-	LatestIrreversibleBlock(context.Context, *NonParamsRequest) (*BlockResponse, error)
-	// Accounts return account list.
-	Accounts(context.Context, *NonParamsRequest) (*AccountsResponse, error)
-	// Return the state of the account.
-	GetAccountState(context.Context, *GetAccountStateRequest) (*GetAccountStateResponse, error)
-	// Verify, sign, and send the transaction.
-	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
-	// Call smart contract.
-	Call(context.Context, *TransactionRequest) (*CallResponse, error)
-	// Submit the signed transaction.
-	SendRawTransaction(context.Context, *SendRawTransactionRequest) (*SendTransactionResponse, error)
-	// Get block info by the block hash.
-	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*BlockResponse, error)
-	// Get block info by the block height.
-	GetBlockByHeight(context.Context, *GetBlockByHeightRequest) (*BlockResponse, error)
-	// Get transactionReceipt info by tansaction hash.
-	GetTransactionReceipt(context.Context, *GetTransactionByHashRequest) (*TransactionResponse, error)
-	// Subscribe message
-	Subscribe(*SubscribeRequest, ApiService_SubscribeServer) error
-	// Get GasPrice
-	GetGasPrice(context.Context, *NonParamsRequest) (*GasPriceResponse, error)
-	// EstimateGas
-	EstimateGas(context.Context, *TransactionRequest) (*GasResponse, error)
-	// Get GasUsed
-	GetGasUsed(context.Context, *HashRequest) (*GasResponse, error)
-	GetEventsByHash(context.Context, *HashRequest) (*EventsResponse, error)
-}
-
-func RegisterApiServiceServer(s *grpc.Server, srv ApiServiceServer) {
-	s.RegisterService(&_ApiService_serviceDesc, srv)
-}
-
-func _ApiService_GetNebState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NonParamsRequest)
-	if err := dec(in); err != nil {
+func (c *adminServiceClient) IterateAccounts(ctx context.Context, in *IterateAccountsRequest, opts ...grpc.CallOption) (AdminService_IterateAccountsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_AdminService_serviceDesc.Streams[0], c.cc, "/rpcpb.AdminService/IterateAccounts", opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApiServiceServer).GetNebState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetNebState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetNebState(ctx, req.(*NonParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ApiService_NodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NonParamsRequest)
-	if err := dec(in); err != nil {
+	x := &adminServiceIterateAccountsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApiServiceServer).NodeInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/NodeInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).NodeInfo(ctx, req.(*NonParamsRequest))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _ApiService_BlockDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockDumpRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApiServiceServer).BlockDump(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/BlockDump",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).BlockDump(ctx, req.(*BlockDumpRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type AdminService_IterateAccountsClient interface {
+	Recv() (*AccountEntry, error)
+	grpc.ClientStream
 }
 
-func _ApiService_LatestIrreversibleBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NonParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApiServiceServer).LatestIrreversibleBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/LatestIrreversibleBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).LatestIrreversibleBlock(ctx, req.(*NonParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type adminServiceIterateAccountsClient struct {
+	grpc.ClientStream
 }
 
-func _ApiService_Accounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NonParamsRequest)
-	if err := dec(in); err != nil {
+func (x *adminServiceIterateAccountsClient) Recv() (*AccountEntry, error) {
+	m := new(AccountEntry)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ApiServiceServer).Accounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/Accounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).Accounts(ctx, req.(*NonParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _ApiService_GetAccountState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApiServiceServer).GetAccountState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetAccountState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetAccountState(ctx, req.(*GetAccountStateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// Server API for AdminService service
+
+type AdminServiceServer interface {
+	// NewAccount create a new account with passphrase
+	NewAccount(context.Context, *NewAccountRequest) (*NewAccountResponse, error)
+	// UnlockAccount unlock account with passphrase
+	UnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error)
+	// LockAccount lock account
+	LockAccount(context.Context, *LockAccountRequest) (*LockAccountResponse, error)
+	// Sign sign transaction
+	SignTransaction(context.Context, *TransactionRequest) (*SignTransactionResponse, error)
+	// SendTransactionWithPassphrase send transaction with passphrase
+	SendTransactionWithPassphrase(context.Context, *SendTransactionPassphraseRequest) (*SendTransactionPassphraseResponse, error)
+	StatisticsNodeInfo(context.Context, *NonParamsRequest) (*StatisticsNodeInfoResponse, error)
+	GetDynasty(context.Context, *ByBlockHeightRequest) (*GetDynastyResponse, error)
+	GetCandidates(context.Context, *ByBlockHeightRequest) (*GetCandidatesResponse, error)
+	GetDelegateVoters(context.Context, *GetDelegateVotersRequest) (*GetDelegateVotersResponse, error)
+	// Return the conflict dependency of transactions in block.
+	GetTransactionDependency(context.Context, *ByBlockHeightRequest) (*GetTransactionDependencyResponse, error)
+	ChangeNetworkID(context.Context, *ChangeNetworkIDRequest) (*ChangeNetworkIDResponse, error)
+	ReloadPeerAccessControl(context.Context, *PeerAccessControlRequest) (*PeerAccessControlResponse, error)
+	StartMining(context.Context, *StartMiningRequest) (*MiningResponse, error)
+	StopMining(context.Context, *NonParamsRequest) (*MiningResponse, error)
+	// Register a webhook to post the matching chain events to.
+	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error)
+	UnregisterWebhook(context.Context, *UnregisterWebhookRequest) (*UnregisterWebhookResponse, error)
+	// Start the pprof server to capture profiles of the running node.
+	StartPprof(context.Context, *PprofRequest) (*PprofResponse, error)
+	StopPprof(context.Context, *NonParamsRequest) (*PprofResponse, error)
+	// Change the log level of a module at runtime.
+	SetLogLevel(context.Context, *LogLevelRequest) (*LogLevelResponse, error)
+	// Return the effective config of the node with secrets redacted.
+	GetConfig(context.Context, *NonParamsRequest) (*GetConfigResponse, error)
+	// Create a multisig transaction waiting for the signers to co-sign.
+	CreateMultisigTransaction(context.Context, *TransactionRequest) (*MultisigTransactionResponse, error)
+	// Co-sign the multisig transaction with the signer passphrase.
+	SignMultisigTransaction(context.Context, *SignMultisigTransactionRequest) (*MultisigTransactionResponse, error)
+	// Return the partially-signed multisig transaction.
+	GetMultisigTransaction(context.Context, *HashRequest) (*MultisigTransactionResponse, error)
+	// Sign the multisig transaction with the from passphrase and send it when the signatures are enough.
+	SendMultisigTransaction(context.Context, *SendMultisigTransactionRequest) (*SendTransactionPassphraseResponse, error)
+	// Return the side branches competing with the canonical chain seen recently.
+	GetForks(context.Context, *GetForksRequest) (*GetForksResponse, error)
+	// Return the execution statistics of the contracts since the node started.
+	GetContractStats(context.Context, *ContractStatsRequest) (*ContractStatsResponse, error)
+	// Return the candidates with their vote weight and the delegations at a height, the tail if height is 0.
+	GetVoteSnapshot(context.Context, *ByBlockHeightRequest) (*VoteSnapshotResponse, error)
+	// Execute the transaction on the tail block in nvm with tracing enabled.
+	DebugCall(context.Context, *TransactionRequest) (*DebugResponse, error)
+	// Re-execute the transaction on chain in nvm with tracing enabled.
+	DebugTransaction(context.Context, *HashRequest) (*DebugResponse, error)
+	// Stream the accounts in the state of an irreversible block in the order of address.
+	IterateAccounts(*IterateAccountsRequest, AdminService_IterateAccountsServer) error
 }
 
-func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ApiServiceServer).SendTransaction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/SendTransaction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).SendTransaction(ctx, req.(*TransactionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
 }
 
-func _ApiService_Call_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionRequest)
+func _AdminService_NewAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).Call(ctx, in)
+		return srv.(AdminServiceServer).NewAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/Call",
+		FullMethod: "/rpcpb.AdminService/NewAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).Call(ctx, req.(*TransactionRequest))
+		return srv.(AdminServiceServer).NewAccount(ctx, req.(*NewAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendRawTransactionRequest)
+func _AdminService_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).SendRawTransaction(ctx, in)
+		return srv.(AdminServiceServer).UnlockAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/SendRawTransaction",
+		FullMethod: "/rpcpb.AdminService/UnlockAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).SendRawTransaction(ctx, req.(*SendRawTransactionRequest))
+		return srv.(AdminServiceServer).UnlockAccount(ctx, req.(*UnlockAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockByHashRequest)
+func _AdminService_LockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockByHash(ctx, in)
+		return srv.(AdminServiceServer).LockAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetBlockByHash",
+		FullMethod: "/rpcpb.AdminService/LockAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+		return srv.(AdminServiceServer).LockAccount(ctx, req.(*LockAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockByHeightRequest)
+func _AdminService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockByHeight(ctx, in)
+		return srv.(AdminServiceServer).SignTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetBlockByHeight",
+		FullMethod: "/rpcpb.AdminService/SignTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockByHeight(ctx, req.(*GetBlockByHeightRequest))
+		return srv.(AdminServiceServer).SignTransaction(ctx, req.(*TransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionByHashRequest)
+func _AdminService_SendTransactionWithPassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTransactionPassphraseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, in)
+		return srv.(AdminServiceServer).SendTransactionWithPassphrase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetTransactionReceipt",
+		FullMethod: "/rpcpb.AdminService/SendTransactionWithPassphrase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTransactionReceipt(ctx, req.(*GetTransactionByHashRequest))
+		return srv.(AdminServiceServer).SendTransactionWithPassphrase(ctx, req.(*SendTransactionPassphraseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(ApiServiceServer).Subscribe(m, &apiServiceSubscribeServer{stream})
-}
-
-type ApiService_SubscribeServer interface {
-	Send(*SubscribeResponse) error
-	grpc.ServerStream
-}
-
-type apiServiceSubscribeServer struct {
-	grpc.ServerStream
-}
-
-func (x *apiServiceSubscribeServer) Send(m *SubscribeResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _ApiService_GetGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AdminService_StatisticsNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(NonParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetGasPrice(ctx, in)
+		return srv.(AdminServiceServer).StatisticsNodeInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetGasPrice",
+		FullMethod: "/rpcpb.AdminService/StatisticsNodeInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetGasPrice(ctx, req.(*NonParamsRequest))
+		return srv.(AdminServiceServer).StatisticsNodeInfo(ctx, req.(*NonParamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransactionRequest)
+func _AdminService_GetDynasty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByBlockHeightRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).EstimateGas(ctx, in)
+		return srv.(AdminServiceServer).GetDynasty(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/EstimateGas",
+		FullMethod: "/rpcpb.AdminService/GetDynasty",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).EstimateGas(ctx, req.(*TransactionRequest))
+		return srv.(AdminServiceServer).GetDynasty(ctx, req.(*ByBlockHeightRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetGasUsed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HashRequest)
+func _AdminService_GetCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByBlockHeightRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetGasUsed(ctx, in)
+		return srv.(AdminServiceServer).GetCandidates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetGasUsed",
+		FullMethod: "/rpcpb.AdminService/GetCandidates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetGasUsed(ctx, req.(*HashRequest))
+		return srv.(AdminServiceServer).GetCandidates(ctx, req.(*ByBlockHeightRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetEventsByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HashRequest)
+func _AdminService_GetDelegateVoters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDelegateVotersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetEventsByHash(ctx, in)
+		return srv.(AdminServiceServer).GetDelegateVoters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetEventsByHash",
+		FullMethod: "/rpcpb.AdminService/GetDelegateVoters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetEventsByHash(ctx, req.(*HashRequest))
+		return srv.(AdminServiceServer).GetDelegateVoters(ctx, req.(*GetDelegateVotersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _ApiService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rpcpb.ApiService",
-	HandlerType: (*ApiServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetNebState",
-			Handler:    _ApiService_GetNebState_Handler,
-		},
-		{
-			MethodName: "NodeInfo",
-			Handler:    _ApiService_NodeInfo_Handler,
-		},
-		{
-			MethodName: "BlockDump",
-			Handler:    _ApiService_BlockDump_Handler,
-		},
-		{
-			MethodName: "LatestIrreversibleBlock",
-			Handler:    _ApiService_LatestIrreversibleBlock_Handler,
-		},
-		{
-			MethodName: "Accounts",
-			Handler:    _ApiService_Accounts_Handler,
-		},
-		{
-			MethodName: "GetAccountState",
-			Handler:    _ApiService_GetAccountState_Handler,
-		},
-		{
-			MethodName: "SendTransaction",
-			Handler:    _ApiService_SendTransaction_Handler,
-		},
-		{
-			MethodName: "Call",
-			Handler:    _ApiService_Call_Handler,
-		},
-		{
-			MethodName: "SendRawTransaction",
-			Handler:    _ApiService_SendRawTransaction_Handler,
-		},
-		{
-			MethodName: "GetBlockByHash",
-			Handler:    _ApiService_GetBlockByHash_Handler,
-		},
-		{
-			MethodName: "GetBlockByHeight",
-			Handler:    _ApiService_GetBlockByHeight_Handler,
-		},
-		{
-			MethodName: "GetTransactionReceipt",
-			Handler:    _ApiService_GetTransactionReceipt_Handler,
-		},
-		{
-			MethodName: "GetGasPrice",
-			Handler:    _ApiService_GetGasPrice_Handler,
-		},
-		{
-			MethodName: "EstimateGas",
-			Handler:    _ApiService_EstimateGas_Handler,
-		},
-		{
-			MethodName: "GetGasUsed",
-			Handler:    _ApiService_GetGasUsed_Handler,
-		},
-		{
-			MethodName: "GetEventsByHash",
-			Handler:    _ApiService_GetEventsByHash_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Subscribe",
-			Handler:       _ApiService_Subscribe_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "rpc.proto",
-}
-
-// Client API for AdminService service
-
-type AdminServiceClient interface {
-	// NewAccount create a new account with passphrase
-	NewAccount(ctx context.Context, in *NewAccountRequest, opts ...grpc.CallOption) (*NewAccountResponse, error)
-	// UnlockAccount unlock account with passphrase
-	UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error)
-	// LockAccount lock account
-	LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*LockAccountResponse, error)
-	// Sign sign transaction
-	SignTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error)
-	// SendTransactionWithPassphrase send transaction with passphrase
-	SendTransactionWithPassphrase(ctx context.Context, in *SendTransactionPassphraseRequest, opts ...grpc.CallOption) (*SendTransactionPassphraseResponse, error)
-	StatisticsNodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*StatisticsNodeInfoResponse, error)
-	GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error)
-	GetCandidates(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetCandidatesResponse, error)
-	GetDelegateVoters(ctx context.Context, in *GetDelegateVotersRequest, opts ...grpc.CallOption) (*GetDelegateVotersResponse, error)
-	ChangeNetworkID(ctx context.Context, in *ChangeNetworkIDRequest, opts ...grpc.CallOption) (*ChangeNetworkIDResponse, error)
-	StartMining(ctx context.Context, in *StartMiningRequest, opts ...grpc.CallOption) (*MiningResponse, error)
-	StopMining(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*MiningResponse, error)
-}
-
-type adminServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
-	return &adminServiceClient{cc}
-}
-
-func (c *adminServiceClient) NewAccount(ctx context.Context, in *NewAccountRequest, opts ...grpc.CallOption) (*NewAccountResponse, error) {
-	out := new(NewAccountResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/NewAccount", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *adminServiceClient) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error) {
-	out := new(UnlockAccountResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/UnlockAccount", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *adminServiceClient) LockAccount(ctx context.Context, in *LockAccountRequest, opts ...grpc.CallOption) (*LockAccountResponse, error) {
-	out := new(LockAccountResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/LockAccount", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *adminServiceClient) SignTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SignTransactionResponse, error) {
-	out := new(SignTransactionResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SignTransaction", in, out, c.cc, opts...)
-	if err != nil {
+func _AdminService_GetTransactionDependency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByBlockHeightRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *adminServiceClient) SendTransactionWithPassphrase(ctx context.Context, in *SendTransactionPassphraseRequest, opts ...grpc.CallOption) (*SendTransactionPassphraseResponse, error) {
-	out := new(SendTransactionPassphraseResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/SendTransactionWithPassphrase", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetTransactionDependency(ctx, in)
 	}
-	return out, nil
-}
-
-func (c *adminServiceClient) StatisticsNodeInfo(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*StatisticsNodeInfoResponse, error) {
-	out := new(StatisticsNodeInfoResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StatisticsNodeInfo", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetTransactionDependency",
 	}
-	return out, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetTransactionDependency(ctx, req.(*ByBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *adminServiceClient) GetDynasty(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetDynastyResponse, error) {
-	out := new(GetDynastyResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetDynasty", in, out, c.cc, opts...)
-	if err != nil {
+func _AdminService_ChangeNetworkID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeNetworkIDRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ChangeNetworkID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/ChangeNetworkID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ChangeNetworkID(ctx, req.(*ChangeNetworkIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *adminServiceClient) GetCandidates(ctx context.Context, in *ByBlockHeightRequest, opts ...grpc.CallOption) (*GetCandidatesResponse, error) {
-	out := new(GetCandidatesResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetCandidates", in, out, c.cc, opts...)
-	if err != nil {
+func _AdminService_ReloadPeerAccessControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerAccessControlRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReloadPeerAccessControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/ReloadPeerAccessControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReloadPeerAccessControl(ctx, req.(*PeerAccessControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *adminServiceClient) GetDelegateVoters(ctx context.Context, in *GetDelegateVotersRequest, opts ...grpc.CallOption) (*GetDelegateVotersResponse, error) {
-	out := new(GetDelegateVotersResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/GetDelegateVoters", in, out, c.cc, opts...)
-	if err != nil {
+func _AdminService_StartMining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMiningRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartMining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/StartMining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartMining(ctx, req.(*StartMiningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *adminServiceClient) ChangeNetworkID(ctx context.Context, in *ChangeNetworkIDRequest, opts ...grpc.CallOption) (*ChangeNetworkIDResponse, error) {
-	out := new(ChangeNetworkIDResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/ChangeNetworkID", in, out, c.cc, opts...)
-	if err != nil {
+func _AdminService_StopMining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StopMining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/StopMining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StopMining(ctx, req.(*NonParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *adminServiceClient) StartMining(ctx context.Context, in *StartMiningRequest, opts ...grpc.CallOption) (*MiningResponse, error) {
-	out := new(MiningResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StartMining", in, out, c.cc, opts...)
-	if err != nil {
+func _AdminService_RegisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebhookRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RegisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RegisterWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RegisterWebhook(ctx, req.(*RegisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *adminServiceClient) StopMining(ctx context.Context, in *NonParamsRequest, opts ...grpc.CallOption) (*MiningResponse, error) {
-	out := new(MiningResponse)
-	err := grpc.Invoke(ctx, "/rpcpb.AdminService/StopMining", in, out, c.cc, opts...)
-	if err != nil {
+func _AdminService_UnregisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterWebhookRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// Server API for AdminService service
-
-type AdminServiceServer interface {
-	// NewAccount create a new account with passphrase
-	NewAccount(context.Context, *NewAccountRequest) (*NewAccountResponse, error)
-	// UnlockAccount unlock account with passphrase
-	UnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error)
-	// LockAccount lock account
-	LockAccount(context.Context, *LockAccountRequest) (*LockAccountResponse, error)
-	// Sign sign transaction
-	SignTransaction(context.Context, *TransactionRequest) (*SignTransactionResponse, error)
-	// SendTransactionWithPassphrase send transaction with passphrase
-	SendTransactionWithPassphrase(context.Context, *SendTransactionPassphraseRequest) (*SendTransactionPassphraseResponse, error)
-	StatisticsNodeInfo(context.Context, *NonParamsRequest) (*StatisticsNodeInfoResponse, error)
-	GetDynasty(context.Context, *ByBlockHeightRequest) (*GetDynastyResponse, error)
-	GetCandidates(context.Context, *ByBlockHeightRequest) (*GetCandidatesResponse, error)
-	GetDelegateVoters(context.Context, *GetDelegateVotersRequest) (*GetDelegateVotersResponse, error)
-	ChangeNetworkID(context.Context, *ChangeNetworkIDRequest) (*ChangeNetworkIDResponse, error)
-	StartMining(context.Context, *StartMiningRequest) (*MiningResponse, error)
-	StopMining(context.Context, *NonParamsRequest) (*MiningResponse, error)
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnregisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/UnregisterWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnregisterWebhook(ctx, req.(*UnregisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
-	s.RegisterService(&_AdminService_serviceDesc, srv)
+func _AdminService_StartPprof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PprofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).StartPprof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/StartPprof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).StartPprof(ctx, req.(*PprofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_NewAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewAccountRequest)
+func _AdminService_StopPprof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).NewAccount(ctx, in)
+		return srv.(AdminServiceServer).StopPprof(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/NewAccount",
+		FullMethod: "/rpcpb.AdminService/StopPprof",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).NewAccount(ctx, req.(*NewAccountRequest))
+		return srv.(AdminServiceServer).StopPprof(ctx, req.(*NonParamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_UnlockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockAccountRequest)
+func _AdminService_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).UnlockAccount(ctx, in)
+		return srv.(AdminServiceServer).SetLogLevel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/UnlockAccount",
+		FullMethod: "/rpcpb.AdminService/SetLogLevel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).UnlockAccount(ctx, req.(*UnlockAccountRequest))
+		return srv.(AdminServiceServer).SetLogLevel(ctx, req.(*LogLevelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_LockAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LockAccountRequest)
+func _AdminService_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NonParamsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).LockAccount(ctx, in)
+		return srv.(AdminServiceServer).GetConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/LockAccount",
+		FullMethod: "/rpcpb.AdminService/GetConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).LockAccount(ctx, req.(*LockAccountRequest))
+		return srv.(AdminServiceServer).GetConfig(ctx, req.(*NonParamsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_SignTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AdminService_CreateMultisigTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).SignTransaction(ctx, in)
+		return srv.(AdminServiceServer).CreateMultisigTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/SignTransaction",
+		FullMethod: "/rpcpb.AdminService/CreateMultisigTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).SignTransaction(ctx, req.(*TransactionRequest))
+		return srv.(AdminServiceServer).CreateMultisigTransaction(ctx, req.(*TransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_SendTransactionWithPassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendTransactionPassphraseRequest)
+func _AdminService_SignMultisigTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMultisigTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).SendTransactionWithPassphrase(ctx, in)
+		return srv.(AdminServiceServer).SignMultisigTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/SendTransactionWithPassphrase",
+		FullMethod: "/rpcpb.AdminService/SignMultisigTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).SendTransactionWithPassphrase(ctx, req.(*SendTransactionPassphraseRequest))
+		return srv.(AdminServiceServer).SignMultisigTransaction(ctx, req.(*SignMultisigTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_StatisticsNodeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NonParamsRequest)
+func _AdminService_GetMultisigTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).StatisticsNodeInfo(ctx, in)
+		return srv.(AdminServiceServer).GetMultisigTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/StatisticsNodeInfo",
+		FullMethod: "/rpcpb.AdminService/GetMultisigTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).StatisticsNodeInfo(ctx, req.(*NonParamsRequest))
+		return srv.(AdminServiceServer).GetMultisigTransaction(ctx, req.(*HashRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetDynasty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ByBlockHeightRequest)
+func _AdminService_SendMultisigTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendMultisigTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetDynasty(ctx, in)
+		return srv.(AdminServiceServer).SendMultisigTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/GetDynasty",
+		FullMethod: "/rpcpb.AdminService/SendMultisigTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetDynasty(ctx, req.(*ByBlockHeightRequest))
+		return srv.(AdminServiceServer).SendMultisigTransaction(ctx, req.(*SendMultisigTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ByBlockHeightRequest)
+func _AdminService_GetForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetCandidates(ctx, in)
+		return srv.(AdminServiceServer).GetForks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/GetCandidates",
+		FullMethod: "/rpcpb.AdminService/GetForks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetCandidates(ctx, req.(*ByBlockHeightRequest))
+		return srv.(AdminServiceServer).GetForks(ctx, req.(*GetForksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetDelegateVoters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDelegateVotersRequest)
+func _AdminService_GetContractStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetDelegateVoters(ctx, in)
+		return srv.(AdminServiceServer).GetContractStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/GetDelegateVoters",
+		FullMethod: "/rpcpb.AdminService/GetContractStats",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetDelegateVoters(ctx, req.(*GetDelegateVotersRequest))
+		return srv.(AdminServiceServer).GetContractStats(ctx, req.(*ContractStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_ChangeNetworkID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeNetworkIDRequest)
+func _AdminService_GetVoteSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ByBlockHeightRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).ChangeNetworkID(ctx, in)
+		return srv.(AdminServiceServer).GetVoteSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/ChangeNetworkID",
+		FullMethod: "/rpcpb.AdminService/GetVoteSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ChangeNetworkID(ctx, req.(*ChangeNetworkIDRequest))
+		return srv.(AdminServiceServer).GetVoteSnapshot(ctx, req.(*ByBlockHeightRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_StartMining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartMiningRequest)
+func _AdminService_DebugCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).StartMining(ctx, in)
+		return srv.(AdminServiceServer).DebugCall(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/StartMining",
+		FullMethod: "/rpcpb.AdminService/DebugCall",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).StartMining(ctx, req.(*StartMiningRequest))
+		return srv.(AdminServiceServer).DebugCall(ctx, req.(*TransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_StopMining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NonParamsRequest)
+func _AdminService_DebugTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).StopMining(ctx, in)
+		return srv.(AdminServiceServer).DebugTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.AdminService/StopMining",
+		FullMethod: "/rpcpb.AdminService/DebugTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).StopMining(ctx, req.(*NonParamsRequest))
+		return srv.(AdminServiceServer).DebugTransaction(ctx, req.(*HashRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_IterateAccounts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IterateAccountsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).IterateAccounts(m, &adminServiceIterateAccountsServer{stream})
+}
+
+type AdminService_IterateAccountsServer interface {
+	Send(*AccountEntry) error
+	grpc.ServerStream
+}
+
+type adminServiceIterateAccountsServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceIterateAccountsServer) Send(m *AccountEntry) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -2682,10 +7124,18 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetDelegateVoters",
 			Handler:    _AdminService_GetDelegateVoters_Handler,
 		},
+		{
+			MethodName: "GetTransactionDependency",
+			Handler:    _AdminService_GetTransactionDependency_Handler,
+		},
 		{
 			MethodName: "ChangeNetworkID",
 			Handler:    _AdminService_ChangeNetworkID_Handler,
 		},
+		{
+			MethodName: "ReloadPeerAccessControl",
+			Handler:    _AdminService_ReloadPeerAccessControl_Handler,
+		},
 		{
 			MethodName: "StartMining",
 			Handler:    _AdminService_StartMining_Handler,
@@ -2694,169 +7144,471 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StopMining",
 			Handler:    _AdminService_StopMining_Handler,
 		},
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _AdminService_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "UnregisterWebhook",
+			Handler:    _AdminService_UnregisterWebhook_Handler,
+		},
+		{
+			MethodName: "StartPprof",
+			Handler:    _AdminService_StartPprof_Handler,
+		},
+		{
+			MethodName: "StopPprof",
+			Handler:    _AdminService_StopPprof_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _AdminService_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _AdminService_GetConfig_Handler,
+		},
+		{
+			MethodName: "CreateMultisigTransaction",
+			Handler:    _AdminService_CreateMultisigTransaction_Handler,
+		},
+		{
+			MethodName: "SignMultisigTransaction",
+			Handler:    _AdminService_SignMultisigTransaction_Handler,
+		},
+		{
+			MethodName: "GetMultisigTransaction",
+			Handler:    _AdminService_GetMultisigTransaction_Handler,
+		},
+		{
+			MethodName: "SendMultisigTransaction",
+			Handler:    _AdminService_SendMultisigTransaction_Handler,
+		},
+		{
+			MethodName: "GetForks",
+			Handler:    _AdminService_GetForks_Handler,
+		},
+		{
+			MethodName: "GetContractStats",
+			Handler:    _AdminService_GetContractStats_Handler,
+		},
+		{
+			MethodName: "GetVoteSnapshot",
+			Handler:    _AdminService_GetVoteSnapshot_Handler,
+		},
+		{
+			MethodName: "DebugCall",
+			Handler:    _AdminService_DebugCall_Handler,
+		},
+		{
+			MethodName: "DebugTransaction",
+			Handler:    _AdminService_DebugTransaction_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "IterateAccounts",
+			Handler:       _AdminService_IterateAccounts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
 }
 
 func init() { proto.RegisterFile("rpc.proto", fileDescriptorRpc) }
 
 var fileDescriptorRpc = []byte{
-	// 2481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x19, 0xd9, 0x6e, 0x23, 0xb9,
-	0x11, 0x92, 0x2f, 0xa9, 0x24, 0x5f, 0xf4, 0x25, 0xcb, 0xe7, 0x70, 0x76, 0xb3, 0x5e, 0x03, 0x6b,
-	0xef, 0x78, 0x8f, 0x01, 0x36, 0x40, 0x80, 0x19, 0xcf, 0xc2, 0xeb, 0x60, 0x32, 0x70, 0xda, 0x7b,
-	0x00, 0x41, 0x36, 0x02, 0xd5, 0xcd, 0x91, 0x1b, 0x23, 0x75, 0x2b, 0x4d, 0xca, 0xc7, 0x04, 0x48,
-	0x80, 0xfd, 0x85, 0x3c, 0xe7, 0x25, 0x6f, 0x79, 0xca, 0x17, 0xe4, 0x21, 0xdf, 0x10, 0x20, 0x40,
-	0xde, 0xf3, 0x92, 0xbf, 0x08, 0x58, 0x24, 0xfb, 0x6e, 0x7b, 0xe6, 0xad, 0xab, 0x58, 0xac, 0x2a,
-	0xd6, 0x4d, 0x36, 0x34, 0xa3, 0xb1, 0x7b, 0x34, 0x8e, 0x42, 0x19, 0x92, 0x99, 0x68, 0xec, 0x8e,
-	0xfb, 0xdd, 0xed, 0x41, 0x18, 0x0e, 0x86, 0xfc, 0x98, 0x8d, 0xfd, 0x63, 0x16, 0x04, 0xa1, 0x64,
-	0xd2, 0x0f, 0x03, 0xa1, 0x89, 0xe8, 0x01, 0x2c, 0x5d, 0x4e, 0xfa, 0xc2, 0x8d, 0xfc, 0x3e, 0x77,
-	0xf8, 0xef, 0x27, 0x5c, 0x48, 0xb2, 0x0a, 0x33, 0x32, 0x1c, 0xfb, 0x6e, 0xa7, 0xb6, 0x3f, 0x75,
-	0xd0, 0x74, 0x34, 0x40, 0x9f, 0xc2, 0xfa, 0xe9, 0x15, 0x0b, 0x06, 0xfc, 0x15, 0x97, 0x37, 0x61,
-	0xf4, 0xe6, 0xfc, 0x85, 0xa5, 0xdf, 0x01, 0x08, 0x34, 0xae, 0xe7, 0x7b, 0x9d, 0xda, 0x7e, 0xed,
-	0x60, 0xde, 0x69, 0x1a, 0xcc, 0xb9, 0x47, 0x9f, 0xc0, 0x46, 0x61, 0xa3, 0x18, 0x87, 0x81, 0xe0,
-	0x64, 0x1d, 0x66, 0x23, 0x2e, 0x26, 0x43, 0x89, 0xbb, 0x1a, 0x8e, 0x81, 0xe8, 0x73, 0x58, 0x4e,
-	0x69, 0x65, 0x88, 0x37, 0xa1, 0x31, 0x12, 0x83, 0x9e, 0xbc, 0x1b, 0x73, 0x24, 0x6f, 0x3a, 0x73,
-	0x23, 0x31, 0xf8, 0xf6, 0x6e, 0xcc, 0x09, 0x81, 0x69, 0x8f, 0x49, 0xd6, 0xa9, 0x23, 0x1a, 0xbf,
-	0x29, 0x81, 0xa5, 0x57, 0x61, 0x70, 0xc1, 0x22, 0x36, 0x12, 0x46, 0x53, 0xfa, 0xb7, 0x29, 0x85,
-	0xf4, 0xf8, 0x79, 0xf0, 0x3a, 0x8c, 0xf9, 0x2e, 0x40, 0xdd, 0xa8, 0xdd, 0x74, 0xea, 0xbe, 0xa7,
-	0xe4, 0xb8, 0x57, 0xcc, 0x0f, 0xd4, 0x61, 0xea, 0x78, 0x98, 0x39, 0x84, 0xcf, 0x3d, 0xd2, 0x81,
-	0xb9, 0x6b, 0x1e, 0x09, 0x3f, 0x0c, 0x3a, 0x53, 0x7a, 0xc5, 0x80, 0xca, 0x06, 0x63, 0xce, 0xa3,
-	0x9e, 0x1b, 0x4e, 0x02, 0xd9, 0x99, 0xd6, 0x36, 0x50, 0x98, 0x53, 0x85, 0x20, 0x14, 0xda, 0xe2,
-	0x2e, 0x70, 0xaf, 0xa2, 0x30, 0xf0, 0xdf, 0x72, 0xaf, 0x33, 0x83, 0xc7, 0xcd, 0xe0, 0xc8, 0x1e,
-	0xb4, 0xfa, 0x13, 0xf7, 0x0d, 0x97, 0x3d, 0xe1, 0xbf, 0xe5, 0x9d, 0xd9, 0xfd, 0xda, 0xc1, 0x8c,
-	0x03, 0x1a, 0x75, 0xe9, 0xbf, 0xe5, 0xe4, 0x00, 0x96, 0x22, 0x3e, 0x64, 0x77, 0x3d, 0x97, 0xb9,
-	0x57, 0x5c, 0x53, 0xcd, 0x21, 0xd5, 0x02, 0xe2, 0x4f, 0x15, 0x1a, 0x29, 0x0f, 0x61, 0x59, 0xc8,
-	0x88, 0xb3, 0x51, 0x4f, 0xc8, 0x30, 0x32, 0xa4, 0x0d, 0x24, 0x5d, 0xd4, 0x0b, 0x97, 0x0a, 0x8f,
-	0xb4, 0x4f, 0xa1, 0x93, 0xa1, 0xe5, 0xb7, 0x92, 0x07, 0x9e, 0xde, 0xd2, 0xc4, 0x2d, 0x6b, 0xa9,
-	0x2d, 0x5f, 0xe3, 0x2a, 0x6e, 0xfc, 0x18, 0x96, 0x30, 0x86, 0xdc, 0x70, 0xd8, 0xb3, 0x56, 0x01,
-	0xb4, 0xe2, 0xa2, 0xc5, 0x7f, 0x6f, 0xac, 0x73, 0x02, 0xad, 0x28, 0x9c, 0x48, 0xde, 0x93, 0xac,
-	0x3f, 0xe4, 0x9d, 0xd6, 0xfe, 0xd4, 0x41, 0xeb, 0x64, 0xf9, 0x08, 0x03, 0xf4, 0xc8, 0x51, 0x2b,
-	0xdf, 0xaa, 0x05, 0x07, 0xa2, 0xf8, 0x9b, 0xfe, 0x11, 0xba, 0x97, 0x2a, 0x56, 0x85, 0xf4, 0x5d,
-	0x51, 0x70, 0xda, 0x3a, 0xcc, 0x22, 0xee, 0x85, 0x71, 0x9c, 0x81, 0x14, 0xfe, 0x1b, 0xee, 0x0f,
-	0xae, 0x24, 0xba, 0x6e, 0xda, 0x31, 0x90, 0x8a, 0x90, 0x6f, 0x98, 0xb8, 0x42, 0xb7, 0x35, 0x1d,
-	0xfc, 0x26, 0xdb, 0xd0, 0xbc, 0xb0, 0x1e, 0xb2, 0x2e, 0x8b, 0x11, 0xf4, 0x4b, 0x80, 0x44, 0xb3,
-	0x42, 0x90, 0x74, 0x60, 0x8e, 0x79, 0x5e, 0xc4, 0x85, 0xe8, 0xd4, 0x31, 0x4b, 0x2c, 0x48, 0xff,
-	0x52, 0x87, 0x95, 0x33, 0x2e, 0x5f, 0xf1, 0xbe, 0x52, 0x3f, 0x13, 0xbe, 0x71, 0x58, 0xd5, 0xb2,
-	0x61, 0x45, 0x60, 0x5a, 0x32, 0x7f, 0x68, 0xc3, 0x57, 0x7d, 0xab, 0x83, 0x5c, 0xe9, 0x83, 0x4c,
-	0xe9, 0x83, 0x68, 0x88, 0x74, 0xa1, 0xe1, 0x86, 0x7e, 0xd0, 0x67, 0x82, 0xa3, 0xce, 0x4d, 0x27,
-	0x86, 0x73, 0x41, 0x38, 0x93, 0x0f, 0xc2, 0x2d, 0x68, 0xfa, 0xa2, 0x37, 0xf2, 0x03, 0x3f, 0x18,
-	0x60, 0x78, 0x35, 0x9c, 0x86, 0x2f, 0x7e, 0x85, 0x70, 0xa9, 0x37, 0xe7, 0xca, 0xbd, 0x99, 0x0f,
-	0xe6, 0x46, 0x49, 0x30, 0xa7, 0x32, 0xa5, 0xa9, 0x73, 0xd5, 0x80, 0xf4, 0x53, 0x58, 0x7a, 0xe6,
-	0xa2, 0x86, 0x22, 0xb6, 0xcd, 0x36, 0x34, 0x8d, 0xf9, 0xb8, 0x30, 0x55, 0x27, 0x41, 0xd0, 0x5f,
-	0xc2, 0xfa, 0x19, 0x97, 0x66, 0x93, 0x31, 0xaa, 0xae, 0x3c, 0x29, 0x2f, 0x98, 0x8a, 0x60, 0xc0,
-	0x94, 0xf9, 0xea, 0x69, 0xf3, 0xd1, 0x73, 0xd8, 0x28, 0xf0, 0x32, 0x4a, 0x74, 0x60, 0xae, 0xcf,
-	0x86, 0x2c, 0x70, 0xe3, 0xf2, 0x62, 0x40, 0x55, 0x10, 0x83, 0x50, 0xe1, 0xb5, 0x83, 0x34, 0x40,
-	0x7f, 0x06, 0xed, 0x53, 0x36, 0x1c, 0x56, 0x14, 0xb3, 0x66, 0x5c, 0xcc, 0x8e, 0x60, 0xf5, 0xf9,
-	0xdd, 0xf3, 0x61, 0xe8, 0xbe, 0xd1, 0xb1, 0x68, 0x95, 0x4f, 0x54, 0xac, 0x65, 0x54, 0x7c, 0x0a,
-	0x6b, 0x67, 0x5c, 0x9e, 0xb2, 0xc0, 0xf3, 0x3d, 0x26, 0x79, 0x62, 0xa5, 0x5d, 0x00, 0x37, 0xc6,
-	0x1a, 0x33, 0xa5, 0x30, 0xf4, 0x73, 0x20, 0x67, 0x5c, 0xbe, 0xb8, 0x0b, 0x98, 0x90, 0x77, 0xe9,
-	0x5d, 0x1e, 0x1f, 0xf2, 0x01, 0x93, 0x3c, 0xd9, 0x95, 0x60, 0xe8, 0x05, 0x74, 0xd4, 0x2e, 0x83,
-	0xf8, 0x3e, 0x94, 0x3c, 0xb2, 0xf5, 0x52, 0xf9, 0x25, 0xa6, 0x34, 0xa7, 0x4a, 0x10, 0x95, 0x36,
-	0xfe, 0x0c, 0x36, 0x4b, 0x38, 0x26, 0x56, 0xba, 0x46, 0x8c, 0x51, 0xc5, 0x40, 0xf4, 0x1f, 0x75,
-	0x20, 0xdf, 0x46, 0x2c, 0x10, 0xcc, 0x55, 0xfd, 0xc9, 0x6a, 0x40, 0x60, 0xfa, 0x75, 0x14, 0x8e,
-	0x8c, 0x70, 0xfc, 0x56, 0xb9, 0x28, 0x43, 0xe3, 0x8b, 0xba, 0x0c, 0x95, 0x7b, 0xae, 0xd9, 0x70,
-	0xc2, 0x4d, 0x72, 0x6b, 0x20, 0x71, 0xda, 0x34, 0x2a, 0xa7, 0x01, 0x95, 0x03, 0x03, 0x26, 0x7a,
-	0xe3, 0xc8, 0x77, 0x39, 0x66, 0x48, 0xd3, 0x69, 0x0c, 0x98, 0xb8, 0x50, 0xb0, 0x5d, 0x1c, 0xfa,
-	0x23, 0x5f, 0x62, 0x82, 0xe8, 0xc5, 0x97, 0x0a, 0x26, 0x27, 0x2a, 0xf1, 0x02, 0x19, 0x31, 0x57,
-	0x62, 0x62, 0xb4, 0x4e, 0xd6, 0x4d, 0x01, 0x3b, 0x35, 0x68, 0xa3, 0xb3, 0x13, 0xd3, 0x91, 0x2f,
-	0xa0, 0x19, 0xfb, 0x07, 0xd3, 0xa4, 0x75, 0xb2, 0x61, 0x37, 0x59, 0xbc, 0xdd, 0x95, 0x50, 0x2a,
-	0x51, 0xd6, 0xca, 0x98, 0x3d, 0x89, 0x28, 0x6b, 0xd4, 0x58, 0x94, 0xa5, 0xa3, 0x6f, 0x61, 0x31,
-	0xa7, 0x87, 0x32, 0xb5, 0x08, 0x27, 0x51, 0x1c, 0xcf, 0x06, 0x52, 0x8d, 0x46, 0x7f, 0xe9, 0x5e,
-	0xaa, 0x0d, 0x09, 0x1a, 0x85, 0xed, 0xb4, 0x0b, 0x8d, 0xd7, 0x93, 0x00, 0xfd, 0x60, 0x6c, 0x1a,
-	0xc3, 0xca, 0x21, 0x2c, 0x1a, 0x08, 0x53, 0x7b, 0xf0, 0x9b, 0x1e, 0xc2, 0x52, 0xfe, 0x38, 0x4a,
-	0xb8, 0xf6, 0xa4, 0x15, 0xae, 0x21, 0x7a, 0x06, 0x8b, 0xb9, 0x43, 0x54, 0x91, 0x66, 0xa3, 0xaf,
-	0x9e, 0x8b, 0x3e, 0x7a, 0x0c, 0x9b, 0x97, 0x3c, 0xf0, 0x1c, 0x76, 0x53, 0x1e, 0x36, 0x38, 0x10,
-	0x28, 0x86, 0x6d, 0x33, 0x10, 0x48, 0xd8, 0x50, 0x1b, 0x32, 0xd4, 0x49, 0x50, 0xca, 0xdb, 0x2b,
-	0xd5, 0x1f, 0x8c, 0x06, 0x1a, 0x52, 0x45, 0xd1, 0xfa, 0xb2, 0x97, 0x94, 0x7b, 0x2c, 0x8a, 0x16,
-	0xff, 0x2c, 0x29, 0x38, 0x26, 0xfb, 0xa7, 0x32, 0xa3, 0xcc, 0xf7, 0x98, 0xcd, 0x98, 0xfe, 0xcf,
-	0xef, 0x54, 0xdb, 0x49, 0xa9, 0x98, 0x92, 0x38, 0x6d, 0xe5, 0xbd, 0x9e, 0x0c, 0x87, 0x3d, 0x99,
-	0xe8, 0x88, 0xf2, 0x1a, 0xce, 0xa2, 0xc2, 0xa7, 0x54, 0xa7, 0xbf, 0xc5, 0x42, 0x66, 0xf9, 0xbe,
-	0x4b, 0x61, 0x79, 0x1f, 0xee, 0x4f, 0x60, 0xeb, 0x8c, 0xcb, 0x14, 0xe6, 0x41, 0xdd, 0xd5, 0x24,
-	0x89, 0xda, 0xbc, 0x98, 0x8c, 0xc6, 0xa9, 0x49, 0x52, 0xf7, 0xa2, 0x1a, 0x0e, 0x12, 0x1a, 0xa0,
-	0x1f, 0xc1, 0x72, 0x8a, 0xd2, 0xb8, 0x20, 0xed, 0x31, 0x3b, 0xc2, 0xfd, 0x7d, 0x0a, 0xe6, 0x91,
-	0x32, 0x4d, 0x55, 0x30, 0xda, 0x1e, 0xb4, 0xc6, 0x2c, 0xe2, 0x81, 0xec, 0xe1, 0x92, 0x09, 0x67,
-	0x8d, 0xc2, 0x3e, 0x5f, 0xd5, 0x4a, 0xcb, 0x2b, 0x44, 0xba, 0xc1, 0xce, 0xe4, 0x1a, 0xec, 0x2a,
-	0xcc, 0x8c, 0xfc, 0x80, 0x47, 0xa6, 0x38, 0x68, 0x40, 0xc5, 0xa9, 0xf4, 0x47, 0x5c, 0x48, 0x36,
-	0x1a, 0x63, 0x69, 0x98, 0x72, 0x12, 0x44, 0xa6, 0xef, 0x37, 0xb2, 0x7d, 0x7f, 0x07, 0x40, 0xa8,
-	0x16, 0xd4, 0x8b, 0xc2, 0x50, 0x76, 0x5a, 0x3a, 0xc2, 0x11, 0xe3, 0x84, 0xa1, 0x54, 0x3b, 0xe5,
-	0xad, 0xd0, 0x8b, 0x6d, 0xdd, 0x91, 0xe4, 0xad, 0xc0, 0xa5, 0x3d, 0x68, 0xf1, 0x6b, 0x1e, 0x48,
-	0xb3, 0x3a, 0xaf, 0xcf, 0xac, 0x51, 0x48, 0xf0, 0x05, 0xb4, 0xbd, 0x71, 0x28, 0x7a, 0x2a, 0x4c,
-	0xf9, 0xad, 0xec, 0x2c, 0x60, 0x19, 0x21, 0xb6, 0x8c, 0x8c, 0x43, 0x71, 0xaa, 0x57, 0x9c, 0x96,
-	0x97, 0x00, 0xe4, 0x17, 0xd0, 0x4e, 0x45, 0x87, 0xe8, 0x78, 0x38, 0xa9, 0x75, 0xcd, 0xb6, 0x92,
-	0xd4, 0x71, 0x32, 0xf4, 0xf4, 0x7f, 0x35, 0x68, 0xa5, 0x98, 0x93, 0x47, 0xd0, 0xf6, 0x74, 0x3f,
-	0xd2, 0x8a, 0x6a, 0xbf, 0xb5, 0x0c, 0x0e, 0x35, 0x3d, 0x84, 0xe5, 0x80, 0xdf, 0xca, 0x5e, 0x86,
-	0xce, 0x24, 0x99, 0x5a, 0x78, 0x91, 0xa2, 0x7d, 0x0c, 0xf3, 0xb6, 0x00, 0x68, 0x3a, 0x5d, 0x9d,
-	0xda, 0x16, 0x89, 0x44, 0x1f, 0xc2, 0x42, 0x5c, 0x4a, 0x35, 0x95, 0xae, 0x55, 0xf3, 0x31, 0x16,
-	0xc9, 0xb6, 0xa0, 0xa9, 0x5a, 0x8f, 0xa6, 0x30, 0x8e, 0x56, 0x08, 0x5c, 0xa4, 0x30, 0x3f, 0xf2,
-	0x03, 0xd9, 0x73, 0x03, 0xa9, 0x09, 0xb4, 0xc3, 0x5b, 0x0a, 0x79, 0x1a, 0x48, 0x45, 0x43, 0xff,
-	0x5d, 0x87, 0x95, 0xb2, 0x62, 0x52, 0x16, 0xa3, 0x1d, 0xb0, 0x4e, 0xcf, 0x5f, 0x29, 0x6c, 0x83,
-	0x9b, 0x2a, 0x34, 0xb8, 0xe9, 0x62, 0x83, 0x9b, 0x29, 0x6d, 0x70, 0xb3, 0xe9, 0xf0, 0xbd, 0x3f,
-	0x18, 0xd5, 0xa4, 0xa9, 0x6a, 0x7e, 0xc3, 0x4c, 0x9a, 0xe9, 0xcb, 0x53, 0x33, 0xa9, 0x95, 0xd9,
-	0x36, 0x09, 0xf7, 0xb5, 0xc9, 0x56, 0xae, 0x4d, 0x96, 0x95, 0xcc, 0x76, 0x65, 0xc9, 0x54, 0xc1,
-	0x3e, 0x11, 0x18, 0xbf, 0xf3, 0x8e, 0x81, 0xe8, 0x67, 0xb0, 0xfc, 0x8a, 0xdf, 0x98, 0x19, 0xcd,
-	0x96, 0x92, 0x5d, 0x80, 0x31, 0x13, 0x62, 0x7c, 0x15, 0xa9, 0xc4, 0xac, 0xd9, 0x24, 0xb7, 0x18,
-	0x7a, 0x04, 0x24, 0xbd, 0x29, 0x99, 0xe9, 0xca, 0x07, 0x44, 0x3a, 0x84, 0xd5, 0xef, 0x02, 0x55,
-	0x5b, 0x72, 0x72, 0xaa, 0x47, 0xca, 0xac, 0x06, 0xf5, 0xbc, 0x06, 0xaa, 0x70, 0x78, 0x93, 0x88,
-	0xc5, 0x5d, 0x73, 0xda, 0x89, 0x61, 0x7a, 0x0c, 0x6b, 0x39, 0x69, 0x0f, 0xdc, 0x80, 0x8f, 0x80,
-	0xbc, 0x7c, 0x0f, 0xe5, 0xe8, 0x27, 0xb0, 0xf2, 0xf2, 0x3d, 0xd8, 0x7f, 0x02, 0x1b, 0x97, 0xfe,
-	0x20, 0xa8, 0x08, 0xdf, 0x42, 0xeb, 0xfc, 0x13, 0xec, 0xe7, 0x5a, 0xe7, 0x45, 0x7c, 0x6e, 0xab,
-	0xdb, 0xcf, 0xa1, 0x95, 0x6e, 0x2c, 0x35, 0x2c, 0x38, 0x9b, 0x65, 0x95, 0x43, 0x8f, 0x2e, 0x69,
-	0xea, 0x87, 0x6c, 0x4b, 0x9f, 0xc2, 0xa3, 0x7b, 0x14, 0xa8, 0x4e, 0x3c, 0x7a, 0x0c, 0x4b, 0x67,
-	0x26, 0x6e, 0x63, 0xba, 0x4c, 0x70, 0xd7, 0xb2, 0xc1, 0x4d, 0x1f, 0x41, 0xeb, 0xa1, 0x4e, 0xb7,
-	0x07, 0xad, 0x33, 0x96, 0x4c, 0xb4, 0x4b, 0x30, 0x35, 0x60, 0xd6, 0x21, 0xea, 0x93, 0x7e, 0x09,
-	0x0b, 0x5f, 0xeb, 0x52, 0x6c, 0x69, 0x3e, 0x80, 0x59, 0x5d, 0x9c, 0x71, 0xea, 0x6d, 0x9d, 0xb4,
-	0x8d, 0x5d, 0x90, 0xcc, 0x31, 0x6b, 0xf4, 0x09, 0xcc, 0x20, 0x22, 0xfd, 0x02, 0x53, 0x8b, 0x5f,
-	0x60, 0x4a, 0x5f, 0x39, 0x3e, 0x07, 0x72, 0x29, 0x59, 0x24, 0xf5, 0x2d, 0xee, 0x5d, 0x93, 0xe5,
-	0x00, 0x16, 0xec, 0x86, 0xfb, 0x03, 0xe5, 0xe4, 0x9f, 0xf3, 0x00, 0xcf, 0xc6, 0xfe, 0x25, 0x8f,
-	0xae, 0x55, 0xea, 0xff, 0x08, 0xad, 0xd4, 0xdd, 0x96, 0xd8, 0x61, 0x36, 0xff, 0xd0, 0xd2, 0xb5,
-	0x1d, 0xa3, 0xe4, 0x22, 0x4c, 0x37, 0x7f, 0xfa, 0xd7, 0x7f, 0xff, 0x5c, 0x5f, 0x21, 0xcb, 0xc7,
-	0xd7, 0x4f, 0x8e, 0x27, 0x82, 0x47, 0xc7, 0x01, 0xef, 0x63, 0xd7, 0x23, 0x3f, 0x40, 0xc3, 0xde,
-	0xf4, 0xab, 0x79, 0x27, 0x0b, 0xd9, 0x37, 0x81, 0x32, 0xc6, 0xa1, 0xc7, 0x7d, 0xc5, 0xec, 0x47,
-	0x68, 0xc6, 0x23, 0x47, 0xcc, 0x39, 0x3f, 0xae, 0x74, 0x3b, 0xc5, 0x05, 0xc3, 0x7a, 0x07, 0x59,
-	0x6f, 0x50, 0x12, 0xb3, 0xee, 0x2b, 0x1a, 0x6f, 0x32, 0x1a, 0x7f, 0x55, 0x3b, 0x24, 0xbf, 0x83,
-	0x8d, 0x97, 0xea, 0x0a, 0x26, 0xcf, 0xa3, 0x88, 0xe3, 0x45, 0xb7, 0x3f, 0xe4, 0xc8, 0xa5, 0xfa,
-	0x18, 0xab, 0x69, 0x61, 0xb1, 0xa0, 0x55, 0x14, 0xb4, 0x40, 0xda, 0xb1, 0xa0, 0xa1, 0xdf, 0x57,
-	0x76, 0xb1, 0x77, 0xe6, 0x87, 0xed, 0x92, 0xbf, 0x5d, 0x97, 0xd8, 0x85, 0x59, 0x66, 0x11, 0x2c,
-	0xe6, 0xae, 0xc3, 0x64, 0x27, 0x71, 0x5d, 0xc9, 0x95, 0xbb, 0xbb, 0x5b, 0xb5, 0x6c, 0x84, 0xed,
-	0xa3, 0xb0, 0x2e, 0x5d, 0x2b, 0x08, 0x53, 0x64, 0xca, 0x58, 0x23, 0x58, 0xcc, 0xe5, 0x32, 0xa9,
-	0x2e, 0x13, 0xb1, 0xbc, 0x8a, 0xd1, 0x9d, 0xee, 0xa1, 0xbc, 0x4d, 0xba, 0x1a, 0xcb, 0x4b, 0xd5,
-	0x15, 0x25, 0xee, 0x02, 0xa6, 0xd5, 0x35, 0xfd, 0x3e, 0x19, 0x2b, 0xf1, 0x9d, 0x2c, 0xb9, 0xce,
-	0xd3, 0x0e, 0x32, 0x26, 0x74, 0x3e, 0x66, 0xec, 0xb2, 0xe1, 0x50, 0x71, 0x7c, 0x0b, 0xa4, 0x78,
-	0xf3, 0x20, 0xfb, 0x29, 0x45, 0x4b, 0x2f, 0x25, 0x0f, 0x1e, 0x85, 0xa2, 0xc4, 0x6d, 0xba, 0x11,
-	0x4b, 0x8c, 0xd8, 0x4d, 0xee, 0x34, 0x57, 0xb0, 0x90, 0xbd, 0x4e, 0x90, 0xed, 0xc4, 0x21, 0xc5,
-	0x5b, 0x46, 0x45, 0x94, 0x15, 0x25, 0x0d, 0x32, 0xbb, 0x95, 0xa4, 0x00, 0x96, 0xf2, 0x17, 0x0c,
-	0xb2, 0x5b, 0x94, 0x95, 0xbe, 0x79, 0x54, 0x48, 0xfb, 0x00, 0xa5, 0xed, 0xd2, 0xcd, 0x32, 0x69,
-	0xb8, 0x5f, 0xc9, 0xfb, 0xa9, 0x86, 0x37, 0xa5, 0x8c, 0x61, 0x5c, 0xee, 0x8f, 0x25, 0xa1, 0x89,
-	0xd4, 0xaa, 0x1b, 0x49, 0xf7, 0x9e, 0x11, 0x95, 0x7e, 0x8c, 0xf2, 0x1f, 0xd3, 0xdd, 0xb4, 0xfc,
-	0xa2, 0x1c, 0xa5, 0x44, 0x0f, 0x9a, 0xf1, 0xc3, 0x73, 0x9c, 0x69, 0xf9, 0x07, 0xf2, 0xb8, 0x4e,
-	0x14, 0xde, 0xa8, 0x4b, 0xea, 0x84, 0xb0, 0x34, 0x5f, 0xd5, 0x0e, 0x3f, 0xad, 0x99, 0x02, 0x6a,
-	0x5b, 0xd2, 0xc3, 0xc9, 0x9c, 0x6f, 0x5e, 0x74, 0x1b, 0x25, 0xac, 0x93, 0xd5, 0xf4, 0x61, 0x62,
-	0x7e, 0x3f, 0x42, 0xeb, 0x6b, 0x21, 0xfd, 0x11, 0x93, 0xfc, 0x8c, 0x89, 0xfb, 0x62, 0x9e, 0x24,
-	0x02, 0xee, 0xc9, 0x25, 0x9e, 0x30, 0x53, 0xe6, 0xf9, 0x35, 0x80, 0xd6, 0xfe, 0x3b, 0xc1, 0x3d,
-	0x62, 0x59, 0xa4, 0xfd, 0x50, 0xc6, 0x76, 0x0b, 0xd9, 0xae, 0x91, 0x95, 0x9c, 0xca, 0xc8, 0x84,
-	0x61, 0x05, 0xd2, 0xed, 0xd2, 0x44, 0x74, 0x19, 0xdf, 0xb5, 0x74, 0xc3, 0x4c, 0x58, 0x3f, 0x46,
-	0xd6, 0x3b, 0xb4, 0x93, 0x66, 0x9d, 0x66, 0xf6, 0x55, 0xed, 0xf0, 0xe4, 0x3f, 0x00, 0xed, 0x67,
-	0xde, 0xc8, 0x0f, 0x6c, 0x17, 0x73, 0x01, 0x92, 0x59, 0x91, 0x58, 0x6f, 0x16, 0x66, 0xce, 0xee,
-	0x66, 0xc9, 0x4a, 0x59, 0x99, 0x63, 0x8a, 0xb9, 0xad, 0x73, 0xc7, 0x01, 0xbf, 0x51, 0xb6, 0x0a,
-	0x61, 0x3e, 0x33, 0xf2, 0x91, 0x2d, 0xc3, 0xad, 0x6c, 0xec, 0xec, 0x6e, 0x97, 0x2f, 0x96, 0x1d,
-	0x33, 0x2b, 0x6d, 0x82, 0x1b, 0x94, 0xc0, 0x01, 0xb4, 0x52, 0x23, 0x60, 0xec, 0xfb, 0xe2, 0x18,
-	0x19, 0x27, 0x4b, 0xc9, 0xc4, 0x48, 0x1f, 0xa1, 0xa8, 0x2d, 0xba, 0x5e, 0x14, 0x95, 0x08, 0x5a,
-	0xcc, 0x0d, 0x8f, 0xef, 0x54, 0xc0, 0xcb, 0xe7, 0x4d, 0xdb, 0x9d, 0xe8, 0x42, 0x22, 0x50, 0xf8,
-	0x03, 0x2c, 0x76, 0x7f, 0xad, 0xc1, 0x4e, 0xae, 0x58, 0xfe, 0xe0, 0xcb, 0xab, 0x64, 0xf4, 0x23,
-	0x1f, 0x95, 0x97, 0xd4, 0xc2, 0x74, 0xda, 0x3d, 0x78, 0x98, 0xd0, 0xe8, 0x73, 0x84, 0xfa, 0x1c,
-	0xd0, 0xc7, 0x89, 0x3e, 0xb2, 0x4a, 0xbe, 0x52, 0xf2, 0x06, 0x27, 0xb0, 0xdc, 0x7f, 0x8a, 0xea,
-	0xc4, 0x7e, 0x64, 0x15, 0xa9, 0xfc, 0xb7, 0x41, 0x3f, 0x44, 0x0d, 0xf6, 0xc8, 0x4e, 0xca, 0x22,
-	0x31, 0x35, 0xce, 0x34, 0x28, 0xa2, 0x8f, 0xc9, 0x68, 0xae, 0xc7, 0x71, 0x74, 0x95, 0x3d, 0x35,
-	0xc7, 0x81, 0x5c, 0x7c, 0x1e, 0xb6, 0xf5, 0x84, 0x2e, 0x27, 0xc2, 0xcc, 0x4d, 0x5c, 0x1d, 0xee,
-	0x0d, 0xcc, 0x67, 0xde, 0xa2, 0xef, 0x17, 0x93, 0x6a, 0x45, 0xc5, 0xe7, 0xeb, 0x6c, 0x75, 0xd1,
-	0x92, 0x92, 0xc7, 0x6b, 0x25, 0xec, 0x0f, 0xb0, 0x5c, 0x78, 0x37, 0x26, 0x7b, 0x29, 0xd5, 0xcb,
-	0xde, 0xa8, 0xbb, 0xfb, 0xd5, 0x04, 0xd5, 0xd9, 0xe3, 0x65, 0x28, 0x95, 0xf0, 0x6b, 0x58, 0xcc,
-	0xfd, 0xa5, 0x8c, 0x27, 0xa1, 0xf2, 0xdf, 0x9e, 0x71, 0x60, 0x57, 0xfc, 0xdc, 0xcc, 0xb6, 0x3d,
-	0x73, 0xde, 0x2c, 0xa9, 0x92, 0xcb, 0xa0, 0x95, 0x1a, 0xe0, 0xe3, 0x44, 0x2a, 0x0e, 0xf5, 0x71,
-	0x09, 0xcc, 0x4e, 0xee, 0x65, 0x95, 0x48, 0x24, 0x9b, 0x95, 0x88, 0xdf, 0x00, 0x5c, 0xca, 0x70,
-	0x6c, 0x24, 0x54, 0x46, 0x66, 0x05, 0xff, 0x4c, 0xc3, 0xb1, 0xfc, 0x2d, 0xb7, 0xfe, 0x2c, 0xfe,
-	0x1c, 0xfa, 0xec, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x07, 0xbb, 0x14, 0x78, 0x1e, 0x00,
-	0x00,
+	// 6267 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3c, 0x5d, 0x6f, 0x1c, 0xc9,
+	0x71, 0x18, 0x2e, 0x29, 0x72, 0x6b, 0xf9, 0x39, 0xa2, 0xa4, 0xe5, 0xea, 0x8b, 0x6a, 0xf9, 0x2c,
+	0x9d, 0xec, 0x13, 0xef, 0x74, 0x3e, 0x5f, 0x62, 0x23, 0xb6, 0x75, 0x94, 0x4e, 0x27, 0x44, 0xba,
+	0xc8, 0x43, 0xde, 0x29, 0x4e, 0x72, 0xde, 0x0c, 0x67, 0x9b, 0xcb, 0x81, 0x76, 0x67, 0xd6, 0x33,
+	0xbd, 0x14, 0x79, 0x41, 0xe2, 0xaf, 0x04, 0x30, 0xf2, 0x10, 0x24, 0x70, 0x80, 0x20, 0x41, 0xf2,
+	0xe2, 0x24, 0x8f, 0x41, 0x9e, 0x93, 0xb7, 0x04, 0xf9, 0x03, 0xc1, 0xfd, 0x85, 0x20, 0x6f, 0xf9,
+	0x0f, 0x41, 0x55, 0x7f, 0x4c, 0xcf, 0x4c, 0xcf, 0x52, 0x32, 0x0c, 0xbf, 0x6d, 0x55, 0xd7, 0x74,
+	0x55, 0x57, 0x77, 0xd7, 0x57, 0x77, 0x2f, 0xb4, 0xb3, 0x49, 0x74, 0x77, 0x92, 0xa5, 0x22, 0xf5,
+	0x17, 0xb2, 0x49, 0x34, 0x39, 0xe8, 0x5d, 0x19, 0xa6, 0xe9, 0x70, 0xc4, 0x77, 0xc2, 0x49, 0xbc,
+	0x13, 0x26, 0x49, 0x2a, 0x42, 0x11, 0xa7, 0x49, 0x2e, 0x89, 0xd8, 0xa7, 0xd0, 0x7d, 0xc6, 0x79,
+	0x76, 0x3f, 0x8a, 0x78, 0x9e, 0xef, 0xa6, 0x89, 0xc8, 0xd2, 0x51, 0xc0, 0x7f, 0x30, 0xe5, 0xb9,
+	0xf0, 0xaf, 0x02, 0x84, 0xa3, 0x51, 0xfa, 0xb2, 0x3f, 0x8a, 0x73, 0xd1, 0xf5, 0xb6, 0x5b, 0xb7,
+	0xdb, 0x41, 0x9b, 0x30, 0x4f, 0xe2, 0x5c, 0xf8, 0x97, 0xa1, 0x3d, 0xe0, 0xc9, 0xa9, 0x6c, 0x9d,
+	0xa3, 0xd6, 0x25, 0x44, 0x60, 0x23, 0x7b, 0x17, 0xb6, 0x1c, 0xfd, 0xe6, 0x93, 0x34, 0xc9, 0xb9,
+	0x7f, 0x11, 0xce, 0x65, 0x3c, 0x9f, 0x8e, 0xb0, 0x53, 0xef, 0xf6, 0x52, 0xa0, 0x20, 0xf6, 0x5d,
+	0x58, 0xdf, 0x9b, 0x1e, 0xe4, 0x51, 0x16, 0x1f, 0x70, 0x2d, 0xc4, 0x26, 0x2c, 0x88, 0x74, 0x12,
+	0x47, 0x8a, 0xbf, 0x04, 0xfc, 0x5b, 0xb0, 0x96, 0x1e, 0xf3, 0xec, 0x10, 0xa5, 0x9b, 0xa4, 0xa3,
+	0x38, 0x3a, 0xed, 0xce, 0x6d, 0x7b, 0xb7, 0xdb, 0xc1, 0xaa, 0x46, 0x3f, 0x23, 0x2c, 0x7b, 0x0e,
+	0x97, 0x4d, 0x97, 0xfb, 0x59, 0x98, 0xe4, 0x61, 0x84, 0xc3, 0xd7, 0xbd, 0xfb, 0x30, 0x7f, 0x14,
+	0xe6, 0x47, 0x24, 0x47, 0x3b, 0xa0, 0xdf, 0xfe, 0x97, 0x60, 0x25, 0x4a, 0x93, 0xc3, 0x38, 0x1b,
+	0x4b, 0x4d, 0x51, 0xcf, 0xf3, 0x41, 0x19, 0xc9, 0x7e, 0xe1, 0xc1, 0x96, 0xd5, 0xe1, 0x9e, 0x08,
+	0xc5, 0x34, 0x37, 0x23, 0x74, 0xf5, 0xbb, 0x09, 0x0b, 0xb9, 0x08, 0x05, 0x57, 0x92, 0x4a, 0x00,
+	0x75, 0x71, 0xc4, 0xe3, 0xe1, 0x91, 0xe8, 0xb6, 0x88, 0x8d, 0x82, 0x50, 0xf9, 0x07, 0xa3, 0x34,
+	0x7a, 0xd1, 0xa7, 0x7e, 0xe6, 0xe9, 0x93, 0x36, 0x61, 0x3e, 0x72, 0x0a, 0xb9, 0xe0, 0x12, 0xf2,
+	0x7d, 0xb8, 0xb8, 0x7b, 0x14, 0x26, 0x43, 0xfe, 0x31, 0x17, 0x2f, 0xd3, 0xec, 0xc5, 0xe3, 0x07,
+	0xd6, 0xdc, 0x26, 0x12, 0xd7, 0x8f, 0x07, 0x24, 0xe6, 0x4a, 0xd0, 0x56, 0x98, 0xc7, 0x03, 0xf6,
+	0x0e, 0x5c, 0xaa, 0x7d, 0x78, 0xc6, 0xe4, 0xfd, 0x10, 0x36, 0xac, 0xc9, 0x53, 0xc4, 0x5b, 0xb0,
+	0x34, 0xce, 0x87, 0x7d, 0x71, 0x3a, 0xe1, 0x4a, 0x17, 0x8b, 0xe3, 0x7c, 0xb8, 0x7f, 0x3a, 0x21,
+	0x15, 0x0d, 0x42, 0x11, 0x2a, 0x6d, 0xd0, 0x6f, 0xbf, 0x0b, 0x8b, 0x03, 0x1e, 0xa5, 0x03, 0x3e,
+	0x20, 0x6d, 0xb4, 0x03, 0x0d, 0xfa, 0x37, 0x60, 0x39, 0x8f, 0x8e, 0xf8, 0x38, 0xec, 0xf3, 0x2c,
+	0x4b, 0x33, 0xa5, 0x90, 0x8e, 0xc4, 0x3d, 0x44, 0x14, 0xf3, 0x61, 0xfd, 0xe3, 0x34, 0x79, 0x16,
+	0x66, 0xe1, 0x38, 0x57, 0xc3, 0x64, 0x3f, 0x9a, 0x47, 0xe4, 0x80, 0x3f, 0x4e, 0x0e, 0x53, 0x23,
+	0xd4, 0x2a, 0xcc, 0xa9, 0x31, 0xb7, 0x83, 0xb9, 0x78, 0x80, 0x42, 0x46, 0x47, 0x61, 0x9c, 0xa0,
+	0x26, 0xe6, 0x48, 0x13, 0x8b, 0x04, 0x3f, 0x1e, 0xa0, 0x40, 0xc7, 0x3c, 0xcb, 0xe3, 0x34, 0x21,
+	0x81, 0x56, 0x02, 0x0d, 0xa2, 0x02, 0x27, 0x9c, 0x67, 0xfd, 0x28, 0x9d, 0x26, 0x82, 0xc4, 0x59,
+	0x09, 0xda, 0x88, 0xd9, 0x45, 0x84, 0xcf, 0x60, 0x39, 0x3f, 0x4d, 0xa2, 0xa3, 0x2c, 0x4d, 0xe2,
+	0xcf, 0xf9, 0x80, 0xa6, 0x67, 0x29, 0x28, 0xe1, 0xfc, 0xeb, 0xd0, 0x39, 0x98, 0x46, 0x2f, 0xb8,
+	0xe8, 0xe7, 0xf1, 0xe7, 0xbc, 0x7b, 0x6e, 0xdb, 0xbb, 0xbd, 0x10, 0x80, 0x44, 0xed, 0xc5, 0x9f,
+	0x73, 0xff, 0x36, 0xac, 0x67, 0x7c, 0x14, 0x9e, 0xf6, 0xa3, 0x30, 0x3a, 0xe2, 0x92, 0x6a, 0x91,
+	0xa8, 0x56, 0x09, 0xbf, 0x8b, 0x68, 0xa2, 0xbc, 0x03, 0x1b, 0xb9, 0xc8, 0x78, 0x38, 0xee, 0xe7,
+	0x22, 0xcd, 0x14, 0xe9, 0x12, 0x91, 0xae, 0xc9, 0x86, 0x3d, 0xc4, 0x13, 0xed, 0xfb, 0xd0, 0x2d,
+	0xd1, 0xf2, 0x13, 0xc1, 0x93, 0x81, 0xfc, 0xa4, 0x4d, 0x9f, 0x5c, 0xb0, 0x3e, 0x79, 0x48, 0xad,
+	0xf4, 0xe1, 0x9b, 0xb0, 0x4e, 0x46, 0x23, 0x4a, 0x47, 0x7d, 0xad, 0x15, 0x20, 0x2d, 0xae, 0x69,
+	0xfc, 0xa7, 0x4a, 0x3b, 0xf7, 0xa0, 0x93, 0xa5, 0x53, 0xc1, 0xfb, 0x22, 0x3c, 0x18, 0xf1, 0x6e,
+	0x67, 0xbb, 0x75, 0xbb, 0x73, 0x6f, 0xe3, 0x2e, 0x59, 0xa4, 0xbb, 0x01, 0xb6, 0xec, 0x63, 0x43,
+	0x00, 0x99, 0xf9, 0xed, 0x33, 0x68, 0x25, 0xa1, 0xe8, 0x2e, 0x6f, 0x7b, 0xb7, 0x3b, 0xf7, 0xd6,
+	0x15, 0xed, 0xc7, 0xf7, 0xf7, 0xd5, 0xd6, 0xc2, 0x46, 0xff, 0x1d, 0xe8, 0x90, 0xd6, 0xf3, 0x28,
+	0xcd, 0x78, 0xde, 0x5d, 0xd9, 0x6e, 0x59, 0xb4, 0x68, 0x70, 0xf6, 0xb0, 0x21, 0x80, 0x89, 0xfe,
+	0x99, 0xb3, 0x7d, 0x68, 0x9b, 0x86, 0xda, 0xd4, 0xe3, 0x9e, 0xc4, 0x06, 0x9a, 0x77, 0x2f, 0x90,
+	0x00, 0x2e, 0xb6, 0x83, 0x30, 0x49, 0xf8, 0xa0, 0x3f, 0x4d, 0x44, 0x3c, 0xa2, 0xa9, 0x6f, 0x05,
+	0x1d, 0x89, 0xfb, 0x04, 0x51, 0xec, 0x3f, 0x3d, 0x68, 0x1b, 0xd9, 0x70, 0x99, 0xf0, 0x04, 0x07,
+	0x31, 0x50, 0x9b, 0x42, 0x83, 0xfe, 0x35, 0x80, 0x41, 0x9c, 0x47, 0x68, 0x95, 0xb8, 0x5c, 0x5d,
+	0x4b, 0x81, 0x85, 0x41, 0x56, 0x93, 0xe9, 0xc1, 0x28, 0x8e, 0xfa, 0xe1, 0x60, 0x90, 0xe5, 0xdd,
+	0x16, 0x59, 0xb9, 0x8e, 0xc4, 0xdd, 0x47, 0x94, 0xff, 0x16, 0x2c, 0x8d, 0xc3, 0xc9, 0x24, 0x4e,
+	0x86, 0x79, 0x77, 0xbe, 0xa4, 0xc8, 0x8f, 0xef, 0xef, 0x3f, 0x95, 0x2d, 0x81, 0x21, 0xc1, 0x59,
+	0x52, 0xbf, 0xfb, 0xa3, 0xf8, 0x90, 0x8b, 0x78, 0xcc, 0x69, 0xf5, 0xb5, 0x82, 0x35, 0x85, 0x7f,
+	0xa2, 0xd0, 0xec, 0x6f, 0x3c, 0x80, 0xa2, 0x0f, 0xbf, 0x07, 0x4b, 0x7a, 0x1e, 0x95, 0x8a, 0x0c,
+	0xec, 0xdf, 0x84, 0x95, 0x38, 0x11, 0x3c, 0x4b, 0xc2, 0x51, 0x7f, 0x92, 0x66, 0x42, 0x6d, 0x94,
+	0x65, 0x8d, 0x7c, 0x96, 0x66, 0x02, 0x89, 0xf8, 0x89, 0x84, 0x25, 0x91, 0xdc, 0x33, 0xcb, 0xfc,
+	0xa4, 0x81, 0x08, 0xc7, 0xac, 0xb6, 0xb2, 0x21, 0xc2, 0x41, 0xb3, 0x3f, 0x81, 0x1e, 0xaa, 0x36,
+	0xce, 0x45, 0x1c, 0xe5, 0xb5, 0x0d, 0x7c, 0x11, 0xce, 0x11, 0xee, 0x81, 0x12, 0x53, 0x41, 0x88,
+	0xff, 0x48, 0xda, 0x52, 0x69, 0xb2, 0x15, 0x84, 0xa6, 0x06, 0x8d, 0xa6, 0xb2, 0x29, 0xf4, 0xdb,
+	0xbf, 0x22, 0x97, 0xc5, 0xae, 0xbd, 0x7d, 0x0d, 0x82, 0x7d, 0x1d, 0xa0, 0x58, 0xa5, 0xb5, 0x55,
+	0xd3, 0x85, 0x45, 0x94, 0x9c, 0xe7, 0xb9, 0xf2, 0x7b, 0x1a, 0x64, 0xff, 0x30, 0x07, 0xe7, 0x1f,
+	0x71, 0xf1, 0x31, 0x3f, 0x40, 0xf1, 0x4b, 0x76, 0xd0, 0x98, 0x18, 0xaf, 0x6c, 0x62, 0x7c, 0x98,
+	0x17, 0x61, 0x3c, 0xd2, 0x76, 0x10, 0x7f, 0x37, 0x3a, 0x85, 0x1e, 0x2c, 0x45, 0x69, 0x9c, 0x1c,
+	0x84, 0x39, 0x57, 0x6a, 0x33, 0x70, 0xc5, 0x20, 0x2d, 0x54, 0x0d, 0xd2, 0x65, 0x68, 0xc7, 0x79,
+	0x7f, 0x1c, 0x27, 0x71, 0x32, 0x24, 0x53, 0xb3, 0x14, 0x2c, 0xc5, 0xf9, 0x53, 0x82, 0x9d, 0x3b,
+	0x7b, 0xd1, 0xbd, 0xb3, 0xab, 0x86, 0x6d, 0xc9, 0x61, 0xd8, 0x2c, 0xab, 0xd9, 0x96, 0x66, 0x5c,
+	0x81, 0xec, 0x9f, 0x3d, 0xf0, 0xf7, 0x4e, 0x93, 0xa8, 0xe2, 0x2e, 0xbb, 0xb0, 0x88, 0x1d, 0xa0,
+	0x68, 0x6a, 0xff, 0x28, 0xd0, 0xd2, 0xc4, 0x5c, 0x49, 0x13, 0xd7, 0x95, 0x21, 0x28, 0xa9, 0x89,
+	0x14, 0xa0, 0xe6, 0xfc, 0x0e, 0x6c, 0x90, 0xb7, 0xcc, 0xfb, 0x13, 0xb4, 0x17, 0x3c, 0x4a, 0x93,
+	0x01, 0xe9, 0xcc, 0x0b, 0xd6, 0x64, 0xc3, 0x33, 0x9e, 0xed, 0x11, 0xda, 0x5f, 0x87, 0x16, 0x17,
+	0xa1, 0xda, 0x25, 0xf8, 0x93, 0x7d, 0x1b, 0xd6, 0xee, 0x47, 0xa4, 0x49, 0xed, 0x4a, 0x50, 0x92,
+	0x68, 0x9a, 0xe5, 0x69, 0xa6, 0x17, 0x9d, 0x84, 0xd0, 0x84, 0x8c, 0xe2, 0x71, 0xac, 0x77, 0x84,
+	0x04, 0xd8, 0x31, 0x74, 0x54, 0x07, 0xb8, 0x72, 0xed, 0x15, 0xa3, 0xdc, 0xa0, 0x02, 0x71, 0x4a,
+	0xa7, 0x09, 0xca, 0x63, 0xcc, 0x83, 0x81, 0x71, 0xce, 0x26, 0xa1, 0x38, 0x92, 0x21, 0x40, 0x4b,
+	0xed, 0xc8, 0x50, 0x1c, 0x7d, 0xa4, 0xc2, 0x89, 0x24, 0x4d, 0x22, 0xb9, 0x10, 0xe6, 0x03, 0x09,
+	0xb0, 0x1f, 0x7b, 0xb0, 0x5e, 0x48, 0xae, 0xd4, 0x7b, 0x05, 0xda, 0x8a, 0x1d, 0xcf, 0x4d, 0x1c,
+	0xa7, 0x11, 0xfe, 0x5d, 0x58, 0x0a, 0xd5, 0x17, 0xb4, 0x9c, 0x3b, 0xf7, 0x7c, 0x65, 0x5f, 0xac,
+	0x11, 0x04, 0x86, 0x06, 0x55, 0x9f, 0xf0, 0x13, 0xd1, 0x57, 0xda, 0x90, 0x72, 0x01, 0xa2, 0x76,
+	0x09, 0xc3, 0x7e, 0x00, 0x17, 0x1f, 0x71, 0xa1, 0x3e, 0x56, 0xfb, 0x40, 0xea, 0xb0, 0x59, 0x0d,
+	0x4d, 0xf3, 0xfc, 0x06, 0xac, 0x1e, 0xc6, 0x49, 0x38, 0xc2, 0x75, 0xd5, 0x4f, 0x93, 0xd1, 0x29,
+	0xf1, 0x5b, 0x0a, 0x56, 0x0c, 0xf6, 0x77, 0x92, 0xd1, 0x29, 0x7b, 0x0c, 0x97, 0x6a, 0x2c, 0x8b,
+	0xb5, 0x75, 0x10, 0x8e, 0x42, 0xd4, 0x94, 0xe2, 0xa9, 0xc0, 0x42, 0x83, 0x2a, 0x20, 0x93, 0x1a,
+	0xfc, 0x8c, 0xba, 0xa2, 0x90, 0x35, 0x8c, 0x5e, 0x55, 0xfc, 0x75, 0x68, 0xbd, 0xe0, 0x3a, 0x06,
+	0xc5, 0x9f, 0x4d, 0x5b, 0x98, 0xbd, 0x0d, 0xdd, 0x7a, 0xf7, 0x4a, 0xd4, 0x4d, 0x58, 0x38, 0x0e,
+	0x47, 0x53, 0x2d, 0xa8, 0x04, 0xd8, 0x43, 0xd8, 0xb2, 0xbe, 0xb8, 0x2f, 0x39, 0x5a, 0x01, 0xec,
+	0x61, 0x96, 0x8e, 0x75, 0xa0, 0x89, 0xbf, 0xcb, 0xe3, 0x32, 0x2b, 0xe3, 0x08, 0x7a, 0xae, 0x6e,
+	0x0a, 0x2d, 0x35, 0x0c, 0xcd, 0xd9, 0x1b, 0x2e, 0xdb, 0x01, 0x9f, 0x8c, 0xd2, 0x53, 0x15, 0xaa,
+	0x2d, 0x05, 0x06, 0x66, 0x7d, 0xb8, 0xa0, 0x66, 0xe2, 0xa3, 0x18, 0x43, 0x8c, 0xd3, 0x57, 0x9a,
+	0xfe, 0xf4, 0xf0, 0x30, 0xe7, 0x66, 0xfa, 0x25, 0x54, 0x6c, 0x2e, 0xa9, 0x44, 0x09, 0xb0, 0x04,
+	0x56, 0x3e, 0x90, 0x73, 0x28, 0x83, 0x54, 0x4b, 0xd9, 0x5e, 0x69, 0xf5, 0x5c, 0x82, 0x45, 0x71,
+	0x22, 0xb7, 0x8f, 0x9c, 0x9a, 0x73, 0xe2, 0x84, 0x36, 0x0f, 0x05, 0xb1, 0x61, 0xae, 0xc2, 0xba,
+	0x76, 0xa0, 0x20, 0xe4, 0x37, 0xe0, 0x23, 0x11, 0x2a, 0xeb, 0x2a, 0x01, 0xf6, 0x7d, 0xb8, 0x58,
+	0x1d, 0x90, 0x52, 0xdb, 0x5d, 0x40, 0x3b, 0x9e, 0x0c, 0xd5, 0xbe, 0xea, 0xdc, 0xdb, 0x54, 0x5b,
+	0xa7, 0x24, 0x5f, 0xa0, 0x89, 0x64, 0x36, 0x23, 0xc2, 0x91, 0x56, 0x26, 0x01, 0xec, 0xeb, 0xa5,
+	0xa9, 0x79, 0xca, 0x45, 0x88, 0xd1, 0xf0, 0x99, 0x5a, 0x63, 0xff, 0xd7, 0x82, 0xcb, 0xce, 0x0f,
+	0xcf, 0x9c, 0xd4, 0x2e, 0x2c, 0x46, 0x19, 0x0f, 0x45, 0x9a, 0x29, 0xc5, 0x68, 0x50, 0x66, 0x75,
+	0x38, 0x91, 0x7d, 0x71, 0xa2, 0x6d, 0x8e, 0x44, 0xec, 0x9f, 0x58, 0x7a, 0x9e, 0xaf, 0x5a, 0xe3,
+	0x3c, 0x9d, 0x66, 0x11, 0x97, 0x91, 0xfe, 0x02, 0x7d, 0x06, 0x12, 0x45, 0xc1, 0xfe, 0x45, 0x38,
+	0x27, 0x21, 0x72, 0x3d, 0xed, 0x40, 0x41, 0xb8, 0x7c, 0xc3, 0x6c, 0x98, 0x2b, 0x67, 0x43, 0xbf,
+	0x31, 0x64, 0x9a, 0x4e, 0x86, 0x59, 0x38, 0xa0, 0xd0, 0x51, 0xfa, 0x17, 0x0b, 0x83, 0x8e, 0x4e,
+	0x42, 0x1c, 0x45, 0x94, 0x0e, 0xa6, 0xad, 0x30, 0xfb, 0x27, 0xb8, 0x32, 0x8f, 0x79, 0x16, 0x1f,
+	0xc6, 0x7c, 0x40, 0xd1, 0xe9, 0x52, 0x60, 0x60, 0x1c, 0x1c, 0xfd, 0xa6, 0xc1, 0x75, 0xe4, 0xe0,
+	0x24, 0x62, 0xff, 0x04, 0x73, 0x4a, 0x4d, 0xd8, 0x57, 0xc2, 0x2e, 0xcb, 0x9c, 0x52, 0xa3, 0xf7,
+	0xa4, 0xd0, 0x6f, 0xc3, 0x66, 0x85, 0x50, 0x0e, 0x7b, 0x85, 0xa8, 0xfd, 0x32, 0x35, 0x0d, 0x9f,
+	0xfc, 0xf6, 0x78, 0x12, 0x8f, 0x78, 0xd6, 0x5d, 0xd5, 0x7e, 0x5b, 0xc2, 0xe8, 0x7b, 0xf5, 0x6f,
+	0xe3, 0x7b, 0xd7, 0xa4, 0xef, 0xd5, 0x78, 0xe5, 0x7b, 0xd9, 0x6f, 0xc3, 0xf9, 0xfd, 0xf4, 0x05,
+	0x4f, 0xd4, 0xe2, 0xd2, 0x0b, 0x84, 0x7a, 0x97, 0x4b, 0x40, 0xc7, 0x6d, 0x1a, 0x2e, 0x87, 0x2a,
+	0xa5, 0xc5, 0xf3, 0x36, 0x6c, 0x96, 0x3b, 0x3b, 0xcb, 0x5e, 0xb2, 0x7b, 0xea, 0x8b, 0xea, 0x02,
+	0x9d, 0xc1, 0x9f, 0xfd, 0xc4, 0x83, 0x0b, 0x95, 0x8f, 0x8a, 0x14, 0x39, 0x09, 0xc7, 0x9a, 0x09,
+	0xfd, 0xa6, 0x65, 0x72, 0x3a, 0x3e, 0x48, 0x75, 0x34, 0xa4, 0x20, 0x69, 0x6d, 0xa2, 0x78, 0x1c,
+	0x8e, 0x72, 0x15, 0x53, 0x1a, 0x18, 0x23, 0x68, 0xda, 0x45, 0xfd, 0x7c, 0x3a, 0x99, 0x8c, 0x4e,
+	0x75, 0x66, 0x48, 0xb8, 0x3d, 0x42, 0xb1, 0x7f, 0xf7, 0xe0, 0x4a, 0xc5, 0x3d, 0x3c, 0xcb, 0xd2,
+	0xf4, 0xf0, 0x97, 0xf5, 0x11, 0x95, 0xe4, 0xbc, 0x55, 0x4d, 0xce, 0xaf, 0x02, 0x50, 0x72, 0xdf,
+	0xcf, 0xd2, 0x54, 0xe8, 0xdc, 0x9d, 0x30, 0x41, 0x9a, 0x0a, 0xff, 0xab, 0xb0, 0x30, 0x41, 0xf6,
+	0xdd, 0x05, 0x32, 0x19, 0x17, 0x95, 0xc9, 0x78, 0xca, 0xb3, 0x17, 0x23, 0x29, 0x18, 0xc6, 0xb3,
+	0x81, 0x24, 0x62, 0x37, 0x61, 0xad, 0xd2, 0x82, 0xde, 0xe6, 0x38, 0x1c, 0x91, 0xc5, 0x59, 0x0e,
+	0xf0, 0x27, 0xfb, 0x0a, 0x6c, 0xec, 0x62, 0x3c, 0x89, 0x63, 0xb3, 0x23, 0x96, 0x97, 0x71, 0x32,
+	0x48, 0x5f, 0x6a, 0xab, 0x28, 0x21, 0xf6, 0xbf, 0x1e, 0xf8, 0x36, 0x75, 0x11, 0x55, 0x3b, 0x8d,
+	0xe8, 0x65, 0x68, 0x4b, 0x05, 0x8b, 0x13, 0x5d, 0x0b, 0x59, 0x22, 0xc4, 0xfe, 0x49, 0x8e, 0x9b,
+	0x46, 0x36, 0xea, 0x19, 0xcf, 0x95, 0xa9, 0x5e, 0x25, 0xb4, 0x36, 0x4d, 0xe4, 0x21, 0xc5, 0x24,
+	0x57, 0x11, 0x18, 0xfe, 0xf4, 0xbf, 0x06, 0x17, 0xc3, 0x63, 0x9e, 0x85, 0x43, 0xde, 0x97, 0xca,
+	0xa4, 0x5c, 0x02, 0x07, 0xb6, 0x40, 0x44, 0x9b, 0xaa, 0xf5, 0x03, 0x6c, 0x7c, 0xac, 0xda, 0x30,
+	0xae, 0x1b, 0x9c, 0x26, 0x61, 0x2e, 0x4e, 0xfb, 0xe3, 0x38, 0xcf, 0xfb, 0x59, 0x28, 0xa4, 0x51,
+	0xf1, 0x82, 0x35, 0xd5, 0xf0, 0x34, 0xce, 0xf3, 0x20, 0x14, 0x9c, 0x7d, 0x13, 0x36, 0x9e, 0xc6,
+	0x09, 0xcf, 0x4a, 0x5a, 0x91, 0x65, 0x98, 0x4c, 0x8f, 0x52, 0x02, 0x28, 0x1e, 0x4f, 0x06, 0x6a,
+	0x78, 0xf8, 0x93, 0xfd, 0xb9, 0x07, 0x50, 0x7c, 0x3d, 0xdb, 0x77, 0x8d, 0x51, 0x74, 0xfd, 0xb5,
+	0x82, 0x24, 0x3e, 0xcf, 0x95, 0x83, 0x9c, 0x0f, 0x14, 0x84, 0x8b, 0x99, 0x9f, 0x4c, 0x78, 0x84,
+	0x5f, 0x48, 0x33, 0x6a, 0x60, 0xfc, 0x66, 0x3a, 0x31, 0x29, 0x9b, 0x17, 0x28, 0x88, 0xfd, 0x16,
+	0xf8, 0xf6, 0x48, 0xd4, 0x8c, 0xdd, 0xa2, 0xa1, 0x08, 0xed, 0x7b, 0x74, 0x5a, 0x68, 0x51, 0xca,
+	0x76, 0xf6, 0x55, 0xf0, 0xf7, 0x8b, 0xfd, 0x60, 0xad, 0x0f, 0xd7, 0x84, 0xb3, 0x7f, 0xf5, 0xe0,
+	0x7c, 0x89, 0xfc, 0x8c, 0x05, 0xd2, 0x85, 0xc5, 0x21, 0x4f, 0x78, 0x1e, 0x1b, 0x1b, 0xa3, 0x40,
+	0x4b, 0x35, 0xca, 0xcd, 0x16, 0xaa, 0x39, 0x98, 0x66, 0x89, 0x52, 0x40, 0x3b, 0x50, 0x50, 0xe1,
+	0x1e, 0xa5, 0x07, 0x91, 0x80, 0xbf, 0x0d, 0x9d, 0x28, 0xce, 0xa2, 0xe9, 0x28, 0x14, 0x3a, 0x79,
+	0x69, 0x07, 0x36, 0x8a, 0x3d, 0x87, 0xe5, 0xdd, 0x70, 0xd4, 0x54, 0x60, 0x6c, 0xeb, 0x1a, 0x95,
+	0xbf, 0xa3, 0x37, 0xe6, 0x20, 0x3e, 0x3c, 0x24, 0x61, 0x8b, 0xea, 0x01, 0x99, 0x85, 0x07, 0xf1,
+	0xe1, 0xa1, 0xda, 0xaa, 0xf8, 0x93, 0xfd, 0xb7, 0x07, 0x6d, 0xd3, 0x80, 0x59, 0xdc, 0x30, 0xcc,
+	0xfb, 0xd3, 0x9c, 0xeb, 0x6c, 0x70, 0x71, 0x18, 0xe6, 0x9f, 0xe0, 0xa4, 0x52, 0x56, 0xcb, 0x23,
+	0x2c, 0x79, 0xc8, 0x02, 0xd5, 0x9c, 0xce, 0x6a, 0x09, 0x49, 0x15, 0x2a, 0x7f, 0x07, 0x96, 0x94,
+	0x5d, 0x91, 0x89, 0x7e, 0xe7, 0xde, 0xf9, 0x72, 0xb8, 0xf0, 0x00, 0xc3, 0x8d, 0xc0, 0x10, 0xf9,
+	0x6f, 0xc1, 0x22, 0xc6, 0x1b, 0xe1, 0x90, 0x77, 0xe7, 0x4b, 0xf4, 0x7b, 0x12, 0xfb, 0x3c, 0x8b,
+	0x05, 0x0f, 0x34, 0x8d, 0xff, 0x25, 0x38, 0xc7, 0x8f, 0x39, 0xc6, 0xf1, 0xd2, 0xb2, 0x2c, 0x2b,
+	0xea, 0x87, 0x88, 0x0c, 0x54, 0x1b, 0xfb, 0x16, 0x2c, 0xdb, 0xec, 0x66, 0x87, 0x7e, 0x32, 0x1a,
+	0x9a, 0xb3, 0xa3, 0xa1, 0x11, 0x2c, 0xdb, 0xec, 0x67, 0xba, 0x9f, 0x7a, 0x5c, 0x6c, 0x62, 0xdc,
+	0x96, 0x15, 0xe3, 0xca, 0xc2, 0xdf, 0x88, 0xeb, 0x2d, 0xb1, 0x14, 0x68, 0x90, 0xdd, 0x85, 0xcd,
+	0x0f, 0x4e, 0xc9, 0x04, 0xc8, 0xc4, 0xee, 0xac, 0xc5, 0xfb, 0x3e, 0x5c, 0xc0, 0x90, 0x28, 0x4c,
+	0x06, 0xf1, 0x20, 0x14, 0xbc, 0xd8, 0x2c, 0xd7, 0x00, 0x22, 0x83, 0x55, 0x59, 0x90, 0x85, 0x61,
+	0x5f, 0x03, 0xff, 0x11, 0x17, 0x0f, 0xa4, 0x09, 0xb1, 0xbf, 0x42, 0x49, 0x86, 0xa1, 0xe0, 0xc5,
+	0x57, 0x05, 0x86, 0x0d, 0x60, 0xfb, 0x11, 0x17, 0x56, 0x21, 0xf8, 0x01, 0x9f, 0xf0, 0x64, 0xc0,
+	0x93, 0xa8, 0xe8, 0xe3, 0x3b, 0xb0, 0x3c, 0xd0, 0xd8, 0xd8, 0x44, 0x8a, 0x57, 0xd4, 0xe4, 0xb8,
+	0xbf, 0x2d, 0x7d, 0xc1, 0x1e, 0xc2, 0x05, 0x27, 0x99, 0xb3, 0xce, 0x4c, 0xba, 0x44, 0x0a, 0x53,
+	0x9d, 0x50, 0x20, 0x9b, 0xc0, 0xc5, 0xc7, 0x82, 0xa3, 0xc5, 0x74, 0x24, 0xb7, 0xce, 0xad, 0xbd,
+	0x09, 0x0b, 0xe1, 0xa1, 0xe0, 0x7a, 0x39, 0x4b, 0xc0, 0x1d, 0x95, 0xa3, 0x2c, 0x64, 0x8c, 0x65,
+	0x31, 0x85, 0x7e, 0xb3, 0xbf, 0xf2, 0x60, 0x59, 0xf1, 0x7a, 0x98, 0x88, 0xec, 0x74, 0x96, 0x0d,
+	0x71, 0xc7, 0x29, 0xb6, 0x6f, 0x6e, 0x35, 0xf8, 0x66, 0x3b, 0x03, 0xc6, 0x58, 0x34, 0xce, 0x8d,
+	0x3b, 0x52, 0x85, 0x57, 0x88, 0x73, 0xed, 0x8a, 0xd8, 0x2d, 0x58, 0x7b, 0xc4, 0xc5, 0x87, 0x69,
+	0xf6, 0xc2, 0xf6, 0x09, 0x03, 0x3e, 0x11, 0x47, 0xda, 0x27, 0x10, 0xc0, 0xde, 0x83, 0xf5, 0x82,
+	0x50, 0xcd, 0xe5, 0x0d, 0x58, 0x38, 0x44, 0x84, 0x9a, 0xc4, 0x8e, 0x9a, 0x44, 0x24, 0x0a, 0x64,
+	0x0b, 0xfb, 0xc2, 0x83, 0x79, 0x84, 0xd1, 0x5c, 0x88, 0x78, 0xd2, 0xb7, 0x26, 0x68, 0x51, 0xc4,
+	0x13, 0x9d, 0x7f, 0x38, 0xd3, 0xdd, 0x2b, 0xd0, 0x46, 0x7b, 0x9f, 0x8b, 0x70, 0x3c, 0x51, 0x65,
+	0xc7, 0x02, 0x81, 0x62, 0x8e, 0xd1, 0xb6, 0xeb, 0xec, 0x84, 0x00, 0xec, 0x6b, 0xc4, 0x93, 0xa1,
+	0x38, 0x52, 0x67, 0x00, 0x0a, 0x42, 0x93, 0x44, 0x56, 0x44, 0xa4, 0x99, 0x94, 0x41, 0x1a, 0xce,
+	0x65, 0x8d, 0x24, 0x41, 0x6e, 0xc1, 0x5a, 0x41, 0x24, 0x25, 0x5a, 0x94, 0xfe, 0xdb, 0x90, 0xc9,
+	0x7d, 0xf5, 0x21, 0x6c, 0xda, 0x49, 0x6b, 0x7e, 0x76, 0x4e, 0xe7, 0x2e, 0x8c, 0xec, 0xc2, 0x85,
+	0x4a, 0x3f, 0x4a, 0xb3, 0x77, 0xca, 0xce, 0x4c, 0x27, 0x52, 0x65, 0x62, 0xe5, 0xcf, 0xfe, 0xcb,
+	0x83, 0x95, 0x52, 0xc3, 0x6c, 0x31, 0xa2, 0x70, 0x34, 0xd2, 0xa1, 0x8b, 0x04, 0xd0, 0x68, 0x1d,
+	0x86, 0xf1, 0x68, 0x9a, 0x71, 0x1d, 0xb0, 0x18, 0x18, 0x23, 0x4a, 0xf5, 0xbb, 0x6f, 0x16, 0xb4,
+	0x17, 0x74, 0x14, 0x2e, 0x08, 0x05, 0x2f, 0x79, 0x02, 0xa9, 0x75, 0xe3, 0x09, 0xde, 0x84, 0x75,
+	0x1d, 0xd6, 0x0c, 0xa6, 0x19, 0x1d, 0xc4, 0x90, 0xe6, 0x5b, 0xc1, 0x9a, 0xc2, 0x3f, 0x50, 0x68,
+	0xf6, 0x77, 0x1e, 0x6c, 0x7e, 0x9a, 0x0a, 0xbe, 0x97, 0x84, 0x93, 0xfc, 0x28, 0x15, 0x67, 0x7a,
+	0xda, 0xf7, 0x4a, 0x36, 0x4c, 0x16, 0x6b, 0x2e, 0x68, 0x45, 0xe9, 0x06, 0xec, 0x31, 0xb7, 0x4d,
+	0x9b, 0xff, 0x2e, 0x74, 0x94, 0xc9, 0xa2, 0xa3, 0xa2, 0x56, 0x29, 0x5a, 0x78, 0x60, 0x5a, 0x02,
+	0x9b, 0x8a, 0x7d, 0x07, 0x56, 0xcb, 0x5d, 0xce, 0xd6, 0xf1, 0x71, 0x2a, 0x45, 0x92, 0x46, 0x1d,
+	0x01, 0xf6, 0x11, 0x40, 0xd1, 0x39, 0x2e, 0x6d, 0xd5, 0xbd, 0x29, 0xa1, 0x15, 0x08, 0xab, 0x95,
+	0xeb, 0x58, 0xbb, 0x40, 0x60, 0xd9, 0x70, 0xe5, 0x01, 0x3f, 0x98, 0x0e, 0xed, 0x82, 0x6a, 0x93,
+	0x2b, 0x2e, 0x9c, 0xff, 0x5c, 0xc9, 0xf9, 0xd7, 0x5c, 0x74, 0xcb, 0xe1, 0xa2, 0xbf, 0x8c, 0xab,
+	0x90, 0x4f, 0x74, 0xa5, 0x7d, 0xbd, 0x30, 0xd2, 0x11, 0xdf, 0x13, 0x7c, 0x12, 0xc8, 0x66, 0x15,
+	0x45, 0x46, 0x2f, 0x74, 0xa4, 0x42, 0x00, 0xfb, 0x1e, 0xb4, 0x0d, 0x25, 0x56, 0x8d, 0xd3, 0x89,
+	0xae, 0x1a, 0xa7, 0x13, 0x93, 0xeb, 0x4a, 0xa3, 0x4c, 0xbf, 0x2d, 0x59, 0x5b, 0x25, 0x59, 0xd7,
+	0xa1, 0x35, 0x0c, 0x73, 0x65, 0xd8, 0xf0, 0x27, 0x7b, 0x46, 0x75, 0x23, 0xa5, 0x4f, 0x9a, 0x90,
+	0xcc, 0xec, 0xc1, 0x92, 0xf2, 0xbc, 0x8a, 0xf2, 0x9a, 0x6c, 0x0d, 0x1e, 0xd1, 0x3a, 0x7a, 0x2c,
+	0x56, 0xe0, 0x31, 0x61, 0x94, 0xcf, 0x53, 0x10, 0xfb, 0xcb, 0x05, 0xf0, 0xdd, 0xe7, 0xa8, 0xb5,
+	0x32, 0xd4, 0x2a, 0xcc, 0x89, 0x54, 0xcd, 0xc1, 0x9c, 0x48, 0x1b, 0x3c, 0xbf, 0xdb, 0x88, 0x5f,
+	0x86, 0x36, 0x4e, 0xef, 0x24, 0x8b, 0x23, 0x5d, 0x4e, 0xc0, 0xf9, 0x7e, 0x96, 0xc5, 0x45, 0xa3,
+	0x34, 0x2e, 0xe7, 0x4c, 0xe3, 0x13, 0x84, 0xfd, 0x7b, 0x56, 0x34, 0xb2, 0xb8, 0xed, 0x59, 0xf9,
+	0x95, 0x36, 0x18, 0x4a, 0x66, 0x2b, 0x4a, 0x79, 0x0f, 0xda, 0x66, 0xb7, 0x50, 0xc1, 0xa1, 0x73,
+	0xef, 0x52, 0x75, 0x57, 0xe9, 0xaf, 0x0a, 0x4a, 0x64, 0xa5, 0xb5, 0xdc, 0x6d, 0x97, 0x58, 0x69,
+	0xa5, 0x1a, 0x56, 0x9a, 0x0e, 0xbf, 0x19, 0x4f, 0x47, 0x22, 0xce, 0xe3, 0x61, 0x17, 0x4a, 0xdf,
+	0x3c, 0x55, 0x68, 0xf3, 0x8d, 0xa6, 0xf3, 0xdf, 0x84, 0x85, 0x83, 0x50, 0x44, 0x47, 0x54, 0xb1,
+	0xb0, 0x63, 0x46, 0x11, 0x1d, 0x69, 0x6a, 0x49, 0x81, 0xdd, 0xa3, 0xbb, 0xc0, 0x70, 0xa9, 0xbb,
+	0x5c, 0xea, 0x7e, 0x5f, 0xa1, 0x4d, 0xf7, 0x9a, 0xce, 0xff, 0x2a, 0xf8, 0xc7, 0xe1, 0x28, 0x56,
+	0x87, 0x5d, 0xda, 0x0b, 0xac, 0xd0, 0x74, 0xac, 0x53, 0x0b, 0x1d, 0x79, 0x7d, 0x64, 0x4a, 0x3d,
+	0x16, 0x35, 0x55, 0x33, 0x5a, 0x01, 0x14, 0x64, 0x8e, 0x8a, 0xed, 0x9a, 0xa3, 0x62, 0xeb, 0x5f,
+	0x2d, 0x85, 0xe2, 0xeb, 0x44, 0x52, 0x04, 0xde, 0x38, 0xe6, 0x49, 0x38, 0xcd, 0x79, 0x77, 0xa3,
+	0x34, 0xe6, 0x67, 0x88, 0x33, 0x63, 0x26, 0x0a, 0xf6, 0xf3, 0x39, 0x58, 0xab, 0xcc, 0xad, 0x55,
+	0x6f, 0xf2, 0x4a, 0xf5, 0xa6, 0x4a, 0xa1, 0x6a, 0xae, 0x56, 0xa8, 0x42, 0xbf, 0x30, 0x4d, 0x68,
+	0x6d, 0xeb, 0xea, 0x97, 0x86, 0xcd, 0x06, 0x9e, 0x6f, 0x2c, 0x56, 0x2d, 0xd4, 0x8a, 0x55, 0x5d,
+	0x58, 0x94, 0x10, 0x57, 0x87, 0x2e, 0x1a, 0xa4, 0x1d, 0x46, 0xa5, 0x27, 0x5a, 0xa6, 0x4b, 0x81,
+	0x82, 0x4a, 0xb5, 0xa2, 0xa5, 0x57, 0xa8, 0x15, 0xb5, 0xdd, 0xb5, 0xa2, 0x3b, 0xb0, 0x5e, 0x5d,
+	0xbb, 0xc8, 0x52, 0x6e, 0x5b, 0xad, 0x15, 0x09, 0xb1, 0x47, 0xb0, 0x56, 0x59, 0xb1, 0x4d, 0xa4,
+	0x67, 0xd8, 0xe9, 0xbf, 0xf5, 0x60, 0xad, 0xb2, 0x8e, 0xf1, 0x0b, 0x71, 0x94, 0xf1, 0xfc, 0x28,
+	0x1d, 0x99, 0x8b, 0x06, 0x06, 0x81, 0xfa, 0xc9, 0xe3, 0x61, 0xc2, 0x33, 0x6d, 0x17, 0x35, 0xd8,
+	0x60, 0x2e, 0x7e, 0x03, 0x00, 0x09, 0x42, 0x41, 0x9e, 0x5b, 0x1a, 0xe9, 0x6e, 0x65, 0x07, 0xed,
+	0x69, 0x82, 0xc0, 0xa2, 0x65, 0x1f, 0xc0, 0xb2, 0xbd, 0x63, 0xfc, 0x7b, 0xd0, 0x16, 0x68, 0xc8,
+	0x0e, 0x79, 0x56, 0x8d, 0x39, 0x88, 0x6e, 0x5f, 0x35, 0x06, 0x05, 0x19, 0x8d, 0xaf, 0xb2, 0x91,
+	0x1a, 0x35, 0x65, 0xe4, 0x9f, 0xb3, 0xe5, 0xbf, 0x09, 0x2b, 0xf2, 0x78, 0xa7, 0x7c, 0x72, 0xb5,
+	0x2c, 0x91, 0xc5, 0x1e, 0x53, 0x44, 0x54, 0x0a, 0x98, 0x97, 0x7b, 0x4c, 0xa2, 0x90, 0x3d, 0xae,
+	0x44, 0xfc, 0xad, 0x2c, 0x23, 0xfd, 0x66, 0x5f, 0x86, 0x65, 0x7b, 0x77, 0x34, 0x4e, 0xf6, 0x7b,
+	0xb0, 0x52, 0x1a, 0x9f, 0xb2, 0xd3, 0x5e, 0xdd, 0x4e, 0xdb, 0x82, 0xb3, 0xef, 0xc2, 0x46, 0x4d,
+	0xbf, 0xb4, 0xcd, 0x68, 0xba, 0xcc, 0x36, 0x23, 0x08, 0xdd, 0x57, 0x38, 0x1a, 0xaa, 0xc0, 0x0f,
+	0x7f, 0xa2, 0xc4, 0xd8, 0x46, 0xc3, 0x5d, 0x0e, 0xe8, 0x37, 0xdb, 0x81, 0xad, 0x3d, 0x9e, 0x0c,
+	0x82, 0xf0, 0xa5, 0xdb, 0xa3, 0xd0, 0xf5, 0x10, 0x4f, 0x7e, 0x80, 0xbf, 0x99, 0x80, 0x4b, 0xf8,
+	0x41, 0x89, 0xba, 0xf0, 0x57, 0xe2, 0xc4, 0x8a, 0xb4, 0x15, 0x24, 0x77, 0x8c, 0xb4, 0x0d, 0xfd,
+	0x72, 0x82, 0xb1, 0x16, 0x95, 0x8f, 0x42, 0x2a, 0xbe, 0xb8, 0xb8, 0xd8, 0xf2, 0x36, 0xf4, 0xea,
+	0x62, 0xe6, 0x75, 0x39, 0x5b, 0x46, 0xce, 0x1c, 0xba, 0xae, 0x81, 0x91, 0x67, 0xff, 0x15, 0x08,
+	0xba, 0x09, 0x0b, 0x76, 0x00, 0x23, 0x01, 0x26, 0xe0, 0xb2, 0x53, 0x4c, 0xa5, 0xa0, 0xdf, 0x84,
+	0x45, 0x39, 0x1e, 0xbd, 0xd8, 0xaf, 0xeb, 0x52, 0x42, 0x83, 0xa4, 0x81, 0xa6, 0x47, 0x8b, 0x14,
+	0x46, 0x11, 0x9f, 0x88, 0xe2, 0x88, 0x52, 0xc3, 0xec, 0xaf, 0x3d, 0xca, 0xb7, 0x29, 0x41, 0xff,
+	0xe0, 0x14, 0x53, 0x8a, 0x59, 0x57, 0xab, 0xde, 0x84, 0xf5, 0xc3, 0xe9, 0x68, 0xd4, 0x17, 0x05,
+	0x33, 0xd5, 0xe3, 0x1a, 0xe2, 0x2d, 0x19, 0xd0, 0xc9, 0x13, 0xe9, 0x60, 0x92, 0xe6, 0xfa, 0x84,
+	0x09, 0x11, 0x0f, 0x26, 0x29, 0x1d, 0x41, 0x1e, 0xf1, 0x70, 0xc0, 0x33, 0xe9, 0x60, 0x64, 0xc9,
+	0x00, 0x24, 0x8a, 0xce, 0x03, 0xff, 0xc3, 0x83, 0x4b, 0x96, 0x58, 0xaf, 0x52, 0x39, 0xf8, 0xb5,
+	0x09, 0xe7, 0xf0, 0x90, 0x0b, 0xae, 0x33, 0xcd, 0x7f, 0xf4, 0xa0, 0x57, 0x8c, 0x61, 0x5f, 0x67,
+	0x81, 0xb6, 0x5d, 0xd5, 0xb8, 0xae, 0x57, 0x4d, 0x15, 0x7f, 0x6d, 0x9a, 0x7e, 0x87, 0x8e, 0xa0,
+	0xac, 0xfe, 0xce, 0x5c, 0x05, 0xec, 0x36, 0xac, 0xd3, 0xa0, 0x1e, 0x4c, 0x8b, 0xd1, 0x60, 0x96,
+	0x46, 0x17, 0x17, 0x3c, 0xba, 0x81, 0x24, 0x01, 0x76, 0x0b, 0x36, 0x2c, 0xca, 0xe2, 0xe0, 0xc0,
+	0x58, 0x06, 0x75, 0x71, 0x8c, 0xfd, 0xcb, 0x3c, 0xac, 0x7c, 0x20, 0xad, 0xf2, 0x8c, 0x1b, 0x78,
+	0x78, 0x69, 0x20, 0xcc, 0x78, 0x22, 0xec, 0x23, 0x41, 0x90, 0xa8, 0x4a, 0x5a, 0xde, 0xaa, 0x96,
+	0x41, 0x1c, 0x41, 0xaa, 0x7d, 0x1b, 0x63, 0xa1, 0x72, 0x1b, 0xc3, 0xa4, 0xea, 0xe7, 0xec, 0x54,
+	0xbd, 0x34, 0x67, 0x8b, 0xd5, 0x39, 0xb3, 0x2f, 0x89, 0x2c, 0x95, 0x2f, 0x89, 0x94, 0x4f, 0x14,
+	0x3a, 0xd5, 0x13, 0x05, 0xac, 0x34, 0x9c, 0xe4, 0xb2, 0x71, 0x59, 0x55, 0x1a, 0x4e, 0x72, 0x6a,
+	0xba, 0x0e, 0x1d, 0x59, 0xf7, 0x93, 0xad, 0xf2, 0x8c, 0x0a, 0x24, 0x8a, 0x08, 0xde, 0x83, 0x65,
+	0x9c, 0x79, 0xaa, 0x98, 0xf0, 0x13, 0x41, 0x11, 0x5d, 0x71, 0x05, 0x00, 0x17, 0xc1, 0xae, 0x6c,
+	0x09, 0x3a, 0x83, 0x02, 0x90, 0x06, 0xfd, 0x73, 0x4e, 0xc1, 0xdd, 0x7c, 0x40, 0xbf, 0xa5, 0x18,
+	0xea, 0x02, 0xca, 0xba, 0xcc, 0x8a, 0xc5, 0x89, 0xbc, 0x7e, 0x52, 0xbb, 0xaf, 0xb8, 0xe1, 0xb8,
+	0xaf, 0x88, 0xd5, 0x88, 0x38, 0xef, 0xc7, 0x59, 0xc6, 0x29, 0xba, 0xc1, 0x90, 0xca, 0xa7, 0x15,
+	0xb7, 0x1a, 0xe7, 0x8f, 0x2d, 0xac, 0xff, 0x2d, 0x58, 0xb6, 0x56, 0x76, 0xde, 0x1d, 0x90, 0x49,
+	0xeb, 0xd5, 0x4b, 0x6a, 0x7a, 0x3d, 0x04, 0x25, 0x7a, 0xf6, 0xd3, 0x39, 0xe8, 0x58, 0x43, 0xc3,
+	0x94, 0x5f, 0x9f, 0x2a, 0x90, 0x9a, 0xe4, 0xaa, 0xe9, 0x28, 0x1c, 0xe9, 0xe9, 0x0e, 0x6c, 0xd0,
+	0xb5, 0x87, 0x12, 0x9d, 0xb2, 0xd0, 0xd8, 0xf0, 0xc0, 0xa2, 0xbd, 0x09, 0x2b, 0x3a, 0x28, 0x92,
+	0x74, 0x2a, 0xd5, 0xd4, 0x48, 0x22, 0x7a, 0x03, 0x56, 0x4d, 0x2e, 0x61, 0x9f, 0x14, 0xad, 0x18,
+	0x2c, 0x91, 0xe1, 0x99, 0x65, 0xaa, 0x29, 0xd4, 0x32, 0x3b, 0x4e, 0x55, 0x23, 0x83, 0x15, 0x2c,
+	0xa9, 0xf7, 0xa3, 0x44, 0x48, 0x02, 0x55, 0x1c, 0x47, 0xe4, 0x6e, 0x22, 0x88, 0x06, 0xeb, 0x81,
+	0x52, 0xb6, 0xee, 0xa2, 0xaa, 0x07, 0x4a, 0x90, 0x7d, 0x31, 0x0f, 0xe7, 0x5d, 0xce, 0xb4, 0xa1,
+	0xaa, 0xa8, 0x16, 0x63, 0xf5, 0x8e, 0xa4, 0xce, 0xfd, 0x5a, 0xb5, 0xdc, 0x6f, 0xbe, 0x1e, 0x53,
+	0x2c, 0x38, 0x73, 0xbf, 0x73, 0xf6, 0xb6, 0x9a, 0xbd, 0x49, 0xf0, 0xba, 0x14, 0x86, 0xee, 0x32,
+	0x34, 0xa6, 0xdf, 0xc6, 0x22, 0xb4, 0x8b, 0x58, 0xa1, 0x9c, 0x41, 0xc2, 0xac, 0x0c, 0xb2, 0x53,
+	0xc9, 0x20, 0x5d, 0x9e, 0x78, 0xb9, 0x31, 0x64, 0xc8, 0xe9, 0x26, 0x13, 0xed, 0xab, 0x95, 0x40,
+	0x41, 0xf5, 0x52, 0xc3, 0xaa, 0xa3, 0xd4, 0x60, 0x97, 0x30, 0xd6, 0xca, 0x25, 0x8c, 0xda, 0x6e,
+	0x59, 0x7f, 0xc5, 0xdd, 0xb2, 0xe1, 0xdc, 0x2d, 0xee, 0x0c, 0xcf, 0x7f, 0xb5, 0x0c, 0xef, 0x7c,
+	0x2d, 0xc3, 0xbb, 0x0a, 0x80, 0x82, 0x67, 0xfc, 0x70, 0x9a, 0x0c, 0xba, 0x9b, 0xd2, 0x18, 0x0d,
+	0xc3, 0x3c, 0x20, 0x04, 0x7b, 0x17, 0x36, 0x3e, 0xe6, 0x2f, 0x55, 0xd5, 0x57, 0xdb, 0xf7, 0x6b,
+	0x00, 0x93, 0x30, 0xcf, 0x27, 0x47, 0x19, 0x5a, 0x4b, 0x4f, 0x5b, 0x5e, 0x8d, 0x61, 0x77, 0xc1,
+	0xb7, 0x3f, 0x3a, 0xeb, 0x02, 0x03, 0x1b, 0xc1, 0xe6, 0x27, 0x14, 0x0f, 0x57, 0xf8, 0x34, 0x7e,
+	0x51, 0x91, 0x60, 0xae, 0x2a, 0x01, 0x9d, 0x31, 0xeb, 0x7a, 0x9d, 0xaa, 0x08, 0x6a, 0x98, 0xed,
+	0xc0, 0x85, 0x0a, 0xb7, 0x33, 0x2e, 0x43, 0xdf, 0x05, 0xff, 0xc9, 0x6b, 0x08, 0xc7, 0xde, 0x82,
+	0xf3, 0x4f, 0x5e, 0xa3, 0xfb, 0xb7, 0xe0, 0x12, 0x06, 0xe1, 0x0d, 0x7b, 0xb7, 0x16, 0x37, 0xff,
+	0x10, 0xb6, 0x2b, 0x71, 0xf3, 0x33, 0x33, 0x6e, 0x2d, 0xdb, 0x37, 0xa1, 0x63, 0xc7, 0x0a, 0x1e,
+	0x79, 0x81, 0x2d, 0x97, 0x41, 0x25, 0xfa, 0xc0, 0xa6, 0x3e, 0x4b, 0xb7, 0xec, 0x7d, 0xb8, 0x31,
+	0x43, 0x80, 0x66, 0xab, 0xc3, 0x46, 0x70, 0x0d, 0x07, 0xaa, 0x33, 0x8f, 0x57, 0xbc, 0xc1, 0x5f,
+	0xa4, 0x25, 0x73, 0xa5, 0xb4, 0xa4, 0x2c, 0x66, 0xab, 0x26, 0xe6, 0x3e, 0x5c, 0x43, 0x31, 0x5f,
+	0x93, 0xdb, 0x59, 0x83, 0xff, 0x7b, 0x0f, 0x2e, 0x3b, 0xbb, 0x9c, 0x61, 0x6d, 0xf1, 0xe8, 0x3e,
+	0x1c, 0x8d, 0xb8, 0xa9, 0x61, 0x4a, 0xa8, 0x3a, 0x4b, 0xad, 0xd7, 0x9a, 0xa5, 0x4d, 0x58, 0xc8,
+	0x78, 0x38, 0xd0, 0x51, 0x9c, 0x04, 0xd8, 0x0e, 0xac, 0x3f, 0x52, 0x76, 0xd1, 0x88, 0x54, 0x32,
+	0x9e, 0x5e, 0xd9, 0x78, 0xb2, 0x1b, 0xd0, 0x39, 0x2b, 0xc2, 0x7b, 0x06, 0x9d, 0x47, 0x61, 0x91,
+	0x7b, 0xa8, 0x6a, 0xa6, 0xa4, 0xc0, 0x9f, 0xaf, 0x7f, 0x10, 0xfb, 0x75, 0x58, 0x7d, 0x28, 0x63,
+	0x16, 0xdd, 0x69, 0x71, 0xd8, 0xe9, 0xcd, 0x38, 0xec, 0xfc, 0x99, 0x07, 0x0b, 0x84, 0xb1, 0x1f,
+	0x92, 0x78, 0xc5, 0x43, 0x92, 0x5f, 0xf5, 0x2b, 0x04, 0xfc, 0x38, 0x4e, 0x06, 0xfc, 0x84, 0x0e,
+	0x06, 0xc8, 0xdb, 0x2a, 0x90, 0x7d, 0x08, 0x3e, 0x49, 0x22, 0x6f, 0x49, 0x96, 0x2f, 0xcf, 0xe4,
+	0xd3, 0xb1, 0x49, 0xa2, 0x0d, 0xdc, 0x70, 0x82, 0x72, 0x02, 0x1d, 0xd9, 0x85, 0x1c, 0xd7, 0x8c,
+	0xa3, 0x3b, 0xe2, 0xac, 0x3f, 0x26, 0xc0, 0xbe, 0x11, 0xd7, 0x2a, 0xdd, 0x88, 0x63, 0xb0, 0x40,
+	0x2a, 0xa3, 0x31, 0x55, 0xb5, 0x29, 0x9b, 0x58, 0x0a, 0xe7, 0x4b, 0x23, 0x30, 0x27, 0x37, 0xe5,
+	0x99, 0xd0, 0xb1, 0xa3, 0x25, 0xa5, 0x9e, 0x8f, 0xc6, 0x83, 0x2f, 0x23, 0x6d, 0xcb, 0x92, 0x96,
+	0xfd, 0x9b, 0x07, 0xe7, 0x3f, 0x8c, 0x47, 0x82, 0x67, 0x7a, 0xf2, 0xa5, 0xd2, 0xae, 0x43, 0x07,
+	0xc3, 0x8c, 0x7e, 0x69, 0xe0, 0x80, 0xa8, 0x8f, 0xac, 0x3b, 0x2b, 0xfd, 0x12, 0xa7, 0x25, 0x91,
+	0xaa, 0x46, 0x4c, 0xc1, 0x71, 0xf2, 0xf5, 0x6d, 0x7b, 0x05, 0x61, 0xe0, 0x51, 0xdc, 0x62, 0x99,
+	0xa7, 0xa6, 0x02, 0x51, 0x4c, 0xc6, 0x82, 0x35, 0x19, 0xf6, 0x74, 0x9f, 0x2b, 0x4f, 0x77, 0x04,
+	0x9b, 0x65, 0xd1, 0x7f, 0x09, 0x6d, 0xe9, 0xab, 0xb6, 0xa5, 0x81, 0xd0, 0x55, 0x5b, 0x75, 0x2a,
+	0x37, 0x80, 0xee, 0x6e, 0x3a, 0x1e, 0xc7, 0xe2, 0x35, 0x57, 0xd6, 0xeb, 0x4d, 0xc3, 0xbb, 0xb0,
+	0xe5, 0xe0, 0x72, 0x86, 0x8f, 0xfa, 0x1a, 0xf8, 0x7b, 0x22, 0xcc, 0x84, 0xbc, 0x62, 0xfe, 0xaa,
+	0x71, 0xc0, 0x6d, 0x58, 0xd5, 0x1f, 0x9c, 0xd1, 0xff, 0x09, 0x5c, 0x0c, 0xf8, 0x30, 0xce, 0x05,
+	0xcf, 0x9e, 0xf3, 0x83, 0xa3, 0x34, 0x35, 0x15, 0xb9, 0x75, 0x68, 0x4d, 0x33, 0xfd, 0x84, 0x01,
+	0x7f, 0x5a, 0x33, 0x3e, 0xd7, 0x3c, 0xe3, 0xad, 0xea, 0x8c, 0xa3, 0x1b, 0xe1, 0x51, 0xc6, 0x75,
+	0x60, 0xae, 0x20, 0xf6, 0x26, 0x5c, 0xaa, 0x71, 0x76, 0x3f, 0x2d, 0x62, 0x77, 0xa0, 0xfb, 0x49,
+	0x92, 0xb9, 0xc5, 0xac, 0xd2, 0xbe, 0x0b, 0x5b, 0x0e, 0xda, 0x33, 0xb4, 0x80, 0x55, 0xbf, 0x49,
+	0x96, 0x1e, 0xea, 0x4e, 0xf1, 0x30, 0x18, 0x3b, 0x30, 0x55, 0x3f, 0x09, 0xb1, 0x6f, 0xc3, 0x8a,
+	0xa2, 0x9b, 0xdd, 0xa1, 0xd5, 0xc1, 0x5c, 0xa5, 0x83, 0xb5, 0x27, 0xe9, 0xf0, 0x09, 0x3f, 0xe6,
+	0x23, 0x8b, 0xd7, 0x38, 0x1d, 0x4c, 0x47, 0xa6, 0xc8, 0x2e, 0x21, 0xda, 0x29, 0x48, 0xa7, 0x0b,
+	0x88, 0x04, 0x60, 0x41, 0xba, 0xe8, 0xe0, 0x8c, 0x51, 0x7d, 0x05, 0x36, 0xe4, 0xbd, 0xd6, 0xc3,
+	0xb8, 0xb4, 0x10, 0x28, 0xfe, 0x1d, 0x6a, 0x76, 0x12, 0xba, 0xf7, 0x4f, 0x57, 0x01, 0xee, 0x4f,
+	0xe2, 0x3d, 0x9e, 0x1d, 0x63, 0x6c, 0xff, 0x19, 0x74, 0xac, 0x17, 0x18, 0xbe, 0x3e, 0xc8, 0xa9,
+	0x3e, 0x0d, 0xeb, 0xe9, 0x64, 0xd1, 0xf1, 0x5c, 0x83, 0x6d, 0xfd, 0xe4, 0x8b, 0xff, 0xf9, 0xf9,
+	0xdc, 0x79, 0x7f, 0x63, 0xe7, 0xf8, 0x9d, 0x9d, 0x69, 0xce, 0xb3, 0x9d, 0x84, 0x1f, 0xc8, 0xf7,
+	0x7a, 0x3f, 0xf3, 0x60, 0xd3, 0xf5, 0xa2, 0xd0, 0x67, 0xda, 0x7d, 0x35, 0x3f, 0x37, 0xec, 0x6d,
+	0xd7, 0x3d, 0x75, 0xf9, 0x25, 0x04, 0xbb, 0x4d, 0x9c, 0x19, 0xbb, 0x6a, 0x38, 0xe7, 0x8e, 0xfe,
+	0xbe, 0xe1, 0xdd, 0x79, 0xdb, 0xf3, 0xff, 0x10, 0x56, 0x1e, 0x71, 0x51, 0x3c, 0xa7, 0x68, 0x1e,
+	0xab, 0x8e, 0x10, 0xea, 0x4f, 0x2f, 0xd8, 0x65, 0x62, 0x78, 0xc1, 0x3f, 0x5f, 0x30, 0x2c, 0x3a,
+	0x7c, 0x0e, 0x4b, 0xfa, 0xf1, 0x4d, 0x73, 0xe7, 0x45, 0x43, 0xf9, 0x99, 0x8e, 0x4b, 0x8b, 0xe9,
+	0x80, 0xc7, 0xd8, 0xd9, 0x67, 0xd0, 0x36, 0x85, 0x1d, 0xd3, 0x73, 0xb5, 0x28, 0xd4, 0xeb, 0xd6,
+	0x1b, 0x54, 0xd7, 0x57, 0xa9, 0xeb, 0x4b, 0xcc, 0x37, 0x5d, 0xd3, 0x15, 0xc2, 0xc1, 0x74, 0x3c,
+	0xf9, 0x86, 0x77, 0xc7, 0xff, 0x3e, 0x5c, 0x7a, 0x12, 0x0a, 0x9e, 0x0b, 0x3b, 0x0d, 0xa2, 0x5e,
+	0x9a, 0x87, 0xb1, 0x69, 0x33, 0x33, 0x8c, 0x36, 0x89, 0xd1, 0xaa, 0xbf, 0x6c, 0x18, 0x8d, 0xe2,
+	0x03, 0xff, 0x53, 0x58, 0xd2, 0x37, 0x68, 0xfc, 0x8b, 0xe5, 0xc7, 0x12, 0x35, 0xb5, 0x54, 0x5f,
+	0x63, 0x38, 0xd4, 0x62, 0x9e, 0x56, 0x64, 0x74, 0x35, 0xc5, 0xbe, 0xa7, 0xea, 0x5f, 0x2d, 0x96,
+	0xa9, 0xe3, 0x45, 0x45, 0xef, 0x5a, 0x53, 0xb3, 0x62, 0xb6, 0x4d, 0xcc, 0x7a, 0xec, 0x42, 0x8d,
+	0x19, 0x92, 0xa1, 0xae, 0x7e, 0xec, 0xc1, 0xa6, 0xeb, 0x72, 0xec, 0x59, 0x9c, 0x6f, 0xba, 0x9b,
+	0x4b, 0x17, 0x6b, 0xd9, 0x1b, 0xc4, 0xfe, 0x3a, 0xeb, 0x55, 0xd9, 0x17, 0xb4, 0x28, 0xc3, 0x18,
+	0xd6, 0x2a, 0xf9, 0x81, 0xdf, 0x1c, 0xd4, 0x9a, 0x31, 0x37, 0x9c, 0x05, 0xb0, 0xeb, 0xc4, 0x74,
+	0x8b, 0x6d, 0x1a, 0xa6, 0xa2, 0xb4, 0x75, 0xfc, 0x67, 0x30, 0x8f, 0xd7, 0x05, 0x67, 0xf1, 0x38,
+	0x6f, 0xce, 0x7f, 0x8b, 0x6b, 0x85, 0xac, 0x4b, 0x1d, 0xfb, 0x6c, 0xc5, 0x74, 0x8c, 0x97, 0x49,
+	0xb0, 0xc7, 0xcf, 0xc1, 0xaf, 0xd7, 0xd1, 0xfd, 0xed, 0x19, 0x25, 0xf6, 0x57, 0x1b, 0x0a, 0x23,
+	0x8e, 0x57, 0xd8, 0x25, 0xc3, 0x31, 0x0b, 0x5f, 0x56, 0x46, 0xf3, 0x63, 0x0f, 0xce, 0xd7, 0x39,
+	0xe4, 0xfe, 0x8d, 0x46, 0xee, 0x66, 0x8d, 0xb2, 0x59, 0x24, 0x4a, 0x84, 0x9b, 0x24, 0xc2, 0x55,
+	0xd6, 0x6d, 0x10, 0x21, 0x47, 0x19, 0x8e, 0x60, 0xb5, 0x7c, 0x0a, 0xe0, 0x5f, 0x29, 0x96, 0x47,
+	0xfd, 0x70, 0xa0, 0x61, 0xb3, 0xd5, 0x47, 0x3b, 0x2c, 0x7d, 0x8d, 0x9c, 0x12, 0xba, 0x94, 0x55,
+	0x2a, 0xec, 0xfb, 0xd7, 0xea, 0xbc, 0xec, 0x8a, 0x7f, 0x03, 0xb7, 0x2f, 0x11, 0xb7, 0x6b, 0x6c,
+	0xcb, 0xc5, 0x8d, 0xbe, 0x47, 0x7e, 0x2f, 0xe9, 0x41, 0x5f, 0xb5, 0x08, 0x6f, 0x94, 0xdb, 0x5c,
+	0xa0, 0x6f, 0xe0, 0x7a, 0x8b, 0xb8, 0xde, 0x60, 0x57, 0x1c, 0x5c, 0x4d, 0x17, 0xc8, 0xf8, 0x27,
+	0xf2, 0x64, 0xa5, 0xb4, 0x2a, 0x22, 0x1e, 0x4f, 0x84, 0xf1, 0x34, 0x33, 0xea, 0xee, 0xbd, 0x19,
+	0xa5, 0x50, 0xf6, 0x26, 0x89, 0x70, 0x93, 0x5d, 0xb3, 0x45, 0xa8, 0xf3, 0x41, 0x21, 0xfa, 0xd0,
+	0x36, 0xfe, 0xcc, 0x98, 0xce, 0xea, 0x1b, 0xfd, 0x5e, 0xb7, 0xde, 0xd0, 0x68, 0xa7, 0x8d, 0x3b,
+	0x93, 0x3e, 0x4c, 0x7a, 0x6b, 0x9d, 0x80, 0x9e, 0xed, 0x64, 0xaa, 0xa9, 0x2a, 0xbb, 0x42, 0x1c,
+	0x2e, 0xfa, 0x9b, 0xf6, 0x60, 0x4c, 0x7f, 0x9f, 0x41, 0xe7, 0x61, 0x2e, 0xe2, 0x71, 0x28, 0xf8,
+	0xa3, 0x30, 0x9f, 0xb5, 0xe1, 0xfd, 0x82, 0xc1, 0x0c, 0x43, 0xc2, 0x8b, 0xce, 0x50, 0x3d, 0xdf,
+	0x05, 0x90, 0xd2, 0x53, 0xd5, 0x4e, 0x77, 0x61, 0xcf, 0x83, 0xab, 0xdb, 0xba, 0xcb, 0x1d, 0x16,
+	0x9d, 0x9c, 0xd2, 0xfa, 0x2e, 0xbd, 0x0f, 0xb3, 0xd7, 0xb7, 0xeb, 0x5d, 0x5a, 0xef, 0x7a, 0x63,
+	0xfb, 0xac, 0xa5, 0x5e, 0x22, 0xc5, 0xd1, 0xfc, 0x99, 0x47, 0x6b, 0xbd, 0xfa, 0x9c, 0xc8, 0x5e,
+	0xeb, 0x0d, 0x6f, 0x94, 0x7a, 0x6c, 0x16, 0xc9, 0xac, 0x95, 0x5f, 0xa5, 0x46, 0x39, 0x52, 0xf2,
+	0x82, 0xf6, 0xe3, 0x14, 0xdf, 0x2c, 0xe7, 0xfa, 0xf3, 0x97, 0xde, 0x65, 0x67, 0x5b, 0xa3, 0xf5,
+	0x1a, 0x96, 0xbb, 0x46, 0x86, 0x39, 0xe9, 0xbc, 0xf4, 0x4c, 0xc5, 0x2f, 0xf5, 0x5a, 0x1d, 0xee,
+	0x15, 0x77, 0xe3, 0x2c, 0x6d, 0x97, 0x48, 0x95, 0xd9, 0xf6, 0xeb, 0x0f, 0xf2, 0x8c, 0xcf, 0x68,
+	0x7c, 0xf2, 0xd7, 0xbb, 0x31, 0x83, 0x42, 0x49, 0xf0, 0x65, 0x92, 0x60, 0x9b, 0x5d, 0x76, 0xa9,
+	0x5a, 0x11, 0xa3, 0x0c, 0x02, 0x36, 0x0a, 0xf7, 0xad, 0xde, 0xb6, 0x19, 0xcb, 0xed, 0x7c, 0xc3,
+	0xd7, 0xbb, 0xda, 0xd0, 0xda, 0x68, 0xc2, 0xc3, 0x12, 0x21, 0x72, 0x1d, 0x50, 0xdc, 0x5a, 0xbc,
+	0x40, 0xf1, 0xb5, 0xfd, 0xa8, 0x3d, 0x61, 0xe9, 0x6d, 0x39, 0x5a, 0x14, 0xa7, 0x6b, 0xc4, 0xa9,
+	0xcb, 0x8a, 0x5d, 0x14, 0x19, 0xa2, 0x82, 0x8b, 0xfd, 0x82, 0xa3, 0xfe, 0x3c, 0xa2, 0xc2, 0xa5,
+	0xfe, 0xc4, 0xc2, 0xc1, 0x65, 0x6c, 0x88, 0x0a, 0xc7, 0x67, 0xbd, 0x96, 0x28, 0x6c, 0x4c, 0xed,
+	0xc1, 0x45, 0xaf, 0xe7, 0x6a, 0x6a, 0x0e, 0x5a, 0x0a, 0x2a, 0xe4, 0x14, 0xd2, 0xae, 0x90, 0xc5,
+	0x04, 0xe5, 0x63, 0x5d, 0x06, 0xe7, 0x82, 0x5d, 0xb8, 0xc9, 0x67, 0xef, 0x03, 0xbb, 0x33, 0x64,
+	0xf1, 0x03, 0x5a, 0x0e, 0x1a, 0x2b, 0xf3, 0x7c, 0x33, 0x9e, 0x7a, 0x85, 0xa1, 0xd7, 0x73, 0x35,
+	0x35, 0x46, 0x7e, 0xc3, 0x6a, 0xd7, 0xc8, 0x32, 0x86, 0x65, 0xbb, 0x4a, 0x62, 0x36, 0xba, 0xa3,
+	0xea, 0xd3, 0xbb, 0xec, 0x6c, 0x6b, 0x0c, 0x74, 0x0f, 0x2d, 0x32, 0x64, 0xf5, 0xc7, 0xb0, 0x51,
+	0xab, 0x62, 0xf8, 0xd7, 0xcd, 0xe5, 0x40, 0x77, 0x15, 0xa5, 0xb7, 0xdd, 0x4c, 0xd0, 0x38, 0xd2,
+	0xa8, 0x4a, 0xfb, 0x0d, 0xef, 0xce, 0xbd, 0x2f, 0x7a, 0xb0, 0x7c, 0x7f, 0x30, 0x8e, 0x13, 0x9d,
+	0xa8, 0x46, 0x00, 0xc5, 0x91, 0x87, 0x59, 0x9d, 0xb5, 0xa3, 0x93, 0xde, 0x96, 0xa3, 0xc5, 0x35,
+	0xe8, 0x10, 0x3b, 0xd7, 0xdb, 0x6d, 0x27, 0xe1, 0x2f, 0xa5, 0x2d, 0x5d, 0x29, 0x9d, 0x5c, 0x18,
+	0xbb, 0xe6, 0x3a, 0x3d, 0xe9, 0x5d, 0x71, 0x37, 0xba, 0xd6, 0x50, 0x99, 0x9b, 0xbc, 0x9b, 0x84,
+	0x0c, 0x87, 0xd0, 0xb1, 0x4e, 0x32, 0xcc, 0xea, 0xa9, 0x9f, 0x86, 0xf4, 0x7a, 0xae, 0x26, 0xc5,
+	0xea, 0x06, 0xb1, 0xba, 0xcc, 0x2e, 0xd6, 0x59, 0x15, 0x8c, 0xd6, 0x2a, 0x67, 0x20, 0xaf, 0x94,
+	0x33, 0xb8, 0x8f, 0x4d, 0x74, 0x52, 0xc6, 0x56, 0x0b, 0x86, 0x78, 0x68, 0x80, 0x8c, 0x7e, 0xe1,
+	0xc1, 0xd5, 0x4a, 0x7c, 0xfe, 0x3c, 0x16, 0x47, 0xc5, 0x09, 0x86, 0x7f, 0xcb, 0x1d, 0xc5, 0xd7,
+	0x0e, 0x59, 0x7a, 0xb7, 0xcf, 0x26, 0x54, 0xf2, 0xdc, 0x25, 0x79, 0x6e, 0xb3, 0x9b, 0x85, 0x3c,
+	0xa2, 0x89, 0xbf, 0x0c, 0x53, 0xfd, 0xfa, 0x1f, 0x66, 0x34, 0x87, 0x53, 0x37, 0xac, 0x5a, 0xbb,
+	0xfb, 0x4f, 0x36, 0xf4, 0xb2, 0xf6, 0xaf, 0x5a, 0x1a, 0x31, 0xd4, 0x3b, 0x89, 0x22, 0xf7, 0x0f,
+	0x28, 0x04, 0x52, 0x87, 0xdf, 0x66, 0x75, 0xb9, 0x9e, 0xec, 0x98, 0x85, 0x5c, 0x7f, 0x66, 0xa3,
+	0xa3, 0x38, 0xb6, 0x51, 0x30, 0x53, 0x87, 0xd4, 0x38, 0xb8, 0x17, 0xd2, 0x61, 0x14, 0x17, 0xda,
+	0x67, 0xb2, 0xb1, 0x32, 0x8f, 0xfa, 0x33, 0xa0, 0xb2, 0x9d, 0x95, 0x9c, 0x8a, 0x9b, 0xf2, 0xc8,
+	0xec, 0x8f, 0xc8, 0x08, 0x96, 0xaf, 0x45, 0xfb, 0x56, 0x84, 0xe5, 0xbc, 0x82, 0xdd, 0xdb, 0x6e,
+	0x26, 0x68, 0xde, 0x3d, 0x83, 0x12, 0x25, 0x32, 0xff, 0xa9, 0x47, 0xd7, 0xbc, 0xdd, 0x8f, 0x7d,
+	0x66, 0x8e, 0xfa, 0x96, 0x33, 0x29, 0xa8, 0xbf, 0x46, 0x72, 0x6d, 0x2d, 0x71, 0x52, 0xd0, 0xa1,
+	0x14, 0xc7, 0xb0, 0x56, 0xf9, 0xf7, 0x27, 0x53, 0x0c, 0x70, 0xff, 0x9d, 0x54, 0xef, 0x5a, 0x53,
+	0xb3, 0x2b, 0x24, 0x52, 0x5a, 0x2f, 0x93, 0x22, 0xdf, 0x3f, 0xf5, 0xb0, 0xb2, 0x3a, 0x4a, 0xc3,
+	0x41, 0xed, 0xbf, 0xc3, 0xcc, 0x0c, 0x34, 0xfd, 0x5b, 0x59, 0x6f, 0xbb, 0x99, 0xc0, 0x15, 0x15,
+	0x49, 0x21, 0x26, 0x55, 0x62, 0xe9, 0x69, 0x3b, 0x56, 0xe5, 0xda, 0x58, 0x95, 0x7a, 0x35, 0xdb,
+	0x38, 0xdb, 0x72, 0xc9, 0xda, 0x65, 0x96, 0xf3, 0xe2, 0x63, 0x64, 0xf1, 0x7b, 0x00, 0x7b, 0x22,
+	0x9d, 0x28, 0x0e, 0x8d, 0xdb, 0xb4, 0xa1, 0xff, 0x52, 0xce, 0xa3, 0xfb, 0x37, 0xbd, 0xbd, 0x84,
+	0xb5, 0x4a, 0x79, 0xda, 0xcc, 0x9e, 0xbb, 0x60, 0xde, 0xbb, 0xd6, 0xd4, 0xec, 0xf2, 0x70, 0x92,
+	0xdf, 0x4b, 0x49, 0xb2, 0xa3, 0xeb, 0xd5, 0x38, 0xa8, 0x1f, 0xc2, 0x46, 0xad, 0x80, 0x6d, 0xe6,
+	0xad, 0xa9, 0x0c, 0xde, 0xdb, 0x6e, 0x26, 0x70, 0x25, 0x0e, 0x65, 0xf6, 0xd3, 0xc4, 0x16, 0xe0,
+	0x7b, 0xa8, 0xd5, 0x30, 0x13, 0x54, 0xe9, 0xf6, 0xcd, 0x9d, 0x71, 0xab, 0x3e, 0xde, 0xdb, 0x2c,
+	0x23, 0x9b, 0x27, 0x6c, 0x82, 0x04, 0x72, 0xda, 0xb0, 0xeb, 0xdf, 0xc5, 0x77, 0xa0, 0xe9, 0x44,
+	0xf6, 0x7c, 0x66, 0x0d, 0xb1, 0xdc, 0xbb, 0x63, 0xba, 0x74, 0xef, 0xe9, 0x04, 0x53, 0xd4, 0x3d,
+	0x2e, 0x74, 0x69, 0xdc, 0x94, 0x13, 0x2b, 0xc5, 0xf6, 0xde, 0xa5, 0x1a, 0xde, 0x95, 0x62, 0xcb,
+	0xde, 0x47, 0x8a, 0x06, 0x05, 0xff, 0x7d, 0x68, 0x9b, 0x52, 0x7a, 0xb3, 0xe0, 0xdd, 0x52, 0x4e,
+	0x61, 0x55, 0xdd, 0xcb, 0xc9, 0xaa, 0xec, 0x7e, 0x68, 0xfa, 0xfb, 0x91, 0x07, 0x5b, 0xbb, 0x19,
+	0x0f, 0x05, 0x77, 0x1c, 0x70, 0xcf, 0x72, 0xc7, 0xac, 0x72, 0x65, 0xdb, 0xe5, 0x92, 0x1d, 0x36,
+	0x43, 0x3f, 0x89, 0xd8, 0xa1, 0x7f, 0xab, 0x20, 0xc7, 0xf7, 0x33, 0x4f, 0xde, 0x85, 0x70, 0x09,
+	0xf0, 0x86, 0xe5, 0xf4, 0x9b, 0x0f, 0xf5, 0x5f, 0x49, 0x98, 0x52, 0x5e, 0x53, 0x11, 0x46, 0x07,
+	0x0a, 0x39, 0xfd, 0xef, 0x8d, 0x4b, 0x10, 0x57, 0xa0, 0xfe, 0x2a, 0x5c, 0x1d, 0xb6, 0xda, 0x70,
+	0x1d, 0x72, 0x5a, 0x98, 0x7f, 0xe1, 0xc9, 0x4b, 0xd1, 0x33, 0xc7, 0x3f, 0xf3, 0x52, 0xc3, 0x6b,
+	0x44, 0x25, 0x33, 0xb5, 0xc0, 0x93, 0x01, 0x0a, 0xf4, 0x1c, 0x96, 0xf4, 0xab, 0x49, 0xb3, 0x98,
+	0x2b, 0xef, 0x2d, 0x7b, 0x97, 0x6a, 0x78, 0xc5, 0xa0, 0x47, 0x0c, 0x36, 0xd9, 0x5a, 0xc1, 0x80,
+	0x1e, 0x55, 0xca, 0xec, 0xa4, 0x5a, 0x19, 0x29, 0x02, 0x01, 0xd7, 0xd3, 0xc4, 0xde, 0x15, 0x77,
+	0x63, 0xf3, 0x58, 0x22, 0x9b, 0x50, 0x16, 0x1b, 0x31, 0xe7, 0xb2, 0x9f, 0xe8, 0xcd, 0x76, 0xc2,
+	0xba, 0xd1, 0xf5, 0xa8, 0xcf, 0x35, 0x99, 0xc7, 0x16, 0x1d, 0xf2, 0xfb, 0x03, 0x68, 0xd3, 0x33,
+	0xb7, 0xb3, 0xaa, 0xd3, 0x9b, 0xe6, 0x9d, 0x91, 0xf5, 0x26, 0xae, 0x9c, 0xab, 0xea, 0x08, 0x43,
+	0xf5, 0x86, 0xbd, 0x47, 0xb0, 0x4e, 0x1f, 0x9c, 0xb5, 0x32, 0xdd, 0xbd, 0x3b, 0x9c, 0xc0, 0xa0,
+	0xd2, 0x9b, 0x2a, 0xe5, 0x57, 0xde, 0x18, 0x1b, 0xef, 0xe3, 0x7e, 0x7b, 0x6c, 0x4a, 0xed, 0xf6,
+	0x3b, 0x61, 0xd7, 0xe6, 0x8f, 0xcb, 0x9f, 0x53, 0xfd, 0xf0, 0xe0, 0x1c, 0xfd, 0x3f, 0xd9, 0xbb,
+	0xff, 0x3f, 0x00, 0xdb, 0x82, 0x16, 0x39, 0xf8, 0x54, 0x00, 0x00,
 }
@@ -0,0 +1,94 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"sync"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultPprofListen is the default listen address of pprof server, only local access is allowed.
+const DefaultPprofListen = "127.0.0.1:6060"
+
+// Errors
+var (
+	ErrPprofAlreadyStarted = errors.New("pprof server already started")
+	ErrPprofNotStarted     = errors.New("pprof server not started")
+)
+
+// pprofServer serves the runtime profiling data on demand.
+type pprofServer struct {
+	mu       sync.Mutex
+	listener net.Listener
+}
+
+func (s *pprofServer) start(addr string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener != nil {
+		return "", ErrPprofAlreadyStarted
+	}
+	if len(addr) == 0 {
+		addr = DefaultPprofListen
+	}
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", err
+	}
+	s.listener = listener
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+
+	logging.CLog().WithFields(logrus.Fields{
+		"address": listener.Addr().String(),
+	}).Info("Started pprof server.")
+
+	go func() {
+		if err := http.Serve(listener, mux); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Info("Pprof server exited.")
+		}
+	}()
+	return listener.Addr().String(), nil
+}
+
+func (s *pprofServer) stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener == nil {
+		return ErrPprofNotStarted
+	}
+	err := s.listener.Close()
+	s.listener = nil
+	return err
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPprofServer(t *testing.T) {
+	s := new(pprofServer)
+	assert.Equal(t, ErrPprofNotStarted, s.stop())
+
+	addr, err := s.start("127.0.0.1:0")
+	assert.Nil(t, err)
+	_, err = s.start("127.0.0.1:0")
+	assert.Equal(t, ErrPprofAlreadyStarted, err)
+
+	resp, err := http.Get("http://" + addr + "/debug/pprof/cmdline")
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	assert.Nil(t, s.stop())
+	assert.Equal(t, ErrPprofNotStarted, s.stop())
+}
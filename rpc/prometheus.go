@@ -0,0 +1,58 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"net/http"
+	"path"
+	"time"
+
+	nebmetrics "github.com/nebulasio/go-nebulas/metrics"
+	metrics "github.com/rcrowley/go-metrics"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// MetricsPath is the path of Prometheus metrics on the gateway.
+const MetricsPath = "/metrics"
+
+// metricsInterceptor records the latency of rpc methods.
+func metricsInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		labels := map[string]string{"method": path.Base(info.FullMethod)}
+		nebmetrics.GetOrRegisterHistogram("neb.rpc.latency.seconds", labels, nebmetrics.DefaultLatencyBuckets).Observe(time.Since(start).Seconds())
+		return resp, err
+	}
+}
+
+// metricsHandler serves the Prometheus metrics, the node status gauges are evaluated on scrape.
+func metricsHandler(neb Neblet) http.HandlerFunc {
+	metrics.GetOrRegister("neb.chain.height", metrics.NewFunctionalGauge(func() int64 {
+		return int64(neb.BlockChain().TailBlock().Height())
+	}))
+	metrics.GetOrRegister("neb.txpool.size", metrics.NewFunctionalGauge(func() int64 {
+		return int64(neb.BlockChain().TransactionPool().Len())
+	}))
+	metrics.GetOrRegister("neb.net.peers", metrics.NewFunctionalGauge(func() int64 {
+		return int64(neb.NetManager().Node().PeersCount())
+	}))
+	return nebmetrics.PrometheusHandler().ServeHTTP
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	nebmetrics "github.com/nebulasio/go-nebulas/metrics"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestMetricsInterceptor(t *testing.T) {
+	interceptor := metricsInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/rpcpb.ApiService/GetNebState"}
+	resp, err := interceptor(context.Background(), "req", info, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, "req", resp)
+
+	labels := map[string]string{"method": "GetNebState"}
+	h := nebmetrics.GetOrRegisterHistogram("neb.rpc.latency.seconds", labels, nebmetrics.DefaultLatencyBuckets)
+	assert.Equal(t, uint64(1), h.Snapshot().Count)
+}
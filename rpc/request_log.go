@@ -0,0 +1,153 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// const
+const (
+	// RequestIDKey is the metadata key of the request id, the id of the caller is used if
+	// present, the gateway forwards it from the Grpc-Metadata-X-Request-Id header.
+	RequestIDKey = "x-request-id"
+
+	// forwardedForKey is the metadata key of the client address set by the gateway.
+	forwardedForKey = "x-forwarded-for"
+)
+
+type requestIDContextKey struct{}
+
+// RequestID returns the id of the rpc request of the context.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDContextKey{}).(string)
+	return id
+}
+
+// requestLogger logs the rpc requests, the requests slower than the threshold are
+// written to the slow request log as well.
+type requestLogger struct {
+	threshold time.Duration
+	slowLog   *logrus.Logger
+}
+
+func newRequestLogger(threshold uint32, slowLogPath string) (*requestLogger, error) {
+	l := &requestLogger{threshold: time.Duration(threshold) * time.Millisecond}
+	if len(slowLogPath) > 0 {
+		file, err := os.OpenFile(slowLogPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, err
+		}
+		l.slowLog = logrus.New()
+		l.slowLog.Out = file
+		l.slowLog.Formatter = &logrus.TextFormatter{FullTimestamp: true, DisableColors: true}
+	}
+	return l, nil
+}
+
+// unaryInterceptor assigns the request id and logs the unary requests.
+func (l *requestLogger) unaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id := requestID(ctx)
+		grpc.SetHeader(ctx, metadata.Pairs(RequestIDKey, id))
+
+		start := time.Now()
+		resp, err := handler(context.WithValue(ctx, requestIDContextKey{}, id), req)
+		l.log(ctx, id, info.FullMethod, time.Since(start), err, true)
+		return resp, err
+	}
+}
+
+// streamInterceptor assigns the request id and logs the streaming requests when closed.
+func (l *requestLogger) streamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		id := requestID(ctx)
+		ss.SetHeader(metadata.Pairs(RequestIDKey, id))
+
+		start := time.Now()
+		err := handler(srv, &requestIDStream{ServerStream: ss, ctx: context.WithValue(ctx, requestIDContextKey{}, id)})
+		// streams are long lived, they are never slow requests.
+		l.log(ctx, id, info.FullMethod, time.Since(start), err, false)
+		return err
+	}
+}
+
+func (l *requestLogger) log(ctx context.Context, id string, method string, latency time.Duration, err error, checkSlow bool) {
+	fields := logrus.Fields{
+		"id":      id,
+		"method":  method,
+		"caller":  caller(ctx),
+		"latency": latency,
+		"code":    grpc.Code(err),
+	}
+	if err != nil {
+		fields["err"] = err
+	}
+	logging.VLog().WithFields(fields).Info("Rpc request.")
+
+	if !checkSlow || l.threshold == 0 || latency < l.threshold {
+		return
+	}
+	if l.slowLog != nil {
+		l.slowLog.WithFields(fields).Warn("Slow rpc request.")
+	} else {
+		logging.VLog().WithFields(fields).Warn("Slow rpc request.")
+	}
+}
+
+type requestIDStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDStream) Context() context.Context {
+	return s.ctx
+}
+
+// requestID returns the request id of the caller, or a new random one.
+func requestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md[RequestIDKey]) > 0 && len(md[RequestIDKey][0]) > 0 {
+		return md[RequestIDKey][0]
+	}
+	id := make([]byte, 8)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+// caller returns the client address, the address forwarded by the gateway is preferred.
+func caller(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md[forwardedForKey]) > 0 {
+		return md[forwardedForKey][0]
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		return p.Addr.String()
+	}
+	return ""
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestID(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDKey, "caller-id"))
+	assert.Equal(t, "caller-id", requestID(ctx))
+
+	id := requestID(context.Background())
+	assert.Equal(t, 16, len(id))
+	assert.NotEqual(t, id, requestID(context.Background()))
+}
+
+func TestRequestLogger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rpc")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "slow.log")
+	l, err := newRequestLogger(10, path)
+	assert.Nil(t, err)
+	interceptor := l.unaryInterceptor()
+
+	var id string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		id = RequestID(ctx)
+		if req == "slow" {
+			time.Sleep(20 * time.Millisecond)
+		}
+		return req, nil
+	}
+
+	_, err = interceptor(context.Background(), "fast", &grpc.UnaryServerInfo{FullMethod: "/rpcpb.ApiService/GetNebState"}, handler)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, id)
+
+	_, err = interceptor(context.Background(), "slow", &grpc.UnaryServerInfo{FullMethod: "/rpcpb.ApiService/Call"}, handler)
+	assert.Nil(t, err)
+
+	content, err := ioutil.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, strings.Count(string(content), "Slow rpc request."))
+	assert.Contains(t, string(content), "/rpcpb.ApiService/Call")
+	assert.Contains(t, string(content), id)
+}
@@ -3,6 +3,8 @@ package rpc
 import (
 	"errors"
 	"net"
+	"net/http"
+	"os"
 
 	"github.com/sirupsen/logrus"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/nebulasio/go-nebulas/neblet/pb"
 	"github.com/nebulasio/go-nebulas/net/p2p"
 	"github.com/nebulasio/go-nebulas/rpc/pb"
+	nsync "github.com/nebulasio/go-nebulas/sync"
 	"github.com/nebulasio/go-nebulas/util/logging"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -20,6 +23,7 @@ import (
 // Errors
 var (
 	ErrEmptyRPCListenList = errors.New("empty rpc listen list")
+	ErrEmptyRPCUnixSocket = errors.New("empty rpc unix socket")
 )
 
 // Neblet interface breaks cycle import dependency and hides unused services.
@@ -30,6 +34,7 @@ type Neblet interface {
 	NetManager() p2p.Manager
 	EventEmitter() *core.EventEmitter
 	Consensus() consensus.Consensus
+	SyncService() *nsync.Service
 }
 
 // GRPCServer server interface for api & management etc.
@@ -52,46 +57,217 @@ type Server struct {
 
 	rpcServer *grpc.Server
 
+	// listeners serve the services configured per listener.
+	listeners []*rpcListener
+
+	// unixServer serves on the unix socket, nil if not configured.
+	unixServer *grpc.Server
+
 	rpcConfig *nebletpb.RPCConfig
+
+	ethService *EthService
+	ethServers []*http.Server
+
+	webhooks *WebhookManager
 }
 
 // NewServer creates a new RPC server and registers the rpc endpoints.
 func NewServer(neblet Neblet) *Server {
 	cfg := neblet.Config().Rpc
 
-	rpc := grpc.NewServer()
+	limits := newRequestLimits(cfg)
+	requestLog, err := newRequestLogger(cfg.SlowRequestThreshold, cfg.SlowRequestLog)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Fatal("Failed to open slow request log.")
+	}
+	// the logging, tracing, metrics and timeouts are shared with the eth JSON-RPC server.
+	observers := []grpc.UnaryServerInterceptor{requestLog.unaryInterceptor()}
+	if len(neblet.Config().GetStats().GetTracing().GetEndpoint()) > 0 {
+		observers = append(observers, tracingInterceptor())
+	}
+	if cfg.PrometheusMetrics {
+		observers = append(observers, metricsInterceptor())
+	}
+	timeout := timeoutInterceptor(cfg.MethodTimeouts)
+	interceptors := append(append([]grpc.UnaryServerInterceptor{}, observers...), errorsInterceptor(), limitsInterceptor(limits), timeout)
+	ethInterceptors := append(append([]grpc.UnaryServerInterceptor{}, observers...), timeout)
+	streamInterceptors := []grpc.StreamServerInterceptor{requestLog.streamInterceptor()}
+
+	opts := newServerOptions(interceptors, streamInterceptors, limits.maxMessageSize, false)
+	rpc := grpc.NewServer(opts...)
+
+	eventSchemas, err := NewEventSchemaRegistry(cfg.EventSchemas)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Fatal("Failed to load event schemas.")
+	}
 
 	srv := &Server{neblet: neblet, rpcServer: rpc, rpcConfig: cfg}
-	api := &APIService{server: srv}
-	admin := &AdminService{server: srv}
+	api := &APIService{server: srv, eventSchemas: eventSchemas}
+	srv.ethService = NewEthService(api, limits.maxMessageSize, ethInterceptors...)
+	srv.webhooks = NewWebhookManager(neblet.BlockChain())
+	admin := &AdminService{server: srv, webhooks: srv.webhooks, pprof: new(pprofServer), multisig: newMultisigStore()}
 
 	rpcpb.RegisterApiServiceServer(rpc, api)
-	rpcpb.RegisterAdminServiceServer(rpc, admin)
+	if !cfg.AdminUnixOnly {
+		rpcpb.RegisterAdminServiceServer(rpc, admin)
+	}
 	// Register reflection service on gRPC server.
 	// TODO: Enable reflection only for testing mode.
 	reflection.Register(rpc)
 
+	for _, v := range cfg.Listeners {
+		if err := checkListenerServices(v.Services, cfg.AdminUnixOnly); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"address":  v.Address,
+				"services": v.Services,
+				"err":      err,
+			}).Fatal("Invalid rpc listener.")
+		}
+		// listeners without the api service only serve the public methods.
+		public := hasService(v.Services, Public) && !hasService(v.Services, API)
+		server := grpc.NewServer(newServerOptions(interceptors, streamInterceptors, limits.maxMessageSize, public)...)
+		if hasService(v.Services, API) || hasService(v.Services, Public) {
+			rpcpb.RegisterApiServiceServer(server, api)
+		}
+		if hasService(v.Services, Admin) {
+			rpcpb.RegisterAdminServiceServer(server, admin)
+		}
+		reflection.Register(server)
+		srv.listeners = append(srv.listeners, &rpcListener{address: v.Address, services: v.Services, server: server})
+	}
+
+	// the unix socket is protected by file permission, serve all services on it.
+	if len(cfg.UnixSocket) > 0 {
+		srv.unixServer = grpc.NewServer(opts...)
+		rpcpb.RegisterApiServiceServer(srv.unixServer, api)
+		rpcpb.RegisterAdminServiceServer(srv.unixServer, admin)
+	}
+
 	return srv
 }
 
+// newServerOptions return the options of the grpc server, the methods not in the
+// public service are rejected if public.
+func newServerOptions(interceptors []grpc.UnaryServerInterceptor, streamInterceptors []grpc.StreamServerInterceptor, maxMessageSize int, public bool) []grpc.ServerOption {
+	if public {
+		interceptors = append(interceptors, publicInterceptor())
+		streamInterceptors = append(streamInterceptors, publicStreamInterceptor())
+	}
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(chainUnaryInterceptors(interceptors...)),
+		grpc.StreamInterceptor(chainStreamInterceptors(streamInterceptors...)),
+	}
+	// the transport rejects messages larger than default size before the interceptor.
+	if maxMessageSize > DefaultMaxMessageSize {
+		opts = append(opts, grpc.MaxRecvMsgSize(maxMessageSize))
+	}
+	return opts
+}
+
 // Start starts the rpc server and serves incoming requests.
 func (s *Server) Start() error {
 	logging.CLog().Info("Starting RPC GRPCServer...")
 
-	if len(s.rpcConfig.RpcListen) == 0 {
+	if len(s.rpcConfig.RpcListen) == 0 && len(s.listeners) == 0 {
 		return ErrEmptyRPCListenList
 	}
 
 	for _, v := range s.rpcConfig.RpcListen {
-		if err := s.start(v); err != nil {
+		if err := s.start(s.rpcServer, v); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range s.listeners {
+		if err := s.start(v.server, v.address); err != nil {
+			return err
+		}
+	}
+
+	for _, v := range s.rpcConfig.EthListen {
+		if err := s.startEth(v); err != nil {
+			return err
+		}
+	}
+
+	if s.unixServer != nil {
+		if err := s.startUnix(s.rpcConfig.UnixSocket); err != nil {
 			return err
 		}
 	}
 
+	if err := s.webhooks.Start(); err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to start webhooks")
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) startUnix(path string) error {
+	listener, err := listenUnix(path, s.unixSocketMode())
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to listen to RPC unix socket")
+		return err
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"path": path,
+	}).Info("Started RPC GRPCServer on unix socket.")
+
+	go func() {
+		if err := s.unixServer.Serve(listener); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Info("RPC unix socket server exited.")
+		}
+	}()
+
+	return nil
+}
+
+func (s *Server) unixSocketMode() os.FileMode {
+	if s.rpcConfig.UnixSocketMode == 0 {
+		return DefaultUnixSocketMode
+	}
+	return os.FileMode(s.rpcConfig.UnixSocketMode)
+}
+
+func (s *Server) startEth(addr string) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"err": err,
+		}).Error("Failed to listen to Eth JSON-RPC Server")
+		return err
+	}
+
+	logging.CLog().WithFields(logrus.Fields{
+		"address": addr,
+	}).Info("Started Eth JSON-RPC Server.")
+
+	server := &http.Server{Handler: s.ethService}
+	s.ethServers = append(s.ethServers, server)
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			logging.CLog().WithFields(logrus.Fields{
+				"err": err,
+			}).Info("Eth JSON-RPC server exited.")
+		}
+	}()
+
 	return nil
 }
 
-func (s *Server) start(addr string) error {
+func (s *Server) start(server *grpc.Server, addr string) error {
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
 		logging.CLog().WithFields(logrus.Fields{
@@ -105,7 +281,7 @@ func (s *Server) start(addr string) error {
 	}).Info("Started RPC GRPCServer.")
 
 	go func() {
-		if err := s.rpcServer.Serve(listener); err != nil {
+		if err := server.Serve(listener); err != nil {
 			logging.CLog().WithFields(logrus.Fields{
 				"err": err,
 			}).Info("RPC server exited.")
@@ -118,21 +294,67 @@ func (s *Server) start(addr string) error {
 // RunGateway run grpc mapping to http after apiserver have started.
 func (s *Server) RunGateway() error {
 	//time.Sleep(3 * time.Second)
-	rpcListen := s.rpcConfig.RpcListen[0]
 	gatewayListen := s.rpcConfig.HttpListen
-	httpModule := s.rpcConfig.HttpModule
+	var httpModule []string
+	for _, v := range s.rpcConfig.HttpModule {
+		if v == Admin && s.rpcConfig.AdminUnixOnly {
+			continue
+		}
+		httpModule = append(httpModule, v)
+	}
+	rpcListen, err := gatewayEndpoint(s.rpcConfig, httpModule)
+	if err != nil {
+		logging.CLog().WithFields(logrus.Fields{
+			"modules": httpModule,
+			"err":     err,
+		}).Error("Failed to find rpc listener for RPC Gateway.")
+		return err
+	}
+	maxBlockLag := s.rpcConfig.ReadyMaxBlockLag
+	if maxBlockLag == 0 {
+		maxBlockLag = DefaultReadyMaxBlockLag
+	}
+	maxMessageSize := newRequestLimits(s.rpcConfig).maxMessageSize
+	handlers := map[string]http.HandlerFunc{
+		HealthzPath: healthzHandler(s.neblet, maxBlockLag),
+		ReadyzPath:  readyzHandler(s.neblet, maxBlockLag),
+	}
+	if s.rpcConfig.PrometheusMetrics {
+		handlers[MetricsPath] = metricsHandler(s.neblet)
+	}
+	if hasService(httpModule, Admin) {
+		handlers[VoteSnapshotCSVPath] = voteSnapshotCSVHandler(s.neblet)
+	}
 	logging.CLog().WithFields(logrus.Fields{
 		"rpc-server":  rpcListen,
 		"http-server": gatewayListen,
 	}).Info("Starting RPC Gateway GRPCServer...")
 
 	go (func() {
-		if err := Run(rpcListen, gatewayListen, httpModule); err != nil {
+		if err := Run(rpcListen, gatewayListen, httpModule, handlers, maxMessageSize); err != nil {
 			logging.CLog().WithFields(logrus.Fields{
 				"error": err,
 			}).Fatal("Failed to start RPC Gateway.")
 		}
 	})()
+
+	if gatewaySocket := s.rpcConfig.HttpUnixSocket; len(gatewaySocket) > 0 {
+		if s.unixServer == nil {
+			return ErrEmptyRPCUnixSocket
+		}
+		logging.CLog().WithFields(logrus.Fields{
+			"rpc-server":  s.rpcConfig.UnixSocket,
+			"http-server": gatewaySocket,
+		}).Info("Starting RPC Gateway on unix socket...")
+
+		go (func() {
+			if err := RunUnix(s.rpcConfig.UnixSocket, gatewaySocket, s.unixSocketMode(), handlers); err != nil {
+				logging.CLog().WithFields(logrus.Fields{
+					"error": err,
+				}).Fatal("Failed to start RPC Gateway on unix socket.")
+			}
+		})()
+	}
 	return nil
 }
 
@@ -143,6 +365,16 @@ func (s *Server) Stop() {
 	}).Info("Stopping RPC GRPCServer and Gateway...")
 
 	s.rpcServer.Stop()
+	for _, v := range s.listeners {
+		v.server.Stop()
+	}
+	if s.unixServer != nil {
+		s.unixServer.Stop()
+	}
+	for _, v := range s.ethServers {
+		v.Close()
+	}
+	s.webhooks.Stop()
 
 	logging.CLog().Info("Stopped RPC GRPCServer and Gateway.")
 }
@@ -0,0 +1,49 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+)
+
+func stateDiffResponse(diff *core.StateDiff) *rpcpb.StateDiff {
+	resp := &rpcpb.StateDiff{
+		GasUsed:      diff.GasUsed.String(),
+		ExecuteError: diff.Err,
+		Balances:     make([]*rpcpb.BalanceDelta, len(diff.Balances)),
+		Storage:      make([]*rpcpb.StorageWrite, len(diff.Storage)),
+		Events:       make([]*rpcpb.Event, len(diff.Events)),
+	}
+	for i, v := range diff.Balances {
+		resp.Balances[i] = &rpcpb.BalanceDelta{Address: v.Address.String(), Delta: v.Delta.String()}
+	}
+	for i, v := range diff.Storage {
+		resp.Storage[i] = &rpcpb.StorageWrite{
+			Contract: v.Contract.String(),
+			Key:      v.Key,
+			Value:    v.Value,
+			Deleted:  v.Deleted,
+		}
+	}
+	for i, v := range diff.Events {
+		resp.Events[i] = &rpcpb.Event{Topic: v.Topic, Data: v.Data, Indexed: v.Indexed}
+	}
+	return resp
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Overflow policies of the slow subscribers.
+const (
+	OverflowDropOldest = "drop_oldest"
+	OverflowDisconnect = "disconnect"
+)
+
+// DefaultSubscribeBufferSize is the default count of events buffered for each subscriber.
+const DefaultSubscribeBufferSize = 1024
+
+// TopicEventsDropped is the message type of the notice of dropped events.
+const TopicEventsDropped = "rpc.eventsDropped"
+
+// ErrInvalidOverflowPolicy invalid overflow policy
+var ErrInvalidOverflowPolicy = errors.New("invalid overflow policy")
+
+// eventBuffer decouples the event sources from a subscriber, the sources never
+// block on a slow subscriber, the events are dropped by the overflow policy instead.
+type eventBuffer struct {
+	mu         sync.Mutex
+	items      []interface{}
+	size       int
+	policy     string
+	dropped    uint64
+	overflowed bool
+	notifyCh   chan struct{}
+}
+
+func newEventBuffer(size int, policy string) (*eventBuffer, error) {
+	if len(policy) == 0 {
+		policy = OverflowDropOldest
+	}
+	if policy != OverflowDropOldest && policy != OverflowDisconnect {
+		return nil, ErrInvalidOverflowPolicy
+	}
+	if size <= 0 {
+		size = DefaultSubscribeBufferSize
+	}
+	return &eventBuffer{
+		size:     size,
+		policy:   policy,
+		notifyCh: make(chan struct{}, 1),
+	}, nil
+}
+
+// push adds the item, the oldest item is dropped if the buffer is full, or all following
+// items are dropped if the policy is disconnect.
+func (b *eventBuffer) push(item interface{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case b.overflowed:
+		b.dropped++
+		metricsSubscribeDropped.Mark(1)
+		return
+	case len(b.items) < b.size:
+		b.items = append(b.items, item)
+	case b.policy == OverflowDisconnect:
+		b.overflowed = true
+		b.dropped++
+		metricsSubscribeDropped.Mark(1)
+	default:
+		b.items = append(b.items[1:], item)
+		b.dropped++
+		metricsSubscribeDropped.Mark(1)
+	}
+
+	select {
+	case b.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// pop returns the oldest item and the count of items dropped since last pop,
+// the item is nil if the buffer is empty or overflowed.
+func (b *eventBuffer) pop() (item interface{}, dropped uint64, overflowed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	dropped, b.dropped = b.dropped, 0
+	if b.overflowed {
+		return nil, dropped, true
+	}
+	if len(b.items) > 0 {
+		item = b.items[0]
+		b.items[0] = nil
+		b.items = b.items[1:]
+	}
+	return item, dropped, false
+}
+
+func eventsDroppedNotice(dropped uint64) string {
+	return fmt.Sprintf(`{"dropped":%d}`, dropped)
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBufferDropOldest(t *testing.T) {
+	buf, err := newEventBuffer(2, "")
+	assert.Nil(t, err)
+	buf.push(1)
+	buf.push(2)
+	buf.push(3)
+	assert.Equal(t, 1, len(buf.notifyCh))
+
+	item, dropped, overflowed := buf.pop()
+	assert.Equal(t, 2, item)
+	assert.Equal(t, uint64(1), dropped)
+	assert.False(t, overflowed)
+
+	item, dropped, _ = buf.pop()
+	assert.Equal(t, 3, item)
+	assert.Equal(t, uint64(0), dropped)
+
+	item, _, _ = buf.pop()
+	assert.Nil(t, item)
+}
+
+func TestEventBufferDisconnect(t *testing.T) {
+	buf, err := newEventBuffer(1, OverflowDisconnect)
+	assert.Nil(t, err)
+	buf.push(1)
+	buf.push(2)
+	buf.push(3)
+
+	item, dropped, overflowed := buf.pop()
+	assert.Nil(t, item)
+	assert.Equal(t, uint64(2), dropped)
+	assert.True(t, overflowed)
+
+	_, err = newEventBuffer(1, "block")
+	assert.Equal(t, ErrInvalidOverflowPolicy, err)
+	buf, _ = newEventBuffer(0, "")
+	assert.Equal(t, DefaultSubscribeBufferSize, buf.size)
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestTimeoutInterceptor(t *testing.T) {
+	interceptor := timeoutInterceptor(map[string]uint32{"Call": 100})
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		_, ok := ctx.Deadline()
+		return ok, nil
+	}
+
+	hasDeadline, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/rpcpb.ApiService/Call"}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, true, hasDeadline)
+
+	hasDeadline, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/rpcpb.ApiService/EstimateGas"}, handler)
+	assert.Nil(t, err)
+	assert.Equal(t, false, hasDeadline)
+}
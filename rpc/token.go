@@ -0,0 +1,77 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/util"
+)
+
+// ErrInvalidTokenDecimals is returned if the decimals of a token contract is not an integer.
+var ErrInvalidTokenDecimals = errors.New("invalid token decimals")
+
+// callToken calls the standard NRC20 function of the token contract on the tail state and
+// returns the result decoded from JSON, strings and numbers are returned as is.
+func callToken(ctx context.Context, bc *core.BlockChain, contract *core.Address, function string, args ...string) (string, error) {
+	if args == nil {
+		args = []string{}
+	}
+	argsJSON, err := json.Marshal(args)
+	if err != nil {
+		return "", err
+	}
+	payload, err := core.NewCallPayload(function, string(argsJSON)).ToBytes()
+	if err != nil {
+		return "", err
+	}
+	tx := core.NewTransaction(bc.ChainID(), contract, contract, util.NewUint128(), 1, core.TxPayloadCallType, payload, core.TransactionGasPrice, core.TransactionMaxGas)
+	result, err := bc.Call(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+
+	var value interface{}
+	decoder := json.NewDecoder(strings.NewReader(result))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return result, nil
+	}
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	}
+	return result, nil
+}
+
+// parseTokenDecimals parses the decimals returned by the token contract.
+func parseTokenDecimals(decimals string) (uint32, error) {
+	v, err := strconv.ParseUint(decimals, 10, 32)
+	if err != nil {
+		return 0, ErrInvalidTokenDecimals
+	}
+	return uint32(v), nil
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTokenDecimals(t *testing.T) {
+	tests := []struct {
+		decimals string
+		want     uint32
+		err      error
+	}{
+		{"18", 18, nil},
+		{"0", 0, nil},
+		{"-1", 0, ErrInvalidTokenDecimals},
+		{"1.5", 0, ErrInvalidTokenDecimals},
+		{`{"decimals":18}`, 0, ErrInvalidTokenDecimals},
+	}
+	for _, tt := range tests {
+		decimals, err := parseTokenDecimals(tt.decimals)
+		assert.Equal(t, tt.err, err, tt.decimals)
+		assert.Equal(t, tt.want, decimals, tt.decimals)
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"github.com/nebulasio/go-nebulas/tracing"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// TraceparentKey is the metadata key of the W3C trace context, the gateway forwards
+// it from the Grpc-Metadata-Traceparent header.
+const TraceparentKey = "traceparent"
+
+// tracingInterceptor starts the span of the rpc request, joining the trace of the caller.
+func tracingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var traceparent string
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md[TraceparentKey]) > 0 {
+			traceparent = md[TraceparentKey][0]
+		}
+		span, ctx := tracing.StartServerSpan(ctx, info.FullMethod, traceparent)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+		span.SetError(err)
+		return resp, err
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"time"
+
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+)
+
+// States of the transaction in SubscribeTransaction.
+const (
+	TxStatePending      = "pending"
+	TxStateIncluded     = "included"
+	TxStateIrreversible = "irreversible"
+	TxStateDropped      = "dropped"
+)
+
+// Settings of SubscribeTransaction.
+var (
+	// TxStatusPollInterval is the interval to check the status of the transaction.
+	TxStatusPollInterval = time.Second
+
+	// TxDroppedBlocks is the count of block intervals a transaction is neither in pool
+	// nor on chain before it's reported dropped, the tx popped by the miner is in neither
+	// of them until the block is minted.
+	TxDroppedBlocks = int64(3)
+)
+
+// txDroppedTimeout returns the duration a transaction is missing before it's reported dropped.
+func txDroppedTimeout(blockInterval int64) time.Duration {
+	return time.Duration(TxDroppedBlocks*blockInterval) * time.Second
+}
+
+// txStatusTracker reports the changes of the transaction status.
+type txStatusTracker struct {
+	hash          string
+	confirmations uint64
+
+	last           *rpcpb.TransactionStatusResponse
+	missingFrom    time.Time
+	droppedTimeout time.Duration
+}
+
+func newTxStatusTracker(hash string, confirmations uint64, blockInterval int64) *txStatusTracker {
+	return &txStatusTracker{hash: hash, confirmations: confirmations, droppedTimeout: txDroppedTimeout(blockInterval)}
+}
+
+// update return the status if it's changed and whether the subscription is finished.
+// height is the height of the block including the tx, 0 if not on chain.
+func (t *txStatusTracker) update(now time.Time, height uint64, blockHash string, pending bool, tail, lib uint64) (*rpcpb.TransactionStatusResponse, bool) {
+	status := &rpcpb.TransactionStatusResponse{Hash: t.hash}
+	switch {
+	case height > 0:
+		status.State = TxStateIncluded
+		status.Height = height
+		status.BlockHash = blockHash
+		if tail >= height {
+			status.Confirmations = tail - height + 1
+		}
+		if lib >= height {
+			status.State = TxStateIrreversible
+		}
+	case pending:
+		status.State = TxStatePending
+	default:
+		if t.missingFrom.IsZero() {
+			t.missingFrom = now
+		}
+		if now.Sub(t.missingFrom) < t.droppedTimeout {
+			return nil, false
+		}
+		status.State = TxStateDropped
+	}
+	if status.State != TxStateDropped {
+		t.missingFrom = time.Time{}
+	}
+
+	done := status.State == TxStateIrreversible || status.State == TxStateDropped ||
+		(t.confirmations > 0 && status.Confirmations >= t.confirmations)
+	if t.last != nil && t.last.State == status.State && t.last.Height == status.Height &&
+		t.last.BlockHash == status.BlockHash && t.last.Confirmations == status.Confirmations {
+		return nil, done
+	}
+	t.last = status
+	return status, done
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxStatusTracker(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	tracker := newTxStatusTracker("tx", 0, core.DefaultBlockInterval)
+
+	// in flight, neither in pool nor on chain.
+	status, done := tracker.update(now, 0, "", false, 10, 5)
+	assert.Nil(t, status)
+	assert.False(t, done)
+
+	status, done = tracker.update(now.Add(time.Second), 0, "", true, 10, 5)
+	assert.Equal(t, TxStatePending, status.State)
+	assert.False(t, done)
+
+	// unchanged status is not reported.
+	status, done = tracker.update(now.Add(2*time.Second), 0, "", true, 10, 5)
+	assert.Nil(t, status)
+	assert.False(t, done)
+
+	status, done = tracker.update(now.Add(3*time.Second), 11, "b11", false, 11, 5)
+	assert.Equal(t, TxStateIncluded, status.State)
+	assert.Equal(t, uint64(11), status.Height)
+	assert.Equal(t, uint64(1), status.Confirmations)
+	assert.False(t, done)
+
+	status, done = tracker.update(now.Add(4*time.Second), 11, "b11", false, 13, 5)
+	assert.Equal(t, uint64(3), status.Confirmations)
+	assert.False(t, done)
+
+	status, done = tracker.update(now.Add(5*time.Second), 11, "b11", false, 20, 11)
+	assert.Equal(t, TxStateIrreversible, status.State)
+	assert.True(t, done)
+}
+
+func TestTxStatusTracker_Dropped(t *testing.T) {
+	now := time.Unix(1500000000, 0)
+	tracker := newTxStatusTracker("tx", 0, core.DefaultBlockInterval)
+
+	status, _ := tracker.update(now, 0, "", true, 10, 5)
+	assert.Equal(t, TxStatePending, status.State)
+
+	// popped by the miner.
+	status, done := tracker.update(now.Add(time.Second), 0, "", false, 10, 5)
+	assert.Nil(t, status)
+	assert.False(t, done)
+
+	status, done = tracker.update(now.Add(time.Second+txDroppedTimeout(core.DefaultBlockInterval)), 0, "", false, 10, 5)
+	assert.Equal(t, TxStateDropped, status.State)
+	assert.True(t, done)
+}
+
+func TestTxStatusTracker_Confirmations(t *testing.T) {
+	tracker := newTxStatusTracker("tx", 2, core.DefaultBlockInterval)
+	now := time.Unix(1500000000, 0)
+
+	_, done := tracker.update(now, 11, "b11", false, 11, 5)
+	assert.False(t, done)
+	status, done := tracker.update(now, 11, "b11", false, 12, 5)
+	assert.Equal(t, uint64(2), status.Confirmations)
+	assert.True(t, done)
+}
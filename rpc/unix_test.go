@@ -0,0 +1,51 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListenUnix(t *testing.T) {
+	dir, err := ioutil.TempDir("", "neb_rpc_unix")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "neb.sock")
+
+	// stale socket file is replaced.
+	assert.Nil(t, ioutil.WriteFile(path, nil, 0644))
+
+	listener, err := listenUnix(path, DefaultUnixSocketMode)
+	assert.Nil(t, err)
+	defer listener.Close()
+
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultUnixSocketMode, info.Mode().Perm())
+
+	conn, err := dialUnix(path, 0)
+	assert.Nil(t, err)
+	conn.Close()
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+)
+
+// VoteSnapshotCSVPath is the path of the vote snapshot in CSV on gateway, served with the admin module.
+// The query "height" selects the block, "table" selects candidates (default) or delegations.
+const VoteSnapshotCSVPath = "/v1/admin/voteSnapshot.csv"
+
+// Tables of the vote snapshot in CSV.
+const (
+	CandidatesTable  = "candidates"
+	DelegationsTable = "delegations"
+)
+
+// ErrInvalidSnapshotTable throws when the table of vote snapshot is unknown.
+var ErrInvalidSnapshotTable = errors.New("invalid vote snapshot table, should be candidates or delegations")
+
+func voteSnapshotResponse(snapshot *core.VoteSnapshot) *rpcpb.VoteSnapshotResponse {
+	resp := &rpcpb.VoteSnapshotResponse{Height: snapshot.Height}
+	for _, v := range snapshot.Candidates {
+		resp.Candidates = append(resp.Candidates, &rpcpb.CandidateVotes{
+			Address: v.Address.String(),
+			Votes:   v.Votes.String(),
+		})
+	}
+	for _, v := range snapshot.Delegations {
+		resp.Delegations = append(resp.Delegations, &rpcpb.Delegation{
+			Delegator: v.Delegator.String(),
+			Delegatee: v.Delegatee.String(),
+		})
+	}
+	return resp
+}
+
+// writeVoteSnapshotCSV writes a table of the snapshot with the header row.
+func writeVoteSnapshotCSV(w io.Writer, snapshot *rpcpb.VoteSnapshotResponse, table string) error {
+	writer := csv.NewWriter(w)
+	height := strconv.FormatUint(snapshot.Height, 10)
+	switch table {
+	case "", CandidatesTable:
+		writer.Write([]string{"height", "rank", "candidate", "votes"})
+		for i, v := range snapshot.Candidates {
+			writer.Write([]string{height, strconv.Itoa(i + 1), v.Address, v.Votes})
+		}
+	case DelegationsTable:
+		writer.Write([]string{"height", "delegator", "delegatee"})
+		for _, v := range snapshot.Delegations {
+			writer.Write([]string{height, v.Delegator, v.Delegatee})
+		}
+	default:
+		return ErrInvalidSnapshotTable
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// voteSnapshotCSVHandler serves the vote snapshot in CSV.
+func voteSnapshotCSVHandler(neb Neblet) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var height uint64
+		if v := r.URL.Query().Get("height"); len(v) > 0 {
+			h, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			height = h
+		}
+		table := r.URL.Query().Get("table")
+		if table != "" && table != CandidatesTable && table != DelegationsTable {
+			http.Error(w, ErrInvalidSnapshotTable.Error(), http.StatusBadRequest)
+			return
+		}
+		snapshot, err := neb.BlockChain().VoteSnapshot(height)
+		if err == core.ErrNotBlockInCanonicalChain {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "text/csv")
+		writeVoteSnapshotCSV(w, voteSnapshotResponse(snapshot), table)
+	}
+}
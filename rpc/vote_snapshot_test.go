@@ -0,0 +1,50 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/rpc/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteVoteSnapshotCSV(t *testing.T) {
+	snapshot := &rpcpb.VoteSnapshotResponse{
+		Height: 10,
+		Candidates: []*rpcpb.CandidateVotes{
+			{Address: "a", Votes: "200"},
+			{Address: "b", Votes: "100"},
+		},
+		Delegations: []*rpcpb.Delegation{
+			{Delegator: "c", Delegatee: "a"},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+	assert.Nil(t, writeVoteSnapshotCSV(buf, snapshot, ""))
+	assert.Equal(t, "height,rank,candidate,votes\n10,1,a,200\n10,2,b,100\n", buf.String())
+
+	buf.Reset()
+	assert.Nil(t, writeVoteSnapshotCSV(buf, snapshot, DelegationsTable))
+	assert.Equal(t, "height,delegator,delegatee\n10,c,a\n", buf.String())
+
+	assert.Equal(t, ErrInvalidSnapshotTable, writeVoteSnapshotCSV(buf, snapshot, "votes"))
+}
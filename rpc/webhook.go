@@ -0,0 +1,405 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/nebulasio/go-nebulas/storage"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Webhook delivery settings.
+const (
+	// WebhooksKey is the storage key of the registered webhooks.
+	WebhooksKey = "rpc_webhooks"
+
+	// WebhookSignatureHeader is the header of hex encoded HMAC-SHA256 signature of the body.
+	WebhookSignatureHeader = "X-Neb-Signature"
+
+	// WebhookIDHeader is the header of the webhook id.
+	WebhookIDHeader = "X-Neb-Webhook"
+
+	// MaxWebhooks is the max count of registered webhooks.
+	MaxWebhooks = 64
+
+	webhookBatchSize    = 100
+	webhookPollInterval = time.Second
+	webhookMinBackoff   = time.Second
+	webhookMaxBackoff   = 5 * time.Minute
+	webhookPostTimeout  = 10 * time.Second
+)
+
+// Errors
+var (
+	ErrWebhookInvalidURL = errors.New("webhook url must be https")
+	ErrWebhookNotFound   = errors.New("webhook not found")
+	ErrTooManyWebhooks   = errors.New("too many webhooks")
+)
+
+// WebhookConfig is the registration of a webhook.
+type WebhookConfig struct {
+	ID        string   `json:"id"`
+	URL       string   `json:"url"`
+	Topics    []string `json:"topics"`
+	Contracts []string `json:"contracts"`
+	Secret    string   `json:"secret"`
+}
+
+// WebhookEvent is the event posted to the webhook.
+type WebhookEvent struct {
+	Height   uint64 `json:"height"`
+	Index    uint32 `json:"index"`
+	TxHash   string `json:"tx_hash"`
+	Contract string `json:"contract,omitempty"`
+	Topic    string `json:"topic"`
+	Data     string `json:"data"`
+}
+
+// WebhookPayload is the body posted to the webhook.
+type WebhookPayload struct {
+	ID     string          `json:"id"`
+	Events []*WebhookEvent `json:"events"`
+}
+
+type webhook struct {
+	config    *WebhookConfig
+	topics    map[string]bool
+	contracts map[string]bool
+	quitCh    chan int
+}
+
+// WebhookManager posts the chain events to the registered webhooks. Each webhook
+// consumes the events by its own event cursor, the cursor is committed after the
+// events are delivered, so the failed deliveries are retried with backoff.
+type WebhookManager struct {
+	bc      *core.BlockChain
+	storage storage.Storage
+	client  *http.Client
+
+	mu       sync.Mutex
+	webhooks map[string]*webhook
+	started  bool
+}
+
+// NewWebhookManager return a new WebhookManager.
+func NewWebhookManager(bc *core.BlockChain) *WebhookManager {
+	return &WebhookManager{
+		bc:       bc,
+		storage:  bc.Storage(),
+		client:   &http.Client{Timeout: webhookPostTimeout},
+		webhooks: make(map[string]*webhook),
+	}
+}
+
+// Start loads the registered webhooks and starts delivering events.
+func (m *WebhookManager) Start() error {
+	configs, err := m.loadConfigs()
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, config := range configs {
+		h := newWebhook(config)
+		m.webhooks[config.ID] = h
+		go m.loop(h)
+	}
+	m.started = true
+	return nil
+}
+
+// Stop stops delivering events.
+func (m *WebhookManager) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, h := range m.webhooks {
+		close(h.quitCh)
+	}
+	m.webhooks = make(map[string]*webhook)
+	m.started = false
+}
+
+// Register adds a webhook, only the events after current tail block are posted.
+func (m *WebhookManager) Register(config *WebhookConfig) (string, error) {
+	u, err := url.Parse(config.URL)
+	if err != nil || u.Scheme != "https" || len(u.Host) == 0 {
+		return "", ErrWebhookInvalidURL
+	}
+	for i, v := range config.Contracts {
+		addr, err := core.AddressParse(v)
+		if err != nil {
+			return "", err
+		}
+		config.Contracts[i] = addr.String()
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	configs, err := m.loadConfigs()
+	if err != nil {
+		return "", err
+	}
+	if len(configs) >= MaxWebhooks {
+		return "", ErrTooManyWebhooks
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	config.ID = hex.EncodeToString(id)
+
+	// skip the events before registration.
+	cursor := &core.EventCursor{Height: m.bc.TailBlock().Height(), Index: math.MaxUint32}
+	if err := m.bc.CommitEventCursor(webhookConsumer(config.ID), cursor); err != nil {
+		return "", err
+	}
+	if err := m.storeConfigs(append(configs, config)); err != nil {
+		return "", err
+	}
+
+	h := newWebhook(config)
+	m.webhooks[config.ID] = h
+	if m.started {
+		go m.loop(h)
+	}
+	return config.ID, nil
+}
+
+// Unregister removes the webhook and its event cursor.
+func (m *WebhookManager) Unregister(id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	configs, err := m.loadConfigs()
+	if err != nil {
+		return err
+	}
+	for i, config := range configs {
+		if config.ID != id {
+			continue
+		}
+		if err := m.storeConfigs(append(configs[:i], configs[i+1:]...)); err != nil {
+			return err
+		}
+		if h, ok := m.webhooks[id]; ok {
+			if m.started {
+				close(h.quitCh)
+			}
+			delete(m.webhooks, id)
+		}
+		return m.bc.DeleteEventCursor(webhookConsumer(id))
+	}
+	return ErrWebhookNotFound
+}
+
+func (m *WebhookManager) loop(h *webhook) {
+	backoff := time.Duration(0)
+	for {
+		wait := webhookPollInterval
+		fetched, err := m.deliver(h)
+		if err != nil {
+			if backoff *= 2; backoff < webhookMinBackoff {
+				backoff = webhookMinBackoff
+			}
+			if backoff > webhookMaxBackoff {
+				backoff = webhookMaxBackoff
+			}
+			wait = backoff
+			logging.VLog().WithFields(logrus.Fields{
+				"id":    h.config.ID,
+				"url":   h.config.URL,
+				"retry": backoff,
+				"err":   err,
+			}).Debug("Failed to deliver events to webhook.")
+		} else {
+			backoff = 0
+			if fetched {
+				wait = 0
+			}
+		}
+
+		select {
+		case <-h.quitCh:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// deliver posts the matching events after the cursor and commits the cursor,
+// it returns whether any event is fetched.
+func (m *WebhookManager) deliver(h *webhook) (bool, error) {
+	consumer := webhookConsumer(h.config.ID)
+	cursor, err := m.bc.LoadEventCursor(consumer)
+	if err != nil {
+		return false, err
+	}
+	events, next, err := m.bc.EventsAfterCursor(cursor, webhookBatchSize)
+	if err != nil {
+		return false, err
+	}
+
+	payload := &WebhookPayload{ID: h.config.ID}
+	for _, e := range events {
+		if v := h.match(e, eventContract(m.bc, e.TxHash)); v != nil {
+			payload.Events = append(payload.Events, v)
+		}
+	}
+	if len(payload.Events) > 0 {
+		if err := m.post(h.config, payload); err != nil {
+			return false, err
+		}
+	}
+
+	if next != nil && (cursor == nil || *next != *cursor) {
+		if err := m.bc.CommitEventCursor(consumer, next); err != nil {
+			return false, err
+		}
+	}
+	return len(events) > 0, nil
+}
+
+func (m *WebhookManager) post(config *WebhookConfig, payload *WebhookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookIDHeader, config.ID)
+	req.Header.Set(WebhookSignatureHeader, signWebhookBody(config.Secret, body))
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (m *WebhookManager) loadConfigs() ([]*WebhookConfig, error) {
+	value, err := m.storage.Get([]byte(WebhooksKey))
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var configs []*WebhookConfig
+	if err := json.Unmarshal(value, &configs); err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
+
+func (m *WebhookManager) storeConfigs(configs []*WebhookConfig) error {
+	value, err := json.Marshal(configs)
+	if err != nil {
+		return err
+	}
+	return m.storage.Put([]byte(WebhooksKey), value)
+}
+
+func newWebhook(config *WebhookConfig) *webhook {
+	h := &webhook{
+		config:    config,
+		topics:    make(map[string]bool),
+		contracts: make(map[string]bool),
+		quitCh:    make(chan int),
+	}
+	for _, v := range config.Topics {
+		h.topics[v] = true
+	}
+	for _, v := range config.Contracts {
+		h.contracts[v] = true
+	}
+	return h
+}
+
+// match return the event to post if it matches the filters, otherwise nil.
+func (h *webhook) match(e *core.CursorEvent, contract string) *WebhookEvent {
+	if len(h.topics) > 0 && !h.topics[e.Topic] {
+		return nil
+	}
+	if len(h.contracts) > 0 && !h.contracts[contract] {
+		return nil
+	}
+
+	return &WebhookEvent{
+		Height:   e.Height,
+		Index:    e.Index,
+		TxHash:   e.TxHash.String(),
+		Contract: contract,
+		Topic:    e.Topic,
+		Data:     e.Data,
+	}
+}
+
+// eventContract return the address of the contract called or deployed by the tx, empty if none.
+func eventContract(bc *core.BlockChain, txHash byteutils.Hash) string {
+	tx := bc.GetTransaction(txHash)
+	if tx == nil {
+		return ""
+	}
+	switch tx.Type() {
+	case core.TxPayloadCallType, core.TxPayloadUpgradeType, core.TxPayloadVerifyType, core.TxPayloadPauseType:
+		return tx.To().String()
+	case core.TxPayloadDeployType:
+		if addr, err := tx.GenerateContractAddress(); err == nil {
+			return addr.String()
+		}
+	}
+	return ""
+}
+
+func webhookConsumer(id string) string {
+	return "webhook." + id
+}
+
+// signWebhookBody return the hex encoded HMAC-SHA256 of the body.
+func signWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
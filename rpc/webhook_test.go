@@ -0,0 +1,72 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package rpc
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookMatch(t *testing.T) {
+	h := newWebhook(&WebhookConfig{
+		Topics:    []string{"chain.contract.Transfer"},
+		Contracts: []string{"contract"},
+	})
+	e := &core.CursorEvent{
+		Event:  &core.Event{Topic: "chain.contract.Transfer", Data: "{}"},
+		Height: 10,
+		Index:  2,
+		TxHash: []byte{0x01},
+	}
+
+	v := h.match(e, "contract")
+	assert.NotNil(t, v)
+	assert.Equal(t, uint64(10), v.Height)
+	assert.Equal(t, uint32(2), v.Index)
+	assert.Equal(t, "01", v.TxHash)
+	assert.Equal(t, "contract", v.Contract)
+
+	assert.Nil(t, h.match(e, "other"))
+	e.Topic = "chain.executeTxSuccess"
+	assert.Nil(t, h.match(e, "contract"))
+
+	// no filters matches all events.
+	assert.NotNil(t, newWebhook(&WebhookConfig{}).match(e, ""))
+}
+
+func TestWebhookRegisterInvalidURL(t *testing.T) {
+	m := &WebhookManager{webhooks: make(map[string]*webhook)}
+	for _, v := range []string{"http://example.com/hook", "example.com", "https://"} {
+		_, err := m.Register(&WebhookConfig{URL: v})
+		assert.Equal(t, ErrWebhookInvalidURL, err)
+	}
+}
+
+func TestSignWebhookBody(t *testing.T) {
+	body := []byte(`{"id":"1","events":[]}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(body)
+	assert.Equal(t, hex.EncodeToString(mac.Sum(nil)), signWebhookBody("secret", body))
+	assert.NotEqual(t, signWebhookBody("other", body), signWebhookBody("secret", body))
+}
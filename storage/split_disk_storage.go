@@ -0,0 +1,137 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"path/filepath"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+)
+
+// LargeValueThreshold is the min size of values stored in the large keyspace.
+const LargeValueThreshold = 1024
+
+// SplitDiskStorage stores the small values, such as trie nodes, and the large values,
+// such as block bodies, in separate keyspaces, each with options tuned for its access pattern.
+type SplitDiskStorage struct {
+	small *leveldb.DB
+	large *leveldb.DB
+	cache *lru.Cache
+}
+
+// NewSplitDiskStorage init a storage with small and large keyspaces under path
+func NewSplitDiskStorage(path string) (*SplitDiskStorage, error) {
+	cache, err := lru.New(40960)
+	if err != nil {
+		return nil, err
+	}
+
+	// small values are read randomly, use small blocks with bloom filter and a large block cache.
+	small, err := leveldb.OpenFile(filepath.Join(path, "small"), &opt.Options{
+		OpenFilesCacheCapacity: 4096,
+		BlockCacheCapacity:     16 * opt.MiB,
+		BlockSize:              4 * opt.KiB,
+		WriteBuffer:            8 * opt.MiB,
+		Filter:                 filter.NewBloomFilter(10),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// large values are written sequentially, use large tables to reduce compactions.
+	large, err := leveldb.OpenFile(filepath.Join(path, "large"), &opt.Options{
+		OpenFilesCacheCapacity: 1024,
+		BlockCacheCapacity:     4 * opt.MiB,
+		BlockSize:              64 * opt.KiB,
+		WriteBuffer:            32 * opt.MiB,
+		CompactionTableSize:    8 * opt.MiB,
+		CompactionTotalSize:    40 * opt.MiB,
+		Filter:                 filter.NewBloomFilter(10),
+	})
+	if err != nil {
+		small.Close()
+		return nil, err
+	}
+
+	return &SplitDiskStorage{
+		small: small,
+		large: large,
+		cache: cache,
+	}, nil
+}
+
+// Get return value to the key in Storage
+func (storage *SplitDiskStorage) Get(key []byte) ([]byte, error) {
+	if value, exist := storage.cache.Get(byteutils.Hex(key)); exist {
+		return value.([]byte), nil
+	}
+	value, err := storage.small.Get(key, nil)
+	if err == leveldb.ErrNotFound {
+		value, err = storage.large.Get(key, nil)
+	}
+	if err != nil && err == leveldb.ErrNotFound {
+		return nil, ErrKeyNotFound
+	}
+	return value, err
+}
+
+// Put put the key-value entry to Storage
+func (storage *SplitDiskStorage) Put(key []byte, value []byte) error {
+	db, other := storage.small, storage.large
+	if len(value) >= LargeValueThreshold {
+		db, other = storage.large, storage.small
+	}
+
+	// the value of the key may be moved between keyspaces, drop the old one first
+	// so Get never finds a stale value in the other keyspace.
+	if exist, _ := other.Has(key, nil); exist {
+		if err := other.Delete(key, nil); err != nil {
+			return err
+		}
+	}
+	if err := db.Put(key, value, nil); err != nil {
+		return err
+	}
+	storage.cache.Add(byteutils.Hex(key), value)
+	return nil
+}
+
+// Del delete the key in Storage.
+func (storage *SplitDiskStorage) Del(key []byte) error {
+	if err := storage.small.Delete(key, nil); err != nil {
+		return err
+	}
+	if err := storage.large.Delete(key, nil); err != nil {
+		return err
+	}
+	storage.cache.Remove(byteutils.Hex(key))
+	return nil
+}
+
+// Close levelDB
+func (storage *SplitDiskStorage) Close() error {
+	if err := storage.small.Close(); err != nil {
+		return err
+	}
+	return storage.large.Close()
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/util/byteutils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitDiskStorage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "split.db")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	storage, err := NewSplitDiskStorage(dir)
+	assert.Nil(t, err)
+	defer storage.Close()
+
+	key := []byte("key")
+	small := []byte("small")
+	large := make([]byte, LargeValueThreshold)
+
+	assert.Nil(t, storage.Put(key, small))
+	value, err := storage.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, small, value)
+
+	// move the value to large keyspace.
+	assert.Nil(t, storage.Put(key, large))
+	storage.cache.Purge()
+	value, err = storage.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, large, value)
+	exist, err := storage.small.Has(key, nil)
+	assert.Nil(t, err)
+	assert.False(t, exist)
+
+	// move the value back to small keyspace.
+	assert.Nil(t, storage.Put(key, small))
+	storage.cache.Purge()
+	value, err = storage.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, small, value)
+	exist, err = storage.large.Has(key, nil)
+	assert.Nil(t, err)
+	assert.False(t, exist)
+
+	assert.Nil(t, storage.Del(key))
+	_, err = storage.Get(key)
+	assert.Equal(t, ErrKeyNotFound, err)
+}
+
+func benchmarkStoragePut(b *testing.B, storage Storage) {
+	// mixed workload, 9 small trie nodes with a large block body.
+	smallValue := make([]byte, 128)
+	largeValue := make([]byte, 16*1024)
+	rand.Read(largeValue)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		key := make([]byte, 32)
+		rand.Read(key)
+		value := smallValue
+		if i%10 == 0 {
+			value = largeValue
+		}
+		if err := storage.Put(key, value); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDiskStoragePut(b *testing.B) {
+	dir, _ := ioutil.TempDir("", "disk.db")
+	defer os.RemoveAll(dir)
+	storage, err := NewDiskStorage(dir)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer storage.Close()
+	benchmarkStoragePut(b, storage)
+}
+
+func BenchmarkSplitDiskStoragePut(b *testing.B) {
+	dir, _ := ioutil.TempDir("", "split.db")
+	defer os.RemoveAll(dir)
+	storage, err := NewSplitDiskStorage(dir)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer storage.Close()
+	benchmarkStoragePut(b, storage)
+}
+
+func benchmarkStorageGet(b *testing.B, storage Storage) {
+	keys := make([][]byte, 10000)
+	for i := range keys {
+		keys[i] = byteutils.FromUint64(uint64(i))
+		if err := storage.Put(keys[i], make([]byte, 128)); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := storage.Get(keys[i%len(keys)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDiskStorageGet(b *testing.B) {
+	dir, _ := ioutil.TempDir("", "disk.db")
+	defer os.RemoveAll(dir)
+	storage, err := NewDiskStorage(dir)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer storage.Close()
+	benchmarkStorageGet(b, storage)
+}
+
+func BenchmarkSplitDiskStorageGet(b *testing.B) {
+	dir, _ := ioutil.TempDir("", "split.db")
+	defer os.RemoveAll(dir)
+	storage, err := NewSplitDiskStorage(dir)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer storage.Close()
+	benchmarkStorageGet(b, storage)
+}
@@ -174,6 +174,8 @@ func (n MockNetManager) SendMessageToPeer(messageName string, data []byte, prior
 
 func (n MockNetManager) ClosePeer(peerID string, reason error) {}
 
+func (n MockNetManager) ReportPeer(peerID string, misbehavior p2p.Misbehavior) {}
+
 func (n MockNetManager) BroadcastNetworkID([]byte) {}
 
 func (n MockNetManager) BuildRawMessageData([]byte, string) []byte { return nil }
@@ -208,12 +210,12 @@ func TestChunk_generateChunkMeta(t *testing.T) {
 
 	blocks := []*core.Block{}
 	for i := 0; i < 96; i++ {
-		context, err := chain.TailBlock().NextDynastyContext(chain, core.BlockInterval)
+		context, err := chain.TailBlock().NextDynastyContext(chain, core.DefaultBlockInterval)
 		assert.Nil(t, err)
 		block, err := chain.NewBlock(coinbase)
 		assert.Nil(t, err)
 		block.LoadDynastyContext(context)
-		block.SetTimestamp(core.BlockInterval * int64(i+1))
+		block.SetTimestamp(core.DefaultBlockInterval * int64(i+1))
 		block.SetMiner(coinbase)
 		block.Sign(signature)
 		assert.Nil(t, block.Seal())
@@ -0,0 +1,89 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"time"
+)
+
+// ProgressWindow is the count of the latest samples to calculate the sync speed,
+// the tail height is sampled every second.
+const ProgressWindow = 30
+
+// Status is the progress of the sync.
+type Status struct {
+	Syncing bool
+
+	// CurrentHeight is the height of the tail block.
+	CurrentHeight uint64
+
+	// TargetHeight is the best known height of the peers, it's not less than CurrentHeight.
+	TargetHeight uint64
+
+	// BlocksPerSecond is the speed of the tail height in the latest window.
+	BlocksPerSecond float64
+
+	// ETA is the estimated duration to reach TargetHeight, 0 if unknown or synced.
+	ETA time.Duration
+}
+
+type progressSample struct {
+	at     time.Time
+	height uint64
+}
+
+// progress samples the tail height to estimate the sync speed.
+type progress struct {
+	samples []progressSample
+}
+
+func (p *progress) record(at time.Time, height uint64) {
+	p.samples = append(p.samples, progressSample{at: at, height: height})
+	if len(p.samples) > ProgressWindow+1 {
+		p.samples = p.samples[len(p.samples)-ProgressWindow-1:]
+	}
+}
+
+func (p *progress) speed() float64 {
+	if len(p.samples) < 2 {
+		return 0
+	}
+	first, last := p.samples[0], p.samples[len(p.samples)-1]
+	elapsed := last.at.Sub(first.at).Seconds()
+	if elapsed <= 0 || last.height <= first.height {
+		return 0
+	}
+	return float64(last.height-first.height) / elapsed
+}
+
+func newStatus(syncing bool, current, target uint64, speed float64) *Status {
+	status := &Status{
+		Syncing:         syncing,
+		CurrentHeight:   current,
+		TargetHeight:    current,
+		BlocksPerSecond: speed,
+	}
+	if target > current {
+		status.TargetHeight = target
+		if speed > 0 {
+			status.ETA = time.Duration(float64(target-current) / speed * float64(time.Second))
+		}
+	}
+	return status
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgress(t *testing.T) {
+	p := new(progress)
+	assert.Equal(t, float64(0), p.speed())
+
+	start := time.Unix(1500000000, 0)
+	for i := 0; i <= ProgressWindow+10; i++ {
+		p.record(start.Add(time.Duration(i)*time.Second), uint64(100+i*4))
+	}
+	assert.Equal(t, ProgressWindow+1, len(p.samples))
+	assert.Equal(t, float64(4), p.speed())
+
+	status := newStatus(true, 200, 600, p.speed())
+	assert.Equal(t, uint64(600), status.TargetHeight)
+	assert.Equal(t, 100*time.Second, status.ETA)
+
+	// the target is never behind the tail.
+	status = newStatus(false, 200, 100, 0)
+	assert.Equal(t, uint64(200), status.TargetHeight)
+	assert.Equal(t, time.Duration(0), status.ETA)
+}
@@ -50,6 +50,9 @@ type Service struct {
 
 	activeTask      *Task
 	activeTaskMutex sync.Mutex
+
+	progress      *progress
+	progressMutex sync.Mutex
 }
 
 // NewService return new Service.
@@ -61,6 +64,7 @@ func NewService(blockChain *core.BlockChain, netService p2p.Manager) *Service {
 		quitCh:     make(chan bool, 1),
 		activeTask: nil,
 		messageCh:  make(chan net.Message, 128),
+		progress:   new(progress),
 	}
 }
 
@@ -147,6 +151,27 @@ func (ss *Service) WaitingForFinish() error {
 	return err
 }
 
+// Status return the progress of the sync, the target height is the best known height
+// of the blocks received from peers and the chunks being synced.
+func (ss *Service) Status() *Status {
+	ss.activeTaskMutex.Lock()
+	task := ss.activeTask
+	ss.activeTaskMutex.Unlock()
+
+	target := ss.blockChain.BlockPool().BestHeight()
+	if task != nil {
+		if height := task.targetHeight(); height > target {
+			target = height
+		}
+	}
+
+	ss.progressMutex.Lock()
+	speed := ss.progress.speed()
+	ss.progressMutex.Unlock()
+
+	return newStatus(task != nil, ss.blockChain.TailBlock().Height(), target, speed)
+}
+
 func (ss *Service) startLoop() {
 	logging.CLog().Info("Started Sync Service.")
 	timerChan := time.NewTicker(time.Second).C
@@ -155,6 +180,9 @@ func (ss *Service) startLoop() {
 		select {
 		case <-timerChan:
 			metricsCachedSync.Update(int64(len(ss.messageCh)))
+			ss.progressMutex.Lock()
+			ss.progress.record(time.Now(), ss.blockChain.TailBlock().Height())
+			ss.progressMutex.Unlock()
 		case <-ss.quitCh:
 			if ss.activeTask != nil {
 				ss.activeTask.Stop()
@@ -193,6 +221,7 @@ func (ss *Service) onChainSync(message net.Message) {
 			"err": err,
 			"pid": message.MessageFrom(),
 		}).Debug("Invalid ChainSync message data.")
+		ss.netService.ReportPeer(message.MessageFrom(), p2p.MisbehaviorMalformedMessage)
 		ss.netService.ClosePeer(message.MessageFrom(), ErrInvalidChainSyncMessageData)
 		return
 	}
@@ -232,6 +261,7 @@ func (ss *Service) onChainGetChunk(message net.Message) {
 			"err": err,
 			"pid": message.MessageFrom(),
 		}).Debug("Invalid ChainGetChunk message data.")
+		ss.netService.ReportPeer(message.MessageFrom(), p2p.MisbehaviorMalformedMessage)
 		ss.netService.ClosePeer(message.MessageFrom(), ErrInvalidChainGetChunkMessageData)
 		return
 	}
@@ -74,6 +74,7 @@ type Task struct {
 	chainChunkDataProcessPosition int
 	chainChunkData                map[int]*syncpb.ChunkData
 	chainChunkDataStatus          map[int]int64
+	chainChunkDataPeers           map[int]string
 	chinGetChunkDataDoneCh        chan bool
 
 	// debug fields.
@@ -101,6 +102,7 @@ func NewTask(blockChain *core.BlockChain, netService p2p.Manager, chunk *Chunk)
 		chainChunkDataProcessPosition:           0,
 		chainChunkData:                          make(map[int]*syncpb.ChunkData),
 		chainChunkDataStatus:                    make(map[int]int64),
+		chainChunkDataPeers:                     make(map[int]string),
 		chinGetChunkDataDoneCh:                  make(chan bool, 1),
 		// debug fields.
 		chainSyncRetryCount: 0,
@@ -185,6 +187,17 @@ func (st *Task) startSyncLoop() {
 	}
 }
 
+// targetHeight return the height of the last block in the chunks being synced, 0 if unknown.
+func (st *Task) targetHeight() uint64 {
+	st.syncMutex.Lock()
+	defer st.syncMutex.Unlock()
+
+	if st.maxConsistentChunkHeaders == nil {
+		return 0
+	}
+	return st.syncPointBlock.Height() + uint64(len(st.maxConsistentChunkHeaders.ChunkHeaders)*core.ChunkSize)
+}
+
 func (st *Task) reset() {
 	st.syncMutex.Lock()
 	defer st.syncMutex.Unlock()
@@ -197,6 +210,7 @@ func (st *Task) reset() {
 	st.chunkHeadersRootHashCounter = make(map[string]int)
 	st.receivedChunkHeadersRootHashPeers = make(map[string]bool)
 	st.chainChunkDataStatus = make(map[int]int64)
+	st.chainChunkDataPeers = make(map[int]string)
 	st.chainChunkDataSyncPosition = 0
 	st.chainChunkDataProcessPosition = 0
 	st.chainChunkData = make(map[int]*syncpb.ChunkData)
@@ -290,6 +304,7 @@ func (st *Task) processChunkHeaders(message net.Message) {
 			"err": err,
 			"pid": message.MessageFrom(),
 		}).Debug("Invalid ChainChunkHeaders message data.")
+		st.netService.ReportPeer(message.MessageFrom(), p2p.MisbehaviorMalformedMessage)
 		st.netService.ClosePeer(message.MessageFrom(), ErrInvalidChainChunksMessageData)
 		return
 	}
@@ -300,6 +315,7 @@ func (st *Task) processChunkHeaders(message net.Message) {
 			"err": err,
 			"pid": message.MessageFrom(),
 		}).Debug("Wrong ChainChunkHeaders message data.")
+		st.netService.ReportPeer(message.MessageFrom(), p2p.MisbehaviorMalformedMessage)
 		st.netService.ClosePeer(message.MessageFrom(), ErrWrongChainChunksMessageData)
 		return
 	}
@@ -393,6 +409,9 @@ func (st *Task) checkChainGetChunkTimeout() {
 			"timout":   time.Now().Unix() - st.chainChunkDataStatus[i],
 		}).Debugf("Get Chunk %d Timout. Retry.", i)
 
+		if peer, ok := st.chainChunkDataPeers[i]; ok {
+			st.netService.ReportPeer(peer, p2p.MisbehaviorSyncTimeout)
+		}
 		st.sendChainGetChunkMessage(i)
 	}
 }
@@ -413,6 +432,7 @@ func (st *Task) sendChainGetChunkMessage(chunkHeaderIndex int) {
 	st.netService.SendMessageToPeer(net.ChainGetChunk, data, net.MessagePriorityLow, peers[idx])
 
 	st.chainChunkDataStatus[chunkHeaderIndex] = time.Now().Unix()
+	st.chainChunkDataPeers[chunkHeaderIndex] = peers[idx]
 
 	logging.VLog().WithFields(logrus.Fields{
 		"peers": peers,
@@ -439,6 +459,7 @@ func (st *Task) processChunkData(message net.Message) {
 			"err": err,
 			"pid": message.MessageFrom(),
 		}).Debug("Invalid ChainChunkData message data.")
+		st.netService.ReportPeer(message.MessageFrom(), p2p.MisbehaviorMalformedMessage)
 		st.netService.ClosePeer(message.MessageFrom(), ErrInvalidChainChunkDataMessageData)
 		return
 	}
@@ -475,6 +496,7 @@ func (st *Task) processChunkData(message net.Message) {
 			"err": err,
 			"pid": message.MessageFrom(),
 		}).Debug("Wrong ChainChunkData message data, retry.")
+		st.netService.ReportPeer(message.MessageFrom(), p2p.MisbehaviorMalformedMessage)
 		st.netService.ClosePeer(message.MessageFrom(), err)
 		st.sendChainGetChunkMessage(chunkDataIndex)
 		return
@@ -489,6 +511,7 @@ func (st *Task) processChunkData(message net.Message) {
 				"err": err,
 				"pid": message.MessageFrom(),
 			}).Debug("Wrong ChainChunkData message data, retry.")
+			st.netService.ReportPeer(message.MessageFrom(), p2p.MisbehaviorInvalidBlock)
 			st.netService.ClosePeer(message.MessageFrom(), err)
 			st.sendChainGetChunkMessage(chunkDataIndex)
 			return
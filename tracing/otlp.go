@@ -0,0 +1,281 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/nebulasio/go-nebulas/util/logging"
+	"github.com/sirupsen/logrus"
+)
+
+// Exporter settings.
+const (
+	// DefaultServiceName is the service name of the exported spans.
+	DefaultServiceName = "neb"
+
+	// TracesPath is the OTLP/HTTP path of traces.
+	TracesPath = "/v1/traces"
+
+	exportInterval  = 5 * time.Second
+	exportBatchSize = 512
+	maxQueuedSpans  = 4096
+	exportTimeout   = 10 * time.Second
+)
+
+// OTLP status codes.
+const (
+	statusCodeOk    = 1
+	statusCodeError = 2
+)
+
+// Neblet interface breaks cycle import dependency.
+type Neblet interface {
+	Config() *nebletpb.Config
+}
+
+// tracer batches the ended spans and exports them to the OTLP/HTTP endpoint in JSON.
+type tracer struct {
+	url         string
+	serviceName string
+	sampleRatio float64
+	client      *http.Client
+
+	mu     sync.Mutex
+	queue  []*Span
+	quitCh chan bool
+	doneCh chan bool
+}
+
+var (
+	tracerMu sync.RWMutex
+	current  *tracer
+)
+
+func getTracer() *tracer {
+	tracerMu.RLock()
+	defer tracerMu.RUnlock()
+	return current
+}
+
+// Start start exporting the spans if the tracing endpoint is configured.
+func Start(neb Neblet) {
+	conf := neb.Config().GetStats().GetTracing()
+	if conf == nil || len(conf.Endpoint) == 0 {
+		return
+	}
+	logging.VLog().Info("Starting Tracing...")
+
+	t := &tracer{
+		url:         strings.TrimSuffix(conf.Endpoint, "/") + TracesPath,
+		serviceName: conf.ServiceName,
+		sampleRatio: conf.SampleRatio,
+		client:      &http.Client{Timeout: exportTimeout},
+		quitCh:      make(chan bool),
+		doneCh:      make(chan bool),
+	}
+	if len(t.serviceName) == 0 {
+		t.serviceName = DefaultServiceName
+	}
+	go t.loop()
+
+	tracerMu.Lock()
+	current = t
+	tracerMu.Unlock()
+
+	logging.VLog().WithFields(logrus.Fields{
+		"endpoint": t.url,
+	}).Info("Started Tracing.")
+}
+
+// Stop stop tracing and flush the queued spans.
+func Stop() {
+	tracerMu.Lock()
+	t := current
+	current = nil
+	tracerMu.Unlock()
+
+	if t == nil {
+		return
+	}
+	logging.VLog().Info("Stopping Tracing...")
+	t.quitCh <- true
+	<-t.doneCh
+	logging.VLog().Info("Stopped Tracing.")
+}
+
+func (t *tracer) sample(id TraceID) bool {
+	return sampled(id, t.sampleRatio)
+}
+
+func (t *tracer) export(span *Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.queue) >= maxQueuedSpans {
+		metricsDroppedSpans.Inc(1)
+		return
+	}
+	t.queue = append(t.queue, span)
+}
+
+func (t *tracer) loop() {
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.quitCh:
+			t.flush()
+			t.doneCh <- true
+			return
+		case <-ticker.C:
+			t.flush()
+		}
+	}
+}
+
+func (t *tracer) flush() {
+	t.mu.Lock()
+	spans := t.queue
+	t.queue = nil
+	t.mu.Unlock()
+
+	for len(spans) > 0 {
+		n := len(spans)
+		if n > exportBatchSize {
+			n = exportBatchSize
+		}
+		if err := t.post(spans[:n]); err != nil {
+			metricsDroppedSpans.Inc(int64(n))
+			logging.VLog().WithFields(logrus.Fields{
+				"url":   t.url,
+				"spans": n,
+				"err":   err,
+			}).Debug("Failed to export spans.")
+		}
+		spans = spans[n:]
+	}
+}
+
+func (t *tracer) post(spans []*Span) error {
+	data, err := json.Marshal(newOTLPRequest(t.serviceName, spans))
+	if err != nil {
+		return err
+	}
+	resp, err := t.client.Post(t.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return ErrUnexpectedExportStatus
+	}
+	return nil
+}
+
+// OTLP/HTTP JSON encoding of the ExportTraceServiceRequest.
+type otlpRequest struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   *otlpResource     `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope *otlpScope  `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string           `json:"traceId"`
+	SpanID            string           `json:"spanId"`
+	ParentSpanID      string           `json:"parentSpanId,omitempty"`
+	Name              string           `json:"name"`
+	Kind              int              `json:"kind"`
+	StartTimeUnixNano string           `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string           `json:"endTimeUnixNano"`
+	Attributes        []*otlpAttribute `json:"attributes,omitempty"`
+	Status            *otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string     `json:"key"`
+	Value *otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+func newOTLPRequest(serviceName string, spans []*Span) *otlpRequest {
+	scope := &otlpScopeSpans{Scope: &otlpScope{Name: "github.com/nebulasio/go-nebulas"}}
+	for _, s := range spans {
+		span := &otlpSpan{
+			TraceID:           hex.EncodeToString(s.context.TraceID[:]),
+			SpanID:            hex.EncodeToString(s.context.SpanID[:]),
+			Name:              s.name,
+			Kind:              s.kind,
+			StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+			Status:            &otlpStatus{Code: statusCodeOk},
+		}
+		if s.parent != (SpanID{}) {
+			span.ParentSpanID = hex.EncodeToString(s.parent[:])
+		}
+		for k, v := range s.attributes {
+			span.Attributes = append(span.Attributes, newOTLPAttribute(k, v))
+		}
+		if s.err != nil {
+			span.Status = &otlpStatus{Code: statusCodeError, Message: s.err.Error()}
+		}
+		scope.Spans = append(scope.Spans, span)
+	}
+	return &otlpRequest{
+		ResourceSpans: []*otlpResourceSpans{{
+			Resource:   &otlpResource{Attributes: []*otlpAttribute{newOTLPAttribute("service.name", serviceName)}},
+			ScopeSpans: []*otlpScopeSpans{scope},
+		}},
+	}
+}
+
+func newOTLPAttribute(key, value string) *otlpAttribute {
+	return &otlpAttribute{Key: key, Value: &otlpValue{StringValue: value}}
+}
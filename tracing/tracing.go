@@ -0,0 +1,259 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// Span kinds of OTLP.
+const (
+	SpanKindInternal = 1
+	SpanKindServer   = 2
+)
+
+// MaxBoundSpans is the max count of span contexts bound to keys.
+const MaxBoundSpans = 10000
+
+// Errors
+var (
+	ErrInvalidTraceparent     = errors.New("invalid traceparent")
+	ErrUnexpectedExportStatus = errors.New("unexpected status of otlp export response")
+)
+
+// TraceID is the id of a trace.
+type TraceID [16]byte
+
+// SpanID is the id of a span.
+type SpanID [8]byte
+
+// SpanContext is the propagated identity of a span.
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+// Traceparent return the W3C traceparent header of the span context.
+func (sc SpanContext) Traceparent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%s-%s-%s", hex.EncodeToString(sc.TraceID[:]), hex.EncodeToString(sc.SpanID[:]), flags)
+}
+
+// ParseTraceparent parse the W3C traceparent header.
+func ParseTraceparent(s string) (SpanContext, error) {
+	var sc SpanContext
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) != 4 || len(parts[0]) != 2 || parts[0] == "ff" || len(parts[3]) != 2 {
+		return sc, ErrInvalidTraceparent
+	}
+	traceID, err := hex.DecodeString(parts[1])
+	if err != nil || len(traceID) != len(sc.TraceID) {
+		return sc, ErrInvalidTraceparent
+	}
+	spanID, err := hex.DecodeString(parts[2])
+	if err != nil || len(spanID) != len(sc.SpanID) {
+		return sc, ErrInvalidTraceparent
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil {
+		return sc, ErrInvalidTraceparent
+	}
+	copy(sc.TraceID[:], traceID)
+	copy(sc.SpanID[:], spanID)
+	if sc.TraceID == (TraceID{}) || sc.SpanID == (SpanID{}) {
+		return sc, ErrInvalidTraceparent
+	}
+	sc.Sampled = flags[0]&1 == 1
+	return sc, nil
+}
+
+// Span is a timed operation in a trace, the methods of nil span are no-ops.
+type Span struct {
+	context    SpanContext
+	parent     SpanID
+	name       string
+	kind       int
+	start      time.Time
+	end        time.Time
+	attributes map[string]string
+	err        error
+}
+
+// Context return the span context, it's zero for nil span.
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.context
+}
+
+// SetAttribute set the attribute of the span.
+func (s *Span) SetAttribute(key, value string) {
+	if s == nil {
+		return
+	}
+	s.attributes[key] = value
+}
+
+// SetError mark the span failed.
+func (s *Span) SetError(err error) {
+	if s == nil {
+		return
+	}
+	s.err = err
+}
+
+// End finish the span and export it.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.end = time.Now()
+	if t := getTracer(); t != nil {
+		t.export(s)
+	}
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpan return the context carrying the span.
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	if span == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, spanContextKey{}, span.context)
+}
+
+// SpanContextFromContext return the span context carried by the context.
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	sc, ok := ctx.Value(spanContextKey{}).(SpanContext)
+	return sc, ok
+}
+
+// StartSpan start a span as the child of the span in ctx, it returns nil span if
+// tracing is disabled or the trace is not sampled.
+func StartSpan(ctx context.Context, name string) (*Span, context.Context) {
+	parent, ok := SpanContextFromContext(ctx)
+	span := startSpan(name, SpanKindInternal, parent, ok)
+	return span, ContextWithSpan(ctx, span)
+}
+
+// StartServerSpan start a span of the request with the remote traceparent, a new
+// trace is started if the traceparent is empty or invalid.
+func StartServerSpan(ctx context.Context, name, traceparent string) (*Span, context.Context) {
+	parent, err := ParseTraceparent(traceparent)
+	span := startSpan(name, SpanKindServer, parent, err == nil)
+	return span, ContextWithSpan(ctx, span)
+}
+
+func startSpan(name string, kind int, parent SpanContext, hasParent bool) *Span {
+	t := getTracer()
+	if t == nil {
+		return nil
+	}
+
+	span := &Span{
+		name:       name,
+		kind:       kind,
+		start:      time.Now(),
+		attributes: make(map[string]string),
+	}
+	if hasParent {
+		if !parent.Sampled {
+			return nil
+		}
+		span.context.TraceID = parent.TraceID
+		span.parent = parent.SpanID
+	} else {
+		rand.Read(span.context.TraceID[:])
+		if !t.sample(span.context.TraceID) {
+			return nil
+		}
+	}
+	rand.Read(span.context.SpanID[:])
+	span.context.Sampled = true
+	return span
+}
+
+// sampled return true if the trace is in the ratio.
+func sampled(id TraceID, ratio float64) bool {
+	if ratio <= 0 || ratio >= 1 {
+		return true
+	}
+	return float64(binary.BigEndian.Uint64(id[8:])>>11)/(1<<53) < ratio
+}
+
+// boundSpans keeps the span contexts by key, so the asynchronous work of an object,
+// e.g. the inclusion of a transaction, joins the trace of the request creating it.
+type boundSpans struct {
+	mu    sync.Mutex
+	spans map[string]SpanContext
+	keys  []string
+}
+
+var bound = &boundSpans{spans: make(map[string]SpanContext)}
+
+// Bind bind the span context of ctx to the key.
+func Bind(key []byte, ctx context.Context) {
+	sc, ok := SpanContextFromContext(ctx)
+	if !ok || getTracer() == nil {
+		return
+	}
+
+	bound.mu.Lock()
+	defer bound.mu.Unlock()
+	k := string(key)
+	if _, ok := bound.spans[k]; !ok {
+		bound.keys = append(bound.keys, k)
+	}
+	bound.spans[k] = sc
+	if len(bound.keys) > MaxBoundSpans {
+		delete(bound.spans, bound.keys[0])
+		bound.keys = bound.keys[1:]
+	}
+}
+
+// StartBoundSpan start a span as the child of the span context bound to the key, it
+// returns nil span if no span context is bound.
+func StartBoundSpan(key []byte, name string) *Span {
+	if getTracer() == nil {
+		return nil
+	}
+
+	bound.mu.Lock()
+	parent, ok := bound.spans[string(key)]
+	bound.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return startSpan(name, SpanKindInternal, parent, true)
+}
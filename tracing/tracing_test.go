@@ -0,0 +1,118 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package tracing
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nebulasio/go-nebulas/neblet/pb"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/context"
+)
+
+type mockNeb struct {
+	config *nebletpb.Config
+}
+
+func (n *mockNeb) Config() *nebletpb.Config {
+	return n.config
+}
+
+func TestTraceparent(t *testing.T) {
+	sc, err := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	assert.Nil(t, err)
+	assert.True(t, sc.Sampled)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", sc.Traceparent())
+
+	for _, v := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01",
+	} {
+		_, err := ParseTraceparent(v)
+		assert.Equal(t, ErrInvalidTraceparent, err, v)
+	}
+}
+
+func TestSampled(t *testing.T) {
+	assert.True(t, sampled(TraceID{15: 0xff}, 0))
+	assert.True(t, sampled(TraceID{8: 0x10}, 0.5))
+	assert.False(t, sampled(TraceID{8: 0x90}, 0.5))
+}
+
+func TestSpan_Disabled(t *testing.T) {
+	span, ctx := StartSpan(context.Background(), "test")
+	assert.Nil(t, span)
+	_, ok := SpanContextFromContext(ctx)
+	assert.False(t, ok)
+
+	// methods of nil span are no-ops.
+	span.SetAttribute("key", "value")
+	span.End()
+	Bind([]byte("tx"), ctx)
+	assert.Nil(t, StartBoundSpan([]byte("tx"), "test"))
+}
+
+func TestExport(t *testing.T) {
+	reqs := make(chan *otlpRequest, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, TracesPath, r.URL.Path)
+		req := new(otlpRequest)
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(req))
+		reqs <- req
+	}))
+	defer server.Close()
+
+	Start(&mockNeb{&nebletpb.Config{Stats: &nebletpb.StatsConfig{
+		Tracing: &nebletpb.TracingConfig{Endpoint: server.URL},
+	}}})
+
+	root, ctx := StartServerSpan(context.Background(), "/rpcpb.ApiService/SendTransaction", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	assert.NotNil(t, root)
+	Bind([]byte("tx"), ctx)
+	child := StartBoundSpan([]byte("tx"), "txpool.push")
+	child.SetAttribute("block.height", "1")
+	child.SetError(ErrInvalidTraceparent)
+	child.End()
+	root.End()
+
+	// the unsampled remote trace is not recorded.
+	span, _ := StartServerSpan(context.Background(), "test", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	assert.Nil(t, span)
+
+	Stop()
+	req := <-reqs
+	assert.Equal(t, "service.name", req.ResourceSpans[0].Resource.Attributes[0].Key)
+	assert.Equal(t, DefaultServiceName, req.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+	spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+	assert.Equal(t, 2, len(spans))
+	assert.Equal(t, "txpool.push", spans[0].Name)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].TraceID)
+	assert.Equal(t, spans[1].SpanID, spans[0].ParentSpanID)
+	assert.Equal(t, statusCodeError, spans[0].Status.Code)
+	assert.Equal(t, "00f067aa0ba902b7", spans[1].ParentSpanID)
+	assert.Equal(t, SpanKindServer, spans[1].Kind)
+	assert.Nil(t, getTracer())
+}
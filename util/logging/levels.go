@@ -0,0 +1,122 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package logging
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ErrInvalidLogLevel invalid log level
+var ErrInvalidLogLevel = errors.New("invalid log level")
+
+// moduleLevels holds the levels of verbose logs, the module of a log is the package
+// of the function calling the logger, e.g. "core" or "p2p".
+type moduleLevels struct {
+	mu      sync.RWMutex
+	def     logrus.Level
+	modules map[string]logrus.Level
+}
+
+var vlogLevels = &moduleLevels{def: logrus.InfoLevel, modules: make(map[string]logrus.Level)}
+
+// SetLevel changes the level of verbose logs of the module at runtime, the level of
+// all modules without their own level is changed if module is empty. The module
+// level is removed if level is empty.
+func SetLevel(module, level string) error {
+	logger := VLog()
+
+	var lvl logrus.Level
+	if len(level) > 0 || len(module) == 0 {
+		var err error
+		if lvl, err = parseLevel(level); err != nil {
+			return err
+		}
+	}
+
+	vlogLevels.mu.Lock()
+	if len(module) == 0 {
+		vlogLevels.def = lvl
+	} else if len(level) == 0 {
+		delete(vlogLevels.modules, module)
+	} else {
+		vlogLevels.modules[module] = lvl
+	}
+	vlogLevels.mu.Unlock()
+
+	// the logger drops entries by its level before hooks, so keep it the most verbose one.
+	logger.SetLevel(vlogLevels.max())
+	return nil
+}
+
+func (l *moduleLevels) max() logrus.Level {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	max := l.def
+	for _, v := range l.modules {
+		if v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+func (l *moduleLevels) enabled(entry *logrus.Entry) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	level := l.def
+	if fn, ok := entry.Data["func"].(string); ok {
+		if v, ok := l.modules[fn[:strings.Index(fn+".", ".")]]; ok {
+			level = v
+		}
+	}
+	return entry.Level <= level
+}
+
+// levelFilterHooker fires the inner hook only if the entry is enabled by the level
+// of its module, it must be added after the function hooker which finds the module.
+type levelFilterHooker struct {
+	levels *moduleLevels
+	inner  logrus.Hook
+}
+
+func (h *levelFilterHooker) Fire(entry *logrus.Entry) error {
+	if !h.levels.enabled(entry) {
+		return nil
+	}
+	return h.inner.Fire(entry)
+}
+
+func (h *levelFilterHooker) Levels() []logrus.Level {
+	return h.inner.Levels()
+}
+
+func parseLevel(level string) (logrus.Level, error) {
+	switch level {
+	case PanicLevel, FatalLevel, ErrorLevel, WarnLevel, InfoLevel, DebugLevel:
+		return convertLevel(level), nil
+	default:
+		return logrus.InfoLevel, ErrInvalidLogLevel
+	}
+}
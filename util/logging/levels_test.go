@@ -0,0 +1,67 @@
+// Copyright (C) 2017 go-nebulas authors
+//
+// This file is part of the go-nebulas library.
+//
+// the go-nebulas library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-nebulas library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-nebulas library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package logging
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type countHooker struct {
+	count int
+}
+
+func (h *countHooker) Fire(entry *logrus.Entry) error {
+	h.count++
+	return nil
+}
+
+func (h *countHooker) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func TestSetLevel(t *testing.T) {
+	levels := &moduleLevels{def: logrus.InfoLevel, modules: make(map[string]logrus.Level)}
+	inner := new(countHooker)
+	hooker := &levelFilterHooker{levels: levels, inner: inner}
+
+	core := &logrus.Entry{Level: logrus.DebugLevel, Data: logrus.Fields{"func": "core.(*BlockChain).SetTailBlock"}}
+	p2p := &logrus.Entry{Level: logrus.DebugLevel, Data: logrus.Fields{"func": "p2p.(*Node).Start"}}
+
+	hooker.Fire(core)
+	hooker.Fire(p2p)
+	assert.Equal(t, 0, inner.count)
+	assert.Equal(t, logrus.InfoLevel, levels.max())
+
+	levels.modules["core"] = logrus.DebugLevel
+	hooker.Fire(core)
+	hooker.Fire(p2p)
+	assert.Equal(t, 1, inner.count)
+	assert.Equal(t, logrus.DebugLevel, levels.max())
+
+	// entries without module use the default level.
+	hooker.Fire(&logrus.Entry{Level: logrus.WarnLevel, Data: logrus.Fields{}})
+	assert.Equal(t, 2, inner.count)
+
+	_, err := parseLevel("verbose")
+	assert.Equal(t, ErrInvalidLogLevel, err)
+	assert.Equal(t, ErrInvalidLogLevel, SetLevel("core", "verbose"))
+}
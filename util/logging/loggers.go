@@ -89,9 +89,14 @@ func Init(path string, level string, age uint32) {
 	clog.Formatter = &logrus.TextFormatter{FullTimestamp: true}
 	clog.Level = convertLevel("debug")
 
+	vlogLevels.mu.Lock()
+	vlogLevels.def = convertLevel(level)
+	vlogLevels.modules = make(map[string]logrus.Level)
+	vlogLevels.mu.Unlock()
+
 	vlog = logrus.New()
 	LoadFunctionHooker(vlog)
-	vlog.Hooks.Add(fileHooker)
+	vlog.Hooks.Add(&levelFilterHooker{levels: vlogLevels, inner: fileHooker})
 	vlog.Out = &emptyWriter{}
 	vlog.Formatter = &logrus.TextFormatter{FullTimestamp: true}
 	vlog.Level = convertLevel(level)